@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 type CircuitAvailableDecorator struct {
@@ -21,16 +22,62 @@ func NewCircuitAvailableDecorator(circuitKeeper anteinterfaces.CircuitKeeper) Ci
 func (d CircuitAvailableDecorator) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, sim bool, next sdk.AnteHandler,
 ) (sdk.Context, error) {
-	if d.circuitKeeper.GetSystemAvailable(ctx) {
+	msgs := tx.GetMsgs()
+	if !d.circuitKeeper.GetSystemAvailable(ctx) {
+		for _, msg := range msgs {
+			if !circuittype.IsOperatorMsg(msg) {
+				return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, "system unavailable")
+			}
+		}
 		return next(ctx, tx, sim)
 	}
 
-	msgs := tx.GetMsgs()
 	for _, msg := range msgs {
-		if _, ok := msg.(*circuittype.MsgUpdateCircuit); !ok {
-			return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, "system unavailable")
+		isDisabled, disabledTypeURL, err := d.containsDisabledMsg(ctx, msg, 1)
+		if err != nil {
+			return ctx, err
+		}
+		if isDisabled {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrUnauthorized,
+				"circuit tripped: message type %s is disabled",
+				disabledTypeURL,
+			)
 		}
 	}
 
 	return next(ctx, tx, sim)
 }
+
+const maxNestedCircuitMsgs = 7
+
+func (d CircuitAvailableDecorator) containsDisabledMsg(ctx sdk.Context, msg sdk.Msg, nestedLvl int) (bool, string, error) {
+	if nestedLvl >= maxNestedCircuitMsgs {
+		return true, sdk.MsgTypeURL(msg), errorsmod.Wrapf(errortypes.ErrUnauthorized, "found more nested msgs than permitted; got: %d, expected: <%d", nestedLvl, maxNestedCircuitMsgs)
+	}
+	if circuittype.IsOperatorMsg(msg) {
+		return false, "", nil
+	}
+	switch castMsg := msg.(type) {
+	case *authz.MsgExec:
+		if d.circuitKeeper.IsMsgTypeDisabled(ctx, sdk.MsgTypeURL(msg)) {
+			return true, sdk.MsgTypeURL(msg), nil
+		}
+		innerMsgs, err := castMsg.GetMessages()
+		if err != nil {
+			return true, sdk.MsgTypeURL(msg), errorsmod.Wrap(err, "failed to unpack authz messages")
+		}
+		for _, inner := range innerMsgs {
+			if ok, typeURL, err := d.containsDisabledMsg(ctx, inner, nestedLvl+1); err != nil || ok {
+				return ok, typeURL, err
+			}
+		}
+		return false, "", nil
+	default:
+		typeURL := sdk.MsgTypeURL(msg)
+		if d.circuitKeeper.IsMsgTypeDisabled(ctx, typeURL) {
+			return true, typeURL, nil
+		}
+		return false, "", nil
+	}
+}
@@ -8,20 +8,29 @@ import (
 
 	"github.com/cosmos/evm/ante/cosmos"
 	circuittype "github.com/cosmos/evm/x/circuit/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type mockCircuitKeeper struct {
-	systemAvailable bool
+	systemAvailable  bool
+	disabledTypeURLs map[string]bool
+	disabledModules  map[string]bool
 }
 
 func (m mockCircuitKeeper) GetSystemAvailable(_ sdk.Context) bool {
 	return m.systemAvailable
 }
 
+func (m mockCircuitKeeper) IsMsgTypeDisabled(_ sdk.Context, typeURL string) bool {
+	return m.disabledTypeURLs[typeURL] || m.disabledModules[circuittype.ModuleNameFromTypeURL(typeURL)]
+}
+
 type mockTx struct {
 	msgs []sdk.Msg
 }
@@ -38,6 +47,95 @@ func (m mockTx) ValidateBasic() error {
 	return nil
 }
 
+func TestCircuitAvailableDecoratorScopedTrips(t *testing.T) {
+	grantee := sdk.AccAddress([]byte("grantee"))
+	ethTxTypeURL := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+	testCases := []struct {
+		name      string
+		keeper    mockCircuitKeeper
+		msgs      []sdk.Msg
+		expectErr bool
+	}{
+		{
+			name:      "disabled msg type is rejected",
+			keeper:    mockCircuitKeeper{systemAvailable: true, disabledTypeURLs: map[string]bool{ethTxTypeURL: true}},
+			msgs:      []sdk.Msg{&evmtypes.MsgEthereumTx{}},
+			expectErr: true,
+		},
+		{
+			name:      "other msg types pass while one type is disabled",
+			keeper:    mockCircuitKeeper{systemAvailable: true, disabledTypeURLs: map[string]bool{ethTxTypeURL: true}},
+			msgs:      []sdk.Msg{&stakingtypes.MsgDelegate{}},
+			expectErr: false,
+		},
+		{
+			name:      "disabled module rejects its msgs",
+			keeper:    mockCircuitKeeper{systemAvailable: true, disabledModules: map[string]bool{"erc20": true}},
+			msgs:      []sdk.Msg{&erc20types.MsgConvertERC20{}},
+			expectErr: true,
+		},
+		{
+			name:      "disabled module does not affect other modules",
+			keeper:    mockCircuitKeeper{systemAvailable: true, disabledModules: map[string]bool{"erc20": true}},
+			msgs:      []sdk.Msg{&stakingtypes.MsgDelegate{}, &evmtypes.MsgEthereumTx{}},
+			expectErr: false,
+		},
+		{
+			name:      "disabled msg nested in authz exec is rejected",
+			keeper:    mockCircuitKeeper{systemAvailable: true, disabledModules: map[string]bool{"erc20": true}},
+			msgs:      []sdk.Msg{newMsgExec(grantee, &stakingtypes.MsgDelegate{}, &erc20types.MsgConvertERC20{})},
+			expectErr: true,
+		},
+		{
+			name:      "deeply nested authz exec is rejected",
+			keeper:    mockCircuitKeeper{systemAvailable: true, disabledModules: map[string]bool{"erc20": true}},
+			msgs:      []sdk.Msg{nestMsgExec(grantee, &stakingtypes.MsgDelegate{}, 7)},
+			expectErr: true,
+		},
+		{
+			name:      "MsgUpdateCircuit is never disabled",
+			keeper:    mockCircuitKeeper{systemAvailable: true, disabledModules: map[string]bool{circuittype.ModuleName: true}},
+			msgs:      []sdk.Msg{&circuittype.MsgUpdateCircuit{}},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nextCalled := false
+			dec := cosmos.NewCircuitAvailableDecorator(tc.keeper)
+
+			_, err := dec.AnteHandle(sdk.Context{}, mockTx{msgs: tc.msgs}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			})
+
+			if tc.expectErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				require.False(t, nextCalled)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, nextCalled)
+		})
+	}
+}
+
+func newMsgExec(grantee sdk.AccAddress, msgs ...sdk.Msg) *authz.MsgExec {
+	msg := authz.NewMsgExec(grantee, msgs)
+	return &msg
+}
+
+func nestMsgExec(grantee sdk.AccAddress, inner sdk.Msg, depth int) sdk.Msg {
+	msg := inner
+	for i := 0; i < depth; i++ {
+		msg = newMsgExec(grantee, msg)
+	}
+	return msg
+}
+
 func TestCircuitAvailableDecorator(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -65,6 +163,15 @@ func TestCircuitAvailableDecorator(t *testing.T) {
 			}},
 			expectErr: false,
 		},
+		{
+			name:          "system unavailable allows contract freeze operator messages",
+			systemEnabled: false,
+			tx: mockTx{msgs: []sdk.Msg{
+				&circuittype.MsgFreezeContracts{},
+				&circuittype.MsgUnfreezeContracts{},
+			}},
+			expectErr: false,
+		},
 		{
 			name:          "system unavailable blocks mixed messages",
 			systemEnabled: false,
@@ -5,6 +5,11 @@ import (
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -21,27 +26,108 @@ func NewIbcAvailableDecorator(ibcBreakerKeeper anteinterfaces.IbcBreakerKeeper)
 func (d IbcAvailableDecorator) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, sim bool, next sdk.AnteHandler,
 ) (sdk.Context, error) {
-	if d.ibcBreakerKeeper.GetIbcAvailable(ctx) {
-		return next(ctx, tx, sim)
-	}
+	available := d.ibcBreakerKeeper.GetIbcAvailable(ctx)
 
 	for _, msg := range tx.GetMsgs() {
-		isRestrictedIbcMsg, restrictedTypeURL, err := containsRestrictedIbcMsg(msg, 1)
-		if err != nil {
-			return ctx, err
+		if !available {
+			isRestrictedIbcMsg, restrictedTypeURL, err := containsRestrictedIbcMsg(msg, 1)
+			if err != nil {
+				return ctx, err
+			}
+			if isRestrictedIbcMsg {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrUnauthorized,
+					"ibc unavailable: message type %s is restricted",
+					restrictedTypeURL,
+				)
+			}
 		}
-		if isRestrictedIbcMsg {
-			return ctx, errorsmod.Wrapf(
-				errortypes.ErrUnauthorized,
-				"ibc unavailable: message type %s is restricted",
-				restrictedTypeURL,
-			)
+
+		if err := d.checkDeniedIbcRoutes(ctx, msg, 1); err != nil {
+			return ctx, err
 		}
 	}
 
 	return next(ctx, tx, sim)
 }
 
+// checkDeniedIbcRoutes rejects msg, or any message nested in it through authz,
+// if it sends over a denied channel or client or transfers a denied denom.
+func (d IbcAvailableDecorator) checkDeniedIbcRoutes(ctx sdk.Context, msg sdk.Msg, nestedLvl int) error {
+	if nestedLvl >= maxNestedIbcMsgs {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "found more nested msgs than permitted; got: %d, expected: <%d", nestedLvl, maxNestedIbcMsgs)
+	}
+	switch castMsg := msg.(type) {
+	case *authz.MsgExec:
+		innerMsgs, err := castMsg.GetMessages()
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to unpack authz messages")
+		}
+		for _, inner := range innerMsgs {
+			if err := d.checkDeniedIbcRoutes(ctx, inner, nestedLvl+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *transfertypes.MsgTransfer:
+		if err := d.checkDeniedChannel(ctx, castMsg.SourceChannel); err != nil {
+			return err
+		}
+		clientID := d.ibcBreakerKeeper.SourceClientID(ctx, castMsg.SourcePort, castMsg.SourceChannel)
+		if err := d.checkDeniedClient(ctx, clientID); err != nil {
+			return err
+		}
+		return d.checkDeniedDenom(ctx, castMsg.Token.Denom)
+	case *channeltypesv2.MsgSendPacket:
+		if err := d.checkDeniedClient(ctx, castMsg.SourceClient); err != nil {
+			return err
+		}
+		for _, payload := range castMsg.Payloads {
+			if payload.SourcePort != transfertypes.PortID {
+				continue
+			}
+			// undecodable payloads are rejected by the transfer application
+			data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+			if err != nil {
+				continue
+			}
+			if err := d.checkDeniedDenom(ctx, data.Token.Denom.IBCDenom()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *connectiontypes.MsgConnectionOpenInit:
+		return d.checkDeniedClient(ctx, castMsg.ClientId)
+	case *clienttypesv2.MsgRegisterCounterparty:
+		return d.checkDeniedClient(ctx, castMsg.ClientId)
+	case *clienttypesv2.MsgUpdateClientConfig:
+		return d.checkDeniedClient(ctx, castMsg.ClientId)
+	default:
+		return nil
+	}
+}
+
+func (d IbcAvailableDecorator) checkDeniedChannel(ctx sdk.Context, channelID string) error {
+	if d.ibcBreakerKeeper.IsChannelDenied(ctx, channelID) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "ibc unavailable: channel %s is denied", channelID)
+	}
+	return nil
+}
+
+func (d IbcAvailableDecorator) checkDeniedClient(ctx sdk.Context, clientID string) error {
+	if d.ibcBreakerKeeper.IsClientDenied(ctx, clientID) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "ibc unavailable: client %s is denied", clientID)
+	}
+	return nil
+}
+
+func (d IbcAvailableDecorator) checkDeniedDenom(ctx sdk.Context, denom string) error {
+	if d.ibcBreakerKeeper.IsDenomDenied(ctx, denom) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "ibc unavailable: denom %s is denied", denom)
+	}
+	return nil
+}
+
 const maxNestedIbcMsgs = 7
 
 var restrictedIbcMsgTypeURLs = map[string]struct{}{
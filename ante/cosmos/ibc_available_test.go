@@ -2,6 +2,7 @@ package cosmos_test
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -19,17 +20,43 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 )
 
 type mockIbcBreakerKeeper struct {
-	available bool
+	available      bool
+	deniedChannels []string
+	deniedClients  []string
+	deniedDenoms   []string
 }
 
 func (m mockIbcBreakerKeeper) GetIbcAvailable(_ sdk.Context) bool {
 	return m.available
 }
 
+func (m mockIbcBreakerKeeper) IsChannelDenied(_ sdk.Context, channelID string) bool {
+	return slices.Contains(m.deniedChannels, channelID)
+}
+
+func (m mockIbcBreakerKeeper) IsClientDenied(_ sdk.Context, clientID string) bool {
+	return slices.Contains(m.deniedClients, clientID)
+}
+
+func (m mockIbcBreakerKeeper) IsDenomDenied(_ sdk.Context, denom string) bool {
+	return slices.Contains(m.deniedDenoms, denom)
+}
+
+// SourceClientID resolves channel-0 to the client of its connection, like the
+// keeper does for IBC v1 channels.
+func (m mockIbcBreakerKeeper) SourceClientID(_ sdk.Context, _, channelID string) string {
+	if channelID == "channel-0" {
+		return "07-tendermint-0"
+	}
+	return channelID
+}
+
 func TestIbcAvailableDecorator(t *testing.T) {
 	evmConfigurator := evmtypes.NewEVMConfigurator().
 		WithEVMCoinInfo(constants.ExampleChainCoinInfo[constants.ExampleChainID])
@@ -47,13 +74,21 @@ func TestIbcAvailableDecorator(t *testing.T) {
 	restrictedIBCMsg := &channeltypes.MsgChannelOpenInit{}
 	restrictedIBCTransferMsg := &transfertypes.MsgTransfer{}
 	nonRestrictedIBCMsg := &channeltypes.MsgRecvPacket{}
+	transferMsg := &transfertypes.MsgTransfer{
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: "channel-0",
+		Token:         sdk.NewInt64Coin(evmDenom, 100),
+	}
 
 	testCases := []struct {
-		name        string
-		msgs        []sdk.Msg
-		available   bool
-		expectedErr error
-		errContains string
+		name           string
+		msgs           []sdk.Msg
+		available      bool
+		deniedChannels []string
+		deniedClients  []string
+		deniedDenoms   []string
+		expectedErr    error
+		errContains    string
 	}{
 		{
 			name:        "ibc available - allow restricted ibc msg",
@@ -144,6 +179,73 @@ func TestIbcAvailableDecorator(t *testing.T) {
 			available:   false,
 			expectedErr: nil,
 		},
+		{
+			name:           "ibc available - reject transfer over denied channel",
+			msgs:           []sdk.Msg{transferMsg},
+			available:      true,
+			deniedChannels: []string{"channel-0"},
+			expectedErr:    sdkerrors.ErrUnauthorized,
+			errContains:    "channel channel-0 is denied",
+		},
+		{
+			name:           "ibc available - allow transfer over other channel",
+			msgs:           []sdk.Msg{transferMsg},
+			available:      true,
+			deniedChannels: []string{"channel-1"},
+			deniedClients:  []string{"07-tendermint-1"},
+			deniedDenoms:   []string{"uatom"},
+			expectedErr:    nil,
+		},
+		{
+			name:          "ibc available - reject v1 transfer on a channel of a denied client",
+			msgs:          []sdk.Msg{transferMsg},
+			available:     true,
+			deniedClients: []string{"07-tendermint-0"},
+			expectedErr:   sdkerrors.ErrUnauthorized,
+			errContains:   "client 07-tendermint-0 is denied",
+		},
+		{
+			name:          "ibc available - reject v2 transfer over denied client",
+			msgs:          []sdk.Msg{&transfertypes.MsgTransfer{SourceChannel: "07-tendermint-0", Token: sdk.NewInt64Coin(evmDenom, 100)}},
+			available:     true,
+			deniedClients: []string{"07-tendermint-0"},
+			expectedErr:   sdkerrors.ErrUnauthorized,
+			errContains:   "client 07-tendermint-0 is denied",
+		},
+		{
+			name:         "ibc available - reject transfer of denied denom",
+			msgs:         []sdk.Msg{transferMsg},
+			available:    true,
+			deniedDenoms: []string{evmDenom},
+			expectedErr:  sdkerrors.ErrUnauthorized,
+			errContains:  fmt.Sprintf("denom %s is denied", evmDenom),
+		},
+		{
+			name:          "ibc available - reject send packet over denied client",
+			msgs:          []sdk.Msg{&channeltypesv2.MsgSendPacket{SourceClient: "07-tendermint-0"}},
+			available:     true,
+			deniedClients: []string{"07-tendermint-0"},
+			expectedErr:   sdkerrors.ErrUnauthorized,
+			errContains:   "client 07-tendermint-0 is denied",
+		},
+		{
+			name:          "ibc available - reject connection open on denied client",
+			msgs:          []sdk.Msg{&connectiontypes.MsgConnectionOpenInit{ClientId: "07-tendermint-0"}},
+			available:     true,
+			deniedClients: []string{"07-tendermint-0"},
+			expectedErr:   sdkerrors.ErrUnauthorized,
+			errContains:   "client 07-tendermint-0 is denied",
+		},
+		{
+			name: "ibc available - reject authz exec with transfer over denied channel",
+			msgs: []sdk.Msg{
+				testutil.NewMsgExec(testAddresses[1], []sdk.Msg{transferMsg}),
+			},
+			available:      true,
+			deniedChannels: []string{"channel-0"},
+			expectedErr:    sdkerrors.ErrUnauthorized,
+			errContains:    "channel channel-0 is denied",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -152,7 +254,12 @@ func TestIbcAvailableDecorator(t *testing.T) {
 			tx, err := testutil.CreateTx(ctx, txCfg, testPrivKeys[0], tc.msgs...)
 			require.NoError(t, err)
 
-			decorator := cosmos.NewIbcAvailableDecorator(mockIbcBreakerKeeper{available: tc.available})
+			decorator := cosmos.NewIbcAvailableDecorator(mockIbcBreakerKeeper{
+				available:      tc.available,
+				deniedChannels: tc.deniedChannels,
+				deniedClients:  tc.deniedClients,
+				deniedDenoms:   tc.deniedDenoms,
+			})
 			_, err = decorator.AnteHandle(ctx, tx, false, testutil.NoOpNextFn)
 			if tc.expectedErr != nil {
 				require.Error(t, err)
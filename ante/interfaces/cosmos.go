@@ -33,8 +33,13 @@ type BankKeeper interface {
 
 type CircuitKeeper interface {
 	GetSystemAvailable(ctx sdk.Context) bool
+	IsMsgTypeDisabled(ctx sdk.Context, typeURL string) bool
 }
 
 type IbcBreakerKeeper interface {
 	GetIbcAvailable(ctx sdk.Context) bool
+	IsChannelDenied(ctx sdk.Context, channelID string) bool
+	IsClientDenied(ctx sdk.Context, clientID string) bool
+	IsDenomDenied(ctx sdk.Context, denom string) bool
+	SourceClientID(ctx sdk.Context, portID, channelID string) string
 }
@@ -39,6 +39,9 @@ import (
 	"github.com/cosmos/evm/x/feemarket"
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/frostgroup"
+	frostgroupkeeper "github.com/cosmos/evm/x/frostgroup/keeper"
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
 	ibccallbackskeeper "github.com/cosmos/evm/x/ibc/callbacks/keeper"
 
 	circuit "github.com/cosmos/evm/x/circuit"
@@ -50,10 +53,13 @@ import (
 	ibcbreaker "github.com/cosmos/evm/x/ibcbreaker"
 	ibcbreakerkeeper "github.com/cosmos/evm/x/ibcbreaker/keeper"
 	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
+	ibcbreakerv2 "github.com/cosmos/evm/x/ibcbreaker/v2"
 	ibcratelimiterext "github.com/cosmos/evm/x/ibcratelimiterext"
 	ibcratelimiterextkeeper "github.com/cosmos/evm/x/ibcratelimiterext/keeper"
 	ibcratelimiterexttypes "github.com/cosmos/evm/x/ibcratelimiterext/types"
 	"github.com/cosmos/evm/x/msdcheck"
+	msdcheckkeeper "github.com/cosmos/evm/x/msdcheck/keeper"
+	msdchecktypes "github.com/cosmos/evm/x/msdcheck/types"
 	"github.com/cosmos/evm/x/precisebank"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
@@ -63,6 +69,9 @@ import (
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/evm/x/zkverifier"
+	zkverifierkeeper "github.com/cosmos/evm/x/zkverifier/keeper"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
@@ -217,6 +226,9 @@ type EVMD struct {
 	CircuitKeeper           circuitkeeper.Keeper
 	IbcBreakerKeeper        ibcbreakerkeeper.Keeper
 	IbcRateLimiterExtKeeper ibcratelimiterextkeeper.Keeper
+	MsdCheckKeeper          msdcheckkeeper.Keeper
+	ZKVerifierKeeper        zkverifierkeeper.Keeper
+	FrostGroupKeeper        frostgroupkeeper.Keeper
 	EVMMempool              *evmmempool.ExperimentalEVMMempool
 
 	// the module manager
@@ -270,6 +282,7 @@ func NewExampleApp(
 		// Cosmos EVM store keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
 		valrewardstypes.StoreKey, circuittype.StoreKey, ibcbreakertypes.StoreKey, ibcratelimiterexttypes.StoreKey,
+		msdchecktypes.StoreKey, feeburntypes.StoreKey, zkverifiertypes.StoreKey, frostgrouptypes.StoreKey,
 	}
 	kvStoreKeys = append(kvStoreKeys, optionalRateLimitStoreKeys()...)
 	keys := storetypes.NewKVStoreKeys(kvStoreKeys...)
@@ -396,12 +409,6 @@ func NewExampleApp(
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
-	)
-
 	app.AuthzKeeper = authzkeeper.NewKeeper(
 		runtime.NewKVStoreService(keys[authzkeeper.StoreKey]),
 		appCodec,
@@ -479,7 +486,15 @@ func NewExampleApp(
 		app.AccountKeeper,
 	)
 	precisebankkeeper.RegisterInvariants(app.CrisisKeeper, &app.PreciseBankKeeper)
-	feeBurnKeeper := feeburnkeeper.NewKeeper(app.AccountKeeper, app.BankKeeper, app.PreciseBankKeeper)
+	feeBurnKeeper := feeburnkeeper.NewKeeper(
+		appCodec,
+		keys[feeburntypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.PreciseBankKeeper,
+		app.DistrKeeper,
+	)
 
 	app.ValRewardsKeeper = valrewardskeeper.NewKeeper(
 		appCodec,
@@ -489,6 +504,14 @@ func NewExampleApp(
 		app.AccountKeeper,
 		app.BankKeeper,
 	)
+	valrewardskeeper.RegisterInvariants(app.CrisisKeeper, app.ValRewardsKeeper)
+
+	// register the staking hooks
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.ValRewardsKeeper.Hooks()),
+	)
+
 	app.CircuitKeeper = circuitkeeper.NewKeeper(
 		appCodec,
 		keys[circuittype.StoreKey],
@@ -498,11 +521,30 @@ func NewExampleApp(
 		appCodec,
 		keys[ibcbreakertypes.StoreKey],
 		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
 	)
 	app.IbcRateLimiterExtKeeper = ibcratelimiterextkeeper.NewKeeper(
 		appCodec,
 		keys[ibcratelimiterexttypes.StoreKey],
 		authtypes.NewModuleAddress(govtypes.ModuleName),
+		&app.Erc20Keeper,
+	)
+	app.MsdCheckKeeper = msdcheckkeeper.NewKeeper(
+		appCodec,
+		keys[msdchecktypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.StakingKeeper,
+	)
+	app.ZKVerifierKeeper = zkverifierkeeper.NewKeeper(
+		appCodec,
+		keys[zkverifiertypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.DistrKeeper,
+	)
+	app.FrostGroupKeeper = frostgroupkeeper.NewKeeper(
+		appCodec,
+		keys[frostgrouptypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	// Set up EVM keeper
@@ -526,16 +568,23 @@ func NewExampleApp(
 			app.ValRewardsKeeper,
 			app.AccountKeeper,
 			*app.StakingKeeper,
+			app.MsdCheckKeeper,
 			app.DistrKeeper,
 			app.PreciseBankKeeper,
 			&app.Erc20Keeper,
 			&app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
 			app.IBCKeeper.ClientKeeper,
+			app.CircuitKeeper,
+			app.IbcBreakerKeeper,
+			app.ZKVerifierKeeper,
+			app.FrostGroupKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
 			appCodec,
 		),
+	).WithCircuitKeeper(
+		app.CircuitKeeper,
 	)
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
@@ -548,6 +597,7 @@ func NewExampleApp(
 		app.StakingKeeper,
 		&app.TransferKeeper,
 	)
+	app.Erc20Keeper.WithCircuitKeeper(app.CircuitKeeper)
 
 	// instantiate IBC transfer keeper AFTER the ERC-20 keeper to use it in the instantiation
 	app.TransferKeeper = transferkeeper.NewKeeper(
@@ -571,13 +621,14 @@ func NewExampleApp(
 			- IBC Callbacks Middleware (with EVM ContractKeeper)
 			- ERC-20 Middleware
 			- IBC Transfer
+			- IBC Breaker Middleware
 			- IBC Rate-Limiting Middleware
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket -> erc20.SendPacket -> callbacks.SendPacket -> ratelimiting.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> erc20.SendPacket -> callbacks.SendPacket -> ibcbreaker.SendPacket -> ratelimiting.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> ratelimiting.OnRecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> ratelimiting.OnRecvPacket -> ibcbreaker.OnRecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -590,12 +641,15 @@ func NewExampleApp(
 		app.AccountKeeper,
 		app.EVMKeeper,
 		app.Erc20Keeper,
+		app.IbcBreakerKeeper,
 	)
 	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
+	transferStack = ibcbreaker.NewIBCMiddleware(app.IbcBreakerKeeper, transferStack)
 
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = transferv2.NewIBCModule(app.TransferKeeper)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
+	transferStackV2 = ibcbreakerv2.NewIBCMiddleware(transferStackV2, app.IbcBreakerKeeper)
 	transferStack, transferStackV2, rateLimitAppModule := setupOptionalRateLimit(
 		app,
 		appCodec,
@@ -639,7 +693,7 @@ func NewExampleApp(
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, nil, app.interfaceRegistry),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, nil),
 		feeburn.NewAppModule(feeBurnKeeper),
-		msdcheck.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, nil),
+		msdcheck.NewStakingAppModule(appCodec, app.StakingKeeper, app.MsdCheckKeeper, app.AccountKeeper, app.BankKeeper, nil),
 		upgrade.NewAppModule(app.UpgradeKeeper, app.AccountKeeper.AddressCodec()),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
@@ -664,6 +718,9 @@ func NewExampleApp(
 		circuit.NewAppModule(app.CircuitKeeper),
 		ibcbreaker.NewAppModule(app.IbcBreakerKeeper),
 		ibcratelimiterext.NewAppModule(app.IbcRateLimiterExtKeeper),
+		msdcheck.NewAppModule(app.MsdCheckKeeper),
+		zkverifier.NewAppModule(app.ZKVerifierKeeper),
+		frostgroup.NewAppModule(app.FrostGroupKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, nil),
 	)
 	app.ModuleManager = module.NewManager(appModules...)
@@ -723,14 +780,18 @@ func NewExampleApp(
 		precisebanktypes.ModuleName,
 		vestingtypes.ModuleName,
 		valrewardstypes.ModuleName,
+		circuittype.ModuleName,
 		ibcbreakertypes.ModuleName,
 	)
 	app.ModuleManager.SetOrderBeginBlockers(beginBlockerOrder...)
 
 	// NOTE: the feemarket module should go last in order of end blockers that are actually doing something,
 	// to get the full block gas used.
+	//
+	// NOTE: msdcheck must come before staking so that validators it jails are
+	// removed from the validator set in the same block.
 	app.ModuleManager.SetOrderEndBlockers(
-		govtypes.ModuleName, stakingtypes.ModuleName,
+		govtypes.ModuleName, msdchecktypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName,
 
 		// Cosmos EVM EndBlockers
@@ -771,6 +832,9 @@ func NewExampleApp(
 		circuittype.ModuleName,
 		ibcbreakertypes.ModuleName,
 		ibcratelimiterexttypes.ModuleName,
+		msdchecktypes.ModuleName,
+		zkverifiertypes.ModuleName,
+		frostgrouptypes.ModuleName,
 
 		ibctransfertypes.ModuleName,
 	}
@@ -1218,6 +1282,26 @@ func (app *EVMD) GetCallbackKeeper() ibccallbackskeeper.ContractKeeper {
 	return app.CallbackKeeper
 }
 
+func (app *EVMD) GetCircuitKeeper() circuitkeeper.Keeper {
+	return app.CircuitKeeper
+}
+
+func (app *EVMD) GetIbcBreakerKeeper() ibcbreakerkeeper.Keeper {
+	return app.IbcBreakerKeeper
+}
+
+func (app *EVMD) GetMsdCheckKeeper() msdcheckkeeper.Keeper {
+	return app.MsdCheckKeeper
+}
+
+func (app *EVMD) GetZKVerifierKeeper() zkverifierkeeper.Keeper {
+	return app.ZKVerifierKeeper
+}
+
+func (app *EVMD) GetFrostGroupKeeper() frostgroupkeeper.Keeper {
+	return app.FrostGroupKeeper
+}
+
 func (app *EVMD) GetTransferKeeper() transferkeeper.Keeper {
 	return app.TransferKeeper
 }
@@ -502,6 +502,36 @@ func CrossGenesisValidateAtInitialHeight(cdc codec.Codec, genState GenesisState,
 			return fmt.Errorf("valrewards validator_outstanding_rewards entry references missing staking validator %s", entry.ValidatorAddress)
 		}
 	}
+	for _, entry := range append(valRewardsGen.RewardsCommissions, valRewardsGen.NextRewardsCommissions...) {
+		if _, ok := validatorSet[entry.ValidatorAddress]; !ok {
+			return fmt.Errorf("valrewards rewards commission entry references missing staking validator %s", entry.ValidatorAddress)
+		}
+	}
+	for _, entry := range valRewardsGen.DelegatorOutstandingRewards {
+		if _, ok := validatorSet[entry.ValidatorAddress]; !ok {
+			return fmt.Errorf("valrewards delegator_outstanding_rewards entry references missing staking validator %s", entry.ValidatorAddress)
+		}
+	}
+	for _, entry := range valRewardsGen.DelegationSettledEpochs {
+		if _, ok := validatorSet[entry.ValidatorAddress]; !ok {
+			return fmt.Errorf("valrewards delegation_settled_epochs entry references missing staking validator %s", entry.ValidatorAddress)
+		}
+	}
+	for _, entry := range valRewardsGen.SlashedValidators {
+		if _, ok := validatorSet[entry.ValidatorAddress]; !ok {
+			return fmt.Errorf("valrewards slashed_validators entry references missing staking validator %s", entry.ValidatorAddress)
+		}
+	}
+	for _, entry := range valRewardsGen.WithdrawAddresses {
+		if _, ok := validatorSet[entry.ValidatorAddress]; !ok {
+			return fmt.Errorf("valrewards withdraw_addresses entry references missing staking validator %s", entry.ValidatorAddress)
+		}
+	}
+	for _, validatorAddress := range valRewardsGen.AutoCompoundValidators {
+		if _, ok := validatorSet[validatorAddress]; !ok {
+			return fmt.Errorf("valrewards auto_compound_validators entry references missing staking validator %s", validatorAddress)
+		}
+	}
 
 	// Reject already-due upgrade plans so imported genesis cannot coordinate an
 	// upgrade halt before the new chain has advanced past its effective start height.
@@ -23,11 +23,14 @@ import (
 	circuittype "github.com/cosmos/evm/x/circuit/types"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
 	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
 	ibcratelimiterexttypes "github.com/cosmos/evm/x/ibcratelimiterext/types"
+	msdchecktypes "github.com/cosmos/evm/x/msdcheck/types"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	valrewardstypes "github.com/cosmos/evm/x/valrewards/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
@@ -52,6 +55,9 @@ func TestInitGenesisOrder(t *testing.T) {
 		circuittype.ModuleName,
 		ibcbreakertypes.ModuleName,
 		ibcratelimiterexttypes.ModuleName,
+		msdchecktypes.ModuleName,
+		zkverifiertypes.ModuleName,
+		frostgrouptypes.ModuleName,
 		ibctransfertypes.ModuleName,
 	}
 	expected = append(expected, optionalRateLimitGenesisModules()...)
@@ -40,6 +40,8 @@ func setupOptionalRateLimit(
 		app.IBCKeeper.ChannelKeeper,
 	)
 
+	app.IbcRateLimiterExtKeeper.WithRateLimitKeeper(rateLimitKeeper)
+
 	rateLimitMiddleware := ratelimiting.NewIBCMiddleware(*rateLimitKeeper, transferStack)
 	transferStack = rateLimitMiddleware
 	transferStackV2 = ratelimitingv2.NewIBCMiddleware(*rateLimitKeeper, transferStackV2)
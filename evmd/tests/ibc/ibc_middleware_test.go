@@ -77,6 +77,7 @@ func (suite *MiddlewareTestSuite) SetupTest() {
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
 		evmAppA.Erc20Keeper,
+		evmAppA.IbcBreakerKeeper,
 	)
 }
 
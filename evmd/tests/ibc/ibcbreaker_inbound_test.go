@@ -0,0 +1,150 @@
+package ibc
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IbcBreakerInboundTestSuite tests the ibcbreaker middlewares that reject
+// incoming transfer packets while inbound transfers are paused.
+type IbcBreakerInboundTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	evmChainA *evmibctesting.TestChain
+	chainB    *evmibctesting.TestChain
+}
+
+func (suite *IbcBreakerInboundTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 1, integration.SetupEvmd)
+	suite.evmChainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+}
+
+func TestIbcBreakerInboundTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(IbcBreakerInboundTestSuite))
+}
+
+func (suite *IbcBreakerInboundTestSuite) TestOnRecvPacketRejectedWhenInboundPaused() {
+	// path.EndpointA = endpoint on chainB
+	// path.EndpointB = endpoint on evmChainA
+	path := evmibctesting.NewTransferPath(suite.chainB, suite.evmChainA)
+	path.Setup()
+
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	evmApp.IbcBreakerKeeper.SetInboundPaused(suite.evmChainA.GetContext(), true)
+
+	simAppB := suite.chainB.GetSimApp()
+	sender := suite.chainB.SenderAccount.GetAddress()
+	receiver := suite.evmChainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	voucherDenom := transfertypes.NewDenom(
+		coin.Denom,
+		transfertypes.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID),
+	).IBCDenom()
+	originalBalance := simAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, coin.Denom)
+
+	sendTransfer := func() []byte {
+		msg := transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			coin,
+			sender.String(),
+			receiver.String(),
+			clienttypes.NewHeight(1, 110),
+			0,
+			"",
+		)
+		res, err := suite.chainB.SendMsgs(msg)
+		suite.Require().NoError(err) // message committed
+
+		packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+		suite.Require().NoError(err)
+
+		_, ack, err := path.RelayPacketWithResults(packet)
+		suite.Require().NoError(err) // relay committed
+		return ack
+	}
+
+	ack := sendTransfer()
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(ibcbreakertypes.ErrInboundPaused).Acknowledgement(), ack)
+
+	// no voucher is minted and the sender is refunded on the source chain
+	voucherBalance := evmApp.BankKeeper.GetBalance(suite.evmChainA.GetContext(), receiver, voucherDenom)
+	suite.Require().True(voucherBalance.IsZero())
+	senderBalance := simAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, coin.Denom)
+	suite.Require().Equal(originalBalance, senderBalance)
+
+	evmApp.IbcBreakerKeeper.SetInboundPaused(suite.evmChainA.GetContext(), false)
+
+	ack = sendTransfer()
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+	voucherBalance = evmApp.BankKeeper.GetBalance(suite.evmChainA.GetContext(), receiver, voucherDenom)
+	suite.Require().Equal(coin.Amount, voucherBalance.Amount)
+}
+
+func (suite *IbcBreakerInboundTestSuite) TestOnRecvPacketV2RejectedWhenInboundPaused() {
+	// path.EndpointA = endpoint on chainB
+	// path.EndpointB = endpoint on evmChainA
+	path := evmibctesting.NewPath(suite.chainB, suite.evmChainA)
+	path.SetupV2()
+
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	evmApp.IbcBreakerKeeper.SetInboundPaused(suite.evmChainA.GetContext(), true)
+
+	receiver := suite.evmChainA.SenderAccount.GetAddress()
+	transferData := transfertypes.NewFungibleTokenPacketData(
+		sdk.DefaultBondDenom,
+		"100",
+		suite.chainB.SenderAccount.GetAddress().String(),
+		receiver.String(),
+		"",
+	)
+	payload := channeltypesv2.NewPayload(
+		transfertypes.PortID, transfertypes.PortID, transfertypes.V1,
+		transfertypes.EncodingProtobuf, suite.chainB.Codec.MustMarshal(&transferData),
+	)
+	voucherDenom := transfertypes.NewDenom(
+		sdk.DefaultBondDenom,
+		transfertypes.NewHop(transfertypes.PortID, path.EndpointA.ClientID),
+	).IBCDenom()
+
+	cbs := evmApp.GetIBCKeeper().ChannelKeeperV2.Router.Route(evmibctesting.TransferPort)
+
+	recvResult := cbs.OnRecvPacket(
+		suite.evmChainA.GetContext(), path.EndpointB.ClientID, path.EndpointA.ClientID,
+		1, payload, receiver,
+	)
+	suite.Require().Equal(channeltypesv2.PacketStatus_Failure, recvResult.Status)
+
+	voucherBalance := evmApp.BankKeeper.GetBalance(suite.evmChainA.GetContext(), receiver, voucherDenom)
+	suite.Require().True(voucherBalance.IsZero())
+
+	evmApp.IbcBreakerKeeper.SetInboundPaused(suite.evmChainA.GetContext(), false)
+
+	recvResult = cbs.OnRecvPacket(
+		suite.evmChainA.GetContext(), path.EndpointB.ClientID, path.EndpointA.ClientID,
+		2, payload, receiver,
+	)
+	suite.Require().Equal(channeltypesv2.PacketStatus_Success, recvResult.Status)
+
+	voucherBalance = evmApp.BankKeeper.GetBalance(suite.evmChainA.GetContext(), receiver, voucherDenom)
+	suite.Require().Equal(sdkmath.NewInt(100), voucherBalance.Amount)
+}
@@ -21,6 +21,8 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type ICS02ClientTestSuite struct {
@@ -49,11 +51,13 @@ func (s *ICS02ClientTestSuite) SetupTest() {
 	s.chainAPrecompile = ics02.NewPrecompile(
 		evmAppA.AppCodec(),
 		evmAppA.IBCKeeper.ClientKeeper,
+		evmAppA.IbcBreakerKeeper,
 	)
 	evmAppB := s.chainB.App.(*evmd.EVMD)
 	s.chainBPrecompile = ics02.NewPrecompile(
 		evmAppA.AppCodec(),
 		evmAppB.IBCKeeper.ClientKeeper,
+		evmAppB.IbcBreakerKeeper,
 	)
 }
 
@@ -406,6 +410,72 @@ func (s *ICS02ClientTestSuite) TestUpdateClientLowGasRollback() {
 	s.Require().Equal(statusBefore, statusAfter)
 }
 
+func (s *ICS02ClientTestSuite) TestUpdateClientBlockedByIbcBreaker() {
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context, evmAppA *evmd.EVMD, clientID string)
+		expReason string
+	}{
+		{
+			"ibc unavailable",
+			func(ctx sdk.Context, evmAppA *evmd.EVMD, _ string) {
+				evmAppA.IbcBreakerKeeper.SetIbcAvailable(ctx, false)
+			},
+			"ibc unavailable",
+		},
+		{
+			"denied client",
+			func(ctx sdk.Context, evmAppA *evmd.EVMD, clientID string) {
+				evmAppA.IbcBreakerKeeper.SetDeniedClients(ctx, []string{clientID})
+			},
+			"client 07-tendermint-0 is denied: ibc unavailable",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientID := ibctesting.FirstClientID
+			s.chainB.Coordinator.CommitBlock(s.chainB, s.chainA)
+
+			evmAppA := s.chainA.App.(*evmd.EVMD)
+			clientKeeper := evmAppA.IBCKeeper.ClientKeeper
+			latestHeightBefore := clientKeeper.GetClientLatestHeight(s.chainA.GetContext(), clientID)
+
+			header, err := s.pathBToA.EndpointA.Chain.IBCClientHeader(s.pathBToA.EndpointA.Chain.LatestCommittedHeader, latestHeightBefore)
+			s.Require().NoError(err)
+
+			anyHeader, err := clienttypes.PackClientMessage(header)
+			s.Require().NoError(err)
+
+			updateBz, err := anyHeader.Marshal()
+			s.Require().NoError(err)
+
+			calldata, err := s.chainAPrecompile.Pack(ics02.UpdateClientMethod, clientID, updateBz)
+			s.Require().NoError(err)
+
+			tc.malleate(s.chainA.GetContext(), evmAppA, clientID)
+
+			senderIdx := 1
+			senderAccount := s.chainA.SenderAccounts[senderIdx]
+
+			_, _, resp, err := s.chainA.SendEvmTx(senderAccount, senderIdx, s.chainAPrecompile.Address(), big.NewInt(0), calldata, 200_000)
+			s.Require().Error(err)
+			s.Require().NotNil(resp)
+
+			// the precompile reverts with the typed IbcUnavailable(string) error
+			abiErr := s.chainAPrecompile.Errors[ics02.IbcUnavailableError]
+			out, err := abiErr.Unpack(resp.Ret)
+			s.Require().NoError(err)
+			s.Require().Equal([]interface{}{tc.expReason}, out)
+
+			latestHeightAfter := clientKeeper.GetClientLatestHeight(s.chainA.GetContext(), clientID)
+			s.Require().Equal(latestHeightBefore, latestHeightAfter)
+		})
+	}
+}
+
 func (s *ICS02ClientTestSuite) TestVerifyMembership() {
 	var (
 		calldata  []byte
@@ -46,6 +46,7 @@ func (suite *ICS20ERC20ConversionTestSuite) SetupTest() {
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
 		evmAppA.Erc20Keeper,
+		evmAppA.IbcBreakerKeeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
 	suite.chainBPrecompile = ics20.NewPrecompile(
@@ -54,6 +55,7 @@ func (suite *ICS20ERC20ConversionTestSuite) SetupTest() {
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
 		evmAppB.Erc20Keeper,
+		evmAppB.IbcBreakerKeeper,
 	)
 }
 
@@ -6,6 +6,7 @@
 package ibc
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -21,7 +22,6 @@ import (
 	evmibctesting "github.com/cosmos/evm/testutil/ibc"
 	evmante "github.com/cosmos/evm/x/vm/ante"
 	"github.com/cosmos/evm/x/vm/statedb"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 
@@ -55,6 +55,7 @@ func (suite *ICS20TransferTestSuite) SetupTest() {
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
 		evmAppA.Erc20Keeper,
+		evmAppA.IbcBreakerKeeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
 	suite.chainBPrecompile = ics20.NewPrecompile(
@@ -63,6 +64,7 @@ func (suite *ICS20TransferTestSuite) SetupTest() {
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
 		evmAppB.Erc20Keeper,
+		evmAppB.IbcBreakerKeeper,
 	)
 }
 
@@ -384,46 +386,84 @@ func (suite *ICS20TransferTestSuite) TestHandleMsgTransfer() {
 }
 
 func (suite *ICS20TransferTestSuite) TestHandleMsgTransferBlockedWhenIbcUnavailable() {
-	pathAToB := evmibctesting.NewTransferPath(suite.chainA, suite.chainB)
-	pathAToB.Setup()
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context, evmAppA *evmd.EVMD, channelID, denom string) (expReason string)
+	}{
+		{
+			"ibc unavailable",
+			func(ctx sdk.Context, evmAppA *evmd.EVMD, _, _ string) string {
+				evmAppA.IbcBreakerKeeper.SetIbcAvailable(ctx, false)
+				return "ibc unavailable"
+			},
+		},
+		{
+			"denied channel",
+			func(ctx sdk.Context, evmAppA *evmd.EVMD, channelID, _ string) string {
+				evmAppA.IbcBreakerKeeper.SetDeniedChannels(ctx, []string{channelID})
+				return fmt.Sprintf("channel %s is denied: ibc unavailable", channelID)
+			},
+		},
+		{
+			"denied denom",
+			func(ctx sdk.Context, evmAppA *evmd.EVMD, _, denom string) string {
+				evmAppA.IbcBreakerKeeper.SetDeniedDenoms(ctx, []string{denom})
+				return fmt.Sprintf("denom %s is denied: ibc unavailable", denom)
+			},
+		},
+	}
 
-	evmAppA := suite.chainA.App.(*evmd.EVMD)
-	evmAppA.IbcBreakerKeeper.SetIbcAvailable(suite.chainA.GetContext(), false)
-
-	sourceDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
-	suite.Require().NoError(err)
-
-	senderIdx := 1
-	senderAccount := suite.chainA.SenderAccounts[senderIdx]
-	timeoutHeight := clienttypes.NewHeight(1, 110)
-	transferAmount := sdkmath.NewInt(5)
-
-	data, err := suite.chainAPrecompile.Pack(
-		"transfer",
-		pathAToB.EndpointA.ChannelConfig.PortID,
-		pathAToB.EndpointA.ChannelID,
-		sourceDenom,
-		transferAmount.BigInt(),
-		common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes()),
-		suite.chainB.SenderAccount.GetAddress().String(),
-		timeoutHeight,
-		uint64(0),
-		"",
-	)
-	suite.Require().NoError(err)
-
-	_, _, ethRes, err := suite.chainA.SendEvmTx(
-		senderAccount,
-		senderIdx,
-		suite.chainAPrecompile.Address(),
-		big.NewInt(0),
-		data,
-		0,
-	)
-	suite.Require().Error(err)
-	suite.Require().Contains(err.Error(), vm.ErrExecutionReverted.Error())
-	suite.Require().NotNil(ethRes)
-	suite.Require().Contains(evmtypes.NewExecErrorWithReason(ethRes.Ret).Error(), "ibc unavailable")
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pathAToB := evmibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			pathAToB.Setup()
+
+			evmAppA := suite.chainA.App.(*evmd.EVMD)
+			sourceDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+
+			expReason := tc.malleate(suite.chainA.GetContext(), evmAppA, pathAToB.EndpointA.ChannelID, sourceDenom)
+
+			senderIdx := 1
+			senderAccount := suite.chainA.SenderAccounts[senderIdx]
+			timeoutHeight := clienttypes.NewHeight(1, 110)
+			transferAmount := sdkmath.NewInt(5)
+
+			data, err := suite.chainAPrecompile.Pack(
+				"transfer",
+				pathAToB.EndpointA.ChannelConfig.PortID,
+				pathAToB.EndpointA.ChannelID,
+				sourceDenom,
+				transferAmount.BigInt(),
+				common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes()),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				timeoutHeight,
+				uint64(0),
+				"",
+			)
+			suite.Require().NoError(err)
+
+			_, _, ethRes, err := suite.chainA.SendEvmTx(
+				senderAccount,
+				senderIdx,
+				suite.chainAPrecompile.Address(),
+				big.NewInt(0),
+				data,
+				0,
+			)
+			suite.Require().Error(err)
+			suite.Require().Contains(err.Error(), vm.ErrExecutionReverted.Error())
+			suite.Require().NotNil(ethRes)
+
+			// the precompile reverts with the typed IbcUnavailable(string) error
+			abiErr := suite.chainAPrecompile.Errors[ics20.IbcUnavailableError]
+			out, err := abiErr.Unpack(ethRes.Ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal([]interface{}{expReason}, out)
+		})
+	}
 }
 
 func TestICS20TransferTestSuite(t *testing.T) {
@@ -226,6 +226,7 @@ func (suite *ICS20RecursivePrecompileCallsTestSuite) SetupTest() {
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
 		evmAppA.Erc20Keeper,
+		evmAppA.IbcBreakerKeeper,
 	)
 	bondDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
 	suite.Require().NoError(err)
@@ -243,6 +244,7 @@ func (suite *ICS20RecursivePrecompileCallsTestSuite) SetupTest() {
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
 		evmAppB.Erc20Keeper,
+		evmAppB.IbcBreakerKeeper,
 	)
 }
 
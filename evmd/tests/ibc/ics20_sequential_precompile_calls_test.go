@@ -49,6 +49,7 @@ func (suite *ICS20SequentialPrecompileCallsTestSuite) SetupTest() {
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
 		evmAppA.Erc20Keeper,
+		evmAppA.IbcBreakerKeeper,
 	)
 	bondDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
 	suite.Require().NoError(err)
@@ -65,6 +66,7 @@ func (suite *ICS20SequentialPrecompileCallsTestSuite) SetupTest() {
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
 		evmAppB.Erc20Keeper,
+		evmAppB.IbcBreakerKeeper,
 	)
 }
 
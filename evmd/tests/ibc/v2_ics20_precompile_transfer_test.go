@@ -55,6 +55,7 @@ func (suite *ICS20TransferV2TestSuite) SetupTest() {
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
 		evmAppA.Erc20Keeper,
+		evmAppA.IbcBreakerKeeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
 	suite.chainBPrecompile = ics20.NewPrecompile(
@@ -63,6 +64,7 @@ func (suite *ICS20TransferV2TestSuite) SetupTest() {
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
 		evmAppB.Erc20Keeper,
+		evmAppB.IbcBreakerKeeper,
 	)
 }
 
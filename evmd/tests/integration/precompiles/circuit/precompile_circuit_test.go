@@ -0,0 +1,15 @@
+package circuit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/circuit"
+)
+
+func TestCircuitPrecompileTestSuite(t *testing.T) {
+	s := circuit.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
@@ -0,0 +1,15 @@
+package frostgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/frostgroup"
+)
+
+func TestFrostGroupPrecompileTestSuite(t *testing.T) {
+	s := frostgroup.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
@@ -0,0 +1,15 @@
+package zkverifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/zkverifier"
+)
+
+func TestZKVerifierPrecompileTestSuite(t *testing.T) {
+	s := zkverifier.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
@@ -9,3 +9,7 @@ import (
 func TestFeeCollectorBurnBeforeDistribution(t *testing.T) {
 	feeburn.TestBurnFeeCollectorBeforeDistribution(t, CreateEvmd)
 }
+
+func TestFeeCollectorRouteByParams(t *testing.T) {
+	feeburn.TestRouteFeesByParams(t, CreateEvmd)
+}
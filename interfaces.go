@@ -3,12 +3,17 @@ package evm
 import (
 	"encoding/json"
 
+	circuitkeeper "github.com/cosmos/evm/x/circuit/keeper"
 	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
+	frostgroupkeeper "github.com/cosmos/evm/x/frostgroup/keeper"
 	"github.com/cosmos/evm/x/ibc/callbacks/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	ibcbreakerkeeper "github.com/cosmos/evm/x/ibcbreaker/keeper"
+	msdcheckkeeper "github.com/cosmos/evm/x/msdcheck/keeper"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	zkverifierkeeper "github.com/cosmos/evm/x/zkverifier/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 
 	storetypes "cosmossdk.io/store/types"
@@ -54,6 +59,11 @@ type EvmApp interface { //nolint:revive
 	GetFeeGrantKeeper() feegrantkeeper.Keeper
 	GetConsensusParamsKeeper() consensusparamkeeper.Keeper
 	GetCallbackKeeper() keeper.ContractKeeper
+	GetCircuitKeeper() circuitkeeper.Keeper
+	GetIbcBreakerKeeper() ibcbreakerkeeper.Keeper
+	GetMsdCheckKeeper() msdcheckkeeper.Keeper
+	GetZKVerifierKeeper() zkverifierkeeper.Keeper
+	GetFrostGroupKeeper() frostgroupkeeper.Keeper
 	GetTransferKeeper() transferkeeper.Keeper
 	SetTransferKeeper(transferKeeper transferkeeper.Keeper)
 	DefaultGenesis() map[string]json.RawMessage
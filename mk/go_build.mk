@@ -52,6 +52,17 @@ ifeq ($(ROCKSDB_ENABLED),true)
   build_tags += gcc rocksdb
 endif
 
+# SP1 proof verification backend: rust (libsp1verifier over cgo) or go
+SP1_BACKEND ?= rust
+ifeq ($(SP1_BACKEND),go)
+  build_tags += sp1go
+  SP1_BUILD_DEPS :=
+else ifeq ($(SP1_BACKEND),rust)
+  SP1_BUILD_DEPS := rust-sp1
+else
+  $(error unknown SP1_BACKEND '$(SP1_BACKEND)', expected rust or go)
+endif
+
 build_tags += $(BUILD_TAGS)
 build_tags := $(strip $(build_tags))
 
@@ -96,13 +107,15 @@ endif
 .PHONY: all build test fmt vet tidy mod-download print-go-env lint-go lint-halt lint-b5
 all: build
 
-# build: dev build (rust-sp1 is a hard dependency — libsp1verifier is always required)
-build: rust-sp1 $(BUILDDIR)/
+# build: dev build (libsp1verifier is required unless SP1_BACKEND=go)
+build: $(SP1_BUILD_DEPS) $(BUILDDIR)/
 	@echo "🏗️  Building $(CTM_BINARY) -> $(BUILDDIR)/$(CTM_BINARY)"
 	@echo "BUILD_FLAGS: $(BUILD_FLAGS)"
 	@cd $(CTM_DIR) && CGO_ENABLED="1" \
 		$(GO) build $(GOFLAGS) $(BUILD_FLAGS) -o $(BUILDDIR)/$(CTM_BINARY) $(CTM_MAIN_PKG)
-	@if [ "$(UNAME_S)" = "Darwin" ]; then \
+	@if [ "$(SP1_BACKEND)" = "go" ]; then \
+		echo "ℹ️  SP1_BACKEND=go: no Rust library to ship"; \
+	elif [ "$(UNAME_S)" = "Darwin" ]; then \
 		echo "📦  Dev (Darwin): placing $(RUST_SP1_LIB_BASENAME) next to $(BUILDDIR)/$(CTM_BINARY)"; \
 		cp "$(RUST_SP1_LIB_BUILD)" "$(BUILDDIR)/$(RUST_SP1_LIB_BASENAME)"; \
 		SP1_DEP=$$(otool -L "$(BUILDDIR)/$(CTM_BINARY)" | awk '/libsp1verifier/ {print $$1; exit}'); \
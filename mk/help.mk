@@ -2,6 +2,7 @@
 help:
 	@echo "Targets:"
 	@echo "  build           Build ctmd (dev) + Rust lib"
+	@echo "  sp1-vk          Copy the SP1 verifying keys used by SP1_BACKEND=go"
 	@echo "  lint-go         Run golangci-lint plus the halt/B5 policy checks"
 	@echo "  lint-halt       Fail on unreviewed panic() calls in consensus-adjacent paths"
 	@echo "  lint-b5         Fail on unreviewed repair/sanitize/unsafe risk markers"
@@ -20,5 +21,6 @@ help:
 	@echo "  ROCKSDB_PREFIX=..., PKG_CONFIG=..."
 	@echo "  RUSTUP_TOOLCHAIN=..., RUST_SP1_PROFILE=release|dev"
 	@echo "  RUST_SP1_LOCKED=true, RUST_SP1_FROZEN=true"
+	@echo "  SP1_BACKEND=rust|go (go: pure-Go SP1 verifier, no Rust toolchain)"
 	@echo "  GOFLAGS=..., BINDIR=..., BUILDDIR=..., RELEASEDIR=..."
 	@echo "  ASSET_BASENAME=ctmd-<version>-<os>-<arch>"
@@ -99,7 +99,10 @@ RUST_SP1_DEPS += $(shell find $(RUST_SP1_DIR)/src -type f -name '*.rs' 2>/dev/nu
 RUST_SP1_CGO_CFLAGS  ?= -I$(RUST_SP1_INCLUDE_DIR)
 RUST_SP1_CGO_LDFLAGS ?= -L$(RUST_SP1_OUT_DIR)
 
-.PHONY: rust-sp1 rust-sp1-clean
+# Verifying keys embedded by the pure-Go SP1 verifier (SP1_BACKEND=go)
+SP1_VK_DIR ?= $(ROOT)/precompiles/sp1verifier/vk
+
+.PHONY: rust-sp1 rust-sp1-clean sp1-vk
 rust-sp1: $(RUST_SP1_LIB_BUILD)
 
 $(RUST_SP1_LIB_BUILD): $(RUST_SP1_DEPS)
@@ -130,3 +133,20 @@ $(RUST_SP1_LIB_BUILD): $(RUST_SP1_DEPS)
 rust-sp1-clean:
 	@echo "🧹  Cleaning Rust SP1 verifier artifacts under $(RUST_SP1_TARGET_DIR_BASE) ..."
 	@rm -rf "$(RUST_SP1_TARGET_DIR_BASE)"
+
+# sp1-vk: copy the verifying keys of the sp1-verifier crate pinned by
+# Cargo.lock, so both backends verify against the same keys.
+sp1-vk:
+	@command -v "$(RUST_SP1_CARGO_BIN)" >/dev/null 2>&1 || { \
+		echo "ERROR: '$(RUST_SP1_CARGO_BIN)' not found (needed to locate the sp1-verifier crate)."; \
+		exit 1; \
+	}
+	@manifest=$$($(RUST_SP1_CARGO) metadata --manifest-path "$(RUST_SP1_MANIFEST)" --format-version 1 $(RUST_SP1_LOCK_ARGS) \
+		| grep -o '"manifest_path":"[^"]*/sp1-verifier-[^"]*/Cargo.toml"' | head -n 1 | cut -d'"' -f4); \
+	if [ -z "$$manifest" ]; then \
+		echo "ERROR: sp1-verifier crate not found in cargo metadata"; \
+		exit 1; \
+	fi; \
+	vkdir=$$(dirname "$$manifest")/bn254-vk; \
+	echo "🔑  Copying SP1 verifying keys from $$vkdir -> $(SP1_VK_DIR)"; \
+	cp "$$vkdir/groth16_vk.bin" "$$vkdir/plonk_vk.bin" "$(SP1_VK_DIR)/"
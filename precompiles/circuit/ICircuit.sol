@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ICircuit contract's address.
+address constant CIRCUIT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000715;
+
+/// @dev The ICircuit contract's instance.
+ICircuit constant CIRCUIT_CONTRACT = ICircuit(CIRCUIT_PRECOMPILE_ADDRESS);
+
+/// @author c8ntinuum Team
+/// @title Circuit Precompile Interface
+/// @dev The interface through which solidity contracts read and toggle the
+/// circuit breaker (x/circuit) and the IBC breaker (x/ibcbreaker).
+/// @custom:address 0x0000000000000000000000000000000000000715
+interface ICircuit {
+    /// @dev Proposes or approves setting the system availability flag. The
+    /// disabled message types and modules are left unchanged. The caller must
+    /// be whitelisted in x/circuit and the change is applied once enough
+    /// operators approved it.
+    /// @param systemAvailable The desired system availability flag
+    /// @param expiresAtHeight The height at which a trip is automatically restored, zero for the
+    /// maximum trip duration. Making the system unavailable fails if the trip would never expire.
+    /// @param reason The justification recorded in the action log
+    /// @return executed Whether the change was applied
+    /// @return proposalId The id of the pending proposal, zero when executed right away
+    function updateCircuit(
+        bool systemAvailable,
+        uint64 expiresAtHeight,
+        string calldata reason
+    ) external returns (bool executed, uint64 proposalId);
+
+    /// @dev Approves a pending x/circuit proposal.
+    /// @param proposalId The id of the proposal
+    /// @return executed Whether the proposal was applied
+    function voteCircuitProposal(uint64 proposalId) external returns (bool executed);
+
+    /// @dev Proposes or approves setting the IBC availability flag. The deny
+    /// sets and the inbound pause are left unchanged. The caller must be
+    /// whitelisted in x/ibcbreaker.
+    /// @param ibcAvailable The desired IBC availability flag
+    /// @param expiresAtHeight The height at which a trip is automatically restored, zero for the
+    /// maximum trip duration. Making the system unavailable fails if the trip would never expire.
+    /// @param reason The justification recorded in the action log
+    /// @return executed Whether the change was applied
+    /// @return proposalId The id of the pending proposal, zero when executed right away
+    function updateIbcBreaker(
+        bool ibcAvailable,
+        uint64 expiresAtHeight,
+        string calldata reason
+    ) external returns (bool executed, uint64 proposalId);
+
+    /// @dev Approves a pending x/ibcbreaker proposal.
+    /// @param proposalId The id of the proposal
+    /// @return executed Whether the proposal was applied
+    function voteIbcBreakerProposal(uint64 proposalId) external returns (bool executed);
+
+    /// @dev Returns whether the system is available, i.e. the circuit is not tripped.
+    function systemAvailable() external view returns (bool);
+
+    /// @dev Returns whether IBC is available, i.e. the IBC breaker is not tripped.
+    function ibcAvailable() external view returns (bool);
+
+    /// @dev Returns the operators allowed to update the circuit.
+    function circuitWhitelist() external view returns (address[] memory);
+
+    /// @dev Returns the operators allowed to update the IBC breaker.
+    function ibcBreakerWhitelist() external view returns (address[] memory);
+}
@@ -0,0 +1,152 @@
+package circuit
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	circuitkeeper "github.com/cosmos/evm/x/circuit/keeper"
+	circuittypes "github.com/cosmos/evm/x/circuit/types"
+	ibcbreakerkeeper "github.com/cosmos/evm/x/ibcbreaker/keeper"
+	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = (*Precompile)(nil)
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the precompiled contract exposing the x/circuit and
+// x/ibcbreaker state to the EVM.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	circuitKeeper       circuitkeeper.Keeper
+	circuitMsgServer    circuittypes.MsgServer
+	ibcBreakerKeeper    ibcbreakerkeeper.Keeper
+	ibcBreakerMsgServer ibcbreakertypes.MsgServer
+}
+
+// NewPrecompile creates a new circuit Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	circuitKeeper circuitkeeper.Keeper,
+	circuitMsgServer circuittypes.MsgServer,
+	ibcBreakerKeeper ibcbreakerkeeper.Keeper,
+	ibcBreakerMsgServer ibcbreakertypes.MsgServer,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ContractAddress:      common.HexToAddress(evmtypes.CircuitPrecompileAddress),
+		},
+		ABI:                 ABI,
+		circuitKeeper:       circuitKeeper,
+		circuitMsgServer:    circuitMsgServer,
+		ibcBreakerKeeper:    ibcBreakerKeeper,
+		ibcBreakerMsgServer: ibcBreakerMsgServer,
+	}
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// Run returns a selector error; keep zero here as the conservative gas fallback.
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// circuit transactions
+	case UpdateCircuitMethod:
+		return p.UpdateCircuit(ctx, contract, method, args)
+	case VoteCircuitProposalMethod:
+		return p.VoteCircuitProposal(ctx, contract, method, args)
+	case UpdateIbcBreakerMethod:
+		return p.UpdateIbcBreaker(ctx, contract, method, args)
+	case VoteIbcBreakerProposalMethod:
+		return p.VoteIbcBreakerProposal(ctx, contract, method, args)
+	// circuit queries
+	case SystemAvailableMethod:
+		return p.SystemAvailable(ctx, method, args)
+	case IbcAvailableMethod:
+		return p.IbcAvailable(ctx, method, args)
+	case CircuitWhitelistMethod:
+		return p.CircuitWhitelist(ctx, method, args)
+	case IbcBreakerWhitelistMethod:
+		return p.IbcBreakerWhitelist(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available circuit transactions are:
+// - UpdateCircuit
+// - VoteCircuitProposal
+// - UpdateIbcBreaker
+// - VoteIbcBreakerProposal
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case UpdateCircuitMethod,
+		VoteCircuitProposalMethod,
+		UpdateIbcBreakerMethod,
+		VoteIbcBreakerProposalMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "circuit")
+}
@@ -0,0 +1,9 @@
+package circuit
+
+import "errors"
+
+// ErrUnboundedTrip is returned when a contract makes the system unavailable
+// without a trip expiry. The circuit ante handler rejects every EVM transaction
+// while the system is unavailable, so such a trip could not be restored from
+// the EVM.
+var ErrUnboundedTrip = errors.New("expiresAtHeight is required to make the system unavailable from the EVM")
@@ -0,0 +1,88 @@
+package circuit
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// SystemAvailableMethod defines the ABI method name for the circuit
+	// SystemAvailable query.
+	SystemAvailableMethod = "systemAvailable"
+	// IbcAvailableMethod defines the ABI method name for the ibcbreaker
+	// IbcAvailable query.
+	IbcAvailableMethod = "ibcAvailable"
+	// CircuitWhitelistMethod defines the ABI method name for the circuit
+	// operator whitelist query.
+	CircuitWhitelistMethod = "circuitWhitelist"
+	// IbcBreakerWhitelistMethod defines the ABI method name for the ibcbreaker
+	// operator whitelist query.
+	IbcBreakerWhitelistMethod = "ibcBreakerWhitelist"
+)
+
+// SystemAvailable returns whether the x/circuit system flag is set.
+func (p Precompile) SystemAvailable(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	return method.Outputs.Pack(p.circuitKeeper.GetSystemAvailable(ctx))
+}
+
+// IbcAvailable returns whether the x/ibcbreaker IBC flag is set.
+func (p Precompile) IbcAvailable(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	return method.Outputs.Pack(p.ibcBreakerKeeper.GetIbcAvailable(ctx))
+}
+
+// CircuitWhitelist returns the operators allowed to update the circuit.
+func (p Precompile) CircuitWhitelist(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	operators, err := ParseWhitelist(p.circuitKeeper.GetParams(ctx).Whitelist)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(operators)
+}
+
+// IbcBreakerWhitelist returns the operators allowed to update the IBC breaker.
+func (p Precompile) IbcBreakerWhitelist(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	operators, err := ParseWhitelist(p.ibcBreakerKeeper.GetParams(ctx).Whitelist)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(operators)
+}
@@ -0,0 +1,140 @@
+package circuit
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	circuittypes "github.com/cosmos/evm/x/circuit/types"
+	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// UpdateCircuitMethod defines the ABI method name for the circuit
+	// UpdateCircuit transaction.
+	UpdateCircuitMethod = "updateCircuit"
+	// VoteCircuitProposalMethod defines the ABI method name for the circuit
+	// VoteProposal transaction.
+	VoteCircuitProposalMethod = "voteCircuitProposal"
+	// UpdateIbcBreakerMethod defines the ABI method name for the ibcbreaker
+	// UpdateIbcBreaker transaction.
+	UpdateIbcBreakerMethod = "updateIbcBreaker"
+	// VoteIbcBreakerProposalMethod defines the ABI method name for the
+	// ibcbreaker VoteProposal transaction.
+	VoteIbcBreakerProposalMethod = "voteIbcBreakerProposal"
+)
+
+// UpdateCircuit proposes or approves a change of the system availability flag
+// on behalf of the calling operator. It goes through the same msg server as
+// MsgUpdateCircuit, so the caller must be on the circuit whitelist and the
+// change only applies once the required number of operators agreed. Making the
+// system unavailable requires a trip expiry, either expiresAtHeight or the
+// maximum trip duration of the circuit params.
+func (p Precompile) UpdateCircuit(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgUpdateCircuit(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if !msg.SystemAvailable && msg.ExpiresAtHeight == 0 && p.circuitKeeper.GetParams(ctx).MaxTripDurationBlocks == 0 {
+		return nil, ErrUnboundedTrip
+	}
+
+	res, err := p.circuitMsgServer.UpdateCircuit(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Executed, res.ProposalId)
+}
+
+// VoteCircuitProposal approves a pending circuit proposal on behalf of the
+// calling operator.
+func (p Precompile) VoteCircuitProposal(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	proposalID, err := NewProposalID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &circuittypes.MsgVoteProposal{
+		Signer:     sdk.AccAddress(contract.Caller().Bytes()).String(),
+		ProposalId: proposalID,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.circuitMsgServer.VoteProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Executed)
+}
+
+// UpdateIbcBreaker proposes or approves a change of the IBC availability flag
+// on behalf of the calling operator, with the same authorization as
+// MsgUpdateIbcBreaker.
+func (p Precompile) UpdateIbcBreaker(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgUpdateIbcBreaker(args, contract.Caller(), p.ibcBreakerKeeper.GetIbcBreakerState(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.ibcBreakerMsgServer.UpdateIbcBreaker(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Executed, res.ProposalId)
+}
+
+// VoteIbcBreakerProposal approves a pending ibcbreaker proposal on behalf of
+// the calling operator.
+func (p Precompile) VoteIbcBreakerProposal(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	proposalID, err := NewProposalID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &ibcbreakertypes.MsgVoteProposal{
+		Signer:     sdk.AccAddress(contract.Caller().Bytes()).String(),
+		ProposalId: proposalID,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.ibcBreakerMsgServer.VoteProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Executed)
+}
@@ -0,0 +1,106 @@
+package circuit
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	circuittypes "github.com/cosmos/evm/x/circuit/types"
+	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParseWhitelist converts the bech32 operator whitelist into hex addresses.
+func ParseWhitelist(whitelist []string) ([]common.Address, error) {
+	operators := make([]common.Address, 0, len(whitelist))
+	for _, operator := range whitelist {
+		addr, err := sdk.AccAddressFromBech32(operator)
+		if err != nil {
+			return nil, fmt.Errorf("invalid whitelisted operator %s: %w", operator, err)
+		}
+		operators = append(operators, common.BytesToAddress(addr))
+	}
+	return operators, nil
+}
+
+// NewMsgUpdateCircuit creates a new MsgUpdateCircuit instance signed by
+// signer. The disabled message types and modules are left empty so the
+// current sets are kept and only the system flag is toggled.
+func NewMsgUpdateCircuit(args []interface{}, signer common.Address) (*circuittypes.MsgUpdateCircuit, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	systemAvailable, ok := args[0].(bool)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "systemAvailable", true, args[0])
+	}
+
+	expiresAtHeight, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "expiresAtHeight", uint64(0), args[1])
+	}
+
+	reason, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "reason", "", args[2])
+	}
+
+	return &circuittypes.MsgUpdateCircuit{
+		Signer:          sdk.AccAddress(signer.Bytes()).String(),
+		SystemAvailable: systemAvailable,
+		ExpiresAtHeight: expiresAtHeight,
+		Reason:          reason,
+	}, nil
+}
+
+// NewMsgUpdateIbcBreaker creates a new MsgUpdateIbcBreaker instance signed by
+// signer. The deny sets and the inbound pause are carried over from current
+// so that only the IBC flag is toggled.
+func NewMsgUpdateIbcBreaker(args []interface{}, signer common.Address, current ibcbreakertypes.IbcBreakerState) (*ibcbreakertypes.MsgUpdateIbcBreaker, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	ibcAvailable, ok := args[0].(bool)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "ibcAvailable", true, args[0])
+	}
+
+	expiresAtHeight, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "expiresAtHeight", uint64(0), args[1])
+	}
+
+	reason, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "reason", "", args[2])
+	}
+
+	return &ibcbreakertypes.MsgUpdateIbcBreaker{
+		Signer:          sdk.AccAddress(signer.Bytes()).String(),
+		IbcAvailable:    ibcAvailable,
+		ExpiresAtHeight: expiresAtHeight,
+		Reason:          reason,
+		DeniedChannels:  current.DeniedChannels,
+		DeniedClients:   current.DeniedClients,
+		DeniedDenoms:    current.DeniedDenoms,
+		InboundPaused:   current.InboundPaused,
+	}, nil
+}
+
+// NewProposalID parses the proposal id argument of the vote methods.
+func NewProposalID(args []interface{}) (uint64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf(cmn.ErrInvalidType, "proposalId", uint64(0), args[0])
+	}
+
+	return proposalID, nil
+}
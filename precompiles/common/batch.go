@@ -0,0 +1,95 @@
+package common
+
+import (
+	"crypto/sha3"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// MaxBatchSize is the largest number of signatures the verifyBatch methods of
+// the signature precompiles take, so that the result fits a uint256 bitmap.
+const MaxBatchSize = 256
+
+// BatchSize checks that the parallel arrays of a verifyBatch call have the
+// same length, at most MaxBatchSize, and returns it.
+func BatchSize(lengths ...int) (int, error) {
+	if len(lengths) == 0 {
+		return 0, nil
+	}
+	n := lengths[0]
+	for _, l := range lengths[1:] {
+		if l != n {
+			return 0, fmt.Errorf("batch arrays have different lengths: %v", lengths)
+		}
+	}
+	if n > MaxBatchSize {
+		return 0, fmt.Errorf("batch of %d signatures exceeds %d", n, MaxBatchSize)
+	}
+	return n, nil
+}
+
+// BatchRequiredGas prices a batch of n verifications from the gas of a single
+// one. perEntryPercent of it is charged for every entry and the rest once, so
+// a batch of one costs the same as a single verification.
+func BatchRequiredGas(single uint64, n int, perEntryPercent uint64) uint64 {
+	perEntry := single * perEntryPercent / 100
+	return single - perEntry + uint64(n)*perEntry //nolint:gosec // G115 // n is bounded by MaxBatchSize
+}
+
+// BatchWithFallbackRequiredGas prices a batch verification that verifies every
+// entry on its own when the batch equation fails. Gas is charged before the
+// outcome is known, so it covers that worst case: the batch attempt, priced by
+// BatchRequiredGas, plus a single verification per entry.
+func BatchWithFallbackRequiredGas(single uint64, n int, perEntryPercent uint64) uint64 {
+	return BatchRequiredGas(single, n, perEntryPercent) + uint64(n)*single //nolint:gosec // G115 // n is bounded by MaxBatchSize
+}
+
+// PackBatchResult packs the outputs of a verifyBatch method: a bitmap whose
+// bit i is set when entry i verified, and whether at least threshold entries
+// verified.
+func PackBatchResult(method *abi.Method, valid []bool, threshold uint16) ([]byte, error) {
+	bitmap := new(big.Int)
+	count := 0
+	for i, ok := range valid {
+		if ok {
+			bitmap.SetBit(bitmap, i, 1)
+			count++
+		}
+	}
+
+	out, err := method.Outputs.Pack(bitmap, count >= int(threshold))
+	if err != nil {
+		return nil, errors.New("failed to ABI-pack result")
+	}
+	return out, nil
+}
+
+// NewBatchRand returns the stream the coefficients of a batch verification
+// equation are drawn from. It is seeded with the whole batch, as BIP-340
+// suggests, so that validators derive the same coefficients while a signer
+// cannot pick them to cancel out an invalid signature without changing them.
+func NewBatchRand(domain string, fields ...[][]byte) io.Reader {
+	h := sha3.NewSHAKE256()
+	writeLengthPrefixed(h, []byte(domain))
+	for _, field := range fields {
+		var n [8]byte
+		binary.BigEndian.PutUint64(n[:], uint64(len(field)))
+		_, _ = h.Write(n[:])
+		for _, b := range field {
+			writeLengthPrefixed(h, b)
+		}
+	}
+	return h
+}
+
+func writeLengthPrefixed(w io.Writer, b []byte) {
+	var n [8]byte
+	binary.BigEndian.PutUint64(n[:], uint64(len(b)))
+	_, _ = w.Write(n[:])
+	_, _ = w.Write(b)
+}
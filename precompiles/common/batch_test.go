@@ -0,0 +1,87 @@
+package common
+
+import (
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchSize(t *testing.T) {
+	n, err := BatchSize(3, 3, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	n, err = BatchSize(MaxBatchSize, MaxBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, MaxBatchSize, n)
+
+	_, err = BatchSize(3, 2, 3)
+	require.ErrorContains(t, err, "different lengths")
+
+	_, err = BatchSize(MaxBatchSize+1, MaxBatchSize+1)
+	require.ErrorContains(t, err, "exceeds")
+}
+
+func TestBatchRequiredGas(t *testing.T) {
+	require.Equal(t, uint64(10_000), BatchRequiredGas(10_000, 1, 40))
+	require.Equal(t, uint64(6_000), BatchRequiredGas(10_000, 0, 40))
+	require.Equal(t, uint64(6_000+100*4_000), BatchRequiredGas(10_000, 100, 40))
+	require.Equal(t, uint64(100*10_000), BatchRequiredGas(10_000, 100, 100))
+}
+
+func TestBatchWithFallbackRequiredGas(t *testing.T) {
+	require.Equal(t, uint64(2*10_000), BatchWithFallbackRequiredGas(10_000, 1, 40))
+	require.Equal(t, uint64(6_000), BatchWithFallbackRequiredGas(10_000, 0, 40))
+	require.Equal(t, uint64(6_000+100*4_000+100*10_000), BatchWithFallbackRequiredGas(10_000, 100, 40))
+}
+
+func TestPackBatchResult(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(`[{
+		"type": "function",
+		"name": "verifyBatch",
+		"inputs": [],
+		"outputs": [
+			{"name": "validBitmap", "type": "uint256"},
+			{"name": "thresholdMet", "type": "bool"}
+		]
+	}]`))
+	require.NoError(t, err)
+	method := parsed.Methods["verifyBatch"]
+
+	valid := make([]bool, MaxBatchSize)
+	valid[0], valid[2], valid[MaxBatchSize-1] = true, true, true
+
+	for threshold, met := range map[uint16]bool{0: true, 3: true, 4: false} {
+		out, err := PackBatchResult(&method, valid, threshold)
+		require.NoError(t, err)
+
+		values, err := method.Outputs.Unpack(out)
+		require.NoError(t, err)
+
+		want := new(big.Int).SetBit(big.NewInt(0b101), MaxBatchSize-1, 1)
+		require.Equal(t, want, values[0].(*big.Int))
+		require.Equal(t, met, values[1].(bool))
+	}
+}
+
+func TestNewBatchRand(t *testing.T) {
+	read := func(r io.Reader) []byte {
+		out := make([]byte, 64)
+		_, err := io.ReadFull(r, out)
+		require.NoError(t, err)
+		return out
+	}
+
+	batch := [][]byte{[]byte("a"), []byte("bc")}
+	require.Equal(t, read(NewBatchRand("d", batch)), read(NewBatchRand("d", batch)))
+	require.NotEqual(t, read(NewBatchRand("d", batch)), read(NewBatchRand("e", batch)))
+
+	// Fields are length-prefixed, so moving bytes between entries changes
+	// the stream.
+	require.NotEqual(t, read(NewBatchRand("d", batch)), read(NewBatchRand("d", [][]byte{[]byte("ab"), []byte("c")})))
+	require.NotEqual(t, read(NewBatchRand("d", batch)), read(NewBatchRand("d", batch[:1], batch[1:])))
+}
@@ -38,6 +38,12 @@ type TransferKeeper interface {
 	Transfer(ctx context.Context, msg *ibctypes.MsgTransfer) (*ibctypes.MsgTransferResponse, error)
 }
 
+// IbcBreakerKeeper defines the ibcbreaker guards enforced by the IBC precompiles.
+type IbcBreakerKeeper interface {
+	CheckClient(ctx sdk.Context, clientID string) error
+	CheckTransfer(ctx sdk.Context, sourcePort, sourceChannel, denom string) error
+}
+
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
 	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error)
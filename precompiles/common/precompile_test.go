@@ -1,11 +1,13 @@
 package common
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
@@ -109,3 +111,33 @@ func TestRunNativeActionPreservesOutOfGas(t *testing.T) {
 
 	require.ErrorIs(t, err, vm.ErrOutOfGas)
 }
+
+func TestRunNativeActionRevertsWithCustomError(t *testing.T) {
+	stateDB := newNativeActionStateDB(t)
+	precompile := Precompile{
+		KvGasConfig:          storetypes.KVGasConfig(),
+		TransientKVGasConfig: storetypes.TransientGasConfig(),
+		ContractAddress:      ethcommon.BigToAddress(big.NewInt(1)),
+	}
+	contract := vm.NewContract(
+		ethcommon.BigToAddress(big.NewInt(2)),
+		precompile.Address(),
+		uint256.NewInt(0),
+		100_000,
+		nil,
+	)
+
+	stringTy, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	abiErr := abi.NewError("Unavailable", abi.Arguments{{Name: "reason", Type: stringTy}})
+	cause := errors.New("halted")
+
+	bz, err := precompile.RunNativeAction(newNativeActionEVM(stateDB), contract, func(sdk.Context) ([]byte, error) {
+		return nil, NewRevertError(abiErr, cause, "halted")
+	})
+
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	out, err := abiErr.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"halted"}, out)
+}
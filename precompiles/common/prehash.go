@@ -0,0 +1,105 @@
+package common
+
+import (
+	"crypto/sha256"
+	"crypto/sha3"
+	"crypto/sha512"
+)
+
+// Pre-hash selectors of the ML-DSA and SLH-DSA precompiles. PreHashNone
+// selects pure signing, and every other selector is the last arc of the NIST
+// hash algorithm OID 2.16.840.1.101.3.4.2.x of the HashML-DSA or HashSLH-DSA
+// hash function.
+const (
+	PreHashNone uint8 = iota
+	PreHashSHA256
+	PreHashSHA384
+	PreHashSHA512
+	PreHashSHA224
+	PreHashSHA512_224
+	PreHashSHA512_256
+	PreHashSHA3_224
+	PreHashSHA3_256
+	PreHashSHA3_384
+	PreHashSHA3_512
+	PreHashSHAKE128
+	PreHashSHAKE256
+)
+
+// MaxContextBytes is the longest context string of FIPS 204 and FIPS 205.
+const MaxContextBytes = 255
+
+// hashOIDPrefix is the DER encoding of the NIST hash algorithm OIDs without
+// their last arc.
+var hashOIDPrefix = []byte{0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02}
+
+// MessageRepresentative returns the message M' that ML-DSA (FIPS 204) and
+// SLH-DSA (FIPS 205) sign for msg under the context string ctx. M' embeds msg
+// itself for PreHashNone, or its digest under the selected hash function
+// otherwise. It returns false for an unknown selector or a context longer than
+// MaxContextBytes.
+func MessageRepresentative(preHash uint8, ctx, msg []byte) ([]byte, bool) {
+	if len(ctx) > MaxContextBytes {
+		return nil, false
+	}
+	if preHash == PreHashNone {
+		mPrime := make([]byte, 0, 2+len(ctx)+len(msg))
+		mPrime = append(mPrime, 0, byte(len(ctx)))
+		mPrime = append(mPrime, ctx...)
+		return append(mPrime, msg...), true
+	}
+
+	digest, ok := preHashDigest(preHash, msg)
+	if !ok {
+		return nil, false
+	}
+	mPrime := make([]byte, 0, 2+len(ctx)+len(hashOIDPrefix)+1+len(digest))
+	mPrime = append(mPrime, 1, byte(len(ctx)))
+	mPrime = append(mPrime, ctx...)
+	mPrime = append(mPrime, hashOIDPrefix...)
+	mPrime = append(mPrime, preHash)
+	return append(mPrime, digest...), true
+}
+
+// preHashDigest returns PH(msg) for a pre-hash selector. The XOFs output 256
+// and 512 bits, as FIPS 204 and FIPS 205 require.
+func preHashDigest(preHash uint8, msg []byte) ([]byte, bool) {
+	switch preHash {
+	case PreHashSHA256:
+		d := sha256.Sum256(msg)
+		return d[:], true
+	case PreHashSHA384:
+		d := sha512.Sum384(msg)
+		return d[:], true
+	case PreHashSHA512:
+		d := sha512.Sum512(msg)
+		return d[:], true
+	case PreHashSHA224:
+		d := sha256.Sum224(msg)
+		return d[:], true
+	case PreHashSHA512_224:
+		d := sha512.Sum512_224(msg)
+		return d[:], true
+	case PreHashSHA512_256:
+		d := sha512.Sum512_256(msg)
+		return d[:], true
+	case PreHashSHA3_224:
+		d := sha3.Sum224(msg)
+		return d[:], true
+	case PreHashSHA3_256:
+		d := sha3.Sum256(msg)
+		return d[:], true
+	case PreHashSHA3_384:
+		d := sha3.Sum384(msg)
+		return d[:], true
+	case PreHashSHA3_512:
+		d := sha3.Sum512(msg)
+		return d[:], true
+	case PreHashSHAKE128:
+		return sha3.SumSHAKE128(msg, 32), true
+	case PreHashSHAKE256:
+		return sha3.SumSHAKE256(msg, 64), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageRepresentative(t *testing.T) {
+	msg := []byte("message")
+
+	mPrime, ok := MessageRepresentative(PreHashNone, []byte("ctx"), msg)
+	require.True(t, ok)
+	require.Equal(t, append([]byte{0, 3, 'c', 't', 'x'}, msg...), mPrime)
+
+	// id-sha256 is 2.16.840.1.101.3.4.2.1.
+	digest := sha256.Sum256(msg)
+	oid, err := hex.DecodeString("0609608648016503040201")
+	require.NoError(t, err)
+	mPrime, ok = MessageRepresentative(PreHashSHA256, nil, msg)
+	require.True(t, ok)
+	require.Equal(t, append(append([]byte{1, 0}, oid...), digest[:]...), mPrime)
+
+	for preHash, size := range map[uint8]int{PreHashSHA512_224: 28, PreHashSHAKE128: 32, PreHashSHAKE256: 64} {
+		mPrime, ok = MessageRepresentative(preHash, nil, msg)
+		require.True(t, ok)
+		require.Len(t, mPrime, 2+len(oid)+size)
+		require.True(t, bytes.HasPrefix(mPrime[2:], oid[:len(oid)-1]))
+		require.Equal(t, preHash, mPrime[2+len(oid)-1])
+	}
+
+	_, ok = MessageRepresentative(PreHashSHAKE256+1, nil, msg)
+	require.False(t, ok)
+
+	_, ok = MessageRepresentative(PreHashNone, make([]byte, MaxContextBytes+1), msg)
+	require.False(t, ok)
+}
@@ -1,6 +1,9 @@
 package common
 
 import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -15,8 +18,17 @@ import (
 // Therefore, the returned error must be ABI-encoded and returned,
 // and the error type changed to ErrExecutionReverted.
 //
+// Errors of type *RevertError are returned with their custom Solidity error
+// data instead of an Error(string) reason.
+//
 // related issue: https://github.com/cosmos/evm/issues/223
 func ReturnRevertError(evm *vm.EVM, err error) ([]byte, error) {
+	var customErr *RevertError
+	if errors.As(err, &customErr) {
+		evm.Interpreter().SetReturnData(customErr.data)
+		return customErr.data, vm.ErrExecutionReverted
+	}
+
 	revertReasonBz, encErr := evmtypes.RevertReasonBytes(err.Error())
 	if encErr != nil {
 		return nil, vm.ErrExecutionReverted
@@ -25,3 +37,36 @@ func ReturnRevertError(evm *vm.EVM, err error) ([]byte, error) {
 
 	return revertReasonBz, vm.ErrExecutionReverted
 }
+
+// RevertError is a precompile error that reverts with a custom Solidity error
+// declared in the precompile ABI.
+type RevertError struct {
+	err  error
+	data []byte
+}
+
+// NewRevertError wraps err so that the precompile reverts with the custom
+// Solidity error abiErr encoded with args. If args cannot be packed, err is
+// returned unchanged and reverts with an Error(string) reason.
+func NewRevertError(abiErr abi.Error, err error, args ...interface{}) error {
+	packed, packErr := abiErr.Inputs.Pack(args...)
+	if packErr != nil {
+		return err
+	}
+	data := make([]byte, 0, 4+len(packed))
+	data = append(data, abiErr.ID[:4]...)
+	return &RevertError{err: err, data: append(data, packed...)}
+}
+
+func (e *RevertError) Error() string {
+	return e.err.Error()
+}
+
+func (e *RevertError) Unwrap() error {
+	return e.err
+}
+
+// Data returns the ABI-encoded custom error returned as revert data.
+func (e *RevertError) Data() []byte {
+	return e.data
+}
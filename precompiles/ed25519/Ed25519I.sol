@@ -22,4 +22,20 @@ interface Ed25519I {
         bytes memory signature,
         bytes memory message
     ) external pure returns (bool success);
+
+    /// @dev Defines a method for verifying a batch of Ed25519 signatures. Gas
+    /// covers verifying every signature on its own if the batch fails. The
+    /// arrays must have the same length, at most 256.
+    /// @param ed25519Addresses The ed25519 public keys (addresses).
+    /// @param signatures The ed25519 signature bytes.
+    /// @param messages The ed25519 messages signed represented as bytes.
+    /// @param threshold The number of valid signatures required for thresholdMet.
+    /// @return validBitmap bitmap whose bit i is set if signature i is valid.
+    /// @return thresholdMet boolean which is true if at least threshold signatures are valid.
+    function verifyBatch(
+        bytes[] memory ed25519Addresses,
+        bytes[] memory signatures,
+        bytes[] memory messages,
+        uint16 threshold
+    ) external pure returns (uint256 validBitmap, bool thresholdMet);
 }
@@ -3,6 +3,7 @@ package ed25519
 import (
 	stded25519 "crypto/ed25519"
 	"crypto/rand"
+	"crypto/sha512"
 	"math/big"
 	"strings"
 	"testing"
@@ -11,6 +12,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
 	"github.com/stretchr/testify/require"
 )
 
@@ -181,6 +184,258 @@ func TestRunRecoversUnexpectedPanics(t *testing.T) {
 	require.ErrorIs(t, err, vm.ErrExecutionReverted)
 }
 
+func TestVerifyBatch(t *testing.T) {
+	precompile, err := NewPrecompile(12_000)
+	require.NoError(t, err)
+
+	const n = 5
+	pubKeys := make([][]byte, n)
+	signatures := make([][]byte, n)
+	messages := make([][]byte, n)
+	for i := range n {
+		pubKey, privKey, err := stded25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		pubKeys[i] = pubKey
+		messages[i] = []byte{'h', 'e', 'a', 'd', 'e', 'r', byte(i)}
+		signatures[i] = stded25519.Sign(privKey, messages[i])
+	}
+
+	bitmap, met := runVerifyBatchCall(t, precompile, pubKeys, signatures, messages, n)
+	require.Equal(t, int64(0b11111), bitmap.Int64())
+	require.True(t, met)
+
+	// A tampered signature and a malformed key clear their bits only.
+	signatures[1] = append([]byte{}, signatures[1]...)
+	signatures[1][0] ^= 0x01
+	pubKeys[3] = pubKeys[3][:stded25519.PublicKeySize-1]
+
+	bitmap, met = runVerifyBatchCall(t, precompile, pubKeys, signatures, messages, 3)
+	require.Equal(t, int64(0b10101), bitmap.Int64())
+	require.True(t, met)
+
+	bitmap, met = runVerifyBatchCall(t, precompile, pubKeys, signatures, messages, 4)
+	require.Equal(t, int64(0b10101), bitmap.Int64())
+	require.False(t, met)
+
+	bitmap, met = runVerifyBatchCall(t, precompile, nil, nil, nil, 0)
+	require.Zero(t, bitmap.Sign())
+	require.True(t, met)
+}
+
+func TestVerifyBatchAgreesWithSingleOnEdgeCaseEncodings(t *testing.T) {
+	precompile, err := NewPrecompile(12_000)
+	require.NoError(t, err)
+
+	// identity is the canonical encoding of the small-order neutral point,
+	// nonCanonicalIdentity encodes it as y = p + 1.
+	identity := make([]byte, 32)
+	identity[0] = 0x01
+	nonCanonicalIdentity := make([]byte, 32)
+	for i := range nonCanonicalIdentity {
+		nonCanonicalIdentity[i] = 0xff
+	}
+	nonCanonicalIdentity[0] = 0xee
+	nonCanonicalIdentity[31] = 0x7f
+	// With A and R the neutral point and S = 0, the cofactorless equation
+	// holds for any message.
+	zeroS := make([]byte, 32)
+
+	pubKey, privKey, err := stded25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	message := []byte("continuum")
+
+	testCases := []struct {
+		name      string
+		pubKey    []byte
+		signature []byte
+		expSingle bool
+		expBatch  bool
+	}{
+		{
+			name:      "valid signature",
+			pubKey:    pubKey,
+			signature: stded25519.Sign(privKey, message),
+			expSingle: true,
+			expBatch:  true,
+		},
+		{
+			name:      "small-order A and R",
+			pubKey:    identity,
+			signature: append(append([]byte{}, identity...), zeroS...),
+			expSingle: true,
+			expBatch:  false,
+		},
+		{
+			name:      "non-canonical A",
+			pubKey:    nonCanonicalIdentity,
+			signature: append(append([]byte{}, identity...), zeroS...),
+			expSingle: true,
+			expBatch:  false,
+		},
+		{
+			name:      "non-canonical R",
+			pubKey:    identity,
+			signature: append(append([]byte{}, nonCanonicalIdentity...), zeroS...),
+			expSingle: false,
+			expBatch:  false,
+		},
+	}
+
+	// Signatures over A or R with an order 8 component satisfy the cofactored
+	// batch equation but the cofactorless one only when [k]A drops the
+	// torsion, that is when the challenge k is a multiple of 8.
+	for _, mc := range []struct {
+		name       string
+		torsionA   bool
+		torsionR   bool
+		kMultiple8 bool
+		exp        bool
+	}{
+		{"mixed-order R", false, true, false, false},
+		{"mixed-order A", true, false, false, false},
+		{"mixed-order A with k a multiple of 8", true, false, true, true},
+	} {
+		mixedPubKey, mixedSignature := signMixedOrder(t, mc.torsionA, mc.torsionR, mc.kMultiple8, message)
+		testCases = append(testCases, struct {
+			name      string
+			pubKey    []byte
+			signature []byte
+			expSingle bool
+			expBatch  bool
+		}{mc.name, mixedPubKey, mixedSignature, mc.exp, mc.exp})
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := runVerifyCall(t, precompile, ABI.Methods[VerifyEd25519Signature], tc.pubKey, tc.signature, message)
+			require.NoError(t, err)
+			require.Equal(t, tc.expSingle, unpackBoolOutput(t, ABI.Methods[VerifyEd25519Signature], out))
+
+			// Batch the entry with a valid signature so a rejection comes from
+			// the entry itself.
+			bitmap, _ := runVerifyBatchCall(
+				t, precompile,
+				[][]byte{tc.pubKey, pubKey},
+				[][]byte{tc.signature, stded25519.Sign(privKey, message)},
+				[][]byte{message, message},
+				0,
+			)
+			require.Equal(t, tc.expBatch, bitmap.Bit(0) == 1)
+			require.Equal(t, uint(1), bitmap.Bit(1))
+		})
+	}
+}
+
+func TestVerifyBatchRevertsOnInvalidBatch(t *testing.T) {
+	precompile, err := NewPrecompile(12_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[VerifyBatch]
+	testCases := []struct {
+		name string
+		size [3]int
+	}{
+		{"length mismatch", [3]int{2, 2, 1}},
+		{"too many entries", [3]int{257, 257, 257}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := method.Inputs.Pack(
+				make([][]byte, tc.size[0]),
+				make([][]byte, tc.size[1]),
+				make([][]byte, tc.size[2]),
+				uint16(0),
+			)
+			require.NoError(t, err)
+
+			out, err := precompile.Run(nil, newTestContract(append(append([]byte{}, method.ID...), args...)), false)
+			require.Nil(t, out)
+			require.ErrorIs(t, err, vm.ErrExecutionReverted)
+		})
+	}
+}
+
+func TestRequiredGasCoversBatchFallback(t *testing.T) {
+	precompile, err := NewPrecompile(12_000)
+	require.NoError(t, err)
+
+	single := ABI.Methods[VerifyEd25519Signature]
+	args, err := single.Inputs.Pack(make([]byte, 32), make([]byte, 64), []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(12_000), precompile.RequiredGas(append(append([]byte{}, single.ID...), args...)))
+
+	batch := ABI.Methods[VerifyBatch]
+	for _, tc := range []struct {
+		n   int
+		gas uint64
+	}{
+		{1, 24_000},
+		{100, 1_687_200},
+	} {
+		args, err := batch.Inputs.Pack(make([][]byte, tc.n), make([][]byte, tc.n), make([][]byte, tc.n), uint16(0))
+		require.NoError(t, err)
+		require.Equal(t, tc.gas, precompile.RequiredGas(append(append([]byte{}, batch.ID...), args...)))
+	}
+}
+
+// signMixedOrder signs message with a random key, adding an order 8 point to
+// A and or R, and retries until the challenge k is a multiple of 8 exactly
+// when kMultiple8 is set.
+func signMixedOrder(t *testing.T, torsionA, torsionR, kMultiple8 bool, message []byte) ([]byte, []byte) {
+	t.Helper()
+
+	// torsion is a point of order 8.
+	var torsionY curve.CompressedEdwardsY
+	_, err := torsionY.SetBytes(common.FromHex("26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc05"))
+	require.NoError(t, err)
+	var torsion curve.EdwardsPoint
+	_, err = torsion.SetCompressedY(&torsionY)
+	require.NoError(t, err)
+	require.True(t, torsion.IsSmallOrder())
+	require.False(t, torsion.IsTorsionFree())
+
+	encode := func(s *scalar.Scalar, addTorsion bool) []byte {
+		var p curve.EdwardsPoint
+		p.MulBasepoint(curve.ED25519_BASEPOINT_TABLE, s)
+		if addTorsion {
+			p.Add(&p, &torsion)
+		}
+		var y curve.CompressedEdwardsY
+		y.SetEdwardsPoint(&p)
+		return append([]byte{}, y[:]...)
+	}
+
+	a, err := scalar.New().SetRandom(rand.Reader)
+	require.NoError(t, err)
+	pubKey := encode(a, torsionA)
+
+	for {
+		r, err := scalar.New().SetRandom(rand.Reader)
+		require.NoError(t, err)
+		rBytes := encode(r, torsionR)
+
+		h := sha512.New()
+		h.Write(rBytes)
+		h.Write(pubKey)
+		h.Write(message)
+		k, err := scalar.NewFromBytesModOrderWide(h.Sum(nil))
+		require.NoError(t, err)
+
+		var kBytes [scalar.ScalarSize]byte
+		require.NoError(t, k.ToBytes(kBytes[:]))
+		if (kBytes[0]&7 == 0) != kMultiple8 {
+			continue
+		}
+
+		s := scalar.New().Mul(k, a)
+		s.Add(s, r)
+		var sBytes [scalar.ScalarSize]byte
+		require.NoError(t, s.ToBytes(sBytes[:]))
+		return pubKey, append(rBytes, sBytes[:]...)
+	}
+}
+
 func runVerifyCall(
 	t *testing.T,
 	precompile *Precompile,
@@ -198,6 +453,28 @@ func runVerifyCall(
 	return precompile.Run(nil, contract, false)
 }
 
+func runVerifyBatchCall(
+	t *testing.T,
+	precompile *Precompile,
+	pubKeys, signatures, messages [][]byte,
+	threshold uint16,
+) (*big.Int, bool) {
+	t.Helper()
+
+	method := ABI.Methods[VerifyBatch]
+	args, err := method.Inputs.Pack(pubKeys, signatures, messages, threshold)
+	require.NoError(t, err)
+
+	out, err := precompile.Run(nil, newTestContract(append(append([]byte{}, method.ID...), args...)), false)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	return values[0].(*big.Int), values[1].(bool)
+}
+
 func unpackBoolOutput(t *testing.T, method gethabi.Method, output []byte) bool {
 	t.Helper()
 
@@ -182,6 +182,12 @@ func (p *Precompile) HandleMethod(
 	method *abi.Method,
 	args []interface{},
 ) (bz []byte, err error) {
+	// Transfers and approvals stop while the circuit breaker disables the erc20
+	// module; queries keep working.
+	if p.IsTransaction(method) && p.erc20Keeper.IsModuleDisabled(ctx) {
+		return nil, ErrModuleDisabled
+	}
+
 	switch method.Name {
 	// ERC-20 transactions
 	case TransferMethod:
@@ -26,6 +26,7 @@ var (
 	ErrIncreaseNonPositiveValue = errors.New("cannot increase allowance with non-positive values")
 	ErrNegativeAmount           = errors.New("cannot approve negative values")
 	ErrSpenderIsOwner           = errors.New("spender cannot be the owner")
+	ErrModuleDisabled           = errors.New("erc20 module is disabled by the circuit breaker")
 
 	// ERC20 errors
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
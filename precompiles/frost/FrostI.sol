@@ -11,6 +11,7 @@ address constant FROST_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000
  *
  * @dev 1) Function names and argument ordering MUST match the precompile’s ABI:
  *        - frostVerifySignature(uint8,bytes,bytes,bytes) -> (bool)
+ *        - verifyBatch(uint8,bytes[],bytes[],bytes[],uint16) -> (uint256,bool)
  *
  *      2) Encodings:
  *        - `ciphersuite` is the byte id defined by bytemare/frost’s Ciphersuite enum.
@@ -28,9 +29,11 @@ address constant FROST_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000
  *          verification key.
  *        - It does NOT prove endorsement by any pre-authorized threshold group.
  *        - Any roster, threshold, or group-commitment policy must be enforced by
- *          the caller at a higher layer.
+ *          the caller at a higher layer, or by verifying against a group registered
+ *          with the FROST group precompile (IFrostGroup at 0x...0717).
  *
  *      5) Gas: the precompile charges a fixed base plus per-word calldata cost.
+ *         verifyBatch charges 90% of the base per signature and 10% once.
  */
 interface FrostI {
     /**
@@ -52,6 +55,30 @@ interface FrostI {
         bytes calldata signature,
         bytes calldata verificationKey
     ) external pure returns (bool ok);
+
+    /**
+     * @notice Verify a batch of FROST signatures of one ciphersuite.
+     *
+     * @param ciphersuite      Ciphersuite id (bytemare/frost enum, as a single byte).
+     * @param messages         Arbitrary message bytes.
+     * @param signatures       bytemare-encoded `frost.Signature`s.
+     * @param verificationKeys bytemare-encoded `ecc.Element` public keys for the suite.
+     * @param threshold        Number of valid signatures required for thresholdMet.
+     * @return validBitmap     Bit i is set iff signature i verifies.
+     * @return thresholdMet    True iff at least threshold signatures verify.
+     *
+     * @dev The arrays must have the same length, at most 256. Unlike
+     *      frostVerifySignature, an entry that fails to decode or verify only
+     *      clears its bit. It reverts on an unsupported ciphersuite or an
+     *      oversized message.
+     */
+    function verifyBatch(
+        uint8 ciphersuite,
+        bytes[] calldata messages,
+        bytes[] calldata signatures,
+        bytes[] calldata verificationKeys,
+        uint16 threshold
+    ) external pure returns (uint256 validBitmap, bool thresholdMet);
 }
 
 /// @dev Convenience typed handle to the precompile.
@@ -39,8 +39,14 @@ func init() {
 
 const (
 	frostVerifySignature  = "frostVerifySignature"
+	frostVerifyBatch      = "verifyBatch"
 	frostVerifyPerWordGas = 30
 	maxFROSTMessageBytes  = 64 * 1024
+
+	// frostBatchGasPercent is the share of the base gas charged for every
+	// entry of a batch. Signatures are verified one by one, so a batch only
+	// saves the overhead of the calls.
+	frostBatchGasPercent = 90
 )
 
 // Precompile is the FROST precompile. The baseGas is a constant, returned by RequiredGas.
@@ -67,7 +73,24 @@ func (Precompile) Address() common.Address {
 }
 
 func (p Precompile) RequiredGas(input []byte) uint64 {
-	return cmn.LinearRequiredGas(p.baseGas, input, frostVerifyPerWordGas)
+	return cmn.LinearRequiredGas(p.batchBaseGas(input), input, frostVerifyPerWordGas)
+}
+
+// batchBaseGas returns the base gas, discounted per entry for verifyBatch.
+func (p Precompile) batchBaseGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return p.baseGas
+	}
+	m, err := p.MethodById(input[:4])
+	if err != nil || m.Name != frostVerifyBatch {
+		return p.baseGas
+	}
+	vals, err := m.Inputs.Unpack(input[4:])
+	if err != nil || len(vals) != 5 {
+		return p.baseGas
+	}
+	messages, _ := vals[1].([][]byte)
+	return cmn.BatchRequiredGas(p.baseGas, len(messages), frostBatchGasPercent)
 }
 
 func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
@@ -85,6 +108,8 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, e
 	switch m.Name {
 	case frostVerifySignature:
 		return p.frostVerifySignature(m, input[4:])
+	case frostVerifyBatch:
+		return p.verifyBatch(m, input[4:])
 	default:
 		return nil, vm.ErrExecutionReverted
 	}
@@ -161,3 +186,69 @@ func (p *Precompile) frostVerifySignature(m *abi.Method, data []byte) ([]byte, e
 
 	return out, nil
 }
+
+// verifyBatch verifies a batch of FROST signatures of one ciphersuite.
+//
+// Expected ABI (from abi.json):
+//
+//	function verifyBatch(
+//	    uint8 ciphersuite,
+//	    bytes[] messages,
+//	    bytes[] signatures,
+//	    bytes[] verificationKeys,
+//	    uint16 threshold
+//	) returns (uint256 validBitmap, bool thresholdMet)
+//
+// NOTE: Unlike frostVerifySignature, an entry that fails to decode or verify
+// only clears its bit. An unsupported ciphersuite, arrays of different lengths
+// or an oversized message revert.
+func (p *Precompile) verifyBatch(m *abi.Method, data []byte) ([]byte, error) {
+	vals, err := m.Inputs.Unpack(data)
+	if err != nil || len(vals) != 5 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	ciphersuiteByte := vals[0].(uint8)
+	messages := vals[1].([][]byte)
+	signatures := vals[2].([][]byte)
+	verificationKeys := vals[3].([][]byte)
+	threshold := vals[4].(uint16)
+
+	n, err := cmn.BatchSize(len(messages), len(signatures), len(verificationKeys))
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+	for _, message := range messages {
+		if len(message) > maxFROSTMessageBytes {
+			return nil, fmt.Errorf("frost message exceeds %d bytes", maxFROSTMessageBytes)
+		}
+	}
+
+	ciphersuite := frost.Ciphersuite(ciphersuiteByte)
+	if !ciphersuite.Available() {
+		return nil, fmt.Errorf("unsupported ciphersuite id %d", ciphersuiteByte)
+	}
+
+	valid := make([]bool, n)
+	for i := range n {
+		valid[i] = verifyEntry(ciphersuite, messages[i], signatures[i], verificationKeys[i])
+	}
+
+	return cmn.PackBatchResult(m, valid, threshold)
+}
+
+// verifyEntry reports whether a signature decodes and verifies under the
+// verification key.
+func verifyEntry(ciphersuite frost.Ciphersuite, message, signatureBytes, verificationKeyBytes []byte) bool {
+	signature := new(frost.Signature)
+	if err := signature.Decode(signatureBytes); err != nil {
+		return false
+	}
+
+	verificationKey := ciphersuite.Group().NewElement()
+	if err := verificationKey.Decode(verificationKeyBytes); err != nil {
+		return false
+	}
+
+	return frost.VerifySignature(ciphersuite, message, signature, verificationKey) == nil
+}
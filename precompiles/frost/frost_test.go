@@ -1,6 +1,7 @@
 package frost
 
 import (
+	"math/big"
 	"testing"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
@@ -92,10 +93,92 @@ func TestRunRejectsOversizedMessage(t *testing.T) {
 	require.ErrorContains(t, err, "frost message exceeds")
 }
 
-func TestABIExposesOnlySignatureVerification(t *testing.T) {
+func TestABIExposesSignatureVerificationMethods(t *testing.T) {
 	_, found := ABI.Methods[frostVerifySignature]
 	require.True(t, found)
-	require.Len(t, ABI.Methods, 1)
+	_, found = ABI.Methods[frostVerifyBatch]
+	require.True(t, found)
+	require.Len(t, ABI.Methods, 2)
+}
+
+func TestVerifyBatch(t *testing.T) {
+	precompile, err := NewPrecompile(60_000)
+	require.NoError(t, err)
+
+	ciphersuite := frostlib.Ed25519
+	group := ciphersuite.Group()
+
+	var messages, signatures, verificationKeys [][]byte
+	for i := range 4 {
+		message := []byte{'h', 'e', 'a', 'd', 'e', 'r', byte(i)}
+		secretKey := group.NewScalar().Random()
+		signature, err := frostdebug.Sign(ciphersuite, message, secretKey)
+		require.NoError(t, err)
+
+		messages = append(messages, message)
+		signatures = append(signatures, signature.Encode())
+		verificationKeys = append(verificationKeys, group.Base().Multiply(secretKey).Encode())
+	}
+
+	bitmap, met := runVerifyBatchCall(t, precompile, uint8(ciphersuite), messages, signatures, verificationKeys, 4)
+	require.Equal(t, int64(0b1111), bitmap.Int64())
+	require.True(t, met)
+
+	// A mismatched key and an undecodable signature clear their bits only.
+	verificationKeys[0] = verificationKeys[1]
+	signatures[2] = []byte("sig")
+
+	bitmap, met = runVerifyBatchCall(t, precompile, uint8(ciphersuite), messages, signatures, verificationKeys, 2)
+	require.Equal(t, int64(0b1010), bitmap.Int64())
+	require.True(t, met)
+
+	bitmap, met = runVerifyBatchCall(t, precompile, uint8(ciphersuite), messages, signatures, verificationKeys, 3)
+	require.Equal(t, int64(0b1010), bitmap.Int64())
+	require.False(t, met)
+}
+
+func TestVerifyBatchReverts(t *testing.T) {
+	precompile, err := NewPrecompile(60_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[frostVerifyBatch]
+	testCases := []struct {
+		name        string
+		ciphersuite uint8
+		messages    [][]byte
+		size        int
+		errContains string
+	}{
+		{"length mismatch", uint8(frostlib.Default), make([][]byte, 1), 2, ""},
+		{"unsupported ciphersuite", 0xff, make([][]byte, 1), 1, "unsupported ciphersuite"},
+		{"oversized message", uint8(frostlib.Default), [][]byte{make([]byte, maxFROSTMessageBytes+1)}, 1, "frost message exceeds"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := method.Inputs.Pack(tc.ciphersuite, tc.messages, make([][]byte, tc.size), make([][]byte, tc.size), uint16(0))
+			require.NoError(t, err)
+
+			_, err = precompile.Run(nil, newTestContract(append(append([]byte{}, method.ID...), args...)), false)
+			if tc.errContains == "" {
+				require.ErrorIs(t, err, vm.ErrExecutionReverted)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestRequiredGasDiscountsBatches(t *testing.T) {
+	precompile, err := NewPrecompile(60_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[frostVerifyBatch]
+	args, err := method.Inputs.Pack(uint8(frostlib.Default), make([][]byte, 10), make([][]byte, 10), make([][]byte, 10), uint16(0))
+	require.NoError(t, err)
+
+	input := append(append([]byte{}, method.ID...), args...)
+	require.Equal(t, cmn.LinearRequiredGas(6_000+10*54_000, input, frostVerifyPerWordGas), precompile.RequiredGas(input))
 }
 
 func runVerifyCall(
@@ -116,6 +199,29 @@ func runVerifyCall(
 	return precompile.Run(nil, contract, false)
 }
 
+func runVerifyBatchCall(
+	t *testing.T,
+	precompile *Precompile,
+	ciphersuite uint8,
+	messages, signatures, verificationKeys [][]byte,
+	threshold uint16,
+) (*big.Int, bool) {
+	t.Helper()
+
+	method := ABI.Methods[frostVerifyBatch]
+	args, err := method.Inputs.Pack(ciphersuite, messages, signatures, verificationKeys, threshold)
+	require.NoError(t, err)
+
+	out, err := precompile.Run(nil, newTestContract(append(append([]byte{}, method.ID...), args...)), false)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	return values[0].(*big.Int), values[1].(bool)
+}
+
 func unpackBoolOutput(t *testing.T, method gethabi.Method, output []byte) bool {
 	t.Helper()
 
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IFrostGroup contract's address.
+address constant FROSTGROUP_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000717;
+
+/// @dev The IFrostGroup contract's instance.
+IFrostGroup constant FROSTGROUP_CONTRACT = IFrostGroup(FROSTGROUP_PRECOMPILE_ADDRESS);
+
+/// @dev Key generation kinds, as numbered in x/frostgroup.
+uint8 constant DKG_KIND_KEYGEN = 1;
+uint8 constant DKG_KIND_RESHARE = 2;
+
+/// @dev FrostGroup is a threshold group registered in x/frostgroup.
+struct FrostGroup {
+    address admin;
+    uint8 ciphersuite;
+    uint16 threshold;
+    address[] participants;
+    bytes verificationKey;
+    bytes[] publicKeyShares;
+    uint64 keyVersion;
+}
+
+/// @dev DkgSession is a key generation or resharing in progress for a group.
+struct DkgSession {
+    uint8 kind;
+    uint16 threshold;
+    address[] participants;
+    bytes[] commitments;
+    uint16[] confirmed;
+    int64 deadlineHeight;
+}
+
+/// @author c8ntinuum Team
+/// @title FROST Group Precompile Interface
+/// @dev The interface through which solidity contracts register FROST
+/// threshold groups, run their distributed key generation and verify
+/// signatures against the registered group keys. Unlike the FROST
+/// precompile, the key is bound to a roster and threshold recorded on chain.
+/// @custom:address 0x0000000000000000000000000000000000000717
+interface IFrostGroup {
+    /// @dev Emitted when a group is created.
+    /// @param groupId The id of the group
+    /// @param admin The account administering the group
+    /// @param ciphersuite The FROST ciphersuite id of the group
+    event GroupCreated(uint64 indexed groupId, address indexed admin, uint8 ciphersuite);
+
+    /// @dev Emitted when a key generation or resharing starts.
+    /// @param groupId The id of the group
+    /// @param kind The kind of the session
+    /// @param threshold The threshold of the resulting key shares
+    /// @param deadlineHeight The last block in which the session can progress
+    event DkgStarted(uint64 indexed groupId, uint8 kind, uint16 threshold, int64 deadlineHeight);
+
+    /// @dev Emitted when the last participant confirms and the group key and
+    /// roster are updated.
+    /// @param groupId The id of the group
+    /// @param keyVersion The new key version of the group
+    /// @param verificationKey The group key
+    event DkgCompleted(uint64 indexed groupId, uint64 keyVersion, bytes verificationKey);
+
+    /// @dev Registers a group administered by the caller and starts the
+    /// generation of its key.
+    /// @param ciphersuite The FROST ciphersuite id
+    /// @param threshold The number of participants needed to sign
+    /// @param participants The roster; the participant at index i has the
+    /// FROST identifier i+1
+    /// @return groupId The id of the group
+    function createGroup(
+        uint8 ciphersuite,
+        uint16 threshold,
+        address[] calldata participants
+    ) external returns (uint64 groupId);
+
+    /// @dev Submits the caller's first round message. Dealers are the
+    /// participants of a key generation and the current roster of a
+    /// resharing, whose commitments must open to their key share.
+    /// @param groupId The id of the group
+    /// @param round1Data The encoded dkg.Round1Data of the caller
+    function submitDkgCommitment(uint64 groupId, bytes calldata round1Data) external returns (bool success);
+
+    /// @dev Confirms the caller's public key share once all the expected
+    /// dealers have committed. The secret shares are exchanged off-chain.
+    /// @param groupId The id of the group
+    /// @param publicKeyShare The encoded public key share of the caller
+    /// @return completed Whether this confirmation completed the session
+    function confirmDkg(uint64 groupId, bytes calldata publicKeyShare) external returns (bool completed);
+
+    /// @dev Starts the generation of a fresh key for the current roster. The
+    /// current key stays valid until the new one is confirmed. Admin only.
+    /// @param groupId The id of the group
+    function rotateGroupKey(uint64 groupId) external returns (bool success);
+
+    /// @dev Starts the redistribution of the group key to a new roster and
+    /// threshold. The group key does not change. Admin only.
+    /// @param groupId The id of the group
+    /// @param threshold The new threshold
+    /// @param participants The new roster
+    function reshareGroup(
+        uint64 groupId,
+        uint16 threshold,
+        address[] calldata participants
+    ) external returns (bool success);
+
+    /// @dev Drops the key generation in progress. Admin only.
+    /// @param groupId The id of the group
+    function cancelDkg(uint64 groupId) external returns (bool success);
+
+    /// @dev Verifies a FROST signature against the current key of a group.
+    /// Reverts if the group is unknown, has no key yet or the signature is
+    /// malformed.
+    /// @param groupId The id of the group
+    /// @param message The signed message
+    /// @param signature The bytemare-encoded frost.Signature
+    /// @return valid Whether the signature is valid
+    function verifyGroupSignature(
+        uint64 groupId,
+        bytes calldata message,
+        bytes calldata signature
+    ) external view returns (bool valid);
+
+    /// @dev Returns a registered group. Reverts if the group is unknown.
+    /// @param groupId The id of the group
+    function group(uint64 groupId) external view returns (FrostGroup memory);
+
+    /// @dev Returns the key generation in progress for a group, with the
+    /// dealers' commitments in submission order and the identifiers of the
+    /// participants that confirmed. Reverts if there is none.
+    /// @param groupId The id of the group
+    function dkgSession(uint64 groupId) external view returns (DkgSession memory);
+}
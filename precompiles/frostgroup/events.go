@@ -0,0 +1,147 @@
+package frostgroup
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGroupCreated defines the event type emitted by the
+	// createGroup transaction.
+	EventTypeGroupCreated = "GroupCreated"
+	// EventTypeDkgStarted defines the event type emitted when a key
+	// generation or resharing starts.
+	EventTypeDkgStarted = "DkgStarted"
+	// EventTypeDkgCompleted defines the event type emitted by the
+	// confirmDkg transaction that installs a new group key.
+	EventTypeDkgCompleted = "DkgCompleted"
+)
+
+// EmitGroupCreatedEvent creates a new event emitted when a group is
+// registered.
+func (p Precompile) EmitGroupCreatedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	groupID uint64,
+	admin common.Address,
+	ciphersuite uint32,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeGroupCreated]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(groupID)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(admin)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(uint8(ciphersuite)) //nolint:gosec // G115 // ciphersuites are validated to fit a byte
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitDkgStartedEvent creates a new event emitted when a key generation or
+// resharing starts.
+func (p Precompile) EmitDkgStartedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	session frostgrouptypes.DkgSession,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeDkgStarted]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(session.GroupId)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(
+		uint8(session.Kind),       //nolint:gosec // G115 // enum values are small
+		uint16(session.Threshold), //nolint:gosec // G115 // thresholds are bounded by max_participants
+		session.DeadlineHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitDkgCompletedEvent creates a new event emitted when a key generation
+// or resharing installs a new group key.
+func (p Precompile) EmitDkgCompletedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	group frostgrouptypes.Group,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeDkgCompleted]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(group.Id)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(group.KeyVersion, group.VerificationKey)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package frostgroup
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	frostgroupkeeper "github.com/cosmos/evm/x/frostgroup/keeper"
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = (*Precompile)(nil)
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the precompiled contract registering FROST threshold
+// groups in x/frostgroup, running their key generation and verifying
+// signatures against the group keys.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	frostGroupKeeper    frostgroupkeeper.Keeper
+	frostGroupMsgServer frostgrouptypes.MsgServer
+}
+
+// NewPrecompile creates a new frostgroup Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	frostGroupKeeper frostgroupkeeper.Keeper,
+	frostGroupMsgServer frostgrouptypes.MsgServer,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ContractAddress:      common.HexToAddress(evmtypes.FrostGroupPrecompileAddress),
+		},
+		ABI:                 ABI,
+		frostGroupKeeper:    frostGroupKeeper,
+		frostGroupMsgServer: frostGroupMsgServer,
+	}
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate. The cost
+// of the curve operations is charged when the method runs, since it depends
+// on the threshold of the group.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// Run returns a selector error; keep zero here as the conservative gas fallback.
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// frostgroup transactions
+	case CreateGroupMethod:
+		return p.CreateGroup(ctx, contract, stateDB, method, args)
+	case SubmitDkgCommitmentMethod:
+		return p.SubmitDkgCommitment(ctx, contract, method, args)
+	case ConfirmDkgMethod:
+		return p.ConfirmDkg(ctx, contract, stateDB, method, args)
+	case RotateGroupKeyMethod:
+		return p.RotateGroupKey(ctx, contract, stateDB, method, args)
+	case ReshareGroupMethod:
+		return p.ReshareGroup(ctx, contract, stateDB, method, args)
+	case CancelDkgMethod:
+		return p.CancelDkg(ctx, contract, method, args)
+	// frostgroup queries
+	case VerifyGroupSignatureMethod:
+		return p.VerifyGroupSignature(ctx, method, args)
+	case GroupMethod:
+		return p.Group(ctx, method, args)
+	case DkgSessionMethod:
+		return p.DkgSession(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available frostgroup transactions are:
+// - CreateGroup
+// - SubmitDkgCommitment
+// - ConfirmDkg
+// - RotateGroupKey
+// - ReshareGroup
+// - CancelDkg
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case CreateGroupMethod,
+		SubmitDkgCommitmentMethod,
+		ConfirmDkgMethod,
+		RotateGroupKeyMethod,
+		ReshareGroupMethod,
+		CancelDkgMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "frostgroup")
+}
@@ -0,0 +1,97 @@
+package frostgroup
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// VerifyGroupSignatureMethod defines the ABI method name for the
+	// frostgroup VerifyGroupSignature query.
+	VerifyGroupSignatureMethod = "verifyGroupSignature"
+	// GroupMethod defines the ABI method name for the frostgroup Group query.
+	GroupMethod = "group"
+	// DkgSessionMethod defines the ABI method name for the frostgroup
+	// DkgSession query.
+	DkgSessionMethod = "dkgSession"
+)
+
+// VerifyGroupSignature verifies a FROST signature against the current key of
+// a group. A well-formed signature that does not verify returns false, while
+// an unknown group, a group without a key or a malformed signature reverts.
+func (p Precompile) VerifyGroupSignature(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	groupID, message, signature, err := ParseVerifyGroupSignatureArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	words := (uint64(len(message)) + 31) / 32
+	ctx.GasMeter().ConsumeGas(GasVerifyGroupSignature+GasVerifyGroupSignaturePerWord*words, "frostgroup verifyGroupSignature")
+
+	err = p.frostGroupKeeper.VerifyGroupSignature(ctx, groupID, message, signature)
+	if errors.Is(err, frostgrouptypes.ErrSignatureVerification) {
+		return method.Outputs.Pack(false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Group returns a registered group.
+func (p Precompile) Group(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	groupID, err := ParseGroupID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	group, found := p.frostGroupKeeper.GetGroup(ctx, groupID)
+	if !found {
+		return nil, frostgrouptypes.ErrGroupNotFound.Wrapf("%d", groupID)
+	}
+
+	out, err := NewGroupOutput(group)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// DkgSession returns the key generation in progress of a group.
+func (p Precompile) DkgSession(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	groupID, err := ParseGroupID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	session, found := p.frostGroupKeeper.GetDkgSession(ctx, groupID)
+	if !found {
+		return nil, frostgrouptypes.ErrDkgNotFound.Wrapf("group %d", groupID)
+	}
+
+	out, err := NewDkgSessionOutput(session)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
@@ -0,0 +1,206 @@
+package frostgroup
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CreateGroupMethod defines the ABI method name for the frostgroup
+	// CreateGroup transaction.
+	CreateGroupMethod = "createGroup"
+	// SubmitDkgCommitmentMethod defines the ABI method name for the
+	// frostgroup SubmitDkgCommitment transaction.
+	SubmitDkgCommitmentMethod = "submitDkgCommitment"
+	// ConfirmDkgMethod defines the ABI method name for the frostgroup
+	// ConfirmDkg transaction.
+	ConfirmDkgMethod = "confirmDkg"
+	// RotateGroupKeyMethod defines the ABI method name for the frostgroup
+	// RotateGroupKey transaction.
+	RotateGroupKeyMethod = "rotateGroupKey"
+	// ReshareGroupMethod defines the ABI method name for the frostgroup
+	// ReshareGroup transaction.
+	ReshareGroupMethod = "reshareGroup"
+	// CancelDkgMethod defines the ABI method name for the frostgroup
+	// CancelDkg transaction.
+	CancelDkgMethod = "cancelDkg"
+)
+
+// CreateGroup registers a group administered by the caller and starts its
+// key generation.
+func (p Precompile) CreateGroup(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgCreateGroup(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.frostGroupMsgServer.CreateGroup(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGroupCreatedEvent(ctx, stateDB, res.GroupId, contract.Caller(), msg.Ciphersuite); err != nil {
+		return nil, err
+	}
+	if err := p.emitDkgStarted(ctx, stateDB, res.GroupId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.GroupId)
+}
+
+// SubmitDkgCommitment submits the first round message of the caller to the
+// key generation of a group.
+func (p Precompile) SubmitDkgCommitment(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgSubmitDkgCommitment(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.frostGroupMsgServer.SubmitDkgCommitment(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ConfirmDkg confirms the public key share of the caller. The confirmation
+// completing the key generation installs the new key of the group.
+func (p Precompile) ConfirmDkg(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgConfirmDkg(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.frostGroupMsgServer.ConfirmDkg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Completed {
+		group, found := p.frostGroupKeeper.GetGroup(ctx, msg.GroupId)
+		if !found {
+			return nil, frostgrouptypes.ErrGroupNotFound.Wrapf("%d", msg.GroupId)
+		}
+		if err := p.EmitDkgCompletedEvent(ctx, stateDB, group); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(res.Completed)
+}
+
+// RotateGroupKey starts a new key generation for the roster of a group
+// administered by the caller.
+func (p Precompile) RotateGroupKey(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgRotateGroupKey(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.frostGroupMsgServer.RotateGroupKey(ctx, msg); err != nil {
+		return nil, err
+	}
+	if err := p.emitDkgStarted(ctx, stateDB, msg.GroupId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ReshareGroup starts a resharing of the group key to a new roster and
+// threshold, for a group administered by the caller.
+func (p Precompile) ReshareGroup(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgReshareGroup(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.frostGroupMsgServer.ReshareGroup(ctx, msg); err != nil {
+		return nil, err
+	}
+	if err := p.emitDkgStarted(ctx, stateDB, msg.GroupId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelDkg cancels the key generation in progress of a group administered
+// by the caller.
+func (p Precompile) CancelDkg(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgCancelDkg(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.frostGroupMsgServer.CancelDkg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) emitDkgStarted(ctx sdk.Context, stateDB vm.StateDB, groupID uint64) error {
+	session, found := p.frostGroupKeeper.GetDkgSession(ctx, groupID)
+	if !found {
+		return frostgrouptypes.ErrDkgNotFound.Wrapf("group %d", groupID)
+	}
+	return p.EmitDkgStartedEvent(ctx, stateDB, session)
+}
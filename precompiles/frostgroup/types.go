@@ -0,0 +1,295 @@
+package frostgroup
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GasVerifyGroupSignature matches the default base gas of the FROST
+	// precompile.
+	GasVerifyGroupSignature = 60_000
+	// GasVerifyGroupSignaturePerWord is charged for every 32-byte word of
+	// the message, which is hashed into the challenge.
+	GasVerifyGroupSignaturePerWord = 30
+	// MaxMessageBytes bounds the message of verifyGroupSignature, like the
+	// FROST precompile.
+	MaxMessageBytes = 64 * 1024
+)
+
+// GroupOutput is the ABI form of a registered group.
+type GroupOutput struct {
+	Admin           common.Address
+	Ciphersuite     uint8
+	Threshold       uint16
+	Participants    []common.Address
+	VerificationKey []byte
+	PublicKeyShares [][]byte
+	KeyVersion      uint64
+}
+
+// NewGroupOutput converts a registered group to its ABI form.
+func NewGroupOutput(group frostgrouptypes.Group) (GroupOutput, error) {
+	admin, err := sdk.AccAddressFromBech32(group.Admin)
+	if err != nil {
+		return GroupOutput{}, fmt.Errorf("invalid admin %s: %w", group.Admin, err)
+	}
+	participants, err := toHexAddresses(group.Participants)
+	if err != nil {
+		return GroupOutput{}, err
+	}
+	shares := group.PublicKeyShares
+	if shares == nil {
+		shares = [][]byte{}
+	}
+
+	return GroupOutput{
+		Admin:           common.BytesToAddress(admin),
+		Ciphersuite:     uint8(group.Ciphersuite), //nolint:gosec // G115 // ciphersuites are validated to fit a byte
+		Threshold:       uint16(group.Threshold),  //nolint:gosec // G115 // thresholds are bounded by max_participants
+		Participants:    participants,
+		VerificationKey: group.VerificationKey,
+		PublicKeyShares: shares,
+		KeyVersion:      group.KeyVersion,
+	}, nil
+}
+
+// DkgSessionOutput is the ABI form of a key generation in progress.
+type DkgSessionOutput struct {
+	Kind           uint8
+	Threshold      uint16
+	Participants   []common.Address
+	Commitments    [][]byte
+	Confirmed      []uint16
+	DeadlineHeight int64
+}
+
+// NewDkgSessionOutput converts a key generation in progress to its ABI
+// form. Commitments are the encoded first round messages, which carry the
+// dealer identifier.
+func NewDkgSessionOutput(session frostgrouptypes.DkgSession) (DkgSessionOutput, error) {
+	participants, err := toHexAddresses(session.Participants)
+	if err != nil {
+		return DkgSessionOutput{}, err
+	}
+	commitments := make([][]byte, len(session.Commitments))
+	for i, c := range session.Commitments {
+		commitments[i] = c.Data
+	}
+	confirmed := make([]uint16, len(session.Confirmations))
+	for i, c := range session.Confirmations {
+		confirmed[i] = uint16(c.Participant) //nolint:gosec // G115 // identifiers are bounded by max_participants
+	}
+
+	return DkgSessionOutput{
+		Kind:           uint8(session.Kind),       //nolint:gosec // G115 // enum values are small
+		Threshold:      uint16(session.Threshold), //nolint:gosec // G115 // thresholds are bounded by max_participants
+		Participants:   participants,
+		Commitments:    commitments,
+		Confirmed:      confirmed,
+		DeadlineHeight: session.DeadlineHeight,
+	}, nil
+}
+
+// NewMsgCreateGroup creates a new MsgCreateGroup instance administered by
+// signer.
+func NewMsgCreateGroup(args []interface{}, signer common.Address) (*frostgrouptypes.MsgCreateGroup, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	ciphersuite, ok := args[0].(uint8)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "ciphersuite", uint8(0), args[0])
+	}
+
+	threshold, ok := args[1].(uint16)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "threshold", uint16(0), args[1])
+	}
+
+	participants, ok := args[2].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "participants", []common.Address{}, args[2])
+	}
+
+	return &frostgrouptypes.MsgCreateGroup{
+		Admin:        sdk.AccAddress(signer.Bytes()).String(),
+		Ciphersuite:  uint32(ciphersuite),
+		Threshold:    uint32(threshold),
+		Participants: toBech32Addresses(participants),
+	}, nil
+}
+
+// NewMsgSubmitDkgCommitment creates a new MsgSubmitDkgCommitment instance
+// signed by signer.
+func NewMsgSubmitDkgCommitment(args []interface{}, signer common.Address) (*frostgrouptypes.MsgSubmitDkgCommitment, error) {
+	groupID, data, err := parseGroupIDAndBytes(args, "round1Data")
+	if err != nil {
+		return nil, err
+	}
+
+	return &frostgrouptypes.MsgSubmitDkgCommitment{
+		Dealer:  sdk.AccAddress(signer.Bytes()).String(),
+		GroupId: groupID,
+		Data:    data,
+	}, nil
+}
+
+// NewMsgConfirmDkg creates a new MsgConfirmDkg instance signed by signer.
+func NewMsgConfirmDkg(args []interface{}, signer common.Address) (*frostgrouptypes.MsgConfirmDkg, error) {
+	groupID, share, err := parseGroupIDAndBytes(args, "publicKeyShare")
+	if err != nil {
+		return nil, err
+	}
+
+	return &frostgrouptypes.MsgConfirmDkg{
+		Participant:    sdk.AccAddress(signer.Bytes()).String(),
+		GroupId:        groupID,
+		PublicKeyShare: share,
+	}, nil
+}
+
+// NewMsgRotateGroupKey creates a new MsgRotateGroupKey instance signed by
+// signer.
+func NewMsgRotateGroupKey(args []interface{}, signer common.Address) (*frostgrouptypes.MsgRotateGroupKey, error) {
+	groupID, err := ParseGroupID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frostgrouptypes.MsgRotateGroupKey{
+		Admin:   sdk.AccAddress(signer.Bytes()).String(),
+		GroupId: groupID,
+	}, nil
+}
+
+// NewMsgReshareGroup creates a new MsgReshareGroup instance signed by
+// signer.
+func NewMsgReshareGroup(args []interface{}, signer common.Address) (*frostgrouptypes.MsgReshareGroup, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	groupID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "groupId", uint64(0), args[0])
+	}
+
+	threshold, ok := args[1].(uint16)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "threshold", uint16(0), args[1])
+	}
+
+	participants, ok := args[2].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "participants", []common.Address{}, args[2])
+	}
+
+	return &frostgrouptypes.MsgReshareGroup{
+		Admin:        sdk.AccAddress(signer.Bytes()).String(),
+		GroupId:      groupID,
+		Threshold:    uint32(threshold),
+		Participants: toBech32Addresses(participants),
+	}, nil
+}
+
+// NewMsgCancelDkg creates a new MsgCancelDkg instance signed by signer.
+func NewMsgCancelDkg(args []interface{}, signer common.Address) (*frostgrouptypes.MsgCancelDkg, error) {
+	groupID, err := ParseGroupID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &frostgrouptypes.MsgCancelDkg{
+		Admin:   sdk.AccAddress(signer.Bytes()).String(),
+		GroupId: groupID,
+	}, nil
+}
+
+// ParseVerifyGroupSignatureArgs parses the arguments of the
+// verifyGroupSignature query.
+func ParseVerifyGroupSignatureArgs(args []interface{}) (groupID uint64, message []byte, signature []byte, err error) {
+	if len(args) != 3 {
+		return 0, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	groupID, ok := args[0].(uint64)
+	if !ok {
+		return 0, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "groupId", uint64(0), args[0])
+	}
+
+	message, ok = args[1].([]byte)
+	if !ok {
+		return 0, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "message", []byte{}, args[1])
+	}
+	if len(message) > MaxMessageBytes {
+		return 0, nil, nil, fmt.Errorf("message exceeds %d bytes", MaxMessageBytes)
+	}
+
+	signature, ok = args[2].([]byte)
+	if !ok {
+		return 0, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "signature", []byte{}, args[2])
+	}
+
+	return groupID, message, signature, nil
+}
+
+// ParseGroupID parses the group id argument of the methods that only take
+// a group id.
+func ParseGroupID(args []interface{}) (uint64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	groupID, ok := args[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf(cmn.ErrInvalidType, "groupId", uint64(0), args[0])
+	}
+
+	return groupID, nil
+}
+
+func parseGroupIDAndBytes(args []interface{}, name string) (uint64, []byte, error) {
+	if len(args) != 2 {
+		return 0, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	groupID, ok := args[0].(uint64)
+	if !ok {
+		return 0, nil, fmt.Errorf(cmn.ErrInvalidType, "groupId", uint64(0), args[0])
+	}
+
+	bz, ok := args[1].([]byte)
+	if !ok {
+		return 0, nil, fmt.Errorf(cmn.ErrInvalidType, name, []byte{}, args[1])
+	}
+
+	return groupID, bz, nil
+}
+
+func toBech32Addresses(addrs []common.Address) []string {
+	out := make([]string, len(addrs))
+	for i, addr := range addrs {
+		out[i] = sdk.AccAddress(addr.Bytes()).String()
+	}
+	return out
+}
+
+func toHexAddresses(addrs []string) ([]common.Address, error) {
+	out := make([]common.Address, len(addrs))
+	for i, addr := range addrs {
+		acc, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid participant %s: %w", addr, err)
+		}
+		out[i] = common.BytesToAddress(acc)
+	}
+	return out, nil
+}
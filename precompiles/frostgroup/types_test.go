@@ -0,0 +1,85 @@
+package frostgroup
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewMsgCreateGroup(t *testing.T) {
+	method := ABI.Methods[CreateGroupMethod]
+	participants := []common.Address{common.HexToAddress("0x1234"), common.HexToAddress("0x5678")}
+	bz, err := method.Inputs.Pack(uint8(1), uint16(2), participants)
+	require.NoError(t, err)
+	args, err := method.Inputs.Unpack(bz)
+	require.NoError(t, err)
+
+	admin := common.HexToAddress("0x9abc")
+	msg, err := NewMsgCreateGroup(args, admin)
+	require.NoError(t, err)
+	require.Equal(t, &frostgrouptypes.MsgCreateGroup{
+		Admin:        sdk.AccAddress(admin.Bytes()).String(),
+		Ciphersuite:  1,
+		Threshold:    2,
+		Participants: []string{sdk.AccAddress(participants[0].Bytes()).String(), sdk.AccAddress(participants[1].Bytes()).String()},
+	}, msg)
+	require.NoError(t, msg.ValidateBasic())
+
+	_, err = NewMsgCreateGroup(args[:2], admin)
+	require.Error(t, err)
+}
+
+func TestGroupOutputRoundTrip(t *testing.T) {
+	admin := sdk.AccAddress(common.HexToAddress("0x1234").Bytes())
+	participant := sdk.AccAddress(common.HexToAddress("0x5678").Bytes())
+	group := frostgrouptypes.Group{
+		Id:              1,
+		Admin:           admin.String(),
+		Ciphersuite:     1,
+		Threshold:       2,
+		Participants:    []string{admin.String(), participant.String()},
+		VerificationKey: []byte{1, 2, 3},
+		PublicKeyShares: [][]byte{{4}, {5}},
+		KeyVersion:      3,
+	}
+	output, err := NewGroupOutput(group)
+	require.NoError(t, err)
+
+	method := ABI.Methods[GroupMethod]
+	bz, err := method.Outputs.Pack(output)
+	require.NoError(t, err)
+	unpacked, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+
+	decoded, ok := abi.ConvertType(unpacked[0], new(GroupOutput)).(*GroupOutput)
+	require.True(t, ok)
+	require.Equal(t, output, *decoded)
+	require.Equal(t, []common.Address{common.BytesToAddress(admin), common.BytesToAddress(participant)}, decoded.Participants)
+
+	group.Admin = "invalid"
+	_, err = NewGroupOutput(group)
+	require.Error(t, err)
+}
+
+func TestParseVerifyGroupSignatureArgs(t *testing.T) {
+	method := ABI.Methods[VerifyGroupSignatureMethod]
+	bz, err := method.Inputs.Pack(uint64(7), []byte{1}, []byte{2})
+	require.NoError(t, err)
+	args, err := method.Inputs.Unpack(bz)
+	require.NoError(t, err)
+
+	groupID, message, signature, err := ParseVerifyGroupSignatureArgs(args)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), groupID)
+	require.Equal(t, []byte{1}, message)
+	require.Equal(t, []byte{2}, signature)
+
+	_, _, _, err = ParseVerifyGroupSignatureArgs([]interface{}{uint64(7), make([]byte, MaxMessageBytes+1), []byte{2}})
+	require.ErrorContains(t, err, "message exceeds")
+}
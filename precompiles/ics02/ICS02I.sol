@@ -14,6 +14,10 @@ ICS02I constant ICS02_CONTRACT = ICS02I(ICS02_PRECOMPILE_ADDRESS);
 /// @dev The interface through which solidity contracts will interact with IBC Light Clients (ICS02)
 /// @custom:address 0x0000000000000000000000000000000000000807
 interface ICS02I {
+    /// @notice Raised when the IBC breaker has IBC disabled or the client is denied.
+    /// @param reason The reason reported by the breaker.
+    error IbcUnavailable(string reason);
+
     /// @notice The result of an update operation
     enum UpdateResult {
         /// The update was successful
@@ -43,8 +43,9 @@ type Precompile struct {
 	cmn.Precompile
 
 	abi.ABI
-	cdc          codec.Codec
-	clientKeeper ibcutils.ClientKeeper
+	cdc              codec.Codec
+	clientKeeper     ibcutils.ClientKeeper
+	ibcBreakerKeeper cmn.IbcBreakerKeeper
 }
 
 // NewPrecompile creates a new Client Precompile instance as a
@@ -52,6 +53,7 @@ type Precompile struct {
 func NewPrecompile(
 	cdc codec.Codec,
 	clientKeeper ibcutils.ClientKeeper,
+	ibcBreakerKeeper cmn.IbcBreakerKeeper,
 ) *Precompile {
 	return &Precompile{
 		cdc: cdc,
@@ -60,8 +62,9 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ContractAddress:      common.HexToAddress(evmtypes.ICS02PrecompileAddress),
 		},
-		ABI:          ABI,
-		clientKeeper: clientKeeper,
+		ABI:              ABI,
+		clientKeeper:     clientKeeper,
+		ibcBreakerKeeper: ibcBreakerKeeper,
 	}
 }
 
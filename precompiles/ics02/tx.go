@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
@@ -25,6 +26,10 @@ const (
 	VerifyNonMembershipMethod = "verifyNonMembership"
 )
 
+// IbcUnavailableError defines the ABI error raised when the IBC breaker
+// rejects a client update.
+const IbcUnavailableError = "IbcUnavailable"
+
 const (
 	UpdateResultSuccess      uint8 = 0
 	UpdateResultMisbehaviour uint8 = 1
@@ -51,6 +56,10 @@ func (p *Precompile) UpdateClient(
 		)
 	}
 
+	if err := p.ibcBreakerKeeper.CheckClient(ctx, clientID); err != nil {
+		return nil, cmn.NewRevertError(p.Errors[IbcUnavailableError], err, err.Error())
+	}
+
 	clientMsg, err := clienttypes.UnmarshalClientMessage(p.cdc, updateBz)
 	if err != nil {
 		return nil, err
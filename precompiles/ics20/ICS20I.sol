@@ -30,6 +30,11 @@ struct Hop {
 /// @dev The interface through which solidity contracts will interact with IBC Transfer (ICS20)
 /// @custom:address 0x0000000000000000000000000000000000000802
 interface ICS20I {
+    /// @dev Raised when the IBC breaker has IBC disabled or the transfer uses
+    /// a denied channel, client or denom.
+    /// @param reason The reason reported by the breaker.
+    error IbcUnavailable(string reason);
+
     /// @dev Emitted when an ICS-20 transfer is executed.
     /// @param sender The address of the sender.
     /// @param receiver The address of the receiver.
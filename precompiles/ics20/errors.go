@@ -1,5 +1,9 @@
 package ics20
 
+// IbcUnavailableError defines the ABI error raised when the IBC breaker
+// rejects a transfer.
+const IbcUnavailableError = "IbcUnavailable"
+
 const (
 	// ErrInvalidSourcePort is raised when the source port is invalid.
 	ErrInvalidSourcePort = "invalid source port"
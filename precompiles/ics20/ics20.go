@@ -41,11 +41,12 @@ type Precompile struct {
 	cmn.Precompile
 
 	abi.ABI
-	bankKeeper     cmn.BankKeeper
-	stakingKeeper  cmn.StakingKeeper
-	transferKeeper cmn.TransferKeeper
-	channelKeeper  cmn.ChannelKeeper
-	erc20Keeper    cmn.ERC20Keeper
+	bankKeeper       cmn.BankKeeper
+	stakingKeeper    cmn.StakingKeeper
+	transferKeeper   cmn.TransferKeeper
+	channelKeeper    cmn.ChannelKeeper
+	erc20Keeper      cmn.ERC20Keeper
+	ibcBreakerKeeper cmn.IbcBreakerKeeper
 }
 
 // NewPrecompile creates a new ICS-20 Precompile instance as a
@@ -56,6 +57,7 @@ func NewPrecompile(
 	transferKeeper cmn.TransferKeeper,
 	channelKeeper cmn.ChannelKeeper,
 	erc20Keeper cmn.ERC20Keeper,
+	ibcBreakerKeeper cmn.IbcBreakerKeeper,
 ) *Precompile {
 	return &Precompile{
 		Precompile: cmn.Precompile{
@@ -64,12 +66,13 @@ func NewPrecompile(
 			ContractAddress:       common.HexToAddress(evmtypes.ICS20PrecompileAddress),
 			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
 		},
-		ABI:            ABI,
-		bankKeeper:     bankKeeper,
-		transferKeeper: transferKeeper,
-		channelKeeper:  channelKeeper,
-		stakingKeeper:  stakingKeeper,
-		erc20Keeper:    erc20Keeper,
+		ABI:              ABI,
+		bankKeeper:       bankKeeper,
+		transferKeeper:   transferKeeper,
+		channelKeeper:    channelKeeper,
+		stakingKeeper:    stakingKeeper,
+		erc20Keeper:      erc20Keeper,
+		ibcBreakerKeeper: ibcBreakerKeeper,
 	}
 }
 
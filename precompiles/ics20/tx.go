@@ -1,6 +1,7 @@
 package ics20
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
 	"github.com/cosmos/evm/x/vm/statedb"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
@@ -213,9 +215,18 @@ func (p *Precompile) Transfer(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
 	}
 
+	if err := p.ibcBreakerKeeper.CheckTransfer(ctx, msg.SourcePort, msg.SourceChannel, msg.Token.Denom); err != nil {
+		return nil, cmn.NewRevertError(p.Errors[IbcUnavailableError], err, err.Error())
+	}
+
 	stateDBExp := stateDB.(*statedb.StateDB)
 	res, err := p.transferWithStateDB(ctx, stateDBExp, msg)
 	if err != nil {
+		// the transfer keeper checks the breaker again once ERC20 denoms are
+		// resolved to their Cosmos representation
+		if errors.Is(err, ibcbreakertypes.ErrIbcUnavailable) {
+			return nil, cmn.NewRevertError(p.Errors[IbcUnavailableError], err, err.Error())
+		}
 		return nil, err
 	}
 
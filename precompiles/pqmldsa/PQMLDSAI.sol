@@ -28,6 +28,50 @@ interface PQMLDSAI {
         bytes calldata pubkey,
         bytes calldata signature
     ) external view returns (bool success);
+
+    /**
+     * @notice Verify a batch of PQ ML-DSA signatures of one parameter set.
+     * @dev The arrays must have the same length, at most 256. Malformed entries are invalid.
+     * @param scheme        Parameter set identifier (44, 65, 87).
+     * @param msgHashes     32-byte message hashes.
+     * @param pubkeys       Encoded public key bytes.
+     * @param signatures    Encoded signature bytes.
+     * @param threshold     Number of valid signatures required for thresholdMet.
+     * @return validBitmap  Bit i is set iff signature i is valid.
+     * @return thresholdMet true iff at least threshold signatures are valid.
+     */
+    function verifyBatch(
+        uint8 scheme,
+        bytes32[] calldata msgHashes,
+        bytes[] calldata pubkeys,
+        bytes[] calldata signatures,
+        uint16 threshold
+    ) external view returns (uint256 validBitmap, bool thresholdMet);
+
+    /**
+     * @notice Verify a PQ ML-DSA signature of a full message under a context string.
+     * @dev Gas grows with the message length, which is capped at 64 KiB; longer messages revert.
+     *      `preHash` selects pure ML-DSA (0) or HashML-DSA with the hash function whose NIST OID is
+     *      2.16.840.1.101.3.4.2.`preHash`: 1 SHA2-256, 2 SHA2-384, 3 SHA2-512, 4 SHA2-224,
+     *      5 SHA2-512/224, 6 SHA2-512/256, 7 SHA3-224, 8 SHA3-256, 9 SHA3-384, 10 SHA3-512,
+     *      11 SHAKE128, 12 SHAKE256. The precompile hashes the message itself.
+     *      Unknown selectors and contexts longer than 255 bytes are invalid.
+     * @param scheme    Parameter set identifier (44, 65, 87).
+     * @param message   Message bytes.
+     * @param context   Context string, at most 255 bytes.
+     * @param preHash   Pre-hash selector.
+     * @param pubkey    Encoded public key bytes.
+     * @param signature Encoded signature bytes.
+     * @return success  true iff the signature is valid for (scheme, message, context, preHash, pubkey).
+     */
+    function verifyMessage(
+        uint8 scheme,
+        bytes calldata message,
+        bytes calldata context,
+        uint8 preHash,
+        bytes calldata pubkey,
+        bytes calldata signature
+    ) external view returns (bool success);
 }
 
 /// @dev PQMLDSAI precompile interface instance at the well-known address.
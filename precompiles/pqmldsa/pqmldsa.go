@@ -11,10 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
-	mldsa44 "github.com/trailofbits/ml-dsa/mldsa44"
-	mldsa65 "github.com/trailofbits/ml-dsa/mldsa65"
-	mldsa87 "github.com/trailofbits/ml-dsa/mldsa87"
-	"github.com/trailofbits/ml-dsa/options"
+	"github.com/cosmos/evm/precompiles/pqmldsa/internal"
+	"github.com/cosmos/evm/precompiles/pqmldsa/internal/params"
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -34,7 +32,21 @@ func init() {
 }
 
 const (
-	MethodVerify = "verify"
+	MethodVerify        = "verify"
+	MethodVerifyBatch   = "verifyBatch"
+	MethodVerifyMessage = "verifyMessage"
+
+	// batchGasPercent is the share of the scheme gas charged for every entry
+	// of a batch. ML-DSA has no batch verification, so a batch only saves the
+	// overhead of the calls.
+	batchGasPercent = 90
+
+	// mldsaContext is the context string of the signatures checked by verify
+	// and verifyBatch.
+	mldsaContext = "c8ntinuum-MLDSA-1"
+
+	mldsaPerWordGas      = 30
+	maxMLDSAMessageBytes = 64 * 1024
 
 	mldsa44Gas = 200_000
 	mldsa65Gas = 300_000
@@ -75,21 +87,44 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	}
 
 	method, err := p.MethodById(input[:4])
-	if err != nil || method.Name != MethodVerify {
-		return mldsa87Gas
-	}
-
-	values, err := method.Inputs.Unpack(input[4:])
-	if err != nil || len(values) != 4 {
+	if err != nil {
 		return mldsa87Gas
 	}
 
-	scheme, ok := values[0].(uint8)
-	if !ok {
+	switch method.Name {
+	case MethodVerify:
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil || len(values) != 4 {
+			return mldsa87Gas
+		}
+
+		scheme, ok := values[0].(uint8)
+		if !ok {
+			return mldsa87Gas
+		}
+
+		return gasForMLDSAScheme(scheme)
+	case MethodVerifyBatch:
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil || len(values) != 5 {
+			return mldsa87Gas
+		}
+
+		scheme, _ := values[0].(uint8)
+		msgHashes, _ := values[1].([][32]byte)
+		return cmn.BatchRequiredGas(gasForMLDSAScheme(scheme), len(msgHashes), batchGasPercent)
+	case MethodVerifyMessage:
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil || len(values) != 6 {
+			return mldsa87Gas
+		}
+
+		scheme, _ := values[0].(uint8)
+		message, _ := values[1].([]byte)
+		return cmn.LinearRequiredGasForLength(gasForMLDSAScheme(scheme), uint64(len(message)), mldsaPerWordGas)
+	default:
 		return mldsa87Gas
 	}
-
-	return gasForMLDSAScheme(scheme)
 }
 
 func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
@@ -105,11 +140,24 @@ func (p *Precompile) run(contract *vm.Contract) ([]byte, error) {
 	}
 
 	method, err := p.MethodById(input[:4])
-	if err != nil || method.Name != MethodVerify {
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	switch method.Name {
+	case MethodVerify:
+		return p.verify(method, input[4:])
+	case MethodVerifyBatch:
+		return p.verifyBatch(method, input[4:])
+	case MethodVerifyMessage:
+		return p.verifyMessage(method, input[4:])
+	default:
 		return nil, vm.ErrExecutionReverted
 	}
+}
 
-	values, err := method.Inputs.Unpack(input[4:])
+func (p *Precompile) verify(method *abi.Method, input []byte) ([]byte, error) {
+	values, err := method.Inputs.Unpack(input)
 	if err != nil || len(values) != 4 {
 		return nil, vm.ErrExecutionReverted
 	}
@@ -137,24 +185,96 @@ func (p *Precompile) run(contract *vm.Contract) ([]byte, error) {
 	if !ok {
 		return packBool(method, false)
 	}
-	if !validMLDSAInputSizes(scheme, pubkeyBytes, sigBytes) {
+
+	return packBool(method, verifyMLDSAHash(scheme, msgHash, pubkeyBytes, sigBytes))
+}
+
+// verifyBatch verifies every entry of a batch of one scheme. Malformed entries
+// are invalid, as verify would return false for them.
+func (p *Precompile) verifyBatch(method *abi.Method, input []byte) ([]byte, error) {
+	values, err := method.Inputs.Unpack(input)
+	if err != nil || len(values) != 5 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	scheme := values[0].(uint8)
+	msgHashes := values[1].([][32]byte)
+	pubkeys := values[2].([][]byte)
+	sigs := values[3].([][]byte)
+	threshold := values[4].(uint16)
+
+	n, err := cmn.BatchSize(len(msgHashes), len(pubkeys), len(sigs))
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	valid := make([]bool, n)
+	for i := range n {
+		valid[i] = verifyMLDSAHash(scheme, msgHashes[i], pubkeys[i], sigs[i])
+	}
+
+	return cmn.PackBatchResult(method, valid, threshold)
+}
+
+// verifyMessage verifies an ML-DSA or HashML-DSA signature of a message under
+// a caller-chosen context string. Oversized messages revert, and an unknown
+// pre-hash selector or an oversized context is invalid.
+func (p *Precompile) verifyMessage(method *abi.Method, input []byte) ([]byte, error) {
+	values, err := method.Inputs.Unpack(input)
+	if err != nil || len(values) != 6 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	scheme := values[0].(uint8)
+	message := values[1].([]byte)
+	context := values[2].([]byte)
+	preHash := values[3].(uint8)
+	pubkeyBytes := values[4].([]byte)
+	sigBytes := values[5].([]byte)
+
+	if len(message) > maxMLDSAMessageBytes {
+		return nil, fmt.Errorf("ml-dsa message exceeds %d bytes", maxMLDSAMessageBytes)
+	}
+
+	mPrime, ok := cmn.MessageRepresentative(preHash, context, message)
+	if !ok {
 		return packBool(method, false)
 	}
+	return packBool(method, verifyMLDSA(scheme, mPrime, pubkeyBytes, sigBytes))
+}
+
+// verifyMLDSAHash verifies a pure ML-DSA signature of a message hash under
+// mldsaContext.
+func verifyMLDSAHash(scheme uint8, msgHash [32]byte, pubkeyBytes, sigBytes []byte) bool {
+	mPrime, _ := cmn.MessageRepresentative(cmn.PreHashNone, []byte(mldsaContext), msgHash[:])
+	return verifyMLDSA(scheme, mPrime, pubkeyBytes, sigBytes)
+}
+
+// verifyMLDSA verifies a signature of the message representative M' of
+// FIPS 204.
+func verifyMLDSA(scheme uint8, mPrime, pubkeyBytes, sigBytes []byte) bool {
+	if !validMLDSAInputSizes(scheme, pubkeyBytes, sigBytes) {
+		return false
+	}
 
 	// Dispatch by scheme
-	var valid bool
+	var cfg *params.Cfg
 	switch scheme {
 	case 44:
-		valid = verify44(msgHash[:], pubkeyBytes, sigBytes)
+		cfg = params.MLDSA44Cfg
 	case 65:
-		valid = verify65(msgHash[:], pubkeyBytes, sigBytes)
+		cfg = params.MLDSA65Cfg
 	case 87:
-		valid = verify87(msgHash[:], pubkeyBytes, sigBytes)
+		cfg = params.MLDSA87Cfg
 	default:
-		valid = false
+		return false
 	}
 
-	return packBool(method, valid)
+	pub, err := internal.PkDecode(cfg, pubkeyBytes)
+	if err != nil || pub == nil {
+		return false
+	}
+	return pub.VerifyInternal(mPrime, sigBytes)
 }
 
 func gasForMLDSAScheme(scheme uint8) uint64 {
@@ -190,27 +310,3 @@ func packBool(method *abi.Method, v bool) ([]byte, error) {
 	}
 	return out, nil
 }
-
-func verify44(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	pub, err := mldsa44.PublicKeyFromBytes(pubkeyBytes)
-	if err != nil || pub == nil {
-		return false
-	}
-	return pub.VerifyWithOptions(msg, sigBytes, &options.Options{Context: "c8ntinuum-MLDSA-1"})
-}
-
-func verify65(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	pub, err := mldsa65.PublicKeyFromBytes(pubkeyBytes)
-	if err != nil || pub == nil {
-		return false
-	}
-	return pub.VerifyWithOptions(msg, sigBytes, &options.Options{Context: "c8ntinuum-MLDSA-1"})
-}
-
-func verify87(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	pub, err := mldsa87.PublicKeyFromBytes(pubkeyBytes)
-	if err != nil || pub == nil {
-		return false
-	}
-	return pub.VerifyWithOptions(msg, sigBytes, &options.Options{Context: "c8ntinuum-MLDSA-1"})
-}
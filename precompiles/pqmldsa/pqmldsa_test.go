@@ -1,12 +1,25 @@
 package pqmldsa
 
 import (
+	"compress/gzip"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
 	"testing"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/pqmldsa/internal/params"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
+
+	mldsa44 "github.com/trailofbits/ml-dsa/mldsa44"
+	"github.com/trailofbits/ml-dsa/options"
 )
 
 func TestRequiredGasUsesSchemeTier(t *testing.T) {
@@ -55,3 +68,346 @@ func TestRunReturnsFalseForWrongSizedInputs(t *testing.T) {
 	require.Len(t, decoded, 1)
 	require.False(t, decoded[0].(bool))
 }
+
+func TestVerifyBatch(t *testing.T) {
+	precompile, err := NewPrecompile(200_000)
+	require.NoError(t, err)
+
+	msgHashes := make([][32]byte, 3)
+	pubkeys := make([][]byte, 3)
+	sigs := make([][]byte, 3)
+	for i := range msgHashes {
+		pub, priv, err := mldsa44.GenerateKeyPair(rand.Reader)
+		require.NoError(t, err)
+
+		msgHashes[i] = sha256.Sum256([]byte{'h', 'e', 'a', 'd', 'e', 'r', byte(i)})
+		sigs[i], err = priv.Sign(rand.Reader, msgHashes[i][:], &options.Options{Context: "c8ntinuum-MLDSA-1"})
+		require.NoError(t, err)
+		pubkeys[i] = pub.Bytes()
+	}
+
+	bitmap, met := runVerifyBatchCall(t, precompile, 44, msgHashes, pubkeys, sigs, 3)
+	require.Equal(t, int64(0b111), bitmap.Int64())
+	require.True(t, met)
+
+	// The entries are invalid under another scheme.
+	bitmap, met = runVerifyBatchCall(t, precompile, 65, msgHashes, pubkeys, sigs, 1)
+	require.Zero(t, bitmap.Sign())
+	require.False(t, met)
+
+	msgHashes[1][0] ^= 0x01
+	bitmap, met = runVerifyBatchCall(t, precompile, 44, msgHashes, pubkeys, sigs, 2)
+	require.Equal(t, int64(0b101), bitmap.Int64())
+	require.True(t, met)
+}
+
+func TestVerifyBatchRevertsOnLengthMismatch(t *testing.T) {
+	precompile, err := NewPrecompile(200_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyBatch]
+	args, err := method.Inputs.Pack(uint8(44), make([][32]byte, 2), make([][]byte, 2), make([][]byte, 1), uint16(0))
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	_, err = precompile.Run(nil, contract, false)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+}
+
+func TestRequiredGasDiscountsBatches(t *testing.T) {
+	precompile, err := NewPrecompile(200_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyBatch]
+	args, err := method.Inputs.Pack(uint8(65), make([][32]byte, 10), make([][]byte, 10), make([][]byte, 10), uint16(0))
+	require.NoError(t, err)
+
+	input := append(method.ID, args...)
+	require.Equal(t, uint64(30_000+10*270_000), precompile.RequiredGas(input))
+}
+
+// acvpHashAlgs maps the ACVP names of the pre-hash functions to their
+// selectors.
+var acvpHashAlgs = map[string]uint8{
+	"none":         cmn.PreHashNone,
+	"SHA2-224":     cmn.PreHashSHA224,
+	"SHA2-256":     cmn.PreHashSHA256,
+	"SHA2-384":     cmn.PreHashSHA384,
+	"SHA2-512":     cmn.PreHashSHA512,
+	"SHA2-512/224": cmn.PreHashSHA512_224,
+	"SHA2-512/256": cmn.PreHashSHA512_256,
+	"SHA3-224":     cmn.PreHashSHA3_224,
+	"SHA3-256":     cmn.PreHashSHA3_256,
+	"SHA3-384":     cmn.PreHashSHA3_384,
+	"SHA3-512":     cmn.PreHashSHA3_512,
+	"SHAKE-128":    cmn.PreHashSHAKE128,
+	"SHAKE-256":    cmn.PreHashSHAKE256,
+}
+
+// acvpSigGen holds ML-DSA sigGen vectors of the external interface, taken
+// from release 1.1.0.38 of the NIST ACVP server repository. Every parameter
+// set has pure vectors and a pre-hash vector for every hash function.
+type acvpSigGen struct {
+	TestGroups []struct {
+		ParameterSet string `json:"parameterSet"`
+		PreHash      string `json:"preHash"`
+		Tests        []struct {
+			TcID      int     `json:"tcId"`
+			Message   hexData `json:"message"`
+			Context   hexData `json:"context"`
+			HashAlg   string  `json:"hashAlg"`
+			PK        hexData `json:"pk"`
+			Signature hexData `json:"signature"`
+		} `json:"tests"`
+	} `json:"testGroups"`
+}
+
+type hexData []byte
+
+func (h *hexData) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	bz, err := hex.DecodeString(s)
+	*h = bz
+	return err
+}
+
+func TestVerifyMessageACVPVectors(t *testing.T) {
+	precompile, err := NewPrecompile(200_000)
+	require.NoError(t, err)
+
+	file, err := os.Open("testdata/acvp_siggen.json.gz")
+	require.NoError(t, err)
+	defer file.Close()
+	zr, err := gzip.NewReader(file)
+	require.NoError(t, err)
+
+	var vectors acvpSigGen
+	require.NoError(t, json.NewDecoder(zr).Decode(&vectors))
+	require.Len(t, vectors.TestGroups, 6)
+
+	schemes := map[string]uint8{"ML-DSA-44": 44, "ML-DSA-65": 65, "ML-DSA-87": 87}
+	for _, group := range vectors.TestGroups {
+		scheme, ok := schemes[group.ParameterSet]
+		require.True(t, ok, group.ParameterSet)
+
+		for _, tc := range group.Tests {
+			t.Run(fmt.Sprintf("%s/%s/%d", group.ParameterSet, group.PreHash, tc.TcID), func(t *testing.T) {
+				preHash, ok := acvpHashAlgs[tc.HashAlg]
+				require.True(t, ok, tc.HashAlg)
+
+				require.True(t, runVerifyMessageCall(t, precompile, scheme, tc.Message, tc.Context, preHash, tc.PK, tc.Signature))
+
+				// The signature binds the message, the context and the
+				// pre-hash function.
+				msg := append([]byte{}, tc.Message...)
+				msg[0] ^= 0x01
+				require.False(t, runVerifyMessageCall(t, precompile, scheme, msg, tc.Context, preHash, tc.PK, tc.Signature))
+				require.False(t, runVerifyMessageCall(t, precompile, scheme, tc.Message, append(tc.Context, 0), preHash, tc.PK, tc.Signature))
+				otherPreHash := cmn.PreHashSHA256
+				if preHash == otherPreHash {
+					otherPreHash = cmn.PreHashNone
+				}
+				require.False(t, runVerifyMessageCall(t, precompile, scheme, tc.Message, tc.Context, otherPreHash, tc.PK, tc.Signature))
+			})
+		}
+	}
+}
+
+// acvpSigVer holds ML-DSA sigVer vectors of the internal interface, which
+// sign the message representative M' directly. They are the NIST ACVP
+// vectors shipped in the testdata of cloudflare/circl v1.6.5, with the
+// expected results merged in, and most of them are modified signatures that
+// must be rejected.
+type acvpSigVer struct {
+	TestGroups []struct {
+		ParameterSet string  `json:"parameterSet"`
+		PK           hexData `json:"pk"`
+		Tests        []struct {
+			TcID       int     `json:"tcId"`
+			Message    hexData `json:"message"`
+			Signature  hexData `json:"signature"`
+			TestPassed bool    `json:"testPassed"`
+		} `json:"tests"`
+	} `json:"testGroups"`
+}
+
+func TestVerifyMLDSAACVPSigVerVectors(t *testing.T) {
+	file, err := os.Open("testdata/acvp_sigver.json.gz")
+	require.NoError(t, err)
+	defer file.Close()
+	zr, err := gzip.NewReader(file)
+	require.NoError(t, err)
+
+	var vectors acvpSigVer
+	require.NoError(t, json.NewDecoder(zr).Decode(&vectors))
+	require.Len(t, vectors.TestGroups, 3)
+
+	schemes := map[string]struct {
+		scheme uint8
+		cfg    *params.Cfg
+	}{
+		"ML-DSA-44": {44, params.MLDSA44Cfg},
+		"ML-DSA-65": {65, params.MLDSA65Cfg},
+		"ML-DSA-87": {87, params.MLDSA87Cfg},
+	}
+	for _, group := range vectors.TestGroups {
+		s, ok := schemes[group.ParameterSet]
+		require.True(t, ok, group.ParameterSet)
+
+		for _, tc := range group.Tests {
+			t.Run(fmt.Sprintf("%s/%d", group.ParameterSet, tc.TcID), func(t *testing.T) {
+				require.Equal(t, tc.TestPassed, verifyMLDSA(s.scheme, tc.Message, group.PK, tc.Signature))
+				if !tc.TestPassed {
+					return
+				}
+
+				// The vectors do not say why a signature is rejected, so
+				// valid ones are also checked with an oversized z and
+				// malformed hints.
+				cfg := s.cfg
+				zOffset := int(cfg.Lambda / 4)
+				hOffset := len(tc.Signature) - int(cfg.Omega) - int(cfg.K)
+				hints := tc.Signature[len(tc.Signature)-1]
+				require.Less(t, hints, cfg.Omega)
+
+				for name, malleate := range map[string]func(sig []byte){
+					// A zero encoding is the coefficient gamma1 of z, beyond
+					// the gamma1 - beta bound.
+					"z at gamma1": func(sig []byte) {
+						sig[zOffset] = 0
+						sig[zOffset+1] = 0
+						sig[zOffset+2] &^= byte(1)<<(cfg.LogGamma1+1-16) - 1
+					},
+					"hint count beyond omega": func(sig []byte) {
+						sig[len(sig)-1] = cfg.Omega + 1
+					},
+					"decreasing hint counts": func(sig []byte) {
+						sig[hOffset+int(cfg.Omega)] = hints + 1
+					},
+					"nonzero hint padding": func(sig []byte) {
+						sig[hOffset+int(cfg.Omega)-1] = 1
+					},
+				} {
+					sig := append([]byte{}, tc.Signature...)
+					malleate(sig)
+					require.False(t, verifyMLDSA(s.scheme, tc.Message, group.PK, sig), name)
+				}
+			})
+		}
+	}
+}
+
+func TestVerifyMessageMatchesVerify(t *testing.T) {
+	precompile, err := NewPrecompile(200_000)
+	require.NoError(t, err)
+
+	pub, priv, err := mldsa44.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	msgHash := sha256.Sum256([]byte("header"))
+	sig, err := priv.Sign(rand.Reader, msgHash[:], &options.Options{Context: mldsaContext})
+	require.NoError(t, err)
+
+	require.True(t, runVerifyMessageCall(t, precompile, 44, msgHash[:], []byte(mldsaContext), cmn.PreHashNone, pub.Bytes(), sig))
+	require.False(t, runVerifyMessageCall(t, precompile, 44, msgHash[:], nil, cmn.PreHashNone, pub.Bytes(), sig))
+	require.False(t, runVerifyMessageCall(t, precompile, 65, msgHash[:], []byte(mldsaContext), cmn.PreHashNone, pub.Bytes(), sig))
+
+	// Unknown pre-hash selectors and oversized contexts are invalid.
+	require.False(t, runVerifyMessageCall(t, precompile, 44, msgHash[:], []byte(mldsaContext), 13, pub.Bytes(), sig))
+	require.False(t, runVerifyMessageCall(t, precompile, 44, msgHash[:], make([]byte, cmn.MaxContextBytes+1), cmn.PreHashNone, pub.Bytes(), sig))
+}
+
+func TestVerifyMessageRevertsOnOversizedMessage(t *testing.T) {
+	precompile, err := NewPrecompile(200_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyMessage]
+	args, err := method.Inputs.Pack(uint8(44), make([]byte, maxMLDSAMessageBytes+1), []byte{}, cmn.PreHashNone, []byte{}, []byte{})
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	_, err = precompile.Run(nil, contract, false)
+	require.ErrorContains(t, err, "exceeds")
+}
+
+func TestRequiredGasMetersMessageLength(t *testing.T) {
+	precompile, err := NewPrecompile(200_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyMessage]
+	for _, tc := range []struct {
+		scheme  uint8
+		msgSize int
+		want    uint64
+	}{
+		{scheme: 44, msgSize: 0, want: mldsa44Gas},
+		{scheme: 65, msgSize: 1, want: mldsa65Gas + 30},
+		{scheme: 87, msgSize: 100, want: mldsa87Gas + 4*30},
+	} {
+		args, err := method.Inputs.Pack(tc.scheme, make([]byte, tc.msgSize), []byte("ctx"), cmn.PreHashSHA256, []byte{}, []byte{})
+		require.NoError(t, err)
+
+		input := append(method.ID, args...)
+		require.Equal(t, tc.want, precompile.RequiredGas(input))
+	}
+}
+
+func runVerifyMessageCall(
+	t *testing.T,
+	precompile *Precompile,
+	scheme uint8,
+	message, context []byte,
+	preHash uint8,
+	pubkey, sig []byte,
+) bool {
+	t.Helper()
+
+	method := ABI.Methods[MethodVerifyMessage]
+	args, err := method.Inputs.Pack(scheme, message, context, preHash, pubkey, sig)
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	out, err := precompile.Run(nil, contract, false)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+
+	return values[0].(bool)
+}
+
+func runVerifyBatchCall(
+	t *testing.T,
+	precompile *Precompile,
+	scheme uint8,
+	msgHashes [][32]byte,
+	pubkeys, sigs [][]byte,
+	threshold uint16,
+) (*big.Int, bool) {
+	t.Helper()
+
+	method := ABI.Methods[MethodVerifyBatch]
+	args, err := method.Inputs.Pack(scheme, msgHashes, pubkeys, sigs, threshold)
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	out, err := precompile.Run(nil, contract, false)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	return values[0].(*big.Int), values[1].(bool)
+}
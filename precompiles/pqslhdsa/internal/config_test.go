@@ -0,0 +1,7 @@
+package internal_test
+
+import (
+	"github.com/cosmos/evm/precompiles/pqslhdsa/internal/slh_dsa"
+)
+
+var testingParamSet = slh_dsa.SlhDsaShake_128f()
@@ -0,0 +1,75 @@
+package internal_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"testing"
+
+	"github.com/cosmos/evm/precompiles/pqslhdsa/internal"
+)
+
+func TestMGF1(t *testing.T) {
+	// Test vectors
+	testCases := []struct {
+		name     string
+		seed     string
+		length   uint32
+		hashFunc func() hash.Hash
+		expected string
+	}{
+		{
+			name:     "SHA-256 Basic",
+			seed:     "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			length:   32,
+			hashFunc: sha256.New,
+			expected: "c03f158d5a21c640563a1045774d5928ec4afd4cb550bb28dbbe5099cf51380a",
+		},
+		{
+			name:     "SHA-512 Basic",
+			seed:     "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			length:   64,
+			hashFunc: sha512.New,
+			expected: "8feff3bbf4875c9d37fb2de60fb4e1baa796032d65953a40fa0164ce89343d97" +
+				"5e4b932adc52fae6466b495a35d87fe23de269da48b2a1e23dfecdca17d035ff",
+		},
+		{
+			name:     "Short output",
+			seed:     "0123456789abcdef",
+			length:   16,
+			hashFunc: sha256.New,
+			expected: "3f7ab49a79d4788372572a77de9e48b2",
+		},
+		{
+			name:     "Long output (multiple hash iterations)",
+			seed:     "0123456789abcdef",
+			length:   100,
+			hashFunc: sha256.New,
+			expected: "3f7ab49a79d4788372572a77de9e48b28833d1bbc194b70e7411682051c024bb" +
+				"067ff37759348e7a82795414d0b6f53887b345aa6a5f1ec17c3110426fc8ef53" +
+				"d43b8fd26b186431cbb2171f908bbdc5d4e77d86c27ef37fd87feae71a10b75422228607",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seed, err := hex.DecodeString(tc.seed)
+			if err != nil {
+				t.Fatalf("Failed to decode seed: %v", err)
+			}
+
+			expected, err := hex.DecodeString(tc.expected)
+			if err != nil {
+				t.Fatalf("Failed to decode expected output: %v", err)
+			}
+
+			result := internal.MGF1(seed, tc.length, tc.hashFunc())
+
+			if !bytes.Equal(result, expected) {
+				t.Errorf("MGF1 output mismatch\nExpected: %x\nGot:      %x", expected, result)
+			}
+		})
+	}
+}
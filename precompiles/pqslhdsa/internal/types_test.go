@@ -0,0 +1,53 @@
+package internal_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/cosmos/evm/precompiles/pqslhdsa/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexResidue(t *testing.T) {
+	idx := internal.Index{0x123, 0x456, 0x789}
+	assert.Equal(t, uint32(0x9), idx.Residue(4))
+	assert.Equal(t, uint32(0x89), idx.Residue(8))
+	assert.Equal(t, uint32(0x789), idx.Residue(12))
+}
+
+func TestIndexModuloPow2(t *testing.T) {
+	idx := internal.Index{0x11112222, 0x33445566, 0x789abcde}
+	tests := []struct {
+		b  uint8
+		hi uint32
+		mi uint32
+		lo uint32
+	}{
+		{1, 0, 0, 0},
+		{2, 0, 0, 2},
+		{4, 0, 0, 0xe},
+		{16, 0, 0, 0xbcde},
+		{32, 0, 0, 0x789abcde},
+		{36, 0, 0x6, 0x789abcde},
+		{64, 0, 0x33445566, 0x789abcde},
+		{92, 0x01112222, 0x33445566, 0x789abcde},
+	}
+	for _, tt := range tests {
+		out := idx.ModuloPow2(tt.b)
+		assert.Equal(t, tt.hi, out[0])
+		assert.Equal(t, tt.mi, out[1])
+		assert.Equal(t, tt.lo, out[2])
+	}
+}
+
+func TestAddressCompress(t *testing.T) {
+	adrs := internal.NewAddress()
+	adrs.SetLayerAddress(0x01020304)
+	adrs.SetTreeAddress(internal.Index{0x05060708, 0x090a0b0c, 0x0d0e0f10})
+	adrs.SetTypeAndClear(0x11121314)
+	adrs.SetKeyPairAddress(0x15161718)
+	adrs.SetTreeHeight(0x191a1b1c)
+	adrs.SetTreeIndex(0x1d1e1f20)
+	c := adrs.Compress().Bytes()
+	assert.Equal(t, "04090a0b0c0d0e0f101415161718191a1b1c1d1e1f20", hex.EncodeToString(c))
+}
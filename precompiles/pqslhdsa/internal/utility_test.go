@@ -0,0 +1,67 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/evm/precompiles/pqslhdsa/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenLen2(t *testing.T) {
+	tests := []struct {
+		n   uint32
+		lgw uint32
+		out uint32
+	}{
+		{16, 4, 3},
+		{24, 4, 3},
+		{32, 4, 3},
+	}
+	for _, tt := range tests {
+		actual := internal.GenLen2(tt.n, tt.lgw)
+		assert.Equal(t, tt.out, actual)
+	}
+}
+
+func TestToIntAndByte(t *testing.T) {
+	tests := []struct {
+		X []byte
+		n uint32
+	}{
+		{[]byte{0x1}, 1},
+		{[]byte{0xFF}, 255},
+		{[]byte{0x1, 0x00}, 256},
+		{[]byte{0xFF, 0xFF}, 65535},
+		{[]byte{0x1, 0x00, 0x00}, 65536},
+	}
+	for _, tt := range tests {
+		actual := internal.ToInt(tt.X)
+		assert.Equal(t, tt.n, actual)
+		numBytes := uint8(len(tt.X))
+		reverse := internal.ToByte(actual, numBytes)
+		assert.Equal(t, tt.X, reverse)
+	}
+}
+
+func TestToBase2b(t *testing.T) {
+	tests := []struct {
+		X        []byte
+		b        uint32
+		out_len  uint32
+		expected []uint32
+	}{
+		{[]byte{0x12, 0x34, 0x56, 0x78}, 4, 8, []uint32{1, 2, 3, 4, 5, 6, 7, 8}},
+		{[]byte{0x12, 0x34, 0x56, 0x78}, 16, 2, []uint32{0x1234, 0x5678}},
+		{[]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}, 16, 4, []uint32{0x1234, 0x5678, 0x9abc, 0xdef0}},
+		{[]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}, 32, 2, []uint32{0x12345678, 0x9abcdef0}},
+	}
+	for _, tt := range tests {
+		actual := internal.ToBase2b(tt.X, tt.b, tt.out_len)
+		assert.Equal(t, len(tt.expected), len(actual))
+		if len(tt.expected) == len(actual) {
+			for i := range tt.expected {
+				assert.Equal(t, tt.expected[i], actual[i])
+			}
+		}
+	}
+}
@@ -11,7 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
-	"github.com/trailofbits/go-slh-dsa/slh_dsa"
+	"github.com/cosmos/evm/precompiles/pqslhdsa/internal"
+	"github.com/cosmos/evm/precompiles/pqslhdsa/internal/slh_dsa"
 )
 
 // Make sure we implement vm.PrecompiledContract
@@ -31,10 +32,22 @@ func init() {
 }
 
 const (
-	MethodVerify = "verify"
+	MethodVerify        = "verify"
+	MethodVerifyBatch   = "verifyBatch"
+	MethodVerifyMessage = "verifyMessage"
 
+	// batchGasPercent is the share of the parameter set gas charged for every
+	// entry of a batch. SLH-DSA has no batch verification, so a batch only
+	// saves the overhead of the calls.
+	batchGasPercent = 90
+
+	// slhContext is the context string of the signatures checked by verify
+	// and verifyBatch.
 	slhContext = "c8ntinuum-SLHDSA-1"
 
+	slhPerWordGas      = 30
+	maxSLHMessageBytes = 64 * 1024
+
 	slh128FGas = 250_000
 	slh128SGas = 500_000
 	slh192FGas = 750_000
@@ -87,21 +100,44 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	}
 
 	method, err := p.MethodById(input[:4])
-	if err != nil || method.Name != MethodVerify {
-		return slh256SGas
-	}
-
-	values, err := method.Inputs.Unpack(input[4:])
-	if err != nil || len(values) != 4 {
+	if err != nil {
 		return slh256SGas
 	}
 
-	paramID, ok := values[0].(uint8)
-	if !ok {
+	switch method.Name {
+	case MethodVerify:
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil || len(values) != 4 {
+			return slh256SGas
+		}
+
+		paramID, ok := values[0].(uint8)
+		if !ok {
+			return slh256SGas
+		}
+
+		return gasForSLHParam(paramID)
+	case MethodVerifyBatch:
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil || len(values) != 5 {
+			return slh256SGas
+		}
+
+		paramID, _ := values[0].(uint8)
+		msgHashes, _ := values[1].([][32]byte)
+		return cmn.BatchRequiredGas(gasForSLHParam(paramID), len(msgHashes), batchGasPercent)
+	case MethodVerifyMessage:
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil || len(values) != 6 {
+			return slh256SGas
+		}
+
+		paramID, _ := values[0].(uint8)
+		message, _ := values[1].([]byte)
+		return cmn.LinearRequiredGasForLength(gasForSLHParam(paramID), uint64(len(message)), slhPerWordGas)
+	default:
 		return slh256SGas
 	}
-
-	return gasForSLHParam(paramID)
 }
 
 func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
@@ -113,11 +149,24 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, e
 	}
 
 	method, err := p.MethodById(input[:4])
-	if err != nil || method.Name != MethodVerify {
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	switch method.Name {
+	case MethodVerify:
+		return p.verify(method, input[4:])
+	case MethodVerifyBatch:
+		return p.verifyBatch(method, input[4:])
+	case MethodVerifyMessage:
+		return p.verifyMessage(method, input[4:])
+	default:
 		return nil, vm.ErrExecutionReverted
 	}
+}
 
-	values, err := method.Inputs.Unpack(input[4:])
+func (p *Precompile) verify(method *abi.Method, input []byte) ([]byte, error) {
+	values, err := method.Inputs.Unpack(input)
 	if err != nil || len(values) != 4 {
 		return nil, vm.ErrExecutionReverted
 	}
@@ -146,255 +195,146 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, e
 		return packBool(method, false)
 	}
 
-	// Dispatch by paramId
-	var valid bool
-	switch slhID(paramId) {
-	case SLH_SHA2_128F:
-		valid = verifySHA2_128F(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHA2_128S:
-		valid = verifySHA2_128S(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHA2_192F:
-		valid = verifySHA2_192F(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHA2_192S:
-		valid = verifySHA2_192S(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHA2_256F:
-		valid = verifySHA2_256F(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHA2_256S:
-		valid = verifySHA2_256S(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHAKE_128F:
-		valid = verifySHAKE_128F(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHAKE_128S:
-		valid = verifySHAKE_128S(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHAKE_192F:
-		valid = verifySHAKE_192F(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHAKE_192S:
-		valid = verifySHAKE_192S(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHAKE_256F:
-		valid = verifySHAKE_256F(msgHash[:], pubkeyBytes, sigBytes)
-	case SLH_SHAKE_256S:
-		valid = verifySHAKE_256S(msgHash[:], pubkeyBytes, sigBytes)
-	default:
-		valid = false
-	}
-
-	return packBool(method, valid)
+	return packBool(method, verifySLHHash(paramId, msgHash, pubkeyBytes, sigBytes))
 }
 
-func gasForSLHParam(paramID uint8) uint64 {
-	switch slhID(paramID) {
-	case SLH_SHA2_128F, SLH_SHAKE_128F:
-		return slh128FGas
-	case SLH_SHA2_128S, SLH_SHAKE_128S:
-		return slh128SGas
-	case SLH_SHA2_192F, SLH_SHAKE_192F:
-		return slh192FGas
-	case SLH_SHA2_192S, SLH_SHAKE_192S:
-		return slh192SGas
-	case SLH_SHA2_256F, SLH_SHAKE_256F:
-		return slh256FGas
-	case SLH_SHA2_256S, SLH_SHAKE_256S:
-		return slh256SGas
-	default:
-		return slh256SGas
-	}
-}
-
-func packBool(method *abi.Method, v bool) ([]byte, error) {
-	out, err := method.Outputs.Pack(v)
-	if err != nil {
-		return nil, errors.New("failed to ABI-pack result")
-	}
-	return out, nil
-}
-
-func verifySHA2_128F(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaSha2_128f()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
-	}
-
-	return pk.Verify(sig, msg, []byte(slhContext))
-}
-
-func verifySHA2_128S(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaSha2_128s()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
-	}
-
-	return pk.Verify(sig, msg, []byte(slhContext))
-}
-
-func verifySHA2_192F(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaSha2_192f()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
+// verifyBatch verifies every entry of a batch of one parameter set.
+// Malformed entries are invalid, as verify would return false for them.
+func (p *Precompile) verifyBatch(method *abi.Method, input []byte) ([]byte, error) {
+	values, err := method.Inputs.Unpack(input)
+	if err != nil || len(values) != 5 {
+		return nil, vm.ErrExecutionReverted
 	}
 
-	return pk.Verify(sig, msg, []byte(slhContext))
-}
+	paramID := values[0].(uint8)
+	msgHashes := values[1].([][32]byte)
+	pubkeys := values[2].([][]byte)
+	sigs := values[3].([][]byte)
+	threshold := values[4].(uint16)
 
-func verifySHA2_192S(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaSha2_192s()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
+	n, err := cmn.BatchSize(len(msgHashes), len(pubkeys), len(sigs))
 	if err != nil {
-		return false
+		return nil, vm.ErrExecutionReverted
 	}
 
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
+	valid := make([]bool, n)
+	for i := range n {
+		valid[i] = verifySLHHash(paramID, msgHashes[i], pubkeys[i], sigs[i])
 	}
 
-	return pk.Verify(sig, msg, []byte(slhContext))
+	return cmn.PackBatchResult(method, valid, threshold)
 }
 
-func verifySHA2_256F(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaSha2_256f()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
+// verifyMessage verifies an SLH-DSA or HashSLH-DSA signature of a message
+// under a caller-chosen context string. Oversized messages revert, and an
+// unknown pre-hash selector or an oversized context is invalid.
+func (p *Precompile) verifyMessage(method *abi.Method, input []byte) ([]byte, error) {
+	values, err := method.Inputs.Unpack(input)
+	if err != nil || len(values) != 6 {
+		return nil, vm.ErrExecutionReverted
 	}
 
-	return pk.Verify(sig, msg, []byte(slhContext))
-}
-
-func verifySHA2_256S(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaSha2_256s()
+	paramID := values[0].(uint8)
+	message := values[1].([]byte)
+	context := values[2].([]byte)
+	preHash := values[3].(uint8)
+	pubkeyBytes := values[4].([]byte)
+	sigBytes := values[5].([]byte)
 
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
+	if len(message) > maxSLHMessageBytes {
+		return nil, fmt.Errorf("slh-dsa message exceeds %d bytes", maxSLHMessageBytes)
 	}
 
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
+	mPrime, ok := cmn.MessageRepresentative(preHash, context, message)
+	if !ok {
+		return packBool(method, false)
 	}
-
-	return pk.Verify(sig, msg, []byte(slhContext))
+	return packBool(method, verifySLH(paramID, mPrime, pubkeyBytes, sigBytes))
 }
 
-func verifySHAKE_128F(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaShake_128f()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
-	}
-
-	return pk.Verify(sig, msg, []byte(slhContext))
+// verifySLHHash verifies a pure SLH-DSA signature of a message hash under
+// slhContext.
+func verifySLHHash(paramID uint8, msgHash [32]byte, pubkeyBytes, sigBytes []byte) bool {
+	mPrime, _ := cmn.MessageRepresentative(cmn.PreHashNone, []byte(slhContext), msgHash[:])
+	return verifySLH(paramID, mPrime, pubkeyBytes, sigBytes)
 }
 
-func verifySHAKE_128S(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaShake_128s()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
+// verifySLH verifies a signature of the message representative M' of
+// FIPS 205.
+func verifySLH(paramID uint8, mPrime, pubkeyBytes, sigBytes []byte) bool {
+	params, ok := slhParamSet(paramID)
+	if !ok {
 		return false
 	}
 
-	return pk.Verify(sig, msg, []byte(slhContext))
-}
-
-func verifySHAKE_192F(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaShake_192f()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
+	pk, err := internal.LoadPublicKey(params, pubkeyBytes)
 	if err != nil {
 		return false
 	}
 
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
+	sig, err := internal.LoadSignature(params, sigBytes)
 	if err != nil {
 		return false
 	}
 
-	return pk.Verify(sig, msg, []byte(slhContext))
+	return internal.SLHVerifyInternal(params, mPrime, sig, pk)
 }
 
-func verifySHAKE_192S(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaShake_192s()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
+func slhParamSet(paramID uint8) (internal.ParamSet, bool) {
+	// Dispatch by paramId
+	switch slhID(paramID) {
+	case SLH_SHA2_128F:
+		return slh_dsa.SlhDsaSha2_128f(), true
+	case SLH_SHA2_128S:
+		return slh_dsa.SlhDsaSha2_128s(), true
+	case SLH_SHA2_192F:
+		return slh_dsa.SlhDsaSha2_192f(), true
+	case SLH_SHA2_192S:
+		return slh_dsa.SlhDsaSha2_192s(), true
+	case SLH_SHA2_256F:
+		return slh_dsa.SlhDsaSha2_256f(), true
+	case SLH_SHA2_256S:
+		return slh_dsa.SlhDsaSha2_256s(), true
+	case SLH_SHAKE_128F:
+		return slh_dsa.SlhDsaShake_128f(), true
+	case SLH_SHAKE_128S:
+		return slh_dsa.SlhDsaShake_128s(), true
+	case SLH_SHAKE_192F:
+		return slh_dsa.SlhDsaShake_192f(), true
+	case SLH_SHAKE_192S:
+		return slh_dsa.SlhDsaShake_192s(), true
+	case SLH_SHAKE_256F:
+		return slh_dsa.SlhDsaShake_256f(), true
+	case SLH_SHAKE_256S:
+		return slh_dsa.SlhDsaShake_256s(), true
+	default:
+		return internal.ParamSet{}, false
 	}
-
-	return pk.Verify(sig, msg, []byte(slhContext))
 }
 
-func verifySHAKE_256F(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaShake_256f()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
-	if err != nil {
-		return false
+func gasForSLHParam(paramID uint8) uint64 {
+	switch slhID(paramID) {
+	case SLH_SHA2_128F, SLH_SHAKE_128F:
+		return slh128FGas
+	case SLH_SHA2_128S, SLH_SHAKE_128S:
+		return slh128SGas
+	case SLH_SHA2_192F, SLH_SHAKE_192F:
+		return slh192FGas
+	case SLH_SHA2_192S, SLH_SHAKE_192S:
+		return slh192SGas
+	case SLH_SHA2_256F, SLH_SHAKE_256F:
+		return slh256FGas
+	case SLH_SHA2_256S, SLH_SHAKE_256S:
+		return slh256SGas
+	default:
+		return slh256SGas
 	}
-
-	return pk.Verify(sig, msg, []byte(slhContext))
 }
 
-func verifySHAKE_256S(msg []byte, pubkeyBytes, sigBytes []byte) bool {
-	params := slh_dsa.SlhDsaShake_256s()
-
-	pk, err := slh_dsa.LoadPublicKey(params, pubkeyBytes)
-	if err != nil {
-		return false
-	}
-
-	sig, err := slh_dsa.LoadSignature(params, sigBytes)
+func packBool(method *abi.Method, v bool) ([]byte, error) {
+	out, err := method.Outputs.Pack(v)
 	if err != nil {
-		return false
+		return nil, errors.New("failed to ABI-pack result")
 	}
-
-	return pk.Verify(sig, msg, []byte(slhContext))
+	return out, nil
 }
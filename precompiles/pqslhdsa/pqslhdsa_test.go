@@ -1,12 +1,21 @@
 package pqslhdsa
 
 import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
 	"testing"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
+	"github.com/trailofbits/go-slh-dsa/slh_dsa"
 )
 
 func TestRequiredGasUsesParamTier(t *testing.T) {
@@ -58,3 +67,266 @@ func TestRunReturnsFalseForWrongSizedInputs(t *testing.T) {
 	require.Len(t, decoded, 1)
 	require.False(t, decoded[0].(bool))
 }
+
+func TestVerifyBatch(t *testing.T) {
+	precompile, err := NewPrecompile(250_000)
+	require.NoError(t, err)
+
+	msgHashes := make([][32]byte, 2)
+	pubkeys := make([][]byte, 2)
+	sigs := make([][]byte, 2)
+	for i := range msgHashes {
+		sk, pk, err := slh_dsa.SLHKeygen(slh_dsa.SlhDsaShake_128f())
+		require.NoError(t, err)
+
+		msgHashes[i] = sha256.Sum256([]byte{'h', 'e', 'a', 'd', 'e', 'r', byte(i)})
+		sig, err := slh_dsa.SLHSignDeterministic(msgHashes[i][:], []byte(slhContext), sk)
+		require.NoError(t, err)
+		pubkeys[i] = pk.Bytes()
+		sigs[i] = sig.Bytes()
+	}
+
+	bitmap, met := runVerifyBatchCall(t, precompile, uint8(SLH_SHAKE_128F), msgHashes, pubkeys, sigs, 2)
+	require.Equal(t, int64(0b11), bitmap.Int64())
+	require.True(t, met)
+
+	msgHashes[0][0] ^= 0x01
+	bitmap, met = runVerifyBatchCall(t, precompile, uint8(SLH_SHAKE_128F), msgHashes, pubkeys, sigs, 2)
+	require.Equal(t, int64(0b10), bitmap.Int64())
+	require.False(t, met)
+}
+
+func TestVerifyBatchRevertsOnLengthMismatch(t *testing.T) {
+	precompile, err := NewPrecompile(250_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyBatch]
+	args, err := method.Inputs.Pack(uint8(SLH_SHA2_128F), make([][32]byte, 1), make([][]byte, 2), make([][]byte, 2), uint16(0))
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	_, err = precompile.Run(nil, contract, false)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+}
+
+func TestRequiredGasDiscountsBatches(t *testing.T) {
+	precompile, err := NewPrecompile(250_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyBatch]
+	args, err := method.Inputs.Pack(uint8(SLH_SHA2_128F), make([][32]byte, 10), make([][]byte, 10), make([][]byte, 10), uint16(0))
+	require.NoError(t, err)
+
+	input := append(method.ID, args...)
+	require.Equal(t, uint64(25_000+10*225_000), precompile.RequiredGas(input))
+}
+
+// acvpHashAlgs maps the ACVP names of the pre-hash functions to their
+// selectors.
+var acvpHashAlgs = map[string]uint8{
+	"none":         cmn.PreHashNone,
+	"SHA2-224":     cmn.PreHashSHA224,
+	"SHA2-256":     cmn.PreHashSHA256,
+	"SHA2-384":     cmn.PreHashSHA384,
+	"SHA2-512":     cmn.PreHashSHA512,
+	"SHA2-512/224": cmn.PreHashSHA512_224,
+	"SHA2-512/256": cmn.PreHashSHA512_256,
+	"SHA3-224":     cmn.PreHashSHA3_224,
+	"SHA3-256":     cmn.PreHashSHA3_256,
+	"SHA3-384":     cmn.PreHashSHA3_384,
+	"SHA3-512":     cmn.PreHashSHA3_512,
+	"SHAKE-128":    cmn.PreHashSHAKE128,
+	"SHAKE-256":    cmn.PreHashSHAKE256,
+}
+
+// acvpParamIDs maps the ACVP names of the parameter sets to their ids.
+var acvpParamIDs = map[string]slhID{
+	"SLH-DSA-SHA2-128f":  SLH_SHA2_128F,
+	"SLH-DSA-SHA2-128s":  SLH_SHA2_128S,
+	"SLH-DSA-SHA2-192f":  SLH_SHA2_192F,
+	"SLH-DSA-SHA2-192s":  SLH_SHA2_192S,
+	"SLH-DSA-SHA2-256f":  SLH_SHA2_256F,
+	"SLH-DSA-SHA2-256s":  SLH_SHA2_256S,
+	"SLH-DSA-SHAKE-128f": SLH_SHAKE_128F,
+	"SLH-DSA-SHAKE-128s": SLH_SHAKE_128S,
+	"SLH-DSA-SHAKE-192f": SLH_SHAKE_192F,
+	"SLH-DSA-SHAKE-192s": SLH_SHAKE_192S,
+	"SLH-DSA-SHAKE-256f": SLH_SHAKE_256F,
+	"SLH-DSA-SHAKE-256s": SLH_SHAKE_256S,
+}
+
+// acvpSigVer holds SLH-DSA sigVer vectors of the external interface from the
+// NIST ACVP server. Every parameter set has a valid pure and pre-hash vector,
+// and the 128s parameter sets have a rejected vector for every reason.
+type acvpSigVer struct {
+	TestGroups []struct {
+		ParameterSet string `json:"parameterSet"`
+		PreHash      string `json:"preHash"`
+		Tests        []struct {
+			TcID       int     `json:"tcId"`
+			TestPassed bool    `json:"testPassed"`
+			Message    hexData `json:"message"`
+			Context    hexData `json:"context"`
+			HashAlg    string  `json:"hashAlg"`
+			PK         hexData `json:"pk"`
+			Signature  hexData `json:"signature"`
+			Reason     string  `json:"reason"`
+		} `json:"tests"`
+	} `json:"testGroups"`
+}
+
+type hexData []byte
+
+func (h *hexData) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	bz, err := hex.DecodeString(s)
+	*h = bz
+	return err
+}
+
+func TestVerifyMessageACVPVectors(t *testing.T) {
+	precompile, err := NewPrecompile(250_000)
+	require.NoError(t, err)
+
+	file, err := os.Open("testdata/acvp_sigver.json.gz")
+	require.NoError(t, err)
+	defer file.Close()
+	zr, err := gzip.NewReader(file)
+	require.NoError(t, err)
+
+	var vectors acvpSigVer
+	require.NoError(t, json.NewDecoder(zr).Decode(&vectors))
+	require.Len(t, vectors.TestGroups, 24)
+
+	for _, group := range vectors.TestGroups {
+		paramID, ok := acvpParamIDs[group.ParameterSet]
+		require.True(t, ok, group.ParameterSet)
+
+		for _, tc := range group.Tests {
+			t.Run(fmt.Sprintf("%s/%s/%d", group.ParameterSet, group.PreHash, tc.TcID), func(t *testing.T) {
+				preHash, ok := acvpHashAlgs[tc.HashAlg]
+				require.True(t, ok, tc.HashAlg)
+
+				valid := runVerifyMessageCall(t, precompile, uint8(paramID), tc.Message, tc.Context, preHash, tc.PK, tc.Signature)
+				require.Equal(t, tc.TestPassed, valid, tc.Reason)
+			})
+		}
+	}
+}
+
+func TestVerifyMessageMatchesVerify(t *testing.T) {
+	precompile, err := NewPrecompile(250_000)
+	require.NoError(t, err)
+
+	sk, pk, err := slh_dsa.SLHKeygen(slh_dsa.SlhDsaShake_128f())
+	require.NoError(t, err)
+	msgHash := sha256.Sum256([]byte("header"))
+	sig, err := slh_dsa.SLHSignDeterministic(msgHash[:], []byte(slhContext), sk)
+	require.NoError(t, err)
+
+	paramID := uint8(SLH_SHAKE_128F)
+	require.True(t, runVerifyMessageCall(t, precompile, paramID, msgHash[:], []byte(slhContext), cmn.PreHashNone, pk.Bytes(), sig.Bytes()))
+	require.False(t, runVerifyMessageCall(t, precompile, paramID, msgHash[:], nil, cmn.PreHashNone, pk.Bytes(), sig.Bytes()))
+	require.False(t, runVerifyMessageCall(t, precompile, paramID, msgHash[:], []byte(slhContext), cmn.PreHashSHA256, pk.Bytes(), sig.Bytes()))
+	require.False(t, runVerifyMessageCall(t, precompile, uint8(SLH_SHA2_128F), msgHash[:], []byte(slhContext), cmn.PreHashNone, pk.Bytes(), sig.Bytes()))
+
+	// Unknown pre-hash selectors and oversized contexts are invalid.
+	require.False(t, runVerifyMessageCall(t, precompile, paramID, msgHash[:], []byte(slhContext), 13, pk.Bytes(), sig.Bytes()))
+	require.False(t, runVerifyMessageCall(t, precompile, paramID, msgHash[:], make([]byte, cmn.MaxContextBytes+1), cmn.PreHashNone, pk.Bytes(), sig.Bytes()))
+}
+
+func TestVerifyMessageRevertsOnOversizedMessage(t *testing.T) {
+	precompile, err := NewPrecompile(250_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyMessage]
+	args, err := method.Inputs.Pack(uint8(SLH_SHA2_128F), make([]byte, maxSLHMessageBytes+1), []byte{}, cmn.PreHashNone, []byte{}, []byte{})
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	_, err = precompile.Run(nil, contract, false)
+	require.ErrorContains(t, err, "exceeds")
+}
+
+func TestRequiredGasMetersMessageLength(t *testing.T) {
+	precompile, err := NewPrecompile(250_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyMessage]
+	for _, tc := range []struct {
+		paramID slhID
+		msgSize int
+		want    uint64
+	}{
+		{paramID: SLH_SHA2_128F, msgSize: 0, want: slh128FGas},
+		{paramID: SLH_SHAKE_192S, msgSize: 1, want: slh192SGas + 30},
+		{paramID: SLH_SHA2_256S, msgSize: 100, want: slh256SGas + 4*30},
+	} {
+		args, err := method.Inputs.Pack(uint8(tc.paramID), make([]byte, tc.msgSize), []byte("ctx"), cmn.PreHashSHA256, []byte{}, []byte{})
+		require.NoError(t, err)
+
+		input := append(method.ID, args...)
+		require.Equal(t, tc.want, precompile.RequiredGas(input))
+	}
+}
+
+func runVerifyMessageCall(
+	t *testing.T,
+	precompile *Precompile,
+	paramID uint8,
+	message, context []byte,
+	preHash uint8,
+	pubkey, sig []byte,
+) bool {
+	t.Helper()
+
+	method := ABI.Methods[MethodVerifyMessage]
+	args, err := method.Inputs.Pack(paramID, message, context, preHash, pubkey, sig)
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	out, err := precompile.Run(nil, contract, false)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+
+	return values[0].(bool)
+}
+
+func runVerifyBatchCall(
+	t *testing.T,
+	precompile *Precompile,
+	paramID uint8,
+	msgHashes [][32]byte,
+	pubkeys, sigs [][]byte,
+	threshold uint16,
+) (*big.Int, bool) {
+	t.Helper()
+
+	method := ABI.Methods[MethodVerifyBatch]
+	args, err := method.Inputs.Pack(paramID, msgHashes, pubkeys, sigs, threshold)
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	out, err := precompile.Run(nil, contract, false)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	return values[0].(*big.Int), values[1].(bool)
+}
@@ -9,14 +9,11 @@ import (
 )
 
 const (
-	minReservedSlot = 15
+	minReservedSlot = 18
 	maxReservedSlot = 50
 )
 
 var reservedSlotAddresses = [...]string{
-	evmtypes.ReservedSlot15PrecompileAddress,
-	evmtypes.ReservedSlot16PrecompileAddress,
-	evmtypes.ReservedSlot17PrecompileAddress,
 	evmtypes.ReservedSlot18PrecompileAddress,
 	evmtypes.ReservedSlot19PrecompileAddress,
 	evmtypes.ReservedSlot20PrecompileAddress,
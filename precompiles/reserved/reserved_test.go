@@ -10,9 +10,6 @@ import (
 
 func TestReservedPrecompileAddresses(t *testing.T) {
 	expectedAddresses := []string{
-		evmtypes.ReservedSlot15PrecompileAddress,
-		evmtypes.ReservedSlot16PrecompileAddress,
-		evmtypes.ReservedSlot17PrecompileAddress,
 		evmtypes.ReservedSlot18PrecompileAddress,
 		evmtypes.ReservedSlot19PrecompileAddress,
 		evmtypes.ReservedSlot20PrecompileAddress,
@@ -19,6 +19,23 @@ interface SchnorrI {
         bytes calldata signature,
         bytes32 messageHash
     ) external pure returns (bool success);
+
+    /**
+     * @notice Verify a batch of BIP-340 Schnorr signatures with one batch verification.
+     * @dev The arrays must have the same length, at most 256. Malformed entries are invalid.
+     * @param xOnlyPubKeys   32-byte x-only public keys.
+     * @param signatures     64-byte Schnorr signatures.
+     * @param messageHashes  32-byte message hashes (already hashed).
+     * @param threshold      Number of valid signatures required for thresholdMet.
+     * @return validBitmap   Bit i is set iff signature i is valid.
+     * @return thresholdMet  true iff at least threshold signatures are valid.
+     */
+    function verifyBatch(
+        bytes32[] calldata xOnlyPubKeys,
+        bytes[] calldata signatures,
+        bytes32[] calldata messageHashes,
+        uint16 threshold
+    ) external pure returns (uint256 validBitmap, bool thresholdMet);
 }
 
 SchnorrI constant SCHNORR_CONTRACT = SchnorrI(SCHNORR_PRECOMPILE_ADDRESS);
@@ -0,0 +1,258 @@
+package schnorr
+
+import (
+	"crypto/sha256"
+	"io"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+)
+
+// batchEntry is a well-formed entry of a verifyBatch call, decoded as
+// btcec's schnorr.ParseSignature does.
+type batchEntry struct {
+	pubKey  *btcec.PublicKey
+	xOnly   [32]byte
+	r       btcec.FieldVal
+	s       btcec.ModNScalar
+	sig     []byte
+	msgHash [32]byte
+}
+
+// parseBatchEntry decodes an entry of a verifyBatch call, or returns false if
+// verifySchnorrSignature would reject it before verifying.
+func parseBatchEntry(xOnly [32]byte, sig []byte, msgHash [32]byte) (batchEntry, bool) {
+	if len(sig) != schnorr.SignatureSize {
+		return batchEntry{}, false
+	}
+	pubKey, err := schnorr.ParsePubKey(xOnly[:])
+	if err != nil {
+		return batchEntry{}, false
+	}
+
+	e := batchEntry{pubKey: pubKey, xOnly: xOnly, sig: sig, msgHash: msgHash}
+	if overflow := e.r.SetByteSlice(sig[:32]); overflow {
+		return batchEntry{}, false
+	}
+	if overflow := e.s.SetByteSlice(sig[32:]); overflow {
+		return batchEntry{}, false
+	}
+	return e, true
+}
+
+// verify checks the entry on its own.
+func (e batchEntry) verify() bool {
+	sig, err := schnorr.ParseSignature(e.sig)
+	if err != nil {
+		return false
+	}
+	return sig.Verify(e.msgHash[:], e.pubKey)
+}
+
+// batchVerify checks the batch verification equation of BIP-340,
+//
+//	(a_1 s_1 + ... + a_u s_u)G = R_1 + ... + a_u R_u + e_1 P_1 + ... + a_u e_u P_u
+//
+// with a_1 = 1 and the other coefficients drawn from rand. It holds if every
+// entry is valid, and fails with overwhelming probability otherwise.
+func batchVerify(entries []batchEntry, rand io.Reader) bool {
+	scalars := make([]btcec.ModNScalar, 0, 2*len(entries))
+	points := make([]btcec.JacobianPoint, 0, 2*len(entries))
+	var sum btcec.ModNScalar
+	for i := range entries {
+		entry := &entries[i]
+
+		var a btcec.ModNScalar
+		if i == 0 {
+			a.SetInt(1)
+		} else if !randomScalar(rand, &a) {
+			return false
+		}
+
+		// R = lift_x(r), the point with even y.
+		var R btcec.JacobianPoint
+		R.X.Set(&entry.r)
+		if !btcec.DecompressY(&R.X, false, &R.Y) {
+			return false
+		}
+		R.Z.SetInt(1)
+
+		var P btcec.JacobianPoint
+		entry.pubKey.AsJacobian(&P)
+
+		rBytes := entry.r.Bytes()
+		var e btcec.ModNScalar
+		e.SetBytes(taggedHash(bip340ChallengeTag, rBytes[:], entry.xOnly[:], entry.msgHash[:]))
+
+		var ae btcec.ModNScalar
+		ae.Mul2(&a, &e)
+		var as btcec.ModNScalar
+		as.Mul2(&a, &entry.s)
+		sum.Add(&as)
+
+		scalars = append(scalars, a, ae)
+		points = append(points, R, P)
+	}
+
+	// Check that (sum a_i R_i + sum a_i e_i P_i) - (sum a_i s_i)G is the
+	// point at infinity.
+	var rhs, sG, result btcec.JacobianPoint
+	multiScalarMult(scalars, points, &rhs)
+	sum.Negate()
+	btcec.ScalarBaseMultNonConst(&sum, &sG)
+	btcec.AddNonConst(&rhs, &sG, &result)
+	return (result.X.IsZero() && result.Y.IsZero()) || result.Z.IsZero()
+}
+
+// randomScalar reads a nonzero 128-bit scalar from rand, which keeps the
+// probability that an invalid batch verifies at 2^-128 while halving the
+// cost of the a_i R_i terms.
+func randomScalar(rand io.Reader, k *btcec.ModNScalar) bool {
+	var buf [32]byte
+	for {
+		if _, err := io.ReadFull(rand, buf[16:]); err != nil {
+			return false
+		}
+		k.SetBytes(&buf)
+		if !k.IsZero() {
+			return true
+		}
+	}
+}
+
+// wnafWidth is the window width of the multi-scalar multiplication, so that
+// every point needs a table of 2^(wnafWidth-2) odd multiples.
+const wnafWidth = 5
+
+// wnafTable holds the odd multiples P, 3P, ... of a point.
+type wnafTable [1 << (wnafWidth - 2)]btcec.JacobianPoint
+
+// multiScalarMult computes sum k_i P_i with the width-w NAF of every scalar,
+// sharing the doublings across all the points.
+func multiScalarMult(scalars []btcec.ModNScalar, points []btcec.JacobianPoint, result *btcec.JacobianPoint) {
+	// tables[i][j] is (2j+1)P_i, and negTables[i][j] its negation.
+	tables := make([]wnafTable, len(points))
+	negTables := make([]wnafTable, len(points))
+	nafs := make([][]int8, len(points))
+	maxLen := 0
+	for i := range points {
+		var double btcec.JacobianPoint
+		btcec.DoubleNonConst(&points[i], &double)
+		tables[i][0] = points[i]
+		for j := 1; j < len(tables[i]); j++ {
+			btcec.AddNonConst(&tables[i][j-1], &double, &tables[i][j])
+		}
+		nafs[i] = wnaf(&scalars[i])
+		maxLen = max(maxLen, len(nafs[i]))
+	}
+
+	// Affine table points make the additions of the main loop cheaper.
+	toAffine(tables)
+	for i := range tables {
+		for j := range tables[i] {
+			negTables[i][j] = tables[i][j]
+			negTables[i][j].Y.Negate(1).Normalize()
+		}
+	}
+
+	var acc, tmp btcec.JacobianPoint
+	for b := maxLen - 1; b >= 0; b-- {
+		btcec.DoubleNonConst(&acc, &tmp)
+		acc.Set(&tmp)
+		for i := range nafs {
+			if b >= len(nafs[i]) || nafs[i][b] == 0 {
+				continue
+			}
+			digit := nafs[i][b]
+			if digit > 0 {
+				btcec.AddNonConst(&acc, &tables[i][digit/2], &tmp)
+			} else {
+				btcec.AddNonConst(&acc, &negTables[i][-digit/2], &tmp)
+			}
+			acc.Set(&tmp)
+		}
+	}
+	result.Set(&acc)
+}
+
+// toAffine converts the table points to affine coordinates, inverting all
+// their z coordinates at once with Montgomery's trick.
+func toAffine(tables []wnafTable) {
+	var points []*btcec.JacobianPoint
+	for i := range tables {
+		for j := range tables[i] {
+			if !tables[i][j].Z.IsZero() {
+				points = append(points, &tables[i][j])
+			}
+		}
+	}
+	if len(points) == 0 {
+		return
+	}
+
+	// prefix[i] is the product of the first i+1 z coordinates.
+	prefix := make([]btcec.FieldVal, len(points))
+	prefix[0].Set(&points[0].Z)
+	for i := 1; i < len(points); i++ {
+		prefix[i].Mul2(&prefix[i-1], &points[i].Z).Normalize()
+	}
+
+	var inv btcec.FieldVal
+	inv.Set(&prefix[len(points)-1]).Inverse()
+	for i := len(points) - 1; i >= 0; i-- {
+		var zInv btcec.FieldVal
+		if i > 0 {
+			zInv.Mul2(&inv, &prefix[i-1])
+			inv.Mul(&points[i].Z).Normalize()
+		} else {
+			zInv.Set(&inv)
+		}
+
+		var zInv2, zInv3 btcec.FieldVal
+		zInv2.SquareVal(&zInv)
+		zInv3.Mul2(&zInv2, &zInv)
+		points[i].X.Mul(&zInv2).Normalize()
+		points[i].Y.Mul(&zInv3).Normalize()
+		points[i].Z.SetInt(1)
+	}
+}
+
+// wnaf returns the width-w NAF of k, least significant digit first. Every
+// nonzero digit is odd and followed by at least w-1 zeros.
+func wnaf(k *btcec.ModNScalar) []int8 {
+	kBytes := k.Bytes()
+	n := new(big.Int).SetBytes(kBytes[:])
+
+	naf := make([]int8, 0, n.BitLen()+1)
+	for n.Sign() > 0 {
+		var digit int8
+		if n.Bit(0) == 1 {
+			mod := int64(n.Uint64() & (1<<wnafWidth - 1))
+			if mod >= 1<<(wnafWidth-1) {
+				mod -= 1 << wnafWidth
+			}
+			digit = int8(mod)
+			n.Sub(n, big.NewInt(mod))
+		}
+		naf = append(naf, digit)
+		n.Rsh(n, 1)
+	}
+	return naf
+}
+
+var bip340ChallengeTag = []byte("BIP0340/challenge")
+
+// taggedHash is the tagged hash of BIP-340.
+func taggedHash(tag []byte, msgs ...[]byte) *[32]byte {
+	tagHash := sha256.Sum256(tag)
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, m := range msgs {
+		h.Write(m)
+	}
+	var out [32]byte
+	h.Sum(out[:0])
+	return &out
+}
@@ -32,7 +32,13 @@ func init() {
 }
 
 const (
-	MethodVerify = "verifySchnorrSignature"
+	MethodVerify      = "verifySchnorrSignature"
+	MethodVerifyBatch = "verifyBatch"
+
+	// batchGasPercent is the share of the base gas charged for every entry of
+	// the batch attempt, whose entries share the doublings of one multi-scalar
+	// multiplication.
+	batchGasPercent = 60
 )
 
 type Precompile struct {
@@ -56,26 +62,53 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(evmtypes.SchnorrPrecompileAddress)
 }
 
-// RequiredGas returns static gas (tune as needed).
-func (p Precompile) RequiredGas(_ []byte) uint64 {
-	return p.baseGas
+// RequiredGas returns static gas (tune as needed). verifyBatch is priced for a
+// failing batch, which verifies every entry again on its own.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return p.baseGas
+	}
+	method, err := p.MethodById(input[:4])
+	if err != nil || method.Name != MethodVerifyBatch {
+		return p.baseGas
+	}
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil || len(values) != 4 {
+		return p.baseGas
+	}
+	pubKeys, _ := values[0].([][32]byte)
+	return cmn.BatchWithFallbackRequiredGas(p.baseGas, len(pubKeys), batchGasPercent)
 }
 
 // Run executes the precompile:
 // verifySchnorrSignature(bytes32 xOnlyPubKey, bytes signature, bytes32 messageHash) -> (bool)
-func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+// verifyBatch(bytes32[] xOnlyPubKeys, bytes[] signatures, bytes32[] messageHashes, uint16 threshold) -> (uint256, bool)
+func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+	defer cmn.RecoverPrecompileError(&err)()
+
 	input := contract.Input
 	if len(input) < 4 {
 		return nil, vm.ErrExecutionReverted
 	}
 
 	method, err := p.MethodById(input[:4])
-	if err != nil || method.Name != MethodVerify {
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	switch method.Name {
+	case MethodVerify:
+		return p.verify(method, input[4:])
+	case MethodVerifyBatch:
+		return p.verifyBatch(method, input[4:])
+	default:
 		return nil, vm.ErrExecutionReverted
 	}
+}
 
+func (p *Precompile) verify(method *abi.Method, input []byte) ([]byte, error) {
 	// Unpack to []interface{} for compatibility with older go-ethereum
-	values, err := method.Inputs.Unpack(input[4:])
+	values, err := method.Inputs.Unpack(input)
 	if err != nil || len(values) != 3 {
 		return nil, vm.ErrExecutionReverted
 	}
@@ -121,3 +154,50 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, erro
 	}
 	return out, nil
 }
+
+// verifyBatch checks all well-formed entries with one BIP-340 batch
+// verification, and falls back to verifying them one by one if it fails.
+func (p *Precompile) verifyBatch(method *abi.Method, input []byte) ([]byte, error) {
+	values, err := method.Inputs.Unpack(input)
+	if err != nil || len(values) != 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	pubKeys := values[0].([][32]byte)
+	sigs := values[1].([][]byte)
+	msgHashes := values[2].([][32]byte)
+	threshold := values[3].(uint16)
+
+	n, err := cmn.BatchSize(len(pubKeys), len(sigs), len(msgHashes))
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	valid := make([]bool, n)
+	entries := make([]batchEntry, 0, n)
+	indices := make([]int, 0, n)
+	for i := range n {
+		entry, ok := parseBatchEntry(pubKeys[i], sigs[i], msgHashes[i])
+		if !ok {
+			continue
+		}
+		entries = append(entries, entry)
+		indices = append(indices, i)
+	}
+
+	rand := cmn.NewBatchRand(MethodVerifyBatch, bytes32Slices(pubKeys), sigs, bytes32Slices(msgHashes))
+	allValid := len(entries) > 0 && batchVerify(entries, rand)
+	for j, i := range indices {
+		valid[i] = allValid || entries[j].verify()
+	}
+
+	return cmn.PackBatchResult(method, valid, threshold)
+}
+
+func bytes32Slices(values [][32]byte) [][]byte {
+	out := make([][]byte, len(values))
+	for i := range values {
+		out[i] = values[i][:]
+	}
+	return out
+}
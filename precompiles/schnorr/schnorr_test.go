@@ -2,10 +2,12 @@ package schnorr
 
 import (
 	"crypto/sha256"
+	"math/big"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	btcschnorr "github.com/btcsuite/btcd/btcec/v2/schnorr"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -109,6 +111,128 @@ func TestRunRevertsOnMalformedCalldata(t *testing.T) {
 	}
 }
 
+func TestVerifyBatch(t *testing.T) {
+	precompile, err := NewPrecompile(15_000)
+	require.NoError(t, err)
+
+	pubKeys, sigs, msgHashes := signBatch(t, 5)
+
+	bitmap, met := runVerifyBatchCall(t, precompile, pubKeys, sigs, msgHashes, 5)
+	require.Equal(t, int64(0b11111), bitmap.Int64())
+	require.True(t, met)
+
+	// A tampered signature and a malformed one clear their bits only.
+	sigs[1][63] ^= 0x01
+	sigs[3] = sigs[3][:63]
+
+	bitmap, met = runVerifyBatchCall(t, precompile, pubKeys, sigs, msgHashes, 3)
+	require.Equal(t, int64(0b10101), bitmap.Int64())
+	require.True(t, met)
+
+	bitmap, met = runVerifyBatchCall(t, precompile, pubKeys, sigs, msgHashes, 4)
+	require.Equal(t, int64(0b10101), bitmap.Int64())
+	require.False(t, met)
+}
+
+func TestVerifyBatchRejectsOverflowingScalars(t *testing.T) {
+	precompile, err := NewPrecompile(15_000)
+	require.NoError(t, err)
+
+	pubKeys, sigs, msgHashes := signBatch(t, 2)
+	fieldPrime := btcec.S256().P.FillBytes(make([]byte, 32))
+	groupOrder := btcec.S256().N.FillBytes(make([]byte, 32))
+
+	testCases := []struct {
+		name string
+		sig  []byte
+	}{
+		{"r not below the field prime", append(append([]byte{}, fieldPrime...), sigs[0][32:]...)},
+		{"s not below the group order", append(append([]byte{}, sigs[0][:32]...), groupOrder...)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok := parseBatchEntry(pubKeys[0], tc.sig, msgHashes[0])
+			require.False(t, ok)
+
+			out, err := runVerifyCall(t, precompile, ABI.Methods[MethodVerify], pubKeys[0], tc.sig, msgHashes[0])
+			require.NoError(t, err)
+			require.False(t, unpackBoolOutput(t, ABI.Methods[MethodVerify], out))
+
+			bitmap, _ := runVerifyBatchCall(t, precompile, pubKeys, [][]byte{tc.sig, sigs[1]}, msgHashes, 0)
+			require.Equal(t, int64(0b10), bitmap.Int64())
+		})
+	}
+}
+
+func TestBatchVerifyEquation(t *testing.T) {
+	pubKeys, sigs, msgHashes := signBatch(t, 4)
+
+	entries := make([]batchEntry, len(pubKeys))
+	for i := range pubKeys {
+		var ok bool
+		entries[i], ok = parseBatchEntry(pubKeys[i], sigs[i], msgHashes[i])
+		require.True(t, ok)
+	}
+	require.True(t, batchVerify(entries, cmn.NewBatchRand("test")))
+
+	// Each invalid entry makes the whole batch fail.
+	for i := range entries {
+		tampered := append([]batchEntry{}, entries...)
+		tampered[i].msgHash[0] ^= 0x01
+		require.False(t, batchVerify(tampered, cmn.NewBatchRand("test")), "entry %d", i)
+	}
+}
+
+func TestVerifyBatchRevertsOnInvalidBatch(t *testing.T) {
+	precompile, err := NewPrecompile(15_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyBatch]
+	testCases := []struct {
+		name string
+		size [3]int
+	}{
+		{"length mismatch", [3]int{2, 1, 2}},
+		{"too many entries", [3]int{257, 257, 257}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := method.Inputs.Pack(
+				make([][32]byte, tc.size[0]),
+				make([][]byte, tc.size[1]),
+				make([][32]byte, tc.size[2]),
+				uint16(0),
+			)
+			require.NoError(t, err)
+
+			out, err := precompile.Run(nil, newTestContract(append(append([]byte{}, method.ID...), args...)), false)
+			require.Nil(t, out)
+			require.ErrorIs(t, err, vm.ErrExecutionReverted)
+		})
+	}
+}
+
+func TestRequiredGasCoversBatchFallback(t *testing.T) {
+	precompile, err := NewPrecompile(15_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[MethodVerifyBatch]
+	for _, tc := range []struct {
+		n   int
+		gas uint64
+	}{
+		{1, 30_000},
+		{100, 2_406_000},
+	} {
+		args, err := method.Inputs.Pack(make([][32]byte, tc.n), make([][]byte, tc.n), make([][32]byte, tc.n), uint16(0))
+		require.NoError(t, err)
+		require.Equal(t, tc.gas, precompile.RequiredGas(append(append([]byte{}, method.ID...), args...)))
+	}
+	require.Equal(t, uint64(15_000), precompile.RequiredGas(ABI.Methods[MethodVerify].ID))
+}
+
 func runVerifyCall(
 	t *testing.T,
 	precompile *Precompile,
@@ -126,6 +250,50 @@ func runVerifyCall(
 	return precompile.Run(nil, contract, false)
 }
 
+func runVerifyBatchCall(
+	t *testing.T,
+	precompile *Precompile,
+	pubKeys [][32]byte,
+	sigs [][]byte,
+	msgHashes [][32]byte,
+	threshold uint16,
+) (*big.Int, bool) {
+	t.Helper()
+
+	method := ABI.Methods[MethodVerifyBatch]
+	args, err := method.Inputs.Pack(pubKeys, sigs, msgHashes, threshold)
+	require.NoError(t, err)
+
+	out, err := precompile.Run(nil, newTestContract(append(append([]byte{}, method.ID...), args...)), false)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	return values[0].(*big.Int), values[1].(bool)
+}
+
+func signBatch(t *testing.T, n int) ([][32]byte, [][]byte, [][32]byte) {
+	t.Helper()
+
+	pubKeys := make([][32]byte, n)
+	sigs := make([][]byte, n)
+	msgHashes := make([][32]byte, n)
+	for i := range n {
+		privKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		msgHashes[i] = sha256.Sum256([]byte{'h', 'e', 'a', 'd', 'e', 'r', byte(i)})
+		signature, err := btcschnorr.Sign(privKey, msgHashes[i][:])
+		require.NoError(t, err)
+
+		pubKeys[i] = toBytes32(btcschnorr.SerializePubKey(privKey.PubKey()))
+		sigs[i] = signature.Serialize()
+	}
+	return pubKeys, sigs, msgHashes
+}
+
 func unpackBoolOutput(t *testing.T, method abi.Method, output []byte) bool {
 	t.Helper()
 
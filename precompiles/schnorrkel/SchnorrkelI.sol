@@ -24,6 +24,26 @@ interface SchnorrkelI {
         bytes calldata pubKey,
         bytes calldata signature
     ) external pure returns (bool success);
+
+    /**
+     * @notice Verify a batch of Schnorrkel signatures sharing one signing context
+     *         with one sr25519 batch verification.
+     * @dev The arrays must have the same length, at most 256. Malformed entries are invalid.
+     * @param signingCtx    Domain separation context bytes shared by all signatures.
+     * @param msgs          Message bytes.
+     * @param pubKeys       32-byte sr25519 public keys.
+     * @param signatures    64-byte Schnorrkel signatures.
+     * @param threshold     Number of valid signatures required for thresholdMet.
+     * @return validBitmap  Bit i is set iff signature i is valid.
+     * @return thresholdMet true iff at least threshold signatures are valid.
+     */
+    function verifyBatch(
+        bytes calldata signingCtx,
+        bytes[] calldata msgs,
+        bytes[] calldata pubKeys,
+        bytes[] calldata signatures,
+        uint16 threshold
+    ) external pure returns (uint256 validBitmap, bool thresholdMet);
 }
 
 SchnorrkelI constant SCHNORRKEL_CONTRACT = SchnorrkelI(
@@ -0,0 +1,121 @@
+package schnorrkel
+
+import (
+	"io"
+
+	schnorrkel "github.com/ChainSafe/go-schnorrkel"
+	r255 "github.com/gtank/ristretto255"
+)
+
+// batchEntry is a well-formed entry of a verifyBatch call, decoded as
+// go-schnorrkel decodes public keys and signatures.
+type batchEntry struct {
+	pubKey    *r255.Element
+	pubKeyBz  []byte
+	r         *r255.Element
+	rBz       []byte
+	s         *r255.Scalar
+	signature [64]byte
+	msg       []byte
+}
+
+// parseBatchEntry decodes an entry of a verifyBatch call, or returns false if
+// verifySchnorrkelSignature would return false for it.
+func parseBatchEntry(pubKeyBz, sigBz, msg []byte) (batchEntry, bool) {
+	if len(pubKeyBz) != 32 || len(sigBz) != 64 {
+		return batchEntry{}, false
+	}
+	// Signatures are marked to tell them apart from ed25519 ones.
+	if sigBz[63]&128 == 0 {
+		return batchEntry{}, false
+	}
+
+	pubKey := r255.NewElement()
+	if err := pubKey.Decode(pubKeyBz); err != nil {
+		return batchEntry{}, false
+	}
+	if pubKey.Equal(r255.NewIdentityElement()) == 1 {
+		return batchEntry{}, false
+	}
+
+	e := batchEntry{pubKey: pubKey, pubKeyBz: pubKeyBz, msg: msg}
+	copy(e.signature[:], sigBz)
+
+	e.rBz = e.signature[:32]
+	e.r = r255.NewElement()
+	if err := e.r.Decode(e.rBz); err != nil {
+		return batchEntry{}, false
+	}
+	var s [32]byte
+	copy(s[:], e.signature[32:])
+	s[31] &= 127
+	e.s = r255.NewScalar()
+	if err := e.s.Decode(s[:]); err != nil {
+		return batchEntry{}, false
+	}
+	return e, true
+}
+
+// challenge returns the challenge scalar k of the signature, derived from the
+// signing transcript as go-schnorrkel's PublicKey.Verify does.
+func (e batchEntry) challenge(signingCtx []byte) *r255.Scalar {
+	t := schnorrkel.NewSigningContext(signingCtx, e.msg)
+	t.AppendMessage([]byte("proto-name"), []byte("Schnorr-sig"))
+	t.AppendMessage([]byte("sign:pk"), e.pubKeyBz)
+	t.AppendMessage([]byte("sign:R"), e.rBz)
+	return r255.NewScalar().FromUniformBytes(t.ExtractBytes([]byte("sign:c"), 64))
+}
+
+// verify checks the entry on its own with go-schnorrkel.
+func (e batchEntry) verify(signingCtx []byte) bool {
+	var pk [32]byte
+	copy(pk[:], e.pubKeyBz)
+	pubKey, err := schnorrkel.NewPublicKey(pk)
+	if err != nil {
+		return false
+	}
+	var sig schnorrkel.Signature
+	if err := sig.Decode(e.signature); err != nil {
+		return false
+	}
+	ok, err := pubKey.Verify(&sig, schnorrkel.NewSigningContext(signingCtx, e.msg))
+	return err == nil && ok
+}
+
+// batchVerify checks the batch verification equation
+//
+//	[-sum z_i s_i]B + sum [z_i]R_i + sum [z_i k_i]P_i = 0
+//
+// with 128-bit coefficients z_i drawn from rand. It holds if every entry is
+// valid, and fails with overwhelming probability otherwise. Unlike
+// go-schnorrkel's VerifyBatch, it shares one multi-scalar multiplication
+// across the batch.
+func batchVerify(signingCtx []byte, entries []batchEntry, rand io.Reader) bool {
+	scalars := make([]*r255.Scalar, 0, 1+2*len(entries))
+	points := make([]*r255.Element, 0, 1+2*len(entries))
+
+	sum := r255.NewScalar()
+	scalars = append(scalars, sum)
+	points = append(points, r255.NewGeneratorElement())
+
+	var buf [32]byte
+	for _, e := range entries {
+		if _, err := io.ReadFull(rand, buf[:16]); err != nil {
+			return false
+		}
+		z := r255.NewScalar()
+		if err := z.Decode(buf[:]); err != nil {
+			return false
+		}
+
+		zk := r255.NewScalar().Multiply(z, e.challenge(signingCtx))
+		sum.Add(sum, r255.NewScalar().Multiply(z, e.s))
+
+		scalars = append(scalars, z, zk)
+		points = append(points, e.r, e.pubKey)
+	}
+	sum.Negate(sum)
+
+	check := r255.NewElement().VarTimeMultiScalarMult(scalars, points)
+	return check.Equal(r255.NewIdentityElement()) == 1
+}
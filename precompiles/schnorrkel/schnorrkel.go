@@ -31,10 +31,19 @@ func init() {
 	}
 }
 
-const methodVerify = "verifySchnorrkelSignature"
+const (
+	methodVerify      = "verifySchnorrkelSignature"
+	methodVerifyBatch = "verifyBatch"
+)
+
 const (
 	schnorrkelPerWordGas      = 30
 	maxSchnorrkelMessageBytes = 64 * 1024
+
+	// batchGasPercent is the share of the base gas charged for every entry of
+	// the batch attempt, which only adds two points to a shared multi-scalar
+	// multiplication and verifies about three times faster.
+	batchGasPercent = 40
 )
 
 type Precompile struct {
@@ -58,10 +67,29 @@ func (Precompile) Address() common.Address {
 }
 
 func (p Precompile) RequiredGas(input []byte) uint64 {
-	return cmn.LinearRequiredGas(p.baseGas, input, schnorrkelPerWordGas)
+	return cmn.LinearRequiredGas(p.batchBaseGas(input), input, schnorrkelPerWordGas)
+}
+
+// batchBaseGas returns the base gas. verifyBatch is priced for a failing
+// batch, which verifies every entry again on its own.
+func (p Precompile) batchBaseGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return p.baseGas
+	}
+	m, err := p.MethodById(input[:4])
+	if err != nil || m.Name != methodVerifyBatch {
+		return p.baseGas
+	}
+	vals, err := m.Inputs.Unpack(input[4:])
+	if err != nil || len(vals) != 5 {
+		return p.baseGas
+	}
+	msgs, _ := vals[1].([][]byte)
+	return cmn.BatchWithFallbackRequiredGas(p.baseGas, len(msgs), batchGasPercent)
 }
 
 // verifySchnorrkelSignature(bytes signingCtx, bytes msg, bytes pubKey, bytes signature) -> (bool)
+// verifyBatch(bytes signingCtx, bytes[] msgs, bytes[] pubKeys, bytes[] signatures, uint16 threshold) -> (uint256, bool)
 func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
 	defer cmn.RecoverPrecompileError(&err)()
 
@@ -70,12 +98,23 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, e
 		return nil, vm.ErrExecutionReverted
 	}
 	m, err := p.MethodById(input[:4])
-	if err != nil || m.Name != methodVerify {
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	switch m.Name {
+	case methodVerify:
+		return p.verify(m, input[4:])
+	case methodVerifyBatch:
+		return p.verifyBatch(m, input[4:])
+	default:
 		return nil, vm.ErrExecutionReverted
 	}
+}
 
+func (p *Precompile) verify(m *abi.Method, input []byte) ([]byte, error) {
 	// Unpack to []any (compatible with older geth)
-	vals, err := m.Inputs.Unpack(input[4:])
+	vals, err := m.Inputs.Unpack(input)
 	if err != nil || len(vals) != 4 {
 		return nil, vm.ErrExecutionReverted
 	}
@@ -137,3 +176,46 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, e
 	}
 	return out, nil
 }
+
+// verifyBatch checks all well-formed entries with one sr25519 batch
+// verification, and falls back to verifying them one by one if it fails.
+func (p *Precompile) verifyBatch(m *abi.Method, input []byte) ([]byte, error) {
+	vals, err := m.Inputs.Unpack(input)
+	if err != nil || len(vals) != 5 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	signingCtx := vals[0].([]byte)
+	msgs := vals[1].([][]byte)
+	pubKeys := vals[2].([][]byte)
+	sigs := vals[3].([][]byte)
+	threshold := vals[4].(uint16)
+
+	n, err := cmn.BatchSize(len(msgs), len(pubKeys), len(sigs))
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	valid := make([]bool, n)
+	entries := make([]batchEntry, 0, n)
+	indices := make([]int, 0, n)
+	for i := range n {
+		if len(signingCtx)+len(msgs[i]) > maxSchnorrkelMessageBytes {
+			return nil, fmt.Errorf("schnorrkel signing context plus message exceeds %d bytes", maxSchnorrkelMessageBytes)
+		}
+		entry, ok := parseBatchEntry(pubKeys[i], sigs[i], msgs[i])
+		if !ok {
+			continue
+		}
+		entries = append(entries, entry)
+		indices = append(indices, i)
+	}
+
+	rand := cmn.NewBatchRand(methodVerifyBatch, [][]byte{signingCtx}, msgs, pubKeys, sigs)
+	allValid := len(entries) > 0 && batchVerify(signingCtx, entries, rand)
+	for j, i := range indices {
+		valid[i] = allValid || entries[j].verify(signingCtx)
+	}
+
+	return cmn.PackBatchResult(m, valid, threshold)
+}
@@ -1,12 +1,16 @@
 package schnorrkel
 
 import (
+	"math/big"
 	"testing"
 
+	schnorrkel "github.com/ChainSafe/go-schnorrkel"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
 )
 
 func TestRequiredGasScalesByWords(t *testing.T) {
@@ -37,3 +41,139 @@ func TestRunRejectsOversizedContextAndMessage(t *testing.T) {
 	_, err = precompile.Run(nil, contract, false)
 	require.ErrorContains(t, err, "schnorrkel signing context plus message exceeds")
 }
+
+func TestVerifyBatch(t *testing.T) {
+	precompile, err := NewPrecompile(40_000)
+	require.NoError(t, err)
+
+	signingCtx := []byte("substrate")
+	msgs, pubKeys, sigs := signBatch(t, signingCtx, 5)
+
+	bitmap, met := runVerifyBatchCall(t, precompile, signingCtx, msgs, pubKeys, sigs, 5)
+	require.Equal(t, int64(0b11111), bitmap.Int64())
+	require.True(t, met)
+
+	// A signature under another context is invalid too.
+	bitmap, met = runVerifyBatchCall(t, precompile, []byte("other"), msgs, pubKeys, sigs, 1)
+	require.Zero(t, bitmap.Sign())
+	require.False(t, met)
+
+	// A tampered message and an unmarked signature clear their bits only.
+	msgs[1] = []byte("tampered")
+	sigs[3][63] &= 127
+
+	bitmap, met = runVerifyBatchCall(t, precompile, signingCtx, msgs, pubKeys, sigs, 3)
+	require.Equal(t, int64(0b10101), bitmap.Int64())
+	require.True(t, met)
+
+	bitmap, met = runVerifyBatchCall(t, precompile, signingCtx, msgs, pubKeys, sigs, 4)
+	require.Equal(t, int64(0b10101), bitmap.Int64())
+	require.False(t, met)
+}
+
+func TestBatchVerifyEquation(t *testing.T) {
+	signingCtx := []byte("substrate")
+	msgs, pubKeys, sigs := signBatch(t, signingCtx, 4)
+
+	entries := make([]batchEntry, len(msgs))
+	for i := range msgs {
+		var ok bool
+		entries[i], ok = parseBatchEntry(pubKeys[i], sigs[i], msgs[i])
+		require.True(t, ok)
+	}
+	require.True(t, batchVerify(signingCtx, entries, cmn.NewBatchRand("test")))
+
+	// Each invalid entry makes the whole batch fail.
+	for i := range entries {
+		tampered := append([]batchEntry{}, entries...)
+		tampered[i].msg = []byte("tampered")
+		require.False(t, batchVerify(signingCtx, tampered, cmn.NewBatchRand("test")), "entry %d", i)
+	}
+}
+
+func TestVerifyBatchRevertsOnInvalidBatch(t *testing.T) {
+	precompile, err := NewPrecompile(40_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[methodVerifyBatch]
+	args, err := method.Inputs.Pack([]byte("ctx"), make([][]byte, 2), make([][]byte, 2), make([][]byte, 1), uint16(0))
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	_, err = precompile.Run(nil, contract, false)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	args, err = method.Inputs.Pack(
+		make([]byte, maxSchnorrkelMessageBytes),
+		[][]byte{{0x01}},
+		[][]byte{make([]byte, 32)},
+		[][]byte{make([]byte, 64)},
+		uint16(0),
+	)
+	require.NoError(t, err)
+	contract.Input = append(method.ID, args...)
+
+	_, err = precompile.Run(nil, contract, false)
+	require.ErrorContains(t, err, "schnorrkel signing context plus message exceeds")
+}
+
+func TestRequiredGasCoversBatchFallback(t *testing.T) {
+	precompile, err := NewPrecompile(40_000)
+	require.NoError(t, err)
+
+	method := ABI.Methods[methodVerifyBatch]
+	args, err := method.Inputs.Pack([]byte{}, make([][]byte, 100), make([][]byte, 100), make([][]byte, 100), uint16(0))
+	require.NoError(t, err)
+
+	input := append(method.ID, args...)
+	words := uint64(len(input)+31) / 32
+	require.Equal(t, 40_000-16_000+100*16_000+100*40_000+words*schnorrkelPerWordGas, precompile.RequiredGas(input))
+}
+
+func runVerifyBatchCall(
+	t *testing.T,
+	precompile *Precompile,
+	signingCtx []byte,
+	msgs, pubKeys, sigs [][]byte,
+	threshold uint16,
+) (*big.Int, bool) {
+	t.Helper()
+
+	method := ABI.Methods[methodVerifyBatch]
+	args, err := method.Inputs.Pack(signingCtx, msgs, pubKeys, sigs, threshold)
+	require.NoError(t, err)
+
+	contract := vm.NewContract(common.Address{}, common.Address{}, uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = append(method.ID, args...)
+
+	out, err := precompile.Run(nil, contract, false)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	return values[0].(*big.Int), values[1].(bool)
+}
+
+func signBatch(t *testing.T, signingCtx []byte, n int) (msgs, pubKeys, sigs [][]byte) {
+	t.Helper()
+
+	for i := range n {
+		sk, pk, err := schnorrkel.GenerateKeypair()
+		require.NoError(t, err)
+
+		msg := []byte{'h', 'e', 'a', 'd', 'e', 'r', byte(i)}
+		sig, err := sk.Sign(schnorrkel.NewSigningContext(signingCtx, msg))
+		require.NoError(t, err)
+
+		pkBz := pk.Encode()
+		sigBz := sig.Encode()
+		msgs = append(msgs, msg)
+		pubKeys = append(pubKeys, pkBz[:])
+		sigs = append(sigs, sigBz[:])
+	}
+	return msgs, pubKeys, sigs
+}
@@ -0,0 +1,97 @@
+package sp1verifier
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+const (
+	g1Size = 2 * fp.Bytes
+	g2Size = 4 * fp.Bytes
+)
+
+// proofReader decodes the big-endian, uncompressed encoding used by the SP1
+// Solidity verifiers. Like the sp1-verifier crate, it rejects non-canonical
+// field elements and points at infinity, and ignores trailing bytes.
+type proofReader struct {
+	buf []byte
+	err error
+}
+
+func (r *proofReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.buf) < n {
+		r.err = fmt.Errorf("%w: proof is truncated", ErrInvalidProof)
+		return nil
+	}
+	bz := r.buf[:n]
+	r.buf = r.buf[n:]
+	return bz
+}
+
+func (r *proofReader) fail(format string, args ...any) {
+	if r.err == nil {
+		r.err = fmt.Errorf("%w: "+format, append([]any{ErrInvalidProof}, args...)...)
+	}
+}
+
+func (r *proofReader) fp(bz []byte) fp.Element {
+	var e fp.Element
+	if err := e.SetBytesCanonical(bz); err != nil {
+		r.fail("base field element: %v", err)
+	}
+	return e
+}
+
+func (r *proofReader) fr() fr.Element {
+	var e fr.Element
+	bz := r.next(fr.Bytes)
+	if bz == nil {
+		return e
+	}
+	if err := e.SetBytesCanonical(bz); err != nil {
+		r.fail("scalar field element: %v", err)
+	}
+	return e
+}
+
+func (r *proofReader) g1() bn254.G1Affine {
+	var p bn254.G1Affine
+	bz := r.next(g1Size)
+	if bz == nil {
+		return p
+	}
+	p.X = r.fp(bz[:fp.Bytes])
+	p.Y = r.fp(bz[fp.Bytes:])
+	if p.IsInfinity() || !p.IsOnCurve() {
+		r.fail("G1 point is not on the curve")
+	}
+	return p
+}
+
+// g2 reads the imaginary part of each coordinate before its real part.
+func (r *proofReader) g2() bn254.G2Affine {
+	var p bn254.G2Affine
+	bz := r.next(g2Size)
+	if bz == nil {
+		return p
+	}
+	p.X.A1 = r.fp(bz[:fp.Bytes])
+	p.X.A0 = r.fp(bz[fp.Bytes : 2*fp.Bytes])
+	p.Y.A1 = r.fp(bz[2*fp.Bytes : 3*fp.Bytes])
+	p.Y.A0 = r.fp(bz[3*fp.Bytes:])
+	if p.IsInfinity() || !p.IsOnCurve() || !p.IsInSubGroup() {
+		r.fail("G2 point is not in the subgroup")
+	}
+	return p
+}
+
+// Err returns the first decoding error.
+func (r *proofReader) Err() error {
+	return r.err
+}
@@ -0,0 +1,68 @@
+package sp1verifier
+
+import (
+	"io"
+
+	groth16 "github.com/consensys/gnark/backend/groth16/bn254"
+)
+
+var groth16Key lazyKey[groth16.VerifyingKey]
+
+func decodeGroth16Key(r io.Reader, vk *groth16.VerifyingKey) error {
+	_, err := vk.ReadFrom(r)
+	return err
+}
+
+// LoadGroth16VerifyingKey decodes the embedded Groth16 verifying key. Nodes
+// call it at startup so a missing or wrong key fails before any proof is
+// verified.
+func LoadGroth16VerifyingKey() error {
+	_, err := groth16Key.load(groth16VKFile, Groth16VerifierHash, decodeGroth16Key)
+	return err
+}
+
+// VerifyGroth16 verifies an SP1 Groth16 proof of the program with the given
+// verifying key hash and public values.
+func VerifyGroth16(proof, publicValues []byte, programVKey [32]byte) error {
+	vk, err := groth16Key.load(groth16VKFile, Groth16VerifierHash, decodeGroth16Key)
+	if err != nil {
+		return err
+	}
+	return verifyGroth16(vk, proof, publicValues, programVKey)
+}
+
+func verifyGroth16(vk verifyingKey[groth16.VerifyingKey], proof, publicValues []byte, programVKey [32]byte) error {
+	gnarkProof, err := splitProof(proof, vk.prefix)
+	if err != nil {
+		return err
+	}
+	inputs, err := publicInputs(programVKey, publicValues)
+	if err != nil {
+		return err
+	}
+	p, err := decodeGroth16Proof(gnarkProof)
+	if err != nil {
+		return err
+	}
+
+	for _, witness := range inputs {
+		if err := groth16.Verify(p, &vk.key, witness); err == nil {
+			return nil
+		}
+	}
+	return ErrProofVerificationFailed
+}
+
+// decodeGroth16Proof decodes the A, B and C points of a gnark Groth16 proof in
+// Solidity encoding. SP1 proofs carry no Pedersen commitments.
+func decodeGroth16Proof(bz []byte) (*groth16.Proof, error) {
+	r := proofReader{buf: bz}
+	p := &groth16.Proof{}
+	p.Ar = r.g1()
+	p.Bs = r.g2()
+	p.Krs = r.g1()
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
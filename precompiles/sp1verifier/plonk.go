@@ -0,0 +1,241 @@
+package sp1verifier
+
+import (
+	"crypto/sha256"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/hash_to_field"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	plonk "github.com/consensys/gnark/backend/plonk/bn254"
+)
+
+// bsb22DST is the domain separation tag gnark hashes BSB22 commitments with.
+const bsb22DST = "BSB22-Plonk"
+
+var plonkKey lazyKey[plonk.VerifyingKey]
+
+func decodePlonkKey(r io.Reader, vk *plonk.VerifyingKey) error {
+	_, err := vk.ReadFrom(r)
+	return err
+}
+
+// LoadPlonkVerifyingKey decodes the embedded Plonk verifying key. Nodes call
+// it at startup so a missing or wrong key fails before any proof is verified.
+func LoadPlonkVerifyingKey() error {
+	_, err := plonkKey.load(plonkVKFile, PlonkVerifierHash, decodePlonkKey)
+	return err
+}
+
+// VerifyPlonk verifies an SP1 Plonk proof of the program with the given
+// verifying key hash and public values.
+func VerifyPlonk(proof, publicValues []byte, programVKey [32]byte) error {
+	vk, err := plonkKey.load(plonkVKFile, PlonkVerifierHash, decodePlonkKey)
+	if err != nil {
+		return err
+	}
+	return verifyPlonk(vk, proof, publicValues, programVKey)
+}
+
+func verifyPlonk(vk verifyingKey[plonk.VerifyingKey], proof, publicValues []byte, programVKey [32]byte) error {
+	gnarkProof, err := splitProof(proof, vk.prefix)
+	if err != nil {
+		return err
+	}
+	inputs, err := publicInputs(programVKey, publicValues)
+	if err != nil {
+		return err
+	}
+	p, err := decodePlonkProof(gnarkProof, len(vk.key.Qcp))
+	if err != nil {
+		return err
+	}
+
+	for _, witness := range inputs {
+		// The opening of the linearised polynomial depends on the public
+		// inputs, so it is set for each candidate digest.
+		opening, err := linearisedPolynomialOpening(p, &vk.key, witness)
+		if err != nil {
+			continue
+		}
+		p.BatchedProof.ClaimedValues[0] = opening
+		if err := plonk.Verify(p, &vk.key, witness); err == nil {
+			return nil
+		}
+	}
+	return ErrProofVerificationFailed
+}
+
+// decodePlonkProof decodes a gnark Plonk proof in Solidity encoding, the
+// inverse of plonk.Proof.MarshalSolidity. The opening of the linearised
+// polynomial is not part of the encoding, see linearisedPolynomialOpening.
+func decodePlonkProof(bz []byte, nbCommitments int) (*plonk.Proof, error) {
+	r := proofReader{buf: bz}
+	p := &plonk.Proof{}
+	p.BatchedProof.ClaimedValues = make([]fr.Element, 6+nbCommitments)
+	p.Bsb22Commitments = make([]bn254.G1Affine, nbCommitments)
+
+	for i := range p.LRO {
+		p.LRO[i] = r.g1()
+	}
+	for i := range p.H {
+		p.H[i] = r.g1()
+	}
+	// l, r, o, s1 and s2 at zeta
+	for i := 1; i < 6; i++ {
+		p.BatchedProof.ClaimedValues[i] = r.fr()
+	}
+	p.Z = r.g1()
+	p.ZShiftedOpening.ClaimedValue = r.fr()
+	p.BatchedProof.H = r.g1()
+	p.ZShiftedOpening.H = r.g1()
+	for i := 0; i < nbCommitments; i++ {
+		p.BatchedProof.ClaimedValues[6+i] = r.fr()
+	}
+	for i := range p.Bsb22Commitments {
+		p.Bsb22Commitments[i] = r.g1()
+	}
+
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// linearisedPolynomialOpening recomputes the opening of the linearised
+// polynomial at zeta, which the Solidity encoding leaves out because the
+// verifier can derive it. It follows plonk.Verify up to the check of that
+// opening, with gnark's default SHA-256 transcript.
+func linearisedPolynomialOpening(p *plonk.Proof, vk *plonk.VerifyingKey, publicWitness fr.Vector) (fr.Element, error) {
+	var opening fr.Element
+	if len(p.Bsb22Commitments) != len(vk.Qcp) || len(publicWitness) != int(vk.NbPublicVariables) { //nolint:gosec // G115 // public inputs are few
+		return opening, ErrInvalidProof
+	}
+
+	fs := fiatshamir.NewTranscript(sha256.New(), "gamma", "beta", "alpha", "zeta")
+	if err := bindPublicData(fs, vk, publicWitness); err != nil {
+		return opening, err
+	}
+	gamma, err := deriveRandomness(fs, "gamma", &p.LRO[0], &p.LRO[1], &p.LRO[2])
+	if err != nil {
+		return opening, err
+	}
+	beta, err := deriveRandomness(fs, "beta")
+	if err != nil {
+		return opening, err
+	}
+	alphaDeps := make([]*bn254.G1Affine, len(p.Bsb22Commitments)+1)
+	for i := range p.Bsb22Commitments {
+		alphaDeps[i] = &p.Bsb22Commitments[i]
+	}
+	alphaDeps[len(alphaDeps)-1] = &p.Z
+	alpha, err := deriveRandomness(fs, "alpha", alphaDeps...)
+	if err != nil {
+		return opening, err
+	}
+	zeta, err := deriveRandomness(fs, "zeta", &p.H[0], &p.H[1], &p.H[2])
+	if err != nil {
+		return opening, err
+	}
+
+	// ζⁿ-1 and L₁(ζ) = (ζⁿ-1)/(n(ζ-1))
+	var zetaPowerN, zhZeta, lagrangeZero fr.Element
+	one := fr.One()
+	zetaPowerN.Exp(zeta, new(big.Int).SetUint64(vk.Size))
+	zhZeta.Sub(&zetaPowerN, &one)
+	lagrangeZero.Sub(&zeta, &one).
+		Inverse(&lagrangeZero).
+		Mul(&lagrangeZero, &zhZeta).
+		Mul(&lagrangeZero, &vk.SizeInv)
+
+	// PI(ζ) = ∑ Lᵢ(ζ)wᵢ over the public inputs and the hashed commitments
+	var pi, accw, xiLi fr.Element
+	dens := make([]fr.Element, len(publicWitness))
+	accw.SetOne()
+	for i := range publicWitness {
+		dens[i].Sub(&zeta, &accw)
+		accw.Mul(&accw, &vk.Generator)
+	}
+	invDens := fr.BatchInvert(dens)
+	accw.SetOne()
+	for i := range publicWitness {
+		xiLi.Mul(&zhZeta, &invDens[i]).
+			Mul(&xiLi, &vk.SizeInv).
+			Mul(&xiLi, &accw).
+			Mul(&xiLi, &publicWitness[i])
+		accw.Mul(&accw, &vk.Generator)
+		pi.Add(&pi, &xiLi)
+	}
+
+	hashToField := hash_to_field.New([]byte(bsb22DST))
+	nbBuf := min(hashToField.Size(), fr.Bytes)
+	var hashedCmt, wPowI, den, lagrange fr.Element
+	for i, cci := range vk.CommitmentConstraintIndexes {
+		hashToField.Write(p.Bsb22Commitments[i].Marshal())
+		hashedCmt.SetBytes(hashToField.Sum(nil)[:nbBuf])
+		hashToField.Reset()
+
+		wPowI.Exp(vk.Generator, new(big.Int).SetUint64(vk.NbPublicVariables+cci))
+		den.Sub(&zeta, &wPowI)
+		lagrange.SetOne().
+			Sub(&zetaPowerN, &lagrange).
+			Mul(&lagrange, &wPowI).
+			Div(&lagrange, &den).
+			Mul(&lagrange, &vk.SizeInv)
+		xiLi.Mul(&lagrange, &hashedCmt)
+		pi.Add(&pi, &xiLi)
+	}
+
+	l := p.BatchedProof.ClaimedValues[1]
+	r := p.BatchedProof.ClaimedValues[2]
+	o := p.BatchedProof.ClaimedValues[3]
+	s1 := p.BatchedProof.ClaimedValues[4]
+	s2 := p.BatchedProof.ClaimedValues[5]
+	zu := p.ZShiftedOpening.ClaimedValue
+
+	// -[PI(ζ) - α²L₁(ζ) + α(l(ζ)+βs1(ζ)+γ)(r(ζ)+βs2(ζ)+γ)(o(ζ)+γ)z(ωζ)]
+	var alphaSquareLagrangeZero, tmp fr.Element
+	alphaSquareLagrangeZero.Mul(&lagrangeZero, &alpha).Mul(&alphaSquareLagrangeZero, &alpha)
+	opening.Mul(&beta, &s1).Add(&opening, &gamma).Add(&opening, &l)
+	tmp.Mul(&s2, &beta).Add(&tmp, &gamma).Add(&tmp, &r)
+	opening.Mul(&opening, &tmp)
+	tmp.Add(&o, &gamma)
+	opening.Mul(&tmp, &opening).Mul(&opening, &alpha).Mul(&opening, &zu)
+	opening.Sub(&opening, &alphaSquareLagrangeZero).Add(&opening, &pi)
+	opening.Neg(&opening)
+	return opening, nil
+}
+
+func bindPublicData(fs *fiatshamir.Transcript, vk *plonk.VerifyingKey, publicInputs fr.Vector) error {
+	digests := []bn254.G1Affine{vk.S[0], vk.S[1], vk.S[2], vk.Ql, vk.Qr, vk.Qm, vk.Qo, vk.Qk}
+	digests = append(digests, vk.Qcp...)
+	for i := range digests {
+		if err := fs.Bind("gamma", digests[i].Marshal()); err != nil {
+			return err
+		}
+	}
+	for i := range publicInputs {
+		if err := fs.Bind("gamma", publicInputs[i].Marshal()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deriveRandomness(fs *fiatshamir.Transcript, challenge string, points ...*bn254.G1Affine) (fr.Element, error) {
+	var r fr.Element
+	for _, p := range points {
+		buf := p.RawBytes()
+		if err := fs.Bind(challenge, buf[:]); err != nil {
+			return r, err
+		}
+	}
+	b, err := fs.ComputeChallenge(challenge)
+	if err != nil {
+		return r, err
+	}
+	r.SetBytes(b)
+	return r, nil
+}
@@ -0,0 +1,144 @@
+// Package sp1verifier verifies SP1 Groth16 and Plonk proofs in pure Go, on
+// top of gnark's BN254 verifiers. It follows the checks of the sp1-verifier
+// crate wrapped by rust/sp1verifier, against the same verifying keys.
+package sp1verifier
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"lukechampine.com/blake3"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/logger"
+)
+
+func init() {
+	// gnark logs every verification to stdout by default.
+	logger.Disable()
+}
+
+var (
+	// ErrVerifierHashMismatch is returned when the proof was not generated
+	// for the embedded verifying key.
+	ErrVerifierHashMismatch = errors.New("verifier hash mismatch (proof generated for another verifying key)")
+	// ErrProofVerificationFailed is returned when a well-formed proof does
+	// not verify.
+	ErrProofVerificationFailed = errors.New("proof verification failed")
+	// ErrInvalidProof is returned when the proof or the program verifying
+	// key cannot be decoded.
+	ErrInvalidProof = errors.New("invalid proof encoding")
+	// ErrVerifyingKeyUnavailable is returned when the verifying key is not
+	// embedded in the binary or does not match its verifier hash.
+	ErrVerifyingKeyUnavailable = errors.New("verifying key unavailable")
+)
+
+const (
+	// Groth16VerifierHash is the SHA-256 of the SP1 v5 Groth16 verifying key,
+	// exposed by the SP1VerifierGroth16 contract as VERIFIER_HASH.
+	Groth16VerifierHash = "a4594c59bbc142f3b81c3ecb7f50a7c34bc9af7c4c444b5d48b795427e285913"
+	// PlonkVerifierHash is the SHA-256 of the SP1 v5 Plonk verifying key,
+	// exposed by the SP1VerifierPlonk contract as VERIFIER_HASH.
+	PlonkVerifierHash = "d4e8ecd2357dd882209800acd6abb443d231cf287d77ba62b732ce937c8b56e7"
+
+	groth16VKFile = "vk/groth16_vk.bin"
+	plonkVKFile   = "vk/plonk_vk.bin"
+
+	// verifierHashPrefixLen is the number of verifier hash bytes that
+	// prefix every SP1 proof.
+	verifierHashPrefixLen = 4
+)
+
+// The verifying keys are copied from the bn254-vk directory of the
+// sp1-verifier crate, see vk/README.md.
+//
+//go:embed vk
+var vkFS embed.FS
+
+// verifyingKey is a decoded verifying key with the hash prefix its proofs
+// start with.
+type verifyingKey[T any] struct {
+	key    T
+	prefix [verifierHashPrefixLen]byte
+}
+
+// lazyKey decodes an embedded verifying key once, after checking it against
+// its pinned verifier hash.
+type lazyKey[T any] struct {
+	once sync.Once
+	vk   verifyingKey[T]
+	err  error
+}
+
+func (l *lazyKey[T]) load(file, verifierHash string, decode func(io.Reader, *T) error) (verifyingKey[T], error) {
+	l.once.Do(func() {
+		bz, err := vkFS.ReadFile(file)
+		if err != nil {
+			l.err = fmt.Errorf("%w: %s is not embedded: %v", ErrVerifyingKeyUnavailable, file, err)
+			return
+		}
+		l.vk, l.err = newVerifyingKey(bz, verifierHash, decode)
+	})
+	return l.vk, l.err
+}
+
+func newVerifyingKey[T any](bz []byte, verifierHash string, decode func(io.Reader, *T) error) (verifyingKey[T], error) {
+	var vk verifyingKey[T]
+	sum := sha256.Sum256(bz)
+	if verifierHash != "" && hex.EncodeToString(sum[:]) != verifierHash {
+		return vk, fmt.Errorf("%w: sha256 %x does not match verifier hash %s", ErrVerifyingKeyUnavailable, sum, verifierHash)
+	}
+
+	r := bytes.NewReader(bz)
+	if err := decode(r, &vk.key); err != nil {
+		return vk, fmt.Errorf("%w: %v", ErrVerifyingKeyUnavailable, err)
+	}
+	if r.Len() != 0 {
+		return vk, fmt.Errorf("%w: %d trailing bytes", ErrVerifyingKeyUnavailable, r.Len())
+	}
+	copy(vk.prefix[:], sum[:verifierHashPrefixLen])
+	return vk, nil
+}
+
+// splitProof checks the verifier hash prefix of an SP1 proof and returns the
+// gnark proof that follows it.
+func splitProof(proof []byte, prefix [verifierHashPrefixLen]byte) ([]byte, error) {
+	if len(proof) < verifierHashPrefixLen {
+		return nil, fmt.Errorf("%w: proof is shorter than the verifier hash prefix", ErrInvalidProof)
+	}
+	if !bytes.Equal(proof[:verifierHashPrefixLen], prefix[:]) {
+		return nil, ErrVerifierHashMismatch
+	}
+	return proof[verifierHashPrefixLen:], nil
+}
+
+// publicInputs returns the public inputs of the SP1 wrapper circuits for
+// both public values digests SP1 accepts, SHA-256 first and then BLAKE3.
+func publicInputs(programVKey [32]byte, publicValues []byte) ([2]fr.Vector, error) {
+	var vkey fr.Element
+	if err := vkey.SetBytesCanonical(programVKey[:]); err != nil {
+		return [2]fr.Vector{}, fmt.Errorf("%w: program verifying key is not a BN254 scalar: %v", ErrInvalidProof, err)
+	}
+
+	sha := sha256.Sum256(publicValues)
+	b3 := blake3.Sum256(publicValues)
+	return [2]fr.Vector{
+		{vkey, publicValuesDigest(sha)},
+		{vkey, publicValuesDigest(b3)},
+	}, nil
+}
+
+// publicValuesDigest clears the top three bits of the hash so it fits in a
+// BN254 scalar, as SP1 does when committing to the public values.
+func publicValuesDigest(hash [32]byte) fr.Element {
+	hash[0] &= 0x1f
+	var digest fr.Element
+	digest.SetBytes(hash[:])
+	return digest
+}
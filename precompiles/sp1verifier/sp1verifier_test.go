@@ -0,0 +1,236 @@
+package sp1verifier
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"lukechampine.com/blake3"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/plonk"
+	plonkbn254 "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/test/unsafekzg"
+
+	"github.com/cosmos/evm/precompiles/testutil"
+)
+
+// wrapperCircuit has the public inputs of the SP1 wrapper circuits. The
+// Plonk variant commits to its witness so the proof carries a BSB22
+// commitment, as SP1 Plonk proofs do.
+type wrapperCircuit struct {
+	VKeyHash              frontend.Variable `gnark:",public"`
+	CommittedValuesDigest frontend.Variable `gnark:",public"`
+	Secret                frontend.Variable
+
+	commit bool
+}
+
+func (c *wrapperCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Add(c.Secret, c.VKeyHash), c.CommittedValuesDigest)
+	if c.commit {
+		cmt, err := api.(frontend.Committer).Commit(c.Secret)
+		if err != nil {
+			return err
+		}
+		api.AssertIsDifferent(cmt, 0)
+	}
+	return nil
+}
+
+var (
+	testProgramVKey  = [32]byte{0: 0x00, 1: 0x12, 31: 0x34}
+	testPublicValues = []byte("sp1 public values")
+)
+
+func assignment(programVKey [32]byte, digest fr.Element) *wrapperCircuit {
+	var vkey, secret fr.Element
+	vkey.SetBytes(programVKey[:])
+	secret.Sub(&digest, &vkey)
+	return &wrapperCircuit{VKeyHash: vkey, CommittedValuesDigest: digest, Secret: secret}
+}
+
+func sha256Digest(publicValues []byte) fr.Element {
+	return publicValuesDigest(sha256.Sum256(publicValues))
+}
+
+func blake3Digest(publicValues []byte) fr.Element {
+	return publicValuesDigest(blake3.Sum256(publicValues))
+}
+
+// groth16Prover proves the wrapper circuit under a fresh Groth16 key.
+func groth16Prover(t *testing.T) (verifyingKey[groth16bn254.VerifyingKey], func(digest fr.Element) []byte) {
+	t.Helper()
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &wrapperCircuit{})
+	require.NoError(t, err)
+	pk, vk, err := groth16.Setup(ccs)
+	require.NoError(t, err)
+
+	var vkBz bytes.Buffer
+	_, err = vk.WriteTo(&vkBz)
+	require.NoError(t, err)
+	key, err := newVerifyingKey(vkBz.Bytes(), "", decodeGroth16Key)
+	require.NoError(t, err)
+
+	return key, func(digest fr.Element) []byte {
+		w, err := frontend.NewWitness(assignment(testProgramVKey, digest), ecc.BN254.ScalarField())
+		require.NoError(t, err)
+		proof, err := groth16.Prove(ccs, pk, w)
+		require.NoError(t, err)
+		return append(key.prefix[:], proof.(*groth16bn254.Proof).MarshalSolidity()...)
+	}
+}
+
+// plonkProver proves the wrapper circuit under a fresh Plonk key.
+func plonkProver(t *testing.T) (verifyingKey[plonkbn254.VerifyingKey], func(digest fr.Element) []byte) {
+	t.Helper()
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &wrapperCircuit{commit: true})
+	require.NoError(t, err)
+	srs, srsLagrange, err := unsafekzg.NewSRS(ccs)
+	require.NoError(t, err)
+	pk, vk, err := plonk.Setup(ccs, srs, srsLagrange)
+	require.NoError(t, err)
+
+	var vkBz bytes.Buffer
+	_, err = vk.WriteTo(&vkBz)
+	require.NoError(t, err)
+	key, err := newVerifyingKey(vkBz.Bytes(), "", decodePlonkKey)
+	require.NoError(t, err)
+	require.Len(t, key.key.Qcp, 1, "expected one BSB22 commitment")
+
+	return key, func(digest fr.Element) []byte {
+		w, err := frontend.NewWitness(assignment(testProgramVKey, digest), ecc.BN254.ScalarField())
+		require.NoError(t, err)
+		proof, err := plonk.Prove(ccs, pk, w)
+		require.NoError(t, err)
+		return append(key.prefix[:], proof.(*plonkbn254.Proof).MarshalSolidity()...)
+	}
+}
+
+func flip(bz []byte, i int) []byte {
+	out := append([]byte{}, bz...)
+	out[i] ^= 0x01
+	return out
+}
+
+type verifyFn func(proof, publicValues []byte, programVKey [32]byte) error
+
+func testVerifier(t *testing.T, verify verifyFn, proof, blake3Proof []byte) {
+	t.Helper()
+	outOfField := [32]byte{0: 0xff}
+
+	testCases := []struct {
+		name         string
+		proof        []byte
+		publicValues []byte
+		programVKey  [32]byte
+		expErr       error
+	}{
+		{"valid proof", proof, testPublicValues, testProgramVKey, nil},
+		{"valid proof with blake3 digest", blake3Proof, testPublicValues, testProgramVKey, nil},
+		{"wrong public values", proof, []byte("other public values"), testProgramVKey, ErrProofVerificationFailed},
+		{"wrong program vkey", proof, testPublicValues, [32]byte{31: 0x35}, ErrProofVerificationFailed},
+		{"program vkey out of field", proof, testPublicValues, outOfField, ErrInvalidProof},
+		{"verifier hash mismatch", flip(proof, 0), testPublicValues, testProgramVKey, ErrVerifierHashMismatch},
+		{"proof shorter than prefix", proof[:3], testPublicValues, testProgramVKey, ErrInvalidProof},
+		{"truncated proof", proof[:len(proof)-1], testPublicValues, testProgramVKey, ErrInvalidProof},
+		{"point not on curve", flip(proof, verifierHashPrefixLen+g1Size-1), testPublicValues, testProgramVKey, ErrInvalidProof},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verify(tc.proof, tc.publicValues, tc.programVKey)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestVerifyGroth16(t *testing.T) {
+	key, prove := groth16Prover(t)
+	proof := prove(sha256Digest(testPublicValues))
+	blake3Proof := prove(blake3Digest(testPublicValues))
+
+	testVerifier(t, func(proof, publicValues []byte, programVKey [32]byte) error {
+		return verifyGroth16(key, proof, publicValues, programVKey)
+	}, proof, blake3Proof)
+}
+
+func TestVerifyPlonk(t *testing.T) {
+	key, prove := plonkProver(t)
+	proof := prove(sha256Digest(testPublicValues))
+	blake3Proof := prove(blake3Digest(testPublicValues))
+
+	testVerifier(t, func(proof, publicValues []byte, programVKey [32]byte) error {
+		return verifyPlonk(key, proof, publicValues, programVKey)
+	}, proof, blake3Proof)
+
+	t.Run("tampered scalar", func(t *testing.T) {
+		// l(ζ) follows the six G1 points of the proof.
+		err := verifyPlonk(key, flip(proof, verifierHashPrefixLen+6*g1Size+fr.Bytes-1), testPublicValues, testProgramVKey)
+		require.ErrorIs(t, err, ErrProofVerificationFailed)
+	})
+}
+
+func TestNewVerifyingKeyChecksVerifierHash(t *testing.T) {
+	_, err := newVerifyingKey([]byte("not a key"), Groth16VerifierHash, decodeGroth16Key)
+	require.ErrorIs(t, err, ErrVerifyingKeyUnavailable)
+	require.ErrorContains(t, err, "does not match verifier hash")
+}
+
+func TestEmbeddedVerifyingKeys(t *testing.T) {
+	for _, tc := range []struct {
+		file string
+		load func() error
+	}{
+		{groth16VKFile, LoadGroth16VerifyingKey},
+		{plonkVKFile, LoadPlonkVerifyingKey},
+	} {
+		t.Run(tc.file, func(t *testing.T) {
+			// The Groth16 key is committed, the Plonk key is copied by make sp1-vk.
+			if _, err := fs.Stat(vkFS, tc.file); tc.file == plonkVKFile && errors.Is(err, fs.ErrNotExist) {
+				t.Skipf("%s is not embedded, run make sp1-vk", tc.file)
+			}
+			require.NoError(t, tc.load())
+		})
+	}
+}
+
+// TestRecordedProofs checks the embedded verifying keys against proofs
+// recorded with the SP1 SDK.
+func TestRecordedProofs(t *testing.T) {
+	for _, tc := range []struct {
+		system string
+		verify verifyFn
+	}{
+		{"groth16", VerifyGroth16},
+		{"plonk", VerifyPlonk},
+	} {
+		t.Run(tc.system, func(t *testing.T) {
+			fixtures := testutil.LoadSP1Fixtures(t, tc.system)
+			if tc.system == "plonk" && len(fixtures) == 0 {
+				t.Skip("no plonk fixtures in testdata")
+			}
+			require.NotEmpty(t, fixtures)
+
+			for _, fixture := range fixtures {
+				for _, c := range testutil.SP1Cases(fixture) {
+					err := tc.verify(c.Proof, c.PublicValues, c.VKey)
+					require.Equal(t, c.Valid, err == nil, "%s/%s: %v", fixture.Name, c.Name, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+//go:build sp1go
+
+package sp1verifiergroth16
+
+import "github.com/cosmos/evm/precompiles/sp1verifier"
+
+func init() {
+	// Fail at startup rather than on the first proof if the key is missing.
+	if err := sp1verifier.LoadGroth16VerifyingKey(); err != nil {
+		panic(err)
+	}
+}
+
+// verifyGroth16 verifies the proof with the pure-Go verifier of the sp1verifier
+// package.
+func verifyGroth16(proof, publicValues []byte, programVKey [32]byte) error {
+	return sp1verifier.VerifyGroth16(proof, publicValues, programVKey)
+}
@@ -0,0 +1,62 @@
+//go:build !sp1go
+
+package sp1verifiergroth16
+
+/*
+#cgo darwin LDFLAGS: -L${SRCDIR}/../../rust/sp1verifier/target/release -lsp1verifier
+#cgo linux  LDFLAGS: -L${SRCDIR}/../../rust/sp1verifier/target/release -lsp1verifier
+#include <stdint.h>
+#include <stdlib.h>
+
+// Declare the Rust function
+extern int verify_groth16_c(const uint8_t* proof_ptr, size_t proof_len,
+                         const uint8_t* inputs_ptr, size_t inputs_len,
+                         const char* hash_ptr);
+*/
+import "C"
+
+import (
+	"encoding/hex"
+	"fmt"
+	"unsafe"
+
+	"github.com/cosmos/evm/precompiles/sp1verifier"
+)
+
+// verifyGroth16 verifies the proof with the sp1-verifier crate through
+// rust/sp1verifier. Build with the sp1go tag to use the pure-Go verifier.
+func verifyGroth16(proof, publicValues []byte, programVKey [32]byte) error {
+	return rustVerifierError(callGroth16Verifier(proof, publicValues, "0x"+hex.EncodeToString(programVKey[:])))
+}
+
+// rustVerifierError maps the result codes of verify_groth16_c to the errors of
+// the pure-Go verifier, so both backends fail the same way.
+func rustVerifierError(res int) error {
+	switch res {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("%w: null or empty pointer (proof/publicInputs/hash)", sp1verifier.ErrInvalidProof)
+	case 2:
+		return fmt.Errorf("%w: invalid UTF-8 in programVKey hash", sp1verifier.ErrInvalidProof)
+	case 3:
+		return sp1verifier.ErrProofVerificationFailed
+	case 4:
+		return sp1verifier.ErrVerifierHashMismatch
+	case 5:
+		return fmt.Errorf("%w: internal verifier error", sp1verifier.ErrInvalidProof)
+	default:
+		return fmt.Errorf("unknown error code %d", res)
+	}
+}
+
+func callGroth16Verifier(proof []byte, publicValues []byte, hash string) int {
+	hashStr := C.CString(hash)
+	defer C.free(unsafe.Pointer(hashStr))
+
+	return int(C.verify_groth16_c(
+		(*C.uint8_t)(unsafe.Pointer(&proof[0])), C.size_t(len(proof)),
+		(*C.uint8_t)(unsafe.Pointer(&publicValues[0])), C.size_t(len(publicValues)),
+		hashStr,
+	))
+}
@@ -1,26 +1,13 @@
 package sp1verifiergroth16
 
-/*
-#cgo darwin LDFLAGS: -L${SRCDIR}/../../rust/sp1verifier/target/release -lsp1verifier
-#cgo linux  LDFLAGS: -L${SRCDIR}/../../rust/sp1verifier/target/release -lsp1verifier
-#include <stdint.h>
-#include <stdlib.h>
-
-// Declare the Rust function
-extern int verify_groth16_c(const uint8_t* proof_ptr, size_t proof_len,
-                         const uint8_t* inputs_ptr, size_t inputs_len,
-                         const char* hash_ptr);
-*/
-import "C"
-
 import (
 	"encoding/hex"
 	"fmt"
-	"unsafe"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/sp1verifier"
 )
 
 const (
@@ -30,10 +17,10 @@ const (
 )
 
 var verifierHash [32]byte
-var verifyGroth16Fn = callGroth16Verifier
+var verifyGroth16Fn = verifyGroth16
 
 func init() {
-	b, err := hex.DecodeString("a4594c59bbc142f3b81c3ecb7f50a7c34bc9af7c4c444b5d48b795427e285913")
+	b, err := hex.DecodeString(sp1verifier.Groth16VerifierHash)
 	if err != nil {
 		panic(err)
 	}
@@ -73,35 +60,10 @@ func (p Precompile) verifyProof(
 		return nil, fmt.Errorf("proof exceeds %d bytes", maxSP1ProofSize)
 	}
 
-	res := verifyGroth16Fn(proof, publicValues, "0x"+hex.EncodeToString(programVKey[:]))
-
-	switch res {
-	case 0:
-		return nil, nil
-	case 1:
-		return nil, fmt.Errorf("groth16 verifier: null or empty pointer (proof/publicInputs/hash)")
-	case 2:
-		return nil, fmt.Errorf("groth16 verifier: invalid UTF-8 in programVKey hash")
-	case 3:
-		return nil, fmt.Errorf("groth16 verifier: proof verification failed")
-	case 4:
-		return nil, fmt.Errorf("groth16 verifier: Groth16 vkey hash mismatch (wrong programVKey?)")
-	case 5:
-		return nil, fmt.Errorf("groth16 verifier: internal verifier error")
-	default:
-		return nil, fmt.Errorf("groth16 verifier: unknown error code %d", res)
+	if err := verifyGroth16Fn(proof, publicValues, programVKey); err != nil {
+		return nil, fmt.Errorf("groth16 verifier: %w", err)
 	}
-}
-
-func callGroth16Verifier(proof []byte, publicValues []byte, hash string) int {
-	hashStr := C.CString(hash)
-	defer C.free(unsafe.Pointer(hashStr))
-
-	return int(C.verify_groth16_c(
-		(*C.uint8_t)(unsafe.Pointer(&proof[0])), C.size_t(len(proof)),
-		(*C.uint8_t)(unsafe.Pointer(&publicValues[0])), C.size_t(len(publicValues)),
-		hashStr,
-	))
+	return nil, nil
 }
 
 func (p Precompile) VERIFIER_HASH(method *abi.Method, args []interface{}) ([]byte, error) {
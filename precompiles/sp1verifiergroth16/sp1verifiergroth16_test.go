@@ -53,7 +53,7 @@ func TestRunRecoversVerifierPanics(t *testing.T) {
 	require.NoError(t, err)
 
 	original := verifyGroth16Fn
-	verifyGroth16Fn = func([]byte, []byte, string) int {
+	verifyGroth16Fn = func([]byte, []byte, [32]byte) error {
 		panic("boom")
 	}
 	defer func() {
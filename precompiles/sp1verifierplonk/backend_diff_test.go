@@ -0,0 +1,41 @@
+//go:build !sp1go
+
+package sp1verifierplonk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/precompiles/sp1verifier"
+	"github.com/cosmos/evm/precompiles/testutil"
+)
+
+// TestBackendsAgree checks the Rust and pure-Go verifiers accept and reject
+// the same recorded proofs.
+func TestBackendsAgree(t *testing.T) {
+	fixtures := testutil.LoadSP1Fixtures(t, "plonk")
+	if len(fixtures) == 0 {
+		t.Skip("no plonk fixtures in precompiles/sp1verifier/testdata")
+	}
+	if err := sp1verifier.LoadPlonkVerifyingKey(); err != nil {
+		t.Skipf("pure-Go verifier unavailable: %v", err)
+	}
+
+	for _, fixture := range fixtures {
+		for _, tc := range testutil.SP1Cases(fixture) {
+			rustErr := verifyPlonk(tc.Proof, tc.PublicValues, tc.VKey)
+			goErr := sp1verifier.VerifyPlonk(tc.Proof, tc.PublicValues, tc.VKey)
+
+			name := fixture.Name + "/" + tc.Name
+			require.Equal(t, tc.Valid, rustErr == nil, "%s: rust: %v", name, rustErr)
+			require.Equal(t, tc.Valid, goErr == nil, "%s: go: %v", name, goErr)
+			require.Equal(t,
+				errors.Is(rustErr, sp1verifier.ErrVerifierHashMismatch),
+				errors.Is(goErr, sp1verifier.ErrVerifierHashMismatch),
+				"%s: rust: %v, go: %v", name, rustErr, goErr,
+			)
+		}
+	}
+}
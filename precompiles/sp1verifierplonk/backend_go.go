@@ -0,0 +1,18 @@
+//go:build sp1go
+
+package sp1verifierplonk
+
+import "github.com/cosmos/evm/precompiles/sp1verifier"
+
+func init() {
+	// Fail at startup rather than on the first proof if the key is missing.
+	if err := sp1verifier.LoadPlonkVerifyingKey(); err != nil {
+		panic(err)
+	}
+}
+
+// verifyPlonk verifies the proof with the pure-Go verifier of the sp1verifier
+// package.
+func verifyPlonk(proof, publicValues []byte, programVKey [32]byte) error {
+	return sp1verifier.VerifyPlonk(proof, publicValues, programVKey)
+}
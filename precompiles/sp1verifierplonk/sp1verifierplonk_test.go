@@ -53,7 +53,7 @@ func TestRunRecoversVerifierPanics(t *testing.T) {
 	require.NoError(t, err)
 
 	original := verifyPlonkFn
-	verifyPlonkFn = func([]byte, []byte, string) int {
+	verifyPlonkFn = func([]byte, []byte, [32]byte) error {
 		panic("boom")
 	}
 	defer func() {
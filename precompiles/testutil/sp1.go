@@ -0,0 +1,92 @@
+package testutil
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+// SP1Fixture is a proof recorded with the SP1 SDK, in the JSON format of the
+// fixtures written by the SP1 project template.
+type SP1Fixture struct {
+	Name         string
+	VKey         [32]byte
+	PublicValues []byte
+	Proof        []byte
+}
+
+// SP1Case is a proof to verify and whether it is valid.
+type SP1Case struct {
+	Name         string
+	VKey         [32]byte
+	PublicValues []byte
+	Proof        []byte
+	Valid        bool
+}
+
+// LoadSP1Fixtures loads the recorded fixtures of the proof system ("groth16"
+// or "plonk") from precompiles/sp1verifier/testdata.
+func LoadSP1Fixtures(t *testing.T, system string) []SP1Fixture {
+	t.Helper()
+	_, file, _, ok := runtime.Caller(0)
+	require.True(t, ok)
+	pattern := filepath.Join(filepath.Dir(file), "..", "sp1verifier", "testdata", system+"-*.json")
+	paths, err := filepath.Glob(pattern)
+	require.NoError(t, err)
+
+	fixtures := make([]SP1Fixture, 0, len(paths))
+	for _, path := range paths {
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var raw struct {
+			VKey         string `json:"vkey"`
+			PublicValues string `json:"publicValues"`
+			Proof        string `json:"proof"`
+		}
+		require.NoError(t, json.Unmarshal(bz, &raw), path)
+
+		fixture := SP1Fixture{Name: filepath.Base(path)}
+		vkey, err := hexutil.Decode(raw.VKey)
+		require.NoError(t, err, path)
+		require.Len(t, vkey, 32, path)
+		copy(fixture.VKey[:], vkey)
+		fixture.PublicValues, err = hexutil.Decode(raw.PublicValues)
+		require.NoError(t, err, path)
+		fixture.Proof, err = hexutil.Decode(raw.Proof)
+		require.NoError(t, err, path)
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures
+}
+
+// SP1Cases returns the fixture and mutations of it that must be rejected: a
+// different program key, changed public values, a flipped verifier hash
+// prefix and a flipped byte in every 32-byte word of the proof.
+func SP1Cases(f SP1Fixture) []SP1Case {
+	flip := func(bz []byte, i int) []byte {
+		out := append([]byte{}, bz...)
+		out[i] ^= 0x01
+		return out
+	}
+
+	otherVKey := f.VKey
+	otherVKey[31] ^= 0x01
+	cases := []SP1Case{
+		{Name: "valid", VKey: f.VKey, PublicValues: f.PublicValues, Proof: f.Proof, Valid: true},
+		{Name: "other program key", VKey: otherVKey, PublicValues: f.PublicValues, Proof: f.Proof},
+		{Name: "verifier hash prefix", VKey: f.VKey, PublicValues: f.PublicValues, Proof: flip(f.Proof, 0)},
+	}
+	if len(f.PublicValues) > 0 {
+		cases = append(cases, SP1Case{Name: "public values", VKey: f.VKey, PublicValues: flip(f.PublicValues, 0), Proof: f.Proof})
+	}
+	// Words start after the 4-byte verifier hash prefix.
+	for i := 4 + 31; i < len(f.Proof); i += 32 {
+		cases = append(cases, SP1Case{Name: "proof byte", VKey: f.VKey, PublicValues: f.PublicValues, Proof: flip(f.Proof, i)})
+	}
+	return cases
+}
@@ -9,10 +9,15 @@ import (
 	evmaddress "github.com/cosmos/evm/encoding/address"
 	ibcutils "github.com/cosmos/evm/ibc"
 	cmn "github.com/cosmos/evm/precompiles/common"
+	circuitkeeper "github.com/cosmos/evm/x/circuit/keeper"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
+	frostgroupkeeper "github.com/cosmos/evm/x/frostgroup/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	ibcbreakerkeeper "github.com/cosmos/evm/x/ibcbreaker/keeper"
+	msdcheckkeeper "github.com/cosmos/evm/x/msdcheck/keeper"
 	vrkeeper "github.com/cosmos/evm/x/valrewards/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	zkverifierkeeper "github.com/cosmos/evm/x/zkverifier/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
 	"cosmossdk.io/core/address"
@@ -86,12 +91,17 @@ func DefaultStaticPrecompiles(
 	valrewardsKeeper vrkeeper.Keeper,
 	accountKeeper accountkeeper.AccountKeeper,
 	stakingKeeper stakingkeeper.Keeper,
+	msdcheckKeeper msdcheckkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper cmn.BankKeeper,
 	erc20Keeper *erc20Keeper.Keeper,
 	transferKeeper *transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
 	clientKeeper ibcutils.ClientKeeper,
+	circuitKeeper circuitkeeper.Keeper,
+	ibcBreakerKeeper ibcbreakerkeeper.Keeper,
+	zkVerifierKeeper zkverifierkeeper.Keeper,
+	frostGroupKeeper frostgroupkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
 	codec codec.Codec,
@@ -104,10 +114,10 @@ func DefaultStaticPrecompiles(
 		WithEd25519Precompile().
 		WithGroth16Precompile().
 		WithPlonkPrecompile().
-		WithStakingPrecompile(stakingKeeper, bankKeeper, opts...).
+		WithStakingPrecompile(stakingKeeper, msdcheckKeeper, bankKeeper, opts...).
 		WithDistributionPrecompile(distributionKeeper, stakingKeeper, bankKeeper, opts...).
-		WithICS02Precompile(codec, clientKeeper).
-		WithICS20Precompile(bankKeeper, stakingKeeper, transferKeeper, channelKeeper, erc20Keeper).
+		WithICS02Precompile(codec, clientKeeper, ibcBreakerKeeper).
+		WithICS20Precompile(bankKeeper, stakingKeeper, transferKeeper, channelKeeper, erc20Keeper, ibcBreakerKeeper).
 		WithBlake2bPrecompile().
 		WithEcvrfPrecompile().
 		WithFrostPrecompile().
@@ -123,6 +133,9 @@ func DefaultStaticPrecompiles(
 		WithBankPrecompile(bankKeeper, erc20Keeper).
 		WithGovPrecompile(govKeeper, bankKeeper, codec, opts...).
 		WithSlashingPrecompile(slashingKeeper, bankKeeper, opts...).
+		WithCircuitPrecompile(circuitKeeper, ibcBreakerKeeper).
+		WithZKVerifierPrecompile(zkVerifierKeeper, bankKeeper).
+		WithFrostGroupPrecompile(frostGroupKeeper).
 		WithReservedPrecompiles()
 
 	assertAvailableStaticPrecompilesRegistered(precompiles)
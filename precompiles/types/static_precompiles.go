@@ -11,11 +11,13 @@ import (
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	"github.com/cosmos/evm/precompiles/blake2bhash"
+	circuitprecompile "github.com/cosmos/evm/precompiles/circuit"
 	cmn "github.com/cosmos/evm/precompiles/common"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
 	"github.com/cosmos/evm/precompiles/ecvrf"
 	"github.com/cosmos/evm/precompiles/ed25519"
 	"github.com/cosmos/evm/precompiles/frost"
+	frostgroupprecompile "github.com/cosmos/evm/precompiles/frostgroup"
 	"github.com/cosmos/evm/precompiles/gnarkhash"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
 	ics02precompile "github.com/cosmos/evm/precompiles/ics02"
@@ -34,10 +36,15 @@ import (
 	"github.com/cosmos/evm/precompiles/sp1verifierplonk"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
 	"github.com/cosmos/evm/precompiles/valrewards"
+	zkverifierprecompile "github.com/cosmos/evm/precompiles/zkverifier"
+	circuitkeeper "github.com/cosmos/evm/x/circuit/keeper"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
+	frostgroupkeeper "github.com/cosmos/evm/x/frostgroup/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
-	"github.com/cosmos/evm/x/msdcheck"
+	ibcbreakerkeeper "github.com/cosmos/evm/x/ibcbreaker/keeper"
+	msdcheckkeeper "github.com/cosmos/evm/x/msdcheck/keeper"
 	vrkeeper "github.com/cosmos/evm/x/valrewards/keeper"
+	zkverifierkeeper "github.com/cosmos/evm/x/zkverifier/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -76,6 +83,7 @@ func (s StaticPrecompiles) WithBech32Precompile() StaticPrecompiles {
 
 func (s StaticPrecompiles) WithStakingPrecompile(
 	stakingKeeper stakingkeeper.Keeper,
+	msdcheckKeeper msdcheckkeeper.Keeper,
 	bankKeeper cmn.BankKeeper,
 	opts ...Option,
 ) StaticPrecompiles {
@@ -86,7 +94,7 @@ func (s StaticPrecompiles) WithStakingPrecompile(
 
 	stakingPrecompile := stakingprecompile.NewPrecompile(
 		stakingKeeper,
-		msdcheck.NewMsgServer(stakingkeeper.NewMsgServerImpl(&stakingKeeper), &stakingKeeper),
+		msdcheckkeeper.NewStakingMsgServer(stakingkeeper.NewMsgServerImpl(&stakingKeeper), &stakingKeeper, msdcheckKeeper),
 		stakingkeeper.NewQuerier(&stakingKeeper),
 		bankKeeper,
 		options.AddressCodec,
@@ -123,10 +131,12 @@ func (s StaticPrecompiles) WithDistributionPrecompile(
 func (s StaticPrecompiles) WithICS02Precompile(
 	codec codec.Codec,
 	clientKeeper ibcutils.ClientKeeper,
+	ibcBreakerKeeper cmn.IbcBreakerKeeper,
 ) StaticPrecompiles {
 	ibcClientPrecompile := ics02precompile.NewPrecompile(
 		codec,
 		clientKeeper,
+		ibcBreakerKeeper,
 	)
 
 	s[ibcClientPrecompile.Address()] = ibcClientPrecompile
@@ -139,6 +149,7 @@ func (s StaticPrecompiles) WithICS20Precompile(
 	transferKeeper *transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
 	erc20Keeper *erc20Keeper.Keeper,
+	ibcBreakerKeeper cmn.IbcBreakerKeeper,
 ) StaticPrecompiles {
 	ibcTransferPrecompile := ics20precompile.NewPrecompile(
 		bankKeeper,
@@ -146,6 +157,7 @@ func (s StaticPrecompiles) WithICS20Precompile(
 		transferKeeper,
 		channelKeeper,
 		erc20Keeper,
+		ibcBreakerKeeper,
 	)
 
 	s[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
@@ -343,8 +355,49 @@ func (s StaticPrecompiles) WithSchnorrkelPrecompile() StaticPrecompiles {
 	return s
 }
 
+func (s StaticPrecompiles) WithCircuitPrecompile(
+	circuitKeeper circuitkeeper.Keeper,
+	ibcBreakerKeeper ibcbreakerkeeper.Keeper,
+) StaticPrecompiles {
+	circuitPrecompile := circuitprecompile.NewPrecompile(
+		circuitKeeper,
+		circuitkeeper.NewMsgServerImpl(circuitKeeper),
+		ibcBreakerKeeper,
+		ibcbreakerkeeper.NewMsgServerImpl(ibcBreakerKeeper),
+	)
+
+	s[circuitPrecompile.Address()] = circuitPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithZKVerifierPrecompile(
+	zkVerifierKeeper zkverifierkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+) StaticPrecompiles {
+	zkVerifierPrecompile := zkverifierprecompile.NewPrecompile(
+		zkVerifierKeeper,
+		zkverifierkeeper.NewMsgServerImpl(zkVerifierKeeper),
+		bankKeeper,
+	)
+
+	s[zkVerifierPrecompile.Address()] = zkVerifierPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithFrostGroupPrecompile(
+	frostGroupKeeper frostgroupkeeper.Keeper,
+) StaticPrecompiles {
+	frostGroupPrecompile := frostgroupprecompile.NewPrecompile(
+		frostGroupKeeper,
+		frostgroupkeeper.NewMsgServerImpl(frostGroupKeeper),
+	)
+
+	s[frostGroupPrecompile.Address()] = frostGroupPrecompile
+	return s
+}
+
 func (s StaticPrecompiles) WithReservedPrecompiles() StaticPrecompiles {
-	for slot := 15; slot <= 50; slot++ {
+	for slot := 18; slot <= 50; slot++ {
 		precompile, err := reserved.NewPrecompile(slot)
 		if err != nil {
 			panic(fmt.Errorf("failed to instantiate reserved precompile %d: %w", slot, err))
@@ -14,9 +14,6 @@ func TestWithReservedPrecompilesRegistersAllReservedSlots(t *testing.T) {
 	precompiles := NewStaticPrecompiles().WithReservedPrecompiles()
 
 	expectedAddresses := []string{
-		evmtypes.ReservedSlot15PrecompileAddress,
-		evmtypes.ReservedSlot16PrecompileAddress,
-		evmtypes.ReservedSlot17PrecompileAddress,
 		evmtypes.ReservedSlot18PrecompileAddress,
 		evmtypes.ReservedSlot19PrecompileAddress,
 		evmtypes.ReservedSlot20PrecompileAddress,
@@ -20,10 +20,34 @@ struct Coin {
 /// @dev The interface through which solidity contracts will interact with Validator Rewards
 /// @custom:address 0x0000000000000000000000000000000000000714
 interface ValRewardsI {
+    /// @dev RewardsClaimed defines an Event emitted for every epoch whose rewards
+    /// are paid out for a validator operator account.
+    /// @param validatorOperatorAddress The validator operator account the rewards were claimed for
+    /// @param epoch The epoch the rewards were earned in
+    /// @param amount The amount paid for the epoch
+    event RewardsClaimed(
+        address indexed validatorOperatorAddress,
+        uint64 indexed epoch,
+        uint256 amount
+    );
+
+    /// @dev DelegatorRewardsClaimed defines an Event emitted for every epoch and
+    /// validator whose pass-through rewards are paid to a delegator account.
+    /// @param delegatorAddress The delegator account that received the rewards
+    /// @param validatorOperatorAddress The validator the rewards were earned through
+    /// @param epoch The epoch the rewards were earned in
+    /// @param amount The amount paid for the epoch and validator
+    event DelegatorRewardsClaimed(
+        address indexed delegatorAddress,
+        address indexed validatorOperatorAddress,
+        uint64 indexed epoch,
+        uint256 amount
+    );
+
     /// @dev Claims rewards for a validator operator account for a specific epoch.
     /// Any caller may trigger this transaction, but rewards are paid only to the
-    /// target validator operator account if it is valid.
-    /// @param validatorOperatorAddress The validator operator account to receive rewards
+    /// withdraw address of the target validator operator account if it is valid.
+    /// @param validatorOperatorAddress The validator operator account to claim rewards for
     /// @param epoch The epoch for which rewards are claimed
     /// @return success Whether the transaction was successful or not
     function claimRewards(
@@ -31,6 +55,72 @@ interface ValRewardsI {
         uint64 epoch
     ) external returns (bool success);
 
+    /// @dev Claims rewards for a validator operator account for every epoch
+    /// between startEpoch and endEpoch (inclusive) that still holds a balance,
+    /// oldest first. An endEpoch of 0 leaves the range open. At most maxEpochs
+    /// epochs are paid per call; 0 selects the module limit of 100. Any caller
+    /// may trigger this transaction, but rewards are paid only to the withdraw
+    /// address of the target validator operator account if it is valid.
+    /// @param validatorOperatorAddress The validator operator account to claim rewards for
+    /// @param startEpoch The first epoch to claim
+    /// @param endEpoch The last epoch to claim, or 0 for no upper bound
+    /// @param maxEpochs The maximum number of epochs to pay
+    /// @return claimedEpochs The number of epochs paid
+    /// @return total The total amount paid
+    /// @return hasMore Whether claimable epochs remain in the range
+    function claimRewardsRange(
+        address validatorOperatorAddress,
+        uint64 startEpoch,
+        uint64 endEpoch,
+        uint32 maxEpochs
+    )
+        external
+        returns (uint64 claimedEpochs, Coin memory total, bool hasMore);
+
+    /// @dev Claims the rewards a delegator earned through validators that pass
+    /// their rewards through to delegators, for every epoch between startEpoch
+    /// and endEpoch (inclusive), oldest first. An endEpoch of 0 leaves the range
+    /// open. At most maxEpochs epochs are paid per call; 0 selects the module
+    /// limit of 100. Any caller may trigger this transaction, but rewards are
+    /// paid only to the delegator account.
+    /// @param delegatorAddress The delegator account to receive rewards
+    /// @param startEpoch The first epoch to claim
+    /// @param endEpoch The last epoch to claim, or 0 for no upper bound
+    /// @param maxEpochs The maximum number of epochs to pay
+    /// @return claimedRewards The number of epoch and validator rewards paid
+    /// @return total The total amount paid
+    /// @return hasMore Whether claimable epochs remain in the range
+    function claimDelegatorRewards(
+        address delegatorAddress,
+        uint64 startEpoch,
+        uint64 endEpoch,
+        uint32 maxEpochs
+    )
+        external
+        returns (uint64 claimedRewards, Coin memory total, bool hasMore);
+
+    /// @dev Sets the account that receives the rewards claimed for the
+    /// validator. Only the validator operator account may call it. Setting it
+    /// to the operator account restores the default.
+    /// @param validatorOperatorAddress The validator operator account
+    /// @param withdrawAddress The account to pay claimed rewards to
+    /// @return success Whether the transaction was successful or not
+    function setWithdrawAddress(
+        address validatorOperatorAddress,
+        address withdrawAddress
+    ) external returns (bool success);
+
+    /// @dev Turns auto-compounding on or off. When on, the operator share of
+    /// every completed epoch is delegated back to the validator at the epoch
+    /// rollover. Only the validator operator account may call it.
+    /// @param validatorOperatorAddress The validator operator account
+    /// @param enabled Whether rewards are auto-compounded
+    /// @return success Whether the transaction was successful or not
+    function setAutoCompound(
+        address validatorOperatorAddress,
+        bool enabled
+    ) external returns (bool success);
+
     /// @dev depositValidatorRewardsPool defines a method to allow an account to directly
     /// fund the validator rewards pool.
     /// @param depositor The address of the depositor
@@ -50,6 +140,40 @@ interface ValRewardsI {
         string memory validatorAddress
     ) external view returns (Coin calldata rewards);
 
+    /// @dev Queries the rewards a validator operator account can claim between
+    /// startEpoch and endEpoch (inclusive). An endEpoch of 0 leaves the range open.
+    /// @param validatorOperatorAddress The validator operator account
+    /// @param startEpoch The first epoch to include
+    /// @param endEpoch The last epoch to include, or 0 for no upper bound
+    /// @return total The total claimable rewards
+    /// @return epochs The number of epochs with claimable rewards
+    function claimableRewards(
+        address validatorOperatorAddress,
+        uint64 startEpoch,
+        uint64 endEpoch
+    ) external view returns (Coin memory total, uint64 epochs);
+
+    /// @dev Queries the pass-through rewards a delegator can claim between
+    /// startEpoch and endEpoch (inclusive). An endEpoch of 0 leaves the range open.
+    /// @param delegatorAddress The delegator account
+    /// @param startEpoch The first epoch to include
+    /// @param endEpoch The last epoch to include, or 0 for no upper bound
+    /// @return total The total claimable rewards
+    /// @return rewards The number of epoch and validator rewards included
+    function delegatorClaimableRewards(
+        address delegatorAddress,
+        uint64 startEpoch,
+        uint64 endEpoch
+    ) external view returns (Coin memory total, uint64 rewards);
+
+    /// @dev Queries where the rewards of a validator are paid.
+    /// @param validatorOperatorAddress The validator operator account
+    /// @return withdrawAddress The account claimed rewards are paid to
+    /// @return autoCompound Whether rewards are delegated back at the epoch rollover
+    function payoutSettings(
+        address validatorOperatorAddress
+    ) external view returns (address withdrawAddress, bool autoCompound);
+
     /// @dev Queries the total rewards accrued by a delegation from a specific epoch.
     /// @param delegatorAddress The address of the delegator
     /// @param epoch The epoch for which rewards are checked
@@ -62,4 +186,18 @@ interface ValRewardsI {
     /// @dev Queries the total rewards in the rewards pool.
     /// @return rewards The the total rewards in the rewards pool
     function rewardsPool() external view returns (Coin calldata rewards);
+
+    /// @dev Queries how long the rewards pool can keep funding epochs at the
+    /// current reward settings.
+    /// @return unallocated The part of the rewards pool not owed to anyone
+    /// @return liabilities The unclaimed rewards owed to validators and delegators
+    /// @return fundedEpochs The number of full epochs the unallocated balance can fund
+    function rewardsRunway()
+        external
+        view
+        returns (
+            Coin memory unallocated,
+            Coin memory liabilities,
+            uint64 fundedEpochs
+        );
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cmn "github.com/cosmos/evm/precompiles/common"
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -18,7 +19,6 @@ func (p *Precompile) ClaimRewards(
 	args []interface{},
 ) ([]byte, error) {
 	_ = contract
-	_ = stateDB
 
 	// Validate args
 	if len(args) != 2 {
@@ -38,11 +38,74 @@ func (p *Precompile) ClaimRewards(
 		return nil, fmt.Errorf(cmn.ErrInvalidType, "epoch", uint64(0), args[1])
 	}
 
-	_, err := p.valrewardsKeeper.ClaimOperatorRewards(ctx, sdk.AccAddress(validatorOperatorAddress.Bytes()), epoch)
+	rewards, err := p.valrewardsKeeper.ClaimOperatorRewards(ctx, sdk.AccAddress(validatorOperatorAddress.Bytes()), epoch)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := p.EmitRewardsClaimedEvent(ctx, stateDB, validatorOperatorAddress, epoch, rewards); err != nil {
+		return nil, err
+	}
+
 	// Return response
 	return method.Outputs.Pack(true)
 }
+
+func (p *Precompile) ClaimRewardsRange(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	_ = contract
+
+	// Validate args
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	// Validate the target validator operator account. As with claimRewards any
+	// EVM caller may trigger the claim and the payout goes to the target.
+	validatorOperatorAddress, ok := args[0].(common.Address)
+	if !ok || validatorOperatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidValidatorOperator, args[0])
+	}
+
+	// Validate epoch range
+	startEpoch, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "startEpoch", uint64(0), args[1])
+	}
+	endEpoch, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "endEpoch", uint64(0), args[2])
+	}
+	maxEpochs, ok := args[3].(uint32)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "maxEpochs", uint32(0), args[3])
+	}
+	if err := vrtypes.ValidateClaimRange(startEpoch, endEpoch, maxEpochs); err != nil {
+		return nil, err
+	}
+
+	rewards, total, hasMore, err := p.valrewardsKeeper.ClaimValidatorRewardsRange(
+		ctx,
+		sdk.AccAddress(validatorOperatorAddress.Bytes()),
+		startEpoch,
+		endEpoch,
+		maxEpochs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reward := range rewards {
+		if err := p.EmitRewardsClaimedEvent(ctx, stateDB, validatorOperatorAddress, reward.Epoch, reward.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	// Return response
+	return method.Outputs.Pack(uint64(len(rewards)), toCoinResponse(total), hasMore)
+}
@@ -0,0 +1,109 @@
+package valrewards
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (p *Precompile) ClaimDelegatorRewards(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	_ = contract
+
+	// Validate args
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	// Validate the target delegator. Any EVM caller may trigger the claim and
+	// the payout always goes to the delegator.
+	delegatorAddress, ok := args[0].(common.Address)
+	if !ok || delegatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	// Validate epoch range
+	startEpoch, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "startEpoch", uint64(0), args[1])
+	}
+	endEpoch, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "endEpoch", uint64(0), args[2])
+	}
+	maxEpochs, ok := args[3].(uint32)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "maxEpochs", uint32(0), args[3])
+	}
+	if err := vrtypes.ValidateClaimRange(startEpoch, endEpoch, maxEpochs); err != nil {
+		return nil, err
+	}
+
+	rewards, total, hasMore, err := p.valrewardsKeeper.ClaimDelegatorRewardsRange(
+		ctx,
+		sdk.AccAddress(delegatorAddress.Bytes()),
+		startEpoch,
+		endEpoch,
+		maxEpochs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reward := range rewards {
+		if err := p.EmitDelegatorRewardsClaimedEvent(ctx, stateDB, delegatorAddress, reward); err != nil {
+			return nil, err
+		}
+	}
+
+	// Return response
+	return method.Outputs.Pack(uint64(len(rewards)), toCoinResponse(total), hasMore)
+}
+
+func (p Precompile) DelegatorClaimableRewards(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	// Validate args
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	// Validate delegator
+	delegatorAddress, ok := args[0].(common.Address)
+	if !ok || delegatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	// Validate epoch range
+	startEpoch, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "startEpoch", uint64(0), args[1])
+	}
+	endEpoch, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "endEpoch", uint64(0), args[2])
+	}
+	if endEpoch != 0 && endEpoch < startEpoch {
+		return nil, fmt.Errorf("endEpoch %d is before startEpoch %d", endEpoch, startEpoch)
+	}
+
+	// Sum the rewards the delegator earned through any validator in the range
+	rewards, total := p.valrewardsKeeper.GetDelegatorClaimableRewards(ctx, sdk.AccAddress(delegatorAddress.Bytes()), startEpoch, endEpoch)
+
+	// Return response
+	return method.Outputs.Pack(toCoinResponse(total), uint64(len(rewards)))
+}
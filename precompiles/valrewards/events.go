@@ -0,0 +1,107 @@
+package valrewards
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeRewardsClaimed defines the event type emitted for every epoch
+	// paid by the claimRewards and claimRewardsRange transactions.
+	EventTypeRewardsClaimed = "RewardsClaimed"
+	// EventTypeDelegatorRewardsClaimed defines the event type emitted for every
+	// epoch and validator paid by the claimDelegatorRewards transaction.
+	EventTypeDelegatorRewardsClaimed = "DelegatorRewardsClaimed"
+)
+
+// EmitRewardsClaimedEvent creates a new event emitted when the rewards of a
+// validator operator are paid for an epoch.
+func (p Precompile) EmitRewardsClaimedEvent(ctx sdk.Context, stateDB vm.StateDB, validatorOperatorAddress common.Address, epoch uint64, amount sdk.Coin) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeRewardsClaimed]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(validatorOperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(epoch)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(amount.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitDelegatorRewardsClaimedEvent creates a new event emitted when the rewards
+// a delegator earned through a validator are paid for an epoch.
+func (p Precompile) EmitDelegatorRewardsClaimedEvent(ctx sdk.Context, stateDB vm.StateDB, delegatorAddress common.Address, reward vrtypes.DelegatorEpochReward) error {
+	valAddr, err := sdk.ValAddressFromBech32(reward.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event topics
+	event := p.Events[EventTypeDelegatorRewardsClaimed]
+	topics := make([]common.Hash, 4)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	topics[1], err = cmn.MakeTopic(delegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(common.BytesToAddress(valAddr.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	topics[3], err = cmn.MakeTopic(reward.Epoch)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[3]}
+	packed, err := arguments.Pack(reward.Amount.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
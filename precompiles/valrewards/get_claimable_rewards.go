@@ -0,0 +1,50 @@
+package valrewards
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (p Precompile) ClaimableRewards(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	// Validate args
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	// Validator operator account
+	validatorOperatorAddress, ok := args[0].(common.Address)
+	if !ok || validatorOperatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidValidatorOperator, args[0])
+	}
+
+	// Validate epoch range
+	startEpoch, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "startEpoch", uint64(0), args[1])
+	}
+	endEpoch, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "endEpoch", uint64(0), args[2])
+	}
+	if endEpoch != 0 && endEpoch < startEpoch {
+		return nil, fmt.Errorf("endEpoch %d is before startEpoch %d", endEpoch, startEpoch)
+	}
+
+	// Sum the outstanding rewards of the operator's validator in the range
+	validatorAddress := sdk.ValAddress(validatorOperatorAddress.Bytes()).String()
+	rewards, total := p.valrewardsKeeper.GetClaimableRewards(ctx, validatorAddress, startEpoch, endEpoch)
+
+	// Return response
+	return method.Outputs.Pack(toCoinResponse(total), uint64(len(rewards)))
+}
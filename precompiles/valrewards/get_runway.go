@@ -0,0 +1,22 @@
+package valrewards
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (p Precompile) RewardsRunway(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+
+	// Measure the unallocated pool against the current per-epoch allocation
+	fundedEpochs, unallocated, _ := p.valrewardsKeeper.GetRewardsRunway(ctx)
+	liabilities := p.valrewardsKeeper.GetOutstandingLiabilities(ctx)
+
+	// Return response
+	return method.Outputs.Pack(toCoinResponse(unallocated), toCoinResponse(liabilities), fundedEpochs)
+}
@@ -0,0 +1,121 @@
+package valrewards
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (p *Precompile) SetWithdrawAddress(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	// Validate args
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	// Only the validator operator may change its withdraw address
+	validatorOperatorAddress, err := operatorArg(contract, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate withdraw address
+	withdrawAddress, ok := args[1].(common.Address)
+	if !ok || withdrawAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidHexAddress, args[1])
+	}
+
+	if err := p.valrewardsKeeper.SetOperatorWithdrawAddress(
+		ctx,
+		sdk.AccAddress(validatorOperatorAddress.Bytes()),
+		sdk.AccAddress(withdrawAddress.Bytes()),
+	); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return method.Outputs.Pack(true)
+}
+
+func (p *Precompile) SetAutoCompound(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	// Validate args
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	// Only the validator operator may change its auto-compound flag
+	validatorOperatorAddress, err := operatorArg(contract, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, ok := args[1].(bool)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "enabled", false, args[1])
+	}
+
+	if err := p.valrewardsKeeper.SetOperatorAutoCompound(ctx, sdk.AccAddress(validatorOperatorAddress.Bytes()), enabled); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) PayoutSettings(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	// Validate args
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Validator operator account
+	validatorOperatorAddress, ok := args[0].(common.Address)
+	if !ok || validatorOperatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidValidatorOperator, args[0])
+	}
+
+	// Read the payout settings of the operator's validator
+	validatorAddress := sdk.ValAddress(validatorOperatorAddress.Bytes()).String()
+	withdrawAddress := p.valrewardsKeeper.GetWithdrawAddress(ctx, validatorAddress)
+	autoCompound := p.valrewardsKeeper.IsAutoCompoundEnabled(ctx, validatorAddress)
+
+	// Return response
+	return method.Outputs.Pack(common.BytesToAddress(withdrawAddress.Bytes()), autoCompound)
+}
+
+// operatorArg parses the validator operator argument and checks that it is
+// the caller.
+func operatorArg(contract *vm.Contract, arg interface{}) (common.Address, error) {
+	validatorOperatorAddress, ok := arg.(common.Address)
+	if !ok || validatorOperatorAddress == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidValidatorOperator, arg)
+	}
+	msgSender := contract.Caller()
+	if msgSender != validatorOperatorAddress {
+		return common.Address{}, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), validatorOperatorAddress.String())
+	}
+	return validatorOperatorAddress, nil
+}
@@ -19,10 +19,18 @@ import (
 
 const (
 	ClaimRewardsMethod                = "claimRewards"
+	ClaimRewardsRangeMethod           = "claimRewardsRange"
+	ClaimableRewardsMethod            = "claimableRewards"
+	ClaimDelegatorRewardsMethod       = "claimDelegatorRewards"
+	DelegatorClaimableRewardsMethod   = "delegatorClaimableRewards"
 	DepositValidatorRewardsPoolMethod = "depositValidatorRewardsPool"
 	ValidatorOutstandingRewardsMethod = "validatorOutstandingRewards"
 	DelegationRewardsMethod           = "delegationRewards"
 	RewardsPoolMethod                 = "rewardsPool"
+	RewardsRunwayMethod               = "rewardsRunway"
+	SetWithdrawAddressMethod          = "setWithdrawAddress"
+	SetAutoCompoundMethod             = "setAutoCompound"
+	PayoutSettingsMethod              = "payoutSettings"
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -106,6 +114,14 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 	switch method.Name {
 	case ClaimRewardsMethod:
 		bz, err = p.ClaimRewards(ctx, contract, stateDB, method, args)
+	case ClaimRewardsRangeMethod:
+		bz, err = p.ClaimRewardsRange(ctx, contract, stateDB, method, args)
+	case ClaimableRewardsMethod:
+		bz, err = p.ClaimableRewards(ctx, contract, method, args)
+	case ClaimDelegatorRewardsMethod:
+		bz, err = p.ClaimDelegatorRewards(ctx, contract, stateDB, method, args)
+	case DelegatorClaimableRewardsMethod:
+		bz, err = p.DelegatorClaimableRewards(ctx, contract, method, args)
 	case DepositValidatorRewardsPoolMethod:
 		bz, err = p.DepositValidatorRewardsPool(ctx, contract, stateDB, method, args)
 	case ValidatorOutstandingRewardsMethod:
@@ -114,6 +130,14 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 		bz, err = p.DelegationRewards(ctx, contract, method, args)
 	case RewardsPoolMethod:
 		bz, err = p.RewardsPool(ctx, contract, method, args)
+	case RewardsRunwayMethod:
+		bz, err = p.RewardsRunway(ctx, contract, method, args)
+	case SetWithdrawAddressMethod:
+		bz, err = p.SetWithdrawAddress(ctx, contract, stateDB, method, args)
+	case SetAutoCompoundMethod:
+		bz, err = p.SetAutoCompound(ctx, contract, stateDB, method, args)
+	case PayoutSettingsMethod:
+		bz, err = p.PayoutSettings(ctx, contract, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -124,7 +148,11 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case ClaimRewardsMethod,
-		DepositValidatorRewardsPoolMethod:
+		ClaimRewardsRangeMethod,
+		ClaimDelegatorRewardsMethod,
+		DepositValidatorRewardsPoolMethod,
+		SetWithdrawAddressMethod,
+		SetAutoCompoundMethod:
 		return true
 	default:
 		return false
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IZKVerifier contract's address.
+address constant ZKVERIFIER_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000716;
+
+/// @dev The IZKVerifier contract's instance.
+IZKVerifier constant ZKVERIFIER_CONTRACT = IZKVerifier(ZKVERIFIER_PRECOMPILE_ADDRESS);
+
+/// @dev Proof systems, as numbered in x/zkverifier.
+uint8 constant PROOF_SYSTEM_GROTH16 = 1;
+uint8 constant PROOF_SYSTEM_PLONK = 2;
+
+/// @dev Curves, as numbered in x/zkverifier.
+uint8 constant CURVE_BN254 = 1;
+uint8 constant CURVE_BLS12_381 = 2;
+
+/// @dev VerifyingKey is a verifying key registered in x/zkverifier.
+struct VerifyingKey {
+    uint8 proofSystem;
+    uint8 curve;
+    uint32 nbPublicInputs;
+    uint32 nbCommitments;
+    address registrant;
+    bytes key;
+}
+
+/// @author c8ntinuum Team
+/// @title ZK Verifier Precompile Interface
+/// @dev The interface through which solidity contracts register gnark
+/// Groth16 and Plonk verifying keys in x/zkverifier and verify proofs
+/// against them.
+/// @custom:address 0x0000000000000000000000000000000000000716
+interface IZKVerifier {
+    /// @dev Emitted when a verifying key is registered.
+    /// @param id The id of the verifying key
+    /// @param registrant The account that registered the key
+    /// @param proofSystem The proof system of the key
+    /// @param curve The curve of the key
+    event VerifyingKeyRegistered(
+        bytes32 indexed id,
+        address indexed registrant,
+        uint8 proofSystem,
+        uint8 curve
+    );
+
+    /// @dev Registers a verifying key. Accounts other than the governance
+    /// authority can only register keys when permissionless registration is
+    /// on, and pay the registration fee to the community pool.
+    /// @param proofSystem The proof system of the key
+    /// @param curve The curve of the key
+    /// @param key The verifying key in gnark's binary encoding
+    /// @return id The id of the registered key
+    function registerVerifyingKey(
+        uint8 proofSystem,
+        uint8 curve,
+        bytes calldata key
+    ) external returns (bytes32 id);
+
+    /// @dev Verifies a proof against a registered verifying key. Reverts if
+    /// the key is unknown, the proof is malformed or a public input is not
+    /// in the scalar field.
+    /// @param id The id of the verifying key
+    /// @param proof The proof in gnark's binary encoding
+    /// @param publicInputs The public inputs of the proof
+    /// @return valid Whether the proof is valid
+    function verifyProof(
+        bytes32 id,
+        bytes calldata proof,
+        uint256[] calldata publicInputs
+    ) external view returns (bool valid);
+
+    /// @dev Returns a registered verifying key. Reverts if the key is unknown.
+    /// @param id The id of the verifying key
+    function verifyingKey(bytes32 id) external view returns (VerifyingKey memory);
+
+    /// @dev Returns the gas charged by verifyProof for a registered key, on
+    /// top of the base cost of the call.
+    /// @param id The id of the verifying key
+    function verificationGas(bytes32 id) external view returns (uint64);
+
+    /// @dev Returns the registration parameters of x/zkverifier.
+    /// @return permissionlessRegistration Whether any account can register keys
+    /// @return registrationFee The fee paid by accounts other than governance
+    /// @return maxVerifyingKeySize The largest key, in bytes, that can be registered
+    function registrationParams()
+        external
+        view
+        returns (
+            bool permissionlessRegistration,
+            Coin[] memory registrationFee,
+            uint64 maxVerifyingKeySize
+        );
+}
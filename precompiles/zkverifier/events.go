@@ -0,0 +1,63 @@
+package zkverifier
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeVerifyingKeyRegistered defines the event type emitted by the
+	// registerVerifyingKey transaction.
+	EventTypeVerifyingKeyRegistered = "VerifyingKeyRegistered"
+)
+
+// EmitVerifyingKeyRegisteredEvent creates a new event emitted when a
+// verifying key is registered.
+func (p Precompile) EmitVerifyingKeyRegisteredEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	id [32]byte,
+	registrant common.Address,
+	proofSystem zkverifiertypes.ProofSystem,
+	curve zkverifiertypes.Curve,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeVerifyingKeyRegistered]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+	topics[1] = common.Hash(id)
+
+	var err error
+	topics[2], err = cmn.MakeTopic(registrant)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(
+		uint8(proofSystem), //nolint:gosec // G115 // enum values are small
+		uint8(curve),       //nolint:gosec // G115 // enum values are small
+	)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
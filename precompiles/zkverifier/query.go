@@ -0,0 +1,139 @@
+package zkverifier
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// VerifyProofMethod defines the ABI method name for the zkverifier
+	// VerifyProof query.
+	VerifyProofMethod = "verifyProof"
+	// VerifyingKeyMethod defines the ABI method name for the zkverifier
+	// VerifyingKey query.
+	VerifyingKeyMethod = "verifyingKey"
+	// VerificationGasMethod defines the ABI method name for the gas charged
+	// by verifyProof for a verifying key.
+	VerificationGasMethod = "verificationGas"
+	// RegistrationParamsMethod defines the ABI method name for the zkverifier
+	// Params query.
+	RegistrationParamsMethod = "registrationParams"
+)
+
+// VerifyProof checks a proof against a registered verifying key. A
+// well-formed proof that does not verify returns false, while an unknown key
+// or malformed input reverts. The verification gas of the key is charged
+// before the proof is decoded.
+func (p Precompile) VerifyProof(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	id, proof, publicInputs, err := ParseVerifyProofArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	vk, err := p.getVerifyingKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	gas, err := VerificationGas(vk)
+	if err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(gas, "zkverifier verifyProof")
+
+	err = vk.Verify(proof, publicInputs)
+	if errors.Is(err, zkverifiertypes.ErrProofVerificationFailed) {
+		return method.Outputs.Pack(false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// VerifyingKey returns a registered verifying key.
+func (p Precompile) VerifyingKey(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	id, err := ParseVerifyingKeyID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	vk, err := p.getVerifyingKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := NewVerifyingKeyOutput(vk)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// VerificationGas returns the gas charged by verifyProof for a registered
+// verifying key.
+func (p Precompile) VerificationGas(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	id, err := ParseVerifyingKeyID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	vk, err := p.getVerifyingKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	gas, err := VerificationGas(vk)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(gas)
+}
+
+// RegistrationParams returns the x/zkverifier registration params.
+func (p Precompile) RegistrationParams(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	params := p.zkVerifierKeeper.GetParams(ctx)
+	return method.Outputs.Pack(
+		params.PermissionlessRegistration,
+		cmn.NewCoinsResponse(params.RegistrationFee),
+		params.MaxVerifyingKeySize,
+	)
+}
+
+func (p Precompile) getVerifyingKey(ctx sdk.Context, id []byte) (zkverifiertypes.VerifyingKey, error) {
+	vk, found := p.zkVerifierKeeper.GetVerifyingKey(ctx, id)
+	if !found {
+		return zkverifiertypes.VerifyingKey{}, zkverifiertypes.ErrVerifyingKeyNotFound.Wrapf("0x%x", id)
+	}
+	return vk, nil
+}
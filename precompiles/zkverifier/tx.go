@@ -0,0 +1,46 @@
+package zkverifier
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterVerifyingKeyMethod defines the ABI method name for the
+	// zkverifier RegisterVerifyingKey transaction.
+	RegisterVerifyingKeyMethod = "registerVerifyingKey"
+)
+
+// RegisterVerifyingKey registers a verifying key on behalf of the caller. It
+// goes through the same msg server as MsgRegisterVerifyingKey, so the caller
+// pays the registration fee unless it is the governance authority.
+func (p Precompile) RegisterVerifyingKey(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgRegisterVerifyingKey(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.zkVerifierMsgServer.RegisterVerifyingKey(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var id [32]byte
+	copy(id[:], res.Id)
+	if err := p.EmitVerifyingKeyRegisteredEvent(ctx, stateDB, id, contract.Caller(), msg.ProofSystem, msg.Curve); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(id)
+}
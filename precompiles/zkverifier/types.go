@@ -0,0 +1,152 @@
+package zkverifier
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// verificationGasCost is the gas charged by verifyProof for a proof system on
+// a curve. It follows the cost of the pairing, scalar multiplication and
+// addition precompiles of EIP-1108 (BN254) and EIP-2537 (BLS12-381) that an
+// equivalent Solidity verifier would call.
+type verificationGasCost struct {
+	base           uint64
+	perPublicInput uint64
+	perCommitment  uint64
+}
+
+var verificationGasCosts = map[zkverifiertypes.ProofSystem]map[zkverifiertypes.Curve]verificationGasCost{
+	zkverifiertypes.ProofSystemGroth16: {
+		// A 4-pair pairing check, one G1 multiplication and addition per public
+		// input and a 2-pair pairing check per commitment.
+		zkverifiertypes.CurveBN254:    {base: 181_000, perPublicInput: 6_150, perCommitment: 74_150},
+		zkverifiertypes.CurveBLS12381: {base: 168_100, perPublicInput: 12_375, perCommitment: 77_575},
+	},
+	zkverifiertypes.ProofSystemPlonk: {
+		// A 2-pair pairing check and a multi-scalar multiplication over the
+		// fixed commitments. Public inputs only add field operations, while
+		// every commitment adds a point to the multiplication.
+		zkverifiertypes.CurveBN254:    {base: 200_000, perPublicInput: 1_000, perCommitment: 7_150},
+		zkverifiertypes.CurveBLS12381: {base: 230_000, perPublicInput: 1_000, perCommitment: 13_375},
+	},
+}
+
+// VerificationGas returns the gas charged to verify a proof against vk.
+func VerificationGas(vk zkverifiertypes.VerifyingKey) (uint64, error) {
+	cost, ok := verificationGasCosts[vk.ProofSystem][vk.Curve]
+	if !ok {
+		return 0, fmt.Errorf("unsupported verifying key %s on %s", vk.ProofSystem, vk.Curve)
+	}
+	return cost.base +
+		cost.perPublicInput*uint64(vk.NbPublicInputs) +
+		cost.perCommitment*uint64(vk.NbCommitments), nil
+}
+
+// VerifyingKeyOutput is the ABI form of a registered verifying key.
+type VerifyingKeyOutput struct {
+	ProofSystem    uint8
+	Curve          uint8
+	NbPublicInputs uint32
+	NbCommitments  uint32
+	Registrant     common.Address
+	Key            []byte
+}
+
+// NewVerifyingKeyOutput converts a registered verifying key to its ABI form.
+// Keys imported in genesis may have no registrant, which is returned as the
+// zero address.
+func NewVerifyingKeyOutput(vk zkverifiertypes.VerifyingKey) (VerifyingKeyOutput, error) {
+	var registrant common.Address
+	if vk.Registrant != "" {
+		addr, err := sdk.AccAddressFromBech32(vk.Registrant)
+		if err != nil {
+			return VerifyingKeyOutput{}, fmt.Errorf("invalid registrant %s: %w", vk.Registrant, err)
+		}
+		registrant = common.BytesToAddress(addr)
+	}
+
+	return VerifyingKeyOutput{
+		ProofSystem:    uint8(vk.ProofSystem), //nolint:gosec // G115 // enum values are small
+		Curve:          uint8(vk.Curve),       //nolint:gosec // G115 // enum values are small
+		NbPublicInputs: vk.NbPublicInputs,
+		NbCommitments:  vk.NbCommitments,
+		Registrant:     registrant,
+		Key:            vk.Key,
+	}, nil
+}
+
+// NewMsgRegisterVerifyingKey creates a new MsgRegisterVerifyingKey instance
+// signed by signer.
+func NewMsgRegisterVerifyingKey(args []interface{}, signer common.Address) (*zkverifiertypes.MsgRegisterVerifyingKey, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	proofSystem, ok := args[0].(uint8)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "proofSystem", uint8(0), args[0])
+	}
+
+	curve, ok := args[1].(uint8)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "curve", uint8(0), args[1])
+	}
+
+	key, ok := args[2].([]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "key", []byte{}, args[2])
+	}
+
+	return &zkverifiertypes.MsgRegisterVerifyingKey{
+		Signer:      sdk.AccAddress(signer.Bytes()).String(),
+		ProofSystem: zkverifiertypes.ProofSystem(proofSystem),
+		Curve:       zkverifiertypes.Curve(curve),
+		Key:         key,
+	}, nil
+}
+
+// ParseVerifyProofArgs parses the arguments of the verifyProof query.
+func ParseVerifyProofArgs(args []interface{}) (id []byte, proof []byte, publicInputs []*big.Int, err error) {
+	if len(args) != 3 {
+		return nil, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	keyID, ok := args[0].([32]byte)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "id", [32]byte{}, args[0])
+	}
+
+	proof, ok = args[1].([]byte)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "proof", []byte{}, args[1])
+	}
+
+	publicInputs, ok = args[2].([]*big.Int)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "publicInputs", []*big.Int{}, args[2])
+	}
+
+	return keyID[:], proof, publicInputs, nil
+}
+
+// ParseVerifyingKeyID parses the verifying key id argument of the
+// verifyingKey and verificationGas queries.
+func ParseVerifyingKeyID(args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	id, ok := args[0].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "id", [32]byte{}, args[0])
+	}
+
+	return id[:], nil
+}
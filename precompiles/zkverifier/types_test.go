@@ -0,0 +1,83 @@
+package zkverifier
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestVerificationGas(t *testing.T) {
+	vk := zkverifiertypes.VerifyingKey{
+		ProofSystem:    zkverifiertypes.ProofSystemGroth16,
+		Curve:          zkverifiertypes.CurveBN254,
+		NbPublicInputs: 3,
+		NbCommitments:  1,
+	}
+	gas, err := VerificationGas(vk)
+	require.NoError(t, err)
+	require.Equal(t, uint64(181_000+3*6_150+74_150), gas)
+
+	vk.NbPublicInputs = 4
+	more, err := VerificationGas(vk)
+	require.NoError(t, err)
+	require.Equal(t, gas+6_150, more)
+
+	vk.ProofSystem = zkverifiertypes.ProofSystemUnspecified
+	_, err = VerificationGas(vk)
+	require.Error(t, err)
+}
+
+func TestVerifyingKeyOutputRoundTrip(t *testing.T) {
+	registrant := sdk.AccAddress(common.HexToAddress("0x1234").Bytes())
+	vk := zkverifiertypes.VerifyingKey{
+		ProofSystem:    zkverifiertypes.ProofSystemPlonk,
+		Curve:          zkverifiertypes.CurveBLS12381,
+		NbPublicInputs: 2,
+		NbCommitments:  1,
+		Registrant:     registrant.String(),
+		Key:            []byte{1, 2, 3},
+	}
+	output, err := NewVerifyingKeyOutput(vk)
+	require.NoError(t, err)
+
+	method := ABI.Methods[VerifyingKeyMethod]
+	bz, err := method.Outputs.Pack(output)
+	require.NoError(t, err)
+	unpacked, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+
+	decoded, ok := abi.ConvertType(unpacked[0], new(VerifyingKeyOutput)).(*VerifyingKeyOutput)
+	require.True(t, ok)
+	require.Equal(t, output, *decoded)
+	require.Equal(t, common.BytesToAddress(registrant), decoded.Registrant)
+
+	vk.Registrant = ""
+	output, err = NewVerifyingKeyOutput(vk)
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, output.Registrant)
+}
+
+func TestParseVerifyProofArgs(t *testing.T) {
+	method := ABI.Methods[VerifyProofMethod]
+	inputs := []*big.Int{big.NewInt(9), big.NewInt(12)}
+	bz, err := method.Inputs.Pack([32]byte{1}, []byte{2}, inputs)
+	require.NoError(t, err)
+	args, err := method.Inputs.Unpack(bz)
+	require.NoError(t, err)
+
+	id, proof, publicInputs, err := ParseVerifyProofArgs(args)
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{1}, make([]byte, 31)...), id)
+	require.Equal(t, []byte{2}, proof)
+	require.Equal(t, inputs, publicInputs)
+
+	_, _, _, err = ParseVerifyProofArgs(args[:2])
+	require.Error(t, err)
+}
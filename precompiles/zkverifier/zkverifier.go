@@ -0,0 +1,136 @@
+package zkverifier
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	zkverifierkeeper "github.com/cosmos/evm/x/zkverifier/keeper"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = (*Precompile)(nil)
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the precompiled contract verifying Groth16 and Plonk
+// proofs against the verifying keys registered in x/zkverifier.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	zkVerifierKeeper    zkverifierkeeper.Keeper
+	zkVerifierMsgServer zkverifiertypes.MsgServer
+}
+
+// NewPrecompile creates a new zkverifier Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	zkVerifierKeeper zkverifierkeeper.Keeper,
+	zkVerifierMsgServer zkverifiertypes.MsgServer,
+	bankKeeper cmn.BankKeeper,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(evmtypes.ZKVerifierPrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:                 ABI,
+		zkVerifierKeeper:    zkVerifierKeeper,
+		zkVerifierMsgServer: zkVerifierMsgServer,
+	}
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate. The cost
+// of decoding keys and verifying proofs is charged when the method runs,
+// since it depends on the registered key.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// Run returns a selector error; keep zero here as the conservative gas fallback.
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// zkverifier transactions
+	case RegisterVerifyingKeyMethod:
+		return p.RegisterVerifyingKey(ctx, contract, stateDB, method, args)
+	// zkverifier queries
+	case VerifyProofMethod:
+		return p.VerifyProof(ctx, method, args)
+	case VerifyingKeyMethod:
+		return p.VerifyingKey(ctx, method, args)
+	case VerificationGasMethod:
+		return p.VerificationGas(ctx, method, args)
+	case RegistrationParamsMethod:
+		return p.RegistrationParams(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available zkverifier transactions are:
+// - RegisterVerifyingKey
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case RegisterVerifyingKeyMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "zkverifier")
+}
@@ -17,6 +17,7 @@ import (
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	feeburntypes "github.com/cosmos/evm/x/feeburn/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -90,6 +91,23 @@ func (b *Backend) BaseFee(blockRes *cmtrpctypes.ResultBlockResults) (*big.Int, e
 	return res.BaseFee.BigInt(), nil
 }
 
+// TotalBurned returns the cumulative amount of the EVM coin burned by the
+// fee burn module, in 18 decimals. It only reads the running total kept in
+// state, so it does not need an archive node.
+func (b *Backend) TotalBurned(blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.QueryClient.FeeBurn.TotalBurned(rpctypes.ContextWithHeight(blockNum.Int64()), &feeburntypes.QueryTotalBurnedRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return (*hexutil.Big)(res.TotalBurned.AmountOf(evmtypes.GetEVMCoinExtendedDenom()).BigInt()), nil
+}
+
 // CurrentHeader returns the latest block header
 // This will return error as per node configuration
 // if the ABCI responses are discarded ('discard_abci_responses' config param)
@@ -0,0 +1,90 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/testutil/constants"
+	feeburntypes "github.com/cosmos/evm/x/feeburn/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// fakeFeeBurnQueryClient answers TotalBurned with a fixed response.
+type fakeFeeBurnQueryClient struct {
+	feeburntypes.QueryClient
+	res *feeburntypes.QueryTotalBurnedResponse
+	err error
+}
+
+func (c fakeFeeBurnQueryClient) TotalBurned(context.Context, *feeburntypes.QueryTotalBurnedRequest, ...grpc.CallOption) (*feeburntypes.QueryTotalBurnedResponse, error) {
+	return c.res, c.err
+}
+
+func TestTotalBurned(t *testing.T) {
+	evmtypes.SetDefaultEvmCoinInfo(constants.ExampleChainCoinInfo[constants.ExampleChainID])
+	blockNum := rpctypes.BlockNumber(1)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+
+	testCases := []struct {
+		name          string
+		client        fakeFeeBurnQueryClient
+		blockNrOrHash rpctypes.BlockNumberOrHash
+		expTotal      *big.Int
+		expError      bool
+	}{
+		{
+			name: "returns the extended denom total",
+			client: fakeFeeBurnQueryClient{res: &feeburntypes.QueryTotalBurnedResponse{
+				TotalBurned: sdk.NewCoins(
+					sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), math.NewInt(1_000)),
+					sdk.NewCoin("uother", math.NewInt(7)),
+				),
+			}},
+			blockNrOrHash: blockNrOrHash,
+			expTotal:      big.NewInt(1_000),
+		},
+		{
+			name:          "nothing burned",
+			client:        fakeFeeBurnQueryClient{res: &feeburntypes.QueryTotalBurnedResponse{}},
+			blockNrOrHash: blockNrOrHash,
+			expTotal:      big.NewInt(0),
+		},
+		{
+			name:          "query error",
+			client:        fakeFeeBurnQueryClient{err: errors.New("query failed")},
+			blockNrOrHash: blockNrOrHash,
+			expError:      true,
+		},
+		{
+			name:          "no block number or hash",
+			client:        fakeFeeBurnQueryClient{res: &feeburntypes.QueryTotalBurnedResponse{}},
+			blockNrOrHash: rpctypes.BlockNumberOrHash{},
+			expError:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := setupMockBackend(t)
+			backend.QueryClient.FeeBurn = tc.client
+
+			total, err := backend.TotalBurned(tc.blockNrOrHash)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expTotal, total.ToInt())
+		})
+	}
+}
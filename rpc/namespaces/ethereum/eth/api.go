@@ -78,6 +78,7 @@ type EthereumAPI interface {
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
+	TotalBurned(blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
 
 	// Getting Uncles
 	//
@@ -356,6 +357,13 @@ func (e *PublicAPI) ChainId() (*hexutil.Big, error) { //nolint
 	return e.backend.ChainID()
 }
 
+// TotalBurned returns the cumulative amount of the EVM coin burned from
+// transaction fees, in 18 decimals.
+func (e *PublicAPI) TotalBurned(blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
+	e.logger.Debug("eth_totalBurned", "block number or hash", blockNrOrHash)
+	return e.backend.TotalBurned(blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Uncles															          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -6,6 +6,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	feeburntypes "github.com/cosmos/evm/x/feeburn/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -17,10 +18,12 @@ import (
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Fee burn module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	FeeBurn   feeburntypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -29,6 +32,7 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		FeeBurn:       feeburntypes.NewQueryClient(clientCtx),
 	}
 }
 
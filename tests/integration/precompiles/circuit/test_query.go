@@ -0,0 +1,69 @@
+package circuit
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/circuit"
+)
+
+func (s *PrecompileTestSuite) TestSystemAvailable() {
+	method := s.precompile.Methods[circuit.SystemAvailableMethod]
+
+	res, err := s.precompile.SystemAvailable(s.network.GetContext(), &method, []interface{}{})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(true, out[0])
+
+	s.network.App.GetCircuitKeeper().SetSystemAvailable(s.network.GetContext(), false)
+
+	res, err = s.precompile.SystemAvailable(s.network.GetContext(), &method, []interface{}{})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(false, out[0])
+}
+
+func (s *PrecompileTestSuite) TestIbcAvailable() {
+	method := s.precompile.Methods[circuit.IbcAvailableMethod]
+
+	res, err := s.precompile.IbcAvailable(s.network.GetContext(), &method, []interface{}{})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(true, out[0])
+
+	s.network.App.GetIbcBreakerKeeper().SetIbcAvailable(s.network.GetContext(), false)
+
+	res, err = s.precompile.IbcAvailable(s.network.GetContext(), &method, []interface{}{})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(false, out[0])
+}
+
+func (s *PrecompileTestSuite) TestWhitelists() {
+	s.setOperators(1)
+	expected := []common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+
+	for _, methodName := range []string{circuit.CircuitWhitelistMethod, circuit.IbcBreakerWhitelistMethod} {
+		s.Run(methodName, func() {
+			method := s.precompile.Methods[methodName]
+
+			var (
+				res []byte
+				err error
+			)
+			if methodName == circuit.CircuitWhitelistMethod {
+				res, err = s.precompile.CircuitWhitelist(s.network.GetContext(), &method, []interface{}{})
+			} else {
+				res, err = s.precompile.IbcBreakerWhitelist(s.network.GetContext(), &method, []interface{}{})
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(res)
+			s.Require().NoError(err)
+			s.Require().Equal(expected, out[0])
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package circuit
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/circuit"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	circuitkeeper "github.com/cosmos/evm/x/circuit/keeper"
+	circuittypes "github.com/cosmos/evm/x/circuit/types"
+	ibcbreakerkeeper "github.com/cosmos/evm/x/ibcbreaker/keeper"
+	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *circuit.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+
+	s.network = nw
+	s.keyring = keyring
+
+	circuitKeeper := s.network.App.GetCircuitKeeper()
+	ibcBreakerKeeper := s.network.App.GetIbcBreakerKeeper()
+	s.precompile = circuit.NewPrecompile(
+		circuitKeeper,
+		circuitkeeper.NewMsgServerImpl(circuitKeeper),
+		ibcBreakerKeeper,
+		ibcbreakerkeeper.NewMsgServerImpl(ibcBreakerKeeper),
+	)
+}
+
+// setOperators whitelists the first two keyring accounts in both x/circuit
+// and x/ibcbreaker with the given trip threshold.
+func (s *PrecompileTestSuite) setOperators(tripThreshold uint32) {
+	whitelist := []string{
+		s.keyring.GetAccAddr(0).String(),
+		s.keyring.GetAccAddr(1).String(),
+	}
+
+	s.network.App.GetCircuitKeeper().SetParams(s.network.GetContext(), circuittypes.Params{
+		Whitelist:            whitelist,
+		TripThreshold:        tripThreshold,
+		RestoreThreshold:     1,
+		ProposalWindowBlocks: 10,
+	})
+	s.network.App.GetIbcBreakerKeeper().SetParams(s.network.GetContext(), ibcbreakertypes.Params{
+		Whitelist:            whitelist,
+		TripThreshold:        tripThreshold,
+		RestoreThreshold:     1,
+		ProposalWindowBlocks: 10,
+	})
+}
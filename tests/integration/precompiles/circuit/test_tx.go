@@ -0,0 +1,228 @@
+package circuit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/circuit"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	circuittypes "github.com/cosmos/evm/x/circuit/types"
+)
+
+func (s *PrecompileTestSuite) TestUpdateCircuit() {
+	method := s.precompile.Methods[circuit.UpdateCircuitMethod]
+	disabledModules := []string{"bank"}
+	expiresAtHeight := uint64(1_000_000)
+
+	testCases := []struct {
+		name        string
+		caller      func() common.Address
+		args        []interface{}
+		maxTrip     uint64
+		expExecuted bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			[]interface{}{},
+			0,
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - caller not whitelisted",
+			func() common.Address { return s.keyring.GetAddr(2) },
+			[]interface{}{false, expiresAtHeight, "halt"},
+			0,
+			false,
+			true,
+			"signer not whitelisted",
+		},
+		{
+			"fail - reason too long",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			[]interface{}{false, expiresAtHeight, strings.Repeat("a", circuittypes.MaxReasonLength+1)},
+			0,
+			false,
+			true,
+			"reason exceeds",
+		},
+		{
+			"fail - trip without expiry could not be restored from the EVM",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			[]interface{}{false, uint64(0), "halt"},
+			0,
+			false,
+			true,
+			circuit.ErrUnboundedTrip.Error(),
+		},
+		{
+			"success - operator trips the circuit until expiresAtHeight",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			[]interface{}{false, expiresAtHeight, "halt"},
+			0,
+			true,
+			false,
+			"",
+		},
+		{
+			"success - trip without expiry is bounded by the maximum trip duration",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			[]interface{}{false, uint64(0), "halt"},
+			100,
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setOperators(1)
+			ctx := s.network.GetContext()
+			params := s.network.App.GetCircuitKeeper().GetParams(ctx)
+			params.MaxTripDurationBlocks = tc.maxTrip
+			s.network.App.GetCircuitKeeper().SetParams(ctx, params)
+			s.network.App.GetCircuitKeeper().SetDisabledModules(ctx, disabledModules)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, tc.caller(), s.precompile.Address(), 200000)
+
+			res, err := s.precompile.UpdateCircuit(ctx, contract, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().True(s.network.App.GetCircuitKeeper().GetSystemAvailable(ctx))
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(res)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expExecuted, out[0])
+			s.Require().Equal(uint64(0), out[1])
+
+			state := s.network.App.GetCircuitKeeper().GetCircuitState(ctx)
+			s.Require().False(state.SystemAvailable)
+			s.Require().NotZero(state.ExpiresAtHeight)
+			s.Require().Equal(disabledModules, state.DisabledModules)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUpdateCircuitWithQuorum() {
+	s.setOperators(2)
+	updateMethod := s.precompile.Methods[circuit.UpdateCircuitMethod]
+	voteMethod := s.precompile.Methods[circuit.VoteCircuitProposalMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	res, err := s.precompile.UpdateCircuit(ctx, contract, &updateMethod, []interface{}{false, uint64(1_000_000), "halt"})
+	s.Require().NoError(err)
+	out, err := updateMethod.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(false, out[0])
+	proposalID, ok := out[1].(uint64)
+	s.Require().True(ok)
+	s.Require().NotZero(proposalID)
+	s.Require().True(s.network.App.GetCircuitKeeper().GetSystemAvailable(ctx))
+
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+	res, err = s.precompile.VoteCircuitProposal(ctx, contract, &voteMethod, []interface{}{proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+	s.Require().False(s.network.App.GetCircuitKeeper().GetSystemAvailable(ctx))
+}
+
+func (s *PrecompileTestSuite) TestUpdateIbcBreaker() {
+	method := s.precompile.Methods[circuit.UpdateIbcBreakerMethod]
+	deniedChannels := []string{"channel-7"}
+
+	testCases := []struct {
+		name        string
+		caller      func() common.Address
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - caller not whitelisted",
+			func() common.Address { return s.keyring.GetAddr(2) },
+			[]interface{}{false, uint64(0), "halt"},
+			true,
+			"signer not whitelisted",
+		},
+		{
+			"success - operator trips the IBC breaker",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			[]interface{}{false, uint64(0), "halt"},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setOperators(1)
+			ctx := s.network.GetContext()
+			s.network.App.GetIbcBreakerKeeper().SetDeniedChannels(ctx, deniedChannels)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, tc.caller(), s.precompile.Address(), 200000)
+
+			res, err := s.precompile.UpdateIbcBreaker(ctx, contract, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().True(s.network.App.GetIbcBreakerKeeper().GetIbcAvailable(ctx))
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(res)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			state := s.network.App.GetIbcBreakerKeeper().GetIbcBreakerState(ctx)
+			s.Require().False(state.IbcAvailable)
+			s.Require().Equal(deniedChannels, state.DeniedChannels)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUpdateIbcBreakerWithQuorum() {
+	s.setOperators(2)
+	updateMethod := s.precompile.Methods[circuit.UpdateIbcBreakerMethod]
+	voteMethod := s.precompile.Methods[circuit.VoteIbcBreakerProposalMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	res, err := s.precompile.UpdateIbcBreaker(ctx, contract, &updateMethod, []interface{}{false, uint64(0), "halt"})
+	s.Require().NoError(err)
+	out, err := updateMethod.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(false, out[0])
+	proposalID, ok := out[1].(uint64)
+	s.Require().True(ok)
+	s.Require().NotZero(proposalID)
+	s.Require().True(s.network.App.GetIbcBreakerKeeper().GetIbcAvailable(ctx))
+
+	// the proposer cannot approve its own proposal twice
+	_, err = s.precompile.VoteIbcBreakerProposal(ctx, contract, &voteMethod, []interface{}{proposalID})
+	s.Require().ErrorContains(err, "already voted")
+
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+	res, err = s.precompile.VoteIbcBreakerProposal(ctx, contract, &voteMethod, []interface{}{proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+	s.Require().False(s.network.App.GetIbcBreakerKeeper().GetIbcAvailable(ctx))
+}
@@ -367,3 +367,32 @@ func (s *PrecompileTestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTransactionsRejectedWhileModuleDisabled() {
+	fromAddr := s.keyring.GetKey(0).Addr
+	transfer := s.precompile.Methods[erc20.TransferMethod]
+	balanceOf := s.precompile.Methods[erc20.BalanceOfMethod]
+
+	s.SetupTest()
+	stateDB := s.network.GetStateDB()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), fromAddr, s.precompile.Address(), 0)
+
+	err := s.network.App.GetBankKeeper().MintCoins(ctx, erc20types.ModuleName, XMPLCoin)
+	s.Require().NoError(err, "failed to mint coins")
+	err = s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, fromAddr.Bytes(), XMPLCoin)
+	s.Require().NoError(err, "failed to send coins from module to account")
+
+	s.network.App.GetCircuitKeeper().SetDisabledModules(ctx, []string{erc20types.ModuleName})
+
+	_, err = s.precompile.HandleMethod(ctx, contract, stateDB, &transfer, []interface{}{toAddr, big.NewInt(100)})
+	s.Require().ErrorIs(err, erc20.ErrModuleDisabled)
+	s.Require().True(s.network.App.GetBankKeeper().GetBalance(ctx, toAddr.Bytes(), tokenDenom).IsZero())
+
+	// queries keep working
+	_, err = s.precompile.HandleMethod(ctx, contract, stateDB, &balanceOf, []interface{}{fromAddr})
+	s.Require().NoError(err)
+
+	s.network.App.GetCircuitKeeper().SetDisabledModules(ctx, nil)
+	_, err = s.precompile.HandleMethod(ctx, contract, stateDB, &transfer, []interface{}{toAddr, big.NewInt(100)})
+	s.Require().NoError(err)
+}
@@ -0,0 +1,170 @@
+package frostgroup
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/frostgroup"
+	"github.com/cosmos/evm/precompiles/testutil"
+	frostgrouptestutil "github.com/cosmos/evm/x/frostgroup/testutil"
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+)
+
+func (s *PrecompileTestSuite) TestVerifyGroupSignature() {
+	method := s.precompile.Methods[frostgroup.VerifyGroupSignatureMethod]
+	message := []byte("signed by the group")
+
+	testCases := []struct {
+		name        string
+		args        func(groupID, pendingID uint64, sig []byte) []interface{}
+		expValid    bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(_, _ uint64, _ []byte) []interface{} { return []interface{}{} },
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - unknown group",
+			func(_, _ uint64, sig []byte) []interface{} { return []interface{}{uint64(99), message, sig} },
+			false,
+			true,
+			"group not found",
+		},
+		{
+			"fail - group without a key",
+			func(_, pendingID uint64, sig []byte) []interface{} { return []interface{}{pendingID, message, sig} },
+			false,
+			true,
+			"group has no key yet",
+		},
+		{
+			"fail - malformed signature",
+			func(groupID, _ uint64, sig []byte) []interface{} { return []interface{}{groupID, message, sig[1:]} },
+			false,
+			true,
+			"invalid signature",
+		},
+		{
+			"success - signature of another message",
+			func(groupID, _ uint64, sig []byte) []interface{} {
+				return []interface{}{groupID, []byte("another message"), sig}
+			},
+			false,
+			false,
+			"",
+		},
+		{
+			"success - valid signature",
+			func(groupID, _ uint64, sig []byte) []interface{} { return []interface{}{groupID, message, sig} },
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			groupID, shares := s.createGroup(2, 3)
+			pending, err := s.network.App.GetFrostGroupKeeper().CreateGroup(
+				s.network.GetContext(), s.keyring.GetAccAddr(0), ciphersuite, 2,
+				[]string{s.keyring.GetAccAddr(0).String(), s.keyring.GetAccAddr(1).String()},
+			)
+			s.Require().NoError(err)
+			sig, err := frostgrouptestutil.Sign(ciphersuite, shares[:2], 2, message)
+			s.Require().NoError(err)
+
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 0)
+			res, err := s.precompile.VerifyGroupSignature(ctx, &method, tc.args(groupID, pending.Id, sig))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(res)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expValid, out[0])
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(frostgroup.GasVerifyGroupSignature))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGroup() {
+	method := s.precompile.Methods[frostgroup.GroupMethod]
+	groupID, shares := s.createGroup(2, 3)
+
+	_, err := s.precompile.Group(s.network.GetContext(), &method, []interface{}{uint64(99)})
+	s.Require().ErrorContains(err, "group not found")
+
+	res, err := s.precompile.Group(s.network.GetContext(), &method, []interface{}{groupID})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	output, ok := abi.ConvertType(out[0], new(frostgroup.GroupOutput)).(*frostgroup.GroupOutput)
+	s.Require().True(ok)
+
+	group, found := s.network.App.GetFrostGroupKeeper().GetGroup(s.network.GetContext(), groupID)
+	s.Require().True(found)
+	publicKeyShares := make([][]byte, len(shares))
+	for i, share := range shares {
+		publicKeyShares[i] = share.PublicKey.Encode()
+	}
+	s.Require().Equal(frostgroup.GroupOutput{
+		Admin:           s.keyring.GetAddr(0),
+		Ciphersuite:     uint8(ciphersuite),
+		Threshold:       2,
+		Participants:    s.members(3),
+		VerificationKey: group.VerificationKey,
+		PublicKeyShares: publicKeyShares,
+		KeyVersion:      1,
+	}, *output)
+}
+
+func (s *PrecompileTestSuite) TestDkgSession() {
+	method := s.precompile.Methods[frostgroup.DkgSessionMethod]
+	k := s.network.App.GetFrostGroupKeeper()
+	ctx := s.network.GetContext()
+
+	group, err := k.CreateGroup(ctx, s.keyring.GetAccAddr(0), ciphersuite, 2, []string{
+		s.keyring.GetAccAddr(0).String(), s.keyring.GetAccAddr(1).String(),
+	})
+	s.Require().NoError(err)
+	round1, shares, err := frostgrouptestutil.Keygen(ciphersuite, 2, 2)
+	s.Require().NoError(err)
+	for i := range round1 {
+		s.Require().NoError(k.SubmitDkgCommitment(ctx, s.keyring.GetAccAddr(i), group.Id, round1[i]))
+	}
+	_, err = k.ConfirmDkg(ctx, s.keyring.GetAccAddr(1), group.Id, shares[1].PublicKey.Encode())
+	s.Require().NoError(err)
+
+	res, err := s.precompile.DkgSession(ctx, &method, []interface{}{group.Id})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	output, ok := abi.ConvertType(out[0], new(frostgroup.DkgSessionOutput)).(*frostgroup.DkgSessionOutput)
+	s.Require().True(ok)
+
+	session, found := k.GetDkgSession(ctx, group.Id)
+	s.Require().True(found)
+	s.Require().Equal(frostgroup.DkgSessionOutput{
+		Kind:           uint8(frostgrouptypes.DkgKindKeygen),
+		Threshold:      2,
+		Participants:   s.members(2),
+		Commitments:    round1,
+		Confirmed:      []uint16{2},
+		DeadlineHeight: session.DeadlineHeight,
+	}, *output)
+
+	_, err = k.ConfirmDkg(ctx, s.keyring.GetAccAddr(0), group.Id, shares[0].PublicKey.Encode())
+	s.Require().NoError(err)
+	_, err = s.precompile.DkgSession(ctx, &method, []interface{}{group.Id})
+	s.Require().ErrorContains(err, "no key generation in progress")
+}
@@ -0,0 +1,96 @@
+package frostgroup
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/frost"
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/secret-sharing/keys"
+	"github.com/cosmos/evm/precompiles/frostgroup"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	frostgroupkeeper "github.com/cosmos/evm/x/frostgroup/keeper"
+	frostgrouptestutil "github.com/cosmos/evm/x/frostgroup/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ciphersuite is the ciphersuite of the groups created by the tests.
+const ciphersuite = uint32(frost.Ed25519)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *frostgroup.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(4)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+
+	s.network = nw
+	s.keyring = keyring
+
+	frostGroupKeeper := s.network.App.GetFrostGroupKeeper()
+	s.precompile = frostgroup.NewPrecompile(
+		frostGroupKeeper,
+		frostgroupkeeper.NewMsgServerImpl(frostGroupKeeper),
+	)
+}
+
+// members returns the hex addresses of the first n keyring accounts.
+func (s *PrecompileTestSuite) members(n int) []common.Address {
+	addrs := make([]common.Address, n)
+	for i := range addrs {
+		addrs[i] = s.keyring.GetAddr(i)
+	}
+	return addrs
+}
+
+// createGroup registers a group of the first n keyring accounts, administered
+// by the first one, and runs its key generation through the keeper. It
+// returns the group id and the key shares of the participants.
+func (s *PrecompileTestSuite) createGroup(threshold uint16, n int) (uint64, []*keys.KeyShare) {
+	k := s.network.App.GetFrostGroupKeeper()
+	ctx := s.network.GetContext()
+
+	participants := make([]string, n)
+	for i := range participants {
+		participants[i] = s.keyring.GetAccAddr(i).String()
+	}
+	group, err := k.CreateGroup(ctx, s.keyring.GetAccAddr(0), ciphersuite, uint32(threshold), participants)
+	s.Require().NoError(err)
+
+	round1, shares, err := frostgrouptestutil.Keygen(ciphersuite, threshold, uint16(n)) //nolint:gosec // G115 // test rosters are small
+	s.Require().NoError(err)
+	for i := range participants {
+		s.Require().NoError(k.SubmitDkgCommitment(ctx, s.keyring.GetAccAddr(i), group.Id, round1[i]))
+	}
+	for i := range participants {
+		_, err := k.ConfirmDkg(ctx, s.keyring.GetAccAddr(i), group.Id, shares[i].PublicKey.Encode())
+		s.Require().NoError(err)
+	}
+
+	return group.Id, shares
+}
+
+// accAddr returns the bech32 form of a hex address.
+func accAddr(addr common.Address) string {
+	return sdk.AccAddress(addr.Bytes()).String()
+}
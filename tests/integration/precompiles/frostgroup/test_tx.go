@@ -0,0 +1,243 @@
+package frostgroup
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/frostgroup"
+	"github.com/cosmos/evm/precompiles/testutil"
+	frostgrouptestutil "github.com/cosmos/evm/x/frostgroup/testutil"
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+)
+
+func (s *PrecompileTestSuite) TestCreateGroup() {
+	method := s.precompile.Methods[frostgroup.CreateGroupMethod]
+
+	testCases := []struct {
+		name        string
+		args        func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} { return []interface{}{} },
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - unsupported ciphersuite",
+			func() []interface{} { return []interface{}{uint8(0), uint16(2), s.members(3)} },
+			true,
+			"unsupported ciphersuite",
+		},
+		{
+			"fail - threshold above the number of participants",
+			func() []interface{} { return []interface{}{uint8(ciphersuite), uint16(4), s.members(3)} },
+			true,
+			"threshold must be between 1 and 3",
+		},
+		{
+			"fail - duplicate participant",
+			func() []interface{} {
+				return []interface{}{uint8(ciphersuite), uint16(2), append(s.members(2), s.keyring.GetAddr(0))}
+			},
+			true,
+			"duplicate participant",
+		},
+		{
+			"success - group registered and key generation started",
+			func() []interface{} { return []interface{}{uint8(ciphersuite), uint16(2), s.members(3)} },
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			caller := s.keyring.GetAddr(0)
+			k := s.network.App.GetFrostGroupKeeper()
+
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile.Address(), 0)
+
+			res, err := s.precompile.CreateGroup(ctx, contract, stateDB, &method, tc.args())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(k.GetAllGroups(ctx))
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(res)
+			s.Require().NoError(err)
+			groupID, ok := out[0].(uint64)
+			s.Require().True(ok)
+
+			group, found := k.GetGroup(ctx, groupID)
+			s.Require().True(found)
+			s.Require().Equal(accAddr(caller), group.Admin)
+			s.Require().Zero(group.KeyVersion)
+
+			session, found := k.GetDkgSession(ctx, groupID)
+			s.Require().True(found)
+			s.Require().Equal(frostgrouptypes.DkgKindKeygen, session.Kind)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 2)
+			s.Require().Equal(s.precompile.Events[frostgroup.EventTypeGroupCreated].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BigToHash(common.Big1), logs[0].Topics[1])
+			s.Require().Equal(common.BytesToHash(caller.Bytes()), logs[0].Topics[2])
+			s.Require().Equal(s.precompile.Events[frostgroup.EventTypeDkgStarted].ID, logs[1].Topics[0])
+			s.Require().Equal(common.BigToHash(common.Big1), logs[1].Topics[1])
+		})
+	}
+}
+
+// TestKeygen runs a whole key generation through the precompile, each
+// participant submitting its commitment and confirming its share from its
+// own account.
+func (s *PrecompileTestSuite) TestKeygen() {
+	createMethod := s.precompile.Methods[frostgroup.CreateGroupMethod]
+	submitMethod := s.precompile.Methods[frostgroup.SubmitDkgCommitmentMethod]
+	confirmMethod := s.precompile.Methods[frostgroup.ConfirmDkgMethod]
+	verifyMethod := s.precompile.Methods[frostgroup.VerifyGroupSignatureMethod]
+	k := s.network.App.GetFrostGroupKeeper()
+
+	stateDB := s.network.GetStateDB()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 0)
+	res, err := s.precompile.CreateGroup(ctx, contract, stateDB, &createMethod, []interface{}{uint8(ciphersuite), uint16(2), s.members(3)})
+	s.Require().NoError(err)
+	out, err := createMethod.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	groupID := out[0].(uint64)
+
+	round1, shares, err := frostgrouptestutil.Keygen(ciphersuite, 2, 3)
+	s.Require().NoError(err)
+
+	// A participant cannot submit the commitment of another one.
+	contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 0)
+	_, err = s.precompile.SubmitDkgCommitment(ctx, contract, &submitMethod, []interface{}{groupID, round1[0]})
+	s.Require().ErrorContains(err, "invalid dkg commitment")
+
+	for i := range round1 {
+		contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(i), s.precompile.Address(), 0)
+		_, err = s.precompile.SubmitDkgCommitment(ctx, contract, &submitMethod, []interface{}{groupID, round1[i]})
+		s.Require().NoError(err)
+		// The proof of knowledge and two coefficients of a key generation.
+		s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(frostgrouptypes.GasDkgCommitmentProof+2*frostgrouptypes.GasPerCommitmentCoefficient))
+	}
+
+	for i, share := range shares {
+		stateDB = s.network.GetStateDB()
+		contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(i), s.precompile.Address(), 0)
+		res, err = s.precompile.ConfirmDkg(ctx, contract, stateDB, &confirmMethod, []interface{}{groupID, share.PublicKey.Encode()})
+		s.Require().NoError(err)
+		out, err = confirmMethod.Outputs.Unpack(res)
+		s.Require().NoError(err)
+
+		completed := i == len(shares)-1
+		s.Require().Equal(completed, out[0])
+		if !completed {
+			s.Require().Empty(stateDB.Logs())
+			continue
+		}
+
+		group, found := k.GetGroup(ctx, groupID)
+		s.Require().True(found)
+		s.Require().Equal(uint64(1), group.KeyVersion)
+
+		logs := stateDB.Logs()
+		s.Require().Len(logs, 1)
+		s.Require().Equal(s.precompile.Events[frostgroup.EventTypeDkgCompleted].ID, logs[0].Topics[0])
+		data, err := s.precompile.Events[frostgroup.EventTypeDkgCompleted].Inputs.NonIndexed().Unpack(logs[0].Data)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), data[0])
+		s.Require().Equal(group.VerificationKey, data[1])
+	}
+
+	message := []byte("signed by the group")
+	sig, err := frostgrouptestutil.Sign(ciphersuite, shares[1:], 2, message)
+	s.Require().NoError(err)
+	res, err = s.precompile.VerifyGroupSignature(s.network.GetContext(), &verifyMethod, []interface{}{groupID, message, sig})
+	s.Require().NoError(err)
+	out, err = verifyMethod.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(true, out[0])
+}
+
+func (s *PrecompileTestSuite) TestConfirmDkgRejectsWrongShare() {
+	k := s.network.App.GetFrostGroupKeeper()
+	method := s.precompile.Methods[frostgroup.ConfirmDkgMethod]
+
+	group, err := k.CreateGroup(s.network.GetContext(), s.keyring.GetAccAddr(0), ciphersuite, 2, []string{
+		s.keyring.GetAccAddr(0).String(), s.keyring.GetAccAddr(1).String(),
+	})
+	s.Require().NoError(err)
+	round1, shares, err := frostgrouptestutil.Keygen(ciphersuite, 2, 2)
+	s.Require().NoError(err)
+	for i := range round1 {
+		s.Require().NoError(k.SubmitDkgCommitment(s.network.GetContext(), s.keyring.GetAccAddr(i), group.Id, round1[i]))
+	}
+
+	stateDB := s.network.GetStateDB()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 0)
+	_, err = s.precompile.ConfirmDkg(ctx, contract, stateDB, &method, []interface{}{group.Id, shares[1].PublicKey.Encode()})
+	s.Require().ErrorContains(err, "does not match the commitments")
+	// Two coefficients of the aggregate commitment.
+	s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(2*frostgrouptypes.GasConfirmDkgPerCoefficient))
+}
+
+func (s *PrecompileTestSuite) TestRotateReshareAndCancel() {
+	rotateMethod := s.precompile.Methods[frostgroup.RotateGroupKeyMethod]
+	reshareMethod := s.precompile.Methods[frostgroup.ReshareGroupMethod]
+	cancelMethod := s.precompile.Methods[frostgroup.CancelDkgMethod]
+	k := s.network.App.GetFrostGroupKeeper()
+	groupID, _ := s.createGroup(2, 3)
+
+	// Only the admin manages the group.
+	stateDB := s.network.GetStateDB()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 0)
+	_, err := s.precompile.RotateGroupKey(ctx, contract, stateDB, &rotateMethod, []interface{}{groupID})
+	s.Require().ErrorContains(err, "not the group admin")
+
+	stateDB = s.network.GetStateDB()
+	contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 0)
+	_, err = s.precompile.ReshareGroup(ctx, contract, stateDB, &reshareMethod, []interface{}{groupID, uint16(3), s.members(4)})
+	s.Require().NoError(err)
+
+	session, found := k.GetDkgSession(ctx, groupID)
+	s.Require().True(found)
+	s.Require().Equal(frostgrouptypes.DkgKindReshare, session.Kind)
+	s.Require().Len(session.Participants, 4)
+
+	logs := stateDB.Logs()
+	s.Require().Len(logs, 1)
+	s.Require().Equal(s.precompile.Events[frostgroup.EventTypeDkgStarted].ID, logs[0].Topics[0])
+	data, err := s.precompile.Events[frostgroup.EventTypeDkgStarted].Inputs.NonIndexed().Unpack(logs[0].Data)
+	s.Require().NoError(err)
+	s.Require().Equal(uint8(frostgrouptypes.DkgKindReshare), data[0])
+	s.Require().Equal(uint16(3), data[1])
+	s.Require().Equal(session.DeadlineHeight, data[2])
+
+	// A second key generation cannot start while one is in progress.
+	_, err = s.precompile.RotateGroupKey(ctx, contract, stateDB, &rotateMethod, []interface{}{groupID})
+	s.Require().ErrorContains(err, "in progress")
+
+	_, err = s.precompile.CancelDkg(ctx, contract, &cancelMethod, []interface{}{groupID})
+	s.Require().NoError(err)
+	_, found = k.GetDkgSession(ctx, groupID)
+	s.Require().False(found)
+
+	// The group keeps its key and roster.
+	group, found := k.GetGroup(ctx, groupID)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), group.KeyVersion)
+	s.Require().Len(group.Participants, 3)
+
+	_, err = s.precompile.RotateGroupKey(ctx, contract, stateDB, &rotateMethod, []interface{}{groupID})
+	s.Require().NoError(err)
+}
@@ -49,6 +49,7 @@ func (s *PrecompileTestSuite) SetupTest() {
 		evmAppA.GetTransferKeeper(),
 		evmAppA.GetIBCKeeper().ChannelKeeper,
 		evmAppA.GetErc20Keeper(),
+		evmAppA.GetIbcBreakerKeeper(),
 	)
 	s.chainABondDenom, _ = evmAppA.GetStakingKeeper().BondDenom(s.chainA.GetContext())
 	evmAppB := s.chainB.App.(evm.EvmApp)
@@ -58,6 +59,7 @@ func (s *PrecompileTestSuite) SetupTest() {
 		evmAppB.GetTransferKeeper(),
 		evmAppB.GetIBCKeeper().ChannelKeeper,
 		evmAppB.GetErc20Keeper(),
+		evmAppB.GetIbcBreakerKeeper(),
 	)
 	s.chainBBondDenom, _ = evmAppB.GetStakingKeeper().BondDenom(s.chainB.GetContext())
 }
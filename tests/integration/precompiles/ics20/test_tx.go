@@ -24,6 +24,7 @@ type testCase struct {
 	channelID          string
 	useDynamicChannel  bool
 	overrideSender     bool
+	denyClient         bool
 	receiver           string
 	expectErrSubstring string
 }
@@ -68,6 +69,14 @@ func (s *PrecompileTestSuite) TestTransferErrors() {
 			receiver:           defaultReceiver,
 			expectErrSubstring: "does not match the requester address",
 		},
+		{
+			name:               "channel on a denied client",
+			port:               transfertypes.PortID,
+			useDynamicChannel:  true,
+			denyClient:         true,
+			receiver:           defaultReceiver,
+			expectErrSubstring: "is denied",
+		},
 	}
 
 	for _, tc := range tests {
@@ -82,6 +91,10 @@ func (s *PrecompileTestSuite) TestTransferErrors() {
 				channel = path.EndpointA.ChannelID
 			}
 
+			if tc.denyClient {
+				evmAppA.GetIbcBreakerKeeper().SetDeniedClients(s.chainA.GetContext(), []string{path.EndpointA.ClientID})
+			}
+
 			sender := defaultSender
 			if tc.overrideSender {
 				sender = tx.GenerateAddress()
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/stretchr/testify/suite"
 
@@ -13,9 +14,12 @@ import (
 	//nolint:revive // dot imports are fine for Ginkgo
 	. "github.com/onsi/gomega"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/testutil"
 	vrprecompile "github.com/cosmos/evm/precompiles/valrewards"
+	"github.com/cosmos/evm/testutil/integration/base/factory"
 	evmfactory "github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/testutil/keyring"
@@ -66,7 +70,10 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 				precompileABI:  vrprecompile.ABI,
 			}
 
-			err := is.advanceToRewardsEpoch()
+			err := is.fundRewardsPool(sender, contractData, 10)
+			Expect(err).ToNot(HaveOccurred(), "failed to fund the rewards pool")
+
+			err = is.advanceToRewardsEpoch()
 			Expect(err).ToNot(HaveOccurred(), "failed to advance blocks")
 		})
 
@@ -82,6 +89,23 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 			Expect(err).ToNot(HaveOccurred(), "failed to unpack rewards pool")
 		})
 
+		It("returns the rewards runway via precompile", func() {
+			txArgs, callArgs := getTxAndCallArgs(directCall, contractData, vrprecompile.RewardsRunwayMethod)
+			ethRes, err := is.factory.QueryContract(txArgs, callArgs, 1_000_000)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error querying rewards runway")
+
+			var out struct {
+				Unallocated  cmn.Coin
+				Liabilities  cmn.Coin
+				FundedEpochs uint64
+			}
+			err = vrprecompile.ABI.UnpackIntoInterface(&out, vrprecompile.RewardsRunwayMethod, ethRes.Ret)
+			Expect(err).ToNot(HaveOccurred(), "failed to unpack rewards runway")
+			Expect(out.Liabilities.Amount.Sign()).To(Equal(1), "expected the settled epoch to be owed")
+			Expect(out.FundedEpochs).To(BeNumerically("<", 10), "expected settled epochs to consume the runway")
+			Expect(out.FundedEpochs).To(BeNumerically(">", 0), "expected the pool to fund further epochs")
+		})
+
 		It("returns outstanding rewards via precompile", func() {
 			txArgs, callArgs := getTxAndCallArgs(directCall, contractData, vrprecompile.DelegationRewardsMethod, sender.Addr, epoch)
 			ethRes, err := is.factory.QueryContract(txArgs, callArgs, 1_000_000)
@@ -157,6 +181,170 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 			Expect(outRewards.Rewards.Amount.Sign()).To(Equal(0), "expected rewards to be cleared after claim")
 		})
 
+		It("lets a third party claim several epochs at once via precompile", func() {
+			err := is.advanceBlocks(vrtypes.BLOCKS_IN_EPOCH)
+			Expect(err).ToNot(HaveOccurred(), "failed to advance to the next rewards epoch")
+
+			rewardsPerEpoch := vrtypes.GetRewardsPerEpoch()
+			depositCoin := cmn.Coin{
+				Denom:  rewardsPerEpoch.Denom,
+				Amount: new(big.Int).Mul(rewardsPerEpoch.Amount.BigInt(), big.NewInt(3)),
+			}
+			txArgs, callArgs := getTxAndCallArgs(directCall, contractData, vrprecompile.DepositValidatorRewardsPoolMethod, sender.Addr, depositCoin)
+			_, _, err = is.commitContractCall(sender.Priv, txArgs, callArgs)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error depositing rewards pool")
+
+			txArgs, callArgs = getTxAndCallArgs(directCall, contractData, vrprecompile.ClaimableRewardsMethod, sender.Addr, uint64(0), uint64(0))
+			ethRes, err := is.factory.QueryContract(txArgs, callArgs, 1_000_000)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error querying claimable rewards")
+
+			var claimable struct {
+				Total  cmn.Coin
+				Epochs uint64
+			}
+			err = vrprecompile.ABI.UnpackIntoInterface(&claimable, vrprecompile.ClaimableRewardsMethod, ethRes.Ret)
+			Expect(err).ToNot(HaveOccurred(), "failed to unpack claimable rewards")
+			Expect(claimable.Epochs).To(BeNumerically(">=", 2), "expected rewards in at least two epochs")
+
+			validatorBalanceBefore, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, rewardsPerEpoch.Denom)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error reading validator operator balance before claim")
+
+			txArgs, callArgs = getTxAndCallArgs(directCall, contractData, vrprecompile.ClaimRewardsRangeMethod, sender.Addr, uint64(0), uint64(0), uint32(0))
+			_, claimRes, err := is.commitContractCall(sponsoredCaller.Priv, txArgs, callArgs)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error claiming a range of epochs")
+			Expect(claimRes.Logs).To(HaveLen(int(claimable.Epochs)), "expected one RewardsClaimed event per epoch")
+
+			var claimed struct {
+				ClaimedEpochs uint64
+				Total         cmn.Coin
+				HasMore       bool
+			}
+			err = vrprecompile.ABI.UnpackIntoInterface(&claimed, vrprecompile.ClaimRewardsRangeMethod, claimRes.Ret)
+			Expect(err).ToNot(HaveOccurred(), "failed to unpack range claim")
+			Expect(claimed.ClaimedEpochs).To(Equal(claimable.Epochs))
+			Expect(claimed.Total.Amount).To(Equal(claimable.Total.Amount))
+			Expect(claimed.HasMore).To(BeFalse())
+
+			validatorBalanceAfter, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, rewardsPerEpoch.Denom)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error reading validator operator balance after claim")
+			Expect(validatorBalanceAfter.Balance.Amount).To(Equal(
+				validatorBalanceBefore.Balance.Amount.Add(sdkmath.NewIntFromBigInt(claimable.Total.Amount)),
+			), "expected rewards of every epoch to be paid to the target validator operator")
+		})
+
+		It("passes rewards through to delegators once the operator opts in", func() {
+			res, err := is.factory.CommitCosmosTx(sender.Priv, factory.CosmosTxArgs{
+				Msgs: []sdk.Msg{&vrtypes.MsgSetRewardsCommission{
+					ValidatorOperator: sender.AccAddr.String(),
+					CommissionRate:    sdkmath.LegacyNewDecWithPrec(1, 1),
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred(), "unexpected error setting the rewards commission")
+			Expect(res.IsOK()).To(BeTrue(), "expected the rewards commission tx to succeed: %s", res.Log)
+
+			// The staged rate activates at the next rollover and applies to the
+			// epoch after it.
+			err = is.advanceBlocks(2 * vrtypes.BLOCKS_IN_EPOCH)
+			Expect(err).ToNot(HaveOccurred(), "failed to advance past a pass-through epoch")
+
+			rewardsPerEpoch := vrtypes.GetRewardsPerEpoch()
+			depositCoin := cmn.Coin{
+				Denom:  rewardsPerEpoch.Denom,
+				Amount: new(big.Int).Mul(rewardsPerEpoch.Amount.BigInt(), big.NewInt(4)),
+			}
+			txArgs, callArgs := getTxAndCallArgs(directCall, contractData, vrprecompile.DepositValidatorRewardsPoolMethod, sender.Addr, depositCoin)
+			_, _, err = is.commitContractCall(sender.Priv, txArgs, callArgs)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error depositing rewards pool")
+
+			txArgs, callArgs = getTxAndCallArgs(directCall, contractData, vrprecompile.DelegatorClaimableRewardsMethod, sender.Addr, uint64(0), uint64(0))
+			ethRes, err := is.factory.QueryContract(txArgs, callArgs, 1_000_000)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error querying delegator claimable rewards")
+
+			var claimable struct {
+				Total   cmn.Coin
+				Rewards uint64
+			}
+			err = vrprecompile.ABI.UnpackIntoInterface(&claimable, vrprecompile.DelegatorClaimableRewardsMethod, ethRes.Ret)
+			Expect(err).ToNot(HaveOccurred(), "failed to unpack delegator claimable rewards")
+			Expect(claimable.Rewards).To(BeNumerically(">=", 1), "expected pass-through rewards for the self-delegation")
+
+			delegatorBalanceBefore, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, rewardsPerEpoch.Denom)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error reading delegator balance before claim")
+
+			txArgs, callArgs = getTxAndCallArgs(directCall, contractData, vrprecompile.ClaimDelegatorRewardsMethod, sender.Addr, uint64(0), uint64(0), uint32(0))
+			_, claimRes, err := is.commitContractCall(sponsoredCaller.Priv, txArgs, callArgs)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error claiming delegator rewards")
+			Expect(claimRes.Logs).To(HaveLen(int(claimable.Rewards)), "expected one DelegatorRewardsClaimed event per epoch and validator")
+
+			var claimed struct {
+				ClaimedRewards uint64
+				Total          cmn.Coin
+				HasMore        bool
+			}
+			err = vrprecompile.ABI.UnpackIntoInterface(&claimed, vrprecompile.ClaimDelegatorRewardsMethod, claimRes.Ret)
+			Expect(err).ToNot(HaveOccurred(), "failed to unpack delegator claim")
+			Expect(claimed.ClaimedRewards).To(Equal(claimable.Rewards))
+			Expect(claimed.Total.Amount).To(Equal(claimable.Total.Amount))
+			Expect(claimed.HasMore).To(BeFalse())
+
+			delegatorBalanceAfter, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, rewardsPerEpoch.Denom)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error reading delegator balance after claim")
+			Expect(delegatorBalanceAfter.Balance.Amount).To(Equal(
+				delegatorBalanceBefore.Balance.Amount.Add(sdkmath.NewIntFromBigInt(claimable.Total.Amount)),
+			), "expected pass-through rewards to be paid to the delegator")
+		})
+
+		It("pays claims to the withdraw address set via precompile", func() {
+			withdrawAddr := common.BytesToAddress([]byte("valrewards_withdraw"))
+
+			txArgs, callArgs := getTxAndCallArgs(directCall, contractData, vrprecompile.SetWithdrawAddressMethod, sender.Addr, withdrawAddr)
+			_, _, err := is.commitContractCall(sponsoredCaller.Priv, txArgs, callArgs)
+			Expect(err).To(HaveOccurred(), "expected only the validator operator to set its withdraw address")
+
+			txArgs, callArgs = getTxAndCallArgs(directCall, contractData, vrprecompile.SetWithdrawAddressMethod, sender.Addr, withdrawAddr)
+			_, _, err = is.commitContractCall(sender.Priv, txArgs, callArgs)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error setting the withdraw address")
+
+			txArgs, callArgs = getTxAndCallArgs(directCall, contractData, vrprecompile.PayoutSettingsMethod, sender.Addr)
+			ethRes, err := is.factory.QueryContract(txArgs, callArgs, 1_000_000)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error querying payout settings")
+
+			var settings struct {
+				WithdrawAddress common.Address
+				AutoCompound    bool
+			}
+			err = vrprecompile.ABI.UnpackIntoInterface(&settings, vrprecompile.PayoutSettingsMethod, ethRes.Ret)
+			Expect(err).ToNot(HaveOccurred(), "failed to unpack payout settings")
+			Expect(settings.WithdrawAddress).To(Equal(withdrawAddr))
+			Expect(settings.AutoCompound).To(BeFalse())
+
+			txArgs, callArgs = getTxAndCallArgs(directCall, contractData, vrprecompile.ClaimableRewardsMethod, sender.Addr, uint64(0), uint64(0))
+			ethRes, err = is.factory.QueryContract(txArgs, callArgs, 1_000_000)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error querying claimable rewards")
+
+			var claimable struct {
+				Total  cmn.Coin
+				Epochs uint64
+			}
+			err = vrprecompile.ABI.UnpackIntoInterface(&claimable, vrprecompile.ClaimableRewardsMethod, ethRes.Ret)
+			Expect(err).ToNot(HaveOccurred(), "failed to unpack claimable rewards")
+			Expect(claimable.Total.Amount.Sign()).To(Equal(1), "expected claimable rewards")
+
+			rewardsDenom := vrtypes.GetRewardsPerEpoch().Denom
+			withdrawBalanceBefore, err := is.grpcHandler.GetBalanceFromBank(sdk.AccAddress(withdrawAddr.Bytes()), rewardsDenom)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error reading withdraw address balance before claim")
+
+			txArgs, callArgs = getTxAndCallArgs(directCall, contractData, vrprecompile.ClaimRewardsRangeMethod, sender.Addr, uint64(0), uint64(0), uint32(0))
+			_, _, err = is.commitContractCall(sponsoredCaller.Priv, txArgs, callArgs)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error claiming rewards")
+
+			withdrawBalanceAfter, err := is.grpcHandler.GetBalanceFromBank(sdk.AccAddress(withdrawAddr.Bytes()), rewardsDenom)
+			Expect(err).ToNot(HaveOccurred(), "unexpected error reading withdraw address balance after claim")
+			Expect(withdrawBalanceAfter.Balance.Amount).To(Equal(
+				withdrawBalanceBefore.Balance.Amount.Add(sdkmath.NewIntFromBigInt(claimable.Total.Amount)),
+			), "expected rewards to be paid to the withdraw address")
+		})
+
 		It("keeps the rewards pool unchanged on low gas deposit", func() {
 			rewardsPerEpoch := vrtypes.GetRewardsPerEpoch()
 			depositAmount := new(big.Int).Mul(rewardsPerEpoch.Amount.BigInt(), big.NewInt(2))
@@ -9,7 +9,10 @@ import (
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
+	vrprecompile "github.com/cosmos/evm/precompiles/valrewards"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/keyring"
 	testutiltypes "github.com/cosmos/evm/testutil/types"
 	vrtypes "github.com/cosmos/evm/x/valrewards/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -69,6 +72,20 @@ func (is *IntegrationTestSuite) advanceToRewardsEpoch() error {
 	return is.advanceBlocks(vrtypes.BLOCKS_IN_EPOCH + 2)
 }
 
+// fundRewardsPool deposits enough rewards for the given number of epochs.
+// Epochs only allocate from the unallocated pool, so the pool must be funded
+// before the rollovers a test relies on.
+func (is *IntegrationTestSuite) fundRewardsPool(depositor keyring.Key, contractData ContractData, epochs int64) error {
+	rewardsPerEpoch := vrtypes.GetRewardsPerEpoch()
+	depositCoin := cmn.Coin{
+		Denom:  rewardsPerEpoch.Denom,
+		Amount: new(big.Int).Mul(rewardsPerEpoch.Amount.BigInt(), big.NewInt(epochs)),
+	}
+	txArgs, callArgs := getTxAndCallArgs(directCall, contractData, vrprecompile.DepositValidatorRewardsPoolMethod, depositor.Addr, depositCoin)
+	_, _, err := is.commitContractCall(depositor.Priv, txArgs, callArgs)
+	return err
+}
+
 func (is *IntegrationTestSuite) commitContractCall(
 	priv cryptotypes.PrivKey,
 	txArgs evmtypes.EvmTxArgs,
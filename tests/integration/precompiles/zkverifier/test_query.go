@@ -0,0 +1,191 @@
+package zkverifier
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/zkverifier"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestVerifyProof() {
+	method := s.precompile.Methods[zkverifier.VerifyProofMethod]
+	groth16Proof := s.groth16Fixture.Proof
+	plonkProof := s.plonkFixture.Proof
+
+	testCases := []struct {
+		name        string
+		args        func(groth16ID, plonkID [32]byte) []interface{}
+		expValid    bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(_, _ [32]byte) []interface{} { return []interface{}{} },
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - unknown verifying key",
+			func(_, _ [32]byte) []interface{} {
+				return []interface{}{[32]byte{1}, groth16Proof, s.groth16Fixture.PublicInputs}
+			},
+			false,
+			true,
+			"verifying key not found",
+		},
+		{
+			"fail - wrong number of public inputs",
+			func(groth16ID, _ [32]byte) []interface{} {
+				return []interface{}{groth16ID, groth16Proof, s.groth16Fixture.PublicInputs[:1]}
+			},
+			false,
+			true,
+			"expected 2 public inputs",
+		},
+		{
+			"fail - malformed proof",
+			func(groth16ID, _ [32]byte) []interface{} {
+				return []interface{}{groth16ID, groth16Proof[:len(groth16Proof)-1], s.groth16Fixture.PublicInputs}
+			},
+			false,
+			true,
+			"invalid proof",
+		},
+		{
+			"fail - proof for another key",
+			func(groth16ID, _ [32]byte) []interface{} {
+				return []interface{}{groth16ID, plonkProof, s.plonkFixture.PublicInputs}
+			},
+			false,
+			true,
+			"invalid proof",
+		},
+		{
+			"success - wrong public input",
+			func(groth16ID, _ [32]byte) []interface{} {
+				return []interface{}{groth16ID, groth16Proof, []*big.Int{big.NewInt(9), big.NewInt(13)}}
+			},
+			false,
+			false,
+			"",
+		},
+		{
+			"success - valid groth16 proof",
+			func(groth16ID, _ [32]byte) []interface{} {
+				return []interface{}{groth16ID, groth16Proof, s.groth16Fixture.PublicInputs}
+			},
+			true,
+			false,
+			"",
+		},
+		{
+			"success - valid plonk proof",
+			func(_, plonkID [32]byte) []interface{} {
+				return []interface{}{plonkID, plonkProof, s.plonkFixture.PublicInputs}
+			},
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			groth16ID := s.registerFixture(s.groth16Fixture)
+			plonkID := s.registerFixture(s.plonkFixture)
+
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 0)
+			res, err := s.precompile.VerifyProof(ctx, &method, tc.args(groth16ID, plonkID))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(res)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expValid, out[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestVerifyProofChargesVerificationGas() {
+	id := s.registerFixture(s.groth16Fixture)
+	method := s.precompile.Methods[zkverifier.VerifyProofMethod]
+	gasMethod := s.precompile.Methods[zkverifier.VerificationGasMethod]
+
+	res, err := s.precompile.VerificationGas(s.network.GetContext(), &gasMethod, []interface{}{id})
+	s.Require().NoError(err)
+	out, err := gasMethod.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	gas, ok := out[0].(uint64)
+	s.Require().True(ok)
+	// 2 public inputs and 1 commitment
+	s.Require().Equal(uint64(181_000+2*6_150+74_150), gas)
+
+	_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 0)
+	_, err = s.precompile.VerifyProof(ctx, &method, []interface{}{id, s.groth16Fixture.Proof, s.groth16Fixture.PublicInputs})
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), gas)
+}
+
+func (s *PrecompileTestSuite) TestVerifyingKey() {
+	id := s.registerFixture(s.plonkFixture)
+	method := s.precompile.Methods[zkverifier.VerifyingKeyMethod]
+
+	_, err := s.precompile.VerifyingKey(s.network.GetContext(), &method, []interface{}{[32]byte{1}})
+	s.Require().ErrorContains(err, "verifying key not found")
+
+	res, err := s.precompile.VerifyingKey(s.network.GetContext(), &method, []interface{}{id})
+	s.Require().NoError(err)
+
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	output, ok := abi.ConvertType(out[0], new(zkverifier.VerifyingKeyOutput)).(*zkverifier.VerifyingKeyOutput)
+	s.Require().True(ok)
+
+	vk, found := s.network.App.GetZKVerifierKeeper().GetVerifyingKey(s.network.GetContext(), id[:])
+	s.Require().True(found)
+	s.Require().Equal(zkverifier.VerifyingKeyOutput{
+		ProofSystem:    uint8(zkverifiertypes.ProofSystemPlonk),
+		Curve:          uint8(zkverifiertypes.CurveBLS12381),
+		NbPublicInputs: 2,
+		NbCommitments:  1,
+		Registrant:     common.BytesToAddress(s.network.App.GetZKVerifierKeeper().GetAuthority()),
+		Key:            vk.Key,
+	}, *output)
+}
+
+func (s *PrecompileTestSuite) TestRegistrationParams() {
+	params := zkverifiertypes.Params{
+		PermissionlessRegistration: true,
+		RegistrationFee:            sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000))),
+		MaxVerifyingKeySize:        4096,
+	}
+	s.network.App.GetZKVerifierKeeper().SetParams(s.network.GetContext(), params)
+	method := s.precompile.Methods[zkverifier.RegistrationParamsMethod]
+
+	res, err := s.precompile.RegistrationParams(s.network.GetContext(), &method, []interface{}{})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(true, out[0])
+	s.Require().Equal(uint64(4096), out[2])
+
+	fee, err := cmn.ToCoins(out[1])
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.NewCoinsResponse(params.RegistrationFee), fee)
+}
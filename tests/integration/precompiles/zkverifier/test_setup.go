@@ -0,0 +1,74 @@
+package zkverifier
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/zkverifier"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	zkverifierkeeper "github.com/cosmos/evm/x/zkverifier/keeper"
+	zkverifiertestutil "github.com/cosmos/evm/x/zkverifier/testutil"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *zkverifier.Precompile
+
+	groth16Fixture zkverifiertestutil.Fixture
+	plonkFixture   zkverifiertestutil.Fixture
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+// SetupSuite generates the proof fixtures once, since they do not depend on
+// the chain state.
+func (s *PrecompileTestSuite) SetupSuite() {
+	var err error
+	s.groth16Fixture, err = zkverifiertestutil.NewFixture(zkverifiertypes.ProofSystemGroth16, zkverifiertypes.CurveBN254)
+	s.Require().NoError(err)
+	s.plonkFixture, err = zkverifiertestutil.NewFixture(zkverifiertypes.ProofSystemPlonk, zkverifiertypes.CurveBLS12381)
+	s.Require().NoError(err)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+
+	s.network = nw
+	s.keyring = keyring
+
+	zkVerifierKeeper := s.network.App.GetZKVerifierKeeper()
+	s.precompile = zkverifier.NewPrecompile(
+		zkVerifierKeeper,
+		zkverifierkeeper.NewMsgServerImpl(zkVerifierKeeper),
+		s.network.App.GetBankKeeper(),
+	)
+}
+
+// registerFixture registers the verifying key of f through governance and
+// returns its id.
+func (s *PrecompileTestSuite) registerFixture(f zkverifiertestutil.Fixture) [32]byte {
+	k := s.network.App.GetZKVerifierKeeper()
+	vk, err := k.RegisterVerifyingKey(s.network.GetContext(), k.GetAuthority(), f.ProofSystem, f.Curve, f.Key)
+	s.Require().NoError(err)
+
+	var id [32]byte
+	copy(id[:], vk.Id)
+	return id
+}
@@ -0,0 +1,134 @@
+package zkverifier
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/zkverifier"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestRegisterVerifyingKey() {
+	method := s.precompile.Methods[zkverifier.RegisterVerifyingKeyMethod]
+	f := s.groth16Fixture
+	fee := math.NewInt(1000)
+
+	testCases := []struct {
+		name           string
+		permissionless bool
+		args           []interface{}
+		expError       bool
+		errContains    string
+	}{
+		{
+			"fail - empty input args",
+			true,
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - registration restricted to governance",
+			false,
+			[]interface{}{uint8(f.ProofSystem), uint8(f.Curve), f.Key},
+			true,
+			"restricted to governance",
+		},
+		{
+			"fail - unsupported proof system",
+			true,
+			[]interface{}{uint8(3), uint8(f.Curve), f.Key},
+			true,
+			"unsupported proof system",
+		},
+		{
+			"fail - key for another curve",
+			true,
+			[]interface{}{uint8(f.ProofSystem), uint8(zkverifiertypes.CurveBLS12381), f.Key},
+			true,
+			"invalid verifying key",
+		},
+		{
+			"success - caller pays the registration fee",
+			true,
+			[]interface{}{uint8(f.ProofSystem), uint8(f.Curve), f.Key},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			caller := s.keyring.GetAddr(0)
+			denom := s.network.GetBaseDenom()
+			k := s.network.App.GetZKVerifierKeeper()
+			k.SetParams(s.network.GetContext(), zkverifiertypes.Params{
+				PermissionlessRegistration: tc.permissionless,
+				RegistrationFee:            sdk.NewCoins(sdk.NewCoin(denom, fee)),
+				MaxVerifyingKeySize:        zkverifiertypes.DefaultMaxVerifyingKeySize,
+			})
+			balanceBefore := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), caller.Bytes(), denom)
+
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile.Address(), 0)
+
+			res, err := s.precompile.RegisterVerifyingKey(ctx, contract, stateDB, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(k.GetAllVerifyingKeys(ctx))
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(res)
+			s.Require().NoError(err)
+			id, ok := out[0].([32]byte)
+			s.Require().True(ok)
+
+			vk, found := k.GetVerifyingKey(ctx, id[:])
+			s.Require().True(found)
+			s.Require().Equal(sdk.AccAddress(caller.Bytes()).String(), vk.Registrant)
+
+			balanceAfter := s.network.App.GetBankKeeper().GetBalance(ctx, caller.Bytes(), denom)
+			s.Require().Equal(balanceBefore.Amount.Sub(fee), balanceAfter.Amount)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.Events[zkverifier.EventTypeVerifyingKeyRegistered].ID, logs[0].Topics[0])
+			s.Require().Equal(common.Hash(id), logs[0].Topics[1])
+			s.Require().Equal(common.BytesToHash(caller.Bytes()), logs[0].Topics[2])
+
+			// Registering the same key again fails.
+			_, err = s.precompile.RegisterVerifyingKey(ctx, contract, stateDB, &method, tc.args)
+			s.Require().ErrorContains(err, "already registered")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRegisterVerifyingKeyByGovernance() {
+	method := s.precompile.Methods[zkverifier.RegisterVerifyingKeyMethod]
+	k := s.network.App.GetZKVerifierKeeper()
+	authority := common.BytesToAddress(k.GetAuthority())
+
+	stateDB := s.network.GetStateDB()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), authority, s.precompile.Address(), 0)
+
+	f := s.plonkFixture
+	res, err := s.precompile.RegisterVerifyingKey(ctx, contract, stateDB, &method, []interface{}{uint8(f.ProofSystem), uint8(f.Curve), f.Key})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	id, ok := out[0].([32]byte)
+	s.Require().True(ok)
+
+	_, found := k.GetVerifyingKey(ctx, id[:])
+	s.Require().True(found)
+}
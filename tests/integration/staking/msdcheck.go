@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	msdchecktypes "github.com/cosmos/evm/x/msdcheck/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -122,6 +123,54 @@ func TestMSDCheckIntegration(t *testing.T, create network.CreateEvmApp, options
 		require.NoError(t, err)
 		require.NotZero(t, res.Code)
 	})
+
+	t.Run("MsgCreateValidator follows the minimum set by governance", func(t *testing.T) {
+		nw, tf, kr := setupNetwork(t, create, options...)
+
+		minSelfDelegation := mustInt(t, minSelfDelegationStr)
+		lowered := minSelfDelegation.QuoRaw(2)
+		nw.App.GetMsdCheckKeeper().SetParams(nw.GetContext(), msdchecktypes.Params{MinSelfDelegation: lowered})
+
+		coin := sdk.NewCoin(nw.GetBaseDenom(), lowered.SubRaw(1))
+		msg := buildCreateValidatorMsg(t, kr.GetPrivKey(0), coin, lowered)
+		res, err := executeCosmosTx(tf, kr.GetPrivKey(0), msg)
+		require.NoError(t, err)
+		require.NotZero(t, res.Code)
+
+		coin = sdk.NewCoin(nw.GetBaseDenom(), lowered)
+		msg = buildCreateValidatorMsg(t, kr.GetPrivKey(0), coin, lowered)
+		res, err = commitCosmosTx(tf, kr.GetPrivKey(0), msg)
+		require.NoError(t, err)
+		require.Zerof(t, res.Code, "log: %s", res.Log)
+	})
+
+	t.Run("MsgUndelegate in strict mode cannot drop self-delegation below minimum", func(t *testing.T) {
+		nw, tf, kr := setupNetwork(t, create, options...)
+
+		minSelfDelegation := mustInt(t, minSelfDelegationStr)
+		coin := sdk.NewCoin(nw.GetBaseDenom(), minSelfDelegation.AddRaw(10))
+
+		msg := buildCreateValidatorMsg(t, kr.GetPrivKey(0), coin, minSelfDelegation)
+		res, err := commitCosmosTx(tf, kr.GetPrivKey(0), msg)
+		require.NoError(t, err)
+		require.Zerof(t, res.Code, "log: %s", res.Log)
+
+		params := nw.App.GetMsdCheckKeeper().GetParams(nw.GetContext())
+		params.StrictMode = true
+		nw.App.GetMsdCheckKeeper().SetParams(nw.GetContext(), params)
+
+		delAddr := kr.GetAccAddr(0).String()
+		valAddr := sdk.ValAddress(kr.GetPrivKey(0).PubKey().Address()).String()
+		undelegateMsg := stakingtypes.NewMsgUndelegate(delAddr, valAddr, sdk.NewCoin(nw.GetBaseDenom(), sdkmath.NewInt(11)))
+		res, err = executeCosmosTx(tf, kr.GetPrivKey(0), undelegateMsg)
+		require.NoError(t, err)
+		require.NotZero(t, res.Code)
+
+		undelegateMsg = stakingtypes.NewMsgUndelegate(delAddr, valAddr, sdk.NewCoin(nw.GetBaseDenom(), sdkmath.NewInt(10)))
+		res, err = commitCosmosTx(tf, kr.GetPrivKey(0), undelegateMsg)
+		require.NoError(t, err)
+		require.Zerof(t, res.Code, "log: %s", res.Log)
+	})
 }
 
 func setupNetwork(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) (*network.UnitTestNetwork, factory.TxFactory, testkeyring.Keyring) {
@@ -52,6 +52,52 @@ func (s *KeeperTestSuite) TestConvertERC20() {
 			},
 			true,
 		},
+		{
+			"fail - conversion disabled by the circuit",
+			func() *types.MsgConvertERC20 {
+				contractAddr, err := s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err)
+
+				sender := s.keyring.GetAccAddr(0)
+				senderHex := s.keyring.GetAddr(0)
+
+				_, err = s.MintERC20Token(contractAddr, senderHex, big.NewInt(100))
+				s.Require().NoError(err)
+
+				s.network.App.GetCircuitKeeper().SetDisabledMsgTypeURLs(s.network.GetContext(), []string{sdk.MsgTypeURL(&types.MsgConvertERC20{})})
+
+				return types.NewMsgConvertERC20(
+					math.NewInt(10),
+					sender,
+					contractAddr,
+					senderHex,
+				)
+			},
+			false,
+		},
+		{
+			"fail - erc20 module disabled by the circuit",
+			func() *types.MsgConvertERC20 {
+				contractAddr, err := s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err)
+
+				sender := s.keyring.GetAccAddr(0)
+				senderHex := s.keyring.GetAddr(0)
+
+				_, err = s.MintERC20Token(contractAddr, senderHex, big.NewInt(100))
+				s.Require().NoError(err)
+
+				s.network.App.GetCircuitKeeper().SetDisabledModules(s.network.GetContext(), []string{types.ModuleName})
+
+				return types.NewMsgConvertERC20(
+					math.NewInt(10),
+					sender,
+					contractAddr,
+					senderHex,
+				)
+			},
+			false,
+		},
 		{
 			"fail - invalid receiver bech32 address format",
 			func() *types.MsgConvertERC20 {
@@ -9,10 +9,15 @@ import (
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testKeyring "github.com/cosmos/evm/testutil/keyring"
+	feeburntypes "github.com/cosmos/evm/x/feeburn/types"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	valrewardstypes "github.com/cosmos/evm/x/valrewards/types"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -101,6 +106,80 @@ func TestBurnFeeCollectorBeforeDistribution(t *testing.T, create network.CreateE
 	require.Equal(t, feePoolBefore.CommunityPool.String(), feePoolAfter.CommunityPool.String(), "community pool should not increase")
 }
 
+func TestRouteFeesByParams(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	keyring := testKeyring.New(1)
+	quarter := sdkmath.LegacyNewDecWithPrec(25, 2)
+	feeburnGenesis := feeburntypes.DefaultGenesisState()
+	feeburnGenesis.Params = feeburntypes.Params{
+		BurnRatio:          quarter,
+		CommunityPoolRatio: quarter,
+		FeeCollectorRatio:  quarter,
+		ValrewardsRatio:    quarter,
+	}
+	// Without the community tax the community pool only receives the routed share.
+	distrGenesis := distrtypes.DefaultGenesisState()
+	distrGenesis.Params.CommunityTax = sdkmath.LegacyZeroDec()
+
+	opts := []network.ConfigOption{
+		network.WithChainID(testconstants.SixDecimalsChainID),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{
+			feeburntypes.ModuleName: feeburnGenesis,
+			distrtypes.ModuleName:   distrGenesis,
+		}),
+	}
+	opts = append(opts, options...)
+
+	nw := network.NewUnitTestNetwork(create, opts...)
+	require.NoError(t, nw.NextBlock(), "failed to advance to a clean post-init block")
+
+	app := nw.App
+	ctx := nw.GetContext()
+	feeCollector := app.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+	valRewards := app.GetAccountKeeper().GetModuleAddress(valrewardstypes.ModuleName)
+	integerDenom := precisebanktypes.IntegerCoinDenom()
+	extendedDenom := precisebanktypes.ExtendedCoinDenom()
+
+	// 8 integer coins plus a fractional amount, and 11 foo.
+	passthroughCoins := sdk.NewCoins(
+		sdk.NewInt64Coin("foo", 11),
+		sdk.NewInt64Coin(integerDenom, 7),
+	)
+	require.NoError(t, app.GetBankKeeper().MintCoins(ctx, minttypes.ModuleName, passthroughCoins))
+	require.NoError(t, app.GetBankKeeper().SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, passthroughCoins))
+	preciseCoins := sdk.NewCoins(sdk.NewCoin(extendedDenom, precisebanktypes.ConversionFactor().AddRaw(500)))
+	require.NoError(t, app.GetPreciseBankKeeper().MintCoins(ctx, minttypes.ModuleName, preciseCoins))
+	require.NoError(t, app.GetPreciseBankKeeper().SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, preciseCoins))
+
+	feePoolBefore, err := app.GetDistrKeeper().FeePool.Get(ctx)
+	require.NoError(t, err)
+	valRewardsBefore := app.GetBankKeeper().GetAllBalances(ctx, valRewards)
+	fooSupplyBefore := app.GetBankKeeper().GetSupply(ctx, "foo")
+
+	require.NoError(t, nw.NextBlock(), "failed to advance routing block")
+	ctx = nw.GetContext()
+
+	// Each routed share is a quarter rounded down: 2 integer coins and 2 foo.
+	expectedShare := sdk.NewCoins(sdk.NewInt64Coin("foo", 2), sdk.NewInt64Coin(integerDenom, 2))
+
+	// Neither denom is the x/valrewards rewards denom, so their valrewards
+	// share is burned.
+	valRewardsAfter := app.GetBankKeeper().GetAllBalances(ctx, valRewards)
+	require.True(t, valRewardsAfter.Sub(valRewardsBefore...).Empty(), "expected no fees routed to valrewards")
+
+	feePoolAfter, err := app.GetDistrKeeper().FeePool.Get(ctx)
+	require.NoError(t, err)
+	for _, coin := range expectedShare {
+		delta := feePoolAfter.CommunityPool.AmountOf(coin.Denom).Sub(feePoolBefore.CommunityPool.AmountOf(coin.Denom))
+		require.Equal(t, coin.Amount.String(), delta.TruncateInt().String(), "unexpected community pool delta for %s", coin.Denom)
+	}
+
+	// The fee collector share was handed to x/distribution and the rest burned.
+	require.True(t, effectiveBalances(app, ctx, feeCollector).Empty(), "expected fee collector to be empty after routing")
+	fooSupplyAfter := app.GetBankKeeper().GetSupply(ctx, "foo")
+	require.Equal(t, int64(7), fooSupplyBefore.Amount.Sub(fooSupplyAfter.Amount).Int64(), "unexpected foo burn")
+}
+
 func effectiveBalances(app evm.EvmApp, ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	balances := app.GetBankKeeper().GetAllBalances(ctx, addr)
 
@@ -2,6 +2,7 @@ package ibc
 
 import (
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/evm/testutil/keyring"
 	testutiltypes "github.com/cosmos/evm/testutil/types"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -24,6 +26,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -122,12 +125,32 @@ type MockICS4Wrapper struct {
 	mock.Mock
 }
 
+// mockIbcBreakerKeeper resolves channel-0 to the client 07-tendermint-0, as
+// the ibcbreaker keeper does through the channel's connection.
 type mockIbcBreakerKeeper struct {
-	available bool
+	available      bool
+	deniedChannels []string
+	deniedClients  []string
+	deniedDenoms   []string
 }
 
-func (m mockIbcBreakerKeeper) GetIbcAvailable(_ sdk.Context) bool {
-	return m.available
+func (m mockIbcBreakerKeeper) CheckTransfer(_ sdk.Context, _, sourceChannel, denom string) error {
+	clientID := sourceChannel
+	if sourceChannel == "channel-0" {
+		clientID = "07-tendermint-0"
+	}
+	switch {
+	case !m.available:
+		return ibcbreakertypes.ErrIbcUnavailable
+	case slices.Contains(m.deniedChannels, sourceChannel):
+		return errorsmod.Wrapf(ibcbreakertypes.ErrIbcUnavailable, "channel %s is denied", sourceChannel)
+	case slices.Contains(m.deniedClients, clientID):
+		return errorsmod.Wrapf(ibcbreakertypes.ErrIbcUnavailable, "client %s is denied", clientID)
+	case slices.Contains(m.deniedDenoms, denom):
+		return errorsmod.Wrapf(ibcbreakertypes.ErrIbcUnavailable, "denom %s is denied", denom)
+	default:
+		return nil
+	}
 }
 
 type mockCircuitKeeper struct {
@@ -138,6 +161,10 @@ func (m mockCircuitKeeper) GetSystemAvailable(_ sdk.Context) bool {
 	return m.available
 }
 
+func (m mockCircuitKeeper) IsMsgTypeDisabled(_ sdk.Context, _ string) bool {
+	return false
+}
+
 func (b *MockICS4Wrapper) WriteAcknowledgement(_ sdk.Context, _ exported.PacketI, _ exported.Acknowledgement) error {
 	return nil
 }
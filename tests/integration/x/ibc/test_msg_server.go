@@ -414,6 +414,77 @@ func (suite *KeeperTestSuite) TestTransferBlockedWhenIbcUnavailable() {
 	suite.Require().Contains(err.Error(), "ibc unavailable")
 }
 
+func (suite *KeeperTestSuite) TestTransferBlockedOnDeniedRoute() {
+	testCases := []struct {
+		name        string
+		breaker     mockIbcBreakerKeeper
+		errContains string
+	}{
+		{
+			name:        "denied channel",
+			breaker:     mockIbcBreakerKeeper{available: true, deniedChannels: []string{"channel-0"}},
+			errContains: "channel channel-0 is denied",
+		},
+		{
+			name:        "denied client of the channel",
+			breaker:     mockIbcBreakerKeeper{available: true, deniedClients: []string{"07-tendermint-0"}},
+			errContains: "client 07-tendermint-0 is denied",
+		},
+		{
+			name:        "denied denom",
+			breaker:     mockIbcBreakerKeeper{available: true, deniedDenoms: []string{evmtypes.GetEVMCoinDenom()}},
+			errContains: fmt.Sprintf("denom %s is denied", evmtypes.GetEVMCoinDenom()),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.network.GetContext()
+			sender := suite.keyring.GetKey(0)
+			receiver := sdk.AccAddress([]byte("receiver"))
+			authAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+			mockChannelKeeper := &MockChannelKeeper{}
+			mockChannelKeeper.On("GetNextSequenceSend", mock.Anything, mock.Anything, mock.Anything).Return(1, true)
+			mockChannelKeeper.On("GetChannel", mock.Anything, mock.Anything, mock.Anything).Return(
+				channeltypes.Channel{Counterparty: channeltypes.NewCounterparty("transfer", "channel-1")},
+				true,
+			)
+
+			suite.network.App.SetTransferKeeper(transferkeeper.NewKeeper(
+				suite.network.App.AppCodec(),
+				suite.network.App.GetAccountKeeper().AddressCodec(),
+				runtime.NewKVStoreService(suite.network.App.GetKey(types.StoreKey)),
+				mockChannelKeeper,
+				suite.network.App.MsgServiceRouter(),
+				suite.network.App.GetAccountKeeper(),
+				suite.network.App.GetBankKeeper(),
+				suite.network.App.GetErc20Keeper(),
+				authAddr,
+				mockCircuitKeeper{available: true},
+				tc.breaker,
+			))
+
+			msg := types.NewMsgTransfer(
+				types.PortID,
+				"channel-0",
+				sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewInt(10)),
+				sender.AccAddr.String(),
+				receiver.String(),
+				timeoutHeight,
+				0,
+				"",
+			)
+
+			_, err := suite.network.App.GetTransferKeeper().Transfer(ctx, msg)
+			suite.Require().Error(err)
+			suite.Require().Contains(err.Error(), tc.errContains)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTransferBlockedWhenSystemUnavailable() {
 	suite.SetupTest()
 
@@ -0,0 +1,159 @@
+package vm
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/testutil/integration/base/factory"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	circuittypes "github.com/cosmos/evm/x/circuit/types"
+	"github.com/cosmos/evm/x/vm/keeper/testdata"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// forwarderInitCode deploys a minimal forwarder contract. Its calldata is a
+// one byte mode (0 = CALL forwarding msg.value, 1 = DELEGATECALL), the 20 byte
+// target address and the inner calldata. The forwarder returns the inner
+// return data on success and reverts with it on failure.
+const forwarderInitCode = "0x604580600b6000396000f3" +
+	"6015360380601560003760013560601c60003560f81c6027576000600083600034855af16032565b" +
+	"60006000836000845af45b3d600060003e6040573d6000fd5b3d6000f3"
+
+const (
+	forwardCall         byte = 0
+	forwardDelegateCall byte = 1
+)
+
+const frozenCallError = "frozen by the circuit breaker"
+
+// TestFrozenContractCalls checks that the circuit permission policy blocks
+// nested CALLs and DELEGATECALLs into a frozen contract, eth_call and value
+// transfers to a frozen address, while unrelated calls keep working and
+// unfreezing restores access.
+func (s *KeeperTestSuite) TestFrozenContractCalls() {
+	s.SetupTest()
+
+	operator := s.Keyring.GetKey(0)
+	user := s.Keyring.GetKey(1)
+
+	s.Network.App.GetCircuitKeeper().SetParams(s.Network.GetContext(), circuittypes.Params{
+		Whitelist:            []string{operator.AccAddr.String()},
+		TripThreshold:        1,
+		RestoreThreshold:     1,
+		ProposalWindowBlocks: 10,
+	})
+	s.Require().NoError(s.Network.NextBlock())
+
+	frozenAddr, err := deployErc20Contract(operator, s.Factory)
+	s.Require().NoError(err)
+	unrelatedAddr, err := deployErc20Contract(operator, s.Factory)
+	s.Require().NoError(err)
+	forwarderAddr, err := s.Factory.DeployContract(
+		operator.Priv,
+		types.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{
+			Contract: types.CompiledContract{Bin: common.FromHex(forwarderInitCode)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// an account without code, frozen to check plain value transfers
+	frozenPayee := common.BytesToAddress([]byte("frozen-payee"))
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+	balanceOf, err := erc20Contract.ABI.Pack("balanceOf", user.Addr)
+	s.Require().NoError(err)
+
+	forward := func(mode byte, target common.Address, value *big.Int) error {
+		input := append([]byte{mode}, target.Bytes()...)
+		input = append(input, balanceOf...)
+		_, err := s.Factory.ExecuteEthTx(user.Priv, types.EvmTxArgs{
+			To:       &forwarderAddr,
+			Input:    input,
+			Amount:   value,
+			GasLimit: 200_000,
+		})
+		s.Require().NoError(s.Network.NextBlock())
+		return err
+	}
+
+	transfer := func(to common.Address) error {
+		_, err := s.Factory.ExecuteEthTx(user.Priv, types.EvmTxArgs{
+			To:     &to,
+			Amount: big.NewInt(1),
+		})
+		s.Require().NoError(s.Network.NextBlock())
+		return err
+	}
+
+	ethCall := func(to common.Address) *types.MsgEthereumTxResponse {
+		args, err := json.Marshal(&types.TransactionArgs{
+			From: &user.Addr,
+			To:   &to,
+			Data: (*hexutil.Bytes)(&balanceOf),
+		})
+		s.Require().NoError(err)
+		res, err := s.Network.GetEvmClient().EthCall(
+			s.Network.GetContext(),
+			&types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap},
+		)
+		s.Require().NoError(err)
+		return res
+	}
+
+	// every path works before the freeze
+	s.Require().NoError(forward(forwardCall, frozenAddr, nil))
+	s.Require().NoError(forward(forwardDelegateCall, frozenAddr, nil))
+	s.Require().Empty(ethCall(frozenAddr).VmError)
+	s.Require().NoError(transfer(frozenPayee))
+	s.Require().NoError(forward(forwardCall, frozenPayee, big.NewInt(1)))
+
+	_, err = s.Factory.CommitCosmosTx(operator.Priv, factory.CosmosTxArgs{
+		Msgs: []sdk.Msg{&circuittypes.MsgFreezeContracts{
+			Signer:    operator.AccAddr.String(),
+			Addresses: []string{frozenAddr.Hex(), frozenPayee.Hex()},
+		}},
+	})
+	s.Require().NoError(err)
+	s.Require().True(s.Network.App.GetCircuitKeeper().IsContractFrozen(s.Network.GetContext(), frozenAddr))
+
+	err = forward(forwardCall, frozenAddr, nil)
+	s.Require().Error(err, "nested CALL into a frozen contract must fail")
+	err = forward(forwardDelegateCall, frozenAddr, nil)
+	s.Require().Error(err, "nested DELEGATECALL into a frozen contract must fail")
+	s.Require().Contains(ethCall(frozenAddr).VmError, frozenCallError)
+	err = transfer(frozenPayee)
+	s.Require().Error(err, "value transfer to a frozen address must fail")
+	err = forward(forwardCall, frozenPayee, big.NewInt(1))
+	s.Require().Error(err, "nested value transfer to a frozen address must fail")
+
+	// calls that do not touch the frozen address are unaffected
+	s.Require().NoError(forward(forwardCall, unrelatedAddr, nil))
+	s.Require().NoError(forward(forwardDelegateCall, unrelatedAddr, nil))
+	s.Require().Empty(ethCall(unrelatedAddr).VmError)
+	s.Require().NoError(transfer(operator.Addr))
+	s.Require().NoError(forward(forwardCall, operator.Addr, big.NewInt(1)))
+
+	_, err = s.Factory.CommitCosmosTx(operator.Priv, factory.CosmosTxArgs{
+		Msgs: []sdk.Msg{&circuittypes.MsgUnfreezeContracts{
+			Signer:    operator.AccAddr.String(),
+			Addresses: []string{frozenAddr.Hex(), frozenPayee.Hex()},
+		}},
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.Network.App.GetCircuitKeeper().IsContractFrozen(s.Network.GetContext(), frozenAddr))
+
+	s.Require().NoError(forward(forwardCall, frozenAddr, nil))
+	s.Require().NoError(forward(forwardDelegateCall, frozenAddr, nil))
+	s.Require().Empty(ethCall(frozenAddr).VmError)
+	s.Require().NoError(transfer(frozenPayee))
+	s.Require().NoError(forward(forwardCall, frozenPayee, big.NewInt(1)))
+}
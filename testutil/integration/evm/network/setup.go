@@ -13,10 +13,13 @@ import (
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	circuittype "github.com/cosmos/evm/x/circuit/types"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feeburntypes "github.com/cosmos/evm/x/feeburn/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
 	ibcbreakertypes "github.com/cosmos/evm/x/ibcbreaker/types"
 	ibcratelimiterexttypes "github.com/cosmos/evm/x/ibcratelimiterext/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
 	"github.com/cosmos/gogoproto/proto"
 
 	sdkmath "cosmossdk.io/math"
@@ -58,9 +61,12 @@ var genesisSetupFunctions = map[string]genSetupFn{
 	erc20types.ModuleName:             genStateSetter[*erc20types.GenesisState](erc20types.ModuleName),
 	govtypes.ModuleName:               genStateSetter[*govtypesv1.GenesisState](govtypes.ModuleName),
 	feemarkettypes.ModuleName:         genStateSetter[*feemarkettypes.GenesisState](feemarkettypes.ModuleName),
+	feeburntypes.ModuleName:           genStateSetter[*feeburntypes.GenesisState](feeburntypes.ModuleName),
 	circuittype.ModuleName:            genStateSetter[*circuittype.GenesisState](circuittype.ModuleName),
 	ibcbreakertypes.ModuleName:        genStateSetter[*ibcbreakertypes.GenesisState](ibcbreakertypes.ModuleName),
 	ibcratelimiterexttypes.ModuleName: genStateSetter[*ibcratelimiterexttypes.GenesisState](ibcratelimiterexttypes.ModuleName),
+	zkverifiertypes.ModuleName:        genStateSetter[*zkverifiertypes.GenesisState](zkverifiertypes.ModuleName),
+	frostgrouptypes.ModuleName:        genStateSetter[*frostgrouptypes.GenesisState](frostgrouptypes.ModuleName),
 	distrtypes.ModuleName:             genStateSetter[*distrtypes.GenesisState](distrtypes.ModuleName),
 	minttypes.ModuleName:              genStateSetter[*minttypes.GenesisState](minttypes.ModuleName),
 	slashingtypes.ModuleName:          genStateSetter[*slashingtypes.GenesisState](slashingtypes.ModuleName),
@@ -23,15 +23,30 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewUpdateCircuitCmd(),
+		NewVoteProposalCmd(),
+		NewFreezeContractsCmd(),
+		NewUnfreezeContractsCmd(),
 	)
 	return txCmd
 }
 
+const (
+	flagDisabledMsgTypes = "disabled-msg-types"
+	flagDisabledModules  = "disabled-modules"
+	flagClearMsgTypes    = "clear-disabled-msg-types"
+	flagClearModules     = "clear-disabled-modules"
+	flagExpiresAtHeight  = "expires-at-height"
+	flagReason           = "reason"
+)
+
 func NewUpdateCircuitCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-circuit [SYSTEM_AVAILABLE]",
-		Short: "Update the system availability flag",
-		Args:  cobra.ExactArgs(1),
+		Short: "Update the system availability flag and the disabled message types and modules",
+		Long: `Update the circuit state. A disabled set given in the flags replaces the
+current one, an omitted set is kept. Use --clear-disabled-msg-types and
+--clear-disabled-modules to re-enable every entry of a set.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -43,9 +58,128 @@ func NewUpdateCircuitCmd() *cobra.Command {
 				return err
 			}
 
+			disabledMsgTypes, err := cmd.Flags().GetStringSlice(flagDisabledMsgTypes)
+			if err != nil {
+				return err
+			}
+
+			disabledModules, err := cmd.Flags().GetStringSlice(flagDisabledModules)
+			if err != nil {
+				return err
+			}
+
+			clearMsgTypes, err := cmd.Flags().GetBool(flagClearMsgTypes)
+			if err != nil {
+				return err
+			}
+
+			clearModules, err := cmd.Flags().GetBool(flagClearModules)
+			if err != nil {
+				return err
+			}
+
+			expiresAtHeight, err := cmd.Flags().GetUint64(flagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(flagReason)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUpdateCircuit{
-				Signer:          clientCtx.GetFromAddress().String(),
-				SystemAvailable: available,
+				Signer:                   clientCtx.GetFromAddress().String(),
+				SystemAvailable:          available,
+				DisabledMsgTypeUrls:      disabledMsgTypes,
+				DisabledModules:          disabledModules,
+				ExpiresAtHeight:          expiresAtHeight,
+				Reason:                   reason,
+				ClearDisabledMsgTypeUrls: clearMsgTypes,
+				ClearDisabledModules:     clearModules,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagDisabledMsgTypes, []string{}, "Comma-separated message type URLs to disable")
+	cmd.Flags().StringSlice(flagDisabledModules, []string{}, "Comma-separated module names to disable")
+	cmd.Flags().Bool(flagClearMsgTypes, false, "Re-enable every disabled message type URL")
+	cmd.Flags().Bool(flagClearModules, false, "Re-enable every disabled module")
+	cmd.Flags().Uint64(flagExpiresAtHeight, 0, "Block height at which the trip is automatically restored (0 for no expiry)")
+	cmd.Flags().String(flagReason, "", "Justification recorded in the circuit action log")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewVoteProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-proposal [PROPOSAL_ID]",
+		Short: "Approve a pending circuit proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgVoteProposal{
+				Signer:     clientCtx.GetFromAddress().String(),
+				ProposalId: proposalID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewFreezeContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-contracts [ADDRESS]...",
+		Short: "Reject every EVM call to the given contract or precompile addresses",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFreezeContracts{
+				Signer:    clientCtx.GetFromAddress().String(),
+				Addresses: args,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewUnfreezeContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-contracts [ADDRESS]...",
+		Short: "Allow EVM calls to the given contract or precompile addresses again",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnfreezeContracts{
+				Signer:    clientCtx.GetFromAddress().String(),
+				Addresses: args,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -1,6 +1,8 @@
 package circuit
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/x/circuit/keeper"
 	"github.com/cosmos/evm/x/circuit/types"
 
@@ -9,14 +11,28 @@ import (
 
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
-	k.SetSystemAvailable(ctx, genState.State.SystemAvailable)
+	k.SetCircuitState(ctx, genState.State)
+	for _, addr := range genState.FrozenContracts {
+		k.SetContractFrozen(ctx, common.HexToAddress(addr), true)
+	}
+	for _, proposal := range genState.Proposals {
+		k.SetProposal(ctx, proposal)
+	}
+	if genState.NextProposalId != 0 {
+		k.SetNextProposalID(ctx, genState.NextProposalId)
+	}
+	for _, action := range genState.Actions {
+		k.SetAction(ctx, action)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		State: types.CircuitState{
-			SystemAvailable: k.GetSystemAvailable(ctx),
-		},
+		Params:          k.GetParams(ctx),
+		State:           k.GetCircuitState(ctx),
+		FrozenContracts: k.GetFrozenContracts(ctx),
+		Proposals:       k.GetProposals(ctx),
+		NextProposalId:  k.GetNextProposalID(ctx),
+		Actions:         k.GetActions(ctx),
 	}
 }
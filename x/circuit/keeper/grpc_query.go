@@ -3,11 +3,15 @@ package keeper
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/evm/x/circuit/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -22,6 +26,7 @@ func (k Keeper) SystemAvailable(c context.Context, req *types.QuerySystemAvailab
 
 	return &types.QuerySystemAvailableResponse{
 		SystemAvailable: available,
+		ExpiresAtHeight: k.GetTripExpiresAtHeight(ctx),
 	}, nil
 }
 
@@ -37,3 +42,110 @@ func (k Keeper) Whitelist(c context.Context, req *types.QueryWhitelistRequest) (
 		Whitelist: params.Whitelist,
 	}, nil
 }
+
+func (k Keeper) DisabledMsgTypeURLs(c context.Context, req *types.QueryDisabledMsgTypeURLsRequest) (*types.QueryDisabledMsgTypeURLsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDisabledMsgTypeURLsResponse{
+		DisabledMsgTypeUrls: k.GetDisabledMsgTypeURLs(ctx),
+	}, nil
+}
+
+func (k Keeper) DisabledModules(c context.Context, req *types.QueryDisabledModulesRequest) (*types.QueryDisabledModulesResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDisabledModulesResponse{
+		DisabledModules: k.GetDisabledModules(ctx),
+	}, nil
+}
+
+func (k Keeper) FrozenContracts(c context.Context, req *types.QueryFrozenContractsRequest) (*types.QueryFrozenContractsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFrozenContractsResponse{
+		FrozenContracts: k.GetFrozenContracts(ctx),
+	}, nil
+}
+
+func (k Keeper) ContractFrozen(c context.Context, req *types.QueryContractFrozenRequest) (*types.QueryContractFrozenResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if !common.IsHexAddress(req.Address) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid hex address: %s", req.Address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryContractFrozenResponse{
+		Frozen: k.IsContractFrozen(ctx, common.HexToAddress(req.Address)),
+	}, nil
+}
+
+func (k Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryProposalsResponse{
+		Proposals: k.GetProposals(ctx),
+	}, nil
+}
+
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, found := k.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "proposal %d not found", req.ProposalId)
+	}
+
+	return &types.QueryProposalResponse{
+		Proposal: proposal,
+	}, nil
+}
+
+func (k Keeper) Actions(c context.Context, req *types.QueryActionsRequest) (*types.QueryActionsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAction)
+
+	actions := []types.CircuitAction{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var action types.CircuitAction
+		if err := k.cdc.Unmarshal(value, &action); err != nil {
+			return err
+		}
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryActionsResponse{
+		Actions:    actions,
+		Pagination: pageRes,
+	}, nil
+}
@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/x/circuit/types"
 
 	"cosmossdk.io/log"
+	prefixstore "cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -76,3 +81,156 @@ func (k Keeper) SetSystemAvailable(ctx sdk.Context, available bool) {
 	}
 	store.Set(types.KeyState, []byte{0})
 }
+
+func (k Keeper) GetDisabledMsgTypeURLs(ctx sdk.Context) []string {
+	return k.getDisabledSet(ctx, types.KeyPrefixDisabledMsgTypeURL)
+}
+
+func (k Keeper) SetDisabledMsgTypeURLs(ctx sdk.Context, typeURLs []string) {
+	k.setDisabledSet(ctx, types.KeyPrefixDisabledMsgTypeURL, typeURLs)
+}
+
+func (k Keeper) GetDisabledModules(ctx sdk.Context) []string {
+	return k.getDisabledSet(ctx, types.KeyPrefixDisabledModule)
+}
+
+func (k Keeper) SetDisabledModules(ctx sdk.Context, modules []string) {
+	k.setDisabledSet(ctx, types.KeyPrefixDisabledModule, modules)
+}
+
+// IsMsgTypeDisabled reports whether messages with the given type URL are
+// rejected, either directly or through their module.
+func (k Keeper) IsMsgTypeDisabled(ctx sdk.Context, typeURL string) bool {
+	if ctx.KVStore(k.storeKey).Has(types.DisabledMsgTypeURLKey(typeURL)) {
+		return true
+	}
+	moduleName := types.ModuleNameFromTypeURL(typeURL)
+	return moduleName != "" && k.IsModuleDisabled(ctx, moduleName)
+}
+
+// IsModuleDisabled reports whether every message of the given module is
+// rejected.
+func (k Keeper) IsModuleDisabled(ctx sdk.Context, moduleName string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DisabledModuleKey(moduleName))
+}
+
+// IsContractCreationDisabled reports whether EVM contract creation is
+// rejected, either through the ContractCreationTypeURL entry or through the
+// vm module.
+func (k Keeper) IsContractCreationDisabled(ctx sdk.Context) bool {
+	return k.IsMsgTypeDisabled(ctx, types.ContractCreationTypeURL)
+}
+
+// GetTripExpiresAtHeight returns the height at which the current trip is
+// automatically restored, or zero if it does not expire.
+func (k Keeper) GetTripExpiresAtHeight(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyTripExpiry)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetTripExpiresAtHeight(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if height == 0 {
+		store.Delete(types.KeyTripExpiry)
+		return
+	}
+	store.Set(types.KeyTripExpiry, sdk.Uint64ToBigEndian(height))
+}
+
+func (k Keeper) GetCircuitState(ctx sdk.Context) types.CircuitState {
+	return types.CircuitState{
+		SystemAvailable:     k.GetSystemAvailable(ctx),
+		DisabledMsgTypeUrls: k.GetDisabledMsgTypeURLs(ctx),
+		DisabledModules:     k.GetDisabledModules(ctx),
+		ExpiresAtHeight:     k.GetTripExpiresAtHeight(ctx),
+	}
+}
+
+func (k Keeper) SetCircuitState(ctx sdk.Context, state types.CircuitState) {
+	k.SetSystemAvailable(ctx, state.SystemAvailable)
+	k.SetDisabledMsgTypeURLs(ctx, state.DisabledMsgTypeUrls)
+	k.SetDisabledModules(ctx, state.DisabledModules)
+	k.SetTripExpiresAtHeight(ctx, state.ExpiresAtHeight)
+}
+
+// BeginBlocker prunes proposals whose voting window has ended and restores the
+// circuit once a time-boxed trip reaches its expiry height. Frozen contracts
+// are not affected.
+func (k Keeper) BeginBlocker(ctx sdk.Context) error {
+	k.pruneExpiredProposals(ctx)
+
+	expiresAtHeight := k.GetTripExpiresAtHeight(ctx)
+	if expiresAtHeight == 0 || uint64(ctx.BlockHeight()) < expiresAtHeight { //#nosec G115 -- block height is never negative
+		return nil
+	}
+
+	current := k.GetCircuitState(ctx)
+	restored := types.CircuitState{SystemAvailable: true}
+	k.SetCircuitState(ctx, restored)
+	k.AppendAction(ctx, nil, current, restored, "trip expired")
+	k.Logger(ctx).Info("circuit trip expired, system restored", "expires_at_height", expiresAtHeight)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRestore,
+			sdk.NewAttribute(types.AttributeKeyExpiresAtHeight, strconv.FormatUint(expiresAtHeight, 10)),
+		),
+	)
+	return nil
+}
+
+// IsContractFrozen reports whether EVM calls to address are rejected.
+func (k Keeper) IsContractFrozen(ctx sdk.Context, address common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FrozenContractKey(address))
+}
+
+func (k Keeper) SetContractFrozen(ctx sdk.Context, address common.Address, frozen bool) {
+	store := ctx.KVStore(k.storeKey)
+	if frozen {
+		store.Set(types.FrozenContractKey(address), []byte{1})
+		return
+	}
+	store.Delete(types.FrozenContractKey(address))
+}
+
+func (k Keeper) GetFrozenContracts(ctx sdk.Context) []string {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenContract)
+	defer iterator.Close()
+
+	contracts := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		address := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixFrozenContract):])
+		contracts = append(contracts, address.Hex())
+	}
+	return contracts
+}
+
+func (k Keeper) getDisabledSet(ctx sdk.Context, prefix []byte) []string {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	entries := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, string(iterator.Key()[len(prefix):]))
+	}
+	return entries
+}
+
+func (k Keeper) setDisabledSet(ctx sdk.Context, prefix []byte, entries []string) {
+	store := prefixstore.NewStore(ctx.KVStore(k.storeKey), prefix)
+	iterator := store.Iterator(nil, nil)
+	var existing [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		existing = append(existing, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range existing {
+		store.Delete(key)
+	}
+	for _, entry := range entries {
+		store.Set([]byte(entry), []byte{1})
+	}
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cosmos/evm/x/circuit/types"
 
@@ -27,22 +30,127 @@ func (m msgServer) UpdateCircuit(goCtx context.Context, req *types.MsgUpdateCirc
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err := m.authorizeOperator(ctx, req.Signer); err != nil {
+		return nil, err
+	}
+
+	desired := req.DesiredState(m.GetCircuitState(ctx))
+	if err := desired.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	params := m.GetParams(ctx)
+	if _, err := m.resolveState(ctx, params, desired); err != nil {
+		return nil, err
+	}
+
+	proposal, found := m.findProposal(ctx, desired)
+	if !found {
+		proposal = types.CircuitProposal{
+			State:           desired,
+			VotingEndHeight: params.VotingEndHeight(uint64(ctx.BlockHeight())), //#nosec G115 -- block height is never negative
+			Reason:          req.Reason,
+		}
+	}
+
+	executed, err := m.approveProposal(ctx, params, &proposal, req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCircuitResponse{ProposalId: proposal.Id, Executed: executed}, nil
+}
+
+func (m msgServer) VoteProposal(goCtx context.Context, req *types.MsgVoteProposal) (*types.MsgVoteProposalResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.authorizeOperator(ctx, req.Signer); err != nil {
+		return nil, err
+	}
+
+	proposal, found := m.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "proposal %d not found", req.ProposalId)
+	}
+	if proposal.VotingEndHeight != 0 && uint64(ctx.BlockHeight()) > proposal.VotingEndHeight { //#nosec G115 -- block height is never negative
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "voting on proposal %d ended at height %d", proposal.Id, proposal.VotingEndHeight)
+	}
+
+	executed, err := m.approveProposal(ctx, m.GetParams(ctx), &proposal, req.Signer)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid signer address")
+		return nil, err
+	}
+
+	return &types.MsgVoteProposalResponse{Executed: executed}, nil
+}
+
+func (m msgServer) FreezeContracts(goCtx context.Context, req *types.MsgFreezeContracts) (*types.MsgFreezeContractsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.authorizeOperator(ctx, req.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateFrozenContracts(req.Addresses); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	for _, addr := range req.Addresses {
+		address := common.HexToAddress(addr)
+		if m.IsContractFrozen(ctx, address) {
+			continue
+		}
+
+		m.SetContractFrozen(ctx, address, true)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFreezeContract,
+				sdk.NewAttribute(types.AttributeKeyContract, address.Hex()),
+				sdk.NewAttribute(types.AttributeKeySigner, req.Signer),
+			),
+		)
+	}
+
+	return &types.MsgFreezeContractsResponse{}, nil
+}
+
+func (m msgServer) UnfreezeContracts(goCtx context.Context, req *types.MsgUnfreezeContracts) (*types.MsgUnfreezeContractsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
 	}
 
-	if !m.IsWhitelisted(ctx, signer) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signer not whitelisted")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.authorizeOperator(ctx, req.Signer); err != nil {
+		return nil, err
 	}
 
-	if m.GetSystemAvailable(ctx) == req.SystemAvailable {
-		return &types.MsgUpdateCircuitResponse{}, nil
+	if err := types.ValidateFrozenContracts(req.Addresses); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
-	m.SetSystemAvailable(ctx, req.SystemAvailable)
+	for _, addr := range req.Addresses {
+		address := common.HexToAddress(addr)
+		if !m.IsContractFrozen(ctx, address) {
+			continue
+		}
 
-	return &types.MsgUpdateCircuitResponse{}, nil
+		m.SetContractFrozen(ctx, address, false)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnfreezeContract,
+				sdk.NewAttribute(types.AttributeKeyContract, address.Hex()),
+				sdk.NewAttribute(types.AttributeKeySigner, req.Signer),
+			),
+		)
+	}
+
+	return &types.MsgUnfreezeContractsResponse{}, nil
 }
 
 func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -71,7 +179,127 @@ func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	if !m.GetSystemAvailable(ctx) && len(req.Params.Whitelist) == 0 {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "whitelist cannot be empty while system is unavailable")
 	}
+	if m.GetCircuitState(ctx).IsTripped() && len(req.Params.Whitelist) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "whitelist cannot be empty while messages or modules are disabled")
+	}
+	if len(m.GetFrozenContracts(ctx)) > 0 && len(req.Params.Whitelist) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "whitelist cannot be empty while contracts are frozen")
+	}
 	m.SetParams(ctx, *req.Params)
+	// votes were cast under the previous whitelist and thresholds
+	m.clearProposals(ctx)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// approveProposal records signer's vote on proposal and applies the proposed
+// state once the required number of operators approved it. Proposals that are
+// still short of the threshold are stored, allocating an id if needed.
+func (m msgServer) approveProposal(ctx sdk.Context, params types.Params, proposal *types.CircuitProposal, signer string) (bool, error) {
+	for _, voter := range proposal.Voters {
+		if voter == signer {
+			return false, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "signer already voted on proposal %d", proposal.Id)
+		}
+	}
+	proposal.Voters = append(proposal.Voters, signer)
+
+	desired, err := m.resolveState(ctx, params, proposal.State)
+	if err != nil {
+		return false, err
+	}
+
+	current := m.GetCircuitState(ctx)
+	required := params.RequiredVotes(liftsRestrictions(current, desired))
+	if uint32(len(proposal.Voters)) < required { //#nosec G115 -- voters are bounded by the whitelist size
+		if proposal.Id == 0 {
+			proposal.Id = m.GetNextProposalID(ctx)
+			m.SetNextProposalID(ctx, proposal.Id+1)
+		}
+		m.SetProposal(ctx, *proposal)
+		m.emitProposalEvent(ctx, types.EventTypeProposalVote, proposal.Id, signer)
+		return false, nil
+	}
+
+	if !sameCircuitState(current, desired) {
+		m.applyCircuitState(ctx, current, desired)
+		m.AppendAction(ctx, proposal.Voters, current, desired, proposal.Reason)
+	}
+	if proposal.Id != 0 {
+		m.DeleteProposal(ctx, proposal.Id)
+		m.emitProposalEvent(ctx, types.EventTypeProposalVote, proposal.Id, signer)
+		m.emitProposalEvent(ctx, types.EventTypeProposalExecuted, proposal.Id, signer)
+	}
+	return true, nil
+}
+
+// resolveState returns state with its trip expiry resolved against params at
+// the current height.
+func (m msgServer) resolveState(ctx sdk.Context, params types.Params, state types.CircuitState) (types.CircuitState, error) {
+	if !state.IsTripped() {
+		return state, nil
+	}
+
+	expiresAtHeight, err := params.TripExpiry(uint64(ctx.BlockHeight()), state.ExpiresAtHeight) //#nosec G115 -- block height is never negative
+	if err != nil {
+		return types.CircuitState{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	state.ExpiresAtHeight = expiresAtHeight
+	return state, nil
+}
+
+// applyCircuitState writes the parts of desired that differ from current.
+func (m msgServer) applyCircuitState(ctx sdk.Context, current, desired types.CircuitState) {
+	if current.ExpiresAtHeight != desired.ExpiresAtHeight {
+		m.SetTripExpiresAtHeight(ctx, desired.ExpiresAtHeight)
+	}
+	if current.SystemAvailable != desired.SystemAvailable {
+		m.SetSystemAvailable(ctx, desired.SystemAvailable)
+	}
+	if !sameStringSet(current.DisabledMsgTypeUrls, desired.DisabledMsgTypeUrls) {
+		m.SetDisabledMsgTypeURLs(ctx, desired.DisabledMsgTypeUrls)
+	}
+	if !sameStringSet(current.DisabledModules, desired.DisabledModules) {
+		m.SetDisabledModules(ctx, desired.DisabledModules)
+	}
+}
+
+func (m msgServer) emitProposalEvent(ctx sdk.Context, eventType string, proposalID uint64, signer string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, signer),
+		),
+	)
+}
+
+// authorizeOperator checks that signer is a valid whitelisted operator address.
+func (m msgServer) authorizeOperator(ctx sdk.Context, signer string) error {
+	signerAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if !m.IsWhitelisted(ctx, signerAddr) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signer not whitelisted")
+	}
+	return nil
+}
+
+// sameStringSet reports whether a and b hold the same entries. Both inputs are
+// expected to be free of duplicates, which state and message validation enforce.
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]struct{}, len(a))
+	for _, entry := range a {
+		set[entry] = struct{}{}
+	}
+	for _, entry := range b {
+		if _, ok := set[entry]; !ok {
+			return false
+		}
+	}
+	return true
+}
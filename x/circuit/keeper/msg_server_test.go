@@ -3,7 +3,9 @@ package keeper
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	cmttypes "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -18,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func setupKeeper(t *testing.T) (Keeper, sdk.Context) {
@@ -155,3 +158,548 @@ func TestWhitelistRejectsNilRequest(t *testing.T) {
 	_, err := k.Whitelist(sdk.WrapSDKContext(ctx), nil)
 	require.Error(t, err)
 }
+
+func TestUpdateCircuitScopedTrips(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+
+	operator := sdk.AccAddress([]byte("operator_1"))
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}})
+
+	ethTxTypeURL := "/cosmos.evm.vm.v1.MsgEthereumTx"
+	_, err := srv.UpdateCircuit(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuit{
+		Signer:              operator.String(),
+		SystemAvailable:     true,
+		DisabledMsgTypeUrls: []string{ethTxTypeURL},
+		DisabledModules:     []string{"erc20"},
+	})
+	require.NoError(t, err)
+	require.True(t, k.GetSystemAvailable(ctx))
+	require.Equal(t, []string{ethTxTypeURL}, k.GetDisabledMsgTypeURLs(ctx))
+	require.Equal(t, []string{"erc20"}, k.GetDisabledModules(ctx))
+
+	require.True(t, k.IsMsgTypeDisabled(ctx, ethTxTypeURL))
+	require.True(t, k.IsMsgTypeDisabled(ctx, "/cosmos.evm.erc20.v1.MsgConvertERC20"))
+	require.False(t, k.IsMsgTypeDisabled(ctx, "/cosmos.staking.v1beta1.MsgDelegate"))
+
+	_, err = srv.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.authority.String(),
+		Params:    &types.Params{Whitelist: []string{}},
+	})
+	require.Error(t, err)
+
+	// omitted sets are kept
+	_, err = srv.UpdateCircuit(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuit{
+		Signer:          operator.String(),
+		SystemAvailable: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{ethTxTypeURL}, k.GetDisabledMsgTypeURLs(ctx))
+	require.Equal(t, []string{"erc20"}, k.GetDisabledModules(ctx))
+
+	// a provided set replaces only that set
+	_, err = srv.UpdateCircuit(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuit{
+		Signer:          operator.String(),
+		SystemAvailable: true,
+		DisabledModules: []string{"staking"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{ethTxTypeURL}, k.GetDisabledMsgTypeURLs(ctx))
+	require.Equal(t, []string{"staking"}, k.GetDisabledModules(ctx))
+
+	_, err = srv.UpdateCircuit(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuit{
+		Signer:                   operator.String(),
+		SystemAvailable:          true,
+		ClearDisabledMsgTypeUrls: true,
+		ClearDisabledModules:     true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, k.GetDisabledMsgTypeURLs(ctx))
+	require.Empty(t, k.GetDisabledModules(ctx))
+	require.False(t, k.IsMsgTypeDisabled(ctx, ethTxTypeURL))
+}
+
+func TestUpdateCircuitContractCreationScope(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+
+	operator := sdk.AccAddress([]byte("operator_1"))
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}})
+	require.False(t, k.IsContractCreationDisabled(ctx))
+
+	_, err := srv.UpdateCircuit(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuit{
+		Signer:              operator.String(),
+		SystemAvailable:     true,
+		DisabledMsgTypeUrls: []string{types.ContractCreationTypeURL},
+	})
+	require.NoError(t, err)
+	require.True(t, k.IsContractCreationDisabled(ctx))
+	require.False(t, k.IsMsgTypeDisabled(ctx, "/cosmos.evm.vm.v1.MsgEthereumTx"))
+	require.False(t, k.IsModuleDisabled(ctx, "vm"))
+
+	// disabling the vm module also disables contract creation
+	_, err = srv.UpdateCircuit(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuit{
+		Signer:                   operator.String(),
+		SystemAvailable:          true,
+		ClearDisabledMsgTypeUrls: true,
+		DisabledModules:          []string{"vm"},
+	})
+	require.NoError(t, err)
+	require.True(t, k.IsModuleDisabled(ctx, "vm"))
+	require.True(t, k.IsContractCreationDisabled(ctx))
+}
+
+func TestUpdateCircuitRejectsDisablingCircuitControl(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+
+	operator := sdk.AccAddress([]byte("operator_1"))
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}})
+
+	_, err := srv.UpdateCircuit(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuit{
+		Signer:          operator.String(),
+		SystemAvailable: true,
+		DisabledModules: []string{types.ModuleName},
+	})
+	require.Error(t, err)
+
+	_, err = srv.UpdateCircuit(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuit{
+		Signer:              operator.String(),
+		SystemAvailable:     true,
+		DisabledMsgTypeUrls: []string{sdk.MsgTypeURL(&types.MsgUpdateCircuit{})},
+	})
+	require.Error(t, err)
+	require.Empty(t, k.GetDisabledModules(ctx))
+	require.Empty(t, k.GetDisabledMsgTypeURLs(ctx))
+}
+
+func TestDisabledScopeQueriesRejectNilRequest(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	_, err := k.DisabledMsgTypeURLs(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	_, err = k.DisabledModules(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
+
+func TestFreezeAndUnfreezeContracts(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+
+	operator := sdk.AccAddress([]byte("operator_1"))
+	blocked := sdk.AccAddress([]byte("operator_2"))
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}})
+
+	contract := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	_, err := srv.FreezeContracts(sdk.WrapSDKContext(ctx), &types.MsgFreezeContracts{
+		Signer:    blocked.String(),
+		Addresses: []string{contract.Hex()},
+	})
+	require.Error(t, err)
+	require.False(t, k.IsContractFrozen(ctx, contract))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.FreezeContracts(sdk.WrapSDKContext(ctx), &types.MsgFreezeContracts{
+		Signer:    operator.String(),
+		Addresses: []string{strings.ToLower(contract.Hex())},
+	})
+	require.NoError(t, err)
+	require.True(t, k.IsContractFrozen(ctx, contract))
+	require.Equal(t, []string{contract.Hex()}, k.GetFrozenContracts(ctx))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeFreezeContract, ctx.EventManager().Events()[0].Type)
+
+	res, err := k.ContractFrozen(sdk.WrapSDKContext(ctx), &types.QueryContractFrozenRequest{Address: contract.Hex()})
+	require.NoError(t, err)
+	require.True(t, res.Frozen)
+
+	_, err = srv.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.authority.String(),
+		Params:    &types.Params{Whitelist: []string{}},
+	})
+	require.Error(t, err)
+
+	// freezing an already frozen address is a no-op without events
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.FreezeContracts(sdk.WrapSDKContext(ctx), &types.MsgFreezeContracts{
+		Signer:    operator.String(),
+		Addresses: []string{contract.Hex()},
+	})
+	require.NoError(t, err)
+	require.Empty(t, ctx.EventManager().Events())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.UnfreezeContracts(sdk.WrapSDKContext(ctx), &types.MsgUnfreezeContracts{
+		Signer:    operator.String(),
+		Addresses: []string{contract.Hex()},
+	})
+	require.NoError(t, err)
+	require.False(t, k.IsContractFrozen(ctx, contract))
+	require.Empty(t, k.GetFrozenContracts(ctx))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeUnfreezeContract, ctx.EventManager().Events()[0].Type)
+}
+
+func TestUpdateCircuitTripExpiry(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	operator := sdk.AccAddress([]byte("operator_1"))
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}, MaxTripDurationBlocks: 20})
+	k.SetSystemAvailable(ctx, true)
+
+	// restoring the circuit cannot carry an expiry
+	_, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          operator.String(),
+		SystemAvailable: true,
+		ExpiresAtHeight: 110,
+	})
+	require.Error(t, err)
+
+	// an expiry beyond the maximum trip duration is rejected
+	_, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          operator.String(),
+		SystemAvailable: false,
+		ExpiresAtHeight: 121,
+	})
+	require.Error(t, err)
+	require.True(t, k.GetSystemAvailable(ctx))
+
+	// without an explicit expiry the maximum trip duration applies
+	_, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          operator.String(),
+		SystemAvailable: false,
+	})
+	require.NoError(t, err)
+	require.False(t, k.GetSystemAvailable(ctx))
+	require.Equal(t, uint64(120), k.GetTripExpiresAtHeight(ctx))
+
+	resp, err := k.SystemAvailable(ctx, &types.QuerySystemAvailableRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), resp.ExpiresAtHeight)
+
+	// restoring manually clears the expiry
+	_, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          operator.String(),
+		SystemAvailable: true,
+	})
+	require.NoError(t, err)
+	require.True(t, k.GetSystemAvailable(ctx))
+	require.Zero(t, k.GetTripExpiresAtHeight(ctx))
+}
+
+func TestBeginBlockerRestoresExpiredTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	operator := sdk.AccAddress([]byte("operator_1"))
+	contract := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}})
+	k.SetSystemAvailable(ctx, true)
+	k.SetContractFrozen(ctx, contract, true)
+
+	_, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          operator.String(),
+		SystemAvailable: false,
+		DisabledModules: []string{"erc20"},
+		ExpiresAtHeight: 105,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(104).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.False(t, k.GetSystemAvailable(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+
+	ctx = ctx.WithBlockHeight(105).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	state := k.GetCircuitState(ctx)
+	require.False(t, state.IsTripped())
+	require.Zero(t, state.ExpiresAtHeight)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeAutoRestore, events[0].Type)
+
+	// frozen contracts are not part of the time-boxed trip
+	require.True(t, k.IsContractFrozen(ctx, contract))
+}
+
+func TestUpdateCircuitQuorum(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	op1 := sdk.AccAddress([]byte("operator_1")).String()
+	op2 := sdk.AccAddress([]byte("operator_2")).String()
+	op3 := sdk.AccAddress([]byte("operator_3")).String()
+	k.SetParams(ctx, types.Params{
+		Whitelist:            []string{op1, op2, op3},
+		TripThreshold:        1,
+		RestoreThreshold:     2,
+		ProposalWindowBlocks: 10,
+	})
+
+	// a single operator can trip the circuit
+	resp, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          op1,
+		SystemAvailable: true,
+		DisabledModules: []string{"erc20", "staking"},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Executed)
+	require.Zero(t, resp.ProposalId)
+	require.Equal(t, []string{"erc20", "staking"}, k.GetDisabledModules(ctx))
+
+	// re-enabling one module is a restore and needs two operators
+	resp, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          op1,
+		SystemAvailable: true,
+		DisabledModules: []string{"erc20"},
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Executed)
+	require.Equal(t, uint64(1), resp.ProposalId)
+	require.Equal(t, []string{"erc20", "staking"}, k.GetDisabledModules(ctx))
+
+	proposal, err := k.Proposal(ctx, &types.QueryProposalRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{op1}, proposal.Proposal.Voters)
+	require.Equal(t, uint64(110), proposal.Proposal.VotingEndHeight)
+
+	// the same operator cannot vote twice
+	_, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op1, ProposalId: 1})
+	require.Error(t, err)
+	_, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          op1,
+		SystemAvailable: true,
+		DisabledModules: []string{"erc20"},
+	})
+	require.Error(t, err)
+
+	// an identical update from another operator counts as a vote
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	resp, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          op2,
+		SystemAvailable: true,
+		DisabledModules: []string{"erc20"},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Executed)
+	require.Equal(t, uint64(1), resp.ProposalId)
+	require.Equal(t, []string{"erc20"}, k.GetDisabledModules(ctx))
+	require.Empty(t, k.GetProposals(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeProposalVote, events[0].Type)
+	require.Equal(t, types.EventTypeProposalExecuted, events[1].Type)
+
+	// restoring the rest through an explicit vote
+	resp, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{Signer: op3, SystemAvailable: true, ClearDisabledModules: true})
+	require.NoError(t, err)
+	require.False(t, resp.Executed)
+	require.Equal(t, uint64(2), resp.ProposalId)
+
+	voteResp, err := srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op1, ProposalId: 2})
+	require.NoError(t, err)
+	require.True(t, voteResp.Executed)
+	require.False(t, k.GetCircuitState(ctx).IsTripped())
+
+	_, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op2, ProposalId: 2})
+	require.Error(t, err)
+}
+
+func TestUpdateCircuitQuorumTreatsEarlierExpiryAsRestore(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	op1 := sdk.AccAddress([]byte("operator_1")).String()
+	op2 := sdk.AccAddress([]byte("operator_2")).String()
+	k.SetParams(ctx, types.Params{
+		Whitelist:            []string{op1, op2},
+		TripThreshold:        1,
+		RestoreThreshold:     2,
+		ProposalWindowBlocks: 10,
+	})
+
+	_, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{Signer: op1, SystemAvailable: false})
+	require.NoError(t, err)
+	require.False(t, k.GetSystemAvailable(ctx))
+
+	// adding an expiry to an open-ended trip brings the restore forward
+	resp, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          op1,
+		SystemAvailable: false,
+		ExpiresAtHeight: 101,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Executed)
+	require.Zero(t, k.GetTripExpiresAtHeight(ctx))
+}
+
+func TestCircuitProposalExpiry(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	op1 := sdk.AccAddress([]byte("operator_1")).String()
+	op2 := sdk.AccAddress([]byte("operator_2")).String()
+	k.SetParams(ctx, types.Params{
+		Whitelist:            []string{op1, op2},
+		TripThreshold:        2,
+		RestoreThreshold:     2,
+		ProposalWindowBlocks: 5,
+	})
+
+	resp, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{Signer: op1, SystemAvailable: false})
+	require.NoError(t, err)
+	require.False(t, resp.Executed)
+	require.True(t, k.GetSystemAvailable(ctx))
+
+	ctx = ctx.WithBlockHeight(105)
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Len(t, k.GetProposals(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(106).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Empty(t, k.GetProposals(ctx))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeProposalExpired, ctx.EventManager().Events()[0].Type)
+
+	_, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op2, ProposalId: resp.ProposalId})
+	require.Error(t, err)
+	require.True(t, k.GetSystemAvailable(ctx))
+}
+
+func TestUpdateParamsClearsProposals(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+
+	op1 := sdk.AccAddress([]byte("operator_1")).String()
+	op2 := sdk.AccAddress([]byte("operator_2")).String()
+	params := types.Params{
+		Whitelist:            []string{op1, op2},
+		TripThreshold:        2,
+		RestoreThreshold:     2,
+		ProposalWindowBlocks: 5,
+	}
+	k.SetParams(ctx, params)
+
+	_, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{Signer: op1, SystemAvailable: false})
+	require.NoError(t, err)
+	require.Len(t, k.GetProposals(ctx), 1)
+
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.authority.String(), Params: &params})
+	require.NoError(t, err)
+	require.Empty(t, k.GetProposals(ctx))
+	require.Equal(t, uint64(2), k.GetNextProposalID(ctx))
+}
+
+func TestCircuitActionLog(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	op1 := sdk.AccAddress([]byte("operator_1")).String()
+	op2 := sdk.AccAddress([]byte("operator_2")).String()
+	k.SetParams(ctx, types.Params{
+		Whitelist:            []string{op1, op2},
+		TripThreshold:        1,
+		RestoreThreshold:     2,
+		ProposalWindowBlocks: 10,
+	})
+
+	_, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          op1,
+		SystemAvailable: false,
+		ExpiresAtHeight: 150,
+		Reason:          "bridge exploit",
+	})
+	require.NoError(t, err)
+
+	// no-op updates are not logged
+	_, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{
+		Signer:          op2,
+		SystemAvailable: false,
+		ExpiresAtHeight: 150,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(120)
+	_, err = srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{Signer: op2, SystemAvailable: true, Reason: "patched"})
+	require.NoError(t, err)
+	_, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op1, ProposalId: 1})
+	require.NoError(t, err)
+
+	actions := k.GetActions(ctx)
+	require.Len(t, actions, 2)
+	require.Equal(t, uint64(1), actions[0].Id)
+	require.Equal(t, []string{op1}, actions[0].Signers)
+	require.Equal(t, int64(100), actions[0].Height)
+	require.Equal(t, ctx.BlockTime(), actions[0].Time)
+	require.True(t, actions[0].OldState.SystemAvailable)
+	require.False(t, actions[0].NewState.SystemAvailable)
+	require.Equal(t, uint64(150), actions[0].NewState.ExpiresAtHeight)
+	require.Equal(t, "bridge exploit", actions[0].Reason)
+	require.Equal(t, []string{op2, op1}, actions[1].Signers)
+	require.Equal(t, int64(120), actions[1].Height)
+	require.Equal(t, "patched", actions[1].Reason)
+
+	resp, err := k.Actions(ctx, &types.QueryActionsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, resp.Actions, 1)
+	require.Equal(t, uint64(1), resp.Actions[0].Id)
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+
+	resp, err = k.Actions(ctx, &types.QueryActionsRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, resp.Actions, 1)
+	require.Equal(t, uint64(2), resp.Actions[0].Id)
+
+	_, err = k.Actions(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestCircuitActionLogRecordsAutoRestore(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	operator := sdk.AccAddress([]byte("operator_1")).String()
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator}})
+
+	_, err := srv.UpdateCircuit(ctx, &types.MsgUpdateCircuit{Signer: operator, SystemAvailable: false, ExpiresAtHeight: 101})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(101)
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	actions := k.GetActions(ctx)
+	require.Len(t, actions, 2)
+	require.Empty(t, actions[1].Signers)
+	require.False(t, actions[1].OldState.SystemAvailable)
+	require.True(t, actions[1].NewState.SystemAvailable)
+
+	// imported logs continue from the highest imported id
+	k.SetAction(ctx, types.CircuitAction{Id: 10})
+	require.Equal(t, uint64(11), k.GetNextActionID(ctx))
+}
+
+func TestFrozenContractQueriesRejectInvalidRequests(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	_, err := k.FrozenContracts(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	_, err = k.ContractFrozen(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	_, err = k.ContractFrozen(sdk.WrapSDKContext(ctx), &types.QueryContractFrozenRequest{Address: "not-an-address"})
+	require.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/evm/x/circuit/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) GetProposal(ctx sdk.Context, id uint64) (types.CircuitProposal, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ProposalKey(id))
+	if bz == nil {
+		return types.CircuitProposal{}, false
+	}
+
+	var proposal types.CircuitProposal
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return proposal, true
+}
+
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.CircuitProposal) {
+	ctx.KVStore(k.storeKey).Set(types.ProposalKey(proposal.Id), k.cdc.MustMarshal(&proposal))
+}
+
+func (k Keeper) DeleteProposal(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.ProposalKey(id))
+}
+
+// GetProposals returns the pending proposals ordered by id.
+func (k Keeper) GetProposals(ctx sdk.Context) []types.CircuitProposal {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixProposal)
+	defer iterator.Close()
+
+	proposals := []types.CircuitProposal{}
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.CircuitProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
+
+// GetNextProposalID returns the id assigned to the next stored proposal.
+func (k Keeper) GetNextProposalID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextProposalID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextProposalID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextProposalID, sdk.Uint64ToBigEndian(id))
+}
+
+// clearProposals discards every pending proposal.
+func (k Keeper) clearProposals(ctx sdk.Context) {
+	for _, proposal := range k.GetProposals(ctx) {
+		k.DeleteProposal(ctx, proposal.Id)
+	}
+}
+
+// pruneExpiredProposals deletes the proposals whose voting window has ended.
+func (k Keeper) pruneExpiredProposals(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight()) //#nosec G115 -- block height is never negative
+	for _, proposal := range k.GetProposals(ctx) {
+		if proposal.VotingEndHeight == 0 || height <= proposal.VotingEndHeight {
+			continue
+		}
+
+		k.DeleteProposal(ctx, proposal.Id)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalExpired,
+				sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.Id, 10)),
+			),
+		)
+	}
+}
+
+// findProposal returns the pending proposal for the given circuit state.
+func (k Keeper) findProposal(ctx sdk.Context, state types.CircuitState) (types.CircuitProposal, bool) {
+	for _, proposal := range k.GetProposals(ctx) {
+		if sameCircuitState(proposal.State, state) {
+			return proposal, true
+		}
+	}
+	return types.CircuitProposal{}, false
+}
+
+func sameCircuitState(a, b types.CircuitState) bool {
+	return a.SystemAvailable == b.SystemAvailable &&
+		a.ExpiresAtHeight == b.ExpiresAtHeight &&
+		sameStringSet(a.DisabledMsgTypeUrls, b.DisabledMsgTypeUrls) &&
+		sameStringSet(a.DisabledModules, b.DisabledModules)
+}
+
+// liftsRestrictions reports whether moving from current to desired re-enables
+// anything that is currently halted, including bringing an automatic restore
+// forward.
+func liftsRestrictions(current, desired types.CircuitState) bool {
+	if !current.SystemAvailable && desired.SystemAvailable {
+		return true
+	}
+	if !containsAll(desired.DisabledMsgTypeUrls, current.DisabledMsgTypeUrls) ||
+		!containsAll(desired.DisabledModules, current.DisabledModules) {
+		return true
+	}
+	if current.IsTripped() && desired.ExpiresAtHeight != 0 {
+		return current.ExpiresAtHeight == 0 || desired.ExpiresAtHeight < current.ExpiresAtHeight
+	}
+	return false
+}
+
+// containsAll reports whether every entry of subset is present in set.
+func containsAll(set, subset []string) bool {
+	entries := make(map[string]struct{}, len(set))
+	for _, entry := range set {
+		entries[entry] = struct{}{}
+	}
+	for _, entry := range subset {
+		if _, ok := entries[entry]; !ok {
+			return false
+		}
+	}
+	return true
+}
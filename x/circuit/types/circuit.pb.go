@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +31,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type CircuitState struct {
 	// system_available controls whether the system is available.
 	SystemAvailable bool `protobuf:"varint,1,opt,name=system_available,json=systemAvailable,proto3" json:"system_available,omitempty"`
+	// disabled_msg_type_urls are the message type URLs rejected while the
+	// system is otherwise available.
+	DisabledMsgTypeUrls []string `protobuf:"bytes,2,rep,name=disabled_msg_type_urls,json=disabledMsgTypeUrls,proto3" json:"disabled_msg_type_urls,omitempty"`
+	// disabled_modules are the module names whose messages are rejected while
+	// the system is otherwise available.
+	DisabledModules []string `protobuf:"bytes,3,rep,name=disabled_modules,json=disabledModules,proto3" json:"disabled_modules,omitempty"`
+	// expires_at_height is the block height at which the circuit is
+	// automatically restored. Zero means the trip does not expire.
+	ExpiresAtHeight uint64 `protobuf:"varint,4,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *CircuitState) Reset()         { *m = CircuitState{} }
@@ -69,10 +82,45 @@ func (m *CircuitState) GetSystemAvailable() bool {
 	return false
 }
 
+func (m *CircuitState) GetDisabledMsgTypeUrls() []string {
+	if m != nil {
+		return m.DisabledMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *CircuitState) GetDisabledModules() []string {
+	if m != nil {
+		return m.DisabledModules
+	}
+	return nil
+}
+
+func (m *CircuitState) GetExpiresAtHeight() uint64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
 // Params defines the circuit module parameters.
 type Params struct {
 	// whitelist are the addresses allowed to update circuit state.
 	Whitelist []string `protobuf:"bytes,1,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	// max_trip_duration_blocks is the maximum number of blocks a trip may last
+	// before it is automatically restored. Zero means trips may last forever.
+	MaxTripDurationBlocks uint64 `protobuf:"varint,2,opt,name=max_trip_duration_blocks,json=maxTripDurationBlocks,proto3" json:"max_trip_duration_blocks,omitempty"`
+	// trip_threshold is the number of distinct whitelisted operators that must
+	// approve a circuit update that only adds restrictions. Zero is treated as
+	// one.
+	TripThreshold uint32 `protobuf:"varint,3,opt,name=trip_threshold,json=tripThreshold,proto3" json:"trip_threshold,omitempty"`
+	// restore_threshold is the number of distinct whitelisted operators that
+	// must approve a circuit update that lifts any restriction. Zero is treated
+	// as one.
+	RestoreThreshold uint32 `protobuf:"varint,4,opt,name=restore_threshold,json=restoreThreshold,proto3" json:"restore_threshold,omitempty"`
+	// proposal_window_blocks is the number of blocks a pending proposal stays
+	// open for votes. Required when either threshold is above one.
+	ProposalWindowBlocks uint64 `protobuf:"varint,5,opt,name=proposal_window_blocks,json=proposalWindowBlocks,proto3" json:"proposal_window_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -115,9 +163,223 @@ func (m *Params) GetWhitelist() []string {
 	return nil
 }
 
+func (m *Params) GetMaxTripDurationBlocks() uint64 {
+	if m != nil {
+		return m.MaxTripDurationBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetTripThreshold() uint32 {
+	if m != nil {
+		return m.TripThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetRestoreThreshold() uint32 {
+	if m != nil {
+		return m.RestoreThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetProposalWindowBlocks() uint64 {
+	if m != nil {
+		return m.ProposalWindowBlocks
+	}
+	return 0
+}
+
+// CircuitProposal defines a pending circuit update awaiting operator votes.
+type CircuitProposal struct {
+	// id is the unique proposal identifier.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// state is the proposed circuit state.
+	State CircuitState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// voters are the whitelisted operators that approved the proposal.
+	Voters []string `protobuf:"bytes,3,rep,name=voters,proto3" json:"voters,omitempty"`
+	// voting_end_height is the last block height at which the proposal accepts
+	// votes. Zero means the proposal does not expire.
+	VotingEndHeight uint64 `protobuf:"varint,4,opt,name=voting_end_height,json=votingEndHeight,proto3" json:"voting_end_height,omitempty"`
+	// reason is the free-form justification given by the proposer.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *CircuitProposal) Reset()         { *m = CircuitProposal{} }
+func (m *CircuitProposal) String() string { return proto.CompactTextString(m) }
+func (*CircuitProposal) ProtoMessage()    {}
+func (*CircuitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_137e734d61abc670, []int{2}
+}
+func (m *CircuitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitProposal.Merge(m, src)
+}
+func (m *CircuitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitProposal proto.InternalMessageInfo
+
+func (m *CircuitProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CircuitProposal) GetState() CircuitState {
+	if m != nil {
+		return m.State
+	}
+	return CircuitState{}
+}
+
+func (m *CircuitProposal) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+func (m *CircuitProposal) GetVotingEndHeight() uint64 {
+	if m != nil {
+		return m.VotingEndHeight
+	}
+	return 0
+}
+
+func (m *CircuitProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// CircuitAction records an applied change of the circuit state.
+type CircuitAction struct {
+	// id is the sequence number of the action in the log.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// signers are the operators that approved the change. Empty for automatic
+	// restores.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// height is the block height at which the change was applied.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the change was applied.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// old_state is the circuit state before the change.
+	OldState CircuitState `protobuf:"bytes,5,opt,name=old_state,json=oldState,proto3" json:"old_state"`
+	// new_state is the circuit state after the change.
+	NewState CircuitState `protobuf:"bytes,6,opt,name=new_state,json=newState,proto3" json:"new_state"`
+	// reason is the free-form justification given for the change.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *CircuitAction) Reset()         { *m = CircuitAction{} }
+func (m *CircuitAction) String() string { return proto.CompactTextString(m) }
+func (*CircuitAction) ProtoMessage()    {}
+func (*CircuitAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_137e734d61abc670, []int{3}
+}
+func (m *CircuitAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitAction.Merge(m, src)
+}
+func (m *CircuitAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitAction proto.InternalMessageInfo
+
+func (m *CircuitAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CircuitAction) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *CircuitAction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CircuitAction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *CircuitAction) GetOldState() CircuitState {
+	if m != nil {
+		return m.OldState
+	}
+	return CircuitState{}
+}
+
+func (m *CircuitAction) GetNewState() CircuitState {
+	if m != nil {
+		return m.NewState
+	}
+	return CircuitState{}
+}
+
+func (m *CircuitAction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CircuitState)(nil), "cosmos.evm.circuit.v1.CircuitState")
 	proto.RegisterType((*Params)(nil), "cosmos.evm.circuit.v1.Params")
+	proto.RegisterType((*CircuitProposal)(nil), "cosmos.evm.circuit.v1.CircuitProposal")
+	proto.RegisterType((*CircuitAction)(nil), "cosmos.evm.circuit.v1.CircuitAction")
 }
 
 func init() {
@@ -125,21 +387,46 @@ func init() {
 }
 
 var fileDescriptor_137e734d61abc670 = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0xce, 0x2c, 0x4a, 0x2e, 0xcd, 0x2c, 0xd1, 0x2f,
-	0x33, 0x84, 0x31, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x21, 0x8a, 0xf4, 0x52, 0xcb,
-	0x72, 0xf5, 0x60, 0x32, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x15, 0xfa, 0x20,
-	0x16, 0x44, 0xb1, 0x92, 0x25, 0x17, 0x8f, 0x33, 0x44, 0x4d, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90,
-	0x26, 0x97, 0x40, 0x71, 0x65, 0x71, 0x49, 0x6a, 0x6e, 0x7c, 0x62, 0x59, 0x62, 0x66, 0x4e, 0x62,
-	0x52, 0x4e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x47, 0x10, 0x3f, 0x44, 0xdc, 0x11, 0x26, 0xac,
-	0xa4, 0xc6, 0xc5, 0x16, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0x24, 0xc3, 0xc5, 0x59, 0x9e, 0x91,
-	0x59, 0x92, 0x9a, 0x93, 0x59, 0x5c, 0x22, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x19, 0x84, 0x10, 0x70,
-	0xb2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd5, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x24, 0x9f, 0x55, 0xc0, 0xfd, 0x56, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xaa, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x2b, 0xd5,
-	0xe0, 0xfe, 0x00, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
+	0x10, 0x8d, 0x93, 0x10, 0xc8, 0xf2, 0x83, 0xc0, 0xfe, 0x00, 0x59, 0xa8, 0x0a, 0x51, 0x10, 0x52,
+	0xda, 0x4a, 0xb6, 0x80, 0x4a, 0xed, 0x0d, 0x91, 0xb6, 0xa8, 0x97, 0x4a, 0xc8, 0xa4, 0xaa, 0xd4,
+	0x8b, 0xb5, 0x89, 0xb7, 0xce, 0xaa, 0x6b, 0xaf, 0xb5, 0xbb, 0x71, 0xc2, 0xb7, 0xe0, 0xbb, 0xf4,
+	0xde, 0x5b, 0x25, 0x8e, 0x1c, 0x7b, 0x6a, 0x2b, 0x38, 0xf5, 0x5b, 0x54, 0xfb, 0x8f, 0x44, 0x6d,
+	0x2f, 0xdc, 0x76, 0xe6, 0xbd, 0x19, 0xcf, 0xbe, 0x37, 0x5e, 0xb0, 0x3f, 0x62, 0x22, 0x63, 0x22,
+	0xc4, 0x65, 0x16, 0x8e, 0x08, 0x1f, 0x4d, 0x88, 0x0c, 0xcb, 0x43, 0x77, 0x0c, 0x0a, 0xce, 0x24,
+	0x83, 0xdb, 0x86, 0x14, 0xe0, 0x32, 0x0b, 0x1c, 0x52, 0x1e, 0xee, 0x6e, 0xa5, 0x2c, 0x65, 0x9a,
+	0x11, 0xaa, 0x93, 0x21, 0xef, 0xee, 0xa5, 0x8c, 0xa5, 0x14, 0x87, 0x3a, 0x1a, 0x4e, 0x3e, 0x86,
+	0x92, 0x64, 0x58, 0x48, 0x94, 0x15, 0x86, 0xd0, 0xfd, 0xea, 0x81, 0xff, 0x5e, 0x9a, 0x2e, 0x17,
+	0x12, 0x49, 0x0c, 0x1f, 0x83, 0x0d, 0x71, 0x29, 0x24, 0xce, 0x62, 0x54, 0x22, 0x42, 0xd1, 0x90,
+	0x62, 0xdf, 0xeb, 0x78, 0xbd, 0x95, 0xa8, 0x65, 0xf2, 0xa7, 0x2e, 0x0d, 0x8f, 0xc1, 0x4e, 0x42,
+	0x84, 0x3a, 0x26, 0x71, 0x26, 0xd2, 0x58, 0x5e, 0x16, 0x38, 0x9e, 0x70, 0x2a, 0xfc, 0x6a, 0xa7,
+	0xd6, 0x6b, 0x46, 0xff, 0x3b, 0xf4, 0xad, 0x48, 0x07, 0x97, 0x05, 0x7e, 0xc7, 0xa9, 0x50, 0xfd,
+	0xe7, 0x45, 0x2c, 0x99, 0x50, 0x2c, 0xfc, 0x9a, 0xa6, 0xb7, 0xee, 0xe9, 0x26, 0x0d, 0x9f, 0x80,
+	0x4d, 0x3c, 0x2b, 0x08, 0xc7, 0x22, 0x46, 0x32, 0x1e, 0x63, 0x92, 0x8e, 0xa5, 0x5f, 0xef, 0x78,
+	0xbd, 0x7a, 0xd4, 0xb2, 0xc0, 0xa9, 0x7c, 0xa3, 0xd3, 0xdd, 0x5f, 0x1e, 0x68, 0x9c, 0x23, 0x8e,
+	0x32, 0x01, 0x1f, 0x81, 0xe6, 0x74, 0x4c, 0x24, 0xa6, 0x44, 0x48, 0xdf, 0xd3, 0xad, 0xe7, 0x09,
+	0xf8, 0x1c, 0xf8, 0x19, 0x9a, 0xc5, 0x92, 0x93, 0x22, 0x4e, 0x26, 0x1c, 0x49, 0xc2, 0xf2, 0x78,
+	0x48, 0xd9, 0xe8, 0x93, 0x1a, 0x5b, 0xf5, 0xde, 0xce, 0xd0, 0x6c, 0xc0, 0x49, 0xf1, 0xca, 0xa2,
+	0x7d, 0x0d, 0xc2, 0x03, 0xb0, 0xae, 0x8b, 0xe4, 0x98, 0x63, 0x31, 0x66, 0x34, 0xf1, 0x6b, 0x1d,
+	0xaf, 0xb7, 0x16, 0xad, 0xa9, 0xec, 0xc0, 0x25, 0xe1, 0x53, 0xb0, 0xc9, 0xb1, 0x90, 0x8c, 0xe3,
+	0x05, 0x66, 0x5d, 0x33, 0x37, 0x2c, 0x30, 0x27, 0x3f, 0x03, 0x3b, 0x05, 0x67, 0x05, 0x13, 0x88,
+	0xc6, 0x53, 0x92, 0x27, 0x6c, 0xea, 0x46, 0x59, 0xd2, 0xa3, 0x6c, 0x39, 0xf4, 0xbd, 0x06, 0xcd,
+	0x24, 0xdd, 0x2f, 0x1e, 0x68, 0x59, 0xcf, 0xce, 0x2d, 0x0e, 0xd7, 0x41, 0x95, 0x24, 0xda, 0xa8,
+	0x7a, 0x54, 0x25, 0x09, 0x3c, 0x01, 0x4b, 0x42, 0xf9, 0xa9, 0xef, 0xb4, 0x7a, 0xb4, 0x1f, 0xfc,
+	0x73, 0x6b, 0x82, 0x45, 0xeb, 0xfb, 0xf5, 0xeb, 0xef, 0x7b, 0x95, 0xc8, 0xd4, 0xc1, 0x1d, 0xd0,
+	0x28, 0x99, 0xc4, 0xdc, 0xb9, 0x63, 0x23, 0x65, 0x4a, 0xc9, 0x24, 0xc9, 0xd3, 0x18, 0xe7, 0xc9,
+	0x1f, 0xa6, 0x18, 0xe0, 0x75, 0x9e, 0x18, 0x53, 0x54, 0x0f, 0x8e, 0x91, 0x60, 0xb9, 0xbe, 0x4e,
+	0x33, 0xb2, 0x51, 0xf7, 0x73, 0x15, 0xac, 0xd9, 0x2f, 0x9f, 0x8e, 0x94, 0xc4, 0x7f, 0x8d, 0xef,
+	0x83, 0x65, 0x41, 0xd2, 0x1c, 0x73, 0xb7, 0x4b, 0x2e, 0x54, 0x3d, 0xed, 0x47, 0x95, 0xfc, 0xb5,
+	0xc8, 0x46, 0xf0, 0x05, 0xa8, 0xab, 0xdd, 0xd6, 0xa3, 0xac, 0x1e, 0xed, 0x06, 0x66, 0xf1, 0x03,
+	0xb7, 0xf8, 0xc1, 0xc0, 0x2d, 0x7e, 0x7f, 0x45, 0x5d, 0xf3, 0xea, 0xc7, 0x9e, 0x17, 0xe9, 0x0a,
+	0x78, 0x06, 0x9a, 0x8c, 0x26, 0xb1, 0x91, 0x6b, 0xe9, 0xa1, 0x72, 0xad, 0x30, 0x9a, 0x98, 0x3f,
+	0xe7, 0x0c, 0x34, 0x73, 0x3c, 0xb5, 0x7d, 0x1a, 0x0f, 0xee, 0x93, 0xe3, 0xe9, 0x85, 0x53, 0xde,
+	0xaa, 0xb6, 0xbc, 0xa8, 0x5a, 0xff, 0xe4, 0xfa, 0xb6, 0xed, 0xdd, 0xdc, 0xb6, 0xbd, 0x9f, 0xb7,
+	0x6d, 0xef, 0xea, 0xae, 0x5d, 0xb9, 0xb9, 0x6b, 0x57, 0xbe, 0xdd, 0xb5, 0x2b, 0x1f, 0x0e, 0x52,
+	0x22, 0xc7, 0x93, 0x61, 0x30, 0x62, 0x59, 0xb8, 0xf0, 0x84, 0xcc, 0xee, 0x1f, 0x11, 0xf5, 0x67,
+	0x8a, 0x61, 0x43, 0x8b, 0x71, 0xfc, 0x7b, 0x00, 0xcd, 0xa4, 0x8e, 0x22, 0x67, 0x04, 0x00, 0x00,
 }
 
 func (m *CircuitState) Marshal() (dAtA []byte, err error) {
@@ -162,6 +449,29 @@ func (m *CircuitState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DisabledModules) > 0 {
+		for iNdEx := len(m.DisabledModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledModules[iNdEx])
+			copy(dAtA[i:], m.DisabledModules[iNdEx])
+			i = encodeVarintCircuit(dAtA, i, uint64(len(m.DisabledModules[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for iNdEx := len(m.DisabledMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypeUrls[iNdEx])
+			i = encodeVarintCircuit(dAtA, i, uint64(len(m.DisabledMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.SystemAvailable {
 		i--
 		if m.SystemAvailable {
@@ -195,6 +505,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposalWindowBlocks != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.ProposalWindowBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RestoreThreshold != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.RestoreThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TripThreshold != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.TripThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTripDurationBlocks != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.MaxTripDurationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Whitelist) > 0 {
 		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Whitelist[iNdEx])
@@ -207,6 +537,142 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CircuitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCircuit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VotingEndHeight != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.VotingEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintCircuit(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCircuit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCircuit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.NewState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCircuit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.OldState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCircuit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCircuit(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintCircuit(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCircuit(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCircuit(v)
 	base := offset
@@ -227,6 +693,21 @@ func (m *CircuitState) Size() (n int) {
 	if m.SystemAvailable {
 		n += 2
 	}
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for _, s := range m.DisabledMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovCircuit(uint64(l))
+		}
+	}
+	if len(m.DisabledModules) > 0 {
+		for _, s := range m.DisabledModules {
+			l = len(s)
+			n += 1 + l + sovCircuit(uint64(l))
+		}
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovCircuit(uint64(m.ExpiresAtHeight))
+	}
 	return n
 }
 
@@ -242,6 +723,76 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovCircuit(uint64(l))
 		}
 	}
+	if m.MaxTripDurationBlocks != 0 {
+		n += 1 + sovCircuit(uint64(m.MaxTripDurationBlocks))
+	}
+	if m.TripThreshold != 0 {
+		n += 1 + sovCircuit(uint64(m.TripThreshold))
+	}
+	if m.RestoreThreshold != 0 {
+		n += 1 + sovCircuit(uint64(m.RestoreThreshold))
+	}
+	if m.ProposalWindowBlocks != 0 {
+		n += 1 + sovCircuit(uint64(m.ProposalWindowBlocks))
+	}
+	return n
+}
+
+func (m *CircuitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCircuit(uint64(m.Id))
+	}
+	l = m.State.Size()
+	n += 1 + l + sovCircuit(uint64(l))
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovCircuit(uint64(l))
+		}
+	}
+	if m.VotingEndHeight != 0 {
+		n += 1 + sovCircuit(uint64(m.VotingEndHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCircuit(uint64(l))
+	}
+	return n
+}
+
+func (m *CircuitAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCircuit(uint64(m.Id))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovCircuit(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovCircuit(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCircuit(uint64(l))
+	l = m.OldState.Size()
+	n += 1 + l + sovCircuit(uint64(l))
+	l = m.NewState.Size()
+	n += 1 + l + sovCircuit(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCircuit(uint64(l))
+	}
 	return n
 }
 
@@ -300,11 +851,94 @@ func (m *CircuitState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SystemAvailable = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCircuit(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypeUrls = append(m.DisabledMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledModules = append(m.DisabledModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCircuit
@@ -382,6 +1016,518 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTripDurationBlocks", wireType)
+			}
+			m.MaxTripDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTripDurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripThreshold", wireType)
+			}
+			m.TripThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TripThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreThreshold", wireType)
+			}
+			m.RestoreThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestoreThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalWindowBlocks", wireType)
+			}
+			m.ProposalWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndHeight", wireType)
+			}
+			m.VotingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCircuit(dAtA[iNdEx:])
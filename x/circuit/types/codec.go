@@ -16,8 +16,11 @@ var (
 )
 
 const (
-	msgUpdateCircuitName = "cosmos/evm/x/circuit/MsgUpdateCircuit"
-	msgUpdateParamsName  = "cosmos/evm/x/circuit/MsgUpdateParams"
+	msgUpdateCircuitName     = "cosmos/evm/x/circuit/MsgUpdateCircuit"
+	msgVoteProposalName      = "cosmos/evm/x/circuit/MsgVoteProposal"
+	msgFreezeContractsName   = "cosmos/evm/x/circuit/MsgFreezeContracts"
+	msgUnfreezeContractsName = "cosmos/evm/x/circuit/MsgUnfreezeContracts"
+	msgUpdateParamsName      = "cosmos/evm/x/circuit/MsgUpdateParams"
 )
 
 func init() {
@@ -29,6 +32,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateCircuit{},
+		&MsgVoteProposal{},
+		&MsgFreezeContracts{},
+		&MsgUnfreezeContracts{},
 		&MsgUpdateParams{},
 	)
 
@@ -37,5 +43,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateCircuit{}, msgUpdateCircuitName, nil)
+	cdc.RegisterConcrete(&MsgVoteProposal{}, msgVoteProposalName, nil)
+	cdc.RegisterConcrete(&MsgFreezeContracts{}, msgFreezeContractsName, nil)
+	cdc.RegisterConcrete(&MsgUnfreezeContracts{}, msgUnfreezeContractsName, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, msgUpdateParamsName, nil)
 }
@@ -0,0 +1,16 @@
+package types
+
+// circuit events
+const (
+	EventTypeFreezeContract   = "freeze_contract"
+	EventTypeUnfreezeContract = "unfreeze_contract"
+	EventTypeAutoRestore      = "circuit_auto_restore"
+	EventTypeProposalVote     = "circuit_proposal_vote"
+	EventTypeProposalExecuted = "circuit_proposal_executed"
+	EventTypeProposalExpired  = "circuit_proposal_expired"
+
+	AttributeKeyContract        = "contract"
+	AttributeKeySigner          = "signer"
+	AttributeKeyExpiresAtHeight = "expires_at_height"
+	AttributeKeyProposalID      = "proposal_id"
+)
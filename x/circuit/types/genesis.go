@@ -8,6 +8,10 @@ func DefaultGenesisState() *GenesisState {
 		State: CircuitState{
 			SystemAvailable: true,
 		},
+		FrozenContracts: []string{},
+		Proposals:       []CircuitProposal{},
+		NextProposalId:  1,
+		Actions:         []CircuitAction{},
 	}
 }
 
@@ -15,8 +19,74 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := gs.State.Validate(); err != nil {
+		return err
+	}
 	if !gs.State.SystemAvailable && len(gs.Params.Whitelist) == 0 {
 		return fmt.Errorf("whitelist cannot be empty while system_available is false")
 	}
+	if gs.State.IsTripped() && len(gs.Params.Whitelist) == 0 {
+		return fmt.Errorf("whitelist cannot be empty while messages or modules are disabled")
+	}
+	if err := ValidateFrozenContracts(gs.FrozenContracts); err != nil {
+		return err
+	}
+	if len(gs.FrozenContracts) > 0 && len(gs.Params.Whitelist) == 0 {
+		return fmt.Errorf("whitelist cannot be empty while contracts are frozen")
+	}
+	if err := validateProposals(gs.Proposals, gs.NextProposalId, gs.Params.Whitelist); err != nil {
+		return err
+	}
+	return validateActions(gs.Actions)
+}
+
+func validateActions(actions []CircuitAction) error {
+	var lastID uint64
+	for _, action := range actions {
+		if action.Id <= lastID {
+			return fmt.Errorf("action ids must be positive and strictly increasing, got %d after %d", action.Id, lastID)
+		}
+		lastID = action.Id
+
+		if len(action.Reason) > MaxReasonLength {
+			return fmt.Errorf("action %d reason exceeds %d characters", action.Id, MaxReasonLength)
+		}
+	}
+	return nil
+}
+
+func validateProposals(proposals []CircuitProposal, nextID uint64, whitelist []string) error {
+	operators := make(map[string]struct{}, len(whitelist))
+	for _, addr := range whitelist {
+		operators[addr] = struct{}{}
+	}
+
+	seen := make(map[uint64]struct{}, len(proposals))
+	for _, proposal := range proposals {
+		if proposal.Id == 0 || proposal.Id >= nextID {
+			return fmt.Errorf("proposal id %d must be positive and below next_proposal_id %d", proposal.Id, nextID)
+		}
+		if _, ok := seen[proposal.Id]; ok {
+			return fmt.Errorf("duplicate proposal id: %d", proposal.Id)
+		}
+		seen[proposal.Id] = struct{}{}
+
+		if err := proposal.State.Validate(); err != nil {
+			return fmt.Errorf("invalid proposal %d: %w", proposal.Id, err)
+		}
+		if len(proposal.Voters) == 0 {
+			return fmt.Errorf("proposal %d has no voters", proposal.Id)
+		}
+		voters := make(map[string]struct{}, len(proposal.Voters))
+		for _, voter := range proposal.Voters {
+			if _, ok := operators[voter]; !ok {
+				return fmt.Errorf("proposal %d voter %s is not whitelisted", proposal.Id, voter)
+			}
+			if _, ok := voters[voter]; ok {
+				return fmt.Errorf("proposal %d has duplicate voter %s", proposal.Id, voter)
+			}
+			voters[voter] = struct{}{}
+		}
+	}
 	return nil
 }
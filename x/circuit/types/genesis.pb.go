@@ -30,6 +30,14 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// state defines the circuit module state.
 	State CircuitState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// frozen_contracts are the hex EVM addresses whose calls are rejected.
+	FrozenContracts []string `protobuf:"bytes,3,rep,name=frozen_contracts,json=frozenContracts,proto3" json:"frozen_contracts,omitempty"`
+	// proposals are the pending circuit proposals.
+	Proposals []CircuitProposal `protobuf:"bytes,4,rep,name=proposals,proto3" json:"proposals"`
+	// next_proposal_id is the identifier assigned to the next proposal.
+	NextProposalId uint64 `protobuf:"varint,5,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
+	// actions is the circuit action log ordered by id.
+	Actions []CircuitAction `protobuf:"bytes,6,rep,name=actions,proto3" json:"actions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +87,34 @@ func (m *GenesisState) GetState() CircuitState {
 	return CircuitState{}
 }
 
+func (m *GenesisState) GetFrozenContracts() []string {
+	if m != nil {
+		return m.FrozenContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposals() []CircuitProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextProposalId() uint64 {
+	if m != nil {
+		return m.NextProposalId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetActions() []CircuitAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.circuit.v1.GenesisState")
 }
@@ -88,22 +124,30 @@ func init() {
 }
 
 var fileDescriptor_a7778abaf780b050 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0xce, 0x2c, 0x4a, 0x2e, 0xcd, 0x2c, 0xd1, 0x2f,
-	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x28, 0xd2, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x2a, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c,
-	0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x95, 0x52, 0x38, 0x8c, 0x83, 0x69, 0x82, 0x28,
-	0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0xd2, 0x34, 0x46, 0x2e,
-	0x1e, 0x77, 0x88, 0xb5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x0e, 0x5c, 0x6c, 0x05, 0x89, 0x45,
-	0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xb2, 0x7a, 0x58, 0x9d, 0xa1, 0x17,
-	0x00, 0x56, 0xe4, 0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0,
-	0xfa, 0x84, 0x5c, 0xb8, 0x58, 0x8b, 0x41, 0x46, 0x49, 0x30, 0x81, 0x0d, 0x50, 0xc6, 0x61, 0x80,
-	0x33, 0x84, 0x09, 0xb6, 0x15, 0xd9, 0x18, 0x88, 0x66, 0x27, 0xfb, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x47, 0xf2, 0x78, 0x05, 0xdc, 0xeb, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x0f,
-	0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x66, 0x9b, 0x1d, 0x6c, 0x01, 0x00, 0x00,
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x4f, 0x4a, 0xfb, 0x40,
+	0x1c, 0xc5, 0x93, 0xfe, 0xfb, 0xd1, 0xe9, 0x0f, 0xad, 0x41, 0x21, 0x14, 0x8c, 0xc1, 0xaa, 0x44,
+	0x17, 0x19, 0x5a, 0x0f, 0xa0, 0xb6, 0x05, 0xd1, 0x55, 0x89, 0x3b, 0x37, 0x65, 0x3a, 0x1d, 0xe3,
+	0x80, 0xc9, 0x84, 0xcc, 0x34, 0x54, 0x4f, 0xe1, 0x31, 0x5c, 0x7a, 0x8c, 0x2e, 0xbb, 0x74, 0x25,
+	0xd2, 0x2e, 0x3c, 0x85, 0x20, 0x99, 0x49, 0xb4, 0x0b, 0xdb, 0xcd, 0xf0, 0xe5, 0xf1, 0xde, 0xfb,
+	0x0c, 0x3c, 0xd0, 0xc4, 0x8c, 0x07, 0x8c, 0x43, 0x92, 0x04, 0x10, 0xd3, 0x18, 0x8f, 0xa9, 0x80,
+	0x49, 0x0b, 0xfa, 0x24, 0x24, 0x9c, 0x72, 0x37, 0x8a, 0x99, 0x60, 0xc6, 0x8e, 0x32, 0xb9, 0x24,
+	0x09, 0xdc, 0xcc, 0xe4, 0x26, 0xad, 0xc6, 0x16, 0x0a, 0x68, 0xc8, 0xa0, 0x7c, 0x95, 0xb3, 0xb1,
+	0xa2, 0x2e, 0x0f, 0x29, 0xd3, 0xb6, 0xcf, 0x7c, 0x26, 0x4f, 0x98, 0x5e, 0x4a, 0xdd, 0xff, 0x2a,
+	0x80, 0xff, 0x97, 0x0a, 0x7b, 0x23, 0x90, 0x20, 0xc6, 0x39, 0xa8, 0x44, 0x28, 0x46, 0x01, 0x37,
+	0x75, 0x5b, 0x77, 0x6a, 0xed, 0x5d, 0xf7, 0xcf, 0x6f, 0xb8, 0x7d, 0x69, 0xea, 0x54, 0xa7, 0xef,
+	0x7b, 0xda, 0xcb, 0xe7, 0xeb, 0x89, 0xee, 0x65, 0x39, 0xa3, 0x07, 0xca, 0x3c, 0xad, 0x32, 0x0b,
+	0xb2, 0xa0, 0xb9, 0xa2, 0xa0, 0xab, 0x4e, 0x49, 0x5d, 0xae, 0x51, 0x61, 0xe3, 0x18, 0xd4, 0xef,
+	0x62, 0xf6, 0x44, 0xc2, 0x01, 0x66, 0xa1, 0x88, 0x11, 0x16, 0xdc, 0x2c, 0xda, 0x45, 0xa7, 0xea,
+	0x6d, 0x2a, 0xbd, 0x9b, 0xcb, 0xc6, 0x35, 0xa8, 0x46, 0x31, 0x8b, 0x18, 0x47, 0x0f, 0xdc, 0x2c,
+	0xd9, 0x45, 0xa7, 0xd6, 0x3e, 0x5a, 0x0f, 0xed, 0x67, 0xf6, 0x4e, 0x29, 0xe5, 0x7a, 0xbf, 0x71,
+	0xc3, 0x01, 0xf5, 0x90, 0x4c, 0xc4, 0x20, 0x57, 0x06, 0x74, 0x64, 0x96, 0x6d, 0xdd, 0x29, 0x79,
+	0x1b, 0xa9, 0x9e, 0x07, 0xaf, 0x46, 0x46, 0x0f, 0xfc, 0x43, 0x58, 0x50, 0x16, 0x72, 0xb3, 0x22,
+	0x99, 0x07, 0xeb, 0x99, 0x17, 0xd2, 0x9c, 0x11, 0xf3, 0x68, 0xe7, 0x6c, 0x3a, 0xb7, 0xf4, 0xd9,
+	0xdc, 0xd2, 0x3f, 0xe6, 0x96, 0xfe, 0xbc, 0xb0, 0xb4, 0xd9, 0xc2, 0xd2, 0xde, 0x16, 0x96, 0x76,
+	0x7b, 0xe8, 0x53, 0x71, 0x3f, 0x1e, 0xba, 0x98, 0x05, 0x70, 0x69, 0xdf, 0xc9, 0xcf, 0xc2, 0xe2,
+	0x31, 0x22, 0x7c, 0x58, 0x91, 0x3b, 0x9e, 0x7e, 0x0f, 0x00, 0x60, 0xf6, 0xf7, 0x1c, 0x53, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +170,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FrozenContracts) > 0 {
+		for iNdEx := len(m.FrozenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenContracts[iNdEx])
+			copy(dAtA[i:], m.FrozenContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -170,6 +256,27 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FrozenContracts) > 0 {
+		for _, s := range m.FrozenContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextProposalId))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -274,6 +381,125 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenContracts = append(m.FrozenContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, CircuitProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalId", wireType)
+			}
+			m.NextProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, CircuitAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 
 func TestGenesisStateValidate(t *testing.T) {
 	operator := sdk.AccAddress([]byte("operator_1")).String()
+	operator2 := sdk.AccAddress([]byte("operator_2")).String()
 
 	testCases := []struct {
 		name        string
@@ -56,6 +57,158 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "scoped trip with empty whitelist is invalid",
+			genesis: GenesisState{
+				Params: Params{
+					Whitelist: []string{},
+				},
+				State: CircuitState{
+					SystemAvailable: true,
+					DisabledModules: []string{"erc20"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "scoped trip with non-empty whitelist is valid",
+			genesis: GenesisState{
+				Params: Params{
+					Whitelist: []string{operator},
+				},
+				State: CircuitState{
+					SystemAvailable:     true,
+					DisabledMsgTypeUrls: []string{"/cosmos.evm.vm.v1.MsgEthereumTx"},
+					DisabledModules:     []string{"erc20"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "frozen contracts with empty whitelist is invalid",
+			genesis: GenesisState{
+				Params:          Params{Whitelist: []string{}},
+				State:           CircuitState{SystemAvailable: true},
+				FrozenContracts: []string{"0x00000000000000000000000000000000000000aa"},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid frozen contract address is invalid",
+			genesis: GenesisState{
+				Params:          Params{Whitelist: []string{operator}},
+				State:           CircuitState{SystemAvailable: true},
+				FrozenContracts: []string{"0xnot-an-address"},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate frozen contract address is invalid",
+			genesis: GenesisState{
+				Params: Params{Whitelist: []string{operator}},
+				State:  CircuitState{SystemAvailable: true},
+				FrozenContracts: []string{
+					"0x00000000000000000000000000000000000000aa",
+					"0x00000000000000000000000000000000000000AA",
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "frozen contracts with non-empty whitelist is valid",
+			genesis: GenesisState{
+				Params:          Params{Whitelist: []string{operator}},
+				State:           CircuitState{SystemAvailable: true},
+				FrozenContracts: []string{"0x00000000000000000000000000000000000000aa"},
+			},
+			expectError: false,
+		},
+		{
+			name: "threshold above whitelist size is invalid",
+			genesis: GenesisState{
+				Params: Params{Whitelist: []string{operator}, RestoreThreshold: 2, ProposalWindowBlocks: 10},
+				State:  CircuitState{SystemAvailable: true},
+			},
+			expectError: true,
+		},
+		{
+			name: "threshold above one without proposal window is invalid",
+			genesis: GenesisState{
+				Params: Params{Whitelist: []string{operator, operator2}, TripThreshold: 2},
+				State:  CircuitState{SystemAvailable: true},
+			},
+			expectError: true,
+		},
+		{
+			name: "pending proposal is valid",
+			genesis: GenesisState{
+				Params: Params{Whitelist: []string{operator, operator2}, TripThreshold: 2, ProposalWindowBlocks: 10},
+				State:  CircuitState{SystemAvailable: true},
+				Proposals: []CircuitProposal{
+					{Id: 1, State: CircuitState{SystemAvailable: false}, Voters: []string{operator}, VotingEndHeight: 10},
+				},
+				NextProposalId: 2,
+			},
+			expectError: false,
+		},
+		{
+			name: "proposal id at next proposal id is invalid",
+			genesis: GenesisState{
+				Params: Params{Whitelist: []string{operator, operator2}, TripThreshold: 2, ProposalWindowBlocks: 10},
+				State:  CircuitState{SystemAvailable: true},
+				Proposals: []CircuitProposal{
+					{Id: 1, State: CircuitState{SystemAvailable: false}, Voters: []string{operator}},
+				},
+				NextProposalId: 1,
+			},
+			expectError: true,
+		},
+		{
+			name: "proposal voter outside whitelist is invalid",
+			genesis: GenesisState{
+				Params: Params{Whitelist: []string{operator, operator2}, TripThreshold: 2, ProposalWindowBlocks: 10},
+				State:  CircuitState{SystemAvailable: true},
+				Proposals: []CircuitProposal{
+					{Id: 1, State: CircuitState{SystemAvailable: false}, Voters: []string{sdk.AccAddress([]byte("operator_3")).String()}},
+				},
+				NextProposalId: 2,
+			},
+			expectError: true,
+		},
+		{
+			name: "action log is valid",
+			genesis: GenesisState{
+				Params:  Params{Whitelist: []string{operator}},
+				State:   CircuitState{SystemAvailable: true},
+				Actions: []CircuitAction{{Id: 1, Signers: []string{operator}}, {Id: 3}},
+			},
+			expectError: false,
+		},
+		{
+			name: "unordered action log is invalid",
+			genesis: GenesisState{
+				Params:  Params{Whitelist: []string{operator}},
+				State:   CircuitState{SystemAvailable: true},
+				Actions: []CircuitAction{{Id: 2}, {Id: 1}},
+			},
+			expectError: true,
+		},
+		{
+			name: "trip expiry while online is invalid",
+			genesis: GenesisState{
+				Params: Params{Whitelist: []string{operator}},
+				State:  CircuitState{SystemAvailable: true, ExpiresAtHeight: 100},
+			},
+			expectError: true,
+		},
+		{
+			name: "trip expiry while offline is valid",
+			genesis: GenesisState{
+				Params: Params{Whitelist: []string{operator}, MaxTripDurationBlocks: 50},
+				State:  CircuitState{SystemAvailable: false, ExpiresAtHeight: 100},
+			},
+			expectError: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName = "circuit"
 
@@ -11,9 +17,46 @@ const (
 const (
 	prefixParams = iota + 1
 	prefixState
+	prefixDisabledMsgTypeURL
+	prefixDisabledModule
+	prefixFrozenContract
+	prefixTripExpiry
+	prefixProposal
+	prefixNextProposalID
+	prefixAction
+	prefixNextActionID
 )
 
 var (
 	KeyParams = []byte{prefixParams}
 	KeyState  = []byte{prefixState}
+
+	KeyPrefixDisabledMsgTypeURL = []byte{prefixDisabledMsgTypeURL}
+	KeyPrefixDisabledModule     = []byte{prefixDisabledModule}
+	KeyPrefixFrozenContract     = []byte{prefixFrozenContract}
+	KeyTripExpiry               = []byte{prefixTripExpiry}
+	KeyPrefixProposal           = []byte{prefixProposal}
+	KeyNextProposalID           = []byte{prefixNextProposalID}
+	KeyPrefixAction             = []byte{prefixAction}
+	KeyNextActionID             = []byte{prefixNextActionID}
 )
+
+func DisabledMsgTypeURLKey(typeURL string) []byte {
+	return append(append([]byte{}, KeyPrefixDisabledMsgTypeURL...), []byte(typeURL)...)
+}
+
+func DisabledModuleKey(moduleName string) []byte {
+	return append(append([]byte{}, KeyPrefixDisabledModule...), []byte(moduleName)...)
+}
+
+func FrozenContractKey(address common.Address) []byte {
+	return append(append([]byte{}, KeyPrefixFrozenContract...), address.Bytes()...)
+}
+
+func ProposalKey(id uint64) []byte {
+	return append(append([]byte{}, KeyPrefixProposal...), sdk.Uint64ToBigEndian(id)...)
+}
+
+func ActionKey(id uint64) []byte {
+	return append(append([]byte{}, KeyPrefixAction...), sdk.Uint64ToBigEndian(id)...)
+}
@@ -7,20 +7,120 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxReasonLength is the maximum length of the reason recorded with a circuit
+// update.
+const MaxReasonLength = 256
+
 var _ sdk.Msg = &MsgUpdateCircuit{}
+var _ sdk.Msg = &MsgVoteProposal{}
+var _ sdk.Msg = &MsgFreezeContracts{}
+var _ sdk.Msg = &MsgUnfreezeContracts{}
 var _ sdk.Msg = &MsgUpdateParams{}
 
+// IsOperatorMsg reports whether msg is a whitelisted-operator circuit control
+// message. Operator messages are never blocked by the circuit itself so a
+// tripped circuit can always be restored.
+func IsOperatorMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *MsgUpdateCircuit, *MsgVoteProposal, *MsgFreezeContracts, *MsgUnfreezeContracts:
+		return true
+	default:
+		return false
+	}
+}
+
 func (m *MsgUpdateCircuit) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
 		return errorsmod.Wrap(err, "invalid signer address")
 	}
+	if m.ClearDisabledMsgTypeUrls && len(m.DisabledMsgTypeUrls) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot both set and clear disabled msg type urls")
+	}
+	if m.ClearDisabledModules && len(m.DisabledModules) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot both set and clear disabled modules")
+	}
+	if err := ValidateDisabledMsgTypeURLs(m.DisabledMsgTypeUrls); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateDisabledModules(m.DisabledModules); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if len(m.Reason) > MaxReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason exceeds %d characters", MaxReasonLength)
+	}
 	return nil
 }
 
+// DesiredState returns the circuit state requested by the message. Disabled
+// sets left empty keep their current entries unless the message clears them.
+func (m *MsgUpdateCircuit) DesiredState(current CircuitState) CircuitState {
+	desired := CircuitState{
+		SystemAvailable:     m.SystemAvailable,
+		DisabledMsgTypeUrls: m.DisabledMsgTypeUrls,
+		DisabledModules:     m.DisabledModules,
+		ExpiresAtHeight:     m.ExpiresAtHeight,
+	}
+	if len(desired.DisabledMsgTypeUrls) == 0 && !m.ClearDisabledMsgTypeUrls {
+		desired.DisabledMsgTypeUrls = current.DisabledMsgTypeUrls
+	}
+	if len(desired.DisabledModules) == 0 && !m.ClearDisabledModules {
+		desired.DisabledModules = current.DisabledModules
+	}
+	return desired
+}
+
 func (m MsgUpdateCircuit) GetSignBytes() []byte {
 	return AminoCdc.MustMarshalJSON(&m)
 }
 
+func (m *MsgVoteProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	if m.ProposalId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proposal id cannot be zero")
+	}
+	return nil
+}
+
+func (m MsgVoteProposal) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgFreezeContracts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	if len(m.Addresses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "addresses cannot be empty")
+	}
+	if err := ValidateFrozenContracts(m.Addresses); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+func (m MsgFreezeContracts) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgUnfreezeContracts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	if len(m.Addresses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "addresses cannot be empty")
+	}
+	if err := ValidateFrozenContracts(m.Addresses); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+func (m MsgUnfreezeContracts) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
 		return errorsmod.Wrap(err, "invalid authority address")
@@ -62,3 +62,92 @@ func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgUpdateCircuitValidateBasic() {
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateCircuit
+		expPass bool
+	}{
+		{
+			name:    "fail - invalid signer address",
+			msg:     &MsgUpdateCircuit{Signer: "invalid", SystemAvailable: true},
+			expPass: false,
+		},
+		{
+			name: "fail - set and clear disabled msg type urls",
+			msg: &MsgUpdateCircuit{
+				Signer:                   signer,
+				DisabledMsgTypeUrls:      []string{"/cosmos.evm.vm.v1.MsgEthereumTx"},
+				ClearDisabledMsgTypeUrls: true,
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - set and clear disabled modules",
+			msg: &MsgUpdateCircuit{
+				Signer:               signer,
+				DisabledModules:      []string{"erc20"},
+				ClearDisabledModules: true,
+			},
+			expPass: false,
+		},
+		{
+			name:    "fail - invalid disabled module",
+			msg:     &MsgUpdateCircuit{Signer: signer, DisabledModules: []string{ModuleName}},
+			expPass: false,
+		},
+		{
+			name: "pass - expiry with the disabled sets kept",
+			msg: &MsgUpdateCircuit{
+				Signer:          signer,
+				SystemAvailable: true,
+				ExpiresAtHeight: 10,
+			},
+			expPass: true,
+		},
+		{
+			name: "pass - contract creation scope",
+			msg: &MsgUpdateCircuit{
+				Signer:              signer,
+				SystemAvailable:     true,
+				DisabledMsgTypeUrls: []string{ContractCreationTypeURL},
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+				return
+			}
+			suite.Error(err)
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateCircuitDesiredState() {
+	current := CircuitState{
+		SystemAvailable:     true,
+		DisabledMsgTypeUrls: []string{"/cosmos.evm.vm.v1.MsgEthereumTx"},
+		DisabledModules:     []string{"erc20"},
+	}
+
+	desired := (&MsgUpdateCircuit{SystemAvailable: false}).DesiredState(current)
+	suite.False(desired.SystemAvailable)
+	suite.Equal(current.DisabledMsgTypeUrls, desired.DisabledMsgTypeUrls)
+	suite.Equal(current.DisabledModules, desired.DisabledModules)
+
+	desired = (&MsgUpdateCircuit{SystemAvailable: true, DisabledModules: []string{"staking"}}).DesiredState(current)
+	suite.Equal(current.DisabledMsgTypeUrls, desired.DisabledMsgTypeUrls)
+	suite.Equal([]string{"staking"}, desired.DisabledModules)
+
+	desired = (&MsgUpdateCircuit{SystemAvailable: true, ClearDisabledMsgTypeUrls: true, ClearDisabledModules: true}).DesiredState(current)
+	suite.Empty(desired.DisabledMsgTypeUrls)
+	suite.Empty(desired.DisabledModules)
+}
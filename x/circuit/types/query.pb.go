@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -70,6 +71,9 @@ var xxx_messageInfo_QuerySystemAvailableRequest proto.InternalMessageInfo
 type QuerySystemAvailableResponse struct {
 	// system_available is the current system availability flag.
 	SystemAvailable bool `protobuf:"varint,1,opt,name=system_available,json=systemAvailable,proto3" json:"system_available,omitempty"`
+	// expires_at_height is the block height at which the current trip is
+	// automatically restored. Zero means no pending auto-restore.
+	ExpiresAtHeight uint64 `protobuf:"varint,2,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *QuerySystemAvailableResponse) Reset()         { *m = QuerySystemAvailableResponse{} }
@@ -181,336 +185,2761 @@ func (m *QueryWhitelistResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryWhitelistResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QuerySystemAvailableRequest)(nil), "cosmos.evm.circuit.v1.QuerySystemAvailableRequest")
-	proto.RegisterType((*QuerySystemAvailableResponse)(nil), "cosmos.evm.circuit.v1.QuerySystemAvailableResponse")
-	proto.RegisterType((*QueryWhitelistRequest)(nil), "cosmos.evm.circuit.v1.QueryWhitelistRequest")
-	proto.RegisterType((*QueryWhitelistResponse)(nil), "cosmos.evm.circuit.v1.QueryWhitelistResponse")
+// QueryDisabledMsgTypeURLsRequest defines the request type for Query/DisabledMsgTypeURLs.
+type QueryDisabledMsgTypeURLsRequest struct {
 }
 
-func init() { proto.RegisterFile("cosmos/evm/circuit/v1/query.proto", fileDescriptor_3d94f72004e6d31d) }
-
-var fileDescriptor_3d94f72004e6d31d = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4a, 0xf3, 0x40,
-	0x14, 0xcd, 0xf4, 0xe3, 0x13, 0x3b, 0x9b, 0xca, 0x60, 0xb5, 0xc4, 0x3a, 0xd4, 0x80, 0xd8, 0x82,
-	0xce, 0xd0, 0x16, 0xdc, 0xab, 0x2b, 0x97, 0xd6, 0x85, 0xe0, 0x46, 0xd2, 0x30, 0xa4, 0x03, 0x49,
-	0x6e, 0xda, 0x99, 0x44, 0xbb, 0xf5, 0x09, 0x44, 0x1f, 0xc3, 0x17, 0x29, 0xae, 0x0a, 0x6e, 0x5c,
-	0x6a, 0xeb, 0x83, 0x48, 0x93, 0xb6, 0x6a, 0xa8, 0x45, 0x77, 0xe1, 0x9e, 0x9f, 0x7b, 0xee, 0xc9,
-	0xe0, 0x1d, 0x07, 0x94, 0x0f, 0x8a, 0x8b, 0xd8, 0xe7, 0x8e, 0xec, 0x39, 0x91, 0xd4, 0x3c, 0xae,
-	0xf3, 0x6e, 0x24, 0x7a, 0x7d, 0x16, 0xf6, 0x40, 0x03, 0x29, 0xa6, 0x14, 0x26, 0x62, 0x9f, 0x4d,
-	0x29, 0x2c, 0xae, 0x9b, 0xeb, 0x2e, 0xb8, 0x90, 0x30, 0xf8, 0xe4, 0x2b, 0x25, 0x9b, 0x65, 0x17,
-	0xc0, 0xf5, 0x04, 0xb7, 0x43, 0xc9, 0xed, 0x20, 0x00, 0x6d, 0x6b, 0x09, 0x81, 0x4a, 0x51, 0x6b,
-	0x1b, 0x6f, 0x9d, 0x4d, 0x9c, 0xcf, 0xfb, 0x4a, 0x0b, 0xff, 0x28, 0xb6, 0xa5, 0x67, 0xb7, 0x3d,
-	0xd1, 0x12, 0xdd, 0x48, 0x28, 0x6d, 0x9d, 0xe2, 0xf2, 0x62, 0x58, 0x85, 0x10, 0x28, 0x41, 0x6a,
-	0x78, 0x4d, 0x25, 0xd0, 0x95, 0x3d, 0xc3, 0x4a, 0xa8, 0x82, 0xaa, 0xab, 0xad, 0x82, 0xfa, 0x2e,
-	0xb1, 0x36, 0x71, 0x31, 0xb1, 0xba, 0xe8, 0x48, 0x2d, 0x3c, 0xa9, 0xf4, 0x6c, 0xc7, 0x21, 0xde,
-	0xc8, 0x02, 0x53, 0xf7, 0x32, 0xce, 0x5f, 0xcf, 0x86, 0x25, 0x54, 0xf9, 0x57, 0xcd, 0xb7, 0x3e,
-	0x07, 0x8d, 0xa7, 0x1c, 0xfe, 0x9f, 0x08, 0xc9, 0x23, 0xc2, 0x85, 0x4c, 0x42, 0xd2, 0x60, 0x0b,
-	0x4b, 0x62, 0x4b, 0xae, 0x35, 0x9b, 0x7f, 0xd2, 0xa4, 0x21, 0x2d, 0x7e, 0xfb, 0xfc, 0xfe, 0x90,
-	0xab, 0x91, 0x3d, 0xbe, 0xf8, 0xc7, 0x65, 0xfb, 0x21, 0xf7, 0x08, 0xe7, 0xe7, 0xb7, 0x92, 0xfd,
-	0x65, 0x3b, 0xb3, 0x5d, 0x99, 0x07, 0xbf, 0x64, 0x4f, 0xb3, 0x55, 0x93, 0x6c, 0x16, 0xa9, 0xfc,
-	0x90, 0x6d, 0x5e, 0xe6, 0xf1, 0xc9, 0xe0, 0x8d, 0x1a, 0x83, 0x11, 0x45, 0xc3, 0x11, 0x45, 0xaf,
-	0x23, 0x8a, 0xee, 0xc6, 0xd4, 0x18, 0x8e, 0xa9, 0xf1, 0x32, 0xa6, 0xc6, 0xe5, 0xae, 0x2b, 0x75,
-	0x27, 0x6a, 0x33, 0x07, 0xfc, 0xaf, 0x4e, 0x37, 0x73, 0x2f, 0xdd, 0x0f, 0x85, 0x6a, 0xaf, 0x24,
-	0x6f, 0xaa, 0xf9, 0x11, 0x00, 0x00, 0xff, 0xff, 0x96, 0x31, 0xc4, 0x00, 0xc3, 0x02, 0x00, 0x00,
+func (m *QueryDisabledMsgTypeURLsRequest) Reset()         { *m = QueryDisabledMsgTypeURLsRequest{} }
+func (m *QueryDisabledMsgTypeURLsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledMsgTypeURLsRequest) ProtoMessage()    {}
+func (*QueryDisabledMsgTypeURLsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{4}
+}
+func (m *QueryDisabledMsgTypeURLsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledMsgTypeURLsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledMsgTypeURLsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisabledMsgTypeURLsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledMsgTypeURLsRequest.Merge(m, src)
+}
+func (m *QueryDisabledMsgTypeURLsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledMsgTypeURLsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledMsgTypeURLsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDisabledMsgTypeURLsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// SystemAvailable returns the current system availability flag.
-	SystemAvailable(ctx context.Context, in *QuerySystemAvailableRequest, opts ...grpc.CallOption) (*QuerySystemAvailableResponse, error)
-	// Whitelist returns the list of authorized addresses.
-	Whitelist(ctx context.Context, in *QueryWhitelistRequest, opts ...grpc.CallOption) (*QueryWhitelistResponse, error)
+// QueryDisabledMsgTypeURLsResponse defines the response type for Query/DisabledMsgTypeURLs.
+type QueryDisabledMsgTypeURLsResponse struct {
+	// disabled_msg_type_urls is the list of disabled message type URLs.
+	DisabledMsgTypeUrls []string `protobuf:"bytes,1,rep,name=disabled_msg_type_urls,json=disabledMsgTypeUrls,proto3" json:"disabled_msg_type_urls,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDisabledMsgTypeURLsResponse) Reset()         { *m = QueryDisabledMsgTypeURLsResponse{} }
+func (m *QueryDisabledMsgTypeURLsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledMsgTypeURLsResponse) ProtoMessage()    {}
+func (*QueryDisabledMsgTypeURLsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDisabledMsgTypeURLsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) SystemAvailable(ctx context.Context, in *QuerySystemAvailableRequest, opts ...grpc.CallOption) (*QuerySystemAvailableResponse, error) {
-	out := new(QuerySystemAvailableResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/SystemAvailable", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDisabledMsgTypeURLsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledMsgTypeURLsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Whitelist(ctx context.Context, in *QueryWhitelistRequest, opts ...grpc.CallOption) (*QueryWhitelistResponse, error) {
-	out := new(QueryWhitelistResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/Whitelist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDisabledMsgTypeURLsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledMsgTypeURLsResponse.Merge(m, src)
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// SystemAvailable returns the current system availability flag.
-	SystemAvailable(context.Context, *QuerySystemAvailableRequest) (*QuerySystemAvailableResponse, error)
-	// Whitelist returns the list of authorized addresses.
-	Whitelist(context.Context, *QueryWhitelistRequest) (*QueryWhitelistResponse, error)
+func (m *QueryDisabledMsgTypeURLsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryDisabledMsgTypeURLsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledMsgTypeURLsResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) SystemAvailable(ctx context.Context, req *QuerySystemAvailableRequest) (*QuerySystemAvailableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemAvailable not implemented")
-}
-func (*UnimplementedQueryServer) Whitelist(ctx context.Context, req *QueryWhitelistRequest) (*QueryWhitelistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Whitelist not implemented")
-}
+var xxx_messageInfo_QueryDisabledMsgTypeURLsResponse proto.InternalMessageInfo
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryDisabledModulesRequest defines the request type for Query/DisabledModules.
+type QueryDisabledModulesRequest struct {
 }
 
-func _Query_SystemAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySystemAvailableRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SystemAvailable(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.circuit.v1.Query/SystemAvailable",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SystemAvailable(ctx, req.(*QuerySystemAvailableRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryDisabledModulesRequest) Reset()         { *m = QueryDisabledModulesRequest{} }
+func (m *QueryDisabledModulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledModulesRequest) ProtoMessage()    {}
+func (*QueryDisabledModulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{6}
 }
-
-func _Query_Whitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWhitelistRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Whitelist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.circuit.v1.Query/Whitelist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Whitelist(ctx, req.(*QueryWhitelistRequest))
+func (m *QueryDisabledModulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledModulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledModulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDisabledModulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledModulesRequest.Merge(m, src)
+}
+func (m *QueryDisabledModulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledModulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledModulesRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.evm.circuit.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SystemAvailable",
-			Handler:    _Query_SystemAvailable_Handler,
-		},
-		{
-			MethodName: "Whitelist",
-			Handler:    _Query_Whitelist_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/evm/circuit/v1/query.proto",
+var xxx_messageInfo_QueryDisabledModulesRequest proto.InternalMessageInfo
+
+// QueryDisabledModulesResponse defines the response type for Query/DisabledModules.
+type QueryDisabledModulesResponse struct {
+	// disabled_modules is the list of disabled module names.
+	DisabledModules []string `protobuf:"bytes,1,rep,name=disabled_modules,json=disabledModules,proto3" json:"disabled_modules,omitempty"`
 }
 
-func (m *QuerySystemAvailableRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDisabledModulesResponse) Reset()         { *m = QueryDisabledModulesResponse{} }
+func (m *QueryDisabledModulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledModulesResponse) ProtoMessage()    {}
+func (*QueryDisabledModulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{7}
+}
+func (m *QueryDisabledModulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledModulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledModulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QuerySystemAvailableRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDisabledModulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledModulesResponse.Merge(m, src)
+}
+func (m *QueryDisabledModulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledModulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledModulesResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySystemAvailableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryDisabledModulesResponse proto.InternalMessageInfo
+
+// QueryFrozenContractsRequest defines the request type for Query/FrozenContracts.
+type QueryFrozenContractsRequest struct {
 }
 
-func (m *QuerySystemAvailableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenContractsRequest) Reset()         { *m = QueryFrozenContractsRequest{} }
+func (m *QueryFrozenContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenContractsRequest) ProtoMessage()    {}
+func (*QueryFrozenContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{8}
+}
+func (m *QueryFrozenContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFrozenContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenContractsRequest.Merge(m, src)
+}
+func (m *QueryFrozenContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenContractsRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySystemAvailableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFrozenContractsRequest proto.InternalMessageInfo
+
+// QueryFrozenContractsResponse defines the response type for Query/FrozenContracts.
+type QueryFrozenContractsResponse struct {
+	// frozen_contracts is the list of frozen hex EVM addresses.
+	FrozenContracts []string `protobuf:"bytes,1,rep,name=frozen_contracts,json=frozenContracts,proto3" json:"frozen_contracts,omitempty"`
 }
 
-func (m *QuerySystemAvailableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SystemAvailable {
-		i--
-		if m.SystemAvailable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryFrozenContractsResponse) Reset()         { *m = QueryFrozenContractsResponse{} }
+func (m *QueryFrozenContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenContractsResponse) ProtoMessage()    {}
+func (*QueryFrozenContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{9}
+}
+func (m *QueryFrozenContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryWhitelistRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFrozenContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenContractsResponse.Merge(m, src)
 }
-
-func (m *QueryWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFrozenContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenContractsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryFrozenContractsResponse proto.InternalMessageInfo
+
+// QueryContractFrozenRequest defines the request type for Query/ContractFrozen.
+type QueryContractFrozenRequest struct {
+	// address is the hex EVM address to check.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryWhitelistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractFrozenRequest) Reset()         { *m = QueryContractFrozenRequest{} }
+func (m *QueryContractFrozenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractFrozenRequest) ProtoMessage()    {}
+func (*QueryContractFrozenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{10}
+}
+func (m *QueryContractFrozenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractFrozenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractFrozenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryContractFrozenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractFrozenRequest.Merge(m, src)
+}
+func (m *QueryContractFrozenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractFrozenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractFrozenRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryContractFrozenRequest proto.InternalMessageInfo
+
+// QueryContractFrozenResponse defines the response type for Query/ContractFrozen.
+type QueryContractFrozenResponse struct {
+	// frozen is true when calls to the address are rejected.
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
-func (m *QueryWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Whitelist) > 0 {
-		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Whitelist[iNdEx])
-			copy(dAtA[i:], m.Whitelist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Whitelist[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContractFrozenResponse) Reset()         { *m = QueryContractFrozenResponse{} }
+func (m *QueryContractFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractFrozenResponse) ProtoMessage()    {}
+func (*QueryContractFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{11}
+}
+func (m *QueryContractFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractFrozenResponse.Merge(m, src)
+}
+func (m *QueryContractFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractFrozenResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryContractFrozenResponse proto.InternalMessageInfo
+
+// QueryProposalsRequest defines the request type for Query/Proposals.
+type QueryProposalsRequest struct {
+}
+
+func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
+func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsRequest) ProtoMessage()    {}
+func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{12}
+}
+func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QuerySystemAvailableRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsRequest.Merge(m, src)
+}
+func (m *QueryProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsRequest proto.InternalMessageInfo
+
+// QueryProposalsResponse defines the response type for Query/Proposals.
+type QueryProposalsResponse struct {
+	// proposals is the list of pending circuit proposals.
+	Proposals []CircuitProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+}
+
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{13}
+}
+func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+}
+func (m *QueryProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySystemAvailableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
+
+// QueryProposalRequest defines the request type for Query/Proposal.
+type QueryProposalRequest struct {
+	// proposal_id is the pending proposal to return.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
+func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRequest) ProtoMessage()    {}
+func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{14}
+}
+func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.SystemAvailable {
-		n += 2
+}
+func (m *QueryProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRequest.Merge(m, src)
+}
+func (m *QueryProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRequest proto.InternalMessageInfo
+
+// QueryProposalResponse defines the response type for Query/Proposal.
+type QueryProposalResponse struct {
+	// proposal is the pending circuit proposal.
+	Proposal CircuitProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
+func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResponse) ProtoMessage()    {}
+func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{15}
+}
+func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResponse.Merge(m, src)
+}
+func (m *QueryProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
+
+// QueryActionsRequest defines the request type for Query/Actions.
+type QueryActionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActionsRequest) Reset()         { *m = QueryActionsRequest{} }
+func (m *QueryActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActionsRequest) ProtoMessage()    {}
+func (*QueryActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{16}
+}
+func (m *QueryActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionsRequest.Merge(m, src)
+}
+func (m *QueryActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActionsRequest proto.InternalMessageInfo
+
+// QueryActionsResponse defines the response type for Query/Actions.
+type QueryActionsResponse struct {
+	// actions is the page of circuit actions ordered by id.
+	Actions []CircuitAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActionsResponse) Reset()         { *m = QueryActionsResponse{} }
+func (m *QueryActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActionsResponse) ProtoMessage()    {}
+func (*QueryActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d94f72004e6d31d, []int{17}
+}
+func (m *QueryActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionsResponse.Merge(m, src)
+}
+func (m *QueryActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActionsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QuerySystemAvailableRequest)(nil), "cosmos.evm.circuit.v1.QuerySystemAvailableRequest")
+	proto.RegisterType((*QuerySystemAvailableResponse)(nil), "cosmos.evm.circuit.v1.QuerySystemAvailableResponse")
+	proto.RegisterType((*QueryWhitelistRequest)(nil), "cosmos.evm.circuit.v1.QueryWhitelistRequest")
+	proto.RegisterType((*QueryWhitelistResponse)(nil), "cosmos.evm.circuit.v1.QueryWhitelistResponse")
+	proto.RegisterType((*QueryDisabledMsgTypeURLsRequest)(nil), "cosmos.evm.circuit.v1.QueryDisabledMsgTypeURLsRequest")
+	proto.RegisterType((*QueryDisabledMsgTypeURLsResponse)(nil), "cosmos.evm.circuit.v1.QueryDisabledMsgTypeURLsResponse")
+	proto.RegisterType((*QueryDisabledModulesRequest)(nil), "cosmos.evm.circuit.v1.QueryDisabledModulesRequest")
+	proto.RegisterType((*QueryDisabledModulesResponse)(nil), "cosmos.evm.circuit.v1.QueryDisabledModulesResponse")
+	proto.RegisterType((*QueryFrozenContractsRequest)(nil), "cosmos.evm.circuit.v1.QueryFrozenContractsRequest")
+	proto.RegisterType((*QueryFrozenContractsResponse)(nil), "cosmos.evm.circuit.v1.QueryFrozenContractsResponse")
+	proto.RegisterType((*QueryContractFrozenRequest)(nil), "cosmos.evm.circuit.v1.QueryContractFrozenRequest")
+	proto.RegisterType((*QueryContractFrozenResponse)(nil), "cosmos.evm.circuit.v1.QueryContractFrozenResponse")
+	proto.RegisterType((*QueryProposalsRequest)(nil), "cosmos.evm.circuit.v1.QueryProposalsRequest")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "cosmos.evm.circuit.v1.QueryProposalsResponse")
+	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.evm.circuit.v1.QueryProposalRequest")
+	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.evm.circuit.v1.QueryProposalResponse")
+	proto.RegisterType((*QueryActionsRequest)(nil), "cosmos.evm.circuit.v1.QueryActionsRequest")
+	proto.RegisterType((*QueryActionsResponse)(nil), "cosmos.evm.circuit.v1.QueryActionsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/evm/circuit/v1/query.proto", fileDescriptor_3d94f72004e6d31d) }
+
+var fileDescriptor_3d94f72004e6d31d = []byte{
+	// 905 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xc1, 0x6f, 0xdc, 0x44,
+	0x14, 0xc6, 0xd7, 0x21, 0x34, 0xd9, 0x17, 0x89, 0xc0, 0x24, 0x0d, 0x91, 0x1b, 0x9c, 0xad, 0x81,
+	0x36, 0x4d, 0x1b, 0x9b, 0xdd, 0x55, 0xb7, 0xe2, 0x98, 0xa6, 0x2a, 0x2d, 0x02, 0xa9, 0x18, 0x50,
+	0x25, 0x24, 0x64, 0xcd, 0xae, 0xa7, 0x5e, 0x4b, 0xde, 0x1d, 0xd7, 0x33, 0xbb, 0x74, 0xa9, 0x7a,
+	0xe1, 0xc8, 0x09, 0xc1, 0x99, 0x23, 0x27, 0x6e, 0xdc, 0xb9, 0xe7, 0x58, 0x89, 0x0b, 0x17, 0x10,
+	0x24, 0xfc, 0x21, 0x68, 0xc7, 0x33, 0xde, 0xb5, 0xeb, 0x75, 0xbc, 0xb7, 0xec, 0xbc, 0xf7, 0xbd,
+	0xef, 0x37, 0xd6, 0xcc, 0x37, 0x81, 0xab, 0x3d, 0xca, 0x06, 0x94, 0xd9, 0x64, 0x3c, 0xb0, 0x7b,
+	0x41, 0xdc, 0x1b, 0x05, 0xdc, 0x1e, 0x37, 0xed, 0xa7, 0x23, 0x12, 0x4f, 0xac, 0x28, 0xa6, 0x9c,
+	0xa2, 0xcb, 0x49, 0x8b, 0x45, 0xc6, 0x03, 0x4b, 0xb6, 0x58, 0xe3, 0xa6, 0xbe, 0xed, 0x53, 0x9f,
+	0x8a, 0x0e, 0x7b, 0xfa, 0x57, 0xd2, 0xac, 0xef, 0xf9, 0x94, 0xfa, 0x21, 0xb1, 0x71, 0x14, 0xd8,
+	0x78, 0x38, 0xa4, 0x1c, 0xf3, 0x80, 0x0e, 0x99, 0xac, 0x1e, 0x4a, 0xb7, 0x2e, 0x66, 0x24, 0xf1,
+	0xb0, 0xc7, 0xcd, 0x2e, 0xe1, 0xb8, 0x69, 0x47, 0xd8, 0x0f, 0x86, 0xa2, 0x59, 0xf6, 0xbe, 0x5b,
+	0x4c, 0xa6, 0x08, 0x44, 0x93, 0xf9, 0x0e, 0x5c, 0xf9, 0x6c, 0x3a, 0xe6, 0xf3, 0x09, 0xe3, 0x64,
+	0x70, 0x3c, 0xc6, 0x41, 0x88, 0xbb, 0x21, 0x71, 0xc8, 0xd3, 0x11, 0x61, 0xdc, 0x1c, 0xc1, 0x5e,
+	0x71, 0x99, 0x45, 0x74, 0xc8, 0x08, 0xba, 0x01, 0x6f, 0x32, 0x51, 0x72, 0xb1, 0xaa, 0xed, 0x6a,
+	0x0d, 0xed, 0x60, 0xdd, 0xd9, 0x64, 0x59, 0x09, 0x3a, 0x84, 0xb7, 0xc8, 0xb3, 0x28, 0x88, 0x09,
+	0x73, 0x31, 0x77, 0xfb, 0x24, 0xf0, 0xfb, 0x7c, 0x77, 0xa5, 0xa1, 0x1d, 0xac, 0x3a, 0x9b, 0xb2,
+	0x70, 0xcc, 0x1f, 0x88, 0x65, 0xf3, 0x6d, 0xb8, 0x2c, 0x6c, 0x1f, 0xf7, 0x03, 0x4e, 0xc2, 0x80,
+	0x71, 0xc5, 0xd3, 0x81, 0x9d, 0x7c, 0x41, 0x92, 0xec, 0x41, 0xfd, 0x1b, 0xb5, 0xb8, 0xab, 0x35,
+	0x5e, 0x3b, 0xa8, 0x3b, 0xb3, 0x05, 0xf3, 0x2a, 0xec, 0x0b, 0xdd, 0xbd, 0x80, 0x4d, 0x61, 0xbc,
+	0x4f, 0x99, 0xff, 0xc5, 0x24, 0x22, 0x5f, 0x3a, 0x9f, 0x30, 0x35, 0xfa, 0x31, 0x34, 0x16, 0xb7,
+	0x48, 0x93, 0x36, 0xec, 0x78, 0xb2, 0xec, 0x0e, 0x98, 0xef, 0xf2, 0x49, 0x44, 0xdc, 0x51, 0x1c,
+	0x32, 0xe9, 0xb8, 0xe5, 0xe5, 0xc4, 0x71, 0xc8, 0xd2, 0x4f, 0x9c, 0x0e, 0xa6, 0xde, 0x28, 0x24,
+	0xa9, 0xef, 0x43, 0xd8, 0x2b, 0x2e, 0xcf, 0x3e, 0xf1, 0xcc, 0x33, 0xa9, 0x49, 0xb7, 0x4d, 0x2f,
+	0x2b, 0x49, 0x9d, 0xee, 0xc7, 0xf4, 0x5b, 0x32, 0x3c, 0xa1, 0x43, 0x1e, 0xe3, 0x1e, 0x7f, 0xc5,
+	0xe9, 0x95, 0xf2, 0xcc, 0xe9, 0x89, 0x28, 0xb9, 0x3d, 0x55, 0x53, 0x4e, 0x4f, 0xb2, 0x12, 0xb3,
+	0x03, 0xba, 0x18, 0xa5, 0x56, 0x92, 0x91, 0xd2, 0x08, 0xed, 0xc2, 0x1a, 0xf6, 0xbc, 0x98, 0x30,
+	0x26, 0x0e, 0x43, 0xdd, 0x51, 0x3f, 0xcd, 0xdb, 0x70, 0xa5, 0x50, 0x27, 0x09, 0x76, 0xe0, 0x52,
+	0xe2, 0x24, 0x0f, 0x91, 0xfc, 0x95, 0x9e, 0x87, 0x47, 0x31, 0x8d, 0x28, 0xc3, 0x61, 0xba, 0x25,
+	0x0f, 0x76, 0xf2, 0x05, 0x39, 0xea, 0x63, 0xa8, 0x47, 0x6a, 0x51, 0xec, 0x62, 0xa3, 0x75, 0xcd,
+	0x2a, 0xbc, 0x88, 0xd6, 0x49, 0xf2, 0xa7, 0x9a, 0x71, 0x77, 0xf5, 0xf4, 0xef, 0xfd, 0x9a, 0x33,
+	0x93, 0x9b, 0x77, 0x60, 0x3b, 0xe3, 0xa2, 0xf6, 0xb9, 0x0f, 0x1b, 0xaa, 0xc9, 0x0d, 0x3c, 0xc1,
+	0xbc, 0xea, 0x80, 0x5a, 0x7a, 0xe8, 0x99, 0x38, 0xc7, 0x9d, 0xd2, 0x3d, 0x80, 0x75, 0xd5, 0x26,
+	0x64, 0xcb, 0xc2, 0xa5, 0x6a, 0xf3, 0x6b, 0xd8, 0x12, 0x16, 0xc7, 0x3d, 0x91, 0x13, 0x0a, 0xed,
+	0x3e, 0xc0, 0x2c, 0x10, 0xf2, 0x16, 0xd3, 0xf4, 0xb0, 0x92, 0x84, 0x92, 0xe9, 0x61, 0x3d, 0xc2,
+	0xbe, 0xba, 0xf4, 0xce, 0x9c, 0xd2, 0xfc, 0x45, 0x83, 0xed, 0xec, 0x7c, 0xb9, 0x83, 0x7b, 0xb0,
+	0x86, 0x93, 0x25, 0xf9, 0x75, 0xdf, 0x2b, 0xdf, 0x40, 0xa2, 0x97, 0xf8, 0x4a, 0x8a, 0x3e, 0xca,
+	0x60, 0xae, 0x08, 0xcc, 0xeb, 0x17, 0x62, 0x26, 0x08, 0xf3, 0x9c, 0xad, 0xbf, 0x36, 0xe0, 0x75,
+	0xc1, 0x89, 0x7e, 0xd5, 0x60, 0x33, 0x17, 0x57, 0xa8, 0xb5, 0x80, 0xad, 0x24, 0xfa, 0xf4, 0xf6,
+	0x52, 0x9a, 0x04, 0xc9, 0xb4, 0xbf, 0xfb, 0xe3, 0xbf, 0x9f, 0x56, 0x6e, 0xa0, 0xeb, 0x76, 0x71,
+	0xf8, 0xe6, 0xc3, 0x12, 0xfd, 0xa8, 0x41, 0x3d, 0x0d, 0x33, 0x74, 0xab, 0xcc, 0x33, 0x1f, 0x86,
+	0xfa, 0x51, 0xc5, 0x6e, 0xc9, 0x76, 0x20, 0xd8, 0x4c, 0xd4, 0x58, 0xc0, 0x96, 0xa6, 0x25, 0xfa,
+	0x5d, 0x83, 0xad, 0x82, 0x18, 0x44, 0x9d, 0x32, 0xc3, 0xc5, 0xd1, 0xaa, 0xdf, 0x59, 0x5a, 0x27,
+	0x91, 0x6f, 0x0b, 0x64, 0x1b, 0x1d, 0x2d, 0x40, 0x2e, 0x0e, 0x63, 0x71, 0x04, 0x72, 0x71, 0x5a,
+	0x7e, 0x04, 0x8a, 0xa3, 0x59, 0x6f, 0x2f, 0xa5, 0xa9, 0x78, 0x04, 0xf2, 0x61, 0x2e, 0x68, 0x73,
+	0x91, 0x5c, 0x4e, 0x5b, 0x1c, 0xef, 0x7a, 0x7b, 0x29, 0x4d, 0x45, 0xda, 0xfc, 0x83, 0x80, 0x7e,
+	0xd3, 0xe0, 0x8d, 0x6c, 0x7a, 0xa3, 0x66, 0x99, 0x71, 0xe1, 0x0b, 0xa1, 0xb7, 0x96, 0x91, 0x48,
+	0xd4, 0x0f, 0x05, 0x6a, 0x1b, 0x35, 0x2b, 0xa2, 0xda, 0xcf, 0xe5, 0xab, 0xf3, 0x42, 0xdc, 0xb2,
+	0xf4, 0x89, 0x28, 0xbf, 0x65, 0xf9, 0x27, 0x46, 0x3f, 0xaa, 0xd8, 0x5d, 0xf1, 0x96, 0xa5, 0xaf,
+	0x0a, 0xfa, 0x59, 0x83, 0x75, 0xa5, 0x47, 0x37, 0xab, 0xb8, 0x28, 0xa4, 0x5b, 0xd5, 0x9a, 0x25,
+	0x51, 0x47, 0x10, 0x7d, 0x80, 0xac, 0x8b, 0x88, 0xec, 0xe7, 0x73, 0xaf, 0xd9, 0x0b, 0xf4, 0xbd,
+	0x06, 0x6b, 0x32, 0xf5, 0xd1, 0x61, 0x99, 0x63, 0xf6, 0xe9, 0xd1, 0x6f, 0x56, 0xea, 0x95, 0x70,
+	0xd7, 0x04, 0x5c, 0x03, 0x19, 0x0b, 0xe0, 0xe4, 0x43, 0x71, 0xf7, 0xe4, 0xf4, 0x5f, 0xa3, 0x76,
+	0x7a, 0x66, 0x68, 0x2f, 0xcf, 0x0c, 0xed, 0x9f, 0x33, 0x43, 0xfb, 0xe1, 0xdc, 0xa8, 0xbd, 0x3c,
+	0x37, 0x6a, 0x7f, 0x9e, 0x1b, 0xb5, 0xaf, 0xde, 0xf7, 0x03, 0xde, 0x1f, 0x75, 0xad, 0x1e, 0x1d,
+	0xcc, 0xcf, 0x79, 0x96, 0x4e, 0x9a, 0x66, 0x03, 0xeb, 0x5e, 0x12, 0xff, 0xf3, 0xb6, 0xff, 0x1f,
+	0x00, 0xc2, 0x2f, 0x1f, 0xa2, 0xb4, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SystemAvailable returns the current system availability flag.
+	SystemAvailable(ctx context.Context, in *QuerySystemAvailableRequest, opts ...grpc.CallOption) (*QuerySystemAvailableResponse, error)
+	// Whitelist returns the list of authorized addresses.
+	Whitelist(ctx context.Context, in *QueryWhitelistRequest, opts ...grpc.CallOption) (*QueryWhitelistResponse, error)
+	// DisabledMsgTypeURLs returns the message type URLs disabled by the circuit.
+	DisabledMsgTypeURLs(ctx context.Context, in *QueryDisabledMsgTypeURLsRequest, opts ...grpc.CallOption) (*QueryDisabledMsgTypeURLsResponse, error)
+	// DisabledModules returns the module names disabled by the circuit.
+	DisabledModules(ctx context.Context, in *QueryDisabledModulesRequest, opts ...grpc.CallOption) (*QueryDisabledModulesResponse, error)
+	// FrozenContracts returns the EVM addresses frozen by the circuit.
+	FrozenContracts(ctx context.Context, in *QueryFrozenContractsRequest, opts ...grpc.CallOption) (*QueryFrozenContractsResponse, error)
+	// ContractFrozen returns whether calls to an EVM address are frozen.
+	ContractFrozen(ctx context.Context, in *QueryContractFrozenRequest, opts ...grpc.CallOption) (*QueryContractFrozenResponse, error)
+	// Proposals returns the pending circuit proposals.
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// Proposal returns a pending circuit proposal by id.
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// Actions returns the circuit action log.
+	Actions(ctx context.Context, in *QueryActionsRequest, opts ...grpc.CallOption) (*QueryActionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SystemAvailable(ctx context.Context, in *QuerySystemAvailableRequest, opts ...grpc.CallOption) (*QuerySystemAvailableResponse, error) {
+	out := new(QuerySystemAvailableResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/SystemAvailable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Whitelist(ctx context.Context, in *QueryWhitelistRequest, opts ...grpc.CallOption) (*QueryWhitelistResponse, error) {
+	out := new(QueryWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/Whitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisabledMsgTypeURLs(ctx context.Context, in *QueryDisabledMsgTypeURLsRequest, opts ...grpc.CallOption) (*QueryDisabledMsgTypeURLsResponse, error) {
+	out := new(QueryDisabledMsgTypeURLsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/DisabledMsgTypeURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisabledModules(ctx context.Context, in *QueryDisabledModulesRequest, opts ...grpc.CallOption) (*QueryDisabledModulesResponse, error) {
+	out := new(QueryDisabledModulesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/DisabledModules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenContracts(ctx context.Context, in *QueryFrozenContractsRequest, opts ...grpc.CallOption) (*QueryFrozenContractsResponse, error) {
+	out := new(QueryFrozenContractsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/FrozenContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractFrozen(ctx context.Context, in *QueryContractFrozenRequest, opts ...grpc.CallOption) (*QueryContractFrozenResponse, error) {
+	out := new(QueryContractFrozenResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/ContractFrozen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/Proposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/Proposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Actions(ctx context.Context, in *QueryActionsRequest, opts ...grpc.CallOption) (*QueryActionsResponse, error) {
+	out := new(QueryActionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Query/Actions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SystemAvailable returns the current system availability flag.
+	SystemAvailable(context.Context, *QuerySystemAvailableRequest) (*QuerySystemAvailableResponse, error)
+	// Whitelist returns the list of authorized addresses.
+	Whitelist(context.Context, *QueryWhitelistRequest) (*QueryWhitelistResponse, error)
+	// DisabledMsgTypeURLs returns the message type URLs disabled by the circuit.
+	DisabledMsgTypeURLs(context.Context, *QueryDisabledMsgTypeURLsRequest) (*QueryDisabledMsgTypeURLsResponse, error)
+	// DisabledModules returns the module names disabled by the circuit.
+	DisabledModules(context.Context, *QueryDisabledModulesRequest) (*QueryDisabledModulesResponse, error)
+	// FrozenContracts returns the EVM addresses frozen by the circuit.
+	FrozenContracts(context.Context, *QueryFrozenContractsRequest) (*QueryFrozenContractsResponse, error)
+	// ContractFrozen returns whether calls to an EVM address are frozen.
+	ContractFrozen(context.Context, *QueryContractFrozenRequest) (*QueryContractFrozenResponse, error)
+	// Proposals returns the pending circuit proposals.
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// Proposal returns a pending circuit proposal by id.
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// Actions returns the circuit action log.
+	Actions(context.Context, *QueryActionsRequest) (*QueryActionsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SystemAvailable(ctx context.Context, req *QuerySystemAvailableRequest) (*QuerySystemAvailableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemAvailable not implemented")
+}
+func (*UnimplementedQueryServer) Whitelist(ctx context.Context, req *QueryWhitelistRequest) (*QueryWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Whitelist not implemented")
+}
+func (*UnimplementedQueryServer) DisabledMsgTypeURLs(ctx context.Context, req *QueryDisabledMsgTypeURLsRequest) (*QueryDisabledMsgTypeURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledMsgTypeURLs not implemented")
+}
+func (*UnimplementedQueryServer) DisabledModules(ctx context.Context, req *QueryDisabledModulesRequest) (*QueryDisabledModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledModules not implemented")
+}
+func (*UnimplementedQueryServer) FrozenContracts(ctx context.Context, req *QueryFrozenContractsRequest) (*QueryFrozenContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenContracts not implemented")
+}
+func (*UnimplementedQueryServer) ContractFrozen(ctx context.Context, req *QueryContractFrozenRequest) (*QueryContractFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractFrozen not implemented")
+}
+func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (*UnimplementedQueryServer) Actions(ctx context.Context, req *QueryActionsRequest) (*QueryActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Actions not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SystemAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySystemAvailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SystemAvailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/SystemAvailable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SystemAvailable(ctx, req.(*QuerySystemAvailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Whitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Whitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/Whitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Whitelist(ctx, req.(*QueryWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisabledMsgTypeURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledMsgTypeURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledMsgTypeURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/DisabledMsgTypeURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledMsgTypeURLs(ctx, req.(*QueryDisabledMsgTypeURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisabledModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/DisabledModules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledModules(ctx, req.(*QueryDisabledModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/FrozenContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenContracts(ctx, req.(*QueryFrozenContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/ContractFrozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractFrozen(ctx, req.(*QueryContractFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/Proposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/Proposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Actions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Actions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Query/Actions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Actions(ctx, req.(*QueryActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.circuit.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SystemAvailable",
+			Handler:    _Query_SystemAvailable_Handler,
+		},
+		{
+			MethodName: "Whitelist",
+			Handler:    _Query_Whitelist_Handler,
+		},
+		{
+			MethodName: "DisabledMsgTypeURLs",
+			Handler:    _Query_DisabledMsgTypeURLs_Handler,
+		},
+		{
+			MethodName: "DisabledModules",
+			Handler:    _Query_DisabledModules_Handler,
+		},
+		{
+			MethodName: "FrozenContracts",
+			Handler:    _Query_FrozenContracts_Handler,
+		},
+		{
+			MethodName: "ContractFrozen",
+			Handler:    _Query_ContractFrozen_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "Actions",
+			Handler:    _Query_Actions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/circuit/v1/query.proto",
+}
+
+func (m *QuerySystemAvailableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySystemAvailableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySystemAvailableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySystemAvailableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySystemAvailableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySystemAvailableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SystemAvailable {
+		i--
+		if m.SystemAvailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Whitelist) > 0 {
+		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Whitelist[iNdEx])
+			copy(dAtA[i:], m.Whitelist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Whitelist[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledMsgTypeURLsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledMsgTypeURLsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledMsgTypeURLsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledMsgTypeURLsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledMsgTypeURLsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledMsgTypeURLsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for iNdEx := len(m.DisabledMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledModulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledModulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledModulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledModulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledModulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledModulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledModules) > 0 {
+		for iNdEx := len(m.DisabledModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledModules[iNdEx])
+			copy(dAtA[i:], m.DisabledModules[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledModules[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FrozenContracts) > 0 {
+		for iNdEx := len(m.FrozenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenContracts[iNdEx])
+			copy(dAtA[i:], m.FrozenContracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FrozenContracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractFrozenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySystemAvailableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySystemAvailableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SystemAvailable {
+		n += 2
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *QueryWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDisabledMsgTypeURLsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDisabledMsgTypeURLsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for _, s := range m.DisabledMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDisabledModulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDisabledModulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisabledModules) > 0 {
+		for _, s := range m.DisabledModules {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFrozenContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FrozenContracts) > 0 {
+		for _, s := range m.FrozenContracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySystemAvailableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySystemAvailableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySystemAvailableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySystemAvailableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySystemAvailableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySystemAvailableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemAvailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SystemAvailable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledMsgTypeURLsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledMsgTypeURLsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledMsgTypeURLsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledMsgTypeURLsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledMsgTypeURLsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledMsgTypeURLsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypeUrls = append(m.DisabledMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledModulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledModulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledModulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledModulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledModulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledModulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledModules = append(m.DisabledModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenContracts = append(m.FrozenContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractFrozenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractFrozenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractFrozenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractFrozenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryWhitelistRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryWhitelistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Whitelist) > 0 {
-		for _, s := range m.Whitelist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, CircuitProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySystemAvailableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -533,12 +2962,31 @@ func (m *QuerySystemAvailableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySystemAvailableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySystemAvailableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -560,7 +3008,7 @@ func (m *QuerySystemAvailableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySystemAvailableResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -583,17 +3031,17 @@ func (m *QuerySystemAvailableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySystemAvailableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySystemAvailableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemAvailable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -603,12 +3051,25 @@ func (m *QuerySystemAvailableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SystemAvailable = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -630,7 +3091,7 @@ func (m *QuerySystemAvailableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -653,12 +3114,48 @@ func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -680,7 +3177,7 @@ func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -703,17 +3200,17 @@ func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -723,23 +3220,61 @@ func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			m.Actions = append(m.Actions, CircuitAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContractCreationTypeURL is the disabled message type URL entry that rejects
+// EVM contract creation, through top-level transactions as well as the CREATE
+// and CREATE2 opcodes, while calls to existing contracts keep working. It
+// belongs to the vm module, so disabling vm also disables contract creation.
+const ContractCreationTypeURL = "/cosmos.evm.vm.v1.ContractCreation"
+
+var (
+	protoVersionSegment = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+	moduleNamePattern   = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// IsTripped reports whether any part of the chain is currently halted.
+func (s CircuitState) IsTripped() bool {
+	return !s.SystemAvailable || len(s.DisabledMsgTypeUrls) > 0 || len(s.DisabledModules) > 0
+}
+
+func (s CircuitState) Validate() error {
+	if s.ExpiresAtHeight != 0 && !s.IsTripped() {
+		return fmt.Errorf("expires_at_height can only be set while the circuit is tripped")
+	}
+	if err := ValidateDisabledMsgTypeURLs(s.DisabledMsgTypeUrls); err != nil {
+		return err
+	}
+	return ValidateDisabledModules(s.DisabledModules)
+}
+
+func ValidateDisabledMsgTypeURLs(typeURLs []string) error {
+	seen := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 || strings.ContainsAny(typeURL, " \t\n") {
+			return fmt.Errorf("invalid disabled msg type url: %q", typeURL)
+		}
+		if _, ok := operatorMsgTypeURLs()[typeURL]; ok {
+			return fmt.Errorf("cannot disable %s", typeURL)
+		}
+		if _, ok := seen[typeURL]; ok {
+			return fmt.Errorf("duplicate disabled msg type url: %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+	return nil
+}
+
+func operatorMsgTypeURLs() map[string]struct{} {
+	return map[string]struct{}{
+		sdk.MsgTypeURL(&MsgUpdateCircuit{}):     {},
+		sdk.MsgTypeURL(&MsgVoteProposal{}):      {},
+		sdk.MsgTypeURL(&MsgFreezeContracts{}):   {},
+		sdk.MsgTypeURL(&MsgUnfreezeContracts{}): {},
+	}
+}
+
+func ValidateDisabledModules(modules []string) error {
+	seen := make(map[string]struct{}, len(modules))
+	for _, moduleName := range modules {
+		if !moduleNamePattern.MatchString(moduleName) {
+			return fmt.Errorf("invalid disabled module name: %q", moduleName)
+		}
+		if moduleName == ModuleName {
+			return fmt.Errorf("cannot disable the %s module", ModuleName)
+		}
+		if _, ok := seen[moduleName]; ok {
+			return fmt.Errorf("duplicate disabled module: %s", moduleName)
+		}
+		seen[moduleName] = struct{}{}
+	}
+	return nil
+}
+
+// ModuleNameFromTypeURL derives the module name of a message from its type URL.
+// The module is the proto package segment preceding the version segment, e.g.
+// "/cosmos.evm.erc20.v1.MsgConvertERC20" maps to "erc20" and
+// "/cosmos.staking.v1beta1.MsgDelegate" maps to "staking". Type URLs without a
+// version segment map to the last package segment.
+func ModuleNameFromTypeURL(typeURL string) string {
+	segments := strings.Split(strings.TrimPrefix(typeURL, "/"), ".")
+	if len(segments) < 2 {
+		return ""
+	}
+	pkg := segments[:len(segments)-1]
+	for i := len(pkg) - 1; i > 0; i-- {
+		if protoVersionSegment.MatchString(pkg[i]) {
+			return pkg[i-1]
+		}
+	}
+	return pkg[len(pkg)-1]
+}
+
+// ValidateFrozenContracts checks that every entry is a hex EVM address and
+// that no address is listed twice.
+func ValidateFrozenContracts(addresses []string) error {
+	seen := make(map[common.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid frozen contract address: %q", addr)
+		}
+		address := common.HexToAddress(addr)
+		if _, ok := seen[address]; ok {
+			return fmt.Errorf("duplicate frozen contract address: %s", address.Hex())
+		}
+		seen[address] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleNameFromTypeURL(t *testing.T) {
+	testCases := []struct {
+		typeURL  string
+		expected string
+	}{
+		{"/cosmos.evm.erc20.v1.MsgConvertERC20", "erc20"},
+		{"/cosmos.evm.vm.v1.MsgEthereumTx", "vm"},
+		{"/cosmos.staking.v1beta1.MsgDelegate", "staking"},
+		{"/ibc.applications.transfer.v1.MsgTransfer", "transfer"},
+		{"/cosmos.authz.v1beta1.MsgExec", "authz"},
+		{"/foo.bar.MsgBaz", "bar"},
+		{"/MsgBaz", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.typeURL, func(t *testing.T) {
+			require.Equal(t, tc.expected, ModuleNameFromTypeURL(tc.typeURL))
+		})
+	}
+}
+
+func TestParamsTripExpiry(t *testing.T) {
+	testCases := []struct {
+		name        string
+		maxDuration uint64
+		requested   uint64
+		expected    uint64
+		expectError bool
+	}{
+		{name: "no expiry without max duration", expected: 0},
+		{name: "explicit expiry without max duration", requested: 150, expected: 150},
+		{name: "default expiry from max duration", maxDuration: 20, expected: 120},
+		{name: "explicit expiry within max duration", maxDuration: 20, requested: 110, expected: 110},
+		{name: "explicit expiry at max duration", maxDuration: 20, requested: 120, expected: 120},
+		{name: "explicit expiry beyond max duration", maxDuration: 20, requested: 121, expectError: true},
+		{name: "expiry at current height", requested: 100, expectError: true},
+		{name: "expiry in the past", maxDuration: 20, requested: 99, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := Params{MaxTripDurationBlocks: tc.maxDuration}
+			expiry, err := params.TripExpiry(100, tc.requested)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, expiry)
+		})
+	}
+}
+
+func TestCircuitStateValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		state       CircuitState
+		expectError bool
+	}{
+		{
+			name:  "valid scoped state",
+			state: CircuitState{SystemAvailable: true, DisabledMsgTypeUrls: []string{"/cosmos.evm.vm.v1.MsgEthereumTx"}, DisabledModules: []string{"erc20"}},
+		},
+		{
+			name:        "type url without leading slash",
+			state:       CircuitState{SystemAvailable: true, DisabledMsgTypeUrls: []string{"cosmos.evm.vm.v1.MsgEthereumTx"}},
+			expectError: true,
+		},
+		{
+			name:        "duplicate type url",
+			state:       CircuitState{SystemAvailable: true, DisabledMsgTypeUrls: []string{"/a.v1.MsgA", "/a.v1.MsgA"}},
+			expectError: true,
+		},
+		{
+			name:        "invalid module name",
+			state:       CircuitState{SystemAvailable: true, DisabledModules: []string{"x/erc20"}},
+			expectError: true,
+		},
+		{
+			name:        "circuit module cannot be disabled",
+			state:       CircuitState{SystemAvailable: true, DisabledModules: []string{ModuleName}},
+			expectError: true,
+		},
+		{
+			name:  "expiry on scoped trip",
+			state: CircuitState{SystemAvailable: true, DisabledModules: []string{"erc20"}, ExpiresAtHeight: 10},
+		},
+		{
+			name:        "expiry without trip",
+			state:       CircuitState{SystemAvailable: true, ExpiresAtHeight: 10},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.state.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
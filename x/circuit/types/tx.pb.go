@@ -36,6 +36,24 @@ type MsgUpdateCircuit struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// system_available is the desired system availability flag.
 	SystemAvailable bool `protobuf:"varint,2,opt,name=system_available,json=systemAvailable,proto3" json:"system_available,omitempty"`
+	// disabled_msg_type_urls replaces the set of disabled message type URLs.
+	// An empty list keeps the current set unless clear_disabled_msg_type_urls
+	// is set.
+	DisabledMsgTypeUrls []string `protobuf:"bytes,3,rep,name=disabled_msg_type_urls,json=disabledMsgTypeUrls,proto3" json:"disabled_msg_type_urls,omitempty"`
+	// disabled_modules replaces the set of disabled module names. An empty list
+	// keeps the current set unless clear_disabled_modules is set.
+	DisabledModules []string `protobuf:"bytes,4,rep,name=disabled_modules,json=disabledModules,proto3" json:"disabled_modules,omitempty"`
+	// expires_at_height is the block height at which the trip is automatically
+	// restored. Zero means no expiry unless params cap the trip duration.
+	ExpiresAtHeight uint64 `protobuf:"varint,5,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// reason is an optional justification recorded in the action log.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// clear_disabled_msg_type_urls re-enables every disabled message type URL.
+	// It cannot be combined with disabled_msg_type_urls.
+	ClearDisabledMsgTypeUrls bool `protobuf:"varint,7,opt,name=clear_disabled_msg_type_urls,json=clearDisabledMsgTypeUrls,proto3" json:"clear_disabled_msg_type_urls,omitempty"`
+	// clear_disabled_modules re-enables every disabled module. It cannot be
+	// combined with disabled_modules.
+	ClearDisabledModules bool `protobuf:"varint,8,opt,name=clear_disabled_modules,json=clearDisabledModules,proto3" json:"clear_disabled_modules,omitempty"`
 }
 
 func (m *MsgUpdateCircuit) Reset()         { *m = MsgUpdateCircuit{} }
@@ -85,8 +103,55 @@ func (m *MsgUpdateCircuit) GetSystemAvailable() bool {
 	return false
 }
 
+func (m *MsgUpdateCircuit) GetDisabledMsgTypeUrls() []string {
+	if m != nil {
+		return m.DisabledMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *MsgUpdateCircuit) GetDisabledModules() []string {
+	if m != nil {
+		return m.DisabledModules
+	}
+	return nil
+}
+
+func (m *MsgUpdateCircuit) GetExpiresAtHeight() uint64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *MsgUpdateCircuit) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgUpdateCircuit) GetClearDisabledMsgTypeUrls() bool {
+	if m != nil {
+		return m.ClearDisabledMsgTypeUrls
+	}
+	return false
+}
+
+func (m *MsgUpdateCircuit) GetClearDisabledModules() bool {
+	if m != nil {
+		return m.ClearDisabledModules
+	}
+	return false
+}
+
 // MsgUpdateCircuitResponse defines the response structure for executing a MsgUpdateCircuit message.
 type MsgUpdateCircuitResponse struct {
+	// proposal_id is the pending proposal that recorded the vote. Zero when the
+	// update was applied without a pending proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// executed is true when the update was applied to the circuit state.
+	Executed bool `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
 }
 
 func (m *MsgUpdateCircuitResponse) Reset()         { *m = MsgUpdateCircuitResponse{} }
@@ -122,6 +187,306 @@ func (m *MsgUpdateCircuitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateCircuitResponse proto.InternalMessageInfo
 
+func (m *MsgUpdateCircuitResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgUpdateCircuitResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// MsgVoteProposal defines a Msg for approving a pending circuit proposal.
+type MsgVoteProposal struct {
+	// signer is the whitelisted operator approving the proposal.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// proposal_id is the pending proposal to approve.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgVoteProposal) Reset()         { *m = MsgVoteProposal{} }
+func (m *MsgVoteProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteProposal) ProtoMessage()    {}
+func (*MsgVoteProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f346bff89830444c, []int{2}
+}
+func (m *MsgVoteProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteProposal.Merge(m, src)
+}
+func (m *MsgVoteProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteProposal proto.InternalMessageInfo
+
+func (m *MsgVoteProposal) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgVoteProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgVoteProposalResponse defines the response structure for executing a MsgVoteProposal message.
+type MsgVoteProposalResponse struct {
+	// executed is true when the vote reached the threshold and the proposal was
+	// applied to the circuit state.
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgVoteProposalResponse) Reset()         { *m = MsgVoteProposalResponse{} }
+func (m *MsgVoteProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteProposalResponse) ProtoMessage()    {}
+func (*MsgVoteProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f346bff89830444c, []int{3}
+}
+func (m *MsgVoteProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteProposalResponse.Merge(m, src)
+}
+func (m *MsgVoteProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteProposalResponse proto.InternalMessageInfo
+
+func (m *MsgVoteProposalResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// MsgFreezeContracts defines a Msg for freezing EVM contract addresses.
+type MsgFreezeContracts struct {
+	// signer is the address authorized to update circuit state.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// addresses are the hex EVM contract or precompile addresses to freeze.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgFreezeContracts) Reset()         { *m = MsgFreezeContracts{} }
+func (m *MsgFreezeContracts) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeContracts) ProtoMessage()    {}
+func (*MsgFreezeContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f346bff89830444c, []int{4}
+}
+func (m *MsgFreezeContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeContracts.Merge(m, src)
+}
+func (m *MsgFreezeContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeContracts proto.InternalMessageInfo
+
+func (m *MsgFreezeContracts) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgFreezeContracts) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgFreezeContractsResponse defines the response structure for executing a MsgFreezeContracts message.
+type MsgFreezeContractsResponse struct {
+}
+
+func (m *MsgFreezeContractsResponse) Reset()         { *m = MsgFreezeContractsResponse{} }
+func (m *MsgFreezeContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeContractsResponse) ProtoMessage()    {}
+func (*MsgFreezeContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f346bff89830444c, []int{5}
+}
+func (m *MsgFreezeContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeContractsResponse.Merge(m, src)
+}
+func (m *MsgFreezeContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeContractsResponse proto.InternalMessageInfo
+
+// MsgUnfreezeContracts defines a Msg for unfreezing EVM contract addresses.
+type MsgUnfreezeContracts struct {
+	// signer is the address authorized to update circuit state.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// addresses are the hex EVM contract or precompile addresses to unfreeze.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgUnfreezeContracts) Reset()         { *m = MsgUnfreezeContracts{} }
+func (m *MsgUnfreezeContracts) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeContracts) ProtoMessage()    {}
+func (*MsgUnfreezeContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f346bff89830444c, []int{6}
+}
+func (m *MsgUnfreezeContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeContracts.Merge(m, src)
+}
+func (m *MsgUnfreezeContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeContracts proto.InternalMessageInfo
+
+func (m *MsgUnfreezeContracts) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUnfreezeContracts) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgUnfreezeContractsResponse defines the response structure for executing a MsgUnfreezeContracts message.
+type MsgUnfreezeContractsResponse struct {
+}
+
+func (m *MsgUnfreezeContractsResponse) Reset()         { *m = MsgUnfreezeContractsResponse{} }
+func (m *MsgUnfreezeContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeContractsResponse) ProtoMessage()    {}
+func (*MsgUnfreezeContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f346bff89830444c, []int{7}
+}
+func (m *MsgUnfreezeContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeContractsResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeContractsResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a Msg for updating the circuit params.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -135,7 +500,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f346bff89830444c, []int{2}
+	return fileDescriptor_f346bff89830444c, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +551,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f346bff89830444c, []int{3}
+	return fileDescriptor_f346bff89830444c, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,6 +583,12 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgUpdateCircuit)(nil), "cosmos.evm.circuit.v1.MsgUpdateCircuit")
 	proto.RegisterType((*MsgUpdateCircuitResponse)(nil), "cosmos.evm.circuit.v1.MsgUpdateCircuitResponse")
+	proto.RegisterType((*MsgVoteProposal)(nil), "cosmos.evm.circuit.v1.MsgVoteProposal")
+	proto.RegisterType((*MsgVoteProposalResponse)(nil), "cosmos.evm.circuit.v1.MsgVoteProposalResponse")
+	proto.RegisterType((*MsgFreezeContracts)(nil), "cosmos.evm.circuit.v1.MsgFreezeContracts")
+	proto.RegisterType((*MsgFreezeContractsResponse)(nil), "cosmos.evm.circuit.v1.MsgFreezeContractsResponse")
+	proto.RegisterType((*MsgUnfreezeContracts)(nil), "cosmos.evm.circuit.v1.MsgUnfreezeContracts")
+	proto.RegisterType((*MsgUnfreezeContractsResponse)(nil), "cosmos.evm.circuit.v1.MsgUnfreezeContractsResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.circuit.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.circuit.v1.MsgUpdateParamsResponse")
 }
@@ -225,34 +596,55 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/circuit/v1/tx.proto", fileDescriptor_f346bff89830444c) }
 
 var fileDescriptor_f346bff89830444c = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0xce, 0x2c, 0x4a, 0x2e, 0xcd, 0x2c, 0xd1, 0x2f,
-	0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x85, 0xc8, 0xeb, 0xa5,
-	0x96, 0xe5, 0xea, 0x41, 0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5,
-	0xc1, 0x24, 0x44, 0xa5, 0x94, 0x38, 0xd4, 0xa4, 0xdc, 0xe2, 0x74, 0x90, 0x09, 0xb9, 0xc5, 0xe9,
-	0x50, 0x09, 0x49, 0x88, 0x44, 0x3c, 0x98, 0xa7, 0x0f, 0x35, 0x0f, 0x22, 0xa5, 0x8c, 0xdd, 0x76,
-	0x98, 0x45, 0x60, 0x45, 0x4a, 0xcb, 0x18, 0xb9, 0x04, 0x7c, 0x8b, 0xd3, 0x43, 0x0b, 0x52, 0x12,
-	0x4b, 0x52, 0x9d, 0x21, 0x52, 0x42, 0x06, 0x5c, 0x6c, 0xc5, 0x99, 0xe9, 0x79, 0xa9, 0x45, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0xcd, 0x76, 0x4c, 0x49,
-	0x29, 0x4a, 0x2d, 0x2e, 0x0e, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x82, 0xaa, 0x13, 0xd2, 0xe4,
-	0x12, 0x28, 0xae, 0x2c, 0x2e, 0x49, 0xcd, 0x8d, 0x4f, 0x2c, 0x4b, 0xcc, 0xcc, 0x49, 0x4c, 0xca,
-	0x49, 0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x08, 0xe2, 0x87, 0x88, 0x3b, 0xc2, 0x84, 0xad, 0x4c,
-	0x9b, 0x9e, 0x6f, 0xd0, 0x82, 0xea, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x15, 0xc9, 0x99, 0x15, 0x70,
-	0x87, 0xa2, 0xbb, 0x49, 0x49, 0x8a, 0x4b, 0x02, 0x5d, 0x2c, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf,
-	0x38, 0x55, 0x69, 0x27, 0x23, 0x17, 0x3f, 0x5c, 0x32, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x92, 0xa0, 0x37, 0x10, 0x4a,
-	0x85, 0x4c, 0xb9, 0xd8, 0x0a, 0xc0, 0x26, 0x80, 0xdd, 0xcf, 0x6d, 0x24, 0xab, 0x87, 0x35, 0x92,
-	0xf4, 0x20, 0xd6, 0x04, 0x41, 0x15, 0x5b, 0x99, 0x83, 0x7c, 0x85, 0x30, 0x06, 0xe4, 0x31, 0x15,
-	0xfc, 0x1e, 0x83, 0x18, 0xa0, 0x24, 0xc9, 0x25, 0x8e, 0x26, 0x04, 0xf3, 0x96, 0xd1, 0x7d, 0x46,
-	0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x4c, 0x2e, 0x5e, 0xd4, 0xf8, 0x51, 0xc7, 0xe1, 0x26, 0xf4,
-	0x00, 0x92, 0xd2, 0x27, 0x52, 0x21, 0xcc, 0x4a, 0xa1, 0x34, 0x2e, 0x1e, 0x94, 0x50, 0x54, 0x23,
-	0x64, 0x00, 0x44, 0x9d, 0x94, 0x1e, 0x71, 0xea, 0x60, 0xf6, 0x48, 0xb1, 0x36, 0x3c, 0xdf, 0xa0,
-	0xc5, 0xe8, 0x64, 0x7f, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xaa, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x58, 0xc3, 0xb1, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x8a, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x42, 0x2a, 0xae,
-	0xbc, 0x6a, 0x03, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x6f, 0xd3, 0x5a,
+	0x14, 0xae, 0x9b, 0x34, 0xaf, 0xb9, 0x7d, 0x4f, 0x69, 0xfd, 0xfa, 0x5a, 0xd7, 0xca, 0x33, 0x91,
+	0xa1, 0x6d, 0x1a, 0x84, 0x4d, 0x7f, 0x81, 0xe8, 0x00, 0x6a, 0x8b, 0x10, 0x0c, 0x91, 0x2a, 0x43,
+	0x41, 0x62, 0xb1, 0xdc, 0xf8, 0xd6, 0xb1, 0x14, 0xe7, 0x5a, 0xf7, 0xdc, 0x44, 0x09, 0x13, 0x62,
+	0x64, 0x62, 0x46, 0x62, 0xe0, 0x2f, 0xa0, 0x03, 0x0b, 0x1b, 0x23, 0x63, 0xc5, 0xc4, 0x88, 0xda,
+	0xa1, 0xff, 0x06, 0x8a, 0xed, 0x38, 0xc9, 0x6d, 0xdc, 0x86, 0x4a, 0x2c, 0x51, 0xfc, 0x9d, 0xef,
+	0x9e, 0xf3, 0x7d, 0x3e, 0x9f, 0x6d, 0xa4, 0x54, 0x08, 0x78, 0x04, 0x74, 0xdc, 0xf4, 0xf4, 0x8a,
+	0x4b, 0x2b, 0x0d, 0x97, 0xe9, 0xcd, 0x55, 0x9d, 0xb5, 0x34, 0x9f, 0x12, 0x46, 0xc4, 0xff, 0xc2,
+	0xba, 0x86, 0x9b, 0x9e, 0x16, 0xd5, 0xb5, 0xe6, 0xaa, 0x3c, 0x63, 0x79, 0x6e, 0x9d, 0xe8, 0xc1,
+	0x6f, 0xc8, 0x94, 0xe7, 0xa3, 0x4e, 0x1e, 0x38, 0x9d, 0x0e, 0x1e, 0x38, 0x51, 0x61, 0x21, 0x2c,
+	0x98, 0xc1, 0x95, 0x1e, 0xf5, 0x0b, 0x4b, 0xd7, 0x87, 0x4f, 0xef, 0x0e, 0x0a, 0x48, 0xea, 0xd7,
+	0x14, 0x9a, 0x2e, 0x83, 0xb3, 0xef, 0xdb, 0x16, 0xc3, 0xbb, 0x61, 0x49, 0xbc, 0x8d, 0x32, 0xe0,
+	0x3a, 0x75, 0x4c, 0x25, 0xa1, 0x20, 0x14, 0xb3, 0x3b, 0xd2, 0xf7, 0xcf, 0xb7, 0x66, 0xa3, 0xde,
+	0xdb, 0xb6, 0x4d, 0x31, 0xc0, 0x53, 0x46, 0xdd, 0xba, 0x63, 0x44, 0x3c, 0x71, 0x05, 0x4d, 0x43,
+	0x1b, 0x18, 0xf6, 0x4c, 0xab, 0x69, 0xb9, 0x35, 0xeb, 0xa0, 0x86, 0xa5, 0xf1, 0x82, 0x50, 0x9c,
+	0x34, 0x72, 0x21, 0xbe, 0xdd, 0x85, 0xc5, 0x75, 0x34, 0x67, 0xbb, 0xd0, 0xf9, 0x6b, 0x9b, 0x1e,
+	0x38, 0x26, 0x6b, 0xfb, 0xd8, 0x6c, 0xd0, 0x1a, 0x48, 0xa9, 0x42, 0xaa, 0x98, 0x35, 0xfe, 0xed,
+	0x56, 0xcb, 0xe0, 0x3c, 0x6b, 0xfb, 0x78, 0x9f, 0xd6, 0xa0, 0xd3, 0xbf, 0x77, 0x88, 0xd8, 0x8d,
+	0x1a, 0x06, 0x29, 0x1d, 0xd0, 0x73, 0x31, 0x3d, 0x84, 0xc5, 0x12, 0x9a, 0xc1, 0x2d, 0xdf, 0xa5,
+	0x18, 0x4c, 0x8b, 0x99, 0x55, 0xec, 0x3a, 0x55, 0x26, 0x4d, 0x14, 0x84, 0x62, 0xda, 0xc8, 0x45,
+	0x85, 0x6d, 0xf6, 0x38, 0x80, 0xc5, 0x39, 0x94, 0xa1, 0xd8, 0x02, 0x52, 0x97, 0x32, 0x1d, 0xa3,
+	0x46, 0x74, 0x25, 0xde, 0x47, 0xf9, 0x4a, 0x0d, 0x5b, 0xd4, 0x4c, 0x50, 0xfa, 0x57, 0x60, 0x4d,
+	0x0a, 0x38, 0x0f, 0x87, 0xc8, 0xdd, 0x40, 0x73, 0xfc, 0xf9, 0x48, 0xf4, 0x64, 0x70, 0x72, 0x76,
+	0xf0, 0x64, 0x58, 0xdb, 0xda, 0x7c, 0x73, 0x76, 0x54, 0x8a, 0xee, 0xe8, 0xdb, 0xb3, 0xa3, 0xd2,
+	0x62, 0xdf, 0x02, 0x5b, 0xf1, 0x0a, 0xf9, 0x6d, 0xa9, 0x2f, 0x90, 0xc4, 0x63, 0x06, 0x06, 0x9f,
+	0xd4, 0x01, 0x8b, 0xd7, 0xd0, 0x94, 0x4f, 0x89, 0x4f, 0xc0, 0xaa, 0x99, 0xae, 0x1d, 0xac, 0x33,
+	0x6d, 0xa0, 0x2e, 0xf4, 0xc4, 0x16, 0x65, 0x34, 0x89, 0x5b, 0xb8, 0xd2, 0x60, 0xd8, 0x8e, 0x16,
+	0x16, 0x5f, 0xab, 0xef, 0x05, 0x94, 0x2b, 0x83, 0xf3, 0x9c, 0x30, 0xbc, 0x17, 0x9d, 0xb8, 0x42,
+	0x34, 0x38, 0x09, 0xe3, 0xbc, 0x84, 0xad, 0x0d, 0xce, 0xf6, 0x8d, 0x24, 0xdb, 0xfd, 0x42, 0xd4,
+	0x4d, 0x34, 0xcf, 0x41, 0xb1, 0xe9, 0x7e, 0x4f, 0x02, 0xe7, 0xe9, 0x83, 0x80, 0xc4, 0x32, 0x38,
+	0x8f, 0x28, 0xc6, 0xaf, 0xf0, 0x2e, 0xa9, 0x33, 0x6a, 0x55, 0x18, 0x5c, 0xc1, 0x56, 0x1e, 0x65,
+	0xad, 0xb0, 0x80, 0x41, 0x1a, 0x0f, 0xa2, 0xd8, 0x03, 0xb6, 0xee, 0x72, 0x9e, 0x96, 0x93, 0x3c,
+	0x71, 0x42, 0xd4, 0x3c, 0x92, 0xcf, 0xa3, 0x5d, 0x67, 0xea, 0x47, 0x01, 0xcd, 0x76, 0x76, 0x5d,
+	0x3f, 0xfc, 0xc3, 0xfa, 0xef, 0x71, 0xfa, 0x57, 0x12, 0xa3, 0xc8, 0x4b, 0x51, 0x15, 0x94, 0x1f,
+	0x86, 0xc7, 0x1e, 0xbe, 0x84, 0xa9, 0x0a, 0xf3, 0xba, 0x67, 0x51, 0xcb, 0x03, 0xf1, 0x0e, 0xca,
+	0x5a, 0x0d, 0x56, 0x25, 0xd4, 0x65, 0xed, 0x4b, 0x1d, 0xf4, 0xa8, 0xe2, 0x26, 0xca, 0xf8, 0x41,
+	0x87, 0x20, 0x56, 0x53, 0x6b, 0xff, 0x6b, 0x43, 0xdf, 0xa8, 0x5a, 0x38, 0xc6, 0x88, 0xc8, 0xe1,
+	0x76, 0x7a, 0x6d, 0x2e, 0x0c, 0x5d, 0xbf, 0x4e, 0x75, 0x01, 0xcd, 0x73, 0x50, 0xd7, 0xd6, 0xda,
+	0xa7, 0x34, 0x4a, 0x95, 0xc1, 0x11, 0x5d, 0xf4, 0xcf, 0xe0, 0xcb, 0x74, 0x39, 0x41, 0x13, 0xff,
+	0xcc, 0xca, 0xfa, 0x88, 0xc4, 0x38, 0xe7, 0x87, 0xe8, 0xef, 0x81, 0x67, 0x73, 0x29, 0xb9, 0x41,
+	0x3f, 0x4f, 0xd6, 0x46, 0xe3, 0xc5, 0x73, 0x08, 0xca, 0xf1, 0xcf, 0xcb, 0x4a, 0x72, 0x0b, 0x8e,
+	0x2a, 0xaf, 0x8e, 0x4c, 0x8d, 0x07, 0x36, 0xd0, 0xcc, 0xf9, 0x88, 0xdf, 0xbc, 0xe0, 0xf6, 0xf0,
+	0x64, 0x79, 0xfd, 0x37, 0xc8, 0xfd, 0xf7, 0x73, 0x20, 0x95, 0x4b, 0x97, 0x2d, 0x24, 0xe4, 0xc9,
+	0xda, 0x68, 0xbc, 0xee, 0x1c, 0x79, 0xe2, 0xf5, 0xd9, 0x51, 0x49, 0xd8, 0x79, 0xf0, 0xed, 0x44,
+	0x11, 0x8e, 0x4f, 0x14, 0xe1, 0xe7, 0x89, 0x22, 0xbc, 0x3b, 0x55, 0xc6, 0x8e, 0x4f, 0x95, 0xb1,
+	0x1f, 0xa7, 0xca, 0xd8, 0xcb, 0x45, 0xc7, 0x65, 0xd5, 0xc6, 0x81, 0x56, 0x21, 0x9e, 0x3e, 0x34,
+	0x97, 0x9d, 0x2f, 0x11, 0x1c, 0x64, 0x82, 0x4f, 0xf8, 0xfa, 0xaf, 0x01, 0x00, 0xdf, 0xa0, 0x80,
+	0x47, 0x67, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -267,8 +659,16 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
-	// UpdateCircuit updates the system availability flag.
+	// UpdateCircuit proposes and approves an update of the system availability
+	// flag and the disabled message type and module sets. The update is applied
+	// once the configured operator threshold is reached.
 	UpdateCircuit(ctx context.Context, in *MsgUpdateCircuit, opts ...grpc.CallOption) (*MsgUpdateCircuitResponse, error)
+	// VoteProposal approves a pending circuit proposal.
+	VoteProposal(ctx context.Context, in *MsgVoteProposal, opts ...grpc.CallOption) (*MsgVoteProposalResponse, error)
+	// FreezeContracts rejects every EVM call to the given addresses.
+	FreezeContracts(ctx context.Context, in *MsgFreezeContracts, opts ...grpc.CallOption) (*MsgFreezeContractsResponse, error)
+	// UnfreezeContracts allows EVM calls to the given addresses again.
+	UnfreezeContracts(ctx context.Context, in *MsgUnfreezeContracts, opts ...grpc.CallOption) (*MsgUnfreezeContractsResponse, error)
 	// UpdateParams updates the circuit module params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -290,6 +690,33 @@ func (c *msgClient) UpdateCircuit(ctx context.Context, in *MsgUpdateCircuit, opt
 	return out, nil
 }
 
+func (c *msgClient) VoteProposal(ctx context.Context, in *MsgVoteProposal, opts ...grpc.CallOption) (*MsgVoteProposalResponse, error) {
+	out := new(MsgVoteProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Msg/VoteProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeContracts(ctx context.Context, in *MsgFreezeContracts, opts ...grpc.CallOption) (*MsgFreezeContractsResponse, error) {
+	out := new(MsgFreezeContractsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Msg/FreezeContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeContracts(ctx context.Context, in *MsgUnfreezeContracts, opts ...grpc.CallOption) (*MsgUnfreezeContractsResponse, error) {
+	out := new(MsgUnfreezeContractsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Msg/UnfreezeContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.circuit.v1.Msg/UpdateParams", in, out, opts...)
@@ -301,8 +728,16 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
-	// UpdateCircuit updates the system availability flag.
+	// UpdateCircuit proposes and approves an update of the system availability
+	// flag and the disabled message type and module sets. The update is applied
+	// once the configured operator threshold is reached.
 	UpdateCircuit(context.Context, *MsgUpdateCircuit) (*MsgUpdateCircuitResponse, error)
+	// VoteProposal approves a pending circuit proposal.
+	VoteProposal(context.Context, *MsgVoteProposal) (*MsgVoteProposalResponse, error)
+	// FreezeContracts rejects every EVM call to the given addresses.
+	FreezeContracts(context.Context, *MsgFreezeContracts) (*MsgFreezeContractsResponse, error)
+	// UnfreezeContracts allows EVM calls to the given addresses again.
+	UnfreezeContracts(context.Context, *MsgUnfreezeContracts) (*MsgUnfreezeContractsResponse, error)
 	// UpdateParams updates the circuit module params.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -314,6 +749,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateCircuit(ctx context.Context, req *MsgUpdateCircuit) (*MsgUpdateCircuitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuit not implemented")
 }
+func (*UnimplementedMsgServer) VoteProposal(ctx context.Context, req *MsgVoteProposal) (*MsgVoteProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteProposal not implemented")
+}
+func (*UnimplementedMsgServer) FreezeContracts(ctx context.Context, req *MsgFreezeContracts) (*MsgFreezeContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeContracts not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeContracts(ctx context.Context, req *MsgUnfreezeContracts) (*MsgUnfreezeContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeContracts not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -340,44 +784,110 @@ func _Msg_UpdateCircuit_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
+func _Msg_VoteProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteProposal)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+		return srv.(MsgServer).VoteProposal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.evm.circuit.v1.Msg/UpdateParams",
+		FullMethod: "/cosmos.evm.circuit.v1.Msg/VoteProposal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+		return srv.(MsgServer).VoteProposal(ctx, req.(*MsgVoteProposal))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.evm.circuit.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateCircuit",
-			Handler:    _Msg_UpdateCircuit_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/evm/circuit/v1/tx.proto",
-}
-
-func (m *MsgUpdateCircuit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+func _Msg_FreezeContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Msg/FreezeContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeContracts(ctx, req.(*MsgFreezeContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Msg/UnfreezeContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeContracts(ctx, req.(*MsgUnfreezeContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.circuit.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.circuit.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateCircuit",
+			Handler:    _Msg_UpdateCircuit_Handler,
+		},
+		{
+			MethodName: "VoteProposal",
+			Handler:    _Msg_VoteProposal_Handler,
+		},
+		{
+			MethodName: "FreezeContracts",
+			Handler:    _Msg_FreezeContracts_Handler,
+		},
+		{
+			MethodName: "UnfreezeContracts",
+			Handler:    _Msg_UnfreezeContracts_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/circuit/v1/tx.proto",
+}
+
+func (m *MsgUpdateCircuit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -396,6 +906,56 @@ func (m *MsgUpdateCircuit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClearDisabledModules {
+		i--
+		if m.ClearDisabledModules {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ClearDisabledMsgTypeUrls {
+		i--
+		if m.ClearDisabledMsgTypeUrls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DisabledModules) > 0 {
+		for iNdEx := len(m.DisabledModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledModules[iNdEx])
+			copy(dAtA[i:], m.DisabledModules[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DisabledModules[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for iNdEx := len(m.DisabledMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DisabledMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.SystemAvailable {
 		i--
 		if m.SystemAvailable {
@@ -436,10 +996,25 @@ func (m *MsgUpdateCircuitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -449,39 +1024,32 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteProposalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -491,88 +1059,849 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteProposalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgFreezeContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateCircuit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgFreezeContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.SystemAvailable {
-		n += 2
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateCircuitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFreezeContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnfreezeContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnfreezeContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateCircuit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SystemAvailable {
+		n += 2
+	}
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for _, s := range m.DisabledMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DisabledModules) > 0 {
+		for _, s := range m.DisabledModules {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClearDisabledMsgTypeUrls {
+		n += 2
+	}
+	if m.ClearDisabledModules {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateCircuitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgVoteProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVoteProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgFreezeContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFreezeContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnfreezeContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateCircuit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCircuit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCircuit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemAvailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SystemAvailable = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypeUrls = append(m.DisabledMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledModules = append(m.DisabledModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearDisabledMsgTypeUrls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearDisabledMsgTypeUrls = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearDisabledModules", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearDisabledModules = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCircuitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCircuitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCircuitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateCircuit) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -595,10 +1924,80 @@ func (m *MsgUpdateCircuit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCircuit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCircuit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeContracts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -634,10 +2033,10 @@ func (m *MsgUpdateCircuit) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemAvailable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -647,12 +2046,24 @@ func (m *MsgUpdateCircuit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SystemAvailable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -674,7 +2085,7 @@ func (m *MsgUpdateCircuit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCircuitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -697,10 +2108,174 @@ func (m *MsgUpdateCircuitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCircuitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCircuitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
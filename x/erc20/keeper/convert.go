@@ -21,6 +21,7 @@ import (
 // ConvertERC20IntoCoinsForNativeToken handles the erc20 conversion for a native erc20 token pair.
 // This function is used by both the msg server and precompiles (like ICS20).
 // It performs the following operations:
+//   - checks that the circuit breaker does not disable MsgConvertERC20
 //   - validates the token pair and checks if conversion is enabled
 //   - removes the token pair if the contract is suicided
 //   - escrows tokens on module account
@@ -30,6 +31,10 @@ import (
 //   - checks if token balance decreased by amount
 //   - checks for unexpected `Approval` event in logs
 func (k Keeper) ConvertERC20IntoCoinsForNativeToken(ctx sdk.Context, stateDB *statedb.StateDB, contract common.Address, amount math.Int, receiver sdk.AccAddress, sender common.Address, commit bool, callFromPrecompile bool) (*types.MsgConvertERC20Response, error) {
+	if err := k.checkCircuit(ctx, &types.MsgConvertERC20{}); err != nil {
+		return nil, err
+	}
+
 	// Validate and get token pair
 	pair, err := k.MintingEnabled(ctx, receiver, contract.Hex())
 	if err != nil {
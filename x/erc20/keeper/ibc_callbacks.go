@@ -32,6 +32,7 @@ import (
 // - ERC20s are disabled
 // - Denomination is native staking token
 // - The base denomination is not registered as ERC20
+// - The circuit breaker disables MsgConvertCoin for a native ERC20 token
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -122,6 +123,11 @@ func (k Keeper) OnRecvPacket(
 			return ack
 		}
 
+		// Conversions are disabled by the circuit breaker -> keep the received coins
+		if err := k.checkCircuit(ctx, &types.MsgConvertCoin{}); err != nil {
+			return ack
+		}
+
 		pair, err := k.MintingEnabled(ctx, recipient, coin.Denom)
 		if err != nil {
 			ctx.EventManager().EmitEvent(
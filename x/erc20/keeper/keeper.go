@@ -7,11 +7,13 @@ import (
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 
 	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Keeper of this module maintains collections of erc20.
@@ -27,6 +29,8 @@ type Keeper struct {
 	evmKeeper      types.EVMKeeper
 	stakingKeeper  types.StakingKeeper
 	transferKeeper *transferkeeper.Keeper
+	// optional circuitKeeper used to reject conversions disabled by the circuit breaker
+	circuitKeeper types.CircuitKeeper
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -62,3 +66,29 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// WithCircuitKeeper sets the circuit keeper used to reject conversions and
+// token precompile transactions disabled by the circuit breaker.
+func (k *Keeper) WithCircuitKeeper(circuitKeeper types.CircuitKeeper) *Keeper {
+	k.circuitKeeper = circuitKeeper
+	return k
+}
+
+// IsModuleDisabled reports whether the circuit breaker disabled the erc20
+// module.
+func (k Keeper) IsModuleDisabled(ctx sdk.Context) bool {
+	return k.circuitKeeper != nil && k.circuitKeeper.IsModuleDisabled(ctx, types.ModuleName)
+}
+
+// checkCircuit returns an error if the circuit breaker disabled msg, either by
+// its type URL or through the erc20 module.
+func (k Keeper) checkCircuit(ctx sdk.Context, msg sdk.Msg) error {
+	if k.circuitKeeper == nil {
+		return nil
+	}
+	typeURL := sdk.MsgTypeURL(msg)
+	if k.circuitKeeper.IsMsgTypeDisabled(ctx, typeURL) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "circuit tripped: message type %s is disabled", typeURL)
+	}
+	return nil
+}
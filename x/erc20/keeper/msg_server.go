@@ -84,12 +84,17 @@ func (k Keeper) ConvertCoin(
 
 // ConvertCoinNativeERC20 handles the coin conversion for a native ERC20 token
 // pair:
+//   - check that the circuit breaker does not disable MsgConvertCoin
 //   - escrow Coins on module account
 //   - unescrow Tokens that have been previously escrowed with ConvertERC20 and send to receiver
 //   - burn escrowed Coins
 //   - check if token balance increased by amount
 //   - check for unexpected `Approval` event in logs
 func (k Keeper) ConvertCoinNativeERC20(ctx sdk.Context, pair types.TokenPair, amount math.Int, receiver common.Address, sender sdk.AccAddress, callFromPrecompile bool) error {
+	if err := k.checkCircuit(ctx, &types.MsgConvertCoin{}); err != nil {
+		return err
+	}
+
 	if !amount.IsPositive() {
 		return sdkerrors.Wrap(types.ErrNegativeToken, "converted coin amount must be positive")
 	}
@@ -61,6 +61,14 @@ type EVMKeeper interface {
 	KVStoreKeys() map[string]*storetypes.KVStoreKey
 }
 
+// CircuitKeeper defines the expected circuit breaker keeper interface used to
+// reject conversions and token precompile transactions disabled by a scoped
+// trip.
+type CircuitKeeper interface {
+	IsMsgTypeDisabled(ctx sdk.Context, typeURL string) bool
+	IsModuleDisabled(ctx sdk.Context, moduleName string) bool
+}
+
 type Erc20Keeper interface {
 	OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, ack exported.Acknowledgement) exported.Acknowledgement
 	OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error
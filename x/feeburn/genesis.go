@@ -0,0 +1,26 @@
+package feeburn
+
+import (
+	"github.com/cosmos/evm/x/feeburn/keeper"
+	"github.com/cosmos/evm/x/feeburn/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	for _, coin := range data.TotalBurned {
+		k.SetTotalBurned(ctx, coin.Denom, coin.Amount)
+	}
+	for _, blockBurn := range data.BlockBurns {
+		k.SetBlockBurn(ctx, blockBurn)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		TotalBurned: k.GetAllTotalBurned(ctx),
+		BlockBurns:  k.GetAllBlockBurns(ctx),
+	}
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeburntypes "github.com/cosmos/evm/x/feeburn/types"
+)
+
+// GetTotalBurned returns the cumulative amount of the denom burned by the
+// module.
+func (k Keeper) GetTotalBurned(ctx sdk.Context, denom string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feeburntypes.GetTotalBurnedKey(denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err) //nolint:halt // Internal invariant: only SetTotalBurned writes this key, from a marshaled math.Int.
+	}
+	return amount
+}
+
+// SetTotalBurned stores the cumulative amount of the denom burned by the
+// module.
+func (k Keeper) SetTotalBurned(ctx sdk.Context, denom string, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := feeburntypes.GetTotalBurnedKey(denom)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err) //nolint:halt // Internal invariant: a math.Int built from coin amounts always marshals.
+	}
+	store.Set(key, bz)
+}
+
+// GetAllTotalBurned returns the cumulative amount burned by the module across
+// all denoms.
+func (k Keeper) GetAllTotalBurned(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, feeburntypes.KeyPrefixTotalBurned)
+	defer iterator.Close()
+
+	var total sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err) //nolint:halt // Internal invariant: only SetTotalBurned writes this prefix, from a marshaled math.Int.
+		}
+		denom := string(iterator.Key()[len(feeburntypes.KeyPrefixTotalBurned):])
+		total = total.Add(sdk.NewCoin(denom, amount))
+	}
+	return total
+}
+
+// GetBlockBurn returns the burn record of the block at the height, if any.
+func (k Keeper) GetBlockBurn(ctx sdk.Context, height uint64) (feeburntypes.BlockBurn, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feeburntypes.GetBlockBurnKey(height))
+	if bz == nil {
+		return feeburntypes.BlockBurn{}, false
+	}
+
+	var blockBurn feeburntypes.BlockBurn
+	k.cdc.MustUnmarshal(bz, &blockBurn)
+	return blockBurn, true
+}
+
+// SetBlockBurn stores the burn record of a block.
+func (k Keeper) SetBlockBurn(ctx sdk.Context, blockBurn feeburntypes.BlockBurn) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feeburntypes.GetBlockBurnKey(blockBurn.Height), k.cdc.MustMarshal(&blockBurn))
+}
+
+// IterateBlockBurns walks the burn records between the heights, inclusive, in
+// increasing height order.
+func (k Keeper) IterateBlockBurns(ctx sdk.Context, startHeight, endHeight uint64, cb func(blockBurn feeburntypes.BlockBurn) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(feeburntypes.KeyPrefixBlockBurn)
+	if endHeight < ^uint64(0) {
+		end = feeburntypes.GetBlockBurnKey(endHeight + 1)
+	}
+	iterator := store.Iterator(feeburntypes.GetBlockBurnKey(startHeight), end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var blockBurn feeburntypes.BlockBurn
+		k.cdc.MustUnmarshal(iterator.Value(), &blockBurn)
+		if cb(blockBurn) {
+			break
+		}
+	}
+}
+
+// GetAllBlockBurns returns every stored burn record in increasing height
+// order.
+func (k Keeper) GetAllBlockBurns(ctx sdk.Context) []feeburntypes.BlockBurn {
+	blockBurns := []feeburntypes.BlockBurn{}
+	k.IterateBlockBurns(ctx, 0, ^uint64(0), func(blockBurn feeburntypes.BlockBurn) bool {
+		blockBurns = append(blockBurns, blockBurn)
+		return false
+	})
+	return blockBurns
+}
+
+// pruneBlockBurns deletes the burn records that left the retention window,
+// oldest first and at most MaxPrunedBlockBurnsPerBlock per block. Records
+// imported from before the window are drained over the following blocks. The
+// running totals are kept.
+func (k Keeper) pruneBlockBurns(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height is never negative
+	if height <= feeburntypes.BlockBurnRetentionBlocks {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cutoff := height - feeburntypes.BlockBurnRetentionBlocks
+	iterator := store.Iterator(feeburntypes.GetBlockBurnKey(0), feeburntypes.GetBlockBurnKey(cutoff+1))
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < feeburntypes.MaxPrunedBlockBurnsPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordBurn adds the burned amount to the cumulative totals and stores the
+// burn record of the current block.
+func (k Keeper) recordBurn(ctx sdk.Context, burned sdk.Coins) {
+	for _, coin := range burned {
+		k.SetTotalBurned(ctx, coin.Denom, k.GetTotalBurned(ctx, coin.Denom).Add(coin.Amount))
+	}
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height is never negative
+	blockBurn := feeburntypes.BlockBurn{Height: height, Amount: burned}
+	if existing, found := k.GetBlockBurn(ctx, height); found {
+		blockBurn.Amount = blockBurn.Amount.Add(existing.Amount...)
+	}
+	k.SetBlockBurn(ctx, blockBurn)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feeburntypes.EventTypeBurnFees,
+			sdk.NewAttribute(feeburntypes.AttributeKeyAmount, burned.String()),
+			sdk.NewAttribute(feeburntypes.AttributeKeyHeight, strconv.FormatUint(height, 10)),
+		),
+	)
+}
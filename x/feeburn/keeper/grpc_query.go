@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/evm/x/feeburn/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// maxBurnedInRangeBlocks bounds the heights a BurnedInRange query may span, so
+// a single query cannot iterate the full burn history.
+const maxBurnedInRangeBlocks = 100_000
+
+func (k Keeper) TotalBurned(c context.Context, req *types.QueryTotalBurnedRequest) (*types.QueryTotalBurnedResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalBurnedResponse{TotalBurned: k.GetAllTotalBurned(ctx)}, nil
+}
+
+func (k Keeper) BurnedInRange(c context.Context, req *types.QueryBurnedInRangeRequest) (*types.QueryBurnedInRangeResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if req.StartHeight > req.EndHeight {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "start height %d is greater than end height %d", req.StartHeight, req.EndHeight)
+	}
+	if req.EndHeight-req.StartHeight >= maxBurnedInRangeBlocks {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "height range cannot span more than %d blocks", maxBurnedInRangeBlocks)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryBurnedInRangeResponse{BlockBurns: []types.BlockBurn{}}
+	k.IterateBlockBurns(ctx, req.StartHeight, req.EndHeight, func(blockBurn types.BlockBurn) bool {
+		res.Burned = res.Burned.Add(blockBurn.Amount...)
+		res.BlockBurns = append(res.BlockBurns, blockBurn)
+		return false
+	})
+	return res, nil
+}
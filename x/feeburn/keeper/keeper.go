@@ -4,8 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	feeburntypes "github.com/cosmos/evm/x/feeburn/types"
 	"github.com/cosmos/evm/x/precisebank/types"
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -16,6 +24,7 @@ type AccountKeeper interface {
 
 type BankKeeper interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
 
 type PreciseBankKeeper interface {
@@ -23,41 +32,156 @@ type PreciseBankKeeper interface {
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 type Keeper struct {
+	cdc               codec.BinaryCodec
+	storeKey          storetypes.StoreKey
+	authority         sdk.AccAddress
 	accountKeeper     AccountKeeper
 	bankKeeper        BankKeeper
 	preciseBankKeeper PreciseBankKeeper
+	distrKeeper       DistributionKeeper
 }
 
-func NewKeeper(accountKeeper AccountKeeper, bankKeeper BankKeeper, preciseBankKeeper PreciseBankKeeper) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
+	accountKeeper AccountKeeper,
+	bankKeeper BankKeeper,
+	preciseBankKeeper PreciseBankKeeper,
+	distrKeeper DistributionKeeper,
+) Keeper {
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err) //nolint:halt // Constructor guard: feeburn authority wiring must be valid at boot.
+	}
 	return Keeper{
+		cdc:               cdc,
+		storeKey:          storeKey,
+		authority:         authority,
 		accountKeeper:     accountKeeper,
 		bankKeeper:        bankKeeper,
 		preciseBankKeeper: preciseBankKeeper,
+		distrKeeper:       distrKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", feeburntypes.ModuleName)
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) feeburntypes.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feeburntypes.KeyParams)
+	if bz == nil {
+		return feeburntypes.DefaultParams()
 	}
+
+	var params feeburntypes.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params feeburntypes.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feeburntypes.KeyParams, k.cdc.MustMarshal(&params))
 }
 
-func (k Keeper) BeginBlock(ctx context.Context) error {
+// feeRouting holds the coins sent to each destination in a block.
+type feeRouting struct {
+	burn          sdk.Coins
+	communityPool sdk.Coins
+	valRewards    sdk.Coins
+}
+
+// BeginBlock prunes the burn records that left the retention window and
+// splits the fees collected in the previous block by the params ratios. It
+// runs before x/distribution, so the fee collector share is then distributed
+// to stakers as usual.
+func (k Keeper) BeginBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
 	if feeCollector == nil {
 		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
 	}
 
-	burnCoins := k.bankKeeper.GetAllBalances(ctx, feeCollector)
+	k.pruneBlockBurns(ctx)
+	routing := k.routeFees(ctx, feeCollector, k.GetParams(ctx))
 
-	integerAmount := burnCoins.AmountOf(types.IntegerCoinDenom())
-	if integerAmount.IsPositive() {
-		burnCoins = burnCoins.Sub(sdk.NewCoin(types.IntegerCoinDenom(), integerAmount))
+	if !routing.communityPool.Empty() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, routing.communityPool, feeCollector); err != nil {
+			return err
+		}
 	}
+	if !routing.valRewards.Empty() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, vrtypes.ModuleName, routing.valRewards); err != nil {
+			return err
+		}
+	}
+	if routing.burn.Empty() {
+		return nil
+	}
+
+	if err := k.preciseBankKeeper.BurnCoins(ctx, authtypes.FeeCollectorName, routing.burn); err != nil {
+		return err
+	}
+	k.recordBurn(ctx, routing.burn)
+	return nil
+}
+
+// routeFees splits the fee collector balance. The EVM denom is split in whole
+// integer coins, since only x/precisebank tracks fractional amounts, and its
+// fractional remainder is burned. x/valrewards only pays out its rewards
+// denom, so the valrewards share of every other denom is burned instead.
+func (k Keeper) routeFees(ctx sdk.Context, feeCollector sdk.AccAddress, params feeburntypes.Params) feeRouting {
+	var routing feeRouting
+	balances := k.bankKeeper.GetAllBalances(ctx, feeCollector)
+
+	for _, coin := range balances {
+		if coin.Denom == types.IntegerCoinDenom() {
+			continue
+		}
+		shares := splitFees(params, coin)
+		routing.add(coin.Denom, shares)
+	}
+
+	// The integer burn share is burned below as part of the extended balance.
+	shares := splitFees(params, sdk.NewCoin(types.IntegerCoinDenom(), balances.AmountOf(types.IntegerCoinDenom())))
+	routed := shares.Routed()
+	shares.Burn = math.ZeroInt()
+	routing.add(types.IntegerCoinDenom(), shares)
 
 	extendedBalance := k.preciseBankKeeper.GetBalance(ctx, feeCollector, types.ExtendedCoinDenom())
-	if extendedBalance.Amount.IsPositive() {
-		burnCoins = burnCoins.Add(extendedBalance)
+	burnExtended := extendedBalance.Amount.Sub(routed.Mul(types.ConversionFactor()))
+	if burnExtended.IsPositive() {
+		routing.burn = routing.burn.Add(sdk.NewCoin(types.ExtendedCoinDenom(), burnExtended))
 	}
 
-	if burnCoins.Empty() {
-		return nil
+	return routing
+}
+
+// splitFees splits coin by the params ratios and burns the valrewards share
+// unless coin is in the rewards denom.
+func splitFees(params feeburntypes.Params, coin sdk.Coin) feeburntypes.FeeShares {
+	shares := params.Split(coin.Amount)
+	if coin.Denom != evmtypes.DefaultEVMDenom {
+		shares.Burn = shares.Burn.Add(shares.ValRewards)
+		shares.ValRewards = math.ZeroInt()
 	}
+	return shares
+}
 
-	return k.preciseBankKeeper.BurnCoins(ctx, authtypes.FeeCollectorName, burnCoins)
+func (r *feeRouting) add(denom string, shares feeburntypes.FeeShares) {
+	if shares.Burn.IsPositive() {
+		r.burn = r.burn.Add(sdk.NewCoin(denom, shares.Burn))
+	}
+	if shares.CommunityPool.IsPositive() {
+		r.communityPool = r.communityPool.Add(sdk.NewCoin(denom, shares.CommunityPool))
+	}
+	if shares.ValRewards.IsPositive() {
+		r.valRewards = r.valRewards.Add(sdk.NewCoin(denom, shares.ValRewards))
+	}
 }
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/x/feeburn/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	store "cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func setupKeeper(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount("cosmos", "cosmospub")
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, cmttypes.Header{}, false, log.NewNopLogger())
+	ir := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(ir)
+	authority := sdk.AccAddress([]byte("authority_addr_12345"))
+
+	k := NewKeeper(cdc, storeKey, authority, nil, nil, nil, nil)
+	return k, ctx
+}
+
+func TestGetParamsDefaultsWhenUnset(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	split := types.Params{
+		BurnRatio:          math.LegacyMustNewDecFromStr("0.5"),
+		CommunityPoolRatio: math.LegacyMustNewDecFromStr("0.1"),
+		FeeCollectorRatio:  math.LegacyMustNewDecFromStr("0.3"),
+		ValrewardsRatio:    math.LegacyMustNewDecFromStr("0.1"),
+	}
+
+	_, err := srv.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("bad_authority")).String(),
+		Params:    &split,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	invalid := split
+	invalid.BurnRatio = math.LegacyMustNewDecFromStr("0.6")
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.authority.String(), Params: &invalid})
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.authority.String(), Params: &split})
+	require.NoError(t, err)
+	require.Equal(t, split, k.GetParams(ctx))
+
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, split, res.Params)
+}
+
+func TestRecordBurn(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	burned := sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(100)), sdk.NewCoin("uother", math.NewInt(7)))
+
+	k.recordBurn(ctx.WithBlockHeight(3), burned)
+	k.recordBurn(ctx.WithBlockHeight(5), sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(50))))
+	k.recordBurn(ctx.WithBlockHeight(9), sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(25))))
+
+	require.Equal(t, math.NewInt(175), k.GetTotalBurned(ctx, "aatom"))
+	require.Equal(t, math.ZeroInt(), k.GetTotalBurned(ctx, "unknown"))
+
+	total, err := k.TotalBurned(ctx, &types.QueryTotalBurnedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(175)), sdk.NewCoin("uother", math.NewInt(7))), total.TotalBurned)
+
+	res, err := k.BurnedInRange(ctx, &types.QueryBurnedInRangeRequest{StartHeight: 4, EndHeight: 9})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(75))), res.Burned)
+	require.Len(t, res.BlockBurns, 2)
+	require.Equal(t, uint64(5), res.BlockBurns[0].Height)
+	require.Equal(t, uint64(9), res.BlockBurns[1].Height)
+
+	res, err = k.BurnedInRange(ctx, &types.QueryBurnedInRangeRequest{StartHeight: 10, EndHeight: 20})
+	require.NoError(t, err)
+	require.True(t, res.Burned.Empty())
+	require.Empty(t, res.BlockBurns)
+
+	_, err = k.BurnedInRange(ctx, &types.QueryBurnedInRangeRequest{StartHeight: 9, EndHeight: 3})
+	require.Error(t, err)
+	_, err = k.BurnedInRange(ctx, &types.QueryBurnedInRangeRequest{StartHeight: 1, EndHeight: maxBurnedInRangeBlocks + 1})
+	require.Error(t, err)
+
+	require.Len(t, k.GetAllBlockBurns(ctx), 3)
+}
+
+type fakeAccountKeeper struct{}
+
+func (fakeAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+// fakeBankKeeper holds the fee collector balance. With an 18 decimals EVM coin
+// the integer and extended denoms are the same, so it also serves the precise
+// bank calls.
+type fakeBankKeeper struct {
+	balance sdk.Coins
+	burned  sdk.Coins
+	sent    sdk.Coins
+}
+
+func (f *fakeBankKeeper) GetAllBalances(context.Context, sdk.AccAddress) sdk.Coins {
+	return f.balance
+}
+
+func (f *fakeBankKeeper) SendCoinsFromModuleToModule(_ context.Context, _, _ string, amt sdk.Coins) error {
+	f.balance = f.balance.Sub(amt...)
+	f.sent = f.sent.Add(amt...)
+	return nil
+}
+
+func (f *fakeBankKeeper) GetBalance(_ context.Context, _ sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, f.balance.AmountOf(denom))
+}
+
+func (f *fakeBankKeeper) BurnCoins(_ context.Context, _ string, amt sdk.Coins) error {
+	f.balance = f.balance.Sub(amt...)
+	f.burned = f.burned.Add(amt...)
+	return nil
+}
+
+func setupBeginBlockKeeper(t *testing.T, balance sdk.Coins) (Keeper, sdk.Context, *fakeBankKeeper) {
+	t.Helper()
+	evmtypes.SetDefaultEvmCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID])
+
+	k, ctx := setupKeeper(t)
+	bank := &fakeBankKeeper{balance: balance}
+	k.accountKeeper = fakeAccountKeeper{}
+	k.bankKeeper = bank
+	k.preciseBankKeeper = bank
+	return k, ctx, bank
+}
+
+func TestBeginBlockBurnsAndRecordsFees(t *testing.T) {
+	denom := testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID].Denom
+	k, ctx, bank := setupBeginBlockKeeper(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))))
+
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(7)))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))), bank.burned)
+	require.Equal(t, math.NewInt(100), k.GetTotalBurned(ctx, denom))
+	blockBurn, found := k.GetBlockBurn(ctx, 7)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))), blockBurn.Amount)
+
+	// An empty fee collector burns nothing and stores no record.
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(8)))
+	_, found = k.GetBlockBurn(ctx, 8)
+	require.False(t, found)
+}
+
+func TestBeginBlockRoutesOnlyRewardsDenomToValRewards(t *testing.T) {
+	k, ctx, bank := setupBeginBlockKeeper(t, sdk.NewCoins(
+		sdk.NewCoin(evmtypes.DefaultEVMDenom, math.NewInt(100)),
+		sdk.NewCoin("uother", math.NewInt(10)),
+	))
+	k.SetParams(ctx, types.Params{
+		BurnRatio:          math.LegacyMustNewDecFromStr("0.5"),
+		CommunityPoolRatio: math.LegacyZeroDec(),
+		FeeCollectorRatio:  math.LegacyZeroDec(),
+		ValrewardsRatio:    math.LegacyMustNewDecFromStr("0.5"),
+	})
+
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(7)))
+	// Only the rewards denom reaches x/valrewards, the share of the other
+	// denoms is burned with the rest.
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, math.NewInt(50))), bank.sent)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewCoin(evmtypes.DefaultEVMDenom, math.NewInt(50)),
+		sdk.NewCoin("uother", math.NewInt(10)),
+	), bank.burned)
+	require.True(t, bank.balance.IsZero())
+}
+
+func TestBeginBlockPrunesBlockBurnsOutsideRetentionWindow(t *testing.T) {
+	k, ctx, _ := setupBeginBlockKeeper(t, sdk.NewCoins())
+	burned := sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(1)))
+	for height := uint64(1); height <= types.MaxPrunedBlockBurnsPerBlock+10; height++ {
+		k.recordBurn(ctx.WithBlockHeight(int64(height)), burned)
+	}
+	height := int64(types.BlockBurnRetentionBlocks) + types.MaxPrunedBlockBurnsPerBlock + 20
+	k.recordBurn(ctx.WithBlockHeight(height), burned)
+	total := k.GetTotalBurned(ctx, "aatom")
+
+	// Every early record is outside the window, but one block prunes at most
+	// MaxPrunedBlockBurnsPerBlock of them.
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(height)))
+	blockBurns := k.GetAllBlockBurns(ctx)
+	require.Len(t, blockBurns, 11)
+	require.Equal(t, uint64(types.MaxPrunedBlockBurnsPerBlock+1), blockBurns[0].Height)
+
+	// The next block drains the rest and keeps the record inside the window.
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(height+1)))
+	blockBurns = k.GetAllBlockBurns(ctx)
+	require.Len(t, blockBurns, 1)
+	require.Equal(t, uint64(height), blockBurns[0].Height)
+	require.Equal(t, total, k.GetTotalBurned(ctx, "aatom"))
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/evm/x/feeburn/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if m.authority.String() != req.Authority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid authority")
+	}
+
+	if req.Params == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty params")
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.SetParams(ctx, *req.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
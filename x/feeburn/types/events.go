@@ -0,0 +1,9 @@
+package types
+
+// feeburn events
+const (
+	EventTypeBurnFees = "burn_fees"
+
+	AttributeKeyAmount = "amount"
+	AttributeKeyHeight = "height"
+)
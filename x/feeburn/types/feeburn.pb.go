@@ -0,0 +1,704 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/feeburn/v1/feeburn.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines how the fees collected in a block are split at the start of
+// the next block. The ratios must add up to one.
+type Params struct {
+	// burn_ratio is the share of collected fees that is burned.
+	BurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=burn_ratio,json=burnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_ratio"`
+	// community_pool_ratio is the share of collected fees that funds the
+	// distribution community pool.
+	CommunityPoolRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool_ratio,json=communityPoolRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_ratio"`
+	// fee_collector_ratio is the share of collected fees left in the fee
+	// collector for the regular x/distribution staking rewards.
+	FeeCollectorRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_collector_ratio,json=feeCollectorRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_collector_ratio"`
+	// valrewards_ratio is the share of collected fees sent to the x/valrewards
+	// rewards pool.
+	ValrewardsRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=valrewards_ratio,json=valrewardsRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"valrewards_ratio"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a8d3a7dd922556, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// BlockBurn records the fees burned in a block.
+type BlockBurn struct {
+	// height is the block height the fees were burned at.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the burned amount.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *BlockBurn) Reset()         { *m = BlockBurn{} }
+func (m *BlockBurn) String() string { return proto.CompactTextString(m) }
+func (*BlockBurn) ProtoMessage()    {}
+func (*BlockBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a8d3a7dd922556, []int{1}
+}
+func (m *BlockBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockBurn.Merge(m, src)
+}
+func (m *BlockBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockBurn proto.InternalMessageInfo
+
+func (m *BlockBurn) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockBurn) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.evm.feeburn.v1.Params")
+	proto.RegisterType((*BlockBurn)(nil), "cosmos.evm.feeburn.v1.BlockBurn")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/evm/feeburn/v1/feeburn.proto", fileDescriptor_10a8d3a7dd922556)
+}
+
+var fileDescriptor_10a8d3a7dd922556 = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x9b, 0xed, 0x52, 0xe8, 0x78, 0xd0, 0x8d, 0xab, 0xec, 0x56, 0x48, 0x97, 0x15, 0xa1,
+	0x08, 0x3b, 0x43, 0x15, 0xbd, 0x0a, 0xd9, 0x3d, 0x7a, 0x58, 0x0a, 0x5e, 0xbc, 0x84, 0xc9, 0xf4,
+	0x25, 0x19, 0x9a, 0xc9, 0x2b, 0x33, 0x93, 0x68, 0x3f, 0x84, 0xe0, 0xc1, 0x0f, 0x21, 0x9e, 0x3c,
+	0xf8, 0x21, 0xf6, 0xb8, 0x78, 0x12, 0x0f, 0xab, 0xb4, 0x07, 0xbf, 0x86, 0x24, 0x33, 0x5d, 0x0f,
+	0x1e, 0x7b, 0x49, 0x66, 0xde, 0x7b, 0xf9, 0xfd, 0x1e, 0xe4, 0x4f, 0x1e, 0x0b, 0x34, 0x0a, 0x0d,
+	0x83, 0x46, 0xb1, 0x0c, 0x20, 0xad, 0x75, 0xc5, 0x9a, 0xe9, 0xf6, 0x48, 0x97, 0x1a, 0x2d, 0x86,
+	0x0f, 0xdc, 0x10, 0x85, 0x46, 0xd1, 0x6d, 0xa7, 0x99, 0x8e, 0x0e, 0xb8, 0x92, 0x15, 0xb2, 0xee,
+	0xe9, 0x26, 0x47, 0x91, 0xc7, 0xa5, 0xdc, 0x00, 0x6b, 0xa6, 0x29, 0x58, 0x3e, 0x65, 0x02, 0xa5,
+	0x27, 0x8d, 0x8e, 0x5d, 0x3f, 0xe9, 0x6e, 0xcc, 0x63, 0x5d, 0xeb, 0x30, 0xc7, 0x1c, 0x5d, 0xbd,
+	0x3d, 0xb9, 0xea, 0xe9, 0xa7, 0x3e, 0x19, 0x5c, 0x72, 0xcd, 0x95, 0x09, 0xdf, 0x10, 0xd2, 0x9a,
+	0x13, 0xcd, 0xad, 0xc4, 0xa3, 0xe0, 0x24, 0x98, 0x0c, 0xe3, 0x97, 0x57, 0x37, 0xe3, 0xde, 0xcf,
+	0x9b, 0xf1, 0x23, 0x87, 0x32, 0xf3, 0x05, 0x95, 0xc8, 0x14, 0xb7, 0x05, 0x7d, 0x0d, 0x39, 0x17,
+	0xab, 0x0b, 0x10, 0xdf, 0xbf, 0x9d, 0x11, 0x6f, 0xba, 0x00, 0xf1, 0xf9, 0xcf, 0xd7, 0xa7, 0xc1,
+	0x6c, 0xd8, 0x92, 0x66, 0x2d, 0x28, 0x2c, 0xc8, 0xa1, 0x40, 0xa5, 0xea, 0x4a, 0xda, 0x55, 0xb2,
+	0x44, 0x2c, 0xbd, 0x60, 0x6f, 0x27, 0x41, 0x78, 0xcb, 0xbc, 0x44, 0x2c, 0x9d, 0x29, 0x23, 0xf7,
+	0x33, 0x80, 0x44, 0x60, 0x59, 0x82, 0xb0, 0xa8, 0xbd, 0xa8, 0xbf, 0x93, 0xe8, 0x20, 0x03, 0x38,
+	0xdf, 0x12, 0x9d, 0x87, 0x93, 0x7b, 0x0d, 0x2f, 0x35, 0xbc, 0xe3, 0x7a, 0x6e, 0xbc, 0x64, 0x7f,
+	0x27, 0xc9, 0xdd, 0x7f, 0xbc, 0x4e, 0x71, 0xfa, 0x21, 0x20, 0xc3, 0xb8, 0x44, 0xb1, 0x88, 0x6b,
+	0x5d, 0x85, 0x0f, 0xc9, 0xa0, 0x00, 0x99, 0x17, 0xb6, 0xfb, 0x2b, 0xfb, 0x33, 0x7f, 0x0b, 0x0b,
+	0x32, 0xe0, 0x0a, 0xeb, 0xca, 0x1e, 0xed, 0x9d, 0xf4, 0x27, 0x77, 0x9e, 0x1d, 0x53, 0x0f, 0x6e,
+	0xe3, 0x41, 0x7d, 0x3c, 0xe8, 0x39, 0xca, 0x2a, 0x7e, 0xd1, 0x6e, 0xf6, 0xe5, 0xd7, 0x78, 0x92,
+	0x4b, 0x5b, 0xd4, 0x29, 0x15, 0xa8, 0x7c, 0x3c, 0xfc, 0xeb, 0xcc, 0xcc, 0x17, 0xcc, 0xae, 0x96,
+	0x60, 0xba, 0x0f, 0x8c, 0x5b, 0xcc, 0xf3, 0xe3, 0x57, 0x57, 0xeb, 0x28, 0xb8, 0x5e, 0x47, 0xc1,
+	0xef, 0x75, 0x14, 0x7c, 0xdc, 0x44, 0xbd, 0xeb, 0x4d, 0xd4, 0xfb, 0xb1, 0x89, 0x7a, 0x6f, 0x9f,
+	0xfc, 0x0f, 0x6c, 0xb3, 0xfe, 0xfe, 0x36, 0xed, 0x1d, 0x33, 0x1d, 0x74, 0x71, 0x7b, 0xfe, 0x77,
+	0x00, 0xa6, 0xcb, 0xf1, 0xaa, 0x10, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValrewardsRatio.Size()
+		i -= size
+		if _, err := m.ValrewardsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeburn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeeCollectorRatio.Size()
+		i -= size
+		if _, err := m.FeeCollectorRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeburn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPoolRatio.Size()
+		i -= size
+		if _, err := m.CommunityPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeburn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeburn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeburn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeeburn(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeburn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeburn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovFeeburn(uint64(l))
+	l = m.CommunityPoolRatio.Size()
+	n += 1 + l + sovFeeburn(uint64(l))
+	l = m.FeeCollectorRatio.Size()
+	n += 1 + l + sovFeeburn(uint64(l))
+	l = m.ValrewardsRatio.Size()
+	n += 1 + l + sovFeeburn(uint64(l))
+	return n
+}
+
+func (m *BlockBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeeburn(uint64(m.Height))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFeeburn(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeeburn(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeburn(x uint64) (n int) {
+	return sovFeeburn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeburn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollectorRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValrewardsRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValrewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeburn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeburn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeburn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeburn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeburn(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeburn
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeburn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeburn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeburn
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeburn
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeburn
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeburn        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeburn          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeburn = fmt.Errorf("proto: unexpected end of group")
+)
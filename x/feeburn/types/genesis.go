@@ -0,0 +1,45 @@
+package types
+
+import "fmt"
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:     DefaultParams(),
+		BlockBurns: []BlockBurn{},
+	}
+}
+
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.TotalBurned.Validate(); err != nil {
+		return fmt.Errorf("invalid total_burned: %w", err)
+	}
+
+	var lastHeight uint64
+	for i, b := range gs.BlockBurns {
+		if i > 0 && b.Height <= lastHeight {
+			return fmt.Errorf("block_burns must be sorted by increasing height, got %d after %d", b.Height, lastHeight)
+		}
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		lastHeight = b.Height
+	}
+	return nil
+}
+
+// Validate checks that the record has a height and a positive amount.
+func (b BlockBurn) Validate() error {
+	if b.Height == 0 {
+		return fmt.Errorf("block burn height cannot be zero")
+	}
+	if err := b.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid block burn amount at height %d: %w", b.Height, err)
+	}
+	if b.Amount.Empty() {
+		return fmt.Errorf("block burn amount at height %d cannot be empty", b.Height)
+	}
+	return nil
+}
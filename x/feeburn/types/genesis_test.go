@@ -0,0 +1,94 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	burned := sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(100)))
+
+	testCases := []struct {
+		name        string
+		genesis     GenesisState
+		expectError bool
+	}{
+		{
+			name:    "default",
+			genesis: *DefaultGenesisState(),
+		},
+		{
+			name: "with burn history",
+			genesis: GenesisState{
+				Params:      DefaultParams(),
+				TotalBurned: burned,
+				BlockBurns: []BlockBurn{
+					{Height: 1, Amount: burned},
+					{Height: 5, Amount: burned},
+				},
+			},
+		},
+		{
+			name: "invalid total burned",
+			genesis: GenesisState{
+				Params:      DefaultParams(),
+				TotalBurned: sdk.Coins{{Denom: "aatom", Amount: math.NewInt(-1)}},
+			},
+			expectError: true,
+		},
+		{
+			name: "unsorted block burns",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				BlockBurns: []BlockBurn{
+					{Height: 5, Amount: burned},
+					{Height: 1, Amount: burned},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate block burn height",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				BlockBurns: []BlockBurn{
+					{Height: 5, Amount: burned},
+					{Height: 5, Amount: burned},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "zero block burn height",
+			genesis: GenesisState{
+				Params:     DefaultParams(),
+				BlockBurns: []BlockBurn{{Height: 0, Amount: burned}},
+			},
+			expectError: true,
+		},
+		{
+			name: "empty block burn amount",
+			genesis: GenesisState{
+				Params:     DefaultParams(),
+				BlockBurns: []BlockBurn{{Height: 1}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
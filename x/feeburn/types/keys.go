@@ -1,3 +1,43 @@
 package types
 
-const ModuleName = "feeburn"
+import "encoding/binary"
+
+const (
+	ModuleName = "feeburn"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+)
+
+const (
+	prefixParams = iota + 1
+	prefixTotalBurned
+	prefixBlockBurn
+)
+
+const (
+	// BlockBurnRetentionBlocks is how many of the latest heights keep their
+	// burn record. Older records are pruned; the running totals cover them.
+	BlockBurnRetentionBlocks uint64 = 100_000
+
+	// MaxPrunedBlockBurnsPerBlock bounds the records pruned in one block.
+	MaxPrunedBlockBurnsPerBlock = 100
+)
+
+var (
+	KeyParams            = []byte{prefixParams}
+	KeyPrefixTotalBurned = []byte{prefixTotalBurned}
+	KeyPrefixBlockBurn   = []byte{prefixBlockBurn}
+)
+
+// GetTotalBurnedKey returns the key of the cumulative burned amount of a denom.
+func GetTotalBurnedKey(denom string) []byte {
+	return append(append([]byte{}, KeyPrefixTotalBurned...), denom...)
+}
+
+// GetBlockBurnKey returns the key of the burn record of a block. Heights are
+// big-endian so records iterate in height order.
+func GetBlockBurnKey(height uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, KeyPrefixBlockBurn...), height)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultParams burns every collected fee, which was the behavior before the
+// split became configurable.
+func DefaultParams() Params {
+	return Params{
+		BurnRatio:          math.LegacyOneDec(),
+		CommunityPoolRatio: math.LegacyZeroDec(),
+		FeeCollectorRatio:  math.LegacyZeroDec(),
+		ValrewardsRatio:    math.LegacyZeroDec(),
+	}
+}
+
+func (p Params) Validate() error {
+	ratios := []struct {
+		name  string
+		ratio math.LegacyDec
+	}{
+		{"burn_ratio", p.BurnRatio},
+		{"community_pool_ratio", p.CommunityPoolRatio},
+		{"fee_collector_ratio", p.FeeCollectorRatio},
+		{"valrewards_ratio", p.ValrewardsRatio},
+	}
+
+	total := math.LegacyZeroDec()
+	for _, r := range ratios {
+		if r.ratio.IsNil() {
+			return fmt.Errorf("%s cannot be empty", r.name)
+		}
+		if r.ratio.IsNegative() || r.ratio.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("%s must be between 0 and 1, got %s", r.name, r.ratio)
+		}
+		total = total.Add(r.ratio)
+	}
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("fee ratios must add up to 1, got %s", total)
+	}
+	return nil
+}
+
+// FeeShares is how an amount of collected fees is split between destinations.
+type FeeShares struct {
+	Burn          math.Int
+	CommunityPool math.Int
+	FeeCollector  math.Int
+	ValRewards    math.Int
+}
+
+// Split divides amount by the ratios. The shares routed elsewhere are rounded
+// down and the burn share takes the remainder, so nothing is left unassigned.
+func (p Params) Split(amount math.Int) FeeShares {
+	shares := FeeShares{
+		CommunityPool: p.CommunityPoolRatio.MulInt(amount).TruncateInt(),
+		FeeCollector:  p.FeeCollectorRatio.MulInt(amount).TruncateInt(),
+		ValRewards:    p.ValrewardsRatio.MulInt(amount).TruncateInt(),
+	}
+	shares.Burn = amount.Sub(shares.Routed())
+	return shares
+}
+
+// Routed returns the part of the fees that is not burned.
+func (s FeeShares) Routed() math.Int {
+	return s.CommunityPool.Add(s.FeeCollector).Add(s.ValRewards)
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestParamsValidate(t *testing.T) {
+	dec := math.LegacyMustNewDecFromStr
+
+	testCases := []struct {
+		name        string
+		params      Params
+		expectError bool
+	}{
+		{
+			name:   "default burns everything",
+			params: DefaultParams(),
+		},
+		{
+			name: "split across all destinations",
+			params: Params{
+				BurnRatio:          dec("0.4"),
+				CommunityPoolRatio: dec("0.1"),
+				FeeCollectorRatio:  dec("0.3"),
+				ValrewardsRatio:    dec("0.2"),
+			},
+		},
+		{
+			name: "ratios below one",
+			params: Params{
+				BurnRatio:          dec("0.4"),
+				CommunityPoolRatio: dec("0.1"),
+				FeeCollectorRatio:  dec("0.3"),
+				ValrewardsRatio:    dec("0.1"),
+			},
+			expectError: true,
+		},
+		{
+			name: "negative ratio",
+			params: Params{
+				BurnRatio:          dec("1.1"),
+				CommunityPoolRatio: dec("-0.1"),
+				FeeCollectorRatio:  math.LegacyZeroDec(),
+				ValrewardsRatio:    math.LegacyZeroDec(),
+			},
+			expectError: true,
+		},
+		{
+			name: "unset ratio",
+			params: Params{
+				BurnRatio:          math.LegacyOneDec(),
+				CommunityPoolRatio: math.LegacyZeroDec(),
+				FeeCollectorRatio:  math.LegacyZeroDec(),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsSplit(t *testing.T) {
+	params := Params{
+		BurnRatio:          math.LegacyMustNewDecFromStr("0.25"),
+		CommunityPoolRatio: math.LegacyMustNewDecFromStr("0.25"),
+		FeeCollectorRatio:  math.LegacyMustNewDecFromStr("0.25"),
+		ValrewardsRatio:    math.LegacyMustNewDecFromStr("0.25"),
+	}
+
+	shares := params.Split(math.NewInt(11))
+	require.Equal(t, int64(2), shares.CommunityPool.Int64())
+	require.Equal(t, int64(2), shares.FeeCollector.Int64())
+	require.Equal(t, int64(2), shares.ValRewards.Int64())
+	require.Equal(t, int64(5), shares.Burn.Int64(), "the burn share takes the rounding remainder")
+	require.Equal(t, int64(6), shares.Routed().Int64())
+
+	shares = DefaultParams().Split(math.NewInt(11))
+	require.Equal(t, int64(11), shares.Burn.Int64())
+	require.True(t, shares.Routed().IsZero())
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	frostgrouptypes "github.com/cosmos/evm/x/frostgroup/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        frostgrouptypes.ModuleName,
+		Short:                      "FROST threshold group query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryGroup(),
+		GetCmdQueryGroups(),
+		GetCmdQueryDkgSession(),
+	)
+	return cmd
+}
+
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the roster size limit and the key generation timeout",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := frostgrouptypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &frostgrouptypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group [GROUP_ID]",
+		Short: "Query a group, its roster and its key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := frostgrouptypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Group(context.Background(), &frostgrouptypes.QueryGroupRequest{GroupId: groupID})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryGroups() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "Query the registered groups",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := frostgrouptypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Groups(context.Background(), &frostgrouptypes.QueryGroupsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "groups")
+	return cmd
+}
+
+func GetCmdQueryDkgSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dkg-session [GROUP_ID]",
+		Short: "Query the key generation in progress for a group, including the dealers' commitments",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := frostgrouptypes.NewQueryClient(clientCtx)
+			res, err := queryClient.DkgSession(context.Background(), &frostgrouptypes.QueryDkgSessionRequest{GroupId: groupID})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
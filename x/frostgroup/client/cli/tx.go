@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/frostgroup/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "frostgroup subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateGroupCmd(),
+		NewSubmitDkgCommitmentCmd(),
+		NewConfirmDkgCmd(),
+		NewRotateGroupKeyCmd(),
+		NewReshareGroupCmd(),
+		NewCancelDkgCmd(),
+	)
+	return txCmd
+}
+
+func NewCreateGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [CIPHERSUITE] [THRESHOLD] [PARTICIPANTS]",
+		Short: "Register a group and start the generation of its key",
+		Long: `Register a group of comma-separated participants, in identifier order, that
+signs with THRESHOLD of them. CIPHERSUITE is the FROST ciphersuite id. The
+sender administers the group.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ciphersuite, err := strconv.ParseUint(args[0], 10, 8)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 16)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateGroup{
+				Admin:        clientCtx.GetFromAddress().String(),
+				Ciphersuite:  uint32(ciphersuite),
+				Threshold:    uint32(threshold),
+				Participants: strings.Split(args[2], ","),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSubmitDkgCommitmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dkg-commitment [GROUP_ID] [ROUND1_DATA]",
+		Short: "Submit the 0x-prefixed encoded dkg.Round1Data of the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			data, err := hexutil.Decode(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitDkgCommitment{
+				Dealer:  clientCtx.GetFromAddress().String(),
+				GroupId: groupID,
+				Data:    data,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewConfirmDkgCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-dkg [GROUP_ID] [PUBLIC_KEY_SHARE]",
+		Short: "Confirm the 0x-prefixed encoded public key share of the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			share, err := hexutil.Decode(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgConfirmDkg{
+				Participant:    clientCtx.GetFromAddress().String(),
+				GroupId:        groupID,
+				PublicKeyShare: share,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRotateGroupKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key [GROUP_ID]",
+		Short: "Start the generation of a fresh key for a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRotateGroupKey{
+				Admin:   clientCtx.GetFromAddress().String(),
+				GroupId: groupID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewReshareGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reshare [GROUP_ID] [THRESHOLD] [PARTICIPANTS]",
+		Short: "Start the redistribution of a group key to new comma-separated participants",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 16)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReshareGroup{
+				Admin:        clientCtx.GetFromAddress().String(),
+				GroupId:      groupID,
+				Threshold:    uint32(threshold),
+				Participants: strings.Split(args[2], ","),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCancelDkgCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-dkg [GROUP_ID]",
+		Short: "Drop the key generation in progress for a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelDkg{
+				Admin:   clientCtx.GetFromAddress().String(),
+				GroupId: groupID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
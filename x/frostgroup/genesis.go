@@ -0,0 +1,28 @@
+package frostgroup
+
+import (
+	"github.com/cosmos/evm/x/frostgroup/keeper"
+	"github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetNextGroupID(ctx, data.NextGroupId)
+	for _, group := range data.Groups {
+		k.SetGroup(ctx, group)
+	}
+	for _, session := range data.Sessions {
+		k.SetDkgSession(ctx, session)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		Groups:      k.GetAllGroups(ctx),
+		Sessions:    k.GetAllDkgSessions(ctx),
+		NextGroupId: k.GetNextGroupID(ctx),
+	}
+}
@@ -0,0 +1,341 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateGroup registers a group administered by admin and starts the
+// generation of its key. The group has no key until every participant has
+// confirmed.
+func (k Keeper) CreateGroup(
+	ctx sdk.Context,
+	admin sdk.AccAddress,
+	ciphersuite uint32,
+	threshold uint32,
+	participants []string,
+) (types.Group, error) {
+	if _, err := types.Ciphersuite(ciphersuite); err != nil {
+		return types.Group{}, types.ErrInvalidGroup.Wrap(err.Error())
+	}
+	roster, err := k.validateRoster(ctx, threshold, participants)
+	if err != nil {
+		return types.Group{}, err
+	}
+
+	id := k.GetNextGroupID(ctx)
+	k.SetNextGroupID(ctx, id+1)
+	group := types.Group{
+		Id:           id,
+		Admin:        admin.String(),
+		Ciphersuite:  ciphersuite,
+		Threshold:    threshold,
+		Participants: roster,
+	}
+	k.SetGroup(ctx, group)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAdmin, group.Admin),
+			sdk.NewAttribute(types.AttributeKeyCiphersuite, strconv.FormatUint(uint64(ciphersuite), 10)),
+		),
+	)
+
+	if _, err := k.startDkg(ctx, group, types.DkgKindKeygen, threshold, roster); err != nil {
+		return types.Group{}, err
+	}
+	return group, nil
+}
+
+// RotateGroupKey starts the generation of a fresh key for the current roster
+// of a group. The current key stays in use until the new one is confirmed.
+// It also restarts the first key generation of a group that failed.
+func (k Keeper) RotateGroupKey(ctx sdk.Context, admin sdk.AccAddress, groupID uint64) (types.DkgSession, error) {
+	group, err := k.authorize(ctx, admin, groupID)
+	if err != nil {
+		return types.DkgSession{}, err
+	}
+	return k.startDkg(ctx, group, types.DkgKindKeygen, group.Threshold, group.Participants)
+}
+
+// ReshareGroup starts the redistribution of the current key of a group to a
+// new roster and threshold. The group key does not change, so signatures
+// keep verifying against it.
+func (k Keeper) ReshareGroup(
+	ctx sdk.Context,
+	admin sdk.AccAddress,
+	groupID uint64,
+	threshold uint32,
+	participants []string,
+) (types.DkgSession, error) {
+	group, err := k.authorize(ctx, admin, groupID)
+	if err != nil {
+		return types.DkgSession{}, err
+	}
+	if group.KeyVersion == 0 {
+		return types.DkgSession{}, types.ErrGroupKeyNotSet.Wrapf("group %d", groupID)
+	}
+	roster, err := k.validateRoster(ctx, threshold, participants)
+	if err != nil {
+		return types.DkgSession{}, err
+	}
+	return k.startDkg(ctx, group, types.DkgKindReshare, threshold, roster)
+}
+
+// CancelDkg drops the key generation in progress for a group.
+func (k Keeper) CancelDkg(ctx sdk.Context, admin sdk.AccAddress, groupID uint64) error {
+	if _, err := k.authorize(ctx, admin, groupID); err != nil {
+		return err
+	}
+	if _, found := k.GetDkgSession(ctx, groupID); !found {
+		return types.ErrDkgNotFound.Wrapf("group %d", groupID)
+	}
+	k.DeleteDkgSession(ctx, groupID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelDkg,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+		),
+	)
+	return nil
+}
+
+// SubmitDkgCommitment records the verified first round message of a dealer.
+// The second round, in which dealers send secret shares to each participant,
+// happens off-chain. Once the expected dealers have committed, the session
+// stores the aggregate commitment the participants' public key shares are
+// checked against.
+func (k Keeper) SubmitDkgCommitment(ctx sdk.Context, dealer sdk.AccAddress, groupID uint64, data []byte) error {
+	group, session, err := k.activeSession(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	required := session.RequiredCommitments(group)
+	if len(session.Commitments) >= required {
+		return types.ErrWrongRound.Wrap("all the commitments were received")
+	}
+
+	id := types.Identifier(session.Dealers(group), dealer.String())
+	if id == 0 {
+		return types.ErrNotParticipant.Wrapf("%s is not a dealer", dealer)
+	}
+	for _, c := range session.Commitments {
+		if c.Dealer == uint32(id) {
+			return types.ErrInvalidCommitment.Wrapf("dealer %d already committed", id)
+		}
+	}
+
+	ctx.GasMeter().ConsumeGas(
+		types.GasDkgCommitmentProof+types.GasPerCommitmentCoefficient*uint64(session.Threshold),
+		"frostgroup dkg commitment",
+	)
+	r1, err := types.VerifyDkgCommitment(group.Ciphersuite, id, session.Threshold, data)
+	if err != nil {
+		return err
+	}
+	if session.Kind == types.DkgKindReshare && !bytes.Equal(r1.Commitment[0].Encode(), group.PublicKeyShares[id-1]) {
+		return types.ErrInvalidCommitment.Wrapf("dealer %d does not reshare its key share", id)
+	}
+
+	session.Commitments = append(session.Commitments, types.DkgCommitment{Dealer: uint32(id), Data: data})
+	if len(session.Commitments) == required {
+		perCoefficient := uint64(types.GasPerCommitmentCoefficient)
+		if session.Kind == types.DkgKindReshare {
+			perCoefficient = types.GasPerResharedCoefficient
+		}
+		ctx.GasMeter().ConsumeGas(
+			perCoefficient*uint64(len(session.Commitments))*uint64(session.Threshold),
+			"frostgroup aggregate dkg commitments",
+		)
+		aggregate, err := types.AggregateCommitment(group.Ciphersuite, session.Kind, session.Threshold, session.Commitments)
+		if err != nil {
+			return err
+		}
+		if session.Kind == types.DkgKindReshare && !bytes.Equal(aggregate[0], group.VerificationKey) {
+			return types.ErrInvalidCommitment.Wrap("reshared commitments do not match the group key")
+		}
+		session.AggregateCommitment = aggregate
+	}
+	k.SetDkgSession(ctx, session)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDkgCommitment,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDealer, dealer.String()),
+		),
+	)
+	return nil
+}
+
+// ConfirmDkg records the public key share a participant derived from the
+// secret shares it received, after checking it against the aggregate
+// commitment. A participant that received an invalid share does not confirm,
+// and the admin restarts the session. The last confirmation installs the new
+// key and roster in the group and returns true.
+func (k Keeper) ConfirmDkg(ctx sdk.Context, participant sdk.AccAddress, groupID uint64, publicKeyShare []byte) (bool, error) {
+	group, session, err := k.activeSession(ctx, groupID)
+	if err != nil {
+		return false, err
+	}
+	if len(session.AggregateCommitment) == 0 {
+		return false, types.ErrWrongRound.Wrapf(
+			"waiting for %d more commitments", session.RequiredCommitments(group)-len(session.Commitments),
+		)
+	}
+
+	id := types.Identifier(session.Participants, participant.String())
+	if id == 0 {
+		return false, types.ErrNotParticipant.Wrapf("%s is not a participant", participant)
+	}
+	for _, c := range session.Confirmations {
+		if c.Participant == uint32(id) {
+			return false, types.ErrInvalidConfirmation.Wrapf("participant %d already confirmed", id)
+		}
+	}
+
+	ctx.GasMeter().ConsumeGas(
+		types.GasConfirmDkgPerCoefficient*uint64(len(session.AggregateCommitment)),
+		"frostgroup confirm dkg",
+	)
+	if err := types.VerifyPublicKeyShare(group.Ciphersuite, id, session.AggregateCommitment, publicKeyShare); err != nil {
+		return false, err
+	}
+	// Store the share in its canonical encoding, which resharing dealers'
+	// commitments are compared to.
+	share, err := types.DecodeElement(group.Ciphersuite, publicKeyShare)
+	if err != nil {
+		return false, types.ErrInvalidConfirmation.Wrap(err.Error())
+	}
+	session.Confirmations = append(session.Confirmations, types.DkgConfirmation{
+		Participant:    uint32(id),
+		PublicKeyShare: share.Encode(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConfirmDkg,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+			sdk.NewAttribute(types.AttributeKeyParticipant, participant.String()),
+		),
+	)
+
+	if len(session.Confirmations) < len(session.Participants) {
+		k.SetDkgSession(ctx, session)
+		return false, nil
+	}
+	k.completeDkg(ctx, group, session)
+	return true, nil
+}
+
+func (k Keeper) completeDkg(ctx sdk.Context, group types.Group, session types.DkgSession) {
+	shares := make([][]byte, len(session.Participants))
+	for _, c := range session.Confirmations {
+		shares[c.Participant-1] = c.PublicKeyShare
+	}
+
+	group.Threshold = session.Threshold
+	group.Participants = session.Participants
+	group.VerificationKey = session.AggregateCommitment[0]
+	group.PublicKeyShares = shares
+	group.KeyVersion++
+	k.SetGroup(ctx, group)
+	k.DeleteDkgSession(ctx, group.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteDkg,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(group.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyKind, session.Kind.String()),
+			sdk.NewAttribute(types.AttributeKeyKeyVersion, strconv.FormatUint(group.KeyVersion, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerificationKey, hexutil.Encode(group.VerificationKey)),
+		),
+	)
+}
+
+// startDkg opens a session for a group. An expired session is replaced,
+// while one that can still progress must be cancelled first.
+func (k Keeper) startDkg(
+	ctx sdk.Context,
+	group types.Group,
+	kind types.DkgKind,
+	threshold uint32,
+	participants []string,
+) (types.DkgSession, error) {
+	if existing, found := k.GetDkgSession(ctx, group.Id); found && ctx.BlockHeight() <= existing.DeadlineHeight {
+		return types.DkgSession{}, types.ErrDkgInProgress.Wrapf("group %d until height %d", group.Id, existing.DeadlineHeight)
+	}
+
+	params := k.GetParams(ctx)
+	session := types.DkgSession{
+		GroupId:        group.Id,
+		Kind:           kind,
+		Threshold:      threshold,
+		Participants:   participants,
+		DeadlineHeight: ctx.BlockHeight() + int64(params.DkgTimeoutBlocks), //nolint:gosec // G115 // timeout is a small block count
+	}
+	k.SetDkgSession(ctx, session)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStartDkg,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(group.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyKind, kind.String()),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(threshold), 10)),
+			sdk.NewAttribute(types.AttributeKeyDeadlineHeight, strconv.FormatInt(session.DeadlineHeight, 10)),
+		),
+	)
+	return session, nil
+}
+
+// activeSession returns a group and its session, which must not have
+// expired.
+func (k Keeper) activeSession(ctx sdk.Context, groupID uint64) (types.Group, types.DkgSession, error) {
+	group, found := k.GetGroup(ctx, groupID)
+	if !found {
+		return types.Group{}, types.DkgSession{}, types.ErrGroupNotFound.Wrapf("%d", groupID)
+	}
+	session, found := k.GetDkgSession(ctx, groupID)
+	if !found {
+		return types.Group{}, types.DkgSession{}, types.ErrDkgNotFound.Wrapf("group %d", groupID)
+	}
+	if ctx.BlockHeight() > session.DeadlineHeight {
+		return types.Group{}, types.DkgSession{}, types.ErrDkgExpired.Wrapf("group %d at height %d", groupID, session.DeadlineHeight)
+	}
+	return group, session, nil
+}
+
+func (k Keeper) authorize(ctx sdk.Context, admin sdk.AccAddress, groupID uint64) (types.Group, error) {
+	group, found := k.GetGroup(ctx, groupID)
+	if !found {
+		return types.Group{}, types.ErrGroupNotFound.Wrapf("%d", groupID)
+	}
+	if group.Admin != admin.String() {
+		return types.Group{}, types.ErrUnauthorized.Wrapf("%s is not the admin of group %d", admin, groupID)
+	}
+	return group, nil
+}
+
+// validateRoster checks a roster against the params and returns it with the
+// canonical encoding of each account, which signers are matched against.
+func (k Keeper) validateRoster(ctx sdk.Context, threshold uint32, participants []string) ([]string, error) {
+	params := k.GetParams(ctx)
+	if err := types.ValidateRoster(threshold, participants, params.MaxParticipants); err != nil {
+		return nil, types.ErrInvalidGroup.Wrap(err.Error())
+	}
+
+	roster := make([]string, len(participants))
+	for i, p := range participants {
+		roster[i] = sdk.MustAccAddressFromBech32(p).String()
+	}
+	return roster, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) Group(c context.Context, req *types.QueryGroupRequest) (*types.QueryGroupResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	group, found := k.GetGroup(ctx, req.GroupId)
+	if !found {
+		return nil, types.ErrGroupNotFound.Wrapf("%d", req.GroupId)
+	}
+	return &types.QueryGroupResponse{Group: group}, nil
+}
+
+func (k Keeper) Groups(c context.Context, req *types.QueryGroupsRequest) (*types.QueryGroupsResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGroup)
+
+	groups := []types.Group{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var group types.Group
+		if err := k.cdc.Unmarshal(value, &group); err != nil {
+			return err
+		}
+		groups = append(groups, group)
+		return nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryGroupsResponse{
+		Groups:     groups,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) DkgSession(c context.Context, req *types.QueryDkgSessionRequest) (*types.QueryDkgSessionResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	session, found := k.GetDkgSession(ctx, req.GroupId)
+	if !found {
+		return nil, types.ErrDkgNotFound.Wrapf("group %d", req.GroupId)
+	}
+	return &types.QueryDkgSessionResponse{Session: session}, nil
+}
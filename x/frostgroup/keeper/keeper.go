@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/frostgroup/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Keeper struct {
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	authority sdk.AccAddress
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
+) Keeper {
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err) //nolint:halt // Constructor guard: frostgroup authority wiring must be valid at boot.
+	}
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetAuthority returns the governance account, which can update the params.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyParams)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyParams, k.cdc.MustMarshal(&params))
+}
+
+// GetNextGroupID returns the id assigned to the next created group. Ids
+// start at 1.
+func (k Keeper) GetNextGroupID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextGroupID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextGroupID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextGroupID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetGroup returns the registered group with the given id.
+func (k Keeper) GetGroup(ctx sdk.Context, id uint64) (types.Group, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGroupKey(id))
+	if bz == nil {
+		return types.Group{}, false
+	}
+
+	var group types.Group
+	k.cdc.MustUnmarshal(bz, &group)
+	return group, true
+}
+
+func (k Keeper) SetGroup(ctx sdk.Context, group types.Group) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGroupKey(group.Id), k.cdc.MustMarshal(&group))
+}
+
+// IterateGroups walks the registered groups in id order.
+func (k Keeper) IterateGroups(ctx sdk.Context, cb func(group types.Group) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixGroup)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var group types.Group
+		k.cdc.MustUnmarshal(iterator.Value(), &group)
+		if cb(group) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAllGroups(ctx sdk.Context) []types.Group {
+	groups := []types.Group{}
+	k.IterateGroups(ctx, func(group types.Group) bool {
+		groups = append(groups, group)
+		return false
+	})
+	return groups
+}
+
+// GetDkgSession returns the key generation in progress for a group, which
+// may have expired.
+func (k Keeper) GetDkgSession(ctx sdk.Context, groupID uint64) (types.DkgSession, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDkgSessionKey(groupID))
+	if bz == nil {
+		return types.DkgSession{}, false
+	}
+
+	var session types.DkgSession
+	k.cdc.MustUnmarshal(bz, &session)
+	return session, true
+}
+
+func (k Keeper) SetDkgSession(ctx sdk.Context, session types.DkgSession) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDkgSessionKey(session.GroupId), k.cdc.MustMarshal(&session))
+}
+
+func (k Keeper) DeleteDkgSession(ctx sdk.Context, groupID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDkgSessionKey(groupID))
+}
+
+func (k Keeper) GetAllDkgSessions(ctx sdk.Context) []types.DkgSession {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixDkgSession)
+	defer iterator.Close()
+
+	sessions := []types.DkgSession{}
+	for ; iterator.Valid(); iterator.Next() {
+		var session types.DkgSession
+		k.cdc.MustUnmarshal(iterator.Value(), &session)
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+// VerifyGroupSignature checks a FROST signature of message against the
+// current key of a group. It returns ErrSignatureVerification when a
+// well-formed signature does not verify.
+func (k Keeper) VerifyGroupSignature(ctx sdk.Context, groupID uint64, message, signature []byte) error {
+	group, found := k.GetGroup(ctx, groupID)
+	if !found {
+		return types.ErrGroupNotFound.Wrapf("%d", groupID)
+	}
+	return group.VerifySignature(message, signature)
+}
@@ -0,0 +1,464 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/frost"
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/secret-sharing/keys"
+	"github.com/cosmos/evm/x/frostgroup/testutil"
+	"github.com/cosmos/evm/x/frostgroup/types"
+
+	"cosmossdk.io/log"
+	store "cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var message = []byte("bridge withdrawal #42")
+
+func setupKeeper(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount("cosmos", "cosmospub")
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, cmttypes.Header{Height: 1}, false, log.NewNopLogger())
+	ir := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(ir)
+	authority := sdk.AccAddress([]byte("authority_addr_12345"))
+
+	return NewKeeper(cdc, storeKey, authority), ctx
+}
+
+func accounts(prefix string, n int) []sdk.AccAddress {
+	out := make([]sdk.AccAddress, n)
+	for i := range out {
+		out[i] = sdk.AccAddress(fmt.Sprintf("%s_%014d", prefix, i))
+	}
+	return out
+}
+
+func roster(accs []sdk.AccAddress) []string {
+	out := make([]string, len(accs))
+	for i, a := range accs {
+		out[i] = a.String()
+	}
+	return out
+}
+
+// runSession submits the commitments of dealers and the confirmations of
+// participants for the session in progress.
+func runSession(
+	t *testing.T,
+	k Keeper,
+	ctx sdk.Context,
+	groupID uint64,
+	dealers []sdk.AccAddress,
+	round1 [][]byte,
+	participants []sdk.AccAddress,
+	shares []*keys.KeyShare,
+) {
+	t.Helper()
+	for i, dealer := range dealers {
+		require.NoError(t, k.SubmitDkgCommitment(ctx, dealer, groupID, round1[i]))
+	}
+	for i, p := range participants {
+		completed, err := k.ConfirmDkg(ctx, p, groupID, shares[i].PublicKey.Encode())
+		require.NoError(t, err)
+		require.Equal(t, i == len(participants)-1, completed)
+	}
+}
+
+func createGroup(t *testing.T, k Keeper, ctx sdk.Context, ciphersuite uint32, threshold uint16, members []sdk.AccAddress) (types.Group, []*keys.KeyShare) {
+	t.Helper()
+	admin := sdk.AccAddress([]byte("admin_addr_123456789"))
+	group, err := k.CreateGroup(ctx, admin, ciphersuite, uint32(threshold), roster(members))
+	require.NoError(t, err)
+
+	round1, shares, err := testutil.Keygen(ciphersuite, threshold, uint16(len(members))) //nolint:gosec // G115 // test rosters are small
+	require.NoError(t, err)
+	runSession(t, k, ctx, group.Id, members, round1, members, shares)
+
+	group, found := k.GetGroup(ctx, group.Id)
+	require.True(t, found)
+	return group, shares
+}
+
+func TestGetParamsDefaultsWhenUnset(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	params := types.Params{MaxParticipants: 10, DkgTimeoutBlocks: 50}
+
+	_, err := srv.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("bad_authority")).String(),
+		Params:    &params,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	invalid := params
+	invalid.DkgTimeoutBlocks = 0
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.authority.String(), Params: &invalid})
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.authority.String(), Params: &params})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
+
+func TestCreateGroupInvalid(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetParams(ctx, types.Params{MaxParticipants: 3, DkgTimeoutBlocks: 10})
+	admin := sdk.AccAddress([]byte("admin_addr_123456789"))
+	members := roster(accounts("member", 4))
+
+	testCases := []struct {
+		name         string
+		ciphersuite  uint32
+		threshold    uint32
+		participants []string
+	}{
+		{"unsupported ciphersuite", 2, 2, members[:3]},
+		{"ciphersuite out of range", 256 + uint32(frost.Ed25519), 2, members[:3]},
+		{"over max participants", uint32(frost.Ed25519), 2, members},
+		{"single participant", uint32(frost.Ed25519), 1, members[:1]},
+		{"zero threshold", uint32(frost.Ed25519), 0, members[:3]},
+		{"threshold over roster", uint32(frost.Ed25519), 4, members[:3]},
+		{"duplicate participant", uint32(frost.Ed25519), 2, []string{members[0], members[1], members[0]}},
+		{"invalid participant", uint32(frost.Ed25519), 2, []string{members[0], "cosmos1invalid"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := k.CreateGroup(ctx, admin, tc.ciphersuite, tc.threshold, tc.participants)
+			require.ErrorIs(t, err, types.ErrInvalidGroup)
+			require.Empty(t, k.GetAllGroups(ctx))
+		})
+	}
+}
+
+func TestKeygen(t *testing.T) {
+	ciphersuites := []frost.Ciphersuite{frost.Ed25519, frost.Ristretto255, frost.P256, frost.P384, frost.P521, frost.Secp256k1}
+	for _, cs := range ciphersuites {
+		t.Run(fmt.Sprintf("ciphersuite %d", cs), func(t *testing.T) {
+			k, ctx := setupKeeper(t)
+			members := accounts("member", 3)
+			group, shares := createGroup(t, k, ctx, uint32(cs), 2, members)
+
+			require.Equal(t, uint64(1), group.Id)
+			require.Equal(t, uint64(1), group.KeyVersion)
+			require.Equal(t, shares[0].VerificationKey.Encode(), group.VerificationKey)
+			for i, share := range shares {
+				require.Equal(t, share.PublicKey.Encode(), group.PublicKeyShares[i])
+			}
+			_, found := k.GetDkgSession(ctx, group.Id)
+			require.False(t, found)
+			require.NoError(t, group.Validate())
+
+			sig, err := testutil.Sign(uint32(cs), shares, 2, message)
+			require.NoError(t, err)
+			require.NoError(t, k.VerifyGroupSignature(ctx, group.Id, message, sig))
+			err = k.VerifyGroupSignature(ctx, group.Id, []byte("another message"), sig)
+			require.ErrorIs(t, err, types.ErrSignatureVerification)
+			err = k.VerifyGroupSignature(ctx, group.Id, message, sig[:len(sig)-1])
+			require.ErrorIs(t, err, types.ErrInvalidSignature)
+			err = k.VerifyGroupSignature(ctx, group.Id+1, message, sig)
+			require.ErrorIs(t, err, types.ErrGroupNotFound)
+		})
+	}
+}
+
+func TestKeygenRejections(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	cs := uint32(frost.Ed25519)
+	admin := sdk.AccAddress([]byte("admin_addr_123456789"))
+	members := accounts("member", 3)
+	outsider := sdk.AccAddress([]byte("outsider_addr_123456"))
+	group, err := k.CreateGroup(ctx, admin, cs, 2, roster(members))
+	require.NoError(t, err)
+
+	round1, shares, err := testutil.Keygen(cs, 2, 3)
+	require.NoError(t, err)
+	_, otherShares, err := testutil.Keygen(cs, 2, 3)
+	require.NoError(t, err)
+	r1Threshold3, _, err := testutil.Keygen(cs, 3, 3)
+	require.NoError(t, err)
+	r1Secp, _, err := testutil.Keygen(uint32(frost.Secp256k1), 2, 3)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, members[0], group.Id+1, round1[0]), types.ErrGroupNotFound)
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, outsider, group.Id, round1[0]), types.ErrNotParticipant)
+	// The first round message of participant 2 submitted by participant 1.
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, members[0], group.Id, round1[1]), types.ErrInvalidCommitment)
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, members[0], group.Id, r1Threshold3[0]), types.ErrInvalidCommitment)
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, members[0], group.Id, r1Secp[0]), types.ErrInvalidCommitment)
+	// Flip a bit of the proof's response scalar, right after the header and R.
+	tampered := append([]byte{}, round1[0]...)
+	tampered[5+32] ^= 1
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, members[0], group.Id, tampered), types.ErrInvalidCommitment)
+
+	require.NoError(t, k.SubmitDkgCommitment(ctx, members[0], group.Id, round1[0]))
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, members[0], group.Id, round1[0]), types.ErrInvalidCommitment)
+	_, err = k.ConfirmDkg(ctx, members[0], group.Id, shares[0].PublicKey.Encode())
+	require.ErrorIs(t, err, types.ErrWrongRound)
+
+	require.NoError(t, k.SubmitDkgCommitment(ctx, members[1], group.Id, round1[1]))
+	require.NoError(t, k.SubmitDkgCommitment(ctx, members[2], group.Id, round1[2]))
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, members[2], group.Id, round1[2]), types.ErrWrongRound)
+
+	_, err = k.ConfirmDkg(ctx, outsider, group.Id, shares[0].PublicKey.Encode())
+	require.ErrorIs(t, err, types.ErrNotParticipant)
+	_, err = k.ConfirmDkg(ctx, members[0], group.Id, shares[1].PublicKey.Encode())
+	require.ErrorIs(t, err, types.ErrInvalidConfirmation)
+	_, err = k.ConfirmDkg(ctx, members[0], group.Id, otherShares[0].PublicKey.Encode())
+	require.ErrorIs(t, err, types.ErrInvalidConfirmation)
+	_, err = k.ConfirmDkg(ctx, members[0], group.Id, []byte{1, 2, 3})
+	require.ErrorIs(t, err, types.ErrInvalidConfirmation)
+
+	completed, err := k.ConfirmDkg(ctx, members[0], group.Id, shares[0].PublicKey.Encode())
+	require.NoError(t, err)
+	require.False(t, completed)
+	_, err = k.ConfirmDkg(ctx, members[0], group.Id, shares[0].PublicKey.Encode())
+	require.ErrorIs(t, err, types.ErrInvalidConfirmation)
+
+	stored, _ := k.GetGroup(ctx, group.Id)
+	require.Zero(t, stored.KeyVersion)
+	sig, err := testutil.Sign(cs, shares, 2, message)
+	require.NoError(t, err)
+	require.ErrorIs(t, k.VerifyGroupSignature(ctx, group.Id, message, sig), types.ErrGroupKeyNotSet)
+}
+
+func TestRotateGroupKey(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	cs := uint32(frost.Secp256k1)
+	members := accounts("member", 3)
+	group, shares := createGroup(t, k, ctx, cs, 2, members)
+	admin := sdk.MustAccAddressFromBech32(group.Admin)
+
+	_, err := k.RotateGroupKey(ctx, members[0], group.Id)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	session, err := k.RotateGroupKey(ctx, admin, group.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DkgKindKeygen, session.Kind)
+
+	// The current key keeps verifying while the new one is generated.
+	sig, err := testutil.Sign(cs, shares, 2, message)
+	require.NoError(t, err)
+	require.NoError(t, k.VerifyGroupSignature(ctx, group.Id, message, sig))
+
+	round1, newShares, err := testutil.Keygen(cs, 2, 3)
+	require.NoError(t, err)
+	runSession(t, k, ctx, group.Id, members, round1, members, newShares)
+
+	rotated, _ := k.GetGroup(ctx, group.Id)
+	require.Equal(t, uint64(2), rotated.KeyVersion)
+	require.NotEqual(t, group.VerificationKey, rotated.VerificationKey)
+	require.ErrorIs(t, k.VerifyGroupSignature(ctx, group.Id, message, sig), types.ErrSignatureVerification)
+	sig, err = testutil.Sign(cs, newShares, 2, message)
+	require.NoError(t, err)
+	require.NoError(t, k.VerifyGroupSignature(ctx, group.Id, message, sig))
+}
+
+func TestReshareGroup(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	cs := uint32(frost.Ed25519)
+	members := accounts("member", 3)
+	group, shares := createGroup(t, k, ctx, cs, 2, members)
+	admin := sdk.MustAccAddressFromBech32(group.Admin)
+	newMembers := append([]sdk.AccAddress{members[2]}, accounts("newcomer", 3)...)
+
+	_, err := k.ReshareGroup(ctx, members[0], group.Id, 3, roster(newMembers))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.ReshareGroup(ctx, admin, group.Id, 5, roster(newMembers))
+	require.ErrorIs(t, err, types.ErrInvalidGroup)
+	session, err := k.ReshareGroup(ctx, admin, group.Id, 3, roster(newMembers))
+	require.NoError(t, err)
+	require.Equal(t, types.DkgKindReshare, session.Kind)
+
+	// Members 2 and 3 of the current roster reshare their key shares.
+	round1, newShares, err := testutil.Reshare(cs, shares[1:], 3, 4)
+	require.NoError(t, err)
+
+	// A fresh secret instead of the dealer's key share is rejected.
+	fresh, _, err := testutil.Keygen(cs, 3, 3)
+	require.NoError(t, err)
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, members[1], group.Id, fresh[1]), types.ErrInvalidCommitment)
+	// Dealers are the current roster, not the new one.
+	require.ErrorIs(t, k.SubmitDkgCommitment(ctx, newMembers[1], group.Id, round1[0]), types.ErrNotParticipant)
+
+	runSession(t, k, ctx, group.Id, members[1:], round1, newMembers, newShares)
+
+	reshared, _ := k.GetGroup(ctx, group.Id)
+	require.Equal(t, uint64(2), reshared.KeyVersion)
+	require.Equal(t, group.VerificationKey, reshared.VerificationKey)
+	require.Equal(t, uint32(3), reshared.Threshold)
+	require.Equal(t, roster(newMembers), reshared.Participants)
+	require.NoError(t, reshared.Validate())
+
+	sig, err := testutil.Sign(cs, newShares, 3, message)
+	require.NoError(t, err)
+	require.NoError(t, k.VerifyGroupSignature(ctx, group.Id, message, sig))
+
+	// The new roster can reshare again.
+	_, err = k.ReshareGroup(ctx, admin, group.Id, 2, roster(members))
+	require.NoError(t, err)
+	round1, shares, err = testutil.Reshare(cs, newShares[:3], 2, 3)
+	require.NoError(t, err)
+	runSession(t, k, ctx, group.Id, newMembers[:3], round1, members, shares)
+	sig, err = testutil.Sign(cs, shares, 2, message)
+	require.NoError(t, err)
+	require.NoError(t, k.VerifyGroupSignature(ctx, group.Id, message, sig))
+}
+
+func TestDkgMessagesChargeCurveGas(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	cs := uint32(frost.Ed25519)
+	members := accounts("member", 3)
+
+	// submit and confirm run the messages with a fresh gas meter and return
+	// the gas they consumed.
+	submit := func(groupID uint64, dealer sdk.AccAddress, data []byte) uint64 {
+		gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := srv.SubmitDkgCommitment(gasCtx, &types.MsgSubmitDkgCommitment{Dealer: dealer.String(), GroupId: groupID, Data: data})
+		require.NoError(t, err)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+	confirm := func(groupID uint64, participant sdk.AccAddress, share *keys.KeyShare) uint64 {
+		gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := srv.ConfirmDkg(gasCtx, &types.MsgConfirmDkg{Participant: participant.String(), GroupId: groupID, PublicKeyShare: share.PublicKey.Encode()})
+		require.NoError(t, err)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+
+	admin := sdk.AccAddress([]byte("admin_addr_123456789"))
+	group, err := k.CreateGroup(ctx, admin, cs, 2, roster(members))
+	require.NoError(t, err)
+	round1, shares, err := testutil.Keygen(cs, 2, 3)
+	require.NoError(t, err)
+
+	// Every dealer pays for its proof and coefficients, and the last one for
+	// adding the 3 commitments of 2 coefficients to the aggregate.
+	commitment := uint64(types.GasDkgCommitmentProof + 2*types.GasPerCommitmentCoefficient)
+	for i := range 2 {
+		require.GreaterOrEqual(t, submit(group.Id, members[i], round1[i]), commitment)
+	}
+	require.GreaterOrEqual(t, submit(group.Id, members[2], round1[2]), commitment+3*2*types.GasPerCommitmentCoefficient)
+	for i, p := range members {
+		require.GreaterOrEqual(t, confirm(group.Id, p, shares[i]), uint64(2*types.GasConfirmDkgPerCoefficient))
+	}
+
+	// Resharing to a threshold of 3 multiplies the 2 dealers' coefficients
+	// by their Lagrange coefficients when aggregating.
+	newMembers := append([]sdk.AccAddress{members[2]}, accounts("newcomer", 3)...)
+	_, err = k.ReshareGroup(ctx, admin, group.Id, 3, roster(newMembers))
+	require.NoError(t, err)
+	round1, _, err = testutil.Reshare(cs, shares[1:], 3, 4)
+	require.NoError(t, err)
+	commitment = types.GasDkgCommitmentProof + 3*types.GasPerCommitmentCoefficient
+	require.GreaterOrEqual(t, submit(group.Id, members[1], round1[0]), commitment)
+	require.GreaterOrEqual(t, submit(group.Id, members[2], round1[1]), commitment+2*3*types.GasPerResharedCoefficient)
+}
+
+func TestReshareWithoutKey(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	admin := sdk.AccAddress([]byte("admin_addr_123456789"))
+	members := roster(accounts("member", 3))
+	group, err := k.CreateGroup(ctx, admin, uint32(frost.Ed25519), 2, members)
+	require.NoError(t, err)
+
+	_, err = k.ReshareGroup(ctx, admin, group.Id, 2, members)
+	require.ErrorIs(t, err, types.ErrGroupKeyNotSet)
+}
+
+func TestDkgExpiryAndCancel(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetParams(ctx, types.Params{MaxParticipants: 10, DkgTimeoutBlocks: 5})
+	cs := uint32(frost.Ed25519)
+	admin := sdk.AccAddress([]byte("admin_addr_123456789"))
+	members := accounts("member", 3)
+	group, err := k.CreateGroup(ctx, admin, cs, 2, roster(members))
+	require.NoError(t, err)
+	session, found := k.GetDkgSession(ctx, group.Id)
+	require.True(t, found)
+	require.Equal(t, int64(6), session.DeadlineHeight)
+
+	round1, _, err := testutil.Keygen(cs, 2, 3)
+	require.NoError(t, err)
+
+	_, err = k.RotateGroupKey(ctx, admin, group.Id)
+	require.ErrorIs(t, err, types.ErrDkgInProgress)
+
+	expired := ctx.WithBlockHeight(7)
+	require.ErrorIs(t, k.SubmitDkgCommitment(expired, members[0], group.Id, round1[0]), types.ErrDkgExpired)
+
+	// An expired session is replaced by a new one.
+	session, err = k.RotateGroupKey(expired, admin, group.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), session.DeadlineHeight)
+	require.NoError(t, k.SubmitDkgCommitment(expired, members[0], group.Id, round1[0]))
+
+	require.ErrorIs(t, k.CancelDkg(expired, members[0], group.Id), types.ErrUnauthorized)
+	require.NoError(t, k.CancelDkg(expired, admin, group.Id))
+	require.ErrorIs(t, k.CancelDkg(expired, admin, group.Id), types.ErrDkgNotFound)
+	require.ErrorIs(t, k.SubmitDkgCommitment(expired, members[1], group.Id, round1[1]), types.ErrDkgNotFound)
+}
+
+func TestQueriesAndGenesis(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	cs := uint32(frost.P256)
+	members := accounts("member", 3)
+	group, _ := createGroup(t, k, ctx, cs, 2, members)
+	pending, err := k.CreateGroup(ctx, sdk.MustAccAddressFromBech32(group.Admin), cs, 3, roster(members))
+	require.NoError(t, err)
+	round1, _, err := testutil.Keygen(cs, 3, 3)
+	require.NoError(t, err)
+	require.NoError(t, k.SubmitDkgCommitment(ctx, members[0], pending.Id, round1[0]))
+
+	res, err := k.Group(ctx, &types.QueryGroupRequest{GroupId: group.Id})
+	require.NoError(t, err)
+	require.Equal(t, group, res.Group)
+	_, err = k.Group(ctx, &types.QueryGroupRequest{GroupId: 3})
+	require.ErrorIs(t, err, types.ErrGroupNotFound)
+
+	page, err := k.Groups(ctx, &types.QueryGroupsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, page.Groups, 1)
+	require.Equal(t, uint64(2), page.Pagination.Total)
+
+	session, err := k.DkgSession(ctx, &types.QueryDkgSessionRequest{GroupId: pending.Id})
+	require.NoError(t, err)
+	require.Len(t, session.Session.Commitments, 1)
+	_, err = k.DkgSession(ctx, &types.QueryDkgSessionRequest{GroupId: group.Id})
+	require.ErrorIs(t, err, types.ErrDkgNotFound)
+
+	genesis := types.GenesisState{
+		Params:      k.GetParams(ctx),
+		Groups:      k.GetAllGroups(ctx),
+		Sessions:    k.GetAllDkgSessions(ctx),
+		NextGroupId: k.GetNextGroupID(ctx),
+	}
+	require.Equal(t, uint64(3), genesis.NextGroupId)
+	require.Len(t, genesis.Groups, 2)
+	require.Len(t, genesis.Sessions, 1)
+	require.NoError(t, genesis.Validate())
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) CreateGroup(goCtx context.Context, req *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	group, err := m.Keeper.CreateGroup(ctx, admin, req.Ciphersuite, req.Threshold, req.Participants)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGroupResponse{GroupId: group.Id}, nil
+}
+
+func (m msgServer) SubmitDkgCommitment(goCtx context.Context, req *types.MsgSubmitDkgCommitment) (*types.MsgSubmitDkgCommitmentResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	dealer, err := sdk.AccAddressFromBech32(req.Dealer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid dealer address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SubmitDkgCommitment(ctx, dealer, req.GroupId, req.Data); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitDkgCommitmentResponse{}, nil
+}
+
+func (m msgServer) ConfirmDkg(goCtx context.Context, req *types.MsgConfirmDkg) (*types.MsgConfirmDkgResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	participant, err := sdk.AccAddressFromBech32(req.Participant)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid participant address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	completed, err := m.Keeper.ConfirmDkg(ctx, participant, req.GroupId, req.PublicKeyShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConfirmDkgResponse{Completed: completed}, nil
+}
+
+func (m msgServer) RotateGroupKey(goCtx context.Context, req *types.MsgRotateGroupKey) (*types.MsgRotateGroupKeyResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := m.Keeper.RotateGroupKey(ctx, admin, req.GroupId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateGroupKeyResponse{}, nil
+}
+
+func (m msgServer) ReshareGroup(goCtx context.Context, req *types.MsgReshareGroup) (*types.MsgReshareGroupResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := m.Keeper.ReshareGroup(ctx, admin, req.GroupId, req.Threshold, req.Participants); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReshareGroupResponse{}, nil
+}
+
+func (m msgServer) CancelDkg(goCtx context.Context, req *types.MsgCancelDkg) (*types.MsgCancelDkgResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CancelDkg(ctx, admin, req.GroupId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelDkgResponse{}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if m.authority.String() != req.Authority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid authority")
+	}
+
+	if req.Params == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty params")
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.SetParams(ctx, *req.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
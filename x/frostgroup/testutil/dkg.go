@@ -0,0 +1,164 @@
+// Package testutil runs the off-chain side of the group key generations in
+// tests: the dealers' commitments, the secret shares and FROST signing.
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/dkg"
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/ecc"
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/frost"
+	secretsharing "github.com/cosmos/evm/precompiles/frost/bytemare-stable/secret-sharing"
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/secret-sharing/keys"
+)
+
+// Keygen runs a FROST key generation among n participants. It returns the
+// encoded first round message of each participant, in identifier order, and
+// the key shares they end up with.
+func Keygen(ciphersuite uint32, threshold, n uint16) ([][]byte, []*keys.KeyShare, error) {
+	cs := dkg.Ciphersuite(ciphersuite)
+	participants := make([]*dkg.Participant, n)
+	r1 := make([]*dkg.Round1Data, n)
+	round1 := make([][]byte, n)
+	for i := range participants {
+		p, err := cs.NewParticipant(uint16(i+1), threshold, n) //nolint:gosec // G115 // test rosters are small
+		if err != nil {
+			return nil, nil, err
+		}
+		participants[i] = p
+		r1[i] = p.Start()
+		round1[i] = r1[i].Encode()
+	}
+
+	r2 := make(map[uint16][]*dkg.Round2Data, n)
+	for _, p := range participants {
+		out, err := p.Continue(r1)
+		if err != nil {
+			return nil, nil, err
+		}
+		for id, data := range out {
+			r2[id] = append(r2[id], data)
+		}
+	}
+
+	shares := make([]*keys.KeyShare, n)
+	for i, p := range participants {
+		share, err := p.Finalize(r1, r2[p.Identifier])
+		if err != nil {
+			return nil, nil, err
+		}
+		shares[i] = share
+	}
+	return round1, shares, nil
+}
+
+// Reshare redistributes the key behind dealers, which must be at least the
+// threshold of the current key, to n new participants with the given
+// threshold. Each dealer shares its own key share with a fresh polynomial,
+// and new participants weight what they receive by the dealers' Lagrange
+// coefficients. It returns the dealers' encoded first round messages and the
+// new key shares.
+func Reshare(ciphersuite uint32, dealers []*keys.KeyShare, threshold, n uint16) ([][]byte, []*keys.KeyShare, error) {
+	if len(dealers) == 0 {
+		return nil, nil, fmt.Errorf("no dealers")
+	}
+	g := ecc.Group(ciphersuite)
+	ids := make([]uint16, len(dealers))
+	for i, d := range dealers {
+		ids[i] = d.ID
+	}
+	interpolation := secretsharing.NewPolynomialFromIntegers(g, ids)
+
+	round1 := make([][]byte, len(dealers))
+	secrets := make([]*ecc.Scalar, n)
+	for i := range secrets {
+		secrets[i] = g.NewScalar()
+	}
+	for i, d := range dealers {
+		poly := secretsharing.NewPolynomial(threshold)
+		poly[0] = d.Secret.Copy()
+		for j := 1; j < len(poly); j++ {
+			poly[j] = g.NewScalar().Random()
+		}
+		commitment := secretsharing.Commit(g, poly)
+		proof, err := dkg.FrostGenerateZeroKnowledgeProof(dkg.Ciphersuite(ciphersuite), d.ID, poly[0], commitment[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		round1[i] = (&dkg.Round1Data{
+			ProofOfKnowledge: proof,
+			Commitment:       commitment,
+			SenderIdentifier: d.ID,
+			Group:            g,
+		}).Encode()
+
+		lambda, err := interpolation.DeriveInterpolatingValue(g, g.NewScalar().SetUInt64(uint64(d.ID)))
+		if err != nil {
+			return nil, nil, err
+		}
+		for j := range secrets {
+			share := poly.Evaluate(g.NewScalar().SetUInt64(uint64(j + 1)))
+			secrets[j].Add(share.Multiply(lambda))
+		}
+	}
+
+	shares := make([]*keys.KeyShare, n)
+	for j, secret := range secrets {
+		shares[j] = &keys.KeyShare{
+			Secret:          secret,
+			VerificationKey: dealers[0].VerificationKey.Copy(),
+			PublicKeyShare: keys.PublicKeyShare{
+				PublicKey: g.Base().Multiply(secret),
+				ID:        uint16(j + 1), //nolint:gosec // G115 // test rosters are small
+				Group:     g,
+			},
+		}
+	}
+	return round1, shares, nil
+}
+
+// Sign has the first threshold of shares sign message and returns the
+// encoded aggregate signature.
+func Sign(ciphersuite uint32, shares []*keys.KeyShare, threshold uint16, message []byte) ([]byte, error) {
+	publicKeyShares := make([]*keys.PublicKeyShare, len(shares))
+	for i, s := range shares {
+		publicKeyShares[i] = s.Public()
+	}
+	configuration := &frost.Configuration{
+		Ciphersuite:           frost.Ciphersuite(ciphersuite),
+		Threshold:             threshold,
+		MaxSigners:            uint16(len(shares)), //nolint:gosec // G115 // test rosters are small
+		VerificationKey:       shares[0].VerificationKey,
+		SignerPublicKeyShares: publicKeyShares,
+	}
+	if err := configuration.Init(); err != nil {
+		return nil, err
+	}
+
+	signers := make([]*frost.Signer, threshold)
+	commitments := make(frost.CommitmentList, threshold)
+	for i := range signers {
+		signer, err := configuration.Signer(shares[i])
+		if err != nil {
+			return nil, err
+		}
+		signers[i] = signer
+		commitments[i] = signer.Commit()
+	}
+	commitments.Sort()
+
+	signatureShares := make([]*frost.SignatureShare, threshold)
+	for i, signer := range signers {
+		share, err := signer.Sign(message, commitments)
+		if err != nil {
+			return nil, err
+		}
+		signatureShares[i] = share
+	}
+
+	signature, err := configuration.AggregateSignatures(message, signatureShares, commitments, true)
+	if err != nil {
+		return nil, err
+	}
+	return signature.Encode(), nil
+}
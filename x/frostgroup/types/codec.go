@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var AminoCdc = codec.NewLegacyAmino()
+
+const (
+	msgCreateGroupName         = "cosmos/evm/x/frostgroup/MsgCreateGroup"
+	msgSubmitDkgCommitmentName = "cosmos/evm/x/frostgroup/MsgSubmitDkgCommitment"
+	msgConfirmDkgName          = "cosmos/evm/x/frostgroup/MsgConfirmDkg"
+	msgRotateGroupKeyName      = "cosmos/evm/x/frostgroup/MsgRotateGroupKey"
+	msgReshareGroupName        = "cosmos/evm/x/frostgroup/MsgReshareGroup"
+	msgCancelDkgName           = "cosmos/evm/x/frostgroup/MsgCancelDkg"
+	msgUpdateParamsName        = "cosmos/evm/x/frostgroup/MsgUpdateParams"
+)
+
+func init() {
+	RegisterLegacyAminoCodec(AminoCdc)
+	AminoCdc.Seal()
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateGroup{},
+		&MsgSubmitDkgCommitment{},
+		&MsgConfirmDkg{},
+		&MsgRotateGroupKey{},
+		&MsgReshareGroup{},
+		&MsgCancelDkg{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateGroup{}, msgCreateGroupName, nil)
+	cdc.RegisterConcrete(&MsgSubmitDkgCommitment{}, msgSubmitDkgCommitmentName, nil)
+	cdc.RegisterConcrete(&MsgConfirmDkg{}, msgConfirmDkgName, nil)
+	cdc.RegisterConcrete(&MsgRotateGroupKey{}, msgRotateGroupKeyName, nil)
+	cdc.RegisterConcrete(&MsgReshareGroup{}, msgReshareGroupName, nil)
+	cdc.RegisterConcrete(&MsgCancelDkg{}, msgCancelDkgName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, msgUpdateParamsName, nil)
+}
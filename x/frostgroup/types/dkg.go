@@ -0,0 +1,240 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/dkg"
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/ecc"
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/frost"
+	secretsharing "github.com/cosmos/evm/precompiles/frost/bytemare-stable/secret-sharing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ciphersuite returns the FROST ciphersuite with the given id. It must be
+// supported by both the DKG and the signature verification.
+func Ciphersuite(id uint32) (frost.Ciphersuite, error) {
+	if id > math.MaxUint8 || !dkg.Ciphersuite(id).Available() || !frost.Ciphersuite(id).Available() {
+		return 0, fmt.Errorf("unsupported ciphersuite %d", id)
+	}
+	return frost.Ciphersuite(id), nil
+}
+
+// ValidateRoster checks that participants are distinct accounts, at most
+// maxParticipants of them, and that threshold of them can sign.
+func ValidateRoster(threshold uint32, participants []string, maxParticipants uint32) error {
+	n := len(participants)
+	if n < 2 {
+		return fmt.Errorf("a group needs at least 2 participants, got %d", n)
+	}
+	if uint64(n) > uint64(maxParticipants) {
+		return fmt.Errorf("a group has at most %d participants, got %d", maxParticipants, n)
+	}
+	if threshold == 0 || uint64(threshold) > uint64(n) {
+		return fmt.Errorf("threshold must be between 1 and %d, got %d", n, threshold)
+	}
+
+	seen := make(map[string]struct{}, n)
+	for _, p := range participants {
+		addr, err := sdk.AccAddressFromBech32(p)
+		if err != nil {
+			return fmt.Errorf("invalid participant %s: %w", p, err)
+		}
+		if _, ok := seen[addr.String()]; ok {
+			return fmt.Errorf("duplicate participant %s", p)
+		}
+		seen[addr.String()] = struct{}{}
+	}
+	return nil
+}
+
+// Identifier returns the FROST identifier of addr in participants, or zero if
+// it is not part of the roster.
+func Identifier(participants []string, addr string) uint16 {
+	for i, p := range participants {
+		if p == addr {
+			return uint16(i + 1) //nolint:gosec // G115 // rosters are bounded by max_participants
+		}
+	}
+	return 0
+}
+
+// DecodeElement decodes a group element of the ciphersuite. The identity
+// element is rejected, since it is never a valid key or commitment.
+func DecodeElement(ciphersuite uint32, bz []byte) (*ecc.Element, error) {
+	cs, err := Ciphersuite(ciphersuite)
+	if err != nil {
+		return nil, err
+	}
+
+	e := cs.Group().NewElement()
+	if err := e.Decode(bz); err != nil {
+		return nil, err
+	}
+	if e.IsIdentity() {
+		return nil, fmt.Errorf("element is the identity")
+	}
+	return e, nil
+}
+
+// VerifyDkgCommitment decodes the first round message of a dealer and checks
+// that it comes from dealer, commits to a polynomial with threshold
+// coefficients and proves knowledge of the constant term.
+func VerifyDkgCommitment(ciphersuite uint32, dealer uint16, threshold uint32, data []byte) (*dkg.Round1Data, error) {
+	cs, err := Ciphersuite(ciphersuite)
+	if err != nil {
+		return nil, ErrInvalidCommitment.Wrap(err.Error())
+	}
+
+	r1, err := decodeRound1Data(cs, data)
+	if err != nil {
+		return nil, err
+	}
+	if r1.SenderIdentifier != dealer {
+		return nil, ErrInvalidCommitment.Wrapf("sent by %d, expected %d", r1.SenderIdentifier, dealer)
+	}
+	if uint64(len(r1.Commitment)) != uint64(threshold) {
+		return nil, ErrInvalidCommitment.Wrapf("expected %d coefficients, got %d", threshold, len(r1.Commitment))
+	}
+
+	ok, err := dkg.FrostVerifyZeroKnowledgeProof(dkg.Ciphersuite(cs), dealer, r1.Commitment[0], r1.ProofOfKnowledge)
+	if err != nil {
+		return nil, ErrInvalidCommitment.Wrap(err.Error())
+	}
+	if !ok {
+		return nil, ErrInvalidCommitment.Wrap("invalid proof of knowledge")
+	}
+	return r1, nil
+}
+
+func decodeRound1Data(cs frost.Ciphersuite, data []byte) (*dkg.Round1Data, error) {
+	r1 := new(dkg.Round1Data)
+	if err := r1.Decode(data); err != nil {
+		return nil, ErrInvalidCommitment.Wrap(err.Error())
+	}
+	if r1.Group != cs.Group() {
+		return nil, ErrInvalidCommitment.Wrapf("commitment for ciphersuite %d, expected %d", r1.Group, cs)
+	}
+	for _, c := range r1.Commitment {
+		if c.IsIdentity() {
+			return nil, ErrInvalidCommitment.Wrap("commitment to a zero coefficient")
+		}
+	}
+	return r1, nil
+}
+
+// AggregateCommitment combines the verified commitments of the dealers into
+// the commitment to the polynomial of the group key, whose constant term is
+// the group key. A key generation adds up the dealers' polynomials. A
+// resharing weights them by the Lagrange coefficients of the dealers, whose
+// constant terms are shares of the current key, so that the group key is
+// unchanged.
+func AggregateCommitment(ciphersuite uint32, kind DkgKind, threshold uint32, commitments []DkgCommitment) ([][]byte, error) {
+	cs, err := Ciphersuite(ciphersuite)
+	if err != nil {
+		return nil, err
+	}
+	g := cs.Group()
+
+	var dealers secretsharing.Polynomial
+	if kind == DkgKindReshare {
+		ids := make([]uint16, len(commitments))
+		for i, c := range commitments {
+			ids[i] = uint16(c.Dealer) //nolint:gosec // G115 // identifiers are bounded by max_participants
+		}
+		dealers = secretsharing.NewPolynomialFromIntegers(g, ids)
+	}
+
+	aggregate := make([]*ecc.Element, threshold)
+	for i := range aggregate {
+		aggregate[i] = g.NewElement()
+	}
+	for _, c := range commitments {
+		r1, err := decodeRound1Data(cs, c.Data)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(r1.Commitment)) != uint64(threshold) {
+			return nil, ErrInvalidCommitment.Wrapf("dealer %d: expected %d coefficients, got %d", c.Dealer, threshold, len(r1.Commitment))
+		}
+
+		var lambda *ecc.Scalar
+		if kind == DkgKindReshare {
+			lambda, err = dealers.DeriveInterpolatingValue(g, g.NewScalar().SetUInt64(uint64(c.Dealer)))
+			if err != nil {
+				return nil, ErrInvalidCommitment.Wrapf("dealer %d: %s", c.Dealer, err)
+			}
+		}
+		for i, com := range r1.Commitment {
+			if lambda != nil {
+				com = com.Copy().Multiply(lambda)
+			}
+			aggregate[i].Add(com)
+		}
+	}
+
+	if aggregate[0].IsIdentity() {
+		return nil, ErrInvalidCommitment.Wrap("group key is the identity")
+	}
+	out := make([][]byte, len(aggregate))
+	for i, e := range aggregate {
+		out[i] = e.Encode()
+	}
+	return out, nil
+}
+
+// VerifyPublicKeyShare checks that publicKeyShare is the share of participant
+// under the aggregate commitment of a session.
+func VerifyPublicKeyShare(ciphersuite uint32, participant uint16, aggregate [][]byte, publicKeyShare []byte) error {
+	cs, err := Ciphersuite(ciphersuite)
+	if err != nil {
+		return ErrInvalidConfirmation.Wrap(err.Error())
+	}
+
+	share, err := DecodeElement(ciphersuite, publicKeyShare)
+	if err != nil {
+		return ErrInvalidConfirmation.Wrapf("invalid public key share: %s", err)
+	}
+	commitment := make([]*ecc.Element, len(aggregate))
+	for i, bz := range aggregate {
+		if commitment[i], err = DecodeElement(ciphersuite, bz); err != nil {
+			return ErrInvalidConfirmation.Wrapf("invalid aggregate commitment: %s", err)
+		}
+	}
+
+	if !secretsharing.Verify(cs.Group(), participant, share, commitment) {
+		return ErrInvalidConfirmation.Wrapf("public key share of participant %d does not match the commitments", participant)
+	}
+	return nil
+}
+
+// VerifySignature verifies a FROST signature of message under the group key.
+// It returns ErrSignatureVerification when a well-formed signature does not
+// verify.
+func (g Group) VerifySignature(message, signature []byte) error {
+	if g.KeyVersion == 0 {
+		return ErrGroupKeyNotSet.Wrapf("group %d", g.Id)
+	}
+	cs, err := Ciphersuite(g.Ciphersuite)
+	if err != nil {
+		return err
+	}
+	key, err := DecodeElement(g.Ciphersuite, g.VerificationKey)
+	if err != nil {
+		return ErrInvalidGroup.Wrapf("invalid verification key: %s", err)
+	}
+
+	sig := new(frost.Signature)
+	if err := sig.Decode(signature); err != nil {
+		return ErrInvalidSignature.Wrap(err.Error())
+	}
+	if sig.Group != cs.Group() {
+		return ErrInvalidSignature.Wrapf("signature for ciphersuite %d, expected %d", sig.Group, cs)
+	}
+
+	if err := frost.VerifySignature(cs, message, sig, key); err != nil {
+		return ErrSignatureVerification.Wrap(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrInvalidGroup          = errorsmod.Register(ModuleName, 2, "invalid group")
+	ErrGroupNotFound         = errorsmod.Register(ModuleName, 3, "group not found")
+	ErrUnauthorized          = errorsmod.Register(ModuleName, 4, "not the group admin")
+	ErrDkgInProgress         = errorsmod.Register(ModuleName, 5, "key generation already in progress")
+	ErrDkgNotFound           = errorsmod.Register(ModuleName, 6, "no key generation in progress")
+	ErrDkgExpired            = errorsmod.Register(ModuleName, 7, "key generation expired")
+	ErrNotParticipant        = errorsmod.Register(ModuleName, 8, "not a participant of the key generation")
+	ErrInvalidCommitment     = errorsmod.Register(ModuleName, 9, "invalid dkg commitment")
+	ErrInvalidConfirmation   = errorsmod.Register(ModuleName, 10, "invalid dkg confirmation")
+	ErrWrongRound            = errorsmod.Register(ModuleName, 11, "message not expected in the current round")
+	ErrGroupKeyNotSet        = errorsmod.Register(ModuleName, 12, "group has no key yet")
+	ErrInvalidSignature      = errorsmod.Register(ModuleName, 13, "invalid signature encoding")
+	ErrSignatureVerification = errorsmod.Register(ModuleName, 14, "signature verification failed")
+)
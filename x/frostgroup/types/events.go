@@ -0,0 +1,22 @@
+package types
+
+// frostgroup events
+const (
+	EventTypeCreateGroup   = "create_group"
+	EventTypeStartDkg      = "start_dkg"
+	EventTypeDkgCommitment = "dkg_commitment"
+	EventTypeConfirmDkg    = "confirm_dkg"
+	EventTypeCompleteDkg   = "complete_dkg"
+	EventTypeCancelDkg     = "cancel_dkg"
+
+	AttributeKeyGroupID         = "group_id"
+	AttributeKeyAdmin           = "admin"
+	AttributeKeyCiphersuite     = "ciphersuite"
+	AttributeKeyKind            = "kind"
+	AttributeKeyThreshold       = "threshold"
+	AttributeKeyDeadlineHeight  = "deadline_height"
+	AttributeKeyDealer          = "dealer"
+	AttributeKeyParticipant     = "participant"
+	AttributeKeyKeyVersion      = "key_version"
+	AttributeKeyVerificationKey = "verification_key"
+)
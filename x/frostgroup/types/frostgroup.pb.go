@@ -0,0 +1,1823 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/frostgroup/v1/frostgroup.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DkgKind is the kind of distributed key generation a session runs.
+type DkgKind int32
+
+const (
+	// DKG_KIND_UNSPECIFIED defines an invalid/undefined session kind.
+	DkgKindUnspecified DkgKind = 0
+	// DKG_KIND_KEYGEN generates a fresh group key. It is used when a group is
+	// created and when its key is rotated.
+	DkgKindKeygen DkgKind = 1
+	// DKG_KIND_RESHARE redistributes the current group key to a new roster
+	// and threshold without changing it.
+	DkgKindReshare DkgKind = 2
+)
+
+var DkgKind_name = map[int32]string{
+	0: "DKG_KIND_UNSPECIFIED",
+	1: "DKG_KIND_KEYGEN",
+	2: "DKG_KIND_RESHARE",
+}
+
+var DkgKind_value = map[string]int32{
+	"DKG_KIND_UNSPECIFIED": 0,
+	"DKG_KIND_KEYGEN":      1,
+	"DKG_KIND_RESHARE":     2,
+}
+
+func (x DkgKind) String() string {
+	return proto.EnumName(DkgKind_name, int32(x))
+}
+
+func (DkgKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3f0e92b60679a844, []int{0}
+}
+
+// Params defines the limits of the group registry.
+type Params struct {
+	// max_participants is the largest roster a group can have.
+	MaxParticipants uint32 `protobuf:"varint,1,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	// dkg_timeout_blocks is the number of blocks a DKG session accepts
+	// commitments and confirmations for. An expired session can be replaced by
+	// a new one.
+	DkgTimeoutBlocks uint64 `protobuf:"varint,2,opt,name=dkg_timeout_blocks,json=dkgTimeoutBlocks,proto3" json:"dkg_timeout_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f0e92b60679a844, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxParticipants() uint32 {
+	if m != nil {
+		return m.MaxParticipants
+	}
+	return 0
+}
+
+func (m *Params) GetDkgTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.DkgTimeoutBlocks
+	}
+	return 0
+}
+
+// Group is a registered FROST threshold group.
+type Group struct {
+	// id is the sequential id of the group.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// admin is the account that can rotate, reshare and cancel the key
+	// generation of the group.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// ciphersuite is the FROST ciphersuite id of the group.
+	Ciphersuite uint32 `protobuf:"varint,3,opt,name=ciphersuite,proto3" json:"ciphersuite,omitempty"`
+	// threshold is the number of participants needed to sign.
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// participants is the roster. The participant at index i has the FROST
+	// identifier i+1.
+	Participants []string `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	// verification_key is the encoded group public key. It is empty until the
+	// first key generation completes.
+	VerificationKey []byte `protobuf:"bytes,6,opt,name=verification_key,json=verificationKey,proto3" json:"verification_key,omitempty"`
+	// public_key_shares are the encoded public key shares of the participants,
+	// in roster order.
+	PublicKeyShares [][]byte `protobuf:"bytes,7,rep,name=public_key_shares,json=publicKeyShares,proto3" json:"public_key_shares,omitempty"`
+	// key_version is the number of completed key generations and resharings.
+	KeyVersion uint64 `protobuf:"varint,8,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+}
+
+func (m *Group) Reset()         { *m = Group{} }
+func (m *Group) String() string { return proto.CompactTextString(m) }
+func (*Group) ProtoMessage()    {}
+func (*Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f0e92b60679a844, []int{1}
+}
+func (m *Group) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Group.Merge(m, src)
+}
+func (m *Group) XXX_Size() int {
+	return m.Size()
+}
+func (m *Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Group proto.InternalMessageInfo
+
+func (m *Group) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Group) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Group) GetCiphersuite() uint32 {
+	if m != nil {
+		return m.Ciphersuite
+	}
+	return 0
+}
+
+func (m *Group) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Group) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *Group) GetVerificationKey() []byte {
+	if m != nil {
+		return m.VerificationKey
+	}
+	return nil
+}
+
+func (m *Group) GetPublicKeyShares() [][]byte {
+	if m != nil {
+		return m.PublicKeyShares
+	}
+	return nil
+}
+
+func (m *Group) GetKeyVersion() uint64 {
+	if m != nil {
+		return m.KeyVersion
+	}
+	return 0
+}
+
+// DkgCommitment is the first round message of a dealer.
+type DkgCommitment struct {
+	// dealer is the FROST identifier of the dealer.
+	Dealer uint32 `protobuf:"varint,1,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// data is the dealer's encoded dkg.Round1Data.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *DkgCommitment) Reset()         { *m = DkgCommitment{} }
+func (m *DkgCommitment) String() string { return proto.CompactTextString(m) }
+func (*DkgCommitment) ProtoMessage()    {}
+func (*DkgCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f0e92b60679a844, []int{2}
+}
+func (m *DkgCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgCommitment.Merge(m, src)
+}
+func (m *DkgCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgCommitment proto.InternalMessageInfo
+
+func (m *DkgCommitment) GetDealer() uint32 {
+	if m != nil {
+		return m.Dealer
+	}
+	return 0
+}
+
+func (m *DkgCommitment) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// DkgConfirmation is the public key share a participant derived from the
+// secret shares it received.
+type DkgConfirmation struct {
+	// participant is the FROST identifier of the participant.
+	Participant uint32 `protobuf:"varint,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// public_key_share is the encoded public key share.
+	PublicKeyShare []byte `protobuf:"bytes,2,opt,name=public_key_share,json=publicKeyShare,proto3" json:"public_key_share,omitempty"`
+}
+
+func (m *DkgConfirmation) Reset()         { *m = DkgConfirmation{} }
+func (m *DkgConfirmation) String() string { return proto.CompactTextString(m) }
+func (*DkgConfirmation) ProtoMessage()    {}
+func (*DkgConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f0e92b60679a844, []int{3}
+}
+func (m *DkgConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgConfirmation.Merge(m, src)
+}
+func (m *DkgConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgConfirmation proto.InternalMessageInfo
+
+func (m *DkgConfirmation) GetParticipant() uint32 {
+	if m != nil {
+		return m.Participant
+	}
+	return 0
+}
+
+func (m *DkgConfirmation) GetPublicKeyShare() []byte {
+	if m != nil {
+		return m.PublicKeyShare
+	}
+	return nil
+}
+
+// DkgSession is a key generation or resharing in progress for a group.
+type DkgSession struct {
+	// group_id is the id of the group the session runs for.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// kind is the kind of the session.
+	Kind DkgKind `protobuf:"varint,2,opt,name=kind,proto3,enum=cosmos.evm.frostgroup.v1.DkgKind" json:"kind,omitempty"`
+	// threshold is the threshold of the resulting key shares.
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// participants is the roster receiving the key shares, in identifier
+	// order.
+	Participants []string `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	// commitments are the dealers' first round messages, in submission order.
+	// Dealers are the participants for a key generation and the current group
+	// roster for a resharing.
+	Commitments []DkgCommitment `protobuf:"bytes,5,rep,name=commitments,proto3" json:"commitments"`
+	// aggregate_commitment is the encoded commitment to the polynomial of the
+	// group key, set once all the expected dealers have committed.
+	AggregateCommitment [][]byte `protobuf:"bytes,6,rep,name=aggregate_commitment,json=aggregateCommitment,proto3" json:"aggregate_commitment,omitempty"`
+	// confirmations are the public key shares confirmed by the participants.
+	Confirmations []DkgConfirmation `protobuf:"bytes,7,rep,name=confirmations,proto3" json:"confirmations"`
+	// deadline_height is the last block in which the session can progress.
+	DeadlineHeight int64 `protobuf:"varint,8,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+}
+
+func (m *DkgSession) Reset()         { *m = DkgSession{} }
+func (m *DkgSession) String() string { return proto.CompactTextString(m) }
+func (*DkgSession) ProtoMessage()    {}
+func (*DkgSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f0e92b60679a844, []int{4}
+}
+func (m *DkgSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgSession.Merge(m, src)
+}
+func (m *DkgSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgSession proto.InternalMessageInfo
+
+func (m *DkgSession) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *DkgSession) GetKind() DkgKind {
+	if m != nil {
+		return m.Kind
+	}
+	return DkgKindUnspecified
+}
+
+func (m *DkgSession) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *DkgSession) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *DkgSession) GetCommitments() []DkgCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *DkgSession) GetAggregateCommitment() [][]byte {
+	if m != nil {
+		return m.AggregateCommitment
+	}
+	return nil
+}
+
+func (m *DkgSession) GetConfirmations() []DkgConfirmation {
+	if m != nil {
+		return m.Confirmations
+	}
+	return nil
+}
+
+func (m *DkgSession) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.evm.frostgroup.v1.DkgKind", DkgKind_name, DkgKind_value)
+	proto.RegisterType((*Params)(nil), "cosmos.evm.frostgroup.v1.Params")
+	proto.RegisterType((*Group)(nil), "cosmos.evm.frostgroup.v1.Group")
+	proto.RegisterType((*DkgCommitment)(nil), "cosmos.evm.frostgroup.v1.DkgCommitment")
+	proto.RegisterType((*DkgConfirmation)(nil), "cosmos.evm.frostgroup.v1.DkgConfirmation")
+	proto.RegisterType((*DkgSession)(nil), "cosmos.evm.frostgroup.v1.DkgSession")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/evm/frostgroup/v1/frostgroup.proto", fileDescriptor_3f0e92b60679a844)
+}
+
+var fileDescriptor_3f0e92b60679a844 = []byte{
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x13, 0x37, 0x6d, 0x27, 0xcd, 0x47, 0x97, 0xa8, 0x32, 0x11, 0x4a, 0x4d, 0x0e, 0x34,
+	0xad, 0x50, 0x42, 0x8b, 0x38, 0x71, 0x22, 0x4d, 0x68, 0xa3, 0x48, 0xa5, 0x72, 0x28, 0x12, 0x48,
+	0xc8, 0xda, 0xd8, 0x1b, 0x67, 0xe5, 0xd8, 0x6b, 0xd9, 0x9b, 0xa8, 0xf9, 0x07, 0xd0, 0x13, 0x12,
+	0xe7, 0x9e, 0xe0, 0xc7, 0xf4, 0xd8, 0x23, 0x27, 0x84, 0xda, 0x3f, 0x82, 0xbc, 0x76, 0x13, 0x07,
+	0x01, 0xbd, 0x79, 0xde, 0xbc, 0x19, 0xbf, 0x99, 0x37, 0x5a, 0xd8, 0x35, 0x58, 0xe0, 0xb0, 0xa0,
+	0x49, 0xa6, 0x4e, 0x73, 0xe8, 0xb3, 0x80, 0x5b, 0x3e, 0x9b, 0x78, 0xcd, 0xe9, 0x7e, 0x22, 0x6a,
+	0x78, 0x3e, 0xe3, 0x0c, 0x29, 0x11, 0xb5, 0x41, 0xa6, 0x4e, 0x23, 0x91, 0x9c, 0xee, 0x57, 0xca,
+	0x16, 0xb3, 0x98, 0x20, 0x35, 0xc3, 0xaf, 0x88, 0x5f, 0xc3, 0x90, 0x3d, 0xc5, 0x3e, 0x76, 0x02,
+	0xb4, 0x0b, 0x25, 0x07, 0x9f, 0xeb, 0x1e, 0xf6, 0x39, 0x35, 0xa8, 0x87, 0x5d, 0x1e, 0x28, 0x92,
+	0x2a, 0xd5, 0xf3, 0x5a, 0xd1, 0xc1, 0xe7, 0xa7, 0x09, 0x18, 0x3d, 0x05, 0x64, 0xda, 0x96, 0xce,
+	0xa9, 0x43, 0xd8, 0x84, 0xeb, 0x83, 0x31, 0x33, 0xec, 0x40, 0x49, 0xab, 0x52, 0x5d, 0xd6, 0x4a,
+	0xa6, 0x6d, 0xbd, 0x8d, 0x12, 0x2d, 0x81, 0xd7, 0x3e, 0xa7, 0x61, 0xe5, 0x28, 0x54, 0x81, 0x0a,
+	0x90, 0xa6, 0xa6, 0x68, 0x2a, 0x6b, 0x69, 0x6a, 0xa2, 0x32, 0xac, 0x60, 0xd3, 0xa1, 0xae, 0x28,
+	0x5d, 0xd7, 0xa2, 0x00, 0xa9, 0x90, 0x33, 0xa8, 0x37, 0x22, 0x7e, 0x30, 0xa1, 0x9c, 0x28, 0x19,
+	0xa1, 0x21, 0x09, 0xa1, 0x47, 0xb0, 0xce, 0x47, 0x3e, 0x09, 0x46, 0x6c, 0x6c, 0x2a, 0xb2, 0xc8,
+	0x2f, 0x00, 0x54, 0x83, 0x8d, 0xa5, 0x21, 0x56, 0xd4, 0x4c, 0x7d, 0x5d, 0x5b, 0xc2, 0xc2, 0x61,
+	0xa7, 0xc4, 0xa7, 0x43, 0x6a, 0x60, 0x4e, 0x99, 0xab, 0xdb, 0x64, 0xa6, 0x64, 0x55, 0xa9, 0xbe,
+	0xa1, 0x15, 0x93, 0x78, 0x8f, 0xcc, 0xd0, 0x1e, 0x6c, 0x7a, 0x93, 0xc1, 0x98, 0x1a, 0x21, 0x49,
+	0x0f, 0x46, 0xd8, 0x27, 0x81, 0xb2, 0xaa, 0x66, 0x42, 0x6e, 0x94, 0xe8, 0x91, 0x59, 0x5f, 0xc0,
+	0x68, 0x1b, 0x72, 0x21, 0x69, 0x4a, 0xfc, 0x80, 0x32, 0x57, 0x59, 0x13, 0x93, 0x82, 0x4d, 0x66,
+	0xef, 0x22, 0xa4, 0xf6, 0x12, 0xf2, 0x6d, 0xdb, 0x3a, 0x64, 0x8e, 0x43, 0xb9, 0x43, 0x5c, 0x8e,
+	0xb6, 0x20, 0x6b, 0x12, 0x3c, 0x26, 0x7e, 0xbc, 0xeb, 0x38, 0x42, 0x08, 0x64, 0x13, 0x73, 0x2c,
+	0x36, 0xb3, 0xa1, 0x89, 0xef, 0xda, 0x47, 0x28, 0x8a, 0x62, 0x77, 0x48, 0x7d, 0x47, 0xe8, 0x0b,
+	0x77, 0x95, 0x98, 0x2b, 0xee, 0x91, 0x84, 0x50, 0x1d, 0x4a, 0x7f, 0xca, 0x8f, 0x9b, 0x16, 0x96,
+	0xd5, 0xd7, 0xbe, 0x67, 0x00, 0xda, 0xb6, 0xd5, 0x27, 0x41, 0x28, 0x15, 0x3d, 0x84, 0x35, 0x71,
+	0x3b, 0xfa, 0xdc, 0xb2, 0x55, 0x11, 0x77, 0x4d, 0xf4, 0x02, 0x64, 0x9b, 0xba, 0xa6, 0xe8, 0x53,
+	0x38, 0x78, 0xdc, 0xf8, 0xd7, 0xcd, 0x35, 0xda, 0xb6, 0xd5, 0xa3, 0xae, 0xa9, 0x09, 0xfa, 0xb2,
+	0x6d, 0x99, 0xfb, 0x6c, 0x93, 0xff, 0x62, 0xdb, 0x1b, 0xc8, 0x19, 0xf3, 0xdd, 0x45, 0xce, 0xe6,
+	0x0e, 0x76, 0xfe, 0xfb, 0xff, 0xc5, 0xae, 0x5b, 0xf2, 0xd5, 0xcf, 0xed, 0x94, 0x96, 0xec, 0x80,
+	0xf6, 0xa1, 0x8c, 0x2d, 0xcb, 0x27, 0x16, 0xe6, 0x44, 0x5f, 0x24, 0x94, 0xac, 0xf0, 0xf7, 0xc1,
+	0x3c, 0x97, 0x70, 0xec, 0x0c, 0xf2, 0x46, 0xc2, 0x82, 0xe8, 0x16, 0x72, 0x07, 0xbb, 0xf7, 0xa8,
+	0x58, 0x54, 0xc4, 0x3a, 0x96, 0xbb, 0xa0, 0x1d, 0x28, 0x9a, 0x04, 0x9b, 0x63, 0xea, 0x12, 0x7d,
+	0x44, 0xa8, 0x35, 0xe2, 0xe2, 0x7c, 0x32, 0x5a, 0xe1, 0x0e, 0x3e, 0x16, 0xe8, 0xde, 0x57, 0x09,
+	0x56, 0xe3, 0xbd, 0xa2, 0x67, 0x50, 0x6e, 0xf7, 0x8e, 0xf4, 0x5e, 0xf7, 0xa4, 0xad, 0x9f, 0x9d,
+	0xf4, 0x4f, 0x3b, 0x87, 0xdd, 0xd7, 0xdd, 0x4e, 0xbb, 0x94, 0xaa, 0x6c, 0x5d, 0x5c, 0xaa, 0x28,
+	0xa6, 0x9d, 0xb9, 0x81, 0x47, 0x0c, 0x3a, 0xa4, 0xc4, 0x44, 0x4f, 0xa0, 0x38, 0xaf, 0xe8, 0x75,
+	0xde, 0x1f, 0x75, 0x4e, 0x4a, 0x52, 0x65, 0xf3, 0xe2, 0x52, 0xcd, 0xc7, 0xe4, 0x1e, 0x99, 0x59,
+	0xc4, 0x0d, 0xcf, 0x66, 0xce, 0xd3, 0x3a, 0xfd, 0xe3, 0x57, 0x5a, 0xa7, 0x94, 0xae, 0xa0, 0x8b,
+	0x4b, 0xb5, 0x70, 0x67, 0x2a, 0x11, 0xc7, 0x54, 0x91, 0x3f, 0x7d, 0xab, 0xa6, 0x5a, 0xad, 0xab,
+	0x9b, 0xaa, 0x74, 0x7d, 0x53, 0x95, 0x7e, 0xdd, 0x54, 0xa5, 0x2f, 0xb7, 0xd5, 0xd4, 0xf5, 0x6d,
+	0x35, 0xf5, 0xe3, 0xb6, 0x9a, 0xfa, 0x50, 0xb7, 0x28, 0x1f, 0x4d, 0x06, 0x0d, 0x83, 0x39, 0xcd,
+	0xc4, 0x3b, 0x76, 0x9e, 0x7c, 0xc9, 0xf8, 0xcc, 0x23, 0xc1, 0x20, 0x2b, 0x9e, 0xa4, 0xe7, 0xbf,
+	0x07, 0x00, 0x5d, 0xf2, 0x75, 0x2f, 0xef, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DkgTimeoutBlocks != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.DkgTimeoutBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxParticipants != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.MaxParticipants))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Group) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Group) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyVersion != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.KeyVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PublicKeyShares) > 0 {
+		for iNdEx := len(m.PublicKeyShares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeyShares[iNdEx])
+			copy(dAtA[i:], m.PublicKeyShares[iNdEx])
+			i = encodeVarintFrostgroup(dAtA, i, uint64(len(m.PublicKeyShares[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.VerificationKey) > 0 {
+		i -= len(m.VerificationKey)
+		copy(dAtA[i:], m.VerificationKey)
+		i = encodeVarintFrostgroup(dAtA, i, uint64(len(m.VerificationKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintFrostgroup(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Ciphersuite != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.Ciphersuite))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintFrostgroup(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintFrostgroup(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Dealer != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.Dealer))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeyShare) > 0 {
+		i -= len(m.PublicKeyShare)
+		copy(dAtA[i:], m.PublicKeyShare)
+		i = encodeVarintFrostgroup(dAtA, i, uint64(len(m.PublicKeyShare)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Participant != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.Participant))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFrostgroup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AggregateCommitment) > 0 {
+		for iNdEx := len(m.AggregateCommitment) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AggregateCommitment[iNdEx])
+			copy(dAtA[i:], m.AggregateCommitment[iNdEx])
+			i = encodeVarintFrostgroup(dAtA, i, uint64(len(m.AggregateCommitment[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFrostgroup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintFrostgroup(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Kind != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintFrostgroup(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFrostgroup(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFrostgroup(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxParticipants != 0 {
+		n += 1 + sovFrostgroup(uint64(m.MaxParticipants))
+	}
+	if m.DkgTimeoutBlocks != 0 {
+		n += 1 + sovFrostgroup(uint64(m.DkgTimeoutBlocks))
+	}
+	return n
+}
+
+func (m *Group) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFrostgroup(uint64(m.Id))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovFrostgroup(uint64(l))
+	}
+	if m.Ciphersuite != 0 {
+		n += 1 + sovFrostgroup(uint64(m.Ciphersuite))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovFrostgroup(uint64(m.Threshold))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovFrostgroup(uint64(l))
+		}
+	}
+	l = len(m.VerificationKey)
+	if l > 0 {
+		n += 1 + l + sovFrostgroup(uint64(l))
+	}
+	if len(m.PublicKeyShares) > 0 {
+		for _, b := range m.PublicKeyShares {
+			l = len(b)
+			n += 1 + l + sovFrostgroup(uint64(l))
+		}
+	}
+	if m.KeyVersion != 0 {
+		n += 1 + sovFrostgroup(uint64(m.KeyVersion))
+	}
+	return n
+}
+
+func (m *DkgCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dealer != 0 {
+		n += 1 + sovFrostgroup(uint64(m.Dealer))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovFrostgroup(uint64(l))
+	}
+	return n
+}
+
+func (m *DkgConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Participant != 0 {
+		n += 1 + sovFrostgroup(uint64(m.Participant))
+	}
+	l = len(m.PublicKeyShare)
+	if l > 0 {
+		n += 1 + l + sovFrostgroup(uint64(l))
+	}
+	return n
+}
+
+func (m *DkgSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovFrostgroup(uint64(m.GroupId))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovFrostgroup(uint64(m.Kind))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovFrostgroup(uint64(m.Threshold))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovFrostgroup(uint64(l))
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovFrostgroup(uint64(l))
+		}
+	}
+	if len(m.AggregateCommitment) > 0 {
+		for _, b := range m.AggregateCommitment {
+			l = len(b)
+			n += 1 + l + sovFrostgroup(uint64(l))
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovFrostgroup(uint64(l))
+		}
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovFrostgroup(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func sovFrostgroup(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFrostgroup(x uint64) (n int) {
+	return sovFrostgroup(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrostgroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxParticipants", wireType)
+			}
+			m.MaxParticipants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxParticipants |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgTimeoutBlocks", wireType)
+			}
+			m.DkgTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrostgroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Group) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrostgroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphersuite", wireType)
+			}
+			m.Ciphersuite = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ciphersuite |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationKey = append(m.VerificationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationKey == nil {
+				m.VerificationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeyShares = append(m.PublicKeyShares, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeyShares[len(m.PublicKeyShares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyVersion", wireType)
+			}
+			m.KeyVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrostgroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrostgroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			m.Dealer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dealer |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrostgroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrostgroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			m.Participant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Participant |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeyShare = append(m.PublicKeyShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKeyShare == nil {
+				m.PublicKeyShare = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrostgroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrostgroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= DkgKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, DkgCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateCommitment = append(m.AggregateCommitment, make([]byte, postIndex-iNdEx))
+			copy(m.AggregateCommitment[len(m.AggregateCommitment)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, DkgConfirmation{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrostgroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrostgroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFrostgroup(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFrostgroup
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrostgroup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFrostgroup
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFrostgroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFrostgroup
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFrostgroup        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFrostgroup          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFrostgroup = fmt.Errorf("proto: unexpected end of group")
+)
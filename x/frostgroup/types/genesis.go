@@ -0,0 +1,182 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		Groups:      []Group{},
+		Sessions:    []DkgSession{},
+		NextGroupId: 1,
+	}
+}
+
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	groups := make(map[uint64]Group, len(gs.Groups))
+	for _, g := range gs.Groups {
+		if _, ok := groups[g.Id]; ok {
+			return fmt.Errorf("duplicate group %d", g.Id)
+		}
+		if g.Id == 0 || g.Id >= gs.NextGroupId {
+			return fmt.Errorf("group id %d must be between 1 and next_group_id %d", g.Id, gs.NextGroupId)
+		}
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("invalid group %d: %w", g.Id, err)
+		}
+		groups[g.Id] = g
+	}
+
+	sessions := make(map[uint64]struct{}, len(gs.Sessions))
+	for _, s := range gs.Sessions {
+		if _, ok := sessions[s.GroupId]; ok {
+			return fmt.Errorf("duplicate session for group %d", s.GroupId)
+		}
+		sessions[s.GroupId] = struct{}{}
+
+		g, ok := groups[s.GroupId]
+		if !ok {
+			return fmt.Errorf("session for unknown group %d", s.GroupId)
+		}
+		if err := s.Validate(g); err != nil {
+			return fmt.Errorf("invalid session for group %d: %w", s.GroupId, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the roster of a group and, once it has a key, that the
+// key and the public key shares decode.
+func (g Group) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return fmt.Errorf("invalid admin: %w", err)
+	}
+	if _, err := Ciphersuite(g.Ciphersuite); err != nil {
+		return err
+	}
+	if err := ValidateRoster(g.Threshold, g.Participants, math.MaxUint16); err != nil {
+		return err
+	}
+
+	if g.KeyVersion == 0 {
+		if len(g.VerificationKey) != 0 || len(g.PublicKeyShares) != 0 {
+			return fmt.Errorf("group without a key version has key material")
+		}
+		return nil
+	}
+	if _, err := DecodeElement(g.Ciphersuite, g.VerificationKey); err != nil {
+		return fmt.Errorf("invalid verification key: %w", err)
+	}
+	if len(g.PublicKeyShares) != len(g.Participants) {
+		return fmt.Errorf("expected %d public key shares, got %d", len(g.Participants), len(g.PublicKeyShares))
+	}
+	for i, share := range g.PublicKeyShares {
+		if _, err := DecodeElement(g.Ciphersuite, share); err != nil {
+			return fmt.Errorf("invalid public key share %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// Dealers returns the roster that deals shares in the session: the new
+// participants for a key generation and the current roster of group for a
+// resharing.
+func (s DkgSession) Dealers(group Group) []string {
+	if s.Kind == DkgKindReshare {
+		return group.Participants
+	}
+	return s.Participants
+}
+
+// RequiredCommitments returns the number of dealers that commit before the
+// participants can confirm. Every participant deals in a key generation,
+// while a resharing needs the threshold of the current key.
+func (s DkgSession) RequiredCommitments(group Group) int {
+	if s.Kind == DkgKindReshare {
+		return int(group.Threshold)
+	}
+	return len(s.Participants)
+}
+
+// Validate checks the session against the group it runs for, including the
+// proofs of the commitments received so far.
+func (s DkgSession) Validate(group Group) error {
+	switch s.Kind {
+	case DkgKindKeygen:
+	case DkgKindReshare:
+		if group.KeyVersion == 0 {
+			return fmt.Errorf("resharing a group without a key")
+		}
+	default:
+		return fmt.Errorf("invalid kind %s", s.Kind)
+	}
+	if err := ValidateRoster(s.Threshold, s.Participants, math.MaxUint16); err != nil {
+		return err
+	}
+
+	dealers := s.Dealers(group)
+	required := s.RequiredCommitments(group)
+	if len(s.Commitments) > required {
+		return fmt.Errorf("expected at most %d commitments, got %d", required, len(s.Commitments))
+	}
+	seen := make(map[uint32]struct{}, len(s.Commitments))
+	for _, c := range s.Commitments {
+		if c.Dealer == 0 || int(c.Dealer) > len(dealers) {
+			return fmt.Errorf("unknown dealer %d", c.Dealer)
+		}
+		if _, ok := seen[c.Dealer]; ok {
+			return fmt.Errorf("duplicate commitment of dealer %d", c.Dealer)
+		}
+		seen[c.Dealer] = struct{}{}
+		if _, err := VerifyDkgCommitment(group.Ciphersuite, uint16(c.Dealer), s.Threshold, c.Data); err != nil {
+			return err
+		}
+	}
+
+	if len(s.Commitments) < required {
+		if len(s.AggregateCommitment) != 0 || len(s.Confirmations) != 0 {
+			return fmt.Errorf("confirmations before all the commitments")
+		}
+		return nil
+	}
+	aggregate, err := AggregateCommitment(group.Ciphersuite, s.Kind, s.Threshold, s.Commitments)
+	if err != nil {
+		return err
+	}
+	if len(aggregate) != len(s.AggregateCommitment) {
+		return fmt.Errorf("aggregate commitment does not match the commitments")
+	}
+	for i := range aggregate {
+		if !bytes.Equal(aggregate[i], s.AggregateCommitment[i]) {
+			return fmt.Errorf("aggregate commitment does not match the commitments")
+		}
+	}
+
+	if len(s.Confirmations) >= len(s.Participants) {
+		return fmt.Errorf("all the participants confirmed but the session is not complete")
+	}
+
+	confirmed := make(map[uint32]struct{}, len(s.Confirmations))
+	for _, c := range s.Confirmations {
+		if c.Participant == 0 || int(c.Participant) > len(s.Participants) {
+			return fmt.Errorf("unknown participant %d", c.Participant)
+		}
+		if _, ok := confirmed[c.Participant]; ok {
+			return fmt.Errorf("duplicate confirmation of participant %d", c.Participant)
+		}
+		confirmed[c.Participant] = struct{}{}
+		if err := VerifyPublicKeyShare(group.Ciphersuite, uint16(c.Participant), s.AggregateCommitment, c.PublicKeyShare); err != nil {
+			return err
+		}
+	}
+	return nil
+}
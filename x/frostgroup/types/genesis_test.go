@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/precompiles/frost/bytemare-stable/frost"
+	"github.com/cosmos/evm/x/frostgroup/testutil"
+	"github.com/cosmos/evm/x/frostgroup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func roster(n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = sdk.AccAddress(fmt.Sprintf("member_%013d", i)).String()
+	}
+	return out
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	cs := uint32(frost.Ed25519)
+	round1, shares, err := testutil.Keygen(cs, 2, 3)
+	require.NoError(t, err)
+
+	group := types.Group{
+		Id:              1,
+		Admin:           sdk.AccAddress("admin_addr_123456789").String(),
+		Ciphersuite:     cs,
+		Threshold:       2,
+		Participants:    roster(3),
+		VerificationKey: shares[0].VerificationKey.Encode(),
+		KeyVersion:      1,
+	}
+	for _, s := range shares {
+		group.PublicKeyShares = append(group.PublicKeyShares, s.PublicKey.Encode())
+	}
+	pending := types.Group{Id: 2, Admin: group.Admin, Ciphersuite: cs, Threshold: 2, Participants: roster(3)}
+	session := types.DkgSession{
+		GroupId:        2,
+		Kind:           types.DkgKindKeygen,
+		Threshold:      2,
+		Participants:   pending.Participants,
+		Commitments:    []types.DkgCommitment{{Dealer: 1, Data: round1[0]}},
+		DeadlineHeight: 100,
+	}
+
+	missingShare := group
+	missingShare.PublicKeyShares = group.PublicKeyShares[:2]
+	keyWithoutVersion := group
+	keyWithoutVersion.KeyVersion = 0
+	wrongDealer := session
+	wrongDealer.Commitments = []types.DkgCommitment{{Dealer: 2, Data: round1[0]}}
+	earlyConfirmation := session
+	earlyConfirmation.Confirmations = []types.DkgConfirmation{{Participant: 1, PublicKeyShare: group.PublicKeyShares[0]}}
+	reshareWithoutKey := session
+	reshareWithoutKey.Kind = types.DkgKindReshare
+	unknownGroup := session
+	unknownGroup.GroupId = 3
+
+	testCases := []struct {
+		name        string
+		groups      []types.Group
+		sessions    []types.DkgSession
+		nextGroupID uint64
+		expectError bool
+	}{
+		{"default", nil, nil, 1, false},
+		{"with groups and session", []types.Group{group, pending}, []types.DkgSession{session}, 3, false},
+		{"group id not below next id", []types.Group{group, pending}, nil, 2, true},
+		{"duplicate group", []types.Group{group, group}, nil, 3, true},
+		{"missing public key share", []types.Group{missingShare}, nil, 3, true},
+		{"key without version", []types.Group{keyWithoutVersion}, nil, 3, true},
+		{"commitment of another dealer", []types.Group{group, pending}, []types.DkgSession{wrongDealer}, 3, true},
+		{"confirmation before commitments", []types.Group{group, pending}, []types.DkgSession{earlyConfirmation}, 3, true},
+		{"reshare without key", []types.Group{group, pending}, []types.DkgSession{reshareWithoutKey}, 3, true},
+		{"session for unknown group", []types.Group{group, pending}, []types.DkgSession{unknownGroup}, 3, true},
+		{"duplicate session", []types.Group{group, pending}, []types.DkgSession{session, session}, 3, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.GenesisState{
+				Params:      types.DefaultParams(),
+				Groups:      tc.groups,
+				Sessions:    tc.sessions,
+				NextGroupId: tc.nextGroupID,
+			}
+			err := gs.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+	require.NoError(t, types.DefaultGenesisState().Validate())
+}
@@ -0,0 +1,35 @@
+package types
+
+import "encoding/binary"
+
+const (
+	ModuleName = "frostgroup"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+)
+
+const (
+	prefixParams = iota + 1
+	prefixNextGroupID
+	prefixGroup
+	prefixDkgSession
+)
+
+var (
+	KeyParams           = []byte{prefixParams}
+	KeyNextGroupID      = []byte{prefixNextGroupID}
+	KeyPrefixGroup      = []byte{prefixGroup}
+	KeyPrefixDkgSession = []byte{prefixDkgSession}
+)
+
+// GetGroupKey returns the key of a registered group.
+func GetGroupKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, KeyPrefixGroup...), id)
+}
+
+// GetDkgSessionKey returns the key of the session in progress for a group.
+func GetDkgSessionKey(groupID uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, KeyPrefixDkgSession...), groupID)
+}
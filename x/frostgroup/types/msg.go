@@ -0,0 +1,125 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateGroup{}
+	_ sdk.Msg = &MsgSubmitDkgCommitment{}
+	_ sdk.Msg = &MsgConfirmDkg{}
+	_ sdk.Msg = &MsgRotateGroupKey{}
+	_ sdk.Msg = &MsgReshareGroup{}
+	_ sdk.Msg = &MsgCancelDkg{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// ValidateBasic checks the roster against the largest one identifiers allow.
+// The max_participants param is checked by the msg server.
+func (m *MsgCreateGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+	if _, err := Ciphersuite(m.Ciphersuite); err != nil {
+		return errorsmod.Wrap(ErrInvalidGroup, err.Error())
+	}
+	if err := ValidateRoster(m.Threshold, m.Participants, math.MaxUint16); err != nil {
+		return errorsmod.Wrap(ErrInvalidGroup, err.Error())
+	}
+	return nil
+}
+
+func (m MsgCreateGroup) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgSubmitDkgCommitment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Dealer); err != nil {
+		return errorsmod.Wrap(err, "invalid dealer address")
+	}
+	if len(m.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidCommitment, "data cannot be empty")
+	}
+	return nil
+}
+
+func (m MsgSubmitDkgCommitment) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgConfirmDkg) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Participant); err != nil {
+		return errorsmod.Wrap(err, "invalid participant address")
+	}
+	if len(m.PublicKeyShare) == 0 {
+		return errorsmod.Wrap(ErrInvalidConfirmation, "public key share cannot be empty")
+	}
+	return nil
+}
+
+func (m MsgConfirmDkg) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgRotateGroupKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+	return nil
+}
+
+func (m MsgRotateGroupKey) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgReshareGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+	if err := ValidateRoster(m.Threshold, m.Participants, math.MaxUint16); err != nil {
+		return errorsmod.Wrap(ErrInvalidGroup, err.Error())
+	}
+	return nil
+}
+
+func (m MsgReshareGroup) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgCancelDkg) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+	return nil
+}
+
+func (m MsgCancelDkg) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if m.Params == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "params cannot be nil")
+	}
+	return m.Params.Validate()
+}
+
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{addr}
+}
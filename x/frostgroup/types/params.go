@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultMaxParticipants bounds the cost of verifying a confirmation,
+	// which grows with the threshold, and of aggregating the commitments.
+	DefaultMaxParticipants = 100
+	// DefaultDkgTimeoutBlocks is about a day of 6 second blocks.
+	DefaultDkgTimeoutBlocks = 14_400
+)
+
+// The curve operations of a key generation are priced after the ecMul (6,000)
+// and ecAdd (150) precompiles of EIP-1108, and charged by the keeper so that
+// messages and precompile calls pay the same.
+const (
+	// GasDkgCommitmentProof is charged for every submitted commitment to
+	// verify its proof of knowledge, two scalar multiplications.
+	GasDkgCommitmentProof = 12_000
+	// GasPerCommitmentCoefficient is charged for every coefficient of a
+	// submitted commitment, which is decoded, and again for every
+	// coefficient of every commitment added to the aggregate commitment.
+	GasPerCommitmentCoefficient = 1_000
+	// GasPerResharedCoefficient replaces GasPerCommitmentCoefficient in the
+	// aggregation of a resharing, where every coefficient is also multiplied
+	// by the dealer's Lagrange coefficient.
+	GasPerResharedCoefficient = 7_000
+	// GasConfirmDkgPerCoefficient is charged for every coefficient of the
+	// aggregate commitment when checking a confirmation, one scalar
+	// multiplication each to evaluate the public key share.
+	GasConfirmDkgPerCoefficient = 6_000
+)
+
+func DefaultParams() Params {
+	return Params{
+		MaxParticipants:  DefaultMaxParticipants,
+		DkgTimeoutBlocks: DefaultDkgTimeoutBlocks,
+	}
+}
+
+func (p Params) Validate() error {
+	if p.MaxParticipants < 2 || p.MaxParticipants > math.MaxUint16 {
+		return fmt.Errorf("max_participants must be between 2 and %d, got %d", math.MaxUint16, p.MaxParticipants)
+	}
+	if p.DkgTimeoutBlocks == 0 {
+		return fmt.Errorf("dkg_timeout_blocks must be positive")
+	}
+	return nil
+}
@@ -34,6 +34,7 @@ type ContractKeeper struct {
 	authKeeper            types.AccountKeeper
 	evmKeeper             types.EVMKeeper
 	erc20Keeper           types.ERC20Keeper
+	ibcBreakerKeeper      types.IbcBreakerKeeper
 	packetDataUnmarshaler porttypes.PacketDataUnmarshaler
 }
 
@@ -41,11 +42,17 @@ type ContractKeeper struct {
 //
 // The ContractKeeper manages cross-chain contract execution and handles IBC packet
 // callbacks for smart contract interactions.
-func NewKeeper(authKeeper types.AccountKeeper, evmKeeper types.EVMKeeper, erc20Keeper types.ERC20Keeper) ContractKeeper {
+func NewKeeper(
+	authKeeper types.AccountKeeper,
+	evmKeeper types.EVMKeeper,
+	erc20Keeper types.ERC20Keeper,
+	ibcBreakerKeeper types.IbcBreakerKeeper,
+) ContractKeeper {
 	ck := ContractKeeper{
-		authKeeper:  authKeeper,
-		evmKeeper:   evmKeeper,
-		erc20Keeper: erc20Keeper,
+		authKeeper:       authKeeper,
+		evmKeeper:        evmKeeper,
+		erc20Keeper:      erc20Keeper,
+		ibcBreakerKeeper: ibcBreakerKeeper,
 	}
 	ck.packetDataUnmarshaler = types.Unmarshaler{}
 	return ck
@@ -77,7 +84,7 @@ func (k ContractKeeper) IBCSendPacketCallback(
 //
 // The function performs the following operations:
 // 1. Unmarshals and validates the IBC packet data
-// 2. Extracts callback data from the packet
+// 2. Extracts callback data from the packet and checks that IBC is available
 // 3. Generates an isolated address for security
 // 4. Validates the receiver address matches the isolated address
 // 5. Verifies the target contract exists and contains code
@@ -89,6 +96,7 @@ func (k ContractKeeper) IBCSendPacketCallback(
 //   - error: Returns nil on success, or an error if any step fails including:
 //   - Packet data unmarshaling errors
 //   - Invalid callback data
+//   - IBC disabled by the IBC breaker
 //   - Address validation failures
 //   - Contract validation failures (non-existent or no code)
 //   - Token pair registration errors
@@ -122,6 +130,12 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 		return nil
 	}
 
+	// Contract execution triggered by an incoming packet is an IBC entry point
+	// into the EVM, so it is refused while the IBC breaker has IBC disabled.
+	if err := k.ibcBreakerKeeper.CheckIbcAvailable(ctx); err != nil {
+		return err
+	}
+
 	// `ProcessCallback` in IBC-Go overrides the infinite gas meter with a basic gas meter,
 	// so we need to generate a new infinite gas meter to run the EVM executions on.
 	// Skipping this causes the EVM gas estimation function to deplete all Cosmos gas.
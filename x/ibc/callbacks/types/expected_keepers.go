@@ -48,3 +48,8 @@ type ERC20Keeper interface {
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	BalanceOf(ctx sdk.Context, abi abi.ABI, contract, account common.Address) *big.Int
 }
+
+// IbcBreakerKeeper defines the expected IBC breaker keeper interface.
+type IbcBreakerKeeper interface {
+	CheckIbcAvailable(ctx sdk.Context) error
+}
@@ -35,11 +35,14 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	if k.circuitKeeper != nil && !k.circuitKeeper.GetSystemAvailable(ctx) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "system unavailable")
 	}
+	if k.circuitKeeper != nil && k.circuitKeeper.IsMsgTypeDisabled(ctx, sdk.MsgTypeURL(msg)) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "circuit tripped: message type %s is disabled", sdk.MsgTypeURL(msg))
+	}
 
 	// Enforce ibcbreaker at module execution level so both Cosmos and EVM
 	// transfer paths are blocked when IBC transfers are disabled.
-	if k.ibcBreakerKeeper != nil && !k.ibcBreakerKeeper.GetIbcAvailable(ctx) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "ibc unavailable")
+	if err := k.checkIbcBreaker(ctx, msg.SourcePort, msg.SourceChannel, msg.Token.Denom); err != nil {
+		return nil, err
 	}
 
 	// NOTE: Do not override KV gas config in this user-facing MsgTransfer path.
@@ -70,6 +73,9 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 
 	// update the msg denom to the token pair denom
 	msg.Token.Denom = pair.Denom
+	if err := k.checkIbcBreaker(ctx, msg.SourcePort, msg.SourceChannel, msg.Token.Denom); err != nil {
+		return nil, err
+	}
 
 	if !pair.IsNativeERC20() {
 		return k.Keeper.Transfer(ctx, msg)
@@ -119,3 +125,11 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 
 	return k.Keeper.Transfer(ctx, msg)
 }
+
+// checkIbcBreaker runs the ibcbreaker transfer guard when the keeper is wired.
+func (k Keeper) checkIbcBreaker(ctx sdk.Context, sourcePort, sourceChannel, denom string) error {
+	if k.ibcBreakerKeeper == nil {
+		return nil
+	}
+	return k.ibcBreakerKeeper.CheckTransfer(ctx, sourcePort, sourceChannel, denom)
+}
@@ -39,10 +39,11 @@ type ERC20Keeper interface {
 
 // IbcBreakerKeeper defines the expected IBC breaker keeper interface.
 type IbcBreakerKeeper interface {
-	GetIbcAvailable(ctx sdk.Context) bool
+	CheckTransfer(ctx sdk.Context, sourcePort, sourceChannel, denom string) error
 }
 
 // CircuitKeeper defines the expected circuit breaker keeper interface.
 type CircuitKeeper interface {
 	GetSystemAvailable(ctx sdk.Context) bool
+	IsMsgTypeDisabled(ctx sdk.Context, typeURL string) bool
 }
@@ -23,14 +23,24 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewUpdateIbcBreakerCmd(),
+		NewVoteProposalCmd(),
 	)
 	return txCmd
 }
 
+const (
+	flagExpiresAtHeight = "expires-at-height"
+	flagReason          = "reason"
+	flagDeniedChannels  = "denied-channels"
+	flagDeniedClients   = "denied-clients"
+	flagDeniedDenoms    = "denied-denoms"
+	flagInboundPaused   = "inbound-paused"
+)
+
 func NewUpdateIbcBreakerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-ibcbreaker [IBC_AVAILABLE]",
-		Short: "Update the IBC availability flag",
+		Short: "Update the IBC availability flag and deny sets",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -43,9 +53,79 @@ func NewUpdateIbcBreakerCmd() *cobra.Command {
 				return err
 			}
 
+			expiresAtHeight, err := cmd.Flags().GetUint64(flagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(flagReason)
+			if err != nil {
+				return err
+			}
+
+			deniedChannels, err := cmd.Flags().GetStringSlice(flagDeniedChannels)
+			if err != nil {
+				return err
+			}
+
+			deniedClients, err := cmd.Flags().GetStringSlice(flagDeniedClients)
+			if err != nil {
+				return err
+			}
+
+			deniedDenoms, err := cmd.Flags().GetStringSlice(flagDeniedDenoms)
+			if err != nil {
+				return err
+			}
+
+			inboundPaused, err := cmd.Flags().GetBool(flagInboundPaused)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUpdateIbcBreaker{
-				Signer:       clientCtx.GetFromAddress().String(),
-				IbcAvailable: available,
+				Signer:          clientCtx.GetFromAddress().String(),
+				IbcAvailable:    available,
+				ExpiresAtHeight: expiresAtHeight,
+				Reason:          reason,
+				DeniedChannels:  deniedChannels,
+				DeniedClients:   deniedClients,
+				DeniedDenoms:    deniedDenoms,
+				InboundPaused:   inboundPaused,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagExpiresAtHeight, 0, "Block height at which the trip is automatically restored (0 for no expiry)")
+	cmd.Flags().String(flagReason, "", "Justification recorded in the ibcbreaker action log")
+	cmd.Flags().StringSlice(flagDeniedChannels, []string{}, "Comma-separated IBC channel IDs to deny")
+	cmd.Flags().StringSlice(flagDeniedClients, []string{}, "Comma-separated IBC client IDs to deny")
+	cmd.Flags().StringSlice(flagDeniedDenoms, []string{}, "Comma-separated denominations to deny for IBC transfers")
+	cmd.Flags().Bool(flagInboundPaused, false, "Reject incoming transfer packets with an error acknowledgement")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+func NewVoteProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-proposal [PROPOSAL_ID]",
+		Short: "Approve a pending ibcbreaker proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgVoteProposal{
+				Signer:     clientCtx.GetFromAddress().String(),
+				ProposalId: proposalID,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
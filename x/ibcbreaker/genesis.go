@@ -9,14 +9,24 @@ import (
 
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
-	k.SetIbcAvailable(ctx, genState.State.IbcAvailable)
+	k.SetIbcBreakerState(ctx, genState.State)
+	for _, proposal := range genState.Proposals {
+		k.SetProposal(ctx, proposal)
+	}
+	if genState.NextProposalId != 0 {
+		k.SetNextProposalID(ctx, genState.NextProposalId)
+	}
+	for _, action := range genState.Actions {
+		k.SetAction(ctx, action)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		State: types.IbcBreakerState{
-			IbcAvailable: k.GetIbcAvailable(ctx),
-		},
+		Params:         k.GetParams(ctx),
+		State:          k.GetIbcBreakerState(ctx),
+		Proposals:      k.GetProposals(ctx),
+		NextProposalId: k.GetNextProposalID(ctx),
+		Actions:        k.GetActions(ctx),
 	}
 }
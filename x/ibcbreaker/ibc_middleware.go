@@ -0,0 +1,63 @@
+package ibcbreaker
+
+import (
+	"errors"
+
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/x/ibcbreaker/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ porttypes.IBCModule             = &IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = &IBCMiddleware{}
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the ibcbreaker middleware.
+// It rejects incoming packets while inbound transfers are paused and forwards
+// every other callback to the underlying application.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper types.IbcBreakerKeeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k types.IbcBreakerKeeper, app porttypes.IBCModule) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+	if k == nil {
+		panic(errors.New("ibcbreaker keeper cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// While inbound transfers are paused it returns an error acknowledgement
+// without calling the underlying application, so no vouchers are minted and
+// the sender is refunded on the source chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	if im.keeper.GetInboundPaused(ctx) {
+		im.keeper.Logger(ctx).Info(
+			"rejected inbound packet",
+			"destination_channel", packet.DestinationChannel,
+			"sequence", packet.Sequence,
+		)
+		return channeltypes.NewErrorAcknowledgement(types.ErrInboundPaused)
+	}
+
+	return im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
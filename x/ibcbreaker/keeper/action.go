@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/ibcbreaker/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppendAction records an applied ibcbreaker state change in the action log.
+func (k Keeper) AppendAction(ctx sdk.Context, signers []string, oldState, newState types.IbcBreakerState, reason string) {
+	id := k.GetNextActionID(ctx)
+	k.SetAction(ctx, types.IbcBreakerAction{
+		Id:       id,
+		Signers:  signers,
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime(),
+		OldState: oldState,
+		NewState: newState,
+		Reason:   reason,
+	})
+}
+
+// SetAction stores action and advances the next action id past it.
+func (k Keeper) SetAction(ctx sdk.Context, action types.IbcBreakerAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActionKey(action.Id), k.cdc.MustMarshal(&action))
+	if action.Id >= k.GetNextActionID(ctx) {
+		store.Set(types.KeyNextActionID, sdk.Uint64ToBigEndian(action.Id+1))
+	}
+}
+
+// GetActions returns the whole action log ordered by id.
+func (k Keeper) GetActions(ctx sdk.Context) []types.IbcBreakerAction {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixAction)
+	defer iterator.Close()
+
+	actions := []types.IbcBreakerAction{}
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.IbcBreakerAction
+		k.cdc.MustUnmarshal(iterator.Value(), &action)
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// GetNextActionID returns the id assigned to the next logged action.
+func (k Keeper) GetNextActionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextActionID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
@@ -5,7 +5,12 @@ import (
 
 	"github.com/cosmos/evm/x/ibcbreaker/types"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -15,7 +20,9 @@ func (k Keeper) IbcAvailable(c context.Context, _ *types.QueryIbcAvailableReques
 	available := k.GetIbcAvailable(ctx)
 
 	return &types.QueryIbcAvailableResponse{
-		IbcAvailable: available,
+		IbcAvailable:    available,
+		ExpiresAtHeight: k.GetTripExpiresAtHeight(ctx),
+		InboundPaused:   k.GetInboundPaused(ctx),
 	}, nil
 }
 
@@ -27,3 +34,78 @@ func (k Keeper) Whitelist(c context.Context, _ *types.QueryWhitelistRequest) (*t
 		Whitelist: params.Whitelist,
 	}, nil
 }
+
+func (k Keeper) DeniedChannels(c context.Context, _ *types.QueryDeniedChannelsRequest) (*types.QueryDeniedChannelsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDeniedChannelsResponse{
+		DeniedChannels: k.GetDeniedChannels(ctx),
+	}, nil
+}
+
+func (k Keeper) DeniedClients(c context.Context, _ *types.QueryDeniedClientsRequest) (*types.QueryDeniedClientsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDeniedClientsResponse{
+		DeniedClients: k.GetDeniedClients(ctx),
+	}, nil
+}
+
+func (k Keeper) DeniedDenoms(c context.Context, _ *types.QueryDeniedDenomsRequest) (*types.QueryDeniedDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDeniedDenomsResponse{
+		DeniedDenoms: k.GetDeniedDenoms(ctx),
+	}, nil
+}
+
+func (k Keeper) Proposals(c context.Context, _ *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryProposalsResponse{
+		Proposals: k.GetProposals(ctx),
+	}, nil
+}
+
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, found := k.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "proposal %d not found", req.ProposalId)
+	}
+
+	return &types.QueryProposalResponse{
+		Proposal: proposal,
+	}, nil
+}
+
+func (k Keeper) Actions(c context.Context, req *types.QueryActionsRequest) (*types.QueryActionsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAction)
+
+	actions := []types.IbcBreakerAction{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var action types.IbcBreakerAction
+		if err := k.cdc.Unmarshal(value, &action); err != nil {
+			return err
+		}
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryActionsResponse{
+		Actions:    actions,
+		Pagination: pageRes,
+	}, nil
+}
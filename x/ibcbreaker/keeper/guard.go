@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/ibcbreaker/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CheckIbcAvailable returns ErrIbcUnavailable while the breaker has IBC
+// disabled. Module and precompile entry points that do not go through the
+// ante handler call it to enforce the breaker themselves.
+func (k Keeper) CheckIbcAvailable(ctx sdk.Context) error {
+	if !k.GetIbcAvailable(ctx) {
+		return types.ErrIbcUnavailable
+	}
+	return nil
+}
+
+// CheckClient returns ErrIbcUnavailable while IBC is disabled or the given
+// light client is denied.
+func (k Keeper) CheckClient(ctx sdk.Context, clientID string) error {
+	if err := k.CheckIbcAvailable(ctx); err != nil {
+		return err
+	}
+	if k.IsClientDenied(ctx, clientID) {
+		return errorsmod.Wrapf(types.ErrIbcUnavailable, "client %s is denied", clientID)
+	}
+	return nil
+}
+
+// SourceClientID returns the light client a transfer from the given port and
+// channel is sent through. IBC v1 channels are resolved through their
+// connection, while IBC v2 transfers carry the client ID in the channel field.
+func (k Keeper) SourceClientID(ctx sdk.Context, portID, channelID string) string {
+	if _, connection, err := k.channelKeeper.GetChannelConnection(ctx, portID, channelID); err == nil {
+		return connection.ClientId
+	}
+	return channelID
+}
+
+// CheckTransfer returns ErrIbcUnavailable while IBC is disabled or the
+// transfer uses a denied channel, a channel on a denied client or a denied
+// denom.
+func (k Keeper) CheckTransfer(ctx sdk.Context, sourcePort, sourceChannel, denom string) error {
+	if err := k.CheckIbcAvailable(ctx); err != nil {
+		return err
+	}
+	if k.IsChannelDenied(ctx, sourceChannel) {
+		return errorsmod.Wrapf(types.ErrIbcUnavailable, "channel %s is denied", sourceChannel)
+	}
+	if clientID := k.SourceClientID(ctx, sourcePort, sourceChannel); k.IsClientDenied(ctx, clientID) {
+		return errorsmod.Wrapf(types.ErrIbcUnavailable, "client %s is denied", clientID)
+	}
+	if k.IsDenomDenied(ctx, denom) {
+		return errorsmod.Wrapf(types.ErrIbcUnavailable, "denom %s is denied", denom)
+	}
+	return nil
+}
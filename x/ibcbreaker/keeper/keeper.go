@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"strconv"
+
 	"github.com/cosmos/evm/x/ibcbreaker/types"
 
 	"cosmossdk.io/log"
+	prefixstore "cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -11,19 +14,26 @@ import (
 )
 
 type Keeper struct {
-	cdc       codec.BinaryCodec
-	storeKey  storetypes.StoreKey
-	authority sdk.AccAddress
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	authority     sdk.AccAddress
+	channelKeeper types.ChannelKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority sdk.AccAddress) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
+	channelKeeper types.ChannelKeeper,
+) Keeper {
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
 		panic(err) //nolint:halt // Constructor guard: ibcbreaker authority wiring must be valid at boot.
 	}
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		authority: authority,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		authority:     authority,
+		channelKeeper: channelKeeper,
 	}
 }
 
@@ -68,6 +78,127 @@ func (k Keeper) GetIbcAvailable(ctx sdk.Context) bool {
 	return bz[0] == 1
 }
 
+// GetTripExpiresAtHeight returns the height at which the current trip is
+// automatically restored, or zero if it does not expire.
+func (k Keeper) GetTripExpiresAtHeight(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyTripExpiry)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetTripExpiresAtHeight(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if height == 0 {
+		store.Delete(types.KeyTripExpiry)
+		return
+	}
+	store.Set(types.KeyTripExpiry, sdk.Uint64ToBigEndian(height))
+}
+
+// GetInboundPaused reports whether incoming transfer packets are rejected.
+func (k Keeper) GetInboundPaused(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyInboundPaused)
+}
+
+func (k Keeper) SetInboundPaused(ctx sdk.Context, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !paused {
+		store.Delete(types.KeyInboundPaused)
+		return
+	}
+	store.Set(types.KeyInboundPaused, []byte{1})
+}
+
+func (k Keeper) GetDeniedChannels(ctx sdk.Context) []string {
+	return k.getDeniedSet(ctx, types.KeyPrefixDeniedChannel)
+}
+
+func (k Keeper) SetDeniedChannels(ctx sdk.Context, channelIDs []string) {
+	k.setDeniedSet(ctx, types.KeyPrefixDeniedChannel, channelIDs)
+}
+
+func (k Keeper) GetDeniedClients(ctx sdk.Context) []string {
+	return k.getDeniedSet(ctx, types.KeyPrefixDeniedClient)
+}
+
+func (k Keeper) SetDeniedClients(ctx sdk.Context, clientIDs []string) {
+	k.setDeniedSet(ctx, types.KeyPrefixDeniedClient, clientIDs)
+}
+
+func (k Keeper) GetDeniedDenoms(ctx sdk.Context) []string {
+	return k.getDeniedSet(ctx, types.KeyPrefixDeniedDenom)
+}
+
+func (k Keeper) SetDeniedDenoms(ctx sdk.Context, denoms []string) {
+	k.setDeniedSet(ctx, types.KeyPrefixDeniedDenom, denoms)
+}
+
+// IsChannelDenied reports whether outbound traffic on the given channel is
+// rejected.
+func (k Keeper) IsChannelDenied(ctx sdk.Context, channelID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DeniedChannelKey(channelID))
+}
+
+// IsClientDenied reports whether outbound traffic through the given client is
+// rejected.
+func (k Keeper) IsClientDenied(ctx sdk.Context, clientID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DeniedClientKey(clientID))
+}
+
+// IsDenomDenied reports whether the given denomination cannot be transferred
+// over IBC.
+func (k Keeper) IsDenomDenied(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DeniedDenomKey(denom))
+}
+
+// GetIbcBreakerState returns the availability flag, deny sets and trip expiry.
+func (k Keeper) GetIbcBreakerState(ctx sdk.Context) types.IbcBreakerState {
+	return types.IbcBreakerState{
+		IbcAvailable:    k.GetIbcAvailable(ctx),
+		ExpiresAtHeight: k.GetTripExpiresAtHeight(ctx),
+		DeniedChannels:  k.GetDeniedChannels(ctx),
+		DeniedClients:   k.GetDeniedClients(ctx),
+		DeniedDenoms:    k.GetDeniedDenoms(ctx),
+		InboundPaused:   k.GetInboundPaused(ctx),
+	}
+}
+
+func (k Keeper) SetIbcBreakerState(ctx sdk.Context, state types.IbcBreakerState) {
+	k.SetIbcAvailable(ctx, state.IbcAvailable)
+	k.SetTripExpiresAtHeight(ctx, state.ExpiresAtHeight)
+	k.SetDeniedChannels(ctx, state.DeniedChannels)
+	k.SetDeniedClients(ctx, state.DeniedClients)
+	k.SetDeniedDenoms(ctx, state.DeniedDenoms)
+	k.SetInboundPaused(ctx, state.InboundPaused)
+}
+
+// BeginBlocker prunes proposals whose voting window has ended and restores
+// IBC, clearing every deny set and the inbound pause, once a time-boxed trip
+// reaches its expiry height.
+func (k Keeper) BeginBlocker(ctx sdk.Context) error {
+	k.pruneExpiredProposals(ctx)
+
+	expiresAtHeight := k.GetTripExpiresAtHeight(ctx)
+	if expiresAtHeight == 0 || uint64(ctx.BlockHeight()) < expiresAtHeight { //#nosec G115 -- block height is never negative
+		return nil
+	}
+
+	current := k.GetIbcBreakerState(ctx)
+	restored := types.IbcBreakerState{IbcAvailable: true}
+	k.SetIbcBreakerState(ctx, restored)
+	k.AppendAction(ctx, nil, current, restored, "trip expired")
+	k.Logger(ctx).Info("ibc breaker trip expired, ibc restored", "expires_at_height", expiresAtHeight)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRestore,
+			sdk.NewAttribute(types.AttributeKeyExpiresAtHeight, strconv.FormatUint(expiresAtHeight, 10)),
+		),
+	)
+	return nil
+}
+
 func (k Keeper) SetIbcAvailable(ctx sdk.Context, available bool) {
 	store := ctx.KVStore(k.storeKey)
 	if available {
@@ -76,3 +207,31 @@ func (k Keeper) SetIbcAvailable(ctx sdk.Context, available bool) {
 	}
 	store.Set(types.KeyState, []byte{0})
 }
+
+func (k Keeper) getDeniedSet(ctx sdk.Context, prefix []byte) []string {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	entries := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, string(iterator.Key()[len(prefix):]))
+	}
+	return entries
+}
+
+func (k Keeper) setDeniedSet(ctx sdk.Context, prefix []byte, entries []string) {
+	store := prefixstore.NewStore(ctx.KVStore(k.storeKey), prefix)
+	iterator := store.Iterator(nil, nil)
+	var existing [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		existing = append(existing, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range existing {
+		store.Delete(key)
+	}
+	for _, entry := range entries {
+		store.Set([]byte(entry), []byte{1})
+	}
+}
@@ -8,6 +8,9 @@ import (
 	cmttypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/x/ibcbreaker/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	"cosmossdk.io/log"
 	store "cosmossdk.io/store"
@@ -19,6 +22,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// fakeChannelKeeper opens channel-0 on a connection of 07-tendermint-0.
+type fakeChannelKeeper struct{}
+
+func (fakeChannelKeeper) GetChannelConnection(_ sdk.Context, portID, channelID string) (string, connectiontypes.ConnectionEnd, error) {
+	if portID != transfertypes.PortID || channelID != "channel-0" {
+		return "", connectiontypes.ConnectionEnd{}, channeltypes.ErrChannelNotFound
+	}
+	return "connection-0", connectiontypes.ConnectionEnd{ClientId: "07-tendermint-0"}, nil
+}
+
 func setupKeeper(t *testing.T) (Keeper, sdk.Context) {
 	t.Helper()
 	cfg := sdk.GetConfig()
@@ -35,7 +48,7 @@ func setupKeeper(t *testing.T) (Keeper, sdk.Context) {
 	cdc := codec.NewProtoCodec(ir)
 	authority := sdk.AccAddress([]byte("authority_addr_12345"))
 
-	return NewKeeper(cdc, storeKey, authority), ctx
+	return NewKeeper(cdc, storeKey, authority, fakeChannelKeeper{}), ctx
 }
 
 func TestUpdateIbcBreakerSkipsNoOpStateWrite(t *testing.T) {
@@ -60,3 +73,374 @@ func TestUpdateIbcBreakerSkipsNoOpStateWrite(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, k.GetIbcAvailable(ctx))
 }
+
+func TestUpdateIbcBreakerTripExpiry(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	operator := sdk.AccAddress([]byte("operator_1"))
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}, MaxTripDurationBlocks: 20})
+	k.SetIbcAvailable(ctx, true)
+
+	_, err := srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:          operator.String(),
+		IbcAvailable:    true,
+		ExpiresAtHeight: 110,
+	})
+	require.Error(t, err)
+
+	_, err = srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:          operator.String(),
+		IbcAvailable:    false,
+		ExpiresAtHeight: 121,
+	})
+	require.Error(t, err)
+	require.True(t, k.GetIbcAvailable(ctx))
+
+	_, err = srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:       operator.String(),
+		IbcAvailable: false,
+	})
+	require.NoError(t, err)
+	require.False(t, k.GetIbcAvailable(ctx))
+	require.Equal(t, uint64(120), k.GetTripExpiresAtHeight(ctx))
+
+	resp, err := k.IbcAvailable(ctx, &types.QueryIbcAvailableRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), resp.ExpiresAtHeight)
+
+	_, err = srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:       operator.String(),
+		IbcAvailable: true,
+	})
+	require.NoError(t, err)
+	require.True(t, k.GetIbcAvailable(ctx))
+	require.Zero(t, k.GetTripExpiresAtHeight(ctx))
+}
+
+func TestUpdateIbcBreakerQuorum(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	op1 := sdk.AccAddress([]byte("operator_1")).String()
+	op2 := sdk.AccAddress([]byte("operator_2")).String()
+	op3 := sdk.AccAddress([]byte("operator_3")).String()
+	k.SetParams(ctx, types.Params{
+		Whitelist:            []string{op1, op2, op3},
+		TripThreshold:        1,
+		RestoreThreshold:     3,
+		ProposalWindowBlocks: 10,
+	})
+	k.SetIbcAvailable(ctx, true)
+
+	resp, err := srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{Signer: op1, IbcAvailable: false})
+	require.NoError(t, err)
+	require.True(t, resp.Executed)
+	require.False(t, k.GetIbcAvailable(ctx))
+
+	resp, err = srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{Signer: op1, IbcAvailable: true})
+	require.NoError(t, err)
+	require.False(t, resp.Executed)
+	require.Equal(t, uint64(1), resp.ProposalId)
+
+	_, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op1, ProposalId: 1})
+	require.Error(t, err)
+
+	voteResp, err := srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op2, ProposalId: 1})
+	require.NoError(t, err)
+	require.False(t, voteResp.Executed)
+	require.False(t, k.GetIbcAvailable(ctx))
+
+	queryResp, err := k.Proposal(ctx, &types.QueryProposalRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{op1, op2}, queryResp.Proposal.Voters)
+
+	voteResp, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op3, ProposalId: 1})
+	require.NoError(t, err)
+	require.True(t, voteResp.Executed)
+	require.True(t, k.GetIbcAvailable(ctx))
+	require.Empty(t, k.GetProposals(ctx))
+}
+
+func TestIbcBreakerProposalExpiry(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	op1 := sdk.AccAddress([]byte("operator_1")).String()
+	op2 := sdk.AccAddress([]byte("operator_2")).String()
+	k.SetParams(ctx, types.Params{
+		Whitelist:            []string{op1, op2},
+		TripThreshold:        2,
+		RestoreThreshold:     2,
+		ProposalWindowBlocks: 5,
+	})
+	k.SetIbcAvailable(ctx, true)
+
+	resp, err := srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{Signer: op1, IbcAvailable: false})
+	require.NoError(t, err)
+	require.False(t, resp.Executed)
+
+	ctx = ctx.WithBlockHeight(106).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Empty(t, k.GetProposals(ctx))
+	require.Equal(t, types.EventTypeProposalExpired, ctx.EventManager().Events()[0].Type)
+
+	_, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: op2, ProposalId: resp.ProposalId})
+	require.Error(t, err)
+	require.True(t, k.GetIbcAvailable(ctx))
+}
+
+func TestIbcBreakerActionLog(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	operator := sdk.AccAddress([]byte("operator_1")).String()
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator}})
+	k.SetIbcAvailable(ctx, true)
+
+	_, err := srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:          operator,
+		IbcAvailable:    false,
+		ExpiresAtHeight: 105,
+		Reason:          "counterparty halted",
+	})
+	require.NoError(t, err)
+	_, err = srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:          operator,
+		IbcAvailable:    false,
+		ExpiresAtHeight: 105,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(105)
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	resp, err := k.Actions(ctx, &types.QueryActionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Actions, 2)
+	require.Equal(t, []string{operator}, resp.Actions[0].Signers)
+	require.Equal(t, "counterparty halted", resp.Actions[0].Reason)
+	require.Equal(t, types.IbcBreakerState{IbcAvailable: true}, resp.Actions[0].OldState)
+	require.Equal(t, types.IbcBreakerState{ExpiresAtHeight: 105}, resp.Actions[0].NewState)
+	require.Empty(t, resp.Actions[1].Signers)
+	require.Equal(t, int64(105), resp.Actions[1].Height)
+	require.True(t, resp.Actions[1].NewState.IbcAvailable)
+}
+
+func TestUpdateIbcBreakerDeniedRoutes(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	operator1 := sdk.AccAddress([]byte("operator_1"))
+	operator2 := sdk.AccAddress([]byte("operator_2"))
+	k.SetParams(ctx, types.Params{
+		Whitelist:            []string{operator1.String(), operator2.String()},
+		TripThreshold:        1,
+		RestoreThreshold:     2,
+		ProposalWindowBlocks: 10,
+	})
+	k.SetIbcAvailable(ctx, true)
+
+	_, err := srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:         operator1.String(),
+		IbcAvailable:   true,
+		DeniedChannels: []string{"channel-0", "channel-0"},
+	})
+	require.Error(t, err)
+
+	resp, err := srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:          operator1.String(),
+		IbcAvailable:    true,
+		ExpiresAtHeight: 150,
+		DeniedChannels:  []string{"channel-0"},
+		DeniedClients:   []string{"07-tendermint-0"},
+		DeniedDenoms:    []string{"uatom"},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Executed)
+	require.True(t, k.GetIbcAvailable(ctx))
+	require.True(t, k.IsChannelDenied(ctx, "channel-0"))
+	require.False(t, k.IsChannelDenied(ctx, "channel-1"))
+	require.True(t, k.IsClientDenied(ctx, "07-tendermint-0"))
+	require.True(t, k.IsDenomDenied(ctx, "uatom"))
+	require.Equal(t, uint64(150), k.GetTripExpiresAtHeight(ctx))
+
+	channels, err := k.DeniedChannels(ctx, &types.QueryDeniedChannelsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"channel-0"}, channels.DeniedChannels)
+
+	// dropping a denied denom lifts a restriction and needs the restore quorum
+	resp, err = srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:          operator1.String(),
+		IbcAvailable:    true,
+		ExpiresAtHeight: 150,
+		DeniedChannels:  []string{"channel-0"},
+		DeniedClients:   []string{"07-tendermint-0"},
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Executed)
+	require.True(t, k.IsDenomDenied(ctx, "uatom"))
+
+	_, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: operator2.String(), ProposalId: resp.ProposalId})
+	require.NoError(t, err)
+	require.False(t, k.IsDenomDenied(ctx, "uatom"))
+	require.True(t, k.IsChannelDenied(ctx, "channel-0"))
+
+	ctx = ctx.WithBlockHeight(150)
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.Equal(t, types.IbcBreakerState{IbcAvailable: true, DeniedChannels: []string{}, DeniedClients: []string{}, DeniedDenoms: []string{}}, k.GetIbcBreakerState(ctx))
+}
+
+func TestUpdateIbcBreakerInboundPause(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	operator1 := sdk.AccAddress([]byte("operator_1"))
+	operator2 := sdk.AccAddress([]byte("operator_2"))
+	k.SetParams(ctx, types.Params{
+		Whitelist:            []string{operator1.String(), operator2.String()},
+		TripThreshold:        1,
+		RestoreThreshold:     2,
+		ProposalWindowBlocks: 10,
+	})
+	k.SetIbcAvailable(ctx, true)
+
+	resp, err := srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:        operator1.String(),
+		IbcAvailable:  true,
+		InboundPaused: true,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Executed)
+	require.True(t, k.GetInboundPaused(ctx))
+	require.True(t, k.GetIbcAvailable(ctx))
+
+	query, err := k.IbcAvailable(ctx, &types.QueryIbcAvailableRequest{})
+	require.NoError(t, err)
+	require.True(t, query.InboundPaused)
+
+	resp, err = srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:       operator1.String(),
+		IbcAvailable: true,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Executed)
+	require.True(t, k.GetInboundPaused(ctx))
+
+	_, err = srv.VoteProposal(ctx, &types.MsgVoteProposal{Signer: operator2.String(), ProposalId: resp.ProposalId})
+	require.NoError(t, err)
+	require.False(t, k.GetInboundPaused(ctx))
+}
+
+func TestIbcBreakerGuards(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.CheckIbcAvailable(ctx))
+	require.NoError(t, k.CheckClient(ctx, "07-tendermint-0"))
+	require.NoError(t, k.CheckTransfer(ctx, transfertypes.PortID, "channel-0", "aatom"))
+
+	k.SetDeniedChannels(ctx, []string{"channel-0"})
+	k.SetDeniedClients(ctx, []string{"07-tendermint-0"})
+	k.SetDeniedDenoms(ctx, []string{"aatom"})
+
+	require.ErrorIs(t, k.CheckClient(ctx, "07-tendermint-0"), types.ErrIbcUnavailable)
+	require.NoError(t, k.CheckClient(ctx, "07-tendermint-1"))
+	require.ErrorContains(t, k.CheckTransfer(ctx, transfertypes.PortID, "channel-0", "uosmo"), "channel channel-0 is denied")
+	require.ErrorContains(t, k.CheckTransfer(ctx, transfertypes.PortID, "07-tendermint-0", "uosmo"), "client 07-tendermint-0 is denied")
+	require.ErrorContains(t, k.CheckTransfer(ctx, transfertypes.PortID, "channel-1", "aatom"), "denom aatom is denied")
+	require.NoError(t, k.CheckTransfer(ctx, transfertypes.PortID, "channel-1", "uosmo"))
+
+	// A v1 channel is blocked by a deny of the client under its connection.
+	k.SetDeniedChannels(ctx, nil)
+	require.Equal(t, "07-tendermint-0", k.SourceClientID(ctx, transfertypes.PortID, "channel-0"))
+	require.ErrorContains(t, k.CheckTransfer(ctx, transfertypes.PortID, "channel-0", "uosmo"), "client 07-tendermint-0 is denied")
+
+	k.SetIbcAvailable(ctx, false)
+	require.ErrorIs(t, k.CheckIbcAvailable(ctx), types.ErrIbcUnavailable)
+	require.ErrorIs(t, k.CheckClient(ctx, "07-tendermint-1"), types.ErrIbcUnavailable)
+	require.ErrorIs(t, k.CheckTransfer(ctx, transfertypes.PortID, "channel-1", "uosmo"), types.ErrIbcUnavailable)
+}
+
+func TestBeginBlockerRestoresExpiredTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	operator := sdk.AccAddress([]byte("operator_1"))
+	k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}})
+	k.SetIbcAvailable(ctx, true)
+
+	_, err := srv.UpdateIbcBreaker(ctx, &types.MsgUpdateIbcBreaker{
+		Signer:          operator.String(),
+		IbcAvailable:    false,
+		ExpiresAtHeight: 105,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(104).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.False(t, k.GetIbcAvailable(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+
+	ctx = ctx.WithBlockHeight(105).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	require.True(t, k.GetIbcAvailable(ctx))
+	require.Zero(t, k.GetTripExpiresAtHeight(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeAutoRestore, events[0].Type)
+}
+
+func TestUpdateParamsRejectsEmptyWhitelistWhileTripped(t *testing.T) {
+	testCases := []struct {
+		name string
+		trip func(Keeper, sdk.Context)
+	}{
+		{"ibc unavailable", func(k Keeper, ctx sdk.Context) { k.SetIbcAvailable(ctx, false) }},
+		{"inbound paused", func(k Keeper, ctx sdk.Context) { k.SetInboundPaused(ctx, true) }},
+		{"channel denied", func(k Keeper, ctx sdk.Context) { k.SetDeniedChannels(ctx, []string{"channel-0"}) }},
+		{"client denied", func(k Keeper, ctx sdk.Context) { k.SetDeniedClients(ctx, []string{"07-tendermint-0"}) }},
+		{"denom denied", func(k Keeper, ctx sdk.Context) { k.SetDeniedDenoms(ctx, []string{"uosmo"}) }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := setupKeeper(t)
+			srv := NewMsgServerImpl(k)
+
+			operator := sdk.AccAddress([]byte("operator_1")).String()
+			k.SetParams(ctx, types.Params{Whitelist: []string{operator}})
+			k.SetIbcAvailable(ctx, true)
+			tc.trip(k, ctx)
+
+			_, err := srv.UpdateParams(ctx, &types.MsgUpdateParams{
+				Authority: k.authority.String(),
+				Params:    &types.Params{},
+			})
+			require.ErrorContains(t, err, "whitelist cannot be empty while the ibc breaker is tripped")
+			require.Equal(t, []string{operator}, k.GetParams(ctx).Whitelist)
+
+			_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{
+				Authority: k.authority.String(),
+				Params:    &types.Params{Whitelist: []string{sdk.AccAddress([]byte("operator_2")).String()}},
+			})
+			require.NoError(t, err)
+		})
+	}
+
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	k.SetIbcAvailable(ctx, true)
+	_, err := srv.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.authority.String(),
+		Params:    &types.Params{},
+	})
+	require.NoError(t, err, "an empty whitelist is allowed while the breaker is not tripped")
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/evm/x/ibcbreaker/types"
 
@@ -36,13 +37,62 @@ func (m msgServer) UpdateIbcBreaker(goCtx context.Context, req *types.MsgUpdateI
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signer not whitelisted")
 	}
 
-	if m.GetIbcAvailable(ctx) == req.IbcAvailable {
-		return &types.MsgUpdateIbcBreakerResponse{}, nil
+	desired := req.DesiredState()
+	if err := desired.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	m.SetIbcAvailable(ctx, req.IbcAvailable)
+	params := m.GetParams(ctx)
+	if _, err := m.resolveState(ctx, params, desired); err != nil {
+		return nil, err
+	}
+
+	proposal, found := m.findProposal(ctx, desired)
+	if !found {
+		proposal = types.IbcBreakerProposal{
+			State:           desired,
+			VotingEndHeight: params.VotingEndHeight(uint64(ctx.BlockHeight())), //#nosec G115 -- block height is never negative
+			Reason:          req.Reason,
+		}
+	}
+
+	executed, err := m.approveProposal(ctx, params, &proposal, req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateIbcBreakerResponse{ProposalId: proposal.Id, Executed: executed}, nil
+}
+
+func (m msgServer) VoteProposal(goCtx context.Context, req *types.MsgVoteProposal) (*types.MsgVoteProposalResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if !m.IsWhitelisted(ctx, signer) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signer not whitelisted")
+	}
 
-	return &types.MsgUpdateIbcBreakerResponse{}, nil
+	proposal, found := m.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "proposal %d not found", req.ProposalId)
+	}
+	if proposal.VotingEndHeight != 0 && uint64(ctx.BlockHeight()) > proposal.VotingEndHeight { //#nosec G115 -- block height is never negative
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "voting on proposal %d ended at height %d", proposal.Id, proposal.VotingEndHeight)
+	}
+
+	executed, err := m.approveProposal(ctx, m.GetParams(ctx), &proposal, req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVoteProposalResponse{Executed: executed}, nil
 }
 
 func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -63,7 +113,109 @@ func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if m.GetIbcBreakerState(ctx).IsTripped() && len(req.Params.Whitelist) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "whitelist cannot be empty while the ibc breaker is tripped")
+	}
+
 	m.SetParams(ctx, *req.Params)
+	// votes were cast under the previous whitelist and thresholds
+	m.clearProposals(ctx)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// approveProposal records signer's vote on proposal and applies the proposed
+// state once the required number of operators approved it. Proposals that are
+// still short of the threshold are stored, allocating an id if needed.
+func (m msgServer) approveProposal(ctx sdk.Context, params types.Params, proposal *types.IbcBreakerProposal, signer string) (bool, error) {
+	for _, voter := range proposal.Voters {
+		if voter == signer {
+			return false, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "signer already voted on proposal %d", proposal.Id)
+		}
+	}
+	proposal.Voters = append(proposal.Voters, signer)
+
+	desired, err := m.resolveState(ctx, params, proposal.State)
+	if err != nil {
+		return false, err
+	}
+
+	current := m.GetIbcBreakerState(ctx)
+	required := params.RequiredVotes(liftsTrip(current, desired))
+	if uint32(len(proposal.Voters)) < required { //#nosec G115 -- voters are bounded by the whitelist size
+		if proposal.Id == 0 {
+			proposal.Id = m.GetNextProposalID(ctx)
+			m.SetNextProposalID(ctx, proposal.Id+1)
+		}
+		m.SetProposal(ctx, *proposal)
+		m.emitProposalEvent(ctx, types.EventTypeProposalVote, proposal.Id, signer)
+		return false, nil
+	}
+
+	if !sameIbcBreakerState(current, desired) {
+		m.applyIbcBreakerState(ctx, current, desired)
+		m.AppendAction(ctx, proposal.Voters, current, desired, proposal.Reason)
+	}
+	if proposal.Id != 0 {
+		m.DeleteProposal(ctx, proposal.Id)
+		m.emitProposalEvent(ctx, types.EventTypeProposalVote, proposal.Id, signer)
+		m.emitProposalEvent(ctx, types.EventTypeProposalExecuted, proposal.Id, signer)
+	}
+	return true, nil
+}
+
+// resolveState returns state with its trip expiry resolved against params at
+// the current height.
+func (m msgServer) resolveState(ctx sdk.Context, params types.Params, state types.IbcBreakerState) (types.IbcBreakerState, error) {
+	if !state.IsTripped() {
+		return state, nil
+	}
+
+	expiresAtHeight, err := params.TripExpiry(uint64(ctx.BlockHeight()), state.ExpiresAtHeight) //#nosec G115 -- block height is never negative
+	if err != nil {
+		return types.IbcBreakerState{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	state.ExpiresAtHeight = expiresAtHeight
+	return state, nil
+}
+
+// applyIbcBreakerState writes the parts of desired that differ from current.
+func (m msgServer) applyIbcBreakerState(ctx sdk.Context, current, desired types.IbcBreakerState) {
+	if current.ExpiresAtHeight != desired.ExpiresAtHeight {
+		m.SetTripExpiresAtHeight(ctx, desired.ExpiresAtHeight)
+	}
+	if current.IbcAvailable != desired.IbcAvailable {
+		m.SetIbcAvailable(ctx, desired.IbcAvailable)
+	}
+	if current.InboundPaused != desired.InboundPaused {
+		m.SetInboundPaused(ctx, desired.InboundPaused)
+	}
+	if !sameStringSet(current.DeniedChannels, desired.DeniedChannels) {
+		m.SetDeniedChannels(ctx, desired.DeniedChannels)
+	}
+	if !sameStringSet(current.DeniedClients, desired.DeniedClients) {
+		m.SetDeniedClients(ctx, desired.DeniedClients)
+	}
+	if !sameStringSet(current.DeniedDenoms, desired.DeniedDenoms) {
+		m.SetDeniedDenoms(ctx, desired.DeniedDenoms)
+	}
+}
+
+func (m msgServer) emitProposalEvent(ctx sdk.Context, eventType string, proposalID uint64, signer string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, signer),
+		),
+	)
+}
+
+// sameStringSet reports whether a and b hold the same entries. Both inputs are
+// expected to be free of duplicates, which state and message validation enforce.
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return containsAll(a, b)
+}
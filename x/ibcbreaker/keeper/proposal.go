@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/evm/x/ibcbreaker/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) GetProposal(ctx sdk.Context, id uint64) (types.IbcBreakerProposal, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ProposalKey(id))
+	if bz == nil {
+		return types.IbcBreakerProposal{}, false
+	}
+
+	var proposal types.IbcBreakerProposal
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return proposal, true
+}
+
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.IbcBreakerProposal) {
+	ctx.KVStore(k.storeKey).Set(types.ProposalKey(proposal.Id), k.cdc.MustMarshal(&proposal))
+}
+
+func (k Keeper) DeleteProposal(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.ProposalKey(id))
+}
+
+// GetProposals returns the pending proposals ordered by id.
+func (k Keeper) GetProposals(ctx sdk.Context) []types.IbcBreakerProposal {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixProposal)
+	defer iterator.Close()
+
+	proposals := []types.IbcBreakerProposal{}
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.IbcBreakerProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
+
+// GetNextProposalID returns the id assigned to the next stored proposal.
+func (k Keeper) GetNextProposalID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextProposalID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextProposalID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextProposalID, sdk.Uint64ToBigEndian(id))
+}
+
+// clearProposals discards every pending proposal.
+func (k Keeper) clearProposals(ctx sdk.Context) {
+	for _, proposal := range k.GetProposals(ctx) {
+		k.DeleteProposal(ctx, proposal.Id)
+	}
+}
+
+// pruneExpiredProposals deletes the proposals whose voting window has ended.
+func (k Keeper) pruneExpiredProposals(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight()) //#nosec G115 -- block height is never negative
+	for _, proposal := range k.GetProposals(ctx) {
+		if proposal.VotingEndHeight == 0 || height <= proposal.VotingEndHeight {
+			continue
+		}
+
+		k.DeleteProposal(ctx, proposal.Id)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalExpired,
+				sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.Id, 10)),
+			),
+		)
+	}
+}
+
+// findProposal returns the pending proposal for the given breaker state.
+func (k Keeper) findProposal(ctx sdk.Context, state types.IbcBreakerState) (types.IbcBreakerProposal, bool) {
+	for _, proposal := range k.GetProposals(ctx) {
+		if sameIbcBreakerState(proposal.State, state) {
+			return proposal, true
+		}
+	}
+	return types.IbcBreakerProposal{}, false
+}
+
+func sameIbcBreakerState(a, b types.IbcBreakerState) bool {
+	return a.IbcAvailable == b.IbcAvailable &&
+		a.InboundPaused == b.InboundPaused &&
+		a.ExpiresAtHeight == b.ExpiresAtHeight &&
+		sameStringSet(a.DeniedChannels, b.DeniedChannels) &&
+		sameStringSet(a.DeniedClients, b.DeniedClients) &&
+		sameStringSet(a.DeniedDenoms, b.DeniedDenoms)
+}
+
+// liftsTrip reports whether moving from current to desired restores IBC,
+// resumes inbound transfers, removes a deny entry or brings the automatic
+// restore forward.
+func liftsTrip(current, desired types.IbcBreakerState) bool {
+	if !current.IbcAvailable && desired.IbcAvailable {
+		return true
+	}
+	if current.InboundPaused && !desired.InboundPaused {
+		return true
+	}
+	if !containsAll(desired.DeniedChannels, current.DeniedChannels) ||
+		!containsAll(desired.DeniedClients, current.DeniedClients) ||
+		!containsAll(desired.DeniedDenoms, current.DeniedDenoms) {
+		return true
+	}
+	if current.IsTripped() && desired.ExpiresAtHeight != 0 {
+		return current.ExpiresAtHeight == 0 || desired.ExpiresAtHeight < current.ExpiresAtHeight
+	}
+	return false
+}
+
+// containsAll reports whether every entry of subset is present in set.
+func containsAll(set, subset []string) bool {
+	entries := make(map[string]struct{}, len(set))
+	for _, entry := range set {
+		entries[entry] = struct{}{}
+	}
+	for _, entry := range subset {
+		if _, ok := entries[entry]; !ok {
+			return false
+		}
+	}
+	return true
+}
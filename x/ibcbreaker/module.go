@@ -1,6 +1,7 @@
 package ibcbreaker
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -30,7 +31,8 @@ var (
 	_ module.AppModuleBasic = AppModuleBasic{}
 	_ module.HasABCIGenesis = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
 )
 
 type AppModuleBasic struct{}
@@ -98,6 +100,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.BeginBlocker(sdk.UnwrapSDKContext(ctx))
+}
+
 func (AppModule) IsAppModule() {}
 
 func (AppModule) IsOnePerModuleType() {}
@@ -17,6 +17,7 @@ var (
 
 const (
 	msgUpdateIbcBreakerName = "cosmos/evm/x/ibcbreaker/MsgUpdateIbcBreaker"
+	msgVoteProposalName     = "cosmos/evm/x/ibcbreaker/MsgVoteProposal"
 	msgUpdateParamsName     = "cosmos/evm/x/ibcbreaker/MsgUpdateParams"
 )
 
@@ -29,6 +30,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateIbcBreaker{},
+		&MsgVoteProposal{},
 		&MsgUpdateParams{},
 	)
 
@@ -37,5 +39,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateIbcBreaker{}, msgUpdateIbcBreakerName, nil)
+	cdc.RegisterConcrete(&MsgVoteProposal{}, msgVoteProposalName, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, msgUpdateParamsName, nil)
 }
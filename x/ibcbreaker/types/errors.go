@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInboundPaused  = errorsmod.Register(ModuleName, 2, "inbound ibc transfers are paused")
+	ErrIbcUnavailable = errorsmod.Register(ModuleName, 3, "ibc unavailable")
+)
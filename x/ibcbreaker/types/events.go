@@ -0,0 +1,13 @@
+package types
+
+// ibcbreaker events
+const (
+	EventTypeAutoRestore      = "ibcbreaker_auto_restore"
+	EventTypeProposalVote     = "ibcbreaker_proposal_vote"
+	EventTypeProposalExecuted = "ibcbreaker_proposal_executed"
+	EventTypeProposalExpired  = "ibcbreaker_proposal_expired"
+
+	AttributeKeyExpiresAtHeight = "expires_at_height"
+	AttributeKeyProposalID      = "proposal_id"
+	AttributeKeySigner          = "signer"
+)
@@ -1,14 +1,79 @@
 package types
 
+import "fmt"
+
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params: DefaultParams(),
 		State: IbcBreakerState{
 			IbcAvailable: true,
 		},
+		Proposals:      []IbcBreakerProposal{},
+		NextProposalId: 1,
+		Actions:        []IbcBreakerAction{},
 	}
 }
 
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.State.Validate(); err != nil {
+		return err
+	}
+	if err := validateProposals(gs.Proposals, gs.NextProposalId, gs.Params.Whitelist); err != nil {
+		return err
+	}
+	return validateActions(gs.Actions)
+}
+
+func validateActions(actions []IbcBreakerAction) error {
+	var lastID uint64
+	for _, action := range actions {
+		if action.Id <= lastID {
+			return fmt.Errorf("action ids must be positive and strictly increasing, got %d after %d", action.Id, lastID)
+		}
+		lastID = action.Id
+
+		if len(action.Reason) > MaxReasonLength {
+			return fmt.Errorf("action %d reason exceeds %d characters", action.Id, MaxReasonLength)
+		}
+	}
+	return nil
+}
+
+func validateProposals(proposals []IbcBreakerProposal, nextID uint64, whitelist []string) error {
+	operators := make(map[string]struct{}, len(whitelist))
+	for _, addr := range whitelist {
+		operators[addr] = struct{}{}
+	}
+
+	seen := make(map[uint64]struct{}, len(proposals))
+	for _, proposal := range proposals {
+		if proposal.Id == 0 || proposal.Id >= nextID {
+			return fmt.Errorf("proposal id %d must be positive and below next_proposal_id %d", proposal.Id, nextID)
+		}
+		if _, ok := seen[proposal.Id]; ok {
+			return fmt.Errorf("duplicate proposal id: %d", proposal.Id)
+		}
+		seen[proposal.Id] = struct{}{}
+
+		if err := proposal.State.Validate(); err != nil {
+			return fmt.Errorf("invalid proposal %d: %w", proposal.Id, err)
+		}
+		if len(proposal.Voters) == 0 {
+			return fmt.Errorf("proposal %d has no voters", proposal.Id)
+		}
+		voters := make(map[string]struct{}, len(proposal.Voters))
+		for _, voter := range proposal.Voters {
+			if _, ok := operators[voter]; !ok {
+				return fmt.Errorf("proposal %d voter %s is not whitelisted", proposal.Id, voter)
+			}
+			if _, ok := voters[voter]; ok {
+				return fmt.Errorf("proposal %d has duplicate voter %s", proposal.Id, voter)
+			}
+			voters[voter] = struct{}{}
+		}
+	}
+	return nil
 }
@@ -30,6 +30,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// state defines the ibcbreaker module state.
 	State IbcBreakerState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// proposals are the pending ibcbreaker proposals.
+	Proposals []IbcBreakerProposal `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals"`
+	// next_proposal_id is the identifier assigned to the next proposal.
+	NextProposalId uint64 `protobuf:"varint,4,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
+	// actions is the ibcbreaker action log ordered by id.
+	Actions []IbcBreakerAction `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +85,27 @@ func (m *GenesisState) GetState() IbcBreakerState {
 	return IbcBreakerState{}
 }
 
+func (m *GenesisState) GetProposals() []IbcBreakerProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextProposalId() uint64 {
+	if m != nil {
+		return m.NextProposalId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetActions() []IbcBreakerAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.ibcbreaker.v1.GenesisState")
 }
@@ -88,23 +115,28 @@ func init() {
 }
 
 var fileDescriptor_e6ea4a597feaae66 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0xcf, 0x4c, 0x4a, 0x4e, 0x2a, 0x4a, 0x4d, 0xcc, 0x4e,
-	0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xa8, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x43, 0xa8, 0xd3, 0x2b, 0x33,
-	0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xc5, 0x52, 0x9a, 0x38, 0x0d,
-	0x45, 0xd2, 0x0a, 0x51, 0x2a, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x10, 0x51,
-	0xa5, 0xf9, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xfb, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x9c, 0xb9,
-	0xd8, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x14, 0xf4,
-	0x70, 0xb9, 0x47, 0x2f, 0x00, 0xac, 0xce, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37,
-	0x68, 0x31, 0x06, 0x41, 0xb5, 0x0a, 0x79, 0x71, 0xb1, 0x16, 0x83, 0x4c, 0x93, 0x60, 0x02, 0x9b,
-	0xa1, 0x89, 0xdb, 0x0c, 0xcf, 0xa4, 0x64, 0x27, 0x08, 0x0f, 0x6c, 0x3d, 0xb2, 0x61, 0x10, 0x23,
-	0x9c, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23, 0x3d, 0xb3,
-	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x29, 0x1c, 0x2a, 0x90, 0x43, 0xa2, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x59, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57,
-	0x2c, 0xaa, 0xa9, 0x84, 0x01, 0x00, 0x00,
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3d, 0x4e, 0xc3, 0x30,
+	0x1c, 0xc5, 0xe3, 0x7e, 0xa1, 0xba, 0x08, 0x41, 0xc4, 0x10, 0x75, 0x08, 0x11, 0x03, 0x4a, 0x2b,
+	0x64, 0xab, 0xe5, 0x04, 0x84, 0x01, 0xb5, 0x53, 0x55, 0x36, 0x96, 0xca, 0x49, 0xad, 0x60, 0x81,
+	0xe3, 0x28, 0x36, 0x51, 0xb9, 0x05, 0xc7, 0x60, 0xe4, 0x18, 0x1d, 0x3b, 0x32, 0x21, 0xd4, 0x0e,
+	0xbd, 0x06, 0x8a, 0xdd, 0xaa, 0x59, 0x22, 0x75, 0x89, 0xfe, 0x79, 0x7a, 0xef, 0xf7, 0x2c, 0x3d,
+	0x78, 0x13, 0x09, 0xc9, 0x85, 0xc4, 0x34, 0xe7, 0x98, 0x85, 0x51, 0x98, 0x51, 0xf2, 0x4a, 0x33,
+	0x9c, 0x0f, 0x70, 0x4c, 0x13, 0x2a, 0x99, 0x44, 0x69, 0x26, 0x94, 0xb0, 0x1d, 0xe3, 0x43, 0x34,
+	0xe7, 0xe8, 0xe0, 0x43, 0xf9, 0xa0, 0x7b, 0x41, 0x38, 0x4b, 0x04, 0xd6, 0x5f, 0x63, 0xee, 0xf6,
+	0x2a, 0xa1, 0xa5, 0xa8, 0xb1, 0x5e, 0xc6, 0x22, 0x16, 0xfa, 0xc4, 0xc5, 0x65, 0xd4, 0xeb, 0x6d,
+	0x0d, 0x9e, 0x3e, 0x9a, 0xfe, 0x27, 0x45, 0x14, 0xb5, 0x1f, 0x60, 0x2b, 0x25, 0x19, 0xe1, 0xd2,
+	0x01, 0x1e, 0xf0, 0x3b, 0x43, 0x0f, 0x55, 0xbd, 0x07, 0x4d, 0xb4, 0x2f, 0x68, 0x2f, 0x7f, 0xaf,
+	0xac, 0xaf, 0xed, 0x77, 0x1f, 0x4c, 0x77, 0x51, 0x7b, 0x0c, 0x9b, 0xb2, 0xa0, 0x39, 0x35, 0xcd,
+	0xe8, 0x55, 0x33, 0x46, 0x61, 0x14, 0x98, 0x3f, 0x5d, 0x5f, 0x86, 0x19, 0x84, 0x3d, 0x81, 0xed,
+	0x34, 0x13, 0xa9, 0x90, 0xe4, 0x4d, 0x3a, 0x75, 0xaf, 0xee, 0x77, 0x86, 0xb7, 0xc7, 0xf0, 0x26,
+	0xbb, 0x50, 0xd0, 0x28, 0x90, 0xd3, 0x03, 0xc4, 0xf6, 0xe1, 0x79, 0x42, 0x17, 0x6a, 0xb6, 0x57,
+	0x66, 0x6c, 0xee, 0x34, 0x3c, 0xe0, 0x37, 0xa6, 0x67, 0x85, 0xbe, 0x0f, 0x8e, 0xe6, 0xf6, 0x18,
+	0x9e, 0x90, 0x48, 0x31, 0x91, 0x48, 0xa7, 0xa9, 0x9b, 0xfb, 0xc7, 0x34, 0xdf, 0xeb, 0xc8, 0xae,
+	0x77, 0x0f, 0x08, 0x82, 0xe5, 0xda, 0x05, 0xab, 0xb5, 0x0b, 0xfe, 0xd6, 0x2e, 0xf8, 0xdc, 0xb8,
+	0xd6, 0x6a, 0xe3, 0x5a, 0x3f, 0x1b, 0xd7, 0x7a, 0xf6, 0x63, 0xa6, 0x5e, 0xde, 0x43, 0x14, 0x09,
+	0x8e, 0x4b, 0x7b, 0x2e, 0xca, 0x8b, 0xaa, 0x8f, 0x94, 0xca, 0xb0, 0xa5, 0x47, 0xbb, 0xfb, 0x1f,
+	0x00, 0x22, 0x32, 0x46, 0x67, 0x4c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +159,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -171,6 +236,21 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextProposalId))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -275,6 +355,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, IbcBreakerProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalId", wireType)
+			}
+			m.NextProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, IbcBreakerAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
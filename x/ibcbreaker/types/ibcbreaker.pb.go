@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +31,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type IbcBreakerState struct {
 	// ibc_available controls whether IBC is available.
 	IbcAvailable bool `protobuf:"varint,1,opt,name=ibc_available,json=ibcAvailable,proto3" json:"ibc_available,omitempty"`
+	// expires_at_height is the block height at which IBC is automatically
+	// restored. Zero means the trip does not expire.
+	ExpiresAtHeight uint64 `protobuf:"varint,2,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// denied_channels are the IBC channel IDs whose outbound traffic is
+	// rejected while IBC is otherwise available.
+	DeniedChannels []string `protobuf:"bytes,3,rep,name=denied_channels,json=deniedChannels,proto3" json:"denied_channels,omitempty"`
+	// denied_clients are the IBC client IDs whose outbound traffic is rejected
+	// while IBC is otherwise available.
+	DeniedClients []string `protobuf:"bytes,4,rep,name=denied_clients,json=deniedClients,proto3" json:"denied_clients,omitempty"`
+	// denied_denoms are the denominations that cannot be transferred over IBC.
+	DeniedDenoms []string `protobuf:"bytes,5,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+	// inbound_paused rejects incoming transfer packets with an error
+	// acknowledgement so that the funds are refunded on the source chain.
+	InboundPaused bool `protobuf:"varint,6,opt,name=inbound_paused,json=inboundPaused,proto3" json:"inbound_paused,omitempty"`
 }
 
 func (m *IbcBreakerState) Reset()         { *m = IbcBreakerState{} }
@@ -69,10 +87,58 @@ func (m *IbcBreakerState) GetIbcAvailable() bool {
 	return false
 }
 
+func (m *IbcBreakerState) GetExpiresAtHeight() uint64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *IbcBreakerState) GetDeniedChannels() []string {
+	if m != nil {
+		return m.DeniedChannels
+	}
+	return nil
+}
+
+func (m *IbcBreakerState) GetDeniedClients() []string {
+	if m != nil {
+		return m.DeniedClients
+	}
+	return nil
+}
+
+func (m *IbcBreakerState) GetDeniedDenoms() []string {
+	if m != nil {
+		return m.DeniedDenoms
+	}
+	return nil
+}
+
+func (m *IbcBreakerState) GetInboundPaused() bool {
+	if m != nil {
+		return m.InboundPaused
+	}
+	return false
+}
+
 // Params defines the ibcbreaker module parameters.
 type Params struct {
 	// whitelist are the addresses allowed to update ibcbreaker state.
 	Whitelist []string `protobuf:"bytes,1,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	// max_trip_duration_blocks is the maximum number of blocks a trip may last
+	// before it is automatically restored. Zero means trips may last forever.
+	MaxTripDurationBlocks uint64 `protobuf:"varint,2,opt,name=max_trip_duration_blocks,json=maxTripDurationBlocks,proto3" json:"max_trip_duration_blocks,omitempty"`
+	// trip_threshold is the number of distinct whitelisted operators that must
+	// approve disabling IBC. Zero is treated as one.
+	TripThreshold uint32 `protobuf:"varint,3,opt,name=trip_threshold,json=tripThreshold,proto3" json:"trip_threshold,omitempty"`
+	// restore_threshold is the number of distinct whitelisted operators that
+	// must approve restoring IBC or bringing its automatic restore forward.
+	// Zero is treated as one.
+	RestoreThreshold uint32 `protobuf:"varint,4,opt,name=restore_threshold,json=restoreThreshold,proto3" json:"restore_threshold,omitempty"`
+	// proposal_window_blocks is the number of blocks a pending proposal stays
+	// open for votes. Required when either threshold is above one.
+	ProposalWindowBlocks uint64 `protobuf:"varint,5,opt,name=proposal_window_blocks,json=proposalWindowBlocks,proto3" json:"proposal_window_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -115,9 +181,224 @@ func (m *Params) GetWhitelist() []string {
 	return nil
 }
 
+func (m *Params) GetMaxTripDurationBlocks() uint64 {
+	if m != nil {
+		return m.MaxTripDurationBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetTripThreshold() uint32 {
+	if m != nil {
+		return m.TripThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetRestoreThreshold() uint32 {
+	if m != nil {
+		return m.RestoreThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetProposalWindowBlocks() uint64 {
+	if m != nil {
+		return m.ProposalWindowBlocks
+	}
+	return 0
+}
+
+// IbcBreakerProposal defines a pending ibcbreaker update awaiting operator
+// votes.
+type IbcBreakerProposal struct {
+	// id is the unique proposal identifier.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// state is the proposed ibcbreaker state.
+	State IbcBreakerState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// voters are the whitelisted operators that approved the proposal.
+	Voters []string `protobuf:"bytes,3,rep,name=voters,proto3" json:"voters,omitempty"`
+	// voting_end_height is the last block height at which the proposal accepts
+	// votes. Zero means the proposal does not expire.
+	VotingEndHeight uint64 `protobuf:"varint,4,opt,name=voting_end_height,json=votingEndHeight,proto3" json:"voting_end_height,omitempty"`
+	// reason is the free-form justification given by the proposer.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *IbcBreakerProposal) Reset()         { *m = IbcBreakerProposal{} }
+func (m *IbcBreakerProposal) String() string { return proto.CompactTextString(m) }
+func (*IbcBreakerProposal) ProtoMessage()    {}
+func (*IbcBreakerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab2876e7d4d88b68, []int{2}
+}
+func (m *IbcBreakerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcBreakerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcBreakerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcBreakerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcBreakerProposal.Merge(m, src)
+}
+func (m *IbcBreakerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcBreakerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcBreakerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcBreakerProposal proto.InternalMessageInfo
+
+func (m *IbcBreakerProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IbcBreakerProposal) GetState() IbcBreakerState {
+	if m != nil {
+		return m.State
+	}
+	return IbcBreakerState{}
+}
+
+func (m *IbcBreakerProposal) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+func (m *IbcBreakerProposal) GetVotingEndHeight() uint64 {
+	if m != nil {
+		return m.VotingEndHeight
+	}
+	return 0
+}
+
+func (m *IbcBreakerProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// IbcBreakerAction records an applied change of the ibcbreaker state.
+type IbcBreakerAction struct {
+	// id is the sequence number of the action in the log.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// signers are the operators that approved the change. Empty for automatic
+	// restores.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// height is the block height at which the change was applied.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the change was applied.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// old_state is the ibcbreaker state before the change.
+	OldState IbcBreakerState `protobuf:"bytes,5,opt,name=old_state,json=oldState,proto3" json:"old_state"`
+	// new_state is the ibcbreaker state after the change.
+	NewState IbcBreakerState `protobuf:"bytes,6,opt,name=new_state,json=newState,proto3" json:"new_state"`
+	// reason is the free-form justification given for the change.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *IbcBreakerAction) Reset()         { *m = IbcBreakerAction{} }
+func (m *IbcBreakerAction) String() string { return proto.CompactTextString(m) }
+func (*IbcBreakerAction) ProtoMessage()    {}
+func (*IbcBreakerAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab2876e7d4d88b68, []int{3}
+}
+func (m *IbcBreakerAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcBreakerAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcBreakerAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcBreakerAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcBreakerAction.Merge(m, src)
+}
+func (m *IbcBreakerAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcBreakerAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcBreakerAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcBreakerAction proto.InternalMessageInfo
+
+func (m *IbcBreakerAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IbcBreakerAction) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *IbcBreakerAction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IbcBreakerAction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *IbcBreakerAction) GetOldState() IbcBreakerState {
+	if m != nil {
+		return m.OldState
+	}
+	return IbcBreakerState{}
+}
+
+func (m *IbcBreakerAction) GetNewState() IbcBreakerState {
+	if m != nil {
+		return m.NewState
+	}
+	return IbcBreakerState{}
+}
+
+func (m *IbcBreakerAction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*IbcBreakerState)(nil), "cosmos.evm.ibcbreaker.v1.IbcBreakerState")
 	proto.RegisterType((*Params)(nil), "cosmos.evm.ibcbreaker.v1.Params")
+	proto.RegisterType((*IbcBreakerProposal)(nil), "cosmos.evm.ibcbreaker.v1.IbcBreakerProposal")
+	proto.RegisterType((*IbcBreakerAction)(nil), "cosmos.evm.ibcbreaker.v1.IbcBreakerAction")
 }
 
 func init() {
@@ -125,21 +406,48 @@ func init() {
 }
 
 var fileDescriptor_ab2876e7d4d88b68 = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0xcf, 0x4c, 0x4a, 0x4e, 0x2a, 0x4a, 0x4d, 0xcc, 0x4e,
-	0x2d, 0xd2, 0x2f, 0x33, 0x44, 0xe2, 0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x49, 0x40, 0x94,
-	0xea, 0xa5, 0x96, 0xe5, 0xea, 0x21, 0x49, 0x96, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83,
-	0x15, 0xe9, 0x83, 0x58, 0x10, 0xf5, 0x4a, 0x66, 0x5c, 0xfc, 0x9e, 0x49, 0xc9, 0x4e, 0x10, 0x65,
-	0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xca, 0x5c, 0xbc, 0x99, 0x49, 0xc9, 0xf1, 0x89, 0x65, 0x89,
-	0x99, 0x39, 0x89, 0x49, 0x39, 0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x1c, 0x41, 0x3c, 0x99, 0x49,
-	0xc9, 0x8e, 0x30, 0x31, 0x25, 0x35, 0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x19,
-	0x2e, 0xce, 0xf2, 0x8c, 0xcc, 0x92, 0xd4, 0x9c, 0xcc, 0xe2, 0x12, 0x09, 0x46, 0x05, 0x66, 0x0d,
-	0xce, 0x20, 0x84, 0x80, 0x93, 0xd3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x69, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x23, 0xf9, 0xaf, 0x02,
-	0xd9, 0x87, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xa7, 0x1a, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xf9, 0x2d, 0x97, 0x94, 0x07, 0x01, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0xdb, 0x30,
+	0x18, 0x8e, 0xf3, 0xd5, 0x46, 0x6d, 0xfa, 0x21, 0xba, 0x62, 0xca, 0x48, 0x43, 0xc6, 0x58, 0xba,
+	0x81, 0x4d, 0xbb, 0xc1, 0x76, 0x6d, 0xd6, 0xc2, 0x06, 0x3d, 0x14, 0xb7, 0x30, 0xd8, 0xc5, 0xc8,
+	0x96, 0x66, 0x8b, 0xda, 0x92, 0x91, 0x14, 0x27, 0xfb, 0x17, 0xfd, 0x4d, 0xbb, 0xac, 0xc7, 0x1e,
+	0x77, 0xda, 0x46, 0x7b, 0xda, 0x9f, 0x18, 0xc3, 0x92, 0x4c, 0xc2, 0xc6, 0x2e, 0xbd, 0xe5, 0x7d,
+	0x9e, 0x47, 0x6f, 0x9e, 0xf7, 0xcb, 0xe0, 0x20, 0xe6, 0x32, 0xe7, 0xd2, 0x27, 0x65, 0xee, 0xd3,
+	0x28, 0x8e, 0x04, 0x41, 0x57, 0x44, 0xf8, 0xe5, 0xe1, 0x52, 0xe4, 0x15, 0x82, 0x2b, 0x0e, 0x5d,
+	0x23, 0xf5, 0x48, 0x99, 0x7b, 0x4b, 0x64, 0x79, 0xb8, 0xb7, 0x93, 0xf0, 0x84, 0x6b, 0x91, 0x5f,
+	0xfd, 0x32, 0xfa, 0xbd, 0xfd, 0x84, 0xf3, 0x24, 0x23, 0xbe, 0x8e, 0xa2, 0xe9, 0x27, 0x5f, 0xd1,
+	0x9c, 0x48, 0x85, 0xf2, 0xc2, 0x08, 0x46, 0xbf, 0x1d, 0xb0, 0xf9, 0x3e, 0x8a, 0x27, 0x26, 0xd1,
+	0x85, 0x42, 0x8a, 0xc0, 0x27, 0xa0, 0x4f, 0xa3, 0x38, 0x44, 0x25, 0xa2, 0x19, 0x8a, 0x32, 0xe2,
+	0x3a, 0x43, 0x67, 0xbc, 0x1a, 0xac, 0xd3, 0x28, 0x3e, 0xae, 0x31, 0xf8, 0x1c, 0x6c, 0x93, 0x79,
+	0x41, 0x05, 0x91, 0x21, 0x52, 0x61, 0x4a, 0x68, 0x92, 0x2a, 0xb7, 0x39, 0x74, 0xc6, 0xed, 0x60,
+	0xd3, 0x12, 0xc7, 0xea, 0x9d, 0x86, 0xe1, 0x33, 0xb0, 0x89, 0x09, 0xa3, 0x04, 0x87, 0x71, 0x8a,
+	0x18, 0x23, 0x99, 0x74, 0x5b, 0xc3, 0xd6, 0xb8, 0x17, 0x6c, 0x18, 0xf8, 0xad, 0x45, 0xe1, 0x53,
+	0xb0, 0x51, 0x0b, 0x33, 0x4a, 0x98, 0x92, 0x6e, 0x5b, 0xeb, 0xfa, 0x56, 0x67, 0xc0, 0xca, 0xa0,
+	0x95, 0x61, 0xc2, 0x78, 0x2e, 0xdd, 0x8e, 0x56, 0xad, 0x1b, 0xf0, 0x44, 0x63, 0x55, 0x2e, 0xca,
+	0x22, 0x3e, 0x65, 0x38, 0x2c, 0xd0, 0x54, 0x12, 0xec, 0x76, 0x75, 0x19, 0x7d, 0x8b, 0x9e, 0x6b,
+	0x70, 0xf4, 0xcb, 0x01, 0xdd, 0x73, 0x24, 0x50, 0x2e, 0xe1, 0x63, 0xd0, 0x9b, 0xa5, 0x54, 0x91,
+	0x8c, 0x4a, 0xe5, 0x3a, 0x3a, 0xe5, 0x02, 0x80, 0xaf, 0x81, 0x9b, 0xa3, 0x79, 0xa8, 0x04, 0x2d,
+	0x42, 0x3c, 0x15, 0x48, 0x51, 0xce, 0xc2, 0x28, 0xe3, 0xf1, 0x95, 0xb4, 0x75, 0x3f, 0xca, 0xd1,
+	0xfc, 0x52, 0xd0, 0xe2, 0xc4, 0xb2, 0x13, 0x4d, 0x56, 0x46, 0xf4, 0x23, 0x95, 0x0a, 0x22, 0x53,
+	0x9e, 0x61, 0xb7, 0x35, 0x74, 0xc6, 0xfd, 0xa0, 0x5f, 0xa1, 0x97, 0x35, 0x08, 0x5f, 0x80, 0x6d,
+	0x41, 0xa4, 0xe2, 0x82, 0x2c, 0x29, 0xdb, 0x5a, 0xb9, 0x65, 0x89, 0x85, 0xf8, 0x15, 0xd8, 0x2d,
+	0x04, 0x2f, 0xb8, 0x44, 0x59, 0x38, 0xa3, 0x0c, 0xf3, 0x59, 0x6d, 0xa5, 0xa3, 0xad, 0xec, 0xd4,
+	0xec, 0x07, 0x4d, 0x1a, 0x27, 0xa3, 0xaf, 0x0e, 0x80, 0x8b, 0x61, 0x9f, 0x5b, 0x09, 0xdc, 0x00,
+	0x4d, 0x8a, 0xf5, 0x90, 0xdb, 0x41, 0x93, 0x62, 0x78, 0x0a, 0x3a, 0xb2, 0x5a, 0x04, 0x5d, 0xd6,
+	0xda, 0xd1, 0x81, 0xf7, 0xbf, 0xa5, 0xf3, 0xfe, 0xda, 0x9c, 0x49, 0xfb, 0xe6, 0xfb, 0x7e, 0x23,
+	0x30, 0xaf, 0xe1, 0x2e, 0xe8, 0x96, 0x5c, 0x11, 0x51, 0x0f, 0xdb, 0x46, 0xd5, 0xe6, 0x94, 0x5c,
+	0x51, 0x96, 0x84, 0x84, 0xe1, 0x7a, 0x73, 0xda, 0x66, 0x73, 0x0c, 0x71, 0xca, 0xb0, 0xdd, 0x9c,
+	0x5d, 0xd0, 0x15, 0x04, 0x49, 0xce, 0x74, 0x5d, 0xbd, 0xc0, 0x46, 0xa3, 0x2f, 0x4d, 0xb0, 0xb5,
+	0xf8, 0xf3, 0xe3, 0xb8, 0x6a, 0xf7, 0x3f, 0x75, 0xb8, 0x60, 0x45, 0xd2, 0x84, 0x55, 0x0e, 0x9a,
+	0xda, 0x41, 0x1d, 0x56, 0x69, 0xed, 0xff, 0x56, 0xa3, 0x68, 0x05, 0x36, 0x82, 0x6f, 0x40, 0xbb,
+	0x3a, 0x10, 0xed, 0x66, 0xed, 0x68, 0xcf, 0x33, 0xd7, 0xe3, 0xd5, 0xd7, 0xe3, 0x5d, 0xd6, 0xd7,
+	0x33, 0x59, 0xad, 0x2a, 0xbd, 0xfe, 0xb1, 0xef, 0x04, 0xfa, 0x05, 0x3c, 0x03, 0x3d, 0x9e, 0xe1,
+	0xd0, 0xf4, 0xad, 0xf3, 0xb0, 0xbe, 0xad, 0xf2, 0x0c, 0x9b, 0x0b, 0x3c, 0x03, 0x3d, 0x46, 0x66,
+	0x36, 0x5b, 0xf7, 0x81, 0xd9, 0x18, 0x99, 0x5d, 0xd4, 0x83, 0xb0, 0x4d, 0x5c, 0x59, 0x6e, 0xe2,
+	0x64, 0x72, 0x73, 0x37, 0x70, 0x6e, 0xef, 0x06, 0xce, 0xcf, 0xbb, 0x81, 0x73, 0x7d, 0x3f, 0x68,
+	0xdc, 0xde, 0x0f, 0x1a, 0xdf, 0xee, 0x07, 0x8d, 0x8f, 0xe3, 0x84, 0xaa, 0x74, 0x1a, 0x79, 0x31,
+	0xcf, 0xfd, 0xa5, 0x8f, 0xd3, 0x7c, 0xf9, 0xf3, 0xa4, 0x3e, 0x17, 0x44, 0x46, 0x5d, 0xdd, 0x9b,
+	0x97, 0x7f, 0x06, 0x00, 0x6f, 0xd7, 0x6a, 0x30, 0xc4, 0x04, 0x00, 0x00,
 }
 
 func (m *IbcBreakerState) Marshal() (dAtA []byte, err error) {
@@ -162,6 +470,48 @@ func (m *IbcBreakerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InboundPaused {
+		i--
+		if m.InboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintIbcbreaker(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DeniedClients) > 0 {
+		for iNdEx := len(m.DeniedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedClients[iNdEx])
+			copy(dAtA[i:], m.DeniedClients[iNdEx])
+			i = encodeVarintIbcbreaker(dAtA, i, uint64(len(m.DeniedClients[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DeniedChannels) > 0 {
+		for iNdEx := len(m.DeniedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedChannels[iNdEx])
+			copy(dAtA[i:], m.DeniedChannels[iNdEx])
+			i = encodeVarintIbcbreaker(dAtA, i, uint64(len(m.DeniedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.IbcAvailable {
 		i--
 		if m.IbcAvailable {
@@ -195,6 +545,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposalWindowBlocks != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.ProposalWindowBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RestoreThreshold != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.RestoreThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TripThreshold != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.TripThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTripDurationBlocks != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.MaxTripDurationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Whitelist) > 0 {
 		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Whitelist[iNdEx])
@@ -207,6 +577,142 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IbcBreakerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcBreakerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcBreakerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VotingEndHeight != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.VotingEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintIbcbreaker(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcBreakerAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcBreakerAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcBreakerAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.NewState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.OldState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintIbcbreaker(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintIbcbreaker(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintIbcbreaker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIbcbreaker(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIbcbreaker(v)
 	base := offset
@@ -227,6 +733,30 @@ func (m *IbcBreakerState) Size() (n int) {
 	if m.IbcAvailable {
 		n += 2
 	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.ExpiresAtHeight))
+	}
+	if len(m.DeniedChannels) > 0 {
+		for _, s := range m.DeniedChannels {
+			l = len(s)
+			n += 1 + l + sovIbcbreaker(uint64(l))
+		}
+	}
+	if len(m.DeniedClients) > 0 {
+		for _, s := range m.DeniedClients {
+			l = len(s)
+			n += 1 + l + sovIbcbreaker(uint64(l))
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovIbcbreaker(uint64(l))
+		}
+	}
+	if m.InboundPaused {
+		n += 2
+	}
 	return n
 }
 
@@ -242,18 +772,88 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovIbcbreaker(uint64(l))
 		}
 	}
+	if m.MaxTripDurationBlocks != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.MaxTripDurationBlocks))
+	}
+	if m.TripThreshold != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.TripThreshold))
+	}
+	if m.RestoreThreshold != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.RestoreThreshold))
+	}
+	if m.ProposalWindowBlocks != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.ProposalWindowBlocks))
+	}
 	return n
 }
 
-func sovIbcbreaker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIbcbreaker(x uint64) (n int) {
-	return sovIbcbreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *IbcBreakerState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *IbcBreakerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.Id))
+	}
+	l = m.State.Size()
+	n += 1 + l + sovIbcbreaker(uint64(l))
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovIbcbreaker(uint64(l))
+		}
+	}
+	if m.VotingEndHeight != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.VotingEndHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovIbcbreaker(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcBreakerAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.Id))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovIbcbreaker(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovIbcbreaker(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovIbcbreaker(uint64(l))
+	l = m.OldState.Size()
+	n += 1 + l + sovIbcbreaker(uint64(l))
+	l = m.NewState.Size()
+	n += 1 + l + sovIbcbreaker(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovIbcbreaker(uint64(l))
+	}
+	return n
+}
+
+func sovIbcbreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIbcbreaker(x uint64) (n int) {
+	return sovIbcbreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IbcBreakerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -300,6 +900,141 @@ func (m *IbcBreakerState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IbcAvailable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedChannels = append(m.DeniedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedClients = append(m.DeniedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InboundPaused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIbcbreaker(dAtA[iNdEx:])
@@ -382,6 +1117,518 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTripDurationBlocks", wireType)
+			}
+			m.MaxTripDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTripDurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripThreshold", wireType)
+			}
+			m.TripThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TripThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreThreshold", wireType)
+			}
+			m.RestoreThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestoreThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalWindowBlocks", wireType)
+			}
+			m.ProposalWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcbreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcBreakerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcbreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcBreakerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcBreakerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndHeight", wireType)
+			}
+			m.VotingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcbreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcBreakerAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcbreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcBreakerAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcBreakerAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIbcbreaker(dAtA[iNdEx:])
@@ -0,0 +1,22 @@
+package types
+
+import (
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IbcBreakerKeeper defines the keeper methods used by the ibcbreaker IBC
+// middlewares.
+type IbcBreakerKeeper interface {
+	GetInboundPaused(ctx sdk.Context) bool
+	Logger(ctx sdk.Context) log.Logger
+}
+
+// ChannelKeeper defines the IBC channel keeper methods used to resolve the
+// light client of an IBC v1 channel.
+type ChannelKeeper interface {
+	GetChannelConnection(ctx sdk.Context, portID, channelID string) (string, connectiontypes.ConnectionEnd, error)
+}
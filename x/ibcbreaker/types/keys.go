@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	ModuleName = "ibcbreaker"
 
@@ -11,9 +13,51 @@ const (
 const (
 	prefixParams = iota + 1
 	prefixState
+	prefixTripExpiry
+	prefixProposal
+	prefixNextProposalID
+	prefixAction
+	prefixNextActionID
+	prefixDeniedChannel
+	prefixDeniedClient
+	prefixDeniedDenom
+	prefixInboundPaused
 )
 
 var (
-	KeyParams = []byte{prefixParams}
-	KeyState  = []byte{prefixState}
+	KeyParams     = []byte{prefixParams}
+	KeyState      = []byte{prefixState}
+	KeyTripExpiry = []byte{prefixTripExpiry}
+
+	KeyInboundPaused = []byte{prefixInboundPaused}
+
+	KeyPrefixProposal = []byte{prefixProposal}
+	KeyNextProposalID = []byte{prefixNextProposalID}
+
+	KeyPrefixAction = []byte{prefixAction}
+	KeyNextActionID = []byte{prefixNextActionID}
+
+	KeyPrefixDeniedChannel = []byte{prefixDeniedChannel}
+	KeyPrefixDeniedClient  = []byte{prefixDeniedClient}
+	KeyPrefixDeniedDenom   = []byte{prefixDeniedDenom}
 )
+
+func ProposalKey(id uint64) []byte {
+	return append(append([]byte{}, KeyPrefixProposal...), sdk.Uint64ToBigEndian(id)...)
+}
+
+func ActionKey(id uint64) []byte {
+	return append(append([]byte{}, KeyPrefixAction...), sdk.Uint64ToBigEndian(id)...)
+}
+
+func DeniedChannelKey(channelID string) []byte {
+	return append(append([]byte{}, KeyPrefixDeniedChannel...), []byte(channelID)...)
+}
+
+func DeniedClientKey(clientID string) []byte {
+	return append(append([]byte{}, KeyPrefixDeniedClient...), []byte(clientID)...)
+}
+
+func DeniedDenomKey(denom string) []byte {
+	return append(append([]byte{}, KeyPrefixDeniedDenom...), []byte(denom)...)
+}
@@ -7,20 +7,57 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxReasonLength is the maximum length of the reason recorded with a breaker
+// update.
+const MaxReasonLength = 256
+
 var _ sdk.Msg = &MsgUpdateIbcBreaker{}
+var _ sdk.Msg = &MsgVoteProposal{}
 var _ sdk.Msg = &MsgUpdateParams{}
 
 func (m *MsgUpdateIbcBreaker) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
 		return errorsmod.Wrap(err, "invalid signer address")
 	}
+	if err := m.DesiredState().Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if len(m.Reason) > MaxReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason exceeds %d characters", MaxReasonLength)
+	}
 	return nil
 }
 
+// DesiredState returns the ibcbreaker state requested by the message.
+func (m *MsgUpdateIbcBreaker) DesiredState() IbcBreakerState {
+	return IbcBreakerState{
+		IbcAvailable:    m.IbcAvailable,
+		ExpiresAtHeight: m.ExpiresAtHeight,
+		DeniedChannels:  m.DeniedChannels,
+		DeniedClients:   m.DeniedClients,
+		DeniedDenoms:    m.DeniedDenoms,
+		InboundPaused:   m.InboundPaused,
+	}
+}
+
 func (m MsgUpdateIbcBreaker) GetSignBytes() []byte {
 	return AminoCdc.MustMarshalJSON(&m)
 }
 
+func (m *MsgVoteProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	if m.ProposalId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proposal id cannot be zero")
+	}
+	return nil
+}
+
+func (m MsgVoteProposal) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
 		return errorsmod.Wrap(err, "invalid authority address")
@@ -17,6 +17,60 @@ func TestMsgsTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgsTestSuite))
 }
 
+func (suite *MsgsTestSuite) TestMsgUpdateIbcBreakerValidateBasic() {
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateIbcBreaker
+		expPass bool
+	}{
+		{
+			name:    "fail - invalid denied channel",
+			msg:     &MsgUpdateIbcBreaker{Signer: signer, IbcAvailable: true, DeniedChannels: []string{"not a channel"}},
+			expPass: false,
+		},
+		{
+			name:    "fail - invalid denied client",
+			msg:     &MsgUpdateIbcBreaker{Signer: signer, IbcAvailable: true, DeniedClients: []string{"x"}},
+			expPass: false,
+		},
+		{
+			name:    "fail - duplicate denied denom",
+			msg:     &MsgUpdateIbcBreaker{Signer: signer, IbcAvailable: true, DeniedDenoms: []string{"uatom", "uatom"}},
+			expPass: false,
+		},
+		{
+			name:    "fail - expiry without trip",
+			msg:     &MsgUpdateIbcBreaker{Signer: signer, IbcAvailable: true, ExpiresAtHeight: 10},
+			expPass: false,
+		},
+		{
+			name: "pass - scoped trip with expiry",
+			msg: &MsgUpdateIbcBreaker{
+				Signer:          signer,
+				IbcAvailable:    true,
+				ExpiresAtHeight: 10,
+				DeniedChannels:  []string{"channel-0"},
+				DeniedClients:   []string{"07-tendermint-0"},
+				DeniedDenoms:    []string{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+				return
+			}
+			suite.Error(err)
+		})
+	}
+}
+
 func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
 	validParams := DefaultParams()
 
@@ -8,10 +8,54 @@ import (
 
 func DefaultParams() Params {
 	return Params{
-		Whitelist: []string{},
+		Whitelist:        []string{},
+		TripThreshold:    1,
+		RestoreThreshold: 1,
 	}
 }
 
+// RequiredVotes returns the number of distinct operator approvals a breaker
+// update needs. Updates that lift the trip use the restore threshold.
+func (p Params) RequiredVotes(lifts bool) uint32 {
+	threshold := p.TripThreshold
+	if lifts {
+		threshold = p.RestoreThreshold
+	}
+	if threshold == 0 {
+		return 1
+	}
+	return threshold
+}
+
+// VotingEndHeight returns the last height at which a proposal created at
+// height accepts votes, or zero if proposals do not expire.
+func (p Params) VotingEndHeight(height uint64) uint64 {
+	if p.ProposalWindowBlocks == 0 {
+		return 0
+	}
+	return height + p.ProposalWindowBlocks
+}
+
+// TripExpiry resolves the auto-restore height of a trip requested at height.
+// A requested expiry of zero falls back to the maximum trip duration, if any.
+func (p Params) TripExpiry(height, requested uint64) (uint64, error) {
+	if requested != 0 && requested <= height {
+		return 0, fmt.Errorf("expires_at_height %d must be greater than the current height %d", requested, height)
+	}
+	if p.MaxTripDurationBlocks == 0 {
+		return requested, nil
+	}
+
+	maxExpiry := height + p.MaxTripDurationBlocks
+	if requested == 0 {
+		return maxExpiry, nil
+	}
+	if requested > maxExpiry {
+		return 0, fmt.Errorf("expires_at_height %d exceeds the maximum trip duration of %d blocks", requested, p.MaxTripDurationBlocks)
+	}
+	return requested, nil
+}
+
 func (p Params) Validate() error {
 	seen := make(map[string]struct{}, len(p.Whitelist))
 	for _, addr := range p.Whitelist {
@@ -23,5 +67,14 @@ func (p Params) Validate() error {
 		}
 		seen[addr] = struct{}{}
 	}
+	if p.TripThreshold > 1 && int(p.TripThreshold) > len(p.Whitelist) {
+		return fmt.Errorf("trip_threshold %d exceeds whitelist size %d", p.TripThreshold, len(p.Whitelist))
+	}
+	if p.RestoreThreshold > 1 && int(p.RestoreThreshold) > len(p.Whitelist) {
+		return fmt.Errorf("restore_threshold %d exceeds whitelist size %d", p.RestoreThreshold, len(p.Whitelist))
+	}
+	if (p.TripThreshold > 1 || p.RestoreThreshold > 1) && p.ProposalWindowBlocks == 0 {
+		return fmt.Errorf("proposal_window_blocks must be positive when a threshold is above one")
+	}
 	return nil
 }
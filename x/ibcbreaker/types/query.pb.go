@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -70,6 +71,11 @@ var xxx_messageInfo_QueryIbcAvailableRequest proto.InternalMessageInfo
 type QueryIbcAvailableResponse struct {
 	// ibc_available is the current IBC availability flag.
 	IbcAvailable bool `protobuf:"varint,1,opt,name=ibc_available,json=ibcAvailable,proto3" json:"ibc_available,omitempty"`
+	// expires_at_height is the block height at which the current trip is
+	// automatically restored. Zero means no pending auto-restore.
+	ExpiresAtHeight uint64 `protobuf:"varint,2,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// inbound_paused reports whether incoming transfer packets are rejected.
+	InboundPaused bool `protobuf:"varint,3,opt,name=inbound_paused,json=inboundPaused,proto3" json:"inbound_paused,omitempty"`
 }
 
 func (m *QueryIbcAvailableResponse) Reset()         { *m = QueryIbcAvailableResponse{} }
@@ -181,11 +187,485 @@ func (m *QueryWhitelistResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryWhitelistResponse proto.InternalMessageInfo
 
+// QueryDeniedChannelsRequest defines the request type for Query/DeniedChannels.
+type QueryDeniedChannelsRequest struct {
+}
+
+func (m *QueryDeniedChannelsRequest) Reset()         { *m = QueryDeniedChannelsRequest{} }
+func (m *QueryDeniedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeniedChannelsRequest) ProtoMessage()    {}
+func (*QueryDeniedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{4}
+}
+func (m *QueryDeniedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeniedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeniedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeniedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeniedChannelsRequest.Merge(m, src)
+}
+func (m *QueryDeniedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeniedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeniedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeniedChannelsRequest proto.InternalMessageInfo
+
+// QueryDeniedChannelsResponse defines the response type for Query/DeniedChannels.
+type QueryDeniedChannelsResponse struct {
+	// denied_channels is the list of denied IBC channel IDs.
+	DeniedChannels []string `protobuf:"bytes,1,rep,name=denied_channels,json=deniedChannels,proto3" json:"denied_channels,omitempty"`
+}
+
+func (m *QueryDeniedChannelsResponse) Reset()         { *m = QueryDeniedChannelsResponse{} }
+func (m *QueryDeniedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeniedChannelsResponse) ProtoMessage()    {}
+func (*QueryDeniedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{5}
+}
+func (m *QueryDeniedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeniedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeniedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeniedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeniedChannelsResponse.Merge(m, src)
+}
+func (m *QueryDeniedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeniedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeniedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeniedChannelsResponse proto.InternalMessageInfo
+
+// QueryDeniedClientsRequest defines the request type for Query/DeniedClients.
+type QueryDeniedClientsRequest struct {
+}
+
+func (m *QueryDeniedClientsRequest) Reset()         { *m = QueryDeniedClientsRequest{} }
+func (m *QueryDeniedClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeniedClientsRequest) ProtoMessage()    {}
+func (*QueryDeniedClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{6}
+}
+func (m *QueryDeniedClientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeniedClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeniedClientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeniedClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeniedClientsRequest.Merge(m, src)
+}
+func (m *QueryDeniedClientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeniedClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeniedClientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeniedClientsRequest proto.InternalMessageInfo
+
+// QueryDeniedClientsResponse defines the response type for Query/DeniedClients.
+type QueryDeniedClientsResponse struct {
+	// denied_clients is the list of denied IBC client IDs.
+	DeniedClients []string `protobuf:"bytes,1,rep,name=denied_clients,json=deniedClients,proto3" json:"denied_clients,omitempty"`
+}
+
+func (m *QueryDeniedClientsResponse) Reset()         { *m = QueryDeniedClientsResponse{} }
+func (m *QueryDeniedClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeniedClientsResponse) ProtoMessage()    {}
+func (*QueryDeniedClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{7}
+}
+func (m *QueryDeniedClientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeniedClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeniedClientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeniedClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeniedClientsResponse.Merge(m, src)
+}
+func (m *QueryDeniedClientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeniedClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeniedClientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeniedClientsResponse proto.InternalMessageInfo
+
+// QueryDeniedDenomsRequest defines the request type for Query/DeniedDenoms.
+type QueryDeniedDenomsRequest struct {
+}
+
+func (m *QueryDeniedDenomsRequest) Reset()         { *m = QueryDeniedDenomsRequest{} }
+func (m *QueryDeniedDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeniedDenomsRequest) ProtoMessage()    {}
+func (*QueryDeniedDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{8}
+}
+func (m *QueryDeniedDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeniedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeniedDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeniedDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeniedDenomsRequest.Merge(m, src)
+}
+func (m *QueryDeniedDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeniedDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeniedDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeniedDenomsRequest proto.InternalMessageInfo
+
+// QueryDeniedDenomsResponse defines the response type for Query/DeniedDenoms.
+type QueryDeniedDenomsResponse struct {
+	// denied_denoms is the list of denominations denied for IBC transfers.
+	DeniedDenoms []string `protobuf:"bytes,1,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+}
+
+func (m *QueryDeniedDenomsResponse) Reset()         { *m = QueryDeniedDenomsResponse{} }
+func (m *QueryDeniedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeniedDenomsResponse) ProtoMessage()    {}
+func (*QueryDeniedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{9}
+}
+func (m *QueryDeniedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeniedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeniedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeniedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeniedDenomsResponse.Merge(m, src)
+}
+func (m *QueryDeniedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeniedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeniedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeniedDenomsResponse proto.InternalMessageInfo
+
+// QueryProposalsRequest defines the request type for Query/Proposals.
+type QueryProposalsRequest struct {
+}
+
+func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
+func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsRequest) ProtoMessage()    {}
+func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{10}
+}
+func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsRequest.Merge(m, src)
+}
+func (m *QueryProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsRequest proto.InternalMessageInfo
+
+// QueryProposalsResponse defines the response type for Query/Proposals.
+type QueryProposalsResponse struct {
+	// proposals is the list of pending ibcbreaker proposals.
+	Proposals []IbcBreakerProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+}
+
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{11}
+}
+func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+}
+func (m *QueryProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
+
+// QueryProposalRequest defines the request type for Query/Proposal.
+type QueryProposalRequest struct {
+	// proposal_id is the pending proposal to return.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
+func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRequest) ProtoMessage()    {}
+func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{12}
+}
+func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRequest.Merge(m, src)
+}
+func (m *QueryProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRequest proto.InternalMessageInfo
+
+// QueryProposalResponse defines the response type for Query/Proposal.
+type QueryProposalResponse struct {
+	// proposal is the pending ibcbreaker proposal.
+	Proposal IbcBreakerProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
+func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResponse) ProtoMessage()    {}
+func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{13}
+}
+func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResponse.Merge(m, src)
+}
+func (m *QueryProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
+
+// QueryActionsRequest defines the request type for Query/Actions.
+type QueryActionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActionsRequest) Reset()         { *m = QueryActionsRequest{} }
+func (m *QueryActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActionsRequest) ProtoMessage()    {}
+func (*QueryActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{14}
+}
+func (m *QueryActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionsRequest.Merge(m, src)
+}
+func (m *QueryActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActionsRequest proto.InternalMessageInfo
+
+// QueryActionsResponse defines the response type for Query/Actions.
+type QueryActionsResponse struct {
+	// actions is the page of ibcbreaker actions ordered by id.
+	Actions []IbcBreakerAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActionsResponse) Reset()         { *m = QueryActionsResponse{} }
+func (m *QueryActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActionsResponse) ProtoMessage()    {}
+func (*QueryActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea0820f390c25cb8, []int{15}
+}
+func (m *QueryActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionsResponse.Merge(m, src)
+}
+func (m *QueryActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActionsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryIbcAvailableRequest)(nil), "cosmos.evm.ibcbreaker.v1.QueryIbcAvailableRequest")
 	proto.RegisterType((*QueryIbcAvailableResponse)(nil), "cosmos.evm.ibcbreaker.v1.QueryIbcAvailableResponse")
 	proto.RegisterType((*QueryWhitelistRequest)(nil), "cosmos.evm.ibcbreaker.v1.QueryWhitelistRequest")
 	proto.RegisterType((*QueryWhitelistResponse)(nil), "cosmos.evm.ibcbreaker.v1.QueryWhitelistResponse")
+	proto.RegisterType((*QueryDeniedChannelsRequest)(nil), "cosmos.evm.ibcbreaker.v1.QueryDeniedChannelsRequest")
+	proto.RegisterType((*QueryDeniedChannelsResponse)(nil), "cosmos.evm.ibcbreaker.v1.QueryDeniedChannelsResponse")
+	proto.RegisterType((*QueryDeniedClientsRequest)(nil), "cosmos.evm.ibcbreaker.v1.QueryDeniedClientsRequest")
+	proto.RegisterType((*QueryDeniedClientsResponse)(nil), "cosmos.evm.ibcbreaker.v1.QueryDeniedClientsResponse")
+	proto.RegisterType((*QueryDeniedDenomsRequest)(nil), "cosmos.evm.ibcbreaker.v1.QueryDeniedDenomsRequest")
+	proto.RegisterType((*QueryDeniedDenomsResponse)(nil), "cosmos.evm.ibcbreaker.v1.QueryDeniedDenomsResponse")
+	proto.RegisterType((*QueryProposalsRequest)(nil), "cosmos.evm.ibcbreaker.v1.QueryProposalsRequest")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "cosmos.evm.ibcbreaker.v1.QueryProposalsResponse")
+	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.evm.ibcbreaker.v1.QueryProposalRequest")
+	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.evm.ibcbreaker.v1.QueryProposalResponse")
+	proto.RegisterType((*QueryActionsRequest)(nil), "cosmos.evm.ibcbreaker.v1.QueryActionsRequest")
+	proto.RegisterType((*QueryActionsResponse)(nil), "cosmos.evm.ibcbreaker.v1.QueryActionsResponse")
 }
 
 func init() {
@@ -193,30 +673,61 @@ func init() {
 }
 
 var fileDescriptor_ea0820f390c25cb8 = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0xcf, 0x4c, 0x4a, 0x4e, 0x2a, 0x4a, 0x4d, 0xcc, 0x4e,
-	0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x80, 0xa8, 0xd2, 0x4b, 0x2d, 0xcb, 0xd5, 0x43, 0xa8, 0xd2, 0x2b, 0x33, 0x94, 0x12,
-	0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x64, 0xd2, 0xf3, 0xf3,
-	0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b, 0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32,
-	0xf3, 0xf3, 0x8a, 0x21, 0xb2, 0x4a, 0x52, 0x5c, 0x12, 0x81, 0x20, 0xc3, 0x3d, 0x93, 0x92, 0x1d,
-	0xcb, 0x12, 0x33, 0x73, 0x12, 0x93, 0x72, 0x52, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x94,
-	0x1c, 0xb8, 0x24, 0xb1, 0xc8, 0x15, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x29, 0x73, 0xf1, 0x66,
-	0x26, 0x25, 0xc7, 0x27, 0xc2, 0x24, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x82, 0x78, 0x32, 0x91,
-	0x14, 0x2b, 0x89, 0x73, 0x89, 0x82, 0x4d, 0x08, 0xcf, 0xc8, 0x2c, 0x49, 0xcd, 0xc9, 0x2c, 0x2e,
-	0x81, 0x19, 0x6d, 0xc6, 0x25, 0x86, 0x2e, 0x01, 0x35, 0x57, 0x86, 0x8b, 0xb3, 0x1c, 0x26, 0x28,
-	0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x19, 0x84, 0x10, 0x30, 0xba, 0xc0, 0xc4, 0xc5, 0x0a, 0xd6, 0x28,
-	0xb4, 0x82, 0x91, 0x8b, 0x07, 0xd9, 0x61, 0x42, 0x46, 0x7a, 0xb8, 0x02, 0x46, 0x0f, 0x97, 0x0f,
-	0xa5, 0x8c, 0x49, 0xd2, 0x03, 0x71, 0xa1, 0x92, 0x7e, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0x34, 0x85,
-	0xd4, 0xf5, 0x71, 0xc6, 0x17, 0x4a, 0xc8, 0x08, 0xcd, 0x66, 0xe4, 0xe2, 0x84, 0x7b, 0x54, 0x48,
-	0x9f, 0x80, 0x9d, 0xe8, 0x61, 0x25, 0x65, 0x40, 0xbc, 0x06, 0xa8, 0x0b, 0xb5, 0xc1, 0x2e, 0x54,
-	0x15, 0x52, 0xc6, 0xed, 0x42, 0x78, 0x90, 0x3a, 0xb9, 0x9d, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x1a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xc8, 0x86, 0x55, 0x20, 0x1b, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x4e, 0x50, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x52, 0xd4, 0xf0, 0xc6, 0x02, 0x00,
-	0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4d, 0x4f, 0x3b, 0x45,
+	0x1c, 0xc7, 0xbb, 0x80, 0x42, 0x7f, 0xd0, 0x12, 0x47, 0xc4, 0xb2, 0x90, 0x82, 0x8b, 0x48, 0x5b,
+	0x74, 0x97, 0x52, 0xd4, 0x78, 0x93, 0x87, 0xa0, 0x78, 0x30, 0xb5, 0x17, 0x13, 0x13, 0xd3, 0xcc,
+	0xee, 0x4e, 0xb6, 0xa3, 0xed, 0xce, 0xd2, 0xdd, 0x56, 0x88, 0xf1, 0xe2, 0x2b, 0x30, 0xe1, 0xe8,
+	0xdd, 0x98, 0xe8, 0xc1, 0xb3, 0xaf, 0x80, 0x23, 0x89, 0x17, 0x4f, 0x46, 0xc1, 0x17, 0x62, 0x3a,
+	0x3b, 0xb3, 0x0f, 0x4d, 0x6b, 0xb7, 0xff, 0x1b, 0xfd, 0x3d, 0x7c, 0xbf, 0x9f, 0xdd, 0xce, 0x7c,
+	0x29, 0xbc, 0x69, 0x31, 0xbf, 0xc7, 0x7c, 0x83, 0x0c, 0x7b, 0x06, 0x35, 0x2d, 0xb3, 0x4f, 0xf0,
+	0xd7, 0xa4, 0x6f, 0x0c, 0xeb, 0xc6, 0xcd, 0x80, 0xf4, 0xef, 0x74, 0xaf, 0xcf, 0x02, 0x86, 0x4a,
+	0xe1, 0x94, 0x4e, 0x86, 0x3d, 0x3d, 0x9e, 0xd2, 0x87, 0x75, 0x75, 0xc3, 0x61, 0x0e, 0xe3, 0x43,
+	0xc6, 0xe8, 0xaf, 0x70, 0x5e, 0xdd, 0x71, 0x18, 0x73, 0xba, 0xc4, 0xc0, 0x1e, 0x35, 0xb0, 0xeb,
+	0xb2, 0x00, 0x07, 0x94, 0xb9, 0xbe, 0xe8, 0xd6, 0x84, 0xa7, 0x89, 0x7d, 0x12, 0xda, 0x18, 0xc3,
+	0xba, 0x49, 0x02, 0x5c, 0x37, 0x3c, 0xec, 0x50, 0x97, 0x0f, 0x8b, 0xd9, 0xea, 0x54, 0xbe, 0xf8,
+	0x53, 0x38, 0xaa, 0xa9, 0x50, 0xfa, 0x6c, 0x24, 0x76, 0x6d, 0x5a, 0x67, 0x43, 0x4c, 0xbb, 0xd8,
+	0xec, 0x92, 0x16, 0xb9, 0x19, 0x10, 0x3f, 0xd0, 0xee, 0x15, 0xd8, 0x9a, 0xd0, 0xf4, 0x3d, 0xe6,
+	0xfa, 0x04, 0xed, 0x43, 0x81, 0x9a, 0x56, 0x1b, 0xcb, 0x46, 0x49, 0xd9, 0x53, 0x2a, 0x2b, 0xad,
+	0x35, 0x9a, 0x18, 0x46, 0x35, 0x78, 0x85, 0xdc, 0x7a, 0xb4, 0x4f, 0xfc, 0x36, 0x0e, 0xda, 0x1d,
+	0x42, 0x9d, 0x4e, 0x50, 0x5a, 0xd8, 0x53, 0x2a, 0x4b, 0xad, 0x75, 0xd1, 0x38, 0x0b, 0x3e, 0xe6,
+	0x65, 0x74, 0x00, 0x45, 0xea, 0x9a, 0x6c, 0xe0, 0xda, 0x6d, 0x0f, 0x0f, 0x7c, 0x62, 0x97, 0x16,
+	0xb9, 0x62, 0x41, 0x54, 0x9b, 0xbc, 0xa8, 0xbd, 0x0e, 0xaf, 0x71, 0xa8, 0xcf, 0x3b, 0x34, 0x20,
+	0x5d, 0xea, 0x07, 0x12, 0xf7, 0x3d, 0xd8, 0x1c, 0x6f, 0x08, 0xd4, 0x1d, 0xc8, 0x7f, 0x23, 0x8b,
+	0x25, 0x65, 0x6f, 0xb1, 0x92, 0x6f, 0xc5, 0x05, 0x6d, 0x07, 0x54, 0xbe, 0x77, 0x49, 0x5c, 0x4a,
+	0xec, 0x8b, 0x0e, 0x76, 0x5d, 0xd2, 0xf5, 0xa5, 0xea, 0x15, 0x6c, 0x4f, 0xec, 0x0a, 0xe9, 0x43,
+	0x58, 0xb7, 0x79, 0xa7, 0x6d, 0x89, 0x96, 0x30, 0x28, 0xda, 0xa9, 0x05, 0x6d, 0x1b, 0xb6, 0x92,
+	0x3a, 0x5d, 0x4a, 0xdc, 0x20, 0x32, 0xb9, 0x00, 0x75, 0x52, 0x53, 0x78, 0x1c, 0x40, 0x51, 0x7a,
+	0x84, 0x1d, 0x61, 0x51, 0xb0, 0x93, 0xe3, 0xd1, 0x57, 0x19, 0x8a, 0x5c, 0x12, 0x97, 0xf5, 0x22,
+	0x83, 0x0f, 0x61, 0x6b, 0x42, 0x2f, 0xfe, 0x26, 0x85, 0xbe, 0xcd, 0x1b, 0x42, 0x7e, 0xcd, 0x4e,
+	0x0c, 0x47, 0xaf, 0xbd, 0xd9, 0x67, 0x1e, 0xf3, 0x71, 0xfc, 0x82, 0xbe, 0x82, 0xcd, 0xf1, 0x86,
+	0xd0, 0x6d, 0x42, 0xde, 0x93, 0x45, 0xae, 0xb9, 0x7a, 0xf2, 0xb6, 0x3e, 0xed, 0x52, 0xe8, 0xd7,
+	0xa6, 0x75, 0x1e, 0x7e, 0x92, 0x4a, 0xe7, 0x4b, 0x0f, 0x7f, 0xed, 0xe6, 0x5a, 0xb1, 0x88, 0xf6,
+	0x3e, 0x6c, 0xa4, 0xbc, 0x04, 0x03, 0xda, 0x85, 0x55, 0x39, 0xd4, 0xa6, 0x36, 0x3f, 0x89, 0x4b,
+	0x2d, 0x90, 0xa5, 0x6b, 0x5b, 0x73, 0xc6, 0xe8, 0x23, 0xc6, 0x4f, 0x61, 0x45, 0x8e, 0xf1, 0xb5,
+	0x17, 0x43, 0x8c, 0x34, 0xb4, 0x2f, 0xe1, 0x55, 0x6e, 0x74, 0x66, 0xf1, 0xcb, 0x2b, 0x01, 0xaf,
+	0x00, 0xe2, 0x5b, 0x2a, 0x8c, 0xde, 0x92, 0x46, 0xa3, 0x2b, 0xad, 0x87, 0xc9, 0x21, 0xae, 0xb4,
+	0xde, 0xc4, 0x8e, 0xbc, 0x86, 0xad, 0xc4, 0xa6, 0xf6, 0x8b, 0x02, 0x1b, 0x69, 0x7d, 0xf1, 0x1c,
+	0x9f, 0xc0, 0x32, 0x0e, 0x4b, 0xe2, 0x4d, 0xd7, 0xb2, 0x3c, 0x46, 0xa8, 0x22, 0x1e, 0x42, 0x0a,
+	0xa0, 0x8f, 0x52, 0xb0, 0x0b, 0x1c, 0xf6, 0x70, 0x26, 0x6c, 0x08, 0x92, 0xa4, 0x3d, 0xf9, 0x1d,
+	0xe0, 0x25, 0x4e, 0x8b, 0x7e, 0x56, 0x60, 0x2d, 0x99, 0x22, 0xe8, 0x64, 0x3a, 0xde, 0xb4, 0x3c,
+	0x52, 0x1b, 0x73, 0xed, 0x84, 0x3c, 0x9a, 0xf1, 0xfd, 0x1f, 0xff, 0xde, 0x2f, 0x54, 0xd1, 0xa1,
+	0xf1, 0x7f, 0xa1, 0x18, 0xc7, 0x18, 0xfa, 0x51, 0x81, 0x7c, 0x14, 0x21, 0xc8, 0x98, 0xe1, 0x39,
+	0x9e, 0x42, 0xea, 0x71, 0xf6, 0x05, 0x41, 0x78, 0xc4, 0x09, 0x0f, 0xd0, 0xfe, 0x74, 0xc2, 0x28,
+	0xac, 0xd0, 0x6f, 0x0a, 0x14, 0xd3, 0x51, 0x84, 0x4e, 0x67, 0x38, 0x4e, 0xcc, 0x35, 0xf5, 0xdd,
+	0x39, 0xb7, 0x04, 0x6c, 0x9d, 0xc3, 0x1e, 0xa1, 0xea, 0x74, 0xd8, 0xb1, 0x3c, 0x44, 0xbf, 0x2a,
+	0x50, 0x48, 0x05, 0x1b, 0x6a, 0x64, 0xf3, 0x4e, 0x65, 0xa4, 0x7a, 0x3a, 0xdf, 0x92, 0xe0, 0x3d,
+	0xe6, 0xbc, 0x35, 0x54, 0x99, 0xcd, 0x2b, 0xe0, 0x46, 0x47, 0x35, 0x19, 0x93, 0x33, 0x8f, 0xea,
+	0x84, 0xbc, 0x55, 0x1b, 0x73, 0xed, 0x64, 0x3f, 0xaa, 0xa9, 0x9c, 0xe6, 0x47, 0x35, 0x8a, 0xdd,
+	0x99, 0x47, 0x75, 0x3c, 0xb9, 0xd5, 0xe3, 0xec, 0x0b, 0xd9, 0x8f, 0x6a, 0x14, 0xd6, 0xe8, 0x27,
+	0x05, 0x56, 0xa4, 0x04, 0xd2, 0x33, 0x7a, 0x49, 0x36, 0x23, 0xf3, 0xbc, 0x40, 0xfb, 0x80, 0xa3,
+	0x35, 0x50, 0x3d, 0x03, 0x9a, 0xf1, 0x6d, 0xe2, 0xbf, 0xc5, 0x77, 0xe8, 0x5e, 0x81, 0x65, 0x91,
+	0xa7, 0xe8, 0x9d, 0x19, 0xbe, 0xe9, 0x5c, 0x57, 0xf5, 0xac, 0xe3, 0x82, 0xb2, 0xca, 0x29, 0xf7,
+	0xd1, 0x1b, 0xd3, 0x29, 0x45, 0x0a, 0x9f, 0x5f, 0x3d, 0xfc, 0x53, 0xce, 0x3d, 0x3c, 0x95, 0x95,
+	0xc7, 0xa7, 0xb2, 0xf2, 0xf7, 0x53, 0x59, 0xf9, 0xe1, 0xb9, 0x9c, 0x7b, 0x7c, 0x2e, 0xe7, 0xfe,
+	0x7c, 0x2e, 0xe7, 0xbe, 0xa8, 0x38, 0x34, 0xe8, 0x0c, 0x4c, 0xdd, 0x62, 0xbd, 0xa4, 0xd4, 0x6d,
+	0x52, 0x2c, 0xb8, 0xf3, 0x88, 0x6f, 0xbe, 0xcc, 0x7f, 0xe8, 0x35, 0xfe, 0x1b, 0x00, 0x2e, 0x1a,
+	0x19, 0x72, 0xb5, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -235,6 +746,18 @@ type QueryClient interface {
 	IbcAvailable(ctx context.Context, in *QueryIbcAvailableRequest, opts ...grpc.CallOption) (*QueryIbcAvailableResponse, error)
 	// Whitelist returns the list of authorized addresses.
 	Whitelist(ctx context.Context, in *QueryWhitelistRequest, opts ...grpc.CallOption) (*QueryWhitelistResponse, error)
+	// DeniedChannels returns the IBC channel IDs denied by the breaker.
+	DeniedChannels(ctx context.Context, in *QueryDeniedChannelsRequest, opts ...grpc.CallOption) (*QueryDeniedChannelsResponse, error)
+	// DeniedClients returns the IBC client IDs denied by the breaker.
+	DeniedClients(ctx context.Context, in *QueryDeniedClientsRequest, opts ...grpc.CallOption) (*QueryDeniedClientsResponse, error)
+	// DeniedDenoms returns the denominations denied for IBC transfers.
+	DeniedDenoms(ctx context.Context, in *QueryDeniedDenomsRequest, opts ...grpc.CallOption) (*QueryDeniedDenomsResponse, error)
+	// Proposals returns the pending ibcbreaker proposals.
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// Proposal returns a pending ibcbreaker proposal by id.
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// Actions returns the ibcbreaker action log.
+	Actions(ctx context.Context, in *QueryActionsRequest, opts ...grpc.CallOption) (*QueryActionsResponse, error)
 }
 
 type queryClient struct {
@@ -263,12 +786,78 @@ func (c *queryClient) Whitelist(ctx context.Context, in *QueryWhitelistRequest,
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// IbcAvailable returns the current IBC availability flag.
-	IbcAvailable(context.Context, *QueryIbcAvailableRequest) (*QueryIbcAvailableResponse, error)
+func (c *queryClient) DeniedChannels(ctx context.Context, in *QueryDeniedChannelsRequest, opts ...grpc.CallOption) (*QueryDeniedChannelsResponse, error) {
+	out := new(QueryDeniedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcbreaker.v1.Query/DeniedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeniedClients(ctx context.Context, in *QueryDeniedClientsRequest, opts ...grpc.CallOption) (*QueryDeniedClientsResponse, error) {
+	out := new(QueryDeniedClientsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcbreaker.v1.Query/DeniedClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeniedDenoms(ctx context.Context, in *QueryDeniedDenomsRequest, opts ...grpc.CallOption) (*QueryDeniedDenomsResponse, error) {
+	out := new(QueryDeniedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcbreaker.v1.Query/DeniedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcbreaker.v1.Query/Proposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcbreaker.v1.Query/Proposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Actions(ctx context.Context, in *QueryActionsRequest, opts ...grpc.CallOption) (*QueryActionsResponse, error) {
+	out := new(QueryActionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcbreaker.v1.Query/Actions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// IbcAvailable returns the current IBC availability flag.
+	IbcAvailable(context.Context, *QueryIbcAvailableRequest) (*QueryIbcAvailableResponse, error)
 	// Whitelist returns the list of authorized addresses.
 	Whitelist(context.Context, *QueryWhitelistRequest) (*QueryWhitelistResponse, error)
+	// DeniedChannels returns the IBC channel IDs denied by the breaker.
+	DeniedChannels(context.Context, *QueryDeniedChannelsRequest) (*QueryDeniedChannelsResponse, error)
+	// DeniedClients returns the IBC client IDs denied by the breaker.
+	DeniedClients(context.Context, *QueryDeniedClientsRequest) (*QueryDeniedClientsResponse, error)
+	// DeniedDenoms returns the denominations denied for IBC transfers.
+	DeniedDenoms(context.Context, *QueryDeniedDenomsRequest) (*QueryDeniedDenomsResponse, error)
+	// Proposals returns the pending ibcbreaker proposals.
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// Proposal returns a pending ibcbreaker proposal by id.
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// Actions returns the ibcbreaker action log.
+	Actions(context.Context, *QueryActionsRequest) (*QueryActionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -281,6 +870,24 @@ func (*UnimplementedQueryServer) IbcAvailable(ctx context.Context, req *QueryIbc
 func (*UnimplementedQueryServer) Whitelist(ctx context.Context, req *QueryWhitelistRequest) (*QueryWhitelistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Whitelist not implemented")
 }
+func (*UnimplementedQueryServer) DeniedChannels(ctx context.Context, req *QueryDeniedChannelsRequest) (*QueryDeniedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeniedChannels not implemented")
+}
+func (*UnimplementedQueryServer) DeniedClients(ctx context.Context, req *QueryDeniedClientsRequest) (*QueryDeniedClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeniedClients not implemented")
+}
+func (*UnimplementedQueryServer) DeniedDenoms(ctx context.Context, req *QueryDeniedDenomsRequest) (*QueryDeniedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeniedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (*UnimplementedQueryServer) Actions(ctx context.Context, req *QueryActionsRequest) (*QueryActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Actions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -322,6 +929,114 @@ func _Query_Whitelist_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeniedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeniedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeniedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcbreaker.v1.Query/DeniedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeniedChannels(ctx, req.(*QueryDeniedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeniedClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeniedClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeniedClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcbreaker.v1.Query/DeniedClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeniedClients(ctx, req.(*QueryDeniedClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeniedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeniedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeniedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcbreaker.v1.Query/DeniedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeniedDenoms(ctx, req.(*QueryDeniedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcbreaker.v1.Query/Proposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcbreaker.v1.Query/Proposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Actions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Actions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcbreaker.v1.Query/Actions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Actions(ctx, req.(*QueryActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.ibcbreaker.v1.Query",
@@ -335,6 +1050,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Whitelist",
 			Handler:    _Query_Whitelist_Handler,
 		},
+		{
+			MethodName: "DeniedChannels",
+			Handler:    _Query_DeniedChannels_Handler,
+		},
+		{
+			MethodName: "DeniedClients",
+			Handler:    _Query_DeniedClients_Handler,
+		},
+		{
+			MethodName: "DeniedDenoms",
+			Handler:    _Query_DeniedDenoms_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "Actions",
+			Handler:    _Query_Actions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/ibcbreaker/v1/query.proto",
@@ -383,6 +1122,21 @@ func (m *QueryIbcAvailableResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.InboundPaused {
+		i--
+		if m.InboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.IbcAvailable {
 		i--
 		if m.IbcAvailable {
@@ -451,69 +1205,1417 @@ func (m *QueryWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDeniedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIbcAvailableRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryDeniedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeniedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeniedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeniedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeniedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.DeniedChannels) > 0 {
+		for iNdEx := len(m.DeniedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedChannels[iNdEx])
+			copy(dAtA[i:], m.DeniedChannels[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DeniedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIbcAvailableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDeniedClientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeniedClientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeniedClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IbcAvailable {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeniedClientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeniedClientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeniedClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedClients) > 0 {
+		for iNdEx := len(m.DeniedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedClients[iNdEx])
+			copy(dAtA[i:], m.DeniedClients[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DeniedClients[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeniedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeniedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeniedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeniedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeniedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeniedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIbcAvailableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIbcAvailableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcAvailable {
+		n += 2
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAtHeight))
+	}
+	if m.InboundPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeniedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeniedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeniedChannels) > 0 {
+		for _, s := range m.DeniedChannels {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeniedClientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeniedClientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeniedClients) > 0 {
+		for _, s := range m.DeniedClients {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeniedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeniedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIbcAvailableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIbcAvailableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIbcAvailableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIbcAvailableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIbcAvailableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIbcAvailableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAvailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcAvailable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InboundPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeniedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeniedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeniedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeniedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeniedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeniedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedChannels = append(m.DeniedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeniedClientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeniedClientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeniedClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeniedClientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeniedClientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeniedClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedClients = append(m.DeniedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeniedDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeniedDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeniedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeniedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeniedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeniedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryWhitelistRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryWhitelistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Whitelist) > 0 {
-		for _, s := range m.Whitelist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, IbcBreakerProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryIbcAvailableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -536,12 +2638,31 @@ func (m *QueryIbcAvailableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIbcAvailableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIbcAvailableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -563,7 +2684,7 @@ func (m *QueryIbcAvailableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIbcAvailableResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -586,17 +2707,17 @@ func (m *QueryIbcAvailableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIbcAvailableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIbcAvailableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcAvailable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -606,12 +2727,25 @@ func (m *QueryIbcAvailableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IbcAvailable = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -633,7 +2767,7 @@ func (m *QueryIbcAvailableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,12 +2790,48 @@ func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -683,7 +2853,7 @@ func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -706,17 +2876,17 @@ func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -726,23 +2896,61 @@ func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			m.Actions = append(m.Actions, IbcBreakerAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsTripped reports whether IBC is currently halted, either entirely, for
+// incoming transfers or for some channels, clients or denominations.
+func (s IbcBreakerState) IsTripped() bool {
+	return !s.IbcAvailable || s.InboundPaused ||
+		len(s.DeniedChannels) > 0 || len(s.DeniedClients) > 0 || len(s.DeniedDenoms) > 0
+}
+
+func (s IbcBreakerState) Validate() error {
+	if s.ExpiresAtHeight != 0 && !s.IsTripped() {
+		return fmt.Errorf("expires_at_height can only be set while the ibc breaker is tripped")
+	}
+	if err := ValidateDeniedChannels(s.DeniedChannels); err != nil {
+		return err
+	}
+	if err := ValidateDeniedClients(s.DeniedClients); err != nil {
+		return err
+	}
+	return ValidateDeniedDenoms(s.DeniedDenoms)
+}
+
+func ValidateDeniedChannels(channelIDs []string) error {
+	return validateDeniedSet("channel", channelIDs, host.ChannelIdentifierValidator)
+}
+
+func ValidateDeniedClients(clientIDs []string) error {
+	return validateDeniedSet("client", clientIDs, host.ClientIdentifierValidator)
+}
+
+func ValidateDeniedDenoms(denoms []string) error {
+	return validateDeniedSet("denom", denoms, sdk.ValidateDenom)
+}
+
+func validateDeniedSet(kind string, entries []string, validate func(string) error) error {
+	seen := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		if err := validate(entry); err != nil {
+			return fmt.Errorf("invalid denied %s %q: %w", kind, entry, err)
+		}
+		if _, ok := seen[entry]; ok {
+			return fmt.Errorf("duplicate denied %s: %s", kind, entry)
+		}
+		seen[entry] = struct{}{}
+	}
+	return nil
+}
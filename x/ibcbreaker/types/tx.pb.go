@@ -36,6 +36,20 @@ type MsgUpdateIbcBreaker struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// ibc_available is the desired IBC availability flag.
 	IbcAvailable bool `protobuf:"varint,2,opt,name=ibc_available,json=ibcAvailable,proto3" json:"ibc_available,omitempty"`
+	// expires_at_height is the block height at which the trip is automatically
+	// restored. Zero means no expiry unless params cap the trip duration.
+	ExpiresAtHeight uint64 `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// reason is an optional justification recorded in the action log.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// denied_channels is the desired set of denied IBC channel IDs.
+	DeniedChannels []string `protobuf:"bytes,5,rep,name=denied_channels,json=deniedChannels,proto3" json:"denied_channels,omitempty"`
+	// denied_clients is the desired set of denied IBC client IDs.
+	DeniedClients []string `protobuf:"bytes,6,rep,name=denied_clients,json=deniedClients,proto3" json:"denied_clients,omitempty"`
+	// denied_denoms is the desired set of denominations denied for IBC
+	// transfers.
+	DeniedDenoms []string `protobuf:"bytes,7,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+	// inbound_paused is the desired inbound transfer pause flag.
+	InboundPaused bool `protobuf:"varint,8,opt,name=inbound_paused,json=inboundPaused,proto3" json:"inbound_paused,omitempty"`
 }
 
 func (m *MsgUpdateIbcBreaker) Reset()         { *m = MsgUpdateIbcBreaker{} }
@@ -85,8 +99,55 @@ func (m *MsgUpdateIbcBreaker) GetIbcAvailable() bool {
 	return false
 }
 
+func (m *MsgUpdateIbcBreaker) GetExpiresAtHeight() uint64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *MsgUpdateIbcBreaker) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgUpdateIbcBreaker) GetDeniedChannels() []string {
+	if m != nil {
+		return m.DeniedChannels
+	}
+	return nil
+}
+
+func (m *MsgUpdateIbcBreaker) GetDeniedClients() []string {
+	if m != nil {
+		return m.DeniedClients
+	}
+	return nil
+}
+
+func (m *MsgUpdateIbcBreaker) GetDeniedDenoms() []string {
+	if m != nil {
+		return m.DeniedDenoms
+	}
+	return nil
+}
+
+func (m *MsgUpdateIbcBreaker) GetInboundPaused() bool {
+	if m != nil {
+		return m.InboundPaused
+	}
+	return false
+}
+
 // MsgUpdateIbcBreakerResponse defines the response structure for executing a MsgUpdateIbcBreaker message.
 type MsgUpdateIbcBreakerResponse struct {
+	// proposal_id is the pending proposal that recorded the vote. Zero when the
+	// update was applied without a pending proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// executed is true when the update was applied to the ibcbreaker state.
+	Executed bool `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
 }
 
 func (m *MsgUpdateIbcBreakerResponse) Reset()         { *m = MsgUpdateIbcBreakerResponse{} }
@@ -122,6 +183,122 @@ func (m *MsgUpdateIbcBreakerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateIbcBreakerResponse proto.InternalMessageInfo
 
+func (m *MsgUpdateIbcBreakerResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgUpdateIbcBreakerResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// MsgVoteProposal defines a Msg for approving a pending ibcbreaker proposal.
+type MsgVoteProposal struct {
+	// signer is the whitelisted operator approving the proposal.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// proposal_id is the pending proposal to approve.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgVoteProposal) Reset()         { *m = MsgVoteProposal{} }
+func (m *MsgVoteProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteProposal) ProtoMessage()    {}
+func (*MsgVoteProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3552f289e212e08d, []int{2}
+}
+func (m *MsgVoteProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteProposal.Merge(m, src)
+}
+func (m *MsgVoteProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteProposal proto.InternalMessageInfo
+
+func (m *MsgVoteProposal) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgVoteProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgVoteProposalResponse defines the response structure for executing a MsgVoteProposal message.
+type MsgVoteProposalResponse struct {
+	// executed is true when the vote reached the threshold and the proposal was
+	// applied to the ibcbreaker state.
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgVoteProposalResponse) Reset()         { *m = MsgVoteProposalResponse{} }
+func (m *MsgVoteProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteProposalResponse) ProtoMessage()    {}
+func (*MsgVoteProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3552f289e212e08d, []int{3}
+}
+func (m *MsgVoteProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteProposalResponse.Merge(m, src)
+}
+func (m *MsgVoteProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteProposalResponse proto.InternalMessageInfo
+
+func (m *MsgVoteProposalResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
 // MsgUpdateParams defines a Msg for updating the ibcbreaker params.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -135,7 +312,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3552f289e212e08d, []int{2}
+	return fileDescriptor_3552f289e212e08d, []int{4}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +363,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3552f289e212e08d, []int{3}
+	return fileDescriptor_3552f289e212e08d, []int{5}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,6 +395,8 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgUpdateIbcBreaker)(nil), "cosmos.evm.ibcbreaker.v1.MsgUpdateIbcBreaker")
 	proto.RegisterType((*MsgUpdateIbcBreakerResponse)(nil), "cosmos.evm.ibcbreaker.v1.MsgUpdateIbcBreakerResponse")
+	proto.RegisterType((*MsgVoteProposal)(nil), "cosmos.evm.ibcbreaker.v1.MsgVoteProposal")
+	proto.RegisterType((*MsgVoteProposalResponse)(nil), "cosmos.evm.ibcbreaker.v1.MsgVoteProposalResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.ibcbreaker.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.ibcbreaker.v1.MsgUpdateParamsResponse")
 }
@@ -225,34 +404,47 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/ibcbreaker/v1/tx.proto", fileDescriptor_3552f289e212e08d) }
 
 var fileDescriptor_3552f289e212e08d = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0xcf, 0x4c, 0x4a, 0x4e, 0x2a, 0x4a, 0x4d, 0xcc, 0x4e,
-	0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x80,
-	0x28, 0xd1, 0x4b, 0x2d, 0xcb, 0xd5, 0x43, 0x28, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd,
-	0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xc5, 0x52, 0xe2, 0x50, 0xf3, 0x72, 0x8b, 0xd3, 0x41, 0x86,
-	0xe4, 0x16, 0xa7, 0x43, 0x25, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0xd4, 0x48, 0x88, 0x94,
-	0x26, 0x4e, 0x37, 0x20, 0x59, 0x07, 0x56, 0xaa, 0xb4, 0x92, 0x91, 0x4b, 0xd8, 0xb7, 0x38, 0x3d,
-	0xb4, 0x20, 0x25, 0xb1, 0x24, 0xd5, 0x33, 0x29, 0xd9, 0x09, 0x22, 0x2b, 0x64, 0xc0, 0xc5, 0x56,
-	0x9c, 0x99, 0x9e, 0x97, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b,
-	0xae, 0x08, 0xd4, 0x12, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc,
-	0xf4, 0x20, 0xa8, 0x3a, 0x21, 0x65, 0x2e, 0xde, 0xcc, 0xa4, 0xe4, 0xf8, 0xc4, 0xb2, 0xc4, 0xcc,
-	0x9c, 0xc4, 0xa4, 0x9c, 0x54, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x9e, 0xcc, 0xa4, 0x64,
-	0x47, 0x98, 0x98, 0x95, 0x75, 0xd3, 0xf3, 0x0d, 0x5a, 0x50, 0x1d, 0x5d, 0xcf, 0x37, 0x68, 0x69,
-	0x23, 0xb9, 0xb4, 0x02, 0xd9, 0xad, 0x58, 0xdc, 0xa4, 0x24, 0xcb, 0x25, 0x8d, 0x45, 0x38, 0x28,
-	0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x69, 0x3f, 0x23, 0x17, 0x3f, 0x5c, 0x3e, 0x20, 0xb1,
-	0x28, 0x31, 0xb7, 0x58, 0xc8, 0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4,
-	0x92, 0xa0, 0x4f, 0x10, 0x4a, 0x85, 0x2c, 0xb8, 0xd8, 0x0a, 0xc0, 0x26, 0x80, 0x7d, 0xc1, 0x6d,
-	0xa4, 0xa0, 0x87, 0x2b, 0xce, 0xf4, 0x20, 0x36, 0x05, 0x41, 0xd5, 0x5b, 0x59, 0x81, 0x7c, 0x88,
-	0x30, 0x09, 0xe4, 0x49, 0x75, 0x82, 0x9e, 0x84, 0x98, 0xa1, 0x24, 0xc9, 0x25, 0x8e, 0x26, 0x04,
-	0xf3, 0x9c, 0xd1, 0x17, 0x46, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x0a, 0x2e, 0x01, 0x8c, 0xb8,
-	0xd2, 0xc5, 0xed, 0x38, 0x2c, 0xe1, 0x25, 0x65, 0x4a, 0x92, 0x72, 0x98, 0x0b, 0x84, 0x72, 0xb8,
-	0x78, 0x50, 0x82, 0x56, 0x93, 0x08, 0x63, 0x20, 0x4a, 0xa5, 0x0c, 0x89, 0x56, 0x0a, 0xb3, 0x4d,
-	0x8a, 0xb5, 0xe1, 0xf9, 0x06, 0x2d, 0x46, 0x27, 0xa7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0xd2, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xc7,
-	0x15, 0xbe, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x94, 0x6e, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x97, 0xc2, 0xc9, 0xc8, 0x9a, 0x03, 0x00, 0x00,
+	// 639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xeb, 0xa6, 0x0d, 0xed, 0xf5, 0x1f, 0x35, 0x88, 0xba, 0x46, 0x0a, 0x21, 0x08, 0x35,
+	0x0d, 0xaa, 0x4d, 0x8a, 0x0a, 0x28, 0x4c, 0x0d, 0x0c, 0x74, 0xa8, 0x54, 0x19, 0xc1, 0xd0, 0xc5,
+	0x3a, 0xdb, 0xaf, 0x9c, 0x13, 0xf1, 0x9d, 0xe5, 0xbb, 0x44, 0xe9, 0x86, 0xd8, 0x60, 0xe2, 0x0b,
+	0xf0, 0x1d, 0x3a, 0xb0, 0xb3, 0x32, 0x56, 0x4c, 0x8c, 0x28, 0x1d, 0xfa, 0x35, 0x50, 0xee, 0x2e,
+	0xad, 0x1b, 0x9a, 0xb6, 0xb0, 0x44, 0xba, 0xe7, 0x7e, 0x79, 0xdf, 0xe7, 0x79, 0x5f, 0xdb, 0xe8,
+	0x7e, 0xc8, 0x78, 0xc2, 0xb8, 0x0b, 0xdd, 0xc4, 0x25, 0x41, 0x18, 0x64, 0x80, 0xdf, 0x43, 0xe6,
+	0x76, 0xeb, 0xae, 0xe8, 0x39, 0x69, 0xc6, 0x04, 0x33, 0x2d, 0x85, 0x38, 0xd0, 0x4d, 0x9c, 0x33,
+	0xc4, 0xe9, 0xd6, 0xed, 0x65, 0x9c, 0x10, 0xca, 0x5c, 0xf9, 0xab, 0x60, 0x7b, 0x45, 0xd7, 0x4b,
+	0x78, 0x3c, 0x28, 0x92, 0xf0, 0x58, 0x5f, 0xac, 0xaa, 0x0b, 0x5f, 0x9e, 0x5c, 0x5d, 0x52, 0x5d,
+	0xad, 0x8f, 0xf5, 0x90, 0x6b, 0x27, 0xd1, 0xca, 0xa7, 0x02, 0xba, 0xb5, 0xcb, 0xe3, 0xb7, 0x69,
+	0x84, 0x05, 0xec, 0x04, 0x61, 0x53, 0xdd, 0x9a, 0x8f, 0x51, 0x91, 0x93, 0x98, 0x42, 0x66, 0x19,
+	0x65, 0xa3, 0x3a, 0xdb, 0xb4, 0x7e, 0x7e, 0xdb, 0xb8, 0xad, 0x9b, 0x6c, 0x47, 0x51, 0x06, 0x9c,
+	0xbf, 0x11, 0x19, 0xa1, 0xb1, 0xa7, 0x39, 0xf3, 0x01, 0x5a, 0x20, 0x41, 0xe8, 0xe3, 0x2e, 0x26,
+	0x6d, 0x1c, 0xb4, 0xc1, 0x9a, 0x2c, 0x1b, 0xd5, 0x19, 0x6f, 0x9e, 0x04, 0xe1, 0xf6, 0x50, 0x33,
+	0x6b, 0x68, 0x19, 0x7a, 0x29, 0xc9, 0x80, 0xfb, 0x58, 0xf8, 0x2d, 0x20, 0x71, 0x4b, 0x58, 0x85,
+	0xb2, 0x51, 0x9d, 0xf2, 0x96, 0xf4, 0xc5, 0xb6, 0x78, 0x2d, 0x65, 0xf3, 0x0e, 0x2a, 0x66, 0x80,
+	0x39, 0xa3, 0xd6, 0xd4, 0xc0, 0x82, 0xa7, 0x4f, 0xe6, 0x1a, 0x5a, 0x8a, 0x80, 0x12, 0x88, 0xfc,
+	0xb0, 0x85, 0x29, 0x85, 0x36, 0xb7, 0xa6, 0xcb, 0x85, 0xea, 0xac, 0xb7, 0xa8, 0xe4, 0x97, 0x5a,
+	0x35, 0x1f, 0xa2, 0xc5, 0x21, 0xd8, 0x26, 0x40, 0x05, 0xb7, 0x8a, 0x92, 0x5b, 0xd0, 0x9c, 0x12,
+	0x07, 0xc6, 0x35, 0x16, 0x01, 0x65, 0x09, 0xb7, 0x6e, 0x48, 0x6a, 0x5e, 0x89, 0xaf, 0xa4, 0x36,
+	0xa8, 0x45, 0x68, 0xc0, 0x3a, 0x34, 0xf2, 0x53, 0xdc, 0xe1, 0x10, 0x59, 0x33, 0x32, 0xde, 0x82,
+	0x56, 0xf7, 0xa4, 0xd8, 0x78, 0xf1, 0xf1, 0xe4, 0xb0, 0xa6, 0x27, 0xf2, 0xf9, 0xe4, 0xb0, 0xf6,
+	0x28, 0xb7, 0x89, 0x5e, 0x7e, 0x17, 0x17, 0xcc, 0xbc, 0xb2, 0x8f, 0xee, 0x5e, 0x20, 0x7b, 0xc0,
+	0x53, 0x46, 0x39, 0x98, 0xf7, 0xd0, 0x5c, 0x9a, 0xb1, 0x94, 0x71, 0xdc, 0xf6, 0x49, 0x24, 0xf7,
+	0x32, 0xe5, 0xa1, 0xa1, 0xb4, 0x13, 0x99, 0x36, 0x9a, 0x81, 0x1e, 0x84, 0x1d, 0x01, 0x91, 0x1e,
+	0xfe, 0xe9, 0xb9, 0xf2, 0xd5, 0x40, 0x4b, 0xbb, 0x3c, 0x7e, 0xc7, 0x04, 0xec, 0xe9, 0x7f, 0xfc,
+	0xc7, 0x8e, 0x47, 0x2c, 0x4c, 0x8e, 0x5a, 0x68, 0x3c, 0x1b, 0xc9, 0xbf, 0x76, 0x49, 0xfe, 0xbc,
+	0x97, 0xca, 0x16, 0x5a, 0x19, 0x91, 0x4e, 0x73, 0xe7, 0x63, 0x19, 0x23, 0xb1, 0xbe, 0xab, 0x58,
+	0x6a, 0x66, 0x7b, 0x38, 0xc3, 0x09, 0x37, 0x9f, 0xa2, 0x59, 0xdc, 0x11, 0x2d, 0x96, 0x11, 0x71,
+	0x70, 0x65, 0xb2, 0x33, 0xd4, 0x7c, 0x8e, 0x8a, 0xa9, 0xac, 0x20, 0x73, 0xcd, 0x6d, 0x96, 0x9d,
+	0x71, 0xef, 0xa9, 0xa3, 0x3a, 0x79, 0x9a, 0x6f, 0x34, 0x06, 0xa9, 0xcf, 0x2a, 0x5d, 0x15, 0x3c,
+	0xef, 0xb6, 0xb2, 0x8a, 0x56, 0x46, 0xa4, 0x61, 0xf0, 0xcd, 0xfe, 0x24, 0x2a, 0xec, 0xf2, 0xd8,
+	0xec, 0xa1, 0x9b, 0x7f, 0xbd, 0x9f, 0x1b, 0xe3, 0xcd, 0x5d, 0xf0, 0x0c, 0xd9, 0x5b, 0xff, 0x84,
+	0x9f, 0x8e, 0xbe, 0x8d, 0xe6, 0xcf, 0x3d, 0x31, 0xeb, 0x97, 0x96, 0xc9, 0xa3, 0x76, 0xfd, 0xda,
+	0x68, 0xbe, 0xdb, 0xb9, 0x45, 0xae, 0x5f, 0xc3, 0xb4, 0x42, 0xed, 0xfa, 0xb5, 0xd1, 0x61, 0x37,
+	0x7b, 0xfa, 0xc3, 0xc9, 0x61, 0xcd, 0x68, 0x36, 0x7f, 0xf4, 0x4b, 0xc6, 0x51, 0xbf, 0x64, 0xfc,
+	0xee, 0x97, 0x8c, 0x2f, 0xc7, 0xa5, 0x89, 0xa3, 0xe3, 0xd2, 0xc4, 0xaf, 0xe3, 0xd2, 0xc4, 0x7e,
+	0x35, 0x26, 0xa2, 0xd5, 0x09, 0x9c, 0x90, 0x25, 0xee, 0xb8, 0x6d, 0x8a, 0x83, 0x14, 0x78, 0x50,
+	0x94, 0xdf, 0xd2, 0x27, 0x7f, 0x06, 0x00, 0x0e, 0x2d, 0x39, 0x60, 0xfc, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -267,8 +459,12 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
-	// UpdateIbcBreaker updates the IBC availability flag.
+	// UpdateIbcBreaker proposes and approves an update of the IBC availability
+	// flag. The update is applied once the configured operator threshold is
+	// reached.
 	UpdateIbcBreaker(ctx context.Context, in *MsgUpdateIbcBreaker, opts ...grpc.CallOption) (*MsgUpdateIbcBreakerResponse, error)
+	// VoteProposal approves a pending ibcbreaker proposal.
+	VoteProposal(ctx context.Context, in *MsgVoteProposal, opts ...grpc.CallOption) (*MsgVoteProposalResponse, error)
 	// UpdateParams updates the ibcbreaker module params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -290,6 +486,15 @@ func (c *msgClient) UpdateIbcBreaker(ctx context.Context, in *MsgUpdateIbcBreake
 	return out, nil
 }
 
+func (c *msgClient) VoteProposal(ctx context.Context, in *MsgVoteProposal, opts ...grpc.CallOption) (*MsgVoteProposalResponse, error) {
+	out := new(MsgVoteProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcbreaker.v1.Msg/VoteProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcbreaker.v1.Msg/UpdateParams", in, out, opts...)
@@ -301,8 +506,12 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
-	// UpdateIbcBreaker updates the IBC availability flag.
+	// UpdateIbcBreaker proposes and approves an update of the IBC availability
+	// flag. The update is applied once the configured operator threshold is
+	// reached.
 	UpdateIbcBreaker(context.Context, *MsgUpdateIbcBreaker) (*MsgUpdateIbcBreakerResponse, error)
+	// VoteProposal approves a pending ibcbreaker proposal.
+	VoteProposal(context.Context, *MsgVoteProposal) (*MsgVoteProposalResponse, error)
 	// UpdateParams updates the ibcbreaker module params.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -314,6 +523,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateIbcBreaker(ctx context.Context, req *MsgUpdateIbcBreaker) (*MsgUpdateIbcBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateIbcBreaker not implemented")
 }
+func (*UnimplementedMsgServer) VoteProposal(ctx context.Context, req *MsgVoteProposal) (*MsgVoteProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteProposal not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -340,6 +552,24 @@ func _Msg_UpdateIbcBreaker_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcbreaker.v1.Msg/VoteProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteProposal(ctx, req.(*MsgVoteProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -367,6 +597,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateIbcBreaker",
 			Handler:    _Msg_UpdateIbcBreaker_Handler,
 		},
+		{
+			MethodName: "VoteProposal",
+			Handler:    _Msg_VoteProposal_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -396,6 +630,55 @@ func (m *MsgUpdateIbcBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InboundPaused {
+		i--
+		if m.InboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DeniedClients) > 0 {
+		for iNdEx := len(m.DeniedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedClients[iNdEx])
+			copy(dAtA[i:], m.DeniedClients[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeniedClients[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DeniedChannels) > 0 {
+		for iNdEx := len(m.DeniedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedChannels[iNdEx])
+			copy(dAtA[i:], m.DeniedChannels[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeniedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.IbcAvailable {
 		i--
 		if m.IbcAvailable {
@@ -436,6 +719,89 @@ func (m *MsgUpdateIbcBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -528,6 +894,34 @@ func (m *MsgUpdateIbcBreaker) Size() (n int) {
 	if m.IbcAvailable {
 		n += 2
 	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DeniedChannels) > 0 {
+		for _, s := range m.DeniedChannels {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DeniedClients) > 0 {
+		for _, s := range m.DeniedClients {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.InboundPaused {
+		n += 2
+	}
 	return n
 }
 
@@ -537,6 +931,40 @@ func (m *MsgUpdateIbcBreakerResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgVoteProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVoteProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
 	return n
 }
 
@@ -653,17 +1081,184 @@ func (m *MsgUpdateIbcBreaker) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IbcAvailable = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedChannels = append(m.DeniedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedClients = append(m.DeniedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InboundPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -703,6 +1298,216 @@ func (m *MsgUpdateIbcBreakerResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgUpdateIbcBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,104 @@
+package v2
+
+import (
+	"errors"
+
+	"github.com/cosmos/evm/x/ibcbreaker/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ibcapi.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the ibcbreaker middleware.
+// The logic is the same as the IBCMiddleware, but this is a v2 version of the
+// middleware.
+type IBCMiddleware struct {
+	app    ibcapi.IBCModule
+	keeper types.IbcBreakerKeeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(
+	app ibcapi.IBCModule,
+	k types.IbcBreakerKeeper,
+) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+	if k == nil {
+		panic(errors.New("ibcbreaker keeper cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket doesn't do anything in the ibcbreaker middleware.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// While inbound transfers are paused it fails the packet without calling the
+// underlying application, so core IBC writes an error acknowledgement and the
+// sender is refunded on the source chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	if im.keeper.GetInboundPaused(ctx) {
+		im.keeper.Logger(ctx).Info(
+			"rejected inbound packet",
+			"destination_client", destinationClient,
+			"sequence", sequence,
+		)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket doesn't do anything in the ibcbreaker middleware.
+// Refunds for outbound packets must keep working while inbound is paused.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
+
+// OnTimeoutPacket doesn't do anything in the ibcbreaker middleware.
+// Refunds for outbound packets must keep working while inbound is paused.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
+const FlagTemplate = "template"
+
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        ibcexttypes.ModuleName,
@@ -22,6 +24,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdQueryWhitelist(),
+		GetCmdQueryTemplates(),
+		GetCmdQueryTemplate(),
+		GetCmdQueryTemplateRateLimits(),
 	)
 	return cmd
 }
@@ -47,3 +52,74 @@ func GetCmdQueryWhitelist() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdQueryTemplates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Query all rate limit templates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := ibcexttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Templates(context.Background(), &ibcexttypes.QueryTemplatesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template [name]",
+		Short: "Query a rate limit template by name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := ibcexttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Template(context.Background(), &ibcexttypes.QueryTemplateRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryTemplateRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template-rate-limits",
+		Short: "Query which rate limits were set from which template",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			template, err := cmd.Flags().GetString(FlagTemplate)
+			if err != nil {
+				return err
+			}
+			queryClient := ibcexttypes.NewQueryClient(clientCtx)
+			res, err := queryClient.TemplateRateLimits(context.Background(), &ibcexttypes.QueryTemplateRateLimitsRequest{Template: template})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagTemplate, "", "only return the rate limits set from this template")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,18 +1,25 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	ibcexttypes "github.com/cosmos/evm/x/ibcratelimiterext/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 )
 
-const FlagWhitelist = "whitelist"
+const (
+	FlagWhitelist     = "whitelist"
+	FlagAllTokenPairs = "all-token-pairs"
+)
 
 func NewTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,6 +32,9 @@ func NewTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetTxUpdateParamsCmd(),
+		GetTxSetTemplateCmd(),
+		GetTxRemoveTemplateCmd(),
+		GetTxApplyTemplateCmd(),
 	)
 	return cmd
 }
@@ -71,3 +81,119 @@ func GetTxUpdateParamsCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetTxSetTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-template [name] [max-percent-send] [max-percent-recv] [duration-hours]",
+		Short: "Create or replace a rate limit template (governance authority only)",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxPercentSend, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max-percent-send %q", args[1])
+			}
+			maxPercentRecv, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid max-percent-recv %q", args[2])
+			}
+			durationHours, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid duration-hours %q: %w", args[3], err)
+			}
+
+			msg := &ibcexttypes.MsgSetRateLimitTemplate{
+				Authority: clientCtx.GetFromAddress().String(),
+				Template: ibcexttypes.RateLimitTemplate{
+					Name:           args[0],
+					MaxPercentSend: maxPercentSend,
+					MaxPercentRecv: maxPercentRecv,
+					DurationHours:  durationHours,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTxRemoveTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-template [name]",
+		Short: "Remove a rate limit template (governance authority only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &ibcexttypes.MsgRemoveRateLimitTemplate{
+				Authority: clientCtx.GetFromAddress().String(),
+				Name:      args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTxApplyTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply-template [template] [channel-or-client-id] [denoms]",
+		Short: "Apply a rate limit template to a channel or client for a comma-separated list of denoms",
+		Long: "Adds or updates the rate limit of every denom on the channel or client with the quota of the template. " +
+			"Pass --all-token-pairs instead of denoms to use every token pair registered in x/erc20.",
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			allTokenPairs, err := cmd.Flags().GetBool(FlagAllTokenPairs)
+			if err != nil {
+				return err
+			}
+			denoms := []string{}
+			if len(args) == 3 {
+				for _, v := range strings.Split(args[2], ",") {
+					s := strings.TrimSpace(v)
+					if s != "" {
+						denoms = append(denoms, s)
+					}
+				}
+			}
+
+			msg := &ibcexttypes.MsgApplyRateLimitTemplate{
+				Signer:            clientCtx.GetFromAddress().String(),
+				Template:          args[0],
+				ChannelOrClientId: args[1],
+				Denoms:            denoms,
+				AllTokenPairs:     allTokenPairs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAllTokenPairs, false, "apply the template to every token pair registered in x/erc20")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
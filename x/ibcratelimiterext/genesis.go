@@ -9,8 +9,18 @@ import (
 
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	k.SetParams(ctx, data.Params)
+	for _, template := range data.Templates {
+		k.SetTemplate(ctx, template)
+	}
+	for _, record := range data.TemplateRateLimits {
+		k.SetTemplateRateLimit(ctx, record)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return &types.GenesisState{Params: k.GetParams(ctx)}
+	return &types.GenesisState{
+		Params:             k.GetParams(ctx),
+		Templates:          k.GetTemplates(ctx),
+		TemplateRateLimits: k.GetTemplateRateLimits(ctx),
+	}
 }
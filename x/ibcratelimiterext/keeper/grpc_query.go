@@ -22,3 +22,45 @@ func (k Keeper) Whitelist(c context.Context, _ *types.QueryWhitelistRequest) (*t
 	params := k.GetParams(ctx)
 	return &types.QueryWhitelistResponse{Whitelist: params.Whitelist}, nil
 }
+
+func (k Keeper) Templates(c context.Context, _ *types.QueryTemplatesRequest) (*types.QueryTemplatesResponse, error) {
+	if c == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nil context")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTemplatesResponse{Templates: k.GetTemplates(ctx)}, nil
+}
+
+func (k Keeper) Template(c context.Context, req *types.QueryTemplateRequest) (*types.QueryTemplateResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	template, found := k.GetTemplate(ctx, req.Name)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "rate limit template %s", req.Name)
+	}
+	return &types.QueryTemplateResponse{Template: template}, nil
+}
+
+func (k Keeper) TemplateRateLimits(c context.Context, req *types.QueryTemplateRateLimitsRequest) (*types.QueryTemplateRateLimitsResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetTemplateRateLimits(ctx)
+	if req.Template == "" {
+		return &types.QueryTemplateRateLimitsResponse{TemplateRateLimits: records}, nil
+	}
+
+	filtered := []types.TemplateRateLimit{}
+	for _, record := range records {
+		if record.Template == req.Template {
+			filtered = append(filtered, record)
+		}
+	}
+	return &types.QueryTemplateRateLimitsResponse{TemplateRateLimits: filtered}, nil
+}
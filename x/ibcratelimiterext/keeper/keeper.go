@@ -14,13 +14,24 @@ type Keeper struct {
 	cdc       codec.BinaryCodec
 	storeKey  storetypes.StoreKey
 	authority sdk.AccAddress
+
+	erc20Keeper     types.Erc20Keeper
+	rateLimitKeeper types.RateLimitKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority sdk.AccAddress) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority sdk.AccAddress, erc20Keeper types.Erc20Keeper) Keeper {
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
 		panic(err) //nolint:halt // Constructor guard: ibcratelimiter authority wiring must be valid at boot.
 	}
-	return Keeper{cdc: cdc, storeKey: storeKey, authority: authority}
+	return Keeper{cdc: cdc, storeKey: storeKey, authority: authority, erc20Keeper: erc20Keeper}
+}
+
+// WithRateLimitKeeper sets the rate-limiting keeper used to apply templates.
+// The rate-limiting keeper is created after this keeper, together with the
+// transfer middleware stack.
+func (k *Keeper) WithRateLimitKeeper(rateLimitKeeper types.RateLimitKeeper) *Keeper {
+	k.rateLimitKeeper = rateLimitKeeper
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
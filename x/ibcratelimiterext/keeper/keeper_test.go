@@ -35,7 +35,7 @@ func setupKeeper(t *testing.T) (Keeper, sdk.Context) {
 	cdc := codec.NewProtoCodec(ir)
 	authority := sdk.AccAddress([]byte("authority_addr_12345"))
 
-	k := NewKeeper(cdc, storeKey, authority)
+	k := NewKeeper(cdc, storeKey, authority, nil)
 	return k, ctx
 }
 
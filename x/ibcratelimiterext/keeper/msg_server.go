@@ -22,19 +22,20 @@ type rateLimitKeeper interface {
 	ResetRateLimit(ctx sdk.Context, denom, channelID string) error
 }
 
-type whitelistKeeper interface {
+type extKeeper interface {
 	IsWhitelisted(ctx sdk.Context, addr sdk.AccAddress) bool
+	DeleteTemplateRateLimit(ctx sdk.Context, channelOrClientID, denom string)
 }
 
 type msgServer struct {
 	rateLimitKeeper rateLimitKeeper
-	whitelistKeeper whitelistKeeper
+	extKeeper       extKeeper
 }
 
-func NewRateLimitMsgServer(rateLimitKeeper rateLimitKeeper, whitelistKeeper whitelistKeeper) ratelimittypes.MsgServer {
+func NewRateLimitMsgServer(rateLimitKeeper rateLimitKeeper, extKeeper extKeeper) ratelimittypes.MsgServer {
 	return &msgServer{
 		rateLimitKeeper: rateLimitKeeper,
-		whitelistKeeper: whitelistKeeper,
+		extKeeper:       extKeeper,
 	}
 }
 
@@ -48,7 +49,7 @@ func (m msgServer) isAuthorized(ctx sdk.Context, signer string) error {
 		return nil
 	}
 
-	if m.whitelistKeeper.IsWhitelisted(ctx, addr) {
+	if m.extKeeper.IsWhitelisted(ctx, addr) {
 		return nil
 	}
 
@@ -77,6 +78,8 @@ func (m msgServer) UpdateRateLimit(goCtx context.Context, msg *ratelimittypes.Ms
 	if err := m.rateLimitKeeper.UpdateRateLimit(ctx, msg); err != nil {
 		return nil, err
 	}
+	// A manual update detaches the rate limit from the template it was set from.
+	m.extKeeper.DeleteTemplateRateLimit(ctx, msg.ChannelOrClientId, msg.Denom)
 
 	return &ratelimittypes.MsgUpdateRateLimitResponse{}, nil
 }
@@ -93,6 +96,7 @@ func (m msgServer) RemoveRateLimit(goCtx context.Context, msg *ratelimittypes.Ms
 	}
 
 	m.rateLimitKeeper.RemoveRateLimit(ctx, msg.Denom, msg.ChannelOrClientId)
+	m.extKeeper.DeleteTemplateRateLimit(ctx, msg.ChannelOrClientId, msg.Denom)
 	return &ratelimittypes.MsgRemoveRateLimitResponse{}, nil
 }
 
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/evm/x/ibcratelimiterext/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (m paramsMsgServer) SetRateLimitTemplate(goCtx context.Context, req *types.MsgSetRateLimitTemplate) (*types.MsgSetRateLimitTemplateResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if m.authority.String() != req.Authority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid authority")
+	}
+
+	if err := req.Template.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.SetTemplate(ctx, req.Template)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetTemplate,
+			sdk.NewAttribute(types.AttributeKeyTemplate, req.Template.Name),
+		),
+	)
+
+	return &types.MsgSetRateLimitTemplateResponse{}, nil
+}
+
+// RemoveRateLimitTemplate deletes the template only. Rate limits already set
+// from it are kept, as are their origin records.
+func (m paramsMsgServer) RemoveRateLimitTemplate(goCtx context.Context, req *types.MsgRemoveRateLimitTemplate) (*types.MsgRemoveRateLimitTemplateResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if m.authority.String() != req.Authority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid authority")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := m.GetTemplate(ctx, req.Name); !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "rate limit template %s", req.Name)
+	}
+	m.DeleteTemplate(ctx, req.Name)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveTemplate,
+			sdk.NewAttribute(types.AttributeKeyTemplate, req.Name),
+		),
+	)
+
+	return &types.MsgRemoveRateLimitTemplateResponse{}, nil
+}
+
+func (m paramsMsgServer) ApplyRateLimitTemplate(goCtx context.Context, req *types.MsgApplyRateLimitTemplate) (*types.MsgApplyRateLimitTemplateResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer := sdk.MustAccAddressFromBech32(req.Signer)
+	if m.authority.String() != req.Signer && !m.IsWhitelisted(ctx, signer) {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "unauthorized signer; expected authority or whitelisted address, got %s", req.Signer)
+	}
+
+	applied, skipped, err := m.ApplyTemplate(ctx, req.Template, req.ChannelOrClientId, req.Denoms, req.AllTokenPairs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApplyTemplate,
+			sdk.NewAttribute(types.AttributeKeyTemplate, req.Template),
+			sdk.NewAttribute(types.AttributeKeyChannelOrClientID, req.ChannelOrClientId),
+			sdk.NewAttribute(types.AttributeKeySigner, req.Signer),
+			sdk.NewAttribute(types.AttributeKeyApplied, strings.Join(applied, ",")),
+			sdk.NewAttribute(types.AttributeKeySkipped, strings.Join(skipped, ",")),
+		),
+	)
+
+	return &types.MsgApplyRateLimitTemplateResponse{Applied: applied, Skipped: skipped}, nil
+}
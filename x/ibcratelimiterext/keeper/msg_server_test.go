@@ -60,14 +60,19 @@ func (f *fakeRateLimitKeeper) ResetRateLimit(_ sdk.Context, _, _ string) error {
 	return f.resetErr
 }
 
-type fakeWhitelistKeeper struct {
+type fakeExtKeeper struct {
 	whitelisted map[string]bool
+	detached    []string
 }
 
-func (f *fakeWhitelistKeeper) IsWhitelisted(_ sdk.Context, addr sdk.AccAddress) bool {
+func (f *fakeExtKeeper) IsWhitelisted(_ sdk.Context, addr sdk.AccAddress) bool {
 	return f.whitelisted[addr.String()]
 }
 
+func (f *fakeExtKeeper) DeleteTemplateRateLimit(_ sdk.Context, channelOrClientID, denom string) {
+	f.detached = append(f.detached, channelOrClientID+"/"+denom)
+}
+
 func testContext() sdk.Context {
 	return sdk.Context{}.WithContext(context.Background())
 }
@@ -81,7 +86,7 @@ func TestAddRateLimit_AuthorityAllowed(t *testing.T) {
 	setBech32Prefix()
 	authority := sdk.AccAddress([]byte("authority_address_123")).String()
 	rlk := &fakeRateLimitKeeper{authority: authority}
-	wlk := &fakeWhitelistKeeper{whitelisted: map[string]bool{}}
+	wlk := &fakeExtKeeper{whitelisted: map[string]bool{}}
 	srv := NewRateLimitMsgServer(rlk, wlk)
 
 	resp, err := srv.AddRateLimit(
@@ -105,7 +110,7 @@ func TestAddRateLimit_AuthorityAllowedWithCanonicalizedCase(t *testing.T) {
 	setBech32Prefix()
 	authority := sdk.AccAddress([]byte("authority_address_123")).String()
 	rlk := &fakeRateLimitKeeper{authority: authority}
-	wlk := &fakeWhitelistKeeper{whitelisted: map[string]bool{}}
+	wlk := &fakeExtKeeper{whitelisted: map[string]bool{}}
 	srv := NewRateLimitMsgServer(rlk, wlk)
 
 	resp, err := srv.AddRateLimit(
@@ -130,7 +135,7 @@ func TestAddRateLimit_WhitelistedAllowed(t *testing.T) {
 	authority := sdk.AccAddress([]byte("authority_address_123")).String()
 	operator := sdk.AccAddress([]byte("whitelisted_operator")).String()
 	rlk := &fakeRateLimitKeeper{authority: authority}
-	wlk := &fakeWhitelistKeeper{whitelisted: map[string]bool{operator: true}}
+	wlk := &fakeExtKeeper{whitelisted: map[string]bool{operator: true}}
 	srv := NewRateLimitMsgServer(rlk, wlk)
 
 	resp, err := srv.AddRateLimit(
@@ -155,7 +160,7 @@ func TestAddRateLimit_UnauthorizedDenied(t *testing.T) {
 	authority := sdk.AccAddress([]byte("authority_address_123")).String()
 	unauthorized := sdk.AccAddress([]byte("unauthorized_operator")).String()
 	rlk := &fakeRateLimitKeeper{authority: authority}
-	wlk := &fakeWhitelistKeeper{whitelisted: map[string]bool{}}
+	wlk := &fakeExtKeeper{whitelisted: map[string]bool{}}
 	srv := NewRateLimitMsgServer(rlk, wlk)
 
 	resp, err := srv.AddRateLimit(
@@ -179,7 +184,7 @@ func TestRemoveRateLimit_NotFound(t *testing.T) {
 	setBech32Prefix()
 	authority := sdk.AccAddress([]byte("authority_address_123")).String()
 	rlk := &fakeRateLimitKeeper{authority: authority, found: false}
-	wlk := &fakeWhitelistKeeper{whitelisted: map[string]bool{}}
+	wlk := &fakeExtKeeper{whitelisted: map[string]bool{}}
 	srv := NewRateLimitMsgServer(rlk, wlk)
 
 	resp, err := srv.RemoveRateLimit(
@@ -201,7 +206,7 @@ func TestResetRateLimit_WhitelistedAllowed(t *testing.T) {
 	authority := sdk.AccAddress([]byte("authority_address_123")).String()
 	operator := sdk.AccAddress([]byte("whitelisted_operator")).String()
 	rlk := &fakeRateLimitKeeper{authority: authority}
-	wlk := &fakeWhitelistKeeper{whitelisted: map[string]bool{operator: true}}
+	wlk := &fakeExtKeeper{whitelisted: map[string]bool{operator: true}}
 	srv := NewRateLimitMsgServer(rlk, wlk)
 
 	resp, err := srv.ResetRateLimit(
@@ -217,3 +222,48 @@ func TestResetRateLimit_WhitelistedAllowed(t *testing.T) {
 	require.NotNil(t, resp)
 	require.True(t, rlk.resetCalled)
 }
+
+func TestRemoveRateLimit_DetachesTemplate(t *testing.T) {
+	setBech32Prefix()
+	authority := sdk.AccAddress([]byte("authority_address_123")).String()
+	rlk := &fakeRateLimitKeeper{authority: authority, found: true}
+	wlk := &fakeExtKeeper{whitelisted: map[string]bool{}}
+	srv := NewRateLimitMsgServer(rlk, wlk)
+
+	_, err := srv.RemoveRateLimit(
+		testContext(),
+		&ratelimittypes.MsgRemoveRateLimit{
+			Authority:         authority,
+			Denom:             "ibc/test",
+			ChannelOrClientId: "channel-0",
+		},
+	)
+
+	require.NoError(t, err)
+	require.True(t, rlk.removeCalled)
+	require.Equal(t, []string{"channel-0/ibc/test"}, wlk.detached)
+}
+
+func TestUpdateRateLimit_DetachesTemplate(t *testing.T) {
+	setBech32Prefix()
+	authority := sdk.AccAddress([]byte("authority_address_123")).String()
+	rlk := &fakeRateLimitKeeper{authority: authority, found: true}
+	wlk := &fakeExtKeeper{whitelisted: map[string]bool{}}
+	srv := NewRateLimitMsgServer(rlk, wlk)
+
+	_, err := srv.UpdateRateLimit(
+		testContext(),
+		&ratelimittypes.MsgUpdateRateLimit{
+			Authority:         authority,
+			Denom:             "ibc/test",
+			ChannelOrClientId: "channel-0",
+			MaxPercentSend:    sdkmath.NewInt(20),
+			MaxPercentRecv:    sdkmath.NewInt(20),
+			DurationHours:     24,
+		},
+	)
+
+	require.NoError(t, err)
+	require.True(t, rlk.updateCalled)
+	require.Equal(t, []string{"channel-0/ibc/test"}, wlk.detached)
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/evm/x/ibcratelimiterext/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+)
+
+func (k Keeper) GetTemplate(ctx sdk.Context, name string) (types.RateLimitTemplate, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TemplateKey(name))
+	if bz == nil {
+		return types.RateLimitTemplate{}, false
+	}
+
+	var template types.RateLimitTemplate
+	k.cdc.MustUnmarshal(bz, &template)
+	return template, true
+}
+
+func (k Keeper) SetTemplate(ctx sdk.Context, template types.RateLimitTemplate) {
+	ctx.KVStore(k.storeKey).Set(types.TemplateKey(template.Name), k.cdc.MustMarshal(&template))
+}
+
+func (k Keeper) DeleteTemplate(ctx sdk.Context, name string) {
+	ctx.KVStore(k.storeKey).Delete(types.TemplateKey(name))
+}
+
+// GetTemplates returns every template ordered by name.
+func (k Keeper) GetTemplates(ctx sdk.Context) []types.RateLimitTemplate {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixTemplate)
+	defer iterator.Close()
+
+	templates := []types.RateLimitTemplate{}
+	for ; iterator.Valid(); iterator.Next() {
+		var template types.RateLimitTemplate
+		k.cdc.MustUnmarshal(iterator.Value(), &template)
+		templates = append(templates, template)
+	}
+	return templates
+}
+
+func (k Keeper) GetTemplateRateLimit(ctx sdk.Context, channelOrClientID, denom string) (types.TemplateRateLimit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TemplateRateLimitKey(channelOrClientID, denom))
+	if bz == nil {
+		return types.TemplateRateLimit{}, false
+	}
+
+	var record types.TemplateRateLimit
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+func (k Keeper) SetTemplateRateLimit(ctx sdk.Context, record types.TemplateRateLimit) {
+	key := types.TemplateRateLimitKey(record.ChannelOrClientId, record.Denom)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&record))
+}
+
+// DeleteTemplateRateLimit forgets the template a rate limit was set from. It
+// is called whenever the rate limit is changed or removed by other means.
+func (k Keeper) DeleteTemplateRateLimit(ctx sdk.Context, channelOrClientID, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.TemplateRateLimitKey(channelOrClientID, denom))
+}
+
+// GetTemplateRateLimits returns the template origin of every rate limit that
+// was set from a template, ordered by channel or client id and denom.
+func (k Keeper) GetTemplateRateLimits(ctx sdk.Context) []types.TemplateRateLimit {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixTemplateRateLimit)
+	defer iterator.Close()
+
+	records := []types.TemplateRateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TemplateRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// ApplyTemplate adds or updates the rate limit of every denom on the channel
+// or client with the quota of the named template. When allTokenPairs is set
+// the denoms of all token pairs registered in x/erc20 are used instead, and
+// the ones without supply are skipped since a rate limit cannot be created
+// for them. Either every rate limit is written or none is.
+func (k Keeper) ApplyTemplate(
+	ctx sdk.Context,
+	name, channelOrClientID string,
+	denoms []string,
+	allTokenPairs bool,
+) (applied, skipped []string, err error) {
+	if k.rateLimitKeeper == nil {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rate limiting is not enabled")
+	}
+
+	template, found := k.GetTemplate(ctx, name)
+	if !found {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "rate limit template %s", name)
+	}
+
+	if allTokenPairs {
+		denoms, skipped = k.tokenPairDenoms(ctx)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	for _, denom := range denoms {
+		if err := k.applyTemplate(cacheCtx, template, channelOrClientID, denom); err != nil {
+			return nil, nil, errorsmod.Wrapf(err, "failed to apply template %s to %s on %s", name, denom, channelOrClientID)
+		}
+		applied = append(applied, denom)
+	}
+	writeFn()
+
+	return applied, skipped, nil
+}
+
+func (k Keeper) applyTemplate(ctx sdk.Context, template types.RateLimitTemplate, channelOrClientID, denom string) error {
+	if _, found := k.rateLimitKeeper.GetRateLimit(ctx, denom, channelOrClientID); found {
+		err := k.rateLimitKeeper.UpdateRateLimit(ctx, &ratelimittypes.MsgUpdateRateLimit{
+			Authority:         k.authority.String(),
+			Denom:             denom,
+			ChannelOrClientId: channelOrClientID,
+			MaxPercentSend:    template.MaxPercentSend,
+			MaxPercentRecv:    template.MaxPercentRecv,
+			DurationHours:     template.DurationHours,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		err := k.rateLimitKeeper.AddRateLimit(ctx, &ratelimittypes.MsgAddRateLimit{
+			Authority:         k.authority.String(),
+			Denom:             denom,
+			ChannelOrClientId: channelOrClientID,
+			MaxPercentSend:    template.MaxPercentSend,
+			MaxPercentRecv:    template.MaxPercentRecv,
+			DurationHours:     template.DurationHours,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetTemplateRateLimit(ctx, types.TemplateRateLimit{
+		Template:          template.Name,
+		ChannelOrClientId: channelOrClientID,
+		Denom:             denom,
+	})
+	return nil
+}
+
+// tokenPairDenoms returns the distinct denoms of the registered token pairs,
+// split by whether they currently have supply.
+func (k Keeper) tokenPairDenoms(ctx sdk.Context) (withSupply, withoutSupply []string) {
+	seen := make(map[string]struct{})
+	for _, pair := range k.erc20Keeper.GetTokenPairs(ctx) {
+		if _, ok := seen[pair.Denom]; ok {
+			continue
+		}
+		seen[pair.Denom] = struct{}{}
+
+		if k.rateLimitKeeper.GetChannelValue(ctx, pair.Denom).IsZero() {
+			withoutSupply = append(withoutSupply, pair.Denom)
+			continue
+		}
+		withSupply = append(withSupply, pair.Denom)
+	}
+	return withSupply, withoutSupply
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/ibcratelimiterext/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+)
+
+// fakeQuotaKeeper keeps rate limit quotas in memory, keyed by
+// channel and denom.
+type fakeQuotaKeeper struct {
+	supply map[string]int64
+	quotas map[string]ratelimittypes.Quota
+}
+
+func newFakeQuotaKeeper(supply map[string]int64) *fakeQuotaKeeper {
+	return &fakeQuotaKeeper{supply: supply, quotas: map[string]ratelimittypes.Quota{}}
+}
+
+func (f *fakeQuotaKeeper) AddRateLimit(_ sdk.Context, msg *ratelimittypes.MsgAddRateLimit) error {
+	if f.supply[msg.Denom] == 0 {
+		return ratelimittypes.ErrZeroChannelValue
+	}
+	key := msg.ChannelOrClientId + "/" + msg.Denom
+	if _, ok := f.quotas[key]; ok {
+		return ratelimittypes.ErrRateLimitAlreadyExists
+	}
+	f.quotas[key] = ratelimittypes.Quota{MaxPercentSend: msg.MaxPercentSend, MaxPercentRecv: msg.MaxPercentRecv, DurationHours: msg.DurationHours}
+	return nil
+}
+
+func (f *fakeQuotaKeeper) UpdateRateLimit(_ sdk.Context, msg *ratelimittypes.MsgUpdateRateLimit) error {
+	key := msg.ChannelOrClientId + "/" + msg.Denom
+	if _, ok := f.quotas[key]; !ok {
+		return ratelimittypes.ErrRateLimitNotFound
+	}
+	f.quotas[key] = ratelimittypes.Quota{MaxPercentSend: msg.MaxPercentSend, MaxPercentRecv: msg.MaxPercentRecv, DurationHours: msg.DurationHours}
+	return nil
+}
+
+func (f *fakeQuotaKeeper) GetRateLimit(_ sdk.Context, denom, channelID string) (ratelimittypes.RateLimit, bool) {
+	quota, ok := f.quotas[channelID+"/"+denom]
+	if !ok {
+		return ratelimittypes.RateLimit{}, false
+	}
+	return ratelimittypes.RateLimit{Quota: &quota}, true
+}
+
+func (f *fakeQuotaKeeper) GetChannelValue(_ sdk.Context, denom string) sdkmath.Int {
+	return sdkmath.NewInt(f.supply[denom])
+}
+
+type fakeErc20Keeper struct {
+	pairs []erc20types.TokenPair
+}
+
+func (f fakeErc20Keeper) GetTokenPairs(sdk.Context) []erc20types.TokenPair {
+	return f.pairs
+}
+
+func testTemplate(name string, percent int64) types.RateLimitTemplate {
+	return types.RateLimitTemplate{
+		Name:           name,
+		MaxPercentSend: sdkmath.NewInt(percent),
+		MaxPercentRecv: sdkmath.NewInt(percent),
+		DurationHours:  24,
+	}
+}
+
+func TestSetAndRemoveTemplate_AuthorityOnly(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	template := testTemplate("default", 10)
+
+	_, err := srv.SetRateLimitTemplate(ctx, &types.MsgSetRateLimitTemplate{
+		Authority: sdk.AccAddress([]byte("bad_authority")).String(),
+		Template:  template,
+	})
+	require.Error(t, err)
+
+	_, err = srv.SetRateLimitTemplate(ctx, &types.MsgSetRateLimitTemplate{
+		Authority: k.authority.String(),
+		Template:  template,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.RateLimitTemplate{template}, k.GetTemplates(ctx))
+
+	_, err = srv.RemoveRateLimitTemplate(ctx, &types.MsgRemoveRateLimitTemplate{
+		Authority: sdk.AccAddress([]byte("bad_authority")).String(),
+		Name:      template.Name,
+	})
+	require.Error(t, err)
+
+	_, err = srv.RemoveRateLimitTemplate(ctx, &types.MsgRemoveRateLimitTemplate{
+		Authority: k.authority.String(),
+		Name:      template.Name,
+	})
+	require.NoError(t, err)
+	require.Empty(t, k.GetTemplates(ctx))
+
+	_, err = srv.RemoveRateLimitTemplate(ctx, &types.MsgRemoveRateLimitTemplate{
+		Authority: k.authority.String(),
+		Name:      template.Name,
+	})
+	require.Error(t, err)
+}
+
+func TestApplyRateLimitTemplate(t *testing.T) {
+	operator := sdk.AccAddress([]byte("whitelisted_operator"))
+	pairs := []erc20types.TokenPair{{Denom: "uatom"}, {Denom: "uosmo"}, {Denom: "unused"}}
+
+	testCases := []struct {
+		name       string
+		signer     func(k Keeper) string
+		denoms     []string
+		allPairs   bool
+		existing   []string
+		expApplied []string
+		expSkipped []string
+		expErr     string
+	}{
+		{
+			name:       "authority adds limits for listed denoms",
+			signer:     func(k Keeper) string { return k.authority.String() },
+			denoms:     []string{"uatom", "uosmo"},
+			expApplied: []string{"uatom", "uosmo"},
+		},
+		{
+			name:       "whitelisted operator updates an existing limit",
+			signer:     func(Keeper) string { return operator.String() },
+			denoms:     []string{"uatom", "uosmo"},
+			existing:   []string{"uatom"},
+			expApplied: []string{"uatom", "uosmo"},
+		},
+		{
+			name:       "all token pairs skips denoms without supply",
+			signer:     func(Keeper) string { return operator.String() },
+			allPairs:   true,
+			expApplied: []string{"uatom", "uosmo"},
+			expSkipped: []string{"unused"},
+		},
+		{
+			name:   "listed denom without supply fails the whole batch",
+			signer: func(Keeper) string { return operator.String() },
+			denoms: []string{"uatom", "unused"},
+			expErr: ratelimittypes.ErrZeroChannelValue.Error(),
+		},
+		{
+			name:   "unknown signer is rejected",
+			signer: func(Keeper) string { return sdk.AccAddress([]byte("unknown_operator")).String() },
+			denoms: []string{"uatom"},
+			expErr: "unauthorized signer",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := setupKeeper(t)
+			rlk := newFakeQuotaKeeper(map[string]int64{"uatom": 100, "uosmo": 100})
+			k.erc20Keeper = fakeErc20Keeper{pairs: pairs}
+			k.WithRateLimitKeeper(rlk)
+			k.SetParams(ctx, types.Params{Whitelist: []string{operator.String()}})
+			k.SetTemplate(ctx, testTemplate("default", 10))
+			for _, denom := range tc.existing {
+				rlk.quotas["channel-0/"+denom] = ratelimittypes.Quota{MaxPercentSend: sdkmath.NewInt(50), MaxPercentRecv: sdkmath.NewInt(50), DurationHours: 1}
+			}
+
+			res, err := NewMsgServerImpl(k).ApplyRateLimitTemplate(ctx, &types.MsgApplyRateLimitTemplate{
+				Signer:            tc.signer(k),
+				Template:          "default",
+				ChannelOrClientId: "channel-0",
+				Denoms:            tc.denoms,
+				AllTokenPairs:     tc.allPairs,
+			})
+
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				require.Empty(t, k.GetTemplateRateLimits(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expApplied, res.Applied)
+			require.Equal(t, tc.expSkipped, res.Skipped)
+
+			records := k.GetTemplateRateLimits(ctx)
+			require.Len(t, records, len(tc.expApplied))
+			for i, denom := range tc.expApplied {
+				require.Equal(t, types.TemplateRateLimit{Template: "default", ChannelOrClientId: "channel-0", Denom: denom}, records[i])
+				quota := rlk.quotas["channel-0/"+denom]
+				require.Equal(t, sdkmath.NewInt(10), quota.MaxPercentSend)
+				require.Equal(t, uint64(24), quota.DurationHours)
+			}
+		})
+	}
+}
+
+func TestApplyRateLimitTemplate_UnknownTemplate(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.WithRateLimitKeeper(newFakeQuotaKeeper(map[string]int64{"uatom": 100}))
+
+	_, _, err := k.ApplyTemplate(ctx, "missing", "channel-0", []string{"uatom"}, false)
+	require.ErrorContains(t, err, "rate limit template missing")
+}
+
+func TestTemplateRateLimitsQuery(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetTemplateRateLimit(ctx, types.TemplateRateLimit{Template: "default", ChannelOrClientId: "channel-0", Denom: "uatom"})
+	k.SetTemplateRateLimit(ctx, types.TemplateRateLimit{Template: "strict", ChannelOrClientId: "channel-1", Denom: "uatom"})
+
+	res, err := k.TemplateRateLimits(ctx, &types.QueryTemplateRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.TemplateRateLimits, 2)
+
+	res, err = k.TemplateRateLimits(ctx, &types.QueryTemplateRateLimitsRequest{Template: "strict"})
+	require.NoError(t, err)
+	require.Equal(t, []types.TemplateRateLimit{{Template: "strict", ChannelOrClientId: "channel-1", Denom: "uatom"}}, res.TemplateRateLimits)
+
+	k.DeleteTemplateRateLimit(ctx, "channel-1", "uatom")
+	res, err = k.TemplateRateLimits(ctx, &types.QueryTemplateRateLimitsRequest{Template: "strict"})
+	require.NoError(t, err)
+	require.Empty(t, res.TemplateRateLimits)
+}
@@ -12,10 +12,18 @@ var (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "ibcratelimiterext/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimitTemplate{}, "cosmos/evm/x/ibcratelimiterext/MsgSetRateLimitTemplate", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimitTemplate{}, "cosmos/evm/x/ibcratelimiterext/MsgRemoveRateLimitTemplate", nil)
+	cdc.RegisterConcrete(&MsgApplyRateLimitTemplate{}, "cosmos/evm/x/ibcratelimiterext/MsgApplyRateLimitTemplate", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgSetRateLimitTemplate{},
+		&MsgRemoveRateLimitTemplate{},
+		&MsgApplyRateLimitTemplate{},
+	)
 }
 
 func init() {
@@ -0,0 +1,14 @@
+package types
+
+// ibcratelimiterext events
+const (
+	EventTypeSetTemplate    = "set_rate_limit_template"
+	EventTypeRemoveTemplate = "remove_rate_limit_template"
+	EventTypeApplyTemplate  = "apply_rate_limit_template"
+
+	AttributeKeyTemplate          = "template"
+	AttributeKeyChannelOrClientID = "channel_or_client_id"
+	AttributeKeySigner            = "signer"
+	AttributeKeyApplied           = "applied"
+	AttributeKeySkipped           = "skipped"
+)
@@ -1,9 +1,41 @@
 package types
 
+import "fmt"
+
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{Params: DefaultParams()}
+	return &GenesisState{
+		Params:             DefaultParams(),
+		Templates:          []RateLimitTemplate{},
+		TemplateRateLimits: []TemplateRateLimit{},
+	}
 }
 
 func (g GenesisState) Validate() error {
-	return g.Params.Validate()
+	if err := g.Params.Validate(); err != nil {
+		return err
+	}
+
+	templates := make(map[string]struct{}, len(g.Templates))
+	for _, template := range g.Templates {
+		if err := template.Validate(); err != nil {
+			return err
+		}
+		if _, ok := templates[template.Name]; ok {
+			return fmt.Errorf("duplicate template: %s", template.Name)
+		}
+		templates[template.Name] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(g.TemplateRateLimits))
+	for _, record := range g.TemplateRateLimits {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		key := string(TemplateRateLimitKey(record.ChannelOrClientId, record.Denom))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate template rate limit for %s on %s", record.Denom, record.ChannelOrClientId)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
 }
@@ -26,6 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// templates are the governance-managed rate limit templates.
+	Templates []RateLimitTemplate `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates"`
+	// template_rate_limits record which rate limits were set from a template.
+	TemplateRateLimits []TemplateRateLimit `protobuf:"bytes,3,rep,name=template_rate_limits,json=templateRateLimits,proto3" json:"template_rate_limits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +72,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTemplates() []RateLimitTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTemplateRateLimits() []TemplateRateLimit {
+	if m != nil {
+		return m.TemplateRateLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.ibcratelimiterext.v1.GenesisState")
 }
@@ -77,22 +95,26 @@ func init() {
 }
 
 var fileDescriptor_a4d1cc75dd03ea2d = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
+	// 294 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4d, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
 	0xcc, 0xcd, 0x2c, 0x49, 0x2d, 0x4a, 0xad, 0x28, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
 	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0x28, 0xd7, 0x4b, 0x2d,
 	0xcb, 0xd5, 0xc3, 0x50, 0xae, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
 	0x26, 0x21, 0x7a, 0xa4, 0xcc, 0x09, 0x59, 0x81, 0x69, 0x10, 0x44, 0xa3, 0x48, 0x7a, 0x7e, 0x7a,
-	0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xa2, 0xb8, 0x78, 0xdc, 0x21, 0x6e, 0x0a, 0x2e,
-	0x49, 0x2c, 0x49, 0x15, 0xf2, 0xe2, 0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd7, 0x23, 0xe0, 0x46, 0xbd, 0x00, 0xb0, 0x72, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0x35, 0xc1, 0xc9, 0xf3, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
-	0x92, 0xf3, 0x73, 0xf5, 0x91, 0xfc, 0x53, 0x81, 0xc5, 0x47, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0x60, 0xd7, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x1d, 0x7d, 0xb8, 0x61, 0x01,
-	0x00, 0x00,
+	0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xe6, 0x31, 0x71, 0xf1, 0xb8, 0x43, 0x1c, 0x15,
+	0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc5, 0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1,
+	0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xae, 0x47, 0xc0, 0x91, 0x7a, 0x01, 0x60, 0xe5, 0x4e, 0x9c,
+	0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0x6a, 0x82, 0x50, 0x18, 0x17,
+	0x67, 0x49, 0x6a, 0x6e, 0x41, 0x4e, 0x62, 0x49, 0x6a, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7,
+	0x91, 0x11, 0x41, 0xe3, 0x82, 0x12, 0x4b, 0x52, 0x7d, 0x40, 0x22, 0x21, 0x50, 0xad, 0x4e, 0x2c,
+	0x20, 0x93, 0x83, 0x10, 0x46, 0x09, 0x65, 0x71, 0x89, 0xc0, 0x38, 0xf1, 0x20, 0x03, 0xe2, 0xc1,
+	0x26, 0x14, 0x4b, 0x30, 0x13, 0x69, 0x05, 0xcc, 0x64, 0xb8, 0x55, 0x50, 0x2b, 0x84, 0x4a, 0xd0,
+	0x25, 0x8a, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
+	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f,
+	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x29, 0x52, 0x2a, 0xb0, 0x44,
+	0x4b, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xc8, 0x8d, 0x01, 0x03, 0x00, 0x68, 0x9c,
+	0x92, 0xab, 0x26, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +137,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TemplateRateLimits) > 0 {
+		for iNdEx := len(m.TemplateRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TemplateRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +197,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TemplateRateLimits) > 0 {
+		for _, e := range m.TemplateRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +280,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, RateLimitTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateRateLimits = append(m.TemplateRateLimits, TemplateRateLimit{})
+			if err := m.TemplateRateLimits[len(m.TemplateRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
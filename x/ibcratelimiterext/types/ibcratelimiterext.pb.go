@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -69,8 +71,135 @@ func (m *Params) GetWhitelist() []string {
 	return nil
 }
 
+// RateLimitTemplate is a named rate limit quota that operators can apply to a
+// channel or client for many denoms at once.
+type RateLimitTemplate struct {
+	// name uniquely identifies the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max_percent_send is the outflow threshold as a percentage of channel value.
+	MaxPercentSend cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_percent_send,json=maxPercentSend,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_send"`
+	// max_percent_recv is the inflow threshold as a percentage of channel value.
+	MaxPercentRecv cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_recv"`
+	// duration_hours is the length of the rate limit window.
+	DurationHours uint64 `protobuf:"varint,4,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+}
+
+func (m *RateLimitTemplate) Reset()         { *m = RateLimitTemplate{} }
+func (m *RateLimitTemplate) String() string { return proto.CompactTextString(m) }
+func (*RateLimitTemplate) ProtoMessage()    {}
+func (*RateLimitTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6fa4b394e9aaf1d, []int{1}
+}
+func (m *RateLimitTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitTemplate.Merge(m, src)
+}
+func (m *RateLimitTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitTemplate proto.InternalMessageInfo
+
+func (m *RateLimitTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RateLimitTemplate) GetDurationHours() uint64 {
+	if m != nil {
+		return m.DurationHours
+	}
+	return 0
+}
+
+// TemplateRateLimit records that the rate limit for a (channel, denom) pair
+// was last set by applying a template.
+type TemplateRateLimit struct {
+	// template is the name of the applied template.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// channel_or_client_id is the channel or client the limit applies to.
+	ChannelOrClientId string `protobuf:"bytes,2,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	// denom is the rate limited denom.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *TemplateRateLimit) Reset()         { *m = TemplateRateLimit{} }
+func (m *TemplateRateLimit) String() string { return proto.CompactTextString(m) }
+func (*TemplateRateLimit) ProtoMessage()    {}
+func (*TemplateRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6fa4b394e9aaf1d, []int{2}
+}
+func (m *TemplateRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TemplateRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TemplateRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateRateLimit.Merge(m, src)
+}
+func (m *TemplateRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateRateLimit proto.InternalMessageInfo
+
+func (m *TemplateRateLimit) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *TemplateRateLimit) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+func (m *TemplateRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.evm.ibcratelimiterext.v1.Params")
+	proto.RegisterType((*RateLimitTemplate)(nil), "cosmos.evm.ibcratelimiterext.v1.RateLimitTemplate")
+	proto.RegisterType((*TemplateRateLimit)(nil), "cosmos.evm.ibcratelimiterext.v1.TemplateRateLimit")
 }
 
 func init() {
@@ -78,19 +207,32 @@ func init() {
 }
 
 var fileDescriptor_f6fa4b394e9aaf1d = []byte{
-	// 177 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0x49, 0x2d, 0x4a, 0xad, 0x28, 0xd1, 0x2f, 0x33, 0xc4, 0x14, 0xd4, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0x68, 0xd4, 0x4b, 0x2d, 0xcb, 0xd5, 0xc3, 0x54, 0x53, 0x66,
-	0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xab, 0x0f, 0x62, 0x41, 0xb4, 0x29, 0xa9, 0x71,
-	0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0xc9, 0x70, 0x71, 0x96, 0x67, 0x64, 0x82, 0x74,
-	0x15, 0x97, 0x48, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x21, 0x04, 0x9c, 0x3c, 0x4f, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0x1f, 0xc9, 0xf1, 0x15, 0x58, 0x9c, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
-	0x06, 0xb6, 0xd9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x7c, 0xfc, 0xe2, 0xeb, 0x00, 0x00,
-	0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x41, 0x8b, 0xd4, 0x30,
+	0x14, 0xc7, 0x1b, 0x77, 0x5c, 0x9c, 0x80, 0x8b, 0x13, 0x46, 0xa8, 0x83, 0x74, 0x86, 0x01, 0x65,
+	0x40, 0x6c, 0x58, 0x3c, 0x78, 0x5f, 0x2f, 0x16, 0x04, 0x97, 0xa8, 0x17, 0x2f, 0x25, 0x93, 0x3e,
+	0xa6, 0xc1, 0x26, 0x29, 0x49, 0xa6, 0xd6, 0x6f, 0xe1, 0x87, 0xf1, 0x43, 0xec, 0x71, 0xf1, 0x24,
+	0x1e, 0x16, 0x99, 0xf9, 0x1a, 0x1e, 0xa4, 0x4d, 0x77, 0x15, 0xc7, 0x9b, 0xb7, 0xf7, 0x7e, 0xef,
+	0xdf, 0x7f, 0xdf, 0x7b, 0x79, 0xf8, 0xb9, 0x30, 0x4e, 0x19, 0x47, 0xa1, 0x51, 0x54, 0xae, 0x85,
+	0xe5, 0x1e, 0x2a, 0xa9, 0xa4, 0x07, 0x0b, 0xad, 0xa7, 0xcd, 0xe9, 0x21, 0x4c, 0x6b, 0x6b, 0xbc,
+	0x21, 0xf3, 0xf0, 0x61, 0x0a, 0x8d, 0x4a, 0x0f, 0x35, 0xcd, 0xe9, 0xec, 0x41, 0x10, 0xe4, 0xbd,
+	0x9c, 0x0e, 0xea, 0x3e, 0x99, 0x4d, 0x37, 0x66, 0x63, 0x02, 0xef, 0xa2, 0x40, 0x97, 0x8f, 0xf1,
+	0xf1, 0x39, 0xb7, 0x5c, 0x39, 0xf2, 0x10, 0x8f, 0x3f, 0x96, 0xb2, 0x33, 0x74, 0x3e, 0x46, 0x8b,
+	0xa3, 0xd5, 0x98, 0xfd, 0x06, 0xcb, 0x9f, 0x08, 0x4f, 0x18, 0xf7, 0xf0, 0xaa, 0xfb, 0xdd, 0x5b,
+	0x50, 0x75, 0xc5, 0x3d, 0x10, 0x82, 0x47, 0x9a, 0x2b, 0x88, 0xd1, 0x02, 0xad, 0xc6, 0xac, 0x8f,
+	0xc9, 0x3b, 0x7c, 0x4f, 0xf1, 0x36, 0xaf, 0xc1, 0x0a, 0xd0, 0x3e, 0x77, 0xa0, 0x8b, 0xf8, 0x56,
+	0x57, 0x3f, 0x7b, 0x72, 0x71, 0x35, 0x8f, 0xbe, 0x5f, 0xcd, 0xef, 0x87, 0xbe, 0x5c, 0xf1, 0x21,
+	0x95, 0x86, 0x2a, 0xee, 0xcb, 0x34, 0xd3, 0xfe, 0xeb, 0x97, 0xa7, 0x78, 0x68, 0x38, 0xd3, 0x9e,
+	0x9d, 0x28, 0xde, 0x9e, 0x07, 0x8f, 0x37, 0xa0, 0x8b, 0xbf, 0x6d, 0x2d, 0x88, 0x26, 0x3e, 0xfa,
+	0x2f, 0x5b, 0x06, 0xa2, 0x21, 0x8f, 0xf0, 0x49, 0xb1, 0xb5, 0xdc, 0x4b, 0xa3, 0xf3, 0xd2, 0x6c,
+	0xad, 0x8b, 0x47, 0x0b, 0xb4, 0x1a, 0xb1, 0xbb, 0xd7, 0xf4, 0x65, 0x07, 0x97, 0x0d, 0x9e, 0x5c,
+	0x0f, 0x7d, 0xb3, 0x05, 0x32, 0xc3, 0x77, 0xfc, 0x00, 0x87, 0x0d, 0xdc, 0xe4, 0x84, 0xe2, 0xa9,
+	0x28, 0xb9, 0xd6, 0x50, 0xe5, 0xc6, 0xe6, 0xa2, 0x92, 0x5d, 0xd3, 0x72, 0xd8, 0x04, 0x9b, 0x0c,
+	0xb5, 0xd7, 0xf6, 0x45, 0x5f, 0xc9, 0x0a, 0x32, 0xc5, 0xb7, 0x0b, 0xd0, 0x46, 0x85, 0xa1, 0x58,
+	0x48, 0xce, 0xb2, 0x8b, 0x5d, 0x82, 0x2e, 0x77, 0x09, 0xfa, 0xb1, 0x4b, 0xd0, 0xe7, 0x7d, 0x12,
+	0x5d, 0xee, 0x93, 0xe8, 0xdb, 0x3e, 0x89, 0xde, 0xd3, 0x8d, 0xf4, 0xe5, 0x76, 0x9d, 0x0a, 0xa3,
+	0xe8, 0x1f, 0xe7, 0xd4, 0xfe, 0xe3, 0xa0, 0xfc, 0xa7, 0x1a, 0xdc, 0xfa, 0xb8, 0x7f, 0xf0, 0x67,
+	0xbf, 0x06, 0x00, 0x5d, 0xbe, 0x8b, 0xd2, 0x7d, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +267,105 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimitTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DurationHours != 0 {
+		i = encodeVarintIbcratelimiterext(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIbcratelimiterext(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIbcratelimiterext(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintIbcratelimiterext(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIbcratelimiterext(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintIbcratelimiterext(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintIbcratelimiterext(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIbcratelimiterext(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIbcratelimiterext(v)
 	base := offset
@@ -151,6 +392,47 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *RateLimitTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovIbcratelimiterext(uint64(l))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovIbcratelimiterext(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovIbcratelimiterext(uint64(l))
+	if m.DurationHours != 0 {
+		n += 1 + sovIbcratelimiterext(uint64(m.DurationHours))
+	}
+	return n
+}
+
+func (m *TemplateRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovIbcratelimiterext(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovIbcratelimiterext(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIbcratelimiterext(uint64(l))
+	}
+	return n
+}
+
 func sovIbcratelimiterext(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -239,6 +521,321 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimitTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcratelimiterext
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcratelimiterext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcratelimiterext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcratelimiterext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcratelimiterext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcratelimiterext(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcratelimiterext
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcratelimiterext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcratelimiterext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcratelimiterext
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcratelimiterext(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcratelimiterext
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIbcratelimiterext(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
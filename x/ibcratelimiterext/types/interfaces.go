@@ -0,0 +1,25 @@
+package types
+
+import (
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+)
+
+// RateLimitKeeper defines the rate-limiting keeper methods used to apply
+// templates.
+type RateLimitKeeper interface {
+	AddRateLimit(ctx sdk.Context, msg *ratelimittypes.MsgAddRateLimit) error
+	UpdateRateLimit(ctx sdk.Context, msg *ratelimittypes.MsgUpdateRateLimit) error
+	GetRateLimit(ctx sdk.Context, denom, channelID string) (ratelimittypes.RateLimit, bool)
+	GetChannelValue(ctx sdk.Context, denom string) sdkmath.Int
+}
+
+// Erc20Keeper defines the erc20 keeper methods used to resolve the registered
+// token pairs.
+type Erc20Keeper interface {
+	GetTokenPairs(ctx sdk.Context) []erc20types.TokenPair
+}
@@ -1,5 +1,7 @@
 package types
 
+import "github.com/cosmos/cosmos-sdk/types/address"
+
 const (
 	ModuleName = "ibcratelimiterext"
 	// StoreKey must not share a prefix with other KV stores. In particular,
@@ -10,8 +12,24 @@ const (
 
 const (
 	prefixParams = iota + 1
+	prefixTemplate
+	prefixTemplateRateLimit
 )
 
 var (
 	KeyParams = []byte{prefixParams}
+
+	KeyPrefixTemplate          = []byte{prefixTemplate}
+	KeyPrefixTemplateRateLimit = []byte{prefixTemplateRateLimit}
 )
+
+func TemplateKey(name string) []byte {
+	return append(append([]byte{}, KeyPrefixTemplate...), []byte(name)...)
+}
+
+// TemplateRateLimitKey length-prefixes the channel or client id so that ids
+// and denoms can never be confused.
+func TemplateRateLimitKey(channelOrClientID, denom string) []byte {
+	key := append(append([]byte{}, KeyPrefixTemplateRateLimit...), address.MustLengthPrefix([]byte(channelOrClientID))...)
+	return append(key, []byte(denom)...)
+}
@@ -30,3 +30,73 @@ func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+var (
+	_ sdk.Msg = &MsgSetRateLimitTemplate{}
+	_ sdk.Msg = &MsgRemoveRateLimitTemplate{}
+	_ sdk.Msg = &MsgApplyRateLimitTemplate{}
+)
+
+func (m *MsgSetRateLimitTemplate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := m.Template.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (m MsgSetRateLimitTemplate) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgRemoveRateLimitTemplate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := ValidateTemplateName(m.Name); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (m MsgRemoveRateLimitTemplate) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgApplyRateLimitTemplate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	if err := ValidateTemplateName(m.Template); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateChannelOrClientID(m.ChannelOrClientId); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if m.AllTokenPairs {
+		if len(m.Denoms) > 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denoms must be empty when all_token_pairs is set")
+		}
+		return nil
+	}
+	if len(m.Denoms) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denoms cannot be empty unless all_token_pairs is set")
+	}
+	seen := make(map[string]struct{}, len(m.Denoms))
+	for _, denom := range m.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom %q: %s", denom, err)
+		}
+		if _, ok := seen[denom]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom %q", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+func (m MsgApplyRateLimitTemplate) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
@@ -102,9 +102,238 @@ func (m *QueryWhitelistResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryWhitelistResponse proto.InternalMessageInfo
 
+type QueryTemplatesRequest struct {
+}
+
+func (m *QueryTemplatesRequest) Reset()         { *m = QueryTemplatesRequest{} }
+func (m *QueryTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTemplatesRequest) ProtoMessage()    {}
+func (*QueryTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52cf1597bcc43625, []int{2}
+}
+func (m *QueryTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTemplatesRequest.Merge(m, src)
+}
+func (m *QueryTemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTemplatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTemplatesRequest proto.InternalMessageInfo
+
+type QueryTemplatesResponse struct {
+	Templates []RateLimitTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates"`
+}
+
+func (m *QueryTemplatesResponse) Reset()         { *m = QueryTemplatesResponse{} }
+func (m *QueryTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTemplatesResponse) ProtoMessage()    {}
+func (*QueryTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52cf1597bcc43625, []int{3}
+}
+func (m *QueryTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTemplatesResponse.Merge(m, src)
+}
+func (m *QueryTemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTemplatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTemplatesResponse proto.InternalMessageInfo
+
+type QueryTemplateRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryTemplateRequest) Reset()         { *m = QueryTemplateRequest{} }
+func (m *QueryTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTemplateRequest) ProtoMessage()    {}
+func (*QueryTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52cf1597bcc43625, []int{4}
+}
+func (m *QueryTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTemplateRequest.Merge(m, src)
+}
+func (m *QueryTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTemplateRequest proto.InternalMessageInfo
+
+type QueryTemplateResponse struct {
+	Template RateLimitTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template"`
+}
+
+func (m *QueryTemplateResponse) Reset()         { *m = QueryTemplateResponse{} }
+func (m *QueryTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTemplateResponse) ProtoMessage()    {}
+func (*QueryTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52cf1597bcc43625, []int{5}
+}
+func (m *QueryTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTemplateResponse.Merge(m, src)
+}
+func (m *QueryTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTemplateResponse proto.InternalMessageInfo
+
+type QueryTemplateRateLimitsRequest struct {
+	// template restricts the result to limits set from this template. Empty
+	// returns every template-managed limit.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (m *QueryTemplateRateLimitsRequest) Reset()         { *m = QueryTemplateRateLimitsRequest{} }
+func (m *QueryTemplateRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTemplateRateLimitsRequest) ProtoMessage()    {}
+func (*QueryTemplateRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52cf1597bcc43625, []int{6}
+}
+func (m *QueryTemplateRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTemplateRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTemplateRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTemplateRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTemplateRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryTemplateRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTemplateRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTemplateRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTemplateRateLimitsRequest proto.InternalMessageInfo
+
+type QueryTemplateRateLimitsResponse struct {
+	TemplateRateLimits []TemplateRateLimit `protobuf:"bytes,1,rep,name=template_rate_limits,json=templateRateLimits,proto3" json:"template_rate_limits"`
+}
+
+func (m *QueryTemplateRateLimitsResponse) Reset()         { *m = QueryTemplateRateLimitsResponse{} }
+func (m *QueryTemplateRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTemplateRateLimitsResponse) ProtoMessage()    {}
+func (*QueryTemplateRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52cf1597bcc43625, []int{7}
+}
+func (m *QueryTemplateRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTemplateRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTemplateRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTemplateRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTemplateRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryTemplateRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTemplateRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTemplateRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTemplateRateLimitsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryWhitelistRequest)(nil), "cosmos.evm.ibcratelimiterext.v1.QueryWhitelistRequest")
 	proto.RegisterType((*QueryWhitelistResponse)(nil), "cosmos.evm.ibcratelimiterext.v1.QueryWhitelistResponse")
+	proto.RegisterType((*QueryTemplatesRequest)(nil), "cosmos.evm.ibcratelimiterext.v1.QueryTemplatesRequest")
+	proto.RegisterType((*QueryTemplatesResponse)(nil), "cosmos.evm.ibcratelimiterext.v1.QueryTemplatesResponse")
+	proto.RegisterType((*QueryTemplateRequest)(nil), "cosmos.evm.ibcratelimiterext.v1.QueryTemplateRequest")
+	proto.RegisterType((*QueryTemplateResponse)(nil), "cosmos.evm.ibcratelimiterext.v1.QueryTemplateResponse")
+	proto.RegisterType((*QueryTemplateRateLimitsRequest)(nil), "cosmos.evm.ibcratelimiterext.v1.QueryTemplateRateLimitsRequest")
+	proto.RegisterType((*QueryTemplateRateLimitsResponse)(nil), "cosmos.evm.ibcratelimiterext.v1.QueryTemplateRateLimitsResponse")
 }
 
 func init() {
@@ -112,25 +341,39 @@ func init() {
 }
 
 var fileDescriptor_52cf1597bcc43625 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0x49, 0x2d, 0x4a, 0xad, 0x28, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d,
-	0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0x28, 0xd6, 0x4b, 0x2d, 0xcb, 0xd5,
-	0xc3, 0x50, 0xac, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xab, 0x0f, 0x62,
-	0x41, 0xb4, 0x49, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27,
-	0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x64, 0x95, 0xc4, 0xb9, 0x44,
-	0x03, 0x41, 0x76, 0x84, 0x67, 0x64, 0x82, 0x8c, 0x2b, 0x2e, 0x09, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d,
-	0x2e, 0x51, 0x32, 0xe3, 0x12, 0x43, 0x97, 0x28, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x92, 0xe1,
-	0xe2, 0x2c, 0x87, 0x09, 0x4a, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x21, 0x04, 0x8c, 0x76, 0x31,
-	0x72, 0xb1, 0x82, 0x35, 0x0a, 0x6d, 0x60, 0xe4, 0xe2, 0x84, 0xeb, 0x16, 0x32, 0xd3, 0x23, 0xe0,
-	0x7c, 0x3d, 0xac, 0xee, 0x90, 0x32, 0x27, 0x59, 0x1f, 0xc4, 0x99, 0x4a, 0x46, 0x4d, 0x97, 0x9f,
-	0x4c, 0x66, 0xd2, 0x11, 0xd2, 0xd2, 0x27, 0x14, 0xc8, 0x70, 0xc7, 0x3b, 0xf9, 0x9e, 0x78, 0x28,
-	0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xc8, 0x66, 0x56, 0x60, 0x31, 0xb5, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xc6, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x70, 0xcc,
-	0x2a, 0xe7, 0x01, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0x80, 0x33, 0xda, 0x4a, 0xf3, 0xbc, 0x3d, 0xd6, 0x5a, 0x42, 0xc9, 0x96, 0x9c, 0x4a, 0x95,
+	0x0c, 0xbb, 0xa5, 0xbb, 0x08, 0x8a, 0xd2, 0xb3, 0x1e, 0x0c, 0x45, 0xc1, 0x4b, 0xc9, 0x2e, 0x43,
+	0x1a, 0xd9, 0x64, 0xd2, 0x9d, 0xd9, 0xb5, 0x45, 0xbc, 0x78, 0x17, 0x04, 0xff, 0x88, 0x07, 0x7f,
+	0xc4, 0x1e, 0x0b, 0x82, 0x78, 0x12, 0xdd, 0xf5, 0x6f, 0x08, 0x92, 0xd9, 0x4c, 0xba, 0x4d, 0x56,
+	0x36, 0xb1, 0xb7, 0xf0, 0x66, 0xde, 0xf7, 0xbe, 0x37, 0xbc, 0x17, 0xb8, 0xd7, 0xe7, 0x22, 0xe2,
+	0x82, 0xb2, 0x71, 0x44, 0xc3, 0x5e, 0x7f, 0xe8, 0x4b, 0x36, 0x08, 0xa3, 0x50, 0xb2, 0x21, 0x3b,
+	0x93, 0x74, 0xdc, 0xa2, 0xa7, 0x23, 0x36, 0x3c, 0x77, 0x93, 0x21, 0x97, 0x1c, 0x9b, 0xf3, 0xcb,
+	0x2e, 0x1b, 0x47, 0x6e, 0xe9, 0xb2, 0x3b, 0x6e, 0x59, 0xdd, 0x55, 0xb4, 0x72, 0x96, 0x22, 0x5b,
+	0x8d, 0x80, 0x07, 0x5c, 0x7d, 0xd2, 0xf4, 0x2b, 0x8b, 0x6e, 0x07, 0x9c, 0x07, 0x03, 0x46, 0xfd,
+	0x24, 0xa4, 0x7e, 0x1c, 0x73, 0xe9, 0xcb, 0x90, 0xc7, 0x62, 0x7e, 0xea, 0xdc, 0x85, 0x3b, 0xcf,
+	0x53, 0xb9, 0x97, 0x27, 0x61, 0x4a, 0x14, 0xd2, 0x63, 0xa7, 0x23, 0x26, 0xa4, 0xd3, 0x81, 0xcd,
+	0xe2, 0x81, 0x48, 0x78, 0x2c, 0x18, 0x6e, 0x83, 0xf9, 0x46, 0x07, 0xb7, 0xc8, 0xce, 0xcd, 0x5d,
+	0xd3, 0xbb, 0x0c, 0xe4, 0xc0, 0x23, 0x16, 0x25, 0x03, 0x5f, 0x32, 0xa1, 0x81, 0x09, 0x6c, 0x16,
+	0x0f, 0x32, 0xe0, 0x0b, 0x30, 0xa5, 0x0e, 0x2a, 0xe0, 0xed, 0x76, 0xdb, 0x5d, 0xf1, 0x4a, 0xae,
+	0xe7, 0x4b, 0xf6, 0x34, 0x8d, 0x68, 0xde, 0xe1, 0xda, 0xe4, 0x47, 0xd3, 0xf0, 0x2e, 0x51, 0xce,
+	0x1e, 0x34, 0xae, 0x54, 0xcc, 0x4c, 0x10, 0x61, 0x2d, 0xf6, 0x23, 0xb6, 0x45, 0x76, 0xc8, 0xae,
+	0xe9, 0xa9, 0x6f, 0x27, 0x2a, 0x68, 0xe7, 0x72, 0x47, 0xb0, 0xa1, 0x89, 0x2a, 0xe1, 0x3a, 0x6e,
+	0x39, 0xc9, 0x79, 0x08, 0xf6, 0xd5, 0x72, 0x3a, 0x43, 0x3f, 0x17, 0x5a, 0x85, 0xba, 0xe6, 0x42,
+	0xf6, 0x07, 0x02, 0xcd, 0x7f, 0xa6, 0x67, 0xde, 0xaf, 0xa1, 0xa1, 0xef, 0x1f, 0xa7, 0x86, 0xc7,
+	0x4a, 0xb1, 0xfa, 0xfb, 0x96, 0xd0, 0x59, 0x0f, 0x28, 0x4b, 0x35, 0xdb, 0x7f, 0xd6, 0x61, 0x5d,
+	0xf9, 0xe0, 0x67, 0x02, 0x66, 0x3e, 0x31, 0xd8, 0x59, 0x59, 0x65, 0xe9, 0xec, 0x59, 0xdd, 0xda,
+	0x79, 0xf3, 0xa6, 0x9d, 0xf6, 0xfb, 0xaf, 0xbf, 0x3f, 0xdd, 0xb8, 0x8f, 0x7b, 0x74, 0xd5, 0x0e,
+	0xe5, 0x03, 0xab, 0x94, 0xf3, 0x99, 0xac, 0xaa, 0x5c, 0x9c, 0x6e, 0xab, 0x5b, 0x3b, 0xaf, 0xb6,
+	0x72, 0x3e, 0xd8, 0xf8, 0x85, 0xc0, 0x86, 0x26, 0xe1, 0x41, 0xbd, 0xca, 0x5a, 0xb8, 0x53, 0x37,
+	0x2d, 0xf3, 0x7d, 0xa0, 0x7c, 0xf7, 0xb1, 0x55, 0xdd, 0x97, 0xbe, 0x4d, 0x57, 0xec, 0x1d, 0x7e,
+	0x23, 0x80, 0xe5, 0x89, 0xc5, 0xc7, 0x35, 0x4d, 0x8a, 0xab, 0x62, 0x3d, 0xf9, 0x7f, 0x40, 0xd6,
+	0xd4, 0x23, 0xd5, 0x54, 0x17, 0x0f, 0x2a, 0x37, 0xb5, 0xb8, 0x53, 0x87, 0xcf, 0x26, 0xbf, 0x6c,
+	0x63, 0x32, 0xb5, 0xc9, 0xc5, 0xd4, 0x26, 0x3f, 0xa7, 0x36, 0xf9, 0x38, 0xb3, 0x8d, 0x8b, 0x99,
+	0x6d, 0x7c, 0x9f, 0xd9, 0xc6, 0x2b, 0x1a, 0x84, 0xf2, 0x64, 0xd4, 0x73, 0xfb, 0x3c, 0x5a, 0xc4,
+	0x9f, 0x2d, 0x29, 0x20, 0xcf, 0x13, 0x26, 0x7a, 0xb7, 0xd4, 0xaf, 0x79, 0xff, 0xef, 0x00, 0xa0,
+	0x68, 0xdc, 0xda, 0x57, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -146,6 +389,13 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Whitelist(ctx context.Context, in *QueryWhitelistRequest, opts ...grpc.CallOption) (*QueryWhitelistResponse, error)
+	// Templates returns every rate limit template ordered by name.
+	Templates(ctx context.Context, in *QueryTemplatesRequest, opts ...grpc.CallOption) (*QueryTemplatesResponse, error)
+	// Template returns a rate limit template by name.
+	Template(ctx context.Context, in *QueryTemplateRequest, opts ...grpc.CallOption) (*QueryTemplateResponse, error)
+	// TemplateRateLimits returns the rate limits that were set from a template,
+	// optionally filtered by template name.
+	TemplateRateLimits(ctx context.Context, in *QueryTemplateRateLimitsRequest, opts ...grpc.CallOption) (*QueryTemplateRateLimitsResponse, error)
 }
 
 type queryClient struct {
@@ -165,9 +415,43 @@ func (c *queryClient) Whitelist(ctx context.Context, in *QueryWhitelistRequest,
 	return out, nil
 }
 
+func (c *queryClient) Templates(ctx context.Context, in *QueryTemplatesRequest, opts ...grpc.CallOption) (*QueryTemplatesResponse, error) {
+	out := new(QueryTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcratelimiterext.v1.Query/Templates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Template(ctx context.Context, in *QueryTemplateRequest, opts ...grpc.CallOption) (*QueryTemplateResponse, error) {
+	out := new(QueryTemplateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcratelimiterext.v1.Query/Template", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TemplateRateLimits(ctx context.Context, in *QueryTemplateRateLimitsRequest, opts ...grpc.CallOption) (*QueryTemplateRateLimitsResponse, error) {
+	out := new(QueryTemplateRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcratelimiterext.v1.Query/TemplateRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Whitelist(context.Context, *QueryWhitelistRequest) (*QueryWhitelistResponse, error)
+	// Templates returns every rate limit template ordered by name.
+	Templates(context.Context, *QueryTemplatesRequest) (*QueryTemplatesResponse, error)
+	// Template returns a rate limit template by name.
+	Template(context.Context, *QueryTemplateRequest) (*QueryTemplateResponse, error)
+	// TemplateRateLimits returns the rate limits that were set from a template,
+	// optionally filtered by template name.
+	TemplateRateLimits(context.Context, *QueryTemplateRateLimitsRequest) (*QueryTemplateRateLimitsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -177,6 +461,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Whitelist(ctx context.Context, req *QueryWhitelistRequest) (*QueryWhitelistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Whitelist not implemented")
 }
+func (*UnimplementedQueryServer) Templates(ctx context.Context, req *QueryTemplatesRequest) (*QueryTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Templates not implemented")
+}
+func (*UnimplementedQueryServer) Template(ctx context.Context, req *QueryTemplateRequest) (*QueryTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Template not implemented")
+}
+func (*UnimplementedQueryServer) TemplateRateLimits(ctx context.Context, req *QueryTemplateRateLimitsRequest) (*QueryTemplateRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateRateLimits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -200,6 +493,60 @@ func _Query_Whitelist_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Templates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Templates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcratelimiterext.v1.Query/Templates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Templates(ctx, req.(*QueryTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Template_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Template(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcratelimiterext.v1.Query/Template",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Template(ctx, req.(*QueryTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TemplateRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTemplateRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TemplateRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcratelimiterext.v1.Query/TemplateRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TemplateRateLimits(ctx, req.(*QueryTemplateRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.ibcratelimiterext.v1.Query",
@@ -209,6 +556,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Whitelist",
 			Handler:    _Query_Whitelist_Handler,
 		},
+		{
+			MethodName: "Templates",
+			Handler:    _Query_Templates_Handler,
+		},
+		{
+			MethodName: "Template",
+			Handler:    _Query_Template_Handler,
+		},
+		{
+			MethodName: "TemplateRateLimits",
+			Handler:    _Query_TemplateRateLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/ibcratelimiterext/v1/query.proto",
@@ -269,43 +628,309 @@ func (m *QueryWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryWhitelistRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTemplatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTemplatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Whitelist) > 0 {
-		for _, s := range m.Whitelist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTemplateRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTemplateRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTemplateRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTemplateRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTemplateRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTemplateRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TemplateRateLimits) > 0 {
+		for iNdEx := len(m.TemplateRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TemplateRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTemplatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Template.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTemplateRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTemplateRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TemplateRateLimits) > 0 {
+		for _, e := range m.TemplateRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -442,6 +1067,471 @@ func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTemplatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTemplatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, RateLimitTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTemplateRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTemplateRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTemplateRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTemplateRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTemplateRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTemplateRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateRateLimits = append(m.TemplateRateLimits, TemplateRateLimit{})
+			if err := m.TemplateRateLimits[len(m.TemplateRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdkmath "cosmossdk.io/math"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+)
+
+// MaxTemplateNameLength is the maximum length of a rate limit template name.
+const MaxTemplateNameLength = 64
+
+var (
+	templateNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	channelIDRegex    = regexp.MustCompile(`^channel-\d+$`)
+)
+
+// ValidateTemplateName checks that name can be used as a template store key.
+func ValidateTemplateName(name string) error {
+	if len(name) == 0 || len(name) > MaxTemplateNameLength {
+		return fmt.Errorf("template name must be between 1 and %d characters", MaxTemplateNameLength)
+	}
+	if !templateNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid template name %q: only letters, digits, '_', '.' and '-' are allowed", name)
+	}
+	return nil
+}
+
+// ValidateChannelOrClientID applies the same format check as the rate-limiting
+// module's MsgAddRateLimit.
+func ValidateChannelOrClientID(id string) error {
+	if !channelIDRegex.MatchString(id) && !clienttypes.IsValidClientID(id) {
+		return fmt.Errorf("invalid channel or client-id %q, must be of the format 'channel-{N}' or a valid client-id", id)
+	}
+	return nil
+}
+
+// Validate mirrors the quota checks of the rate-limiting module so a template
+// can never produce a rate limit that MsgAddRateLimit would reject.
+func (t RateLimitTemplate) Validate() error {
+	if err := ValidateTemplateName(t.Name); err != nil {
+		return err
+	}
+	if t.MaxPercentSend.IsNil() || t.MaxPercentRecv.IsNil() {
+		return fmt.Errorf("template %s: max percents cannot be empty", t.Name)
+	}
+	hundred := sdkmath.NewInt(100)
+	if t.MaxPercentSend.IsNegative() || t.MaxPercentSend.GT(hundred) {
+		return fmt.Errorf("template %s: max_percent_send must be between 0 and 100, got %s", t.Name, t.MaxPercentSend)
+	}
+	if t.MaxPercentRecv.IsNegative() || t.MaxPercentRecv.GT(hundred) {
+		return fmt.Errorf("template %s: max_percent_recv must be between 0 and 100, got %s", t.Name, t.MaxPercentRecv)
+	}
+	if t.MaxPercentSend.IsZero() && t.MaxPercentRecv.IsZero() {
+		return fmt.Errorf("template %s: either max_percent_send or max_percent_recv must be greater than 0", t.Name)
+	}
+	if t.DurationHours == 0 {
+		return fmt.Errorf("template %s: duration_hours cannot be zero", t.Name)
+	}
+	return nil
+}
+
+// Validate checks the fields of a template origin record.
+func (r TemplateRateLimit) Validate() error {
+	if err := ValidateTemplateName(r.Template); err != nil {
+		return err
+	}
+	if err := ValidateChannelOrClientID(r.ChannelOrClientId); err != nil {
+		return err
+	}
+	if r.Denom == "" {
+		return fmt.Errorf("template rate limit denom cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestRateLimitTemplateValidate(t *testing.T) {
+	valid := RateLimitTemplate{
+		Name:           "default",
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.ZeroInt(),
+		DurationHours:  24,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*RateLimitTemplate)
+		expErr   string
+	}{
+		{"valid", func(*RateLimitTemplate) {}, ""},
+		{"empty name", func(tmpl *RateLimitTemplate) { tmpl.Name = "" }, "template name"},
+		{"name with spaces", func(tmpl *RateLimitTemplate) { tmpl.Name = "my template" }, "invalid template name"},
+		{"send above 100", func(tmpl *RateLimitTemplate) { tmpl.MaxPercentSend = sdkmath.NewInt(101) }, "max_percent_send"},
+		{"negative recv", func(tmpl *RateLimitTemplate) { tmpl.MaxPercentRecv = sdkmath.NewInt(-1) }, "max_percent_recv"},
+		{"both zero", func(tmpl *RateLimitTemplate) { tmpl.MaxPercentSend = sdkmath.ZeroInt() }, "greater than 0"},
+		{"zero duration", func(tmpl *RateLimitTemplate) { tmpl.DurationHours = 0 }, "duration_hours"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl := valid
+			tc.malleate(&tmpl)
+			err := tmpl.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestMsgApplyRateLimitTemplateValidateBasic(t *testing.T) {
+	signer := "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+
+	testCases := []struct {
+		name   string
+		msg    MsgApplyRateLimitTemplate
+		expErr string
+	}{
+		{"valid denoms", MsgApplyRateLimitTemplate{Signer: signer, Template: "default", ChannelOrClientId: "channel-0", Denoms: []string{"uatom"}}, ""},
+		{"valid all token pairs", MsgApplyRateLimitTemplate{Signer: signer, Template: "default", ChannelOrClientId: "07-tendermint-0", AllTokenPairs: true}, ""},
+		{"invalid channel", MsgApplyRateLimitTemplate{Signer: signer, Template: "default", ChannelOrClientId: "chan", Denoms: []string{"uatom"}}, "invalid channel or client-id"},
+		{"no denoms", MsgApplyRateLimitTemplate{Signer: signer, Template: "default", ChannelOrClientId: "channel-0"}, "denoms cannot be empty"},
+		{"denoms with all token pairs", MsgApplyRateLimitTemplate{Signer: signer, Template: "default", ChannelOrClientId: "channel-0", Denoms: []string{"uatom"}, AllTokenPairs: true}, "denoms must be empty"},
+		{"duplicate denom", MsgApplyRateLimitTemplate{Signer: signer, Template: "default", ChannelOrClientId: "channel-0", Denoms: []string{"uatom", "uatom"}}, "duplicate denom"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
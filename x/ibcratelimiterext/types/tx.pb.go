@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -118,9 +119,327 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+type MsgSetRateLimitTemplate struct {
+	Authority string            `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Template  RateLimitTemplate `protobuf:"bytes,2,opt,name=template,proto3" json:"template"`
+}
+
+func (m *MsgSetRateLimitTemplate) Reset()         { *m = MsgSetRateLimitTemplate{} }
+func (m *MsgSetRateLimitTemplate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitTemplate) ProtoMessage()    {}
+func (*MsgSetRateLimitTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47d77a94fd2db173, []int{2}
+}
+func (m *MsgSetRateLimitTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimitTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateLimitTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitTemplate.Merge(m, src)
+}
+func (m *MsgSetRateLimitTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimitTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimitTemplate proto.InternalMessageInfo
+
+func (m *MsgSetRateLimitTemplate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetRateLimitTemplate) GetTemplate() RateLimitTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return RateLimitTemplate{}
+}
+
+type MsgSetRateLimitTemplateResponse struct {
+}
+
+func (m *MsgSetRateLimitTemplateResponse) Reset()         { *m = MsgSetRateLimitTemplateResponse{} }
+func (m *MsgSetRateLimitTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitTemplateResponse) ProtoMessage()    {}
+func (*MsgSetRateLimitTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47d77a94fd2db173, []int{3}
+}
+func (m *MsgSetRateLimitTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimitTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateLimitTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitTemplateResponse.Merge(m, src)
+}
+func (m *MsgSetRateLimitTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimitTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimitTemplateResponse proto.InternalMessageInfo
+
+type MsgRemoveRateLimitTemplate struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgRemoveRateLimitTemplate) Reset()         { *m = MsgRemoveRateLimitTemplate{} }
+func (m *MsgRemoveRateLimitTemplate) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimitTemplate) ProtoMessage()    {}
+func (*MsgRemoveRateLimitTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47d77a94fd2db173, []int{4}
+}
+func (m *MsgRemoveRateLimitTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimitTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimitTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimitTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimitTemplate.Merge(m, src)
+}
+func (m *MsgRemoveRateLimitTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimitTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimitTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRateLimitTemplate proto.InternalMessageInfo
+
+func (m *MsgRemoveRateLimitTemplate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveRateLimitTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type MsgRemoveRateLimitTemplateResponse struct {
+}
+
+func (m *MsgRemoveRateLimitTemplateResponse) Reset()         { *m = MsgRemoveRateLimitTemplateResponse{} }
+func (m *MsgRemoveRateLimitTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimitTemplateResponse) ProtoMessage()    {}
+func (*MsgRemoveRateLimitTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47d77a94fd2db173, []int{5}
+}
+func (m *MsgRemoveRateLimitTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimitTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimitTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimitTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimitTemplateResponse.Merge(m, src)
+}
+func (m *MsgRemoveRateLimitTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimitTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimitTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRateLimitTemplateResponse proto.InternalMessageInfo
+
+type MsgApplyRateLimitTemplate struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// template is the name of the template to apply.
+	Template string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// channel_or_client_id is the channel or client to rate limit.
+	ChannelOrClientId string `protobuf:"bytes,3,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	// denoms are the denoms to rate limit. Must be empty when
+	// all_token_pairs is set.
+	Denoms []string `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// all_token_pairs applies the template to the denom of every token pair
+	// registered in x/erc20. Denoms without supply are skipped.
+	AllTokenPairs bool `protobuf:"varint,5,opt,name=all_token_pairs,json=allTokenPairs,proto3" json:"all_token_pairs,omitempty"`
+}
+
+func (m *MsgApplyRateLimitTemplate) Reset()         { *m = MsgApplyRateLimitTemplate{} }
+func (m *MsgApplyRateLimitTemplate) String() string { return proto.CompactTextString(m) }
+func (*MsgApplyRateLimitTemplate) ProtoMessage()    {}
+func (*MsgApplyRateLimitTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47d77a94fd2db173, []int{6}
+}
+func (m *MsgApplyRateLimitTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApplyRateLimitTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApplyRateLimitTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApplyRateLimitTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApplyRateLimitTemplate.Merge(m, src)
+}
+func (m *MsgApplyRateLimitTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApplyRateLimitTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApplyRateLimitTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApplyRateLimitTemplate proto.InternalMessageInfo
+
+func (m *MsgApplyRateLimitTemplate) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgApplyRateLimitTemplate) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *MsgApplyRateLimitTemplate) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+func (m *MsgApplyRateLimitTemplate) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *MsgApplyRateLimitTemplate) GetAllTokenPairs() bool {
+	if m != nil {
+		return m.AllTokenPairs
+	}
+	return false
+}
+
+type MsgApplyRateLimitTemplateResponse struct {
+	// applied are the denoms whose rate limit was added or updated.
+	Applied []string `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	// skipped are the token pair denoms left out because they have no supply.
+	Skipped []string `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (m *MsgApplyRateLimitTemplateResponse) Reset()         { *m = MsgApplyRateLimitTemplateResponse{} }
+func (m *MsgApplyRateLimitTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApplyRateLimitTemplateResponse) ProtoMessage()    {}
+func (*MsgApplyRateLimitTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47d77a94fd2db173, []int{7}
+}
+func (m *MsgApplyRateLimitTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApplyRateLimitTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApplyRateLimitTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApplyRateLimitTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApplyRateLimitTemplateResponse.Merge(m, src)
+}
+func (m *MsgApplyRateLimitTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApplyRateLimitTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApplyRateLimitTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApplyRateLimitTemplateResponse proto.InternalMessageInfo
+
+func (m *MsgApplyRateLimitTemplateResponse) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *MsgApplyRateLimitTemplateResponse) GetSkipped() []string {
+	if m != nil {
+		return m.Skipped
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.ibcratelimiterext.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.ibcratelimiterext.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetRateLimitTemplate)(nil), "cosmos.evm.ibcratelimiterext.v1.MsgSetRateLimitTemplate")
+	proto.RegisterType((*MsgSetRateLimitTemplateResponse)(nil), "cosmos.evm.ibcratelimiterext.v1.MsgSetRateLimitTemplateResponse")
+	proto.RegisterType((*MsgRemoveRateLimitTemplate)(nil), "cosmos.evm.ibcratelimiterext.v1.MsgRemoveRateLimitTemplate")
+	proto.RegisterType((*MsgRemoveRateLimitTemplateResponse)(nil), "cosmos.evm.ibcratelimiterext.v1.MsgRemoveRateLimitTemplateResponse")
+	proto.RegisterType((*MsgApplyRateLimitTemplate)(nil), "cosmos.evm.ibcratelimiterext.v1.MsgApplyRateLimitTemplate")
+	proto.RegisterType((*MsgApplyRateLimitTemplateResponse)(nil), "cosmos.evm.ibcratelimiterext.v1.MsgApplyRateLimitTemplateResponse")
 }
 
 func init() {
@@ -128,28 +447,50 @@ func init() {
 }
 
 var fileDescriptor_47d77a94fd2db173 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0x49, 0x2d, 0x4a, 0xad, 0x28, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0xa8, 0xd4, 0x4b, 0x2d, 0xcb, 0xd5, 0xc3, 0x50, 0xa9,
-	0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x7a, 0xa4, 0xc4,
-	0xa1, 0xa6, 0xe7, 0x16, 0xa7, 0x83, 0xcc, 0xca, 0x2d, 0x4e, 0x87, 0x4a, 0x48, 0x42, 0x24, 0xe2,
-	0xc1, 0x3c, 0x7d, 0xa8, 0xc9, 0x10, 0x29, 0x73, 0x42, 0x2e, 0xc2, 0xb4, 0x1c, 0xac, 0x51, 0xe9,
-	0x2c, 0x23, 0x17, 0xbf, 0x6f, 0x71, 0x7a, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x6a, 0x40, 0x62, 0x51,
-	0x62, 0x6e, 0xb1, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0x1b, 0x1d, 0x53,
-	0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xec,
-	0xb9, 0xd8, 0x0a, 0xc0, 0x26, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0xa9, 0xeb, 0x11, 0xf0,
-	0xbd, 0x1e, 0xc4, 0xc2, 0x20, 0xa8, 0x36, 0x2b, 0xc7, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x06, 0x76,
-	0x3d, 0xdf, 0xa0, 0xa5, 0x87, 0xe4, 0xb1, 0x0a, 0x2c, 0x5e, 0x43, 0x73, 0xbb, 0x92, 0x24, 0x97,
-	0x38, 0x9a, 0x50, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x51, 0x07, 0x23, 0x17, 0xb3,
-	0x6f, 0x71, 0xba, 0x50, 0x15, 0x17, 0x0f, 0x8a, 0x77, 0x0d, 0x08, 0x3a, 0x13, 0xcd, 0x44, 0x29,
-	0x0b, 0x52, 0x75, 0xc0, 0xdc, 0x20, 0xc5, 0xda, 0xf0, 0x7c, 0x83, 0x16, 0xa3, 0x93, 0xe7, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x13, 0xf0, 0x7a, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38,
-	0x1e, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x06, 0x57, 0xdb, 0xa2, 0x94, 0x02, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x4f, 0xd4, 0x4e,
+	0x1c, 0xdd, 0x81, 0x65, 0xff, 0xec, 0xfc, 0x35, 0x84, 0x66, 0x03, 0xa5, 0x87, 0x65, 0x69, 0x8c,
+	0x6e, 0x48, 0x6c, 0x01, 0x13, 0xc4, 0xf5, 0xa0, 0x2c, 0x17, 0x49, 0xd8, 0x48, 0x0a, 0xc6, 0xc4,
+	0xcb, 0x66, 0xd8, 0x4e, 0xca, 0x84, 0x4e, 0x67, 0xd2, 0x19, 0x36, 0xe0, 0xc9, 0x78, 0xf4, 0x64,
+	0x3c, 0x99, 0x78, 0xf5, 0x03, 0x70, 0x30, 0x7e, 0x06, 0x2e, 0x26, 0xc4, 0x83, 0xf1, 0x64, 0x0c,
+	0x1c, 0xb8, 0xfb, 0x09, 0x4c, 0xdb, 0x61, 0xd1, 0xdd, 0x36, 0xc5, 0xd5, 0xcb, 0xa6, 0x33, 0x6f,
+	0xde, 0x9b, 0xf7, 0x5e, 0xf3, 0xdb, 0xc2, 0x7a, 0x87, 0x09, 0xca, 0x84, 0x8d, 0xbb, 0xd4, 0x26,
+	0x3b, 0x9d, 0x10, 0x49, 0xec, 0x13, 0x4a, 0x24, 0x0e, 0xf1, 0x81, 0xb4, 0xbb, 0x8b, 0xb6, 0x3c,
+	0xb0, 0x78, 0xc8, 0x24, 0xd3, 0x66, 0x93, 0x93, 0x16, 0xee, 0x52, 0x6b, 0xe0, 0xa4, 0xd5, 0x5d,
+	0x34, 0x26, 0x11, 0x25, 0x01, 0xb3, 0xe3, 0xdf, 0x84, 0x63, 0x4c, 0x2b, 0x75, 0x2a, 0xbc, 0x48,
+	0x8b, 0x0a, 0x4f, 0x01, 0x33, 0x09, 0xd0, 0x8e, 0x57, 0xb6, 0x52, 0x4e, 0xa0, 0xbb, 0x79, 0x8e,
+	0x06, 0x2f, 0x4f, 0x88, 0x15, 0x8f, 0x79, 0x2c, 0x11, 0x8c, 0x9e, 0x92, 0x5d, 0xf3, 0x13, 0x80,
+	0x13, 0x2d, 0xe1, 0x3d, 0xe1, 0x2e, 0x92, 0x78, 0x13, 0x85, 0x88, 0x0a, 0x6d, 0x19, 0x96, 0xd1,
+	0xbe, 0xdc, 0x65, 0x21, 0x91, 0x87, 0x3a, 0xa8, 0x81, 0x7a, 0xb9, 0xa9, 0x7f, 0xfe, 0x70, 0xbb,
+	0xa2, 0x7c, 0xac, 0xba, 0x6e, 0x88, 0x85, 0xd8, 0x92, 0x21, 0x09, 0x3c, 0xe7, 0xf2, 0xa8, 0xf6,
+	0x00, 0x96, 0x78, 0xac, 0xa0, 0x8f, 0xd4, 0x40, 0xfd, 0xff, 0xa5, 0x5b, 0x56, 0x4e, 0x27, 0x56,
+	0x72, 0xa1, 0xa3, 0x68, 0x8d, 0xd5, 0x97, 0xe7, 0x47, 0xf3, 0x97, 0x82, 0xaf, 0xce, 0x8f, 0xe6,
+	0xad, 0x5f, 0xe2, 0x1e, 0xa4, 0x04, 0xee, 0xf3, 0x6e, 0xce, 0xc0, 0xe9, 0xbe, 0x2d, 0x07, 0x0b,
+	0xce, 0x02, 0x81, 0xcd, 0x1f, 0x20, 0xc6, 0xb6, 0xb0, 0x74, 0x90, 0xc4, 0x1b, 0x91, 0xc6, 0x36,
+	0xa6, 0xdc, 0x47, 0x12, 0x0f, 0x1d, 0x79, 0x1b, 0x8e, 0x4b, 0xa5, 0xa1, 0x42, 0x2f, 0xe5, 0x86,
+	0x1e, 0xb8, 0xbd, 0x59, 0x3c, 0xfe, 0x36, 0x5b, 0x70, 0x7a, 0x4a, 0x8d, 0xf5, 0xc1, 0x1e, 0x96,
+	0xf3, 0x7b, 0x48, 0x0b, 0x66, 0xce, 0xc1, 0xd9, 0x0c, 0xa8, 0xd7, 0xcb, 0x47, 0x00, 0x8d, 0x96,
+	0xf0, 0x1c, 0x4c, 0x59, 0x17, 0xff, 0xbb, 0x6a, 0x34, 0x58, 0x0c, 0x10, 0x4d, 0x6a, 0x29, 0x3b,
+	0xf1, 0x73, 0x63, 0x63, 0x30, 0xd8, 0xbd, 0xfc, 0x60, 0x19, 0xce, 0xcc, 0x1b, 0xd0, 0xcc, 0x46,
+	0x7b, 0xf1, 0xde, 0x8f, 0xc0, 0x99, 0x96, 0xf0, 0x56, 0x39, 0xf7, 0x0f, 0x07, 0xd3, 0x2d, 0xc0,
+	0x92, 0x20, 0x5e, 0x80, 0xc3, 0xdc, 0x68, 0xea, 0x9c, 0x66, 0xf4, 0xbd, 0xf2, 0xf2, 0xe5, 0x8b,
+	0xd3, 0x6c, 0x58, 0xe9, 0xec, 0xa2, 0x20, 0xc0, 0x7e, 0x9b, 0x85, 0xed, 0x8e, 0x4f, 0x70, 0x20,
+	0xdb, 0xc4, 0xd5, 0x47, 0xe3, 0x73, 0x93, 0x0a, 0x7b, 0x1c, 0xae, 0xc5, 0xc8, 0xba, 0xab, 0x4d,
+	0xc1, 0x92, 0x8b, 0x03, 0x46, 0x85, 0x5e, 0xac, 0x8d, 0xd6, 0xcb, 0x8e, 0x5a, 0x69, 0x37, 0xe1,
+	0x04, 0xf2, 0xfd, 0xb6, 0x64, 0x7b, 0x38, 0x68, 0x73, 0x44, 0x42, 0xa1, 0x8f, 0xd5, 0x40, 0x7d,
+	0xdc, 0xb9, 0x8e, 0x7c, 0x7f, 0x3b, 0xda, 0xdd, 0x8c, 0x36, 0x1b, 0x8f, 0xa2, 0x42, 0x95, 0xb3,
+	0xa8, 0xcd, 0x95, 0xfc, 0x36, 0xd3, 0x8b, 0x30, 0x9f, 0xc2, 0xb9, 0x4c, 0xf0, 0xa2, 0x4b, 0x4d,
+	0x87, 0xff, 0x21, 0xce, 0x7d, 0x82, 0x5d, 0x1d, 0xc4, 0x7e, 0x2f, 0x96, 0x11, 0x22, 0xf6, 0x08,
+	0xe7, 0xd8, 0xd5, 0x47, 0x12, 0x44, 0x2d, 0x97, 0xbe, 0x14, 0xe1, 0x68, 0x4b, 0x78, 0xda, 0x73,
+	0x78, 0xed, 0xb7, 0x7f, 0x99, 0x85, 0xdc, 0x41, 0xe9, 0x1b, 0x64, 0x63, 0xe5, 0x4f, 0x19, 0x3d,
+	0xdf, 0x6f, 0x00, 0xac, 0xa4, 0xce, 0xfd, 0x95, 0x24, 0xd3, 0x98, 0xc6, 0xc3, 0x61, 0x99, 0x3d,
+	0x53, 0xef, 0x00, 0x9c, 0xce, 0x1a, 0xba, 0xfb, 0x57, 0x51, 0xcf, 0x20, 0x1b, 0x6b, 0x7f, 0x41,
+	0xee, 0xb9, 0x7b, 0x0b, 0xe0, 0x54, 0xc6, 0xcc, 0x34, 0xae, 0xa2, 0x9f, 0xce, 0x35, 0x9a, 0xc3,
+	0x73, 0x2f, 0xac, 0x19, 0x63, 0x2f, 0xce, 0x8f, 0xe6, 0x41, 0x73, 0xfd, 0xf8, 0xb4, 0x0a, 0x4e,
+	0x4e, 0xab, 0xe0, 0xfb, 0x69, 0x15, 0xbc, 0x3e, 0xab, 0x16, 0x4e, 0xce, 0xaa, 0x85, 0xaf, 0x67,
+	0xd5, 0xc2, 0x33, 0xdb, 0x23, 0x72, 0x77, 0x7f, 0xc7, 0xea, 0x30, 0x6a, 0xe7, 0x0c, 0x84, 0x3c,
+	0xe4, 0x58, 0xec, 0x94, 0xe2, 0x8f, 0xe1, 0x9d, 0x9f, 0x03, 0x00, 0x33, 0xf6, 0x87, 0x09, 0xef,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -165,6 +506,16 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetRateLimitTemplate creates or replaces a rate limit template. Only the
+	// governance authority may call it.
+	SetRateLimitTemplate(ctx context.Context, in *MsgSetRateLimitTemplate, opts ...grpc.CallOption) (*MsgSetRateLimitTemplateResponse, error)
+	// RemoveRateLimitTemplate deletes a rate limit template. Only the
+	// governance authority may call it.
+	RemoveRateLimitTemplate(ctx context.Context, in *MsgRemoveRateLimitTemplate, opts ...grpc.CallOption) (*MsgRemoveRateLimitTemplateResponse, error)
+	// ApplyRateLimitTemplate adds or updates the rate limits of a channel or
+	// client for a list of denoms from a template. The authority and
+	// whitelisted operators may call it.
+	ApplyRateLimitTemplate(ctx context.Context, in *MsgApplyRateLimitTemplate, opts ...grpc.CallOption) (*MsgApplyRateLimitTemplateResponse, error)
 }
 
 type msgClient struct {
@@ -184,9 +535,46 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetRateLimitTemplate(ctx context.Context, in *MsgSetRateLimitTemplate, opts ...grpc.CallOption) (*MsgSetRateLimitTemplateResponse, error) {
+	out := new(MsgSetRateLimitTemplateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcratelimiterext.v1.Msg/SetRateLimitTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimitTemplate(ctx context.Context, in *MsgRemoveRateLimitTemplate, opts ...grpc.CallOption) (*MsgRemoveRateLimitTemplateResponse, error) {
+	out := new(MsgRemoveRateLimitTemplateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcratelimiterext.v1.Msg/RemoveRateLimitTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApplyRateLimitTemplate(ctx context.Context, in *MsgApplyRateLimitTemplate, opts ...grpc.CallOption) (*MsgApplyRateLimitTemplateResponse, error) {
+	out := new(MsgApplyRateLimitTemplateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.ibcratelimiterext.v1.Msg/ApplyRateLimitTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetRateLimitTemplate creates or replaces a rate limit template. Only the
+	// governance authority may call it.
+	SetRateLimitTemplate(context.Context, *MsgSetRateLimitTemplate) (*MsgSetRateLimitTemplateResponse, error)
+	// RemoveRateLimitTemplate deletes a rate limit template. Only the
+	// governance authority may call it.
+	RemoveRateLimitTemplate(context.Context, *MsgRemoveRateLimitTemplate) (*MsgRemoveRateLimitTemplateResponse, error)
+	// ApplyRateLimitTemplate adds or updates the rate limits of a channel or
+	// client for a list of denoms from a template. The authority and
+	// whitelisted operators may call it.
+	ApplyRateLimitTemplate(context.Context, *MsgApplyRateLimitTemplate) (*MsgApplyRateLimitTemplateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -196,6 +584,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetRateLimitTemplate(ctx context.Context, req *MsgSetRateLimitTemplate) (*MsgSetRateLimitTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimitTemplate not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRateLimitTemplate(ctx context.Context, req *MsgRemoveRateLimitTemplate) (*MsgRemoveRateLimitTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimitTemplate not implemented")
+}
+func (*UnimplementedMsgServer) ApplyRateLimitTemplate(ctx context.Context, req *MsgApplyRateLimitTemplate) (*MsgApplyRateLimitTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRateLimitTemplate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -219,6 +616,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRateLimitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimitTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimitTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcratelimiterext.v1.Msg/SetRateLimitTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimitTemplate(ctx, req.(*MsgSetRateLimitTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimitTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimitTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcratelimiterext.v1.Msg/RemoveRateLimitTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimitTemplate(ctx, req.(*MsgRemoveRateLimitTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApplyRateLimitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApplyRateLimitTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApplyRateLimitTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.ibcratelimiterext.v1.Msg/ApplyRateLimitTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApplyRateLimitTemplate(ctx, req.(*MsgApplyRateLimitTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.ibcratelimiterext.v1.Msg",
@@ -228,6 +679,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetRateLimitTemplate",
+			Handler:    _Msg_SetRateLimitTemplate_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimitTemplate",
+			Handler:    _Msg_RemoveRateLimitTemplate_Handler,
+		},
+		{
+			MethodName: "ApplyRateLimitTemplate",
+			Handler:    _Msg_ApplyRateLimitTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/ibcratelimiterext/v1/tx.proto",
@@ -298,15 +761,242 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *MsgSetRateLimitTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimitTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApplyRateLimitTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApplyRateLimitTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApplyRateLimitTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllTokenPairs {
+		i--
+		if m.AllTokenPairs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApplyRateLimitTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApplyRateLimitTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApplyRateLimitTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Skipped) > 0 {
+		for iNdEx := len(m.Skipped) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skipped[iNdEx])
+			copy(dAtA[i:], m.Skipped[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Skipped[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Applied) > 0 {
+		for iNdEx := len(m.Applied) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applied[iNdEx])
+			copy(dAtA[i:], m.Applied[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Applied[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgUpdateParams) Size() (n int) {
@@ -335,6 +1025,107 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetRateLimitTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRateLimitTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRateLimitTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApplyRateLimitTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AllTokenPairs {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApplyRateLimitTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Skipped) > 0 {
+		for _, s := range m.Skipped {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -509,6 +1300,647 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetRateLimitTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRateLimitTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRateLimitTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRateLimitTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRateLimitTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRateLimitTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimitTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimitTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApplyRateLimitTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApplyRateLimitTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApplyRateLimitTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllTokenPairs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllTokenPairs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApplyRateLimitTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApplyRateLimitTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApplyRateLimitTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applied = append(m.Applied, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skipped = append(m.Skipped, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,26 @@
+package msdcheck
+
+import (
+	"github.com/cosmos/evm/x/msdcheck/keeper"
+	"github.com/cosmos/evm/x/msdcheck/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	for _, v := range data.BelowFloorValidators {
+		valAddr, err := sdk.ValAddressFromBech32(v.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetBelowFloorSince(ctx, valAddr, v.SinceHeight)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:               k.GetParams(ctx),
+		BelowFloorValidators: k.GetBelowFloorValidators(ctx),
+	}
+}
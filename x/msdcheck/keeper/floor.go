@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/evm/x/msdcheck/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetBelowFloorSince returns the height at which the validator was first seen
+// below the minimum self-delegation, if it is in its grace period.
+func (k Keeper) GetBelowFloorSince(ctx sdk.Context, valAddr sdk.ValAddress) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBelowFloorSinceKey(valAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+func (k Keeper) SetBelowFloorSince(ctx sdk.Context, valAddr sdk.ValAddress, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBelowFloorSinceKey(valAddr), sdk.Uint64ToBigEndian(height))
+}
+
+func (k Keeper) DeleteBelowFloorSince(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBelowFloorSinceKey(valAddr))
+}
+
+// IterateBelowFloorValidators walks the validators in their grace period.
+func (k Keeper) IterateBelowFloorValidators(ctx sdk.Context, cb func(valAddr sdk.ValAddress, sinceHeight uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBelowFloorSince)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.KeyPrefixBelowFloorSince):])
+		if cb(valAddr, binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetBelowFloorValidators(ctx sdk.Context) []types.BelowFloorValidator {
+	validators := []types.BelowFloorValidator{}
+	k.IterateBelowFloorValidators(ctx, func(valAddr sdk.ValAddress, sinceHeight uint64) bool {
+		validators = append(validators, types.BelowFloorValidator{
+			ValidatorAddress: valAddr.String(),
+			SinceHeight:      sinceHeight,
+		})
+		return false
+	})
+	return validators
+}
+
+// SelfDelegation returns the tokens the operator has delegated to its own
+// validator.
+func (k Keeper) SelfDelegation(ctx sdk.Context, validator stakingtypes.ValidatorI) (math.Int, error) {
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.Int{}, err
+	}
+	return validator.TokensFromShares(delegation.Shares).TruncateInt(), nil
+}
+
+// ValidateSelfDelegationWithdrawal rejects, in strict mode, an undelegation or
+// redelegation of amount by the operator that would leave its self-delegation
+// above zero but below the minimum. Withdrawing the whole self-delegation is
+// allowed so that operators can always exit.
+func (k Keeper) ValidateSelfDelegationWithdrawal(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) error {
+	params := k.GetParams(ctx)
+	if !params.StrictMode || !delegator.Equals(sdk.AccAddress(valAddr)) {
+		return nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	selfDelegation, err := k.SelfDelegation(ctx, validator)
+	if err != nil {
+		return err
+	}
+
+	remaining := selfDelegation.Sub(amount)
+	if remaining.IsPositive() && remaining.LT(params.MinSelfDelegation) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"remaining self-delegation %s < minimum %s",
+			remaining.String(),
+			params.MinSelfDelegation.String(),
+		)
+	}
+	return nil
+}
+
+// EndBlocker jails, in strict mode, bonded validators whose self-delegation
+// has stayed below the minimum for longer than the grace period, e.g. after
+// being slashed. A jailed validator keeps its below-floor height, so it is
+// jailed again right after unjailing unless it got back to the floor.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if !params.StrictMode {
+		k.clearBelowFloorValidators(ctx, nil)
+		return nil
+	}
+
+	var bonded []stakingtypes.ValidatorI
+	err := k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		bonded = append(bonded, validator)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	height := uint64(ctx.BlockHeight()) //#nosec G115 -- block height is never negative
+	checked := make(map[string]struct{}, len(bonded))
+	for _, validator := range bonded {
+		if validator.IsJailed() {
+			continue
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+		checked[valAddr.String()] = struct{}{}
+
+		selfDelegation, err := k.SelfDelegation(ctx, validator)
+		if err != nil {
+			k.Logger(ctx).Error("failed to read validator self-delegation", "validator", valAddr.String(), "err", err)
+			continue
+		}
+		k.checkSelfDelegationFloor(ctx, params, validator, valAddr, selfDelegation, height)
+	}
+
+	k.IterateBelowFloorValidators(ctx, func(valAddr sdk.ValAddress, _ uint64) bool {
+		if validator, err := k.stakingKeeper.GetValidator(ctx, valAddr); err == nil && validator.IsJailed() {
+			checked[valAddr.String()] = struct{}{}
+		}
+		return false
+	})
+	k.clearBelowFloorValidators(ctx, checked)
+	return nil
+}
+
+func (k Keeper) checkSelfDelegationFloor(
+	ctx sdk.Context,
+	params types.Params,
+	validator stakingtypes.ValidatorI,
+	valAddr sdk.ValAddress,
+	selfDelegation math.Int,
+	height uint64,
+) {
+	since, tracked := k.GetBelowFloorSince(ctx, valAddr)
+	if selfDelegation.GTE(params.MinSelfDelegation) {
+		if tracked {
+			k.DeleteBelowFloorSince(ctx, valAddr)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeSelfBondRestored,
+					sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+					sdk.NewAttribute(types.AttributeKeySelfBond, selfDelegation.String()),
+				),
+			)
+		}
+		return
+	}
+
+	if !tracked {
+		since = height
+		k.SetBelowFloorSince(ctx, valAddr, since)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSelfBondBelowFloor,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeySelfBond, selfDelegation.String()),
+				sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, params.MinSelfDelegation.String()),
+				sdk.NewAttribute(types.AttributeKeyJailHeight, strconv.FormatUint(since+params.GracePeriodBlocks, 10)),
+			),
+		)
+	}
+
+	if height-since < params.GracePeriodBlocks {
+		return
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		k.Logger(ctx).Error("failed to read validator consensus address", "validator", valAddr.String(), "err", err)
+		return
+	}
+	if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+		k.Logger(ctx).Error("failed to jail validator below minimum self-delegation", "validator", valAddr.String(), "err", err)
+		return
+	}
+	k.Logger(ctx).Info("jailed validator below minimum self-delegation", "validator", valAddr.String(), "since_height", since)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJailBelowFloor,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySelfBond, selfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, params.MinSelfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeySinceHeight, strconv.FormatUint(since, 10)),
+		),
+	)
+}
+
+// clearBelowFloorValidators drops the grace period of every tracked validator
+// not in keep, i.e. validators that left the bonded set or all of them once
+// strict mode is turned off.
+func (k Keeper) clearBelowFloorValidators(ctx sdk.Context, keep map[string]struct{}) {
+	var stale []sdk.ValAddress
+	k.IterateBelowFloorValidators(ctx, func(valAddr sdk.ValAddress, _ uint64) bool {
+		if _, ok := keep[valAddr.String()]; !ok {
+			stale = append(stale, valAddr)
+		}
+		return false
+	})
+	for _, valAddr := range stale {
+		k.DeleteBelowFloorSince(ctx, valAddr)
+	}
+}
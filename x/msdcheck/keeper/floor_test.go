@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/msdcheck/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type fakeStakingKeeper struct {
+	validators  map[string]stakingtypes.Validator
+	delegations map[string]stakingtypes.Delegation
+}
+
+func newFakeStakingKeeper() *fakeStakingKeeper {
+	return &fakeStakingKeeper{
+		validators:  map[string]stakingtypes.Validator{},
+		delegations: map[string]stakingtypes.Delegation{},
+	}
+}
+
+func (f *fakeStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, ok := f.validators[addr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (f *fakeStakingKeeper) GetDelegation(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error) {
+	delegation, ok := f.delegations[delAddr.String()+valAddr.String()]
+	if !ok {
+		return stakingtypes.Delegation{}, stakingtypes.ErrNoDelegation
+	}
+	return delegation, nil
+}
+
+func (f *fakeStakingKeeper) IterateBondedValidatorsByPower(_ context.Context, fn func(int64, stakingtypes.ValidatorI) bool) error {
+	var i int64
+	for _, validator := range f.validators {
+		if !validator.IsBonded() || validator.IsJailed() {
+			continue
+		}
+		if fn(i, validator) {
+			break
+		}
+		i++
+	}
+	return nil
+}
+
+func (f *fakeStakingKeeper) Jail(_ context.Context, consAddr sdk.ConsAddress) error {
+	for addr, validator := range f.validators {
+		valConsAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		if sdk.ConsAddress(valConsAddr).Equals(consAddr) {
+			validator.Jailed = true
+			f.validators[addr] = validator
+			return nil
+		}
+	}
+	return stakingtypes.ErrNoValidatorFound
+}
+
+// addValidator registers a bonded validator whose operator holds selfBond of
+// its tokens.
+func (f *fakeStakingKeeper) addValidator(t *testing.T, seed string, selfBond math.Int) sdk.ValAddress {
+	t.Helper()
+	valAddr := sdk.ValAddress([]byte(seed))
+	validator, err := stakingtypes.NewValidator(valAddr.String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	f.validators[valAddr.String()] = validator
+	f.setSelfBond(valAddr, selfBond)
+	return valAddr
+}
+
+func (f *fakeStakingKeeper) setSelfBond(valAddr sdk.ValAddress, selfBond math.Int) {
+	validator := f.validators[valAddr.String()]
+	validator.Tokens = selfBond
+	validator.DelegatorShares = math.LegacyNewDecFromInt(selfBond)
+	f.validators[valAddr.String()] = validator
+
+	delAddr := sdk.AccAddress(valAddr)
+	f.delegations[delAddr.String()+valAddr.String()] = stakingtypes.NewDelegation(
+		delAddr.String(), valAddr.String(), math.LegacyNewDecFromInt(selfBond),
+	)
+}
+
+func strictParams(gracePeriodBlocks uint64) types.Params {
+	return types.Params{
+		MinSelfDelegation: math.NewInt(100),
+		StrictMode:        true,
+		GracePeriodBlocks: gracePeriodBlocks,
+	}
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidateSelfDelegationWithdrawal(t *testing.T) {
+	k, ctx, sk := setupKeeperWithStaking(t)
+	valAddr := sk.addValidator(t, "validator_1", math.NewInt(150))
+	operator := sdk.AccAddress(valAddr)
+
+	// Strict mode off: nothing is checked.
+	require.NoError(t, k.ValidateSelfDelegationWithdrawal(ctx, operator, valAddr, math.NewInt(100)))
+
+	k.SetParams(ctx, strictParams(10))
+	require.NoError(t, k.ValidateSelfDelegationWithdrawal(ctx, operator, valAddr, math.NewInt(50)))
+	require.ErrorContains(t, k.ValidateSelfDelegationWithdrawal(ctx, operator, valAddr, math.NewInt(51)), "remaining self-delegation 99 < minimum 100")
+	require.NoError(t, k.ValidateSelfDelegationWithdrawal(ctx, operator, valAddr, math.NewInt(150)), "full exit is allowed")
+
+	other := sdk.AccAddress([]byte("delegator_1"))
+	require.NoError(t, k.ValidateSelfDelegationWithdrawal(ctx, other, valAddr, math.NewInt(100)), "only the operator is checked")
+}
+
+func TestEndBlockerJailsAfterGracePeriod(t *testing.T) {
+	k, ctx, sk := setupKeeperWithStaking(t)
+	k.SetParams(ctx, strictParams(10))
+	valAddr := sk.addValidator(t, "validator_1", math.NewInt(100))
+	healthy := sk.addValidator(t, "validator_2", math.NewInt(200))
+
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Empty(t, k.GetBelowFloorValidators(ctx))
+
+	// Slashing drops the self-delegation below the floor.
+	sk.setSelfBond(valAddr, math.NewInt(90))
+	ctx = ctx.WithBlockHeight(6).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	since, ok := k.GetBelowFloorSince(ctx, valAddr)
+	require.True(t, ok)
+	require.Equal(t, uint64(6), since)
+	require.True(t, hasEvent(ctx, types.EventTypeSelfBondBelowFloor))
+
+	ctx = ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.False(t, sk.validators[valAddr.String()].Jailed)
+
+	ctx = ctx.WithBlockHeight(16).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.True(t, sk.validators[valAddr.String()].Jailed)
+	require.False(t, sk.validators[healthy.String()].Jailed)
+	require.True(t, hasEvent(ctx, types.EventTypeJailBelowFloor))
+
+	// The jailed validator keeps its record, so unjailing below the floor
+	// does not buy another grace period.
+	ctx = ctx.WithBlockHeight(17).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	since, ok = k.GetBelowFloorSince(ctx, valAddr)
+	require.True(t, ok)
+	require.Equal(t, uint64(6), since)
+
+	unjail := func() {
+		validator := sk.validators[valAddr.String()]
+		validator.Jailed = false
+		sk.validators[valAddr.String()] = validator
+	}
+	unjail()
+	ctx = ctx.WithBlockHeight(18).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.True(t, sk.validators[valAddr.String()].Jailed)
+	require.True(t, hasEvent(ctx, types.EventTypeJailBelowFloor))
+	require.False(t, hasEvent(ctx, types.EventTypeSelfBondBelowFloor))
+
+	// Back at the floor, the validator can unjail and its record is dropped.
+	sk.setSelfBond(valAddr, math.NewInt(100))
+	unjail()
+	ctx = ctx.WithBlockHeight(19).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.False(t, sk.validators[valAddr.String()].Jailed)
+	require.True(t, hasEvent(ctx, types.EventTypeSelfBondRestored))
+	require.Empty(t, k.GetBelowFloorValidators(ctx))
+}
+
+func TestEndBlockerClearsRestoredValidators(t *testing.T) {
+	k, ctx, sk := setupKeeperWithStaking(t)
+	k.SetParams(ctx, strictParams(10))
+	valAddr := sk.addValidator(t, "validator_1", math.NewInt(90))
+
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Len(t, k.GetBelowFloorValidators(ctx), 1)
+
+	sk.setSelfBond(valAddr, math.NewInt(100))
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Empty(t, k.GetBelowFloorValidators(ctx))
+	require.True(t, hasEvent(ctx, types.EventTypeSelfBondRestored))
+
+	// Turning strict mode off drops any grace period in progress.
+	sk.setSelfBond(valAddr, math.NewInt(90))
+	require.NoError(t, k.EndBlocker(ctx.WithBlockHeight(3)))
+	require.Len(t, k.GetBelowFloorValidators(ctx), 1)
+	k.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, k.EndBlocker(ctx.WithBlockHeight(4)))
+	require.Empty(t, k.GetBelowFloorValidators(ctx))
+	require.False(t, sk.validators[valAddr.String()].Jailed)
+}
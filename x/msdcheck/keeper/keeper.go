@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/msdcheck/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	authority     sdk.AccAddress
+	stakingKeeper types.StakingKeeper
+}
+
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority sdk.AccAddress, stakingKeeper types.StakingKeeper) Keeper {
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err) //nolint:halt // Constructor guard: msdcheck authority wiring must be valid at boot.
+	}
+	return Keeper{cdc: cdc, storeKey: storeKey, authority: authority, stakingKeeper: stakingKeeper}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyParams)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyParams, k.cdc.MustMarshal(&params))
+}
+
+// GetMinSelfDelegation returns the minimum self-delegation validators must
+// create with and may propose as their MinSelfDelegation.
+func (k Keeper) GetMinSelfDelegation(ctx sdk.Context) math.Int {
+	return k.GetParams(ctx).MinSelfDelegation
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/x/msdcheck/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	store "cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func setupKeeper(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+	k, ctx, _ := setupKeeperWithStaking(t)
+	return k, ctx
+}
+
+func setupKeeperWithStaking(t *testing.T) (Keeper, sdk.Context, *fakeStakingKeeper) {
+	t.Helper()
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount("cosmos", "cosmospub")
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, cmttypes.Header{}, false, log.NewNopLogger())
+	ir := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(ir)
+	authority := sdk.AccAddress([]byte("authority_addr_12345"))
+
+	sk := newFakeStakingKeeper()
+	k := NewKeeper(cdc, storeKey, authority, sk)
+	return k, ctx, sk
+}
+
+func TestGetParamsDefaultsWhenUnset(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	require.True(t, types.DefaultMinSelfDelegation.Equal(k.GetMinSelfDelegation(ctx)))
+}
+
+func TestParamsRoundTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	params := types.Params{MinSelfDelegation: math.NewIntWithDecimal(1000, 18)}
+
+	require.NoError(t, params.Validate())
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+	require.True(t, params.MinSelfDelegation.Equal(k.GetMinSelfDelegation(ctx)))
+}
+
+func TestQueryParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	params := types.Params{MinSelfDelegation: math.NewInt(42)}
+	k.SetParams(ctx, params)
+
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+
+	_, err = k.Params(ctx, nil)
+	require.Error(t, err)
+}
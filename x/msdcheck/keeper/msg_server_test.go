@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/msdcheck/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	lowered := types.Params{MinSelfDelegation: math.NewIntWithDecimal(1, 18)}
+
+	_, err := srv.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("bad_authority")).String(),
+		Params:    &lowered,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.authority.String()})
+	require.ErrorContains(t, err, "empty params")
+
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.authority.String(),
+		Params:    &types.Params{MinSelfDelegation: math.ZeroInt()},
+	})
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.authority.String(),
+		Params:    &lowered,
+	})
+	require.NoError(t, err)
+	require.Equal(t, lowered, k.GetParams(ctx))
+}
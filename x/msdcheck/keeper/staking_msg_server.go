@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingMsgServer wraps the staking Msg service and enforces the configured
+// minimum self-delegation on validator creation and MinSelfDelegation edits,
+// and in strict mode on operator undelegations and redelegations.
+type StakingMsgServer struct {
+	stakingtypes.MsgServer
+	stakingKeeper *stakingkeeper.Keeper
+	msdKeeper     Keeper
+}
+
+func NewStakingMsgServer(base stakingtypes.MsgServer, stakingKeeper *stakingkeeper.Keeper, msdKeeper Keeper) stakingtypes.MsgServer {
+	return &StakingMsgServer{
+		MsgServer:     base,
+		stakingKeeper: stakingKeeper,
+		msdKeeper:     msdKeeper,
+	}
+}
+
+func (m *StakingMsgServer) CreateValidator(ctx context.Context, msg *stakingtypes.MsgCreateValidator) (*stakingtypes.MsgCreateValidatorResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bondDenom, err := m.stakingKeeper.BondDenom(sdkCtx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Value.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "initial self-delegation must be in bond denom %s", bondDenom)
+	}
+
+	minSelfDelegation := m.msdKeeper.GetMinSelfDelegation(sdkCtx)
+	if msg.Value.Amount.LT(minSelfDelegation) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"initial self-delegation amount %s < minimum %s",
+			msg.Value.Amount.String(),
+			minSelfDelegation.String(),
+		)
+	}
+
+	if msg.MinSelfDelegation.LT(minSelfDelegation) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"initial self-delegation specified %s < minimum %s",
+			msg.MinSelfDelegation.String(),
+			minSelfDelegation.String(),
+		)
+	}
+
+	return m.MsgServer.CreateValidator(ctx, msg)
+}
+
+func (m *StakingMsgServer) EditValidator(ctx context.Context, msg *stakingtypes.MsgEditValidator) (*stakingtypes.MsgEditValidatorResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if _, err := m.stakingKeeper.GetValidator(sdkCtx, valAddr); err != nil {
+		return nil, err
+	}
+
+	minSelfDelegation := m.msdKeeper.GetMinSelfDelegation(sdkCtx)
+	if msg.MinSelfDelegation != nil && msg.MinSelfDelegation.LT(minSelfDelegation) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"proposed min self-delegation %s < minimum %s",
+			msg.MinSelfDelegation.String(),
+			minSelfDelegation.String(),
+		)
+	}
+
+	return m.MsgServer.EditValidator(ctx, msg)
+}
+
+func (m *StakingMsgServer) Undelegate(ctx context.Context, msg *stakingtypes.MsgUndelegate) (*stakingtypes.MsgUndelegateResponse, error) {
+	if err := m.validateSelfDelegationWithdrawal(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+	return m.MsgServer.Undelegate(ctx, msg)
+}
+
+func (m *StakingMsgServer) BeginRedelegate(ctx context.Context, msg *stakingtypes.MsgBeginRedelegate) (*stakingtypes.MsgBeginRedelegateResponse, error) {
+	if err := m.validateSelfDelegationWithdrawal(ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+	return m.MsgServer.BeginRedelegate(ctx, msg)
+}
+
+func (m *StakingMsgServer) validateSelfDelegationWithdrawal(ctx context.Context, delegator, validator string, amount math.Int) error {
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return m.msdKeeper.ValidateSelfDelegationWithdrawal(sdk.UnwrapSDKContext(ctx), delAddr, valAddr, amount)
+}
+
+var _ stakingtypes.MsgServer = (*StakingMsgServer)(nil)
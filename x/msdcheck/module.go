@@ -1,50 +1,102 @@
 package msdcheck
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/x/msdcheck/client/cli"
+	"github.com/cosmos/evm/x/msdcheck/keeper"
+	"github.com/cosmos/evm/x/msdcheck/types"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	staking "github.com/cosmos/cosmos-sdk/x/staking"
-	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
-	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-type AppModule struct {
-	staking.AppModule
-	keeper         *stakingkeeper.Keeper
-	legacySubspace stakingexported.Subspace
-}
-
-func NewAppModule(
-	cdc codec.Codec,
-	keeper *stakingkeeper.Keeper,
-	accountKeeper stakingtypes.AccountKeeper,
-	bankKeeper stakingtypes.BankKeeper,
-	ls stakingexported.Subspace,
-) AppModule {
-	return AppModule{
-		AppModule:      staking.NewAppModule(cdc, keeper, accountKeeper, bankKeeper, ls),
-		keeper:         keeper,
-		legacySubspace: ls,
+const consensusVersion = 1
+
+var (
+	_ module.AppModule        = AppModule{}
+	_ module.AppModuleBasic   = AppModuleBasic{}
+	_ module.HasABCIGenesis   = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
+
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string { return types.ModuleName }
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+func (AppModuleBasic) ConsensusVersion() uint64 { return consensusVersion }
+
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
+	return gs.Validate()
 }
 
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+
+func (AppModuleBasic) GetTxCmd() *cobra.Command { return nil }
+
+func (AppModuleBasic) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
+
+type AppModule struct {
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+func NewAppModule(k keeper.Keeper) AppModule {
+	return AppModule{AppModuleBasic: AppModuleBasic{}, keeper: k}
+}
+
+func (AppModule) Name() string { return types.ModuleName }
+
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	base := stakingkeeper.NewMsgServerImpl(am.keeper)
-	wrapped := NewMsgServer(base, am.keeper)
-	stakingtypes.RegisterMsgServer(cfg.MsgServer(), wrapped)
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
 
-	querier := stakingkeeper.Querier{Keeper: am.keeper}
-	stakingtypes.RegisterQueryServer(cfg.QueryServer(), querier)
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var gs types.GenesisState
+	cdc.MustUnmarshalJSON(data, &gs)
+	InitGenesis(ctx, am.keeper, gs)
+	return []abci.ValidatorUpdate{}
+}
 
-	m := stakingkeeper.NewMigrator(am.keeper, am.legacySubspace)
-	if err := cfg.RegisterMigration(stakingtypes.ModuleName, 1, m.Migrate1to2); err != nil {
-		panic(err)
-	}
-	if err := cfg.RegisterMigration(stakingtypes.ModuleName, 2, m.Migrate2to3); err != nil {
-		panic(err)
-	}
-	if err := cfg.RegisterMigration(stakingtypes.ModuleName, 3, m.Migrate3to4); err != nil {
-		panic(err)
-	}
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(gs)
 }
+
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(sdk.UnwrapSDKContext(ctx))
+}
+
+func (AppModule) IsAppModule() {}
+
+func (AppModule) IsOnePerModuleType() {}
@@ -0,0 +1,57 @@
+package msdcheck
+
+import (
+	"github.com/cosmos/evm/x/msdcheck/keeper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	staking "github.com/cosmos/cosmos-sdk/x/staking"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingAppModule replaces the staking AppModule so that its Msg service
+// enforces the minimum self-delegation configured in the msdcheck params.
+type StakingAppModule struct {
+	staking.AppModule
+	keeper         *stakingkeeper.Keeper
+	msdKeeper      keeper.Keeper
+	legacySubspace stakingexported.Subspace
+}
+
+func NewStakingAppModule(
+	cdc codec.Codec,
+	stakingKeeper *stakingkeeper.Keeper,
+	msdKeeper keeper.Keeper,
+	accountKeeper stakingtypes.AccountKeeper,
+	bankKeeper stakingtypes.BankKeeper,
+	ls stakingexported.Subspace,
+) StakingAppModule {
+	return StakingAppModule{
+		AppModule:      staking.NewAppModule(cdc, stakingKeeper, accountKeeper, bankKeeper, ls),
+		keeper:         stakingKeeper,
+		msdKeeper:      msdKeeper,
+		legacySubspace: ls,
+	}
+}
+
+func (am StakingAppModule) RegisterServices(cfg module.Configurator) {
+	base := stakingkeeper.NewMsgServerImpl(am.keeper)
+	wrapped := keeper.NewStakingMsgServer(base, am.keeper, am.msdKeeper)
+	stakingtypes.RegisterMsgServer(cfg.MsgServer(), wrapped)
+
+	querier := stakingkeeper.Querier{Keeper: am.keeper}
+	stakingtypes.RegisterQueryServer(cfg.QueryServer(), querier)
+
+	m := stakingkeeper.NewMigrator(am.keeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(stakingtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(stakingtypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(stakingtypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
+}
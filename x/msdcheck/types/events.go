@@ -0,0 +1,14 @@
+package types
+
+// msdcheck events
+const (
+	EventTypeSelfBondBelowFloor = "self_bond_below_floor"
+	EventTypeSelfBondRestored   = "self_bond_restored"
+	EventTypeJailBelowFloor     = "jail_below_floor"
+
+	AttributeKeyValidator         = "validator"
+	AttributeKeySelfBond          = "self_bond"
+	AttributeKeyMinSelfDelegation = "min_self_delegation"
+	AttributeKeySinceHeight       = "since_height"
+	AttributeKeyJailHeight        = "jail_height"
+)
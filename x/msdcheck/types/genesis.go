@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:               DefaultParams(),
+		BelowFloorValidators: []BelowFloorValidator{},
+	}
+}
+
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if !gs.Params.StrictMode && len(gs.BelowFloorValidators) > 0 {
+		return fmt.Errorf("below_floor_validators must be empty when strict_mode is off")
+	}
+
+	seen := make(map[string]struct{}, len(gs.BelowFloorValidators))
+	for _, v := range gs.BelowFloorValidators {
+		if _, err := sdk.ValAddressFromBech32(v.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid below floor validator address %q: %w", v.ValidatorAddress, err)
+		}
+		if _, ok := seen[v.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate below floor validator: %s", v.ValidatorAddress)
+		}
+		seen[v.ValidatorAddress] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator_1")).String()
+
+	testCases := []struct {
+		name        string
+		genesis     GenesisState
+		expectError bool
+	}{
+		{
+			name:        "default genesis",
+			genesis:     *DefaultGenesisState(),
+			expectError: false,
+		},
+		{
+			name:        "custom positive minimum",
+			genesis:     GenesisState{Params: Params{MinSelfDelegation: math.NewInt(1)}},
+			expectError: false,
+		},
+		{
+			name:        "zero minimum is invalid",
+			genesis:     GenesisState{Params: Params{MinSelfDelegation: math.ZeroInt()}},
+			expectError: true,
+		},
+		{
+			name:        "negative minimum is invalid",
+			genesis:     GenesisState{Params: Params{MinSelfDelegation: math.NewInt(-1)}},
+			expectError: true,
+		},
+		{
+			name: "below floor validators in strict mode",
+			genesis: GenesisState{
+				Params:               Params{MinSelfDelegation: math.NewInt(1), StrictMode: true},
+				BelowFloorValidators: []BelowFloorValidator{{ValidatorAddress: valAddr, SinceHeight: 10}},
+			},
+			expectError: false,
+		},
+		{
+			name: "below floor validators without strict mode",
+			genesis: GenesisState{
+				Params:               Params{MinSelfDelegation: math.NewInt(1)},
+				BelowFloorValidators: []BelowFloorValidator{{ValidatorAddress: valAddr, SinceHeight: 10}},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate below floor validator",
+			genesis: GenesisState{
+				Params: Params{MinSelfDelegation: math.NewInt(1), StrictMode: true},
+				BelowFloorValidators: []BelowFloorValidator{
+					{ValidatorAddress: valAddr, SinceHeight: 10},
+					{ValidatorAddress: valAddr, SinceHeight: 11},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name:        "unset minimum is invalid",
+			genesis:     GenesisState{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package types
+
+const (
+	ModuleName = "msdcheck"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+)
+
+const (
+	prefixParams = iota + 1
+	prefixBelowFloorSince
+)
+
+var (
+	KeyParams                = []byte{prefixParams}
+	KeyPrefixBelowFloorSince = []byte{prefixBelowFloorSince}
+)
+
+// GetBelowFloorSinceKey returns the key of the height at which the validator
+// fell below the minimum self-delegation.
+func GetBelowFloorSinceKey(valAddr []byte) []byte {
+	return append(append([]byte{}, KeyPrefixBelowFloorSince...), valAddr...)
+}
@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/msdcheck/v1/msdcheck.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the msdcheck module parameters.
+type Params struct {
+	// min_self_delegation is the minimum self-delegation, in the bond denom,
+	// that validators must create with and may propose as their
+	// MinSelfDelegation.
+	MinSelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"min_self_delegation"`
+	// strict_mode rejects undelegations and redelegations that would leave an
+	// operator's self-delegation below min_self_delegation, and jails bonded
+	// validators whose self-delegation stays below it for grace_period_blocks.
+	StrictMode bool `protobuf:"varint,2,opt,name=strict_mode,json=strictMode,proto3" json:"strict_mode,omitempty"`
+	// grace_period_blocks is the number of blocks a bonded validator may stay
+	// below min_self_delegation before strict mode jails it.
+	GracePeriodBlocks uint64 `protobuf:"varint,3,opt,name=grace_period_blocks,json=gracePeriodBlocks,proto3" json:"grace_period_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e701eb8b96fad096, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetStrictMode() bool {
+	if m != nil {
+		return m.StrictMode
+	}
+	return false
+}
+
+func (m *Params) GetGracePeriodBlocks() uint64 {
+	if m != nil {
+		return m.GracePeriodBlocks
+	}
+	return 0
+}
+
+// BelowFloorValidator records a bonded validator whose self-delegation is
+// below min_self_delegation while strict mode is on.
+type BelowFloorValidator struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// since_height is the height at which the validator was first seen below
+	// min_self_delegation.
+	SinceHeight uint64 `protobuf:"varint,2,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+}
+
+func (m *BelowFloorValidator) Reset()         { *m = BelowFloorValidator{} }
+func (m *BelowFloorValidator) String() string { return proto.CompactTextString(m) }
+func (*BelowFloorValidator) ProtoMessage()    {}
+func (*BelowFloorValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e701eb8b96fad096, []int{1}
+}
+func (m *BelowFloorValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BelowFloorValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BelowFloorValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BelowFloorValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BelowFloorValidator.Merge(m, src)
+}
+func (m *BelowFloorValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *BelowFloorValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_BelowFloorValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BelowFloorValidator proto.InternalMessageInfo
+
+func (m *BelowFloorValidator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BelowFloorValidator) GetSinceHeight() uint64 {
+	if m != nil {
+		return m.SinceHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.evm.msdcheck.v1.Params")
+	proto.RegisterType((*BelowFloorValidator)(nil), "cosmos.evm.msdcheck.v1.BelowFloorValidator")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/evm/msdcheck/v1/msdcheck.proto", fileDescriptor_e701eb8b96fad096)
+}
+
+var fileDescriptor_e701eb8b96fad096 = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x6a, 0x14, 0x41,
+	0x10, 0x86, 0xb7, 0x35, 0x04, 0xed, 0x78, 0x70, 0x67, 0x55, 0xd6, 0x80, 0xb3, 0x9b, 0x80, 0xb2,
+	0x08, 0x99, 0x31, 0xf8, 0x02, 0x3a, 0x88, 0x98, 0x83, 0x12, 0x26, 0xe0, 0xc1, 0x4b, 0xdb, 0xdb,
+	0x5d, 0x99, 0x69, 0x76, 0xba, 0x6b, 0xe9, 0x6e, 0x47, 0x7d, 0x03, 0x8f, 0x3e, 0x86, 0x47, 0x0f,
+	0xb9, 0xf9, 0x02, 0x39, 0x86, 0x9c, 0xc4, 0x43, 0x90, 0xdd, 0x83, 0xaf, 0x21, 0x76, 0x8f, 0xa3,
+	0xe4, 0x32, 0x54, 0x7d, 0xff, 0x5f, 0x35, 0xd5, 0xfc, 0xf4, 0xbe, 0x40, 0xa7, 0xd1, 0xe5, 0xd0,
+	0xea, 0x5c, 0x3b, 0x29, 0x6a, 0x10, 0x8b, 0xbc, 0xdd, 0xef, 0xeb, 0x6c, 0x69, 0xd1, 0x63, 0x72,
+	0x27, 0xda, 0x32, 0x68, 0x75, 0xd6, 0x4b, 0xed, 0xfe, 0xf6, 0x90, 0x6b, 0x65, 0x30, 0x0f, 0xdf,
+	0x68, 0xdd, 0xbe, 0x1b, 0xad, 0x2c, 0x74, 0x79, 0x37, 0x17, 0xa5, 0x5b, 0x15, 0x56, 0x18, 0xf9,
+	0x9f, 0x2a, 0xd2, 0xdd, 0x6f, 0x84, 0x6e, 0x1e, 0x72, 0xcb, 0xb5, 0x4b, 0xde, 0xd2, 0x91, 0x56,
+	0x86, 0x39, 0x68, 0x8e, 0x99, 0x84, 0x06, 0x2a, 0xee, 0x15, 0x9a, 0x31, 0x99, 0x92, 0xd9, 0xf5,
+	0xe2, 0xd1, 0xe9, 0xc5, 0x64, 0xf0, 0xe3, 0x62, 0x72, 0x3b, 0xee, 0x74, 0x72, 0x91, 0x29, 0xcc,
+	0x35, 0xf7, 0x75, 0x76, 0x60, 0xfc, 0xf9, 0xc9, 0x1e, 0xed, 0x7e, 0x76, 0x60, 0xfc, 0x97, 0x5f,
+	0x5f, 0x1f, 0x92, 0x72, 0xa8, 0x95, 0x39, 0x82, 0xe6, 0xf8, 0x59, 0xbf, 0x2a, 0x99, 0xd0, 0x2d,
+	0xe7, 0xad, 0x12, 0x9e, 0x69, 0x94, 0x30, 0xbe, 0x32, 0x25, 0xb3, 0x6b, 0x25, 0x8d, 0xe8, 0x25,
+	0x4a, 0x48, 0x32, 0x3a, 0xaa, 0x2c, 0x17, 0xc0, 0x96, 0x60, 0x15, 0x4a, 0x36, 0x6f, 0x50, 0x2c,
+	0xdc, 0xf8, 0xea, 0x94, 0xcc, 0x36, 0xca, 0x61, 0x90, 0x0e, 0x83, 0x52, 0x04, 0x61, 0xf7, 0x13,
+	0xa1, 0xa3, 0x02, 0x1a, 0x7c, 0xff, 0xbc, 0x41, 0xb4, 0xaf, 0x79, 0xa3, 0x24, 0xf7, 0x68, 0x93,
+	0x57, 0x74, 0xd8, 0xfe, 0x6d, 0x18, 0x97, 0xd2, 0x82, 0x73, 0xdd, 0x43, 0x76, 0xce, 0x4f, 0xf6,
+	0xee, 0x75, 0xb7, 0xf6, 0x03, 0x4f, 0xa3, 0xe5, 0xc8, 0x5b, 0x65, 0xaa, 0xf2, 0x66, 0x7b, 0x89,
+	0x27, 0x3b, 0xf4, 0x86, 0x53, 0x46, 0x00, 0xab, 0x41, 0x55, 0xb5, 0x0f, 0x97, 0x6f, 0x94, 0x5b,
+	0x81, 0xbd, 0x08, 0xa8, 0x78, 0x72, 0xba, 0x4a, 0xc9, 0xd9, 0x2a, 0x25, 0x3f, 0x57, 0x29, 0xf9,
+	0xbc, 0x4e, 0x07, 0x67, 0xeb, 0x74, 0xf0, 0x7d, 0x9d, 0x0e, 0xde, 0x3c, 0xa8, 0x94, 0xaf, 0xdf,
+	0xcd, 0x33, 0x81, 0x3a, 0xff, 0x2f, 0xf0, 0x0f, 0xff, 0x22, 0xf7, 0x1f, 0x97, 0xe0, 0xe6, 0x9b,
+	0x21, 0x91, 0xc7, 0xbf, 0x07, 0x00, 0xfe, 0x1e, 0x8f, 0xa8, 0x16, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GracePeriodBlocks != 0 {
+		i = encodeVarintMsdcheck(dAtA, i, uint64(m.GracePeriodBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StrictMode {
+		i--
+		if m.StrictMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsdcheck(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BelowFloorValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BelowFloorValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BelowFloorValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		i = encodeVarintMsdcheck(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsdcheck(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsdcheck(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsdcheck(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovMsdcheck(uint64(l))
+	if m.StrictMode {
+		n += 2
+	}
+	if m.GracePeriodBlocks != 0 {
+		n += 1 + sovMsdcheck(uint64(m.GracePeriodBlocks))
+	}
+	return n
+}
+
+func (m *BelowFloorValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsdcheck(uint64(l))
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovMsdcheck(uint64(m.SinceHeight))
+	}
+	return n
+}
+
+func sovMsdcheck(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsdcheck(x uint64) (n int) {
+	return sovMsdcheck(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsdcheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsdcheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsdcheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsdcheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsdcheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictMode = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodBlocks", wireType)
+			}
+			m.GracePeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsdcheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsdcheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsdcheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BelowFloorValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsdcheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BelowFloorValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BelowFloorValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsdcheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsdcheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsdcheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsdcheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsdcheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsdcheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsdcheck(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsdcheck
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsdcheck
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsdcheck
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsdcheck
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsdcheck
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsdcheck
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsdcheck        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsdcheck          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsdcheck = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultMinSelfDelegation is the minimum self-delegation enforced before it
+// became a module parameter.
+var DefaultMinSelfDelegation = math.NewIntWithDecimal(888888, 18)
+
+// DefaultGracePeriodBlocks is roughly one day of 6 second blocks.
+const DefaultGracePeriodBlocks uint64 = 14_400
+
+func DefaultParams() Params {
+	return Params{
+		MinSelfDelegation: DefaultMinSelfDelegation,
+		StrictMode:        false,
+		GracePeriodBlocks: DefaultGracePeriodBlocks,
+	}
+}
+
+func (p Params) Validate() error {
+	if p.MinSelfDelegation.IsNil() || !p.MinSelfDelegation.IsPositive() {
+		return fmt.Errorf("min_self_delegation must be positive, got %s", p.MinSelfDelegation)
+	}
+	return nil
+}
@@ -34,11 +34,53 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "ClaimableRewards",
+					Use:       "claimable-rewards [validator-address]",
+					Short:     "Query the outstanding rewards of a validator across epochs and their total",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "RewardsCommission",
+					Use:       "rewards-commission [validator-address]",
+					Short:     "Query the current and next-epoch valrewards commission of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "PayoutSettings",
+					Use:       "payout-settings [validator-address]",
+					Short:     "Query the withdraw address and auto-compound flag of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "DelegatorRewards",
+					Use:       "delegator-rewards [delegator]",
+					Short:     "Query the valrewards a delegator can claim across epochs and their total",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+				},
 				{
 					RpcMethod: "RewardsPool",
 					Use:       "rewards-pool",
 					Short:     "Query the rewards module pool balance",
 				},
+				{
+					RpcMethod: "OutstandingLiabilities",
+					Use:       "outstanding-liabilities",
+					Short:     "Query the unclaimed rewards owed by the module against the rewards pool balance",
+				},
+				{
+					RpcMethod: "RewardsRunway",
+					Use:       "rewards-runway",
+					Short:     "Query how many epochs the unallocated rewards pool can fund at the current settings",
+				},
 			},
 		},
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"cosmossdk.io/math"
 	"github.com/spf13/cobra"
 
 	vrtypes "github.com/cosmos/evm/x/valrewards/types"
@@ -27,8 +28,16 @@ func NewTxCmd() *cobra.Command {
 		NewSetBlocksInEpochCmd(),
 		NewSetRewardsPerEpochCmd(),
 		NewSetRewardingPausedCmd(),
+		NewSetProposerBonusPointsCmd(),
+		NewSetSlashForfeitRateCmd(),
+		NewSetClaimWindowEpochsCmd(),
 		NewDepositRewardsPoolCmd(),
 		NewClaimRewardsCmd(),
+		NewClaimRewardsRangeCmd(),
+		NewSetRewardsCommissionCmd(),
+		NewSetWithdrawAddressCmd(),
+		NewSetAutoCompoundCmd(),
+		NewClaimDelegatorRewardsCmd(),
 	)
 
 	return txCmd
@@ -159,6 +168,97 @@ func NewSetRewardingPausedCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSetProposerBonusPointsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-proposer-bonus-points [proposer-bonus-points]",
+		Short: "Stage a new proposer_bonus_points value for the next epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposerBonusPoints, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposer-bonus-points: %w", err)
+			}
+
+			msg := &vrtypes.MsgSetProposerBonusPoints{
+				Signer:              clientCtx.GetFromAddress().String(),
+				ProposerBonusPoints: proposerBonusPoints,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetClaimWindowEpochsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-claim-window-epochs [claim-window-epochs]",
+		Short: "Stage a new claim_window_epochs value for the next epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			claimWindowEpochs, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid claim-window-epochs: %w", err)
+			}
+
+			msg := &vrtypes.MsgSetClaimWindowEpochs{
+				Signer:            clientCtx.GetFromAddress().String(),
+				ClaimWindowEpochs: claimWindowEpochs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetSlashForfeitRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-slash-forfeit-rate [slash-forfeit-rate]",
+		Short: "Stage a new slash_forfeit_rate value for the next epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &vrtypes.MsgSetSlashForfeitRate{
+				Signer:           clientCtx.GetFromAddress().String(),
+				SlashForfeitRate: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewClaimRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim [validator-address] [epoch]",
@@ -192,3 +292,205 @@ func NewClaimRewardsCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+const (
+	flagStartEpoch = "start-epoch"
+	flagEndEpoch   = "end-epoch"
+	flagMaxEpochs  = "max-epochs"
+)
+
+func NewClaimRewardsRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-range [validator-address]",
+		Short: "Trigger a validator rewards claim for every outstanding epoch in a range",
+		Long: fmt.Sprintf(`Claims the outstanding rewards of every epoch between --start-epoch and --end-epoch (inclusive), oldest first.
+An --end-epoch of 0 leaves the range open. At most --max-epochs epochs (up to %d) are paid per transaction; send it again to continue.`, vrtypes.MaxClaimEpochsPerCall),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := cmd.Flags().GetUint64(flagStartEpoch)
+			if err != nil {
+				return err
+			}
+			endEpoch, err := cmd.Flags().GetUint64(flagEndEpoch)
+			if err != nil {
+				return err
+			}
+			maxEpochs, err := cmd.Flags().GetUint32(flagMaxEpochs)
+			if err != nil {
+				return err
+			}
+
+			msg := &vrtypes.MsgClaimRewardsRange{
+				ValidatorOperator: args[0],
+				Requester:         cliCtx.GetFromAddress().String(),
+				StartEpoch:        startEpoch,
+				EndEpoch:          endEpoch,
+				MaxEpochs:         maxEpochs,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartEpoch, 0, "First epoch to claim")
+	cmd.Flags().Uint64(flagEndEpoch, 0, "Last epoch to claim (0 for no upper bound)")
+	cmd.Flags().Uint32(flagMaxEpochs, 0, "Maximum number of epochs to pay (0 for the module limit)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetRewardsCommissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rewards-commission [commission-rate]",
+		Short: "Stage the valrewards commission of the sender's validator for the next epoch",
+		Long: `Opts the validator operated by the sender into passing its valrewards through to its delegators.
+The operator keeps commission-rate of every epoch reward and the rest is split pro-rata to delegation shares.
+A commission-rate of 1 opts the validator back out. The new rate applies from the next epoch.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rate, err := math.LegacyNewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid commission-rate: %w", err)
+			}
+
+			msg := &vrtypes.MsgSetRewardsCommission{
+				ValidatorOperator: clientCtx.GetFromAddress().String(),
+				CommissionRate:    rate,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetWithdrawAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-address [withdraw-address]",
+		Short: "Set the account that receives the valrewards claimed for the sender's validator",
+		Long: `Sets the account that receives the valrewards claimed for the validator operated by the sender.
+Setting it to the operator account restores the default.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &vrtypes.MsgSetWithdrawAddress{
+				ValidatorOperator: clientCtx.GetFromAddress().String(),
+				WithdrawAddress:   args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [enabled]",
+		Short: "Turn auto-compounding of the sender's validator rewards on or off",
+		Long: `When enabled, the operator share of every completed epoch is delegated back to the validator
+operated by the sender at the epoch rollover instead of waiting to be claimed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid enabled: %w", err)
+			}
+
+			msg := &vrtypes.MsgSetAutoCompound{
+				ValidatorOperator: clientCtx.GetFromAddress().String(),
+				Enabled:           enabled,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewClaimDelegatorRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-delegator-rewards [delegator-address]",
+		Short: "Trigger a delegator rewards claim for every outstanding epoch in a range",
+		Long: fmt.Sprintf(`Claims the rewards a delegator earned through every validator between --start-epoch and --end-epoch (inclusive), oldest first.
+An --end-epoch of 0 leaves the range open. At most --max-epochs epochs (up to %d) are paid per transaction; send it again to continue.`, vrtypes.MaxClaimEpochsPerCall),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := cmd.Flags().GetUint64(flagStartEpoch)
+			if err != nil {
+				return err
+			}
+			endEpoch, err := cmd.Flags().GetUint64(flagEndEpoch)
+			if err != nil {
+				return err
+			}
+			maxEpochs, err := cmd.Flags().GetUint32(flagMaxEpochs)
+			if err != nil {
+				return err
+			}
+
+			msg := &vrtypes.MsgClaimDelegatorRewards{
+				Delegator:  args[0],
+				Requester:  cliCtx.GetFromAddress().String(),
+				StartEpoch: startEpoch,
+				EndEpoch:   endEpoch,
+				MaxEpochs:  maxEpochs,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartEpoch, 0, "First epoch to claim")
+	cmd.Flags().Uint64(flagEndEpoch, 0, "Last epoch to claim (0 for no upper bound)")
+	cmd.Flags().Uint32(flagMaxEpochs, 0, "Maximum number of epochs to pay (0 for the module limit)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,6 +3,8 @@ package valrewards
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/evm/x/valrewards/keeper"
 	"github.com/cosmos/evm/x/valrewards/types"
 
@@ -25,6 +27,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	k.SetNextRewardSettings(ctx, data.NextRewardSettings)
 	k.SetEpochState(ctx, data.EpochState)
 	k.SetEpochToPay(ctx, data.EpochToPay)
+	k.SetNextEpochToExpire(ctx, data.NextEpochToExpire)
 
 	for _, entry := range data.ValidatorPoints {
 		k.SetValidatorRewardPoints(ctx, entry.Epoch, entry.ValidatorAddress, entry.EpochPoints)
@@ -33,6 +36,40 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	for _, entry := range data.ValidatorOutstandingRewards {
 		k.SetValidatorOutstandingReward(ctx, entry.Epoch, entry.ValidatorAddress, entry.Amount)
 	}
+
+	for _, entry := range data.RewardsCommissions {
+		k.SetValidatorRewardsCommission(ctx, entry.ValidatorAddress, entry.CommissionRate)
+	}
+
+	for _, entry := range data.NextRewardsCommissions {
+		k.SetNextValidatorRewardsCommission(ctx, entry.ValidatorAddress, entry.CommissionRate)
+	}
+
+	for _, entry := range data.DelegatorOutstandingRewards {
+		delegator := sdk.MustAccAddressFromBech32(entry.DelegatorAddress)
+		k.SetDelegatorOutstandingReward(ctx, delegator, entry.Epoch, entry.ValidatorAddress, entry.Amount)
+	}
+
+	for _, entry := range data.DelegatorRewardPools {
+		k.SetDelegatorRewardPool(ctx, entry.Epoch, entry.ValidatorAddress, entry.RewardPerShare, entry.Remaining)
+	}
+
+	for _, entry := range data.DelegationSettledEpochs {
+		delegator := sdk.MustAccAddressFromBech32(entry.DelegatorAddress)
+		k.SetDelegationSettledEpoch(ctx, delegator, entry.ValidatorAddress, entry.Epoch)
+	}
+
+	for _, entry := range data.SlashedValidators {
+		k.SetValidatorSlashed(ctx, entry.Epoch, entry.ValidatorAddress)
+	}
+
+	for _, entry := range data.WithdrawAddresses {
+		k.SetValidatorWithdrawAddress(ctx, entry.ValidatorAddress, sdk.MustAccAddressFromBech32(entry.WithdrawAddress))
+	}
+
+	for _, validatorAddress := range data.AutoCompoundValidators {
+		k.SetValidatorAutoCompound(ctx, validatorAddress, true)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
@@ -42,6 +79,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	gs.NextRewardSettings = k.GetNextRewardSettings(ctx)
 	gs.EpochState = k.GetEpochState(ctx)
 	gs.EpochToPay = k.GetEpochToPay(ctx)
+	gs.NextEpochToExpire = k.GetNextEpochToExpire(ctx)
 
 	k.IterateAllValidatorsPoints(ctx, func(epoch uint64, validatorAddress string, points uint64) bool {
 		gs.ValidatorPoints = append(gs.ValidatorPoints, types.GenesisValidatorPoint{
@@ -65,5 +103,71 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	k.IterateValidatorRewardsCommissions(ctx, func(validatorAddress string, rate math.LegacyDec) bool {
+		gs.RewardsCommissions = append(gs.RewardsCommissions, types.GenesisRewardsCommission{
+			ValidatorAddress: validatorAddress,
+			CommissionRate:   rate,
+		})
+		return false
+	})
+
+	k.IterateNextValidatorRewardsCommissions(ctx, func(validatorAddress string, rate math.LegacyDec) bool {
+		gs.NextRewardsCommissions = append(gs.NextRewardsCommissions, types.GenesisRewardsCommission{
+			ValidatorAddress: validatorAddress,
+			CommissionRate:   rate,
+		})
+		return false
+	})
+
+	k.IterateAllDelegatorsOutstandingRewards(ctx, func(delegator sdk.AccAddress, epoch uint64, validatorAddress string, amount sdk.Coin) bool {
+		gs.DelegatorOutstandingRewards = append(gs.DelegatorOutstandingRewards, types.GenesisDelegatorOutstandingReward{
+			Epoch:            epoch,
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validatorAddress,
+			Amount:           amount,
+		})
+		return false
+	})
+
+	k.IterateAllDelegatorRewardPools(ctx, func(epoch uint64, validatorAddress string, rewardPerShare math.LegacyDec, remaining sdk.Coin) bool {
+		gs.DelegatorRewardPools = append(gs.DelegatorRewardPools, types.GenesisDelegatorRewardPool{
+			Epoch:            epoch,
+			ValidatorAddress: validatorAddress,
+			RewardPerShare:   rewardPerShare,
+			Remaining:        remaining,
+		})
+		return false
+	})
+
+	k.IterateDelegationSettledEpochs(ctx, func(delegator sdk.AccAddress, validatorAddress string, epoch uint64) bool {
+		gs.DelegationSettledEpochs = append(gs.DelegationSettledEpochs, types.GenesisDelegationSettledEpoch{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validatorAddress,
+			Epoch:            epoch,
+		})
+		return false
+	})
+
+	k.IterateAllSlashedValidators(ctx, func(epoch uint64, validatorAddress string) bool {
+		gs.SlashedValidators = append(gs.SlashedValidators, types.GenesisSlashedValidator{
+			Epoch:            epoch,
+			ValidatorAddress: validatorAddress,
+		})
+		return false
+	})
+
+	k.IterateWithdrawAddresses(ctx, func(validatorAddress string, withdrawAddress sdk.AccAddress) bool {
+		gs.WithdrawAddresses = append(gs.WithdrawAddresses, types.GenesisWithdrawAddress{
+			ValidatorAddress: validatorAddress,
+			WithdrawAddress:  withdrawAddress.String(),
+		})
+		return false
+	})
+
+	k.IterateAutoCompoundValidators(ctx, func(validatorAddress string) bool {
+		gs.AutoCompoundValidators = append(gs.AutoCompoundValidators, validatorAddress)
+		return false
+	})
+
 	return gs
 }
@@ -58,6 +58,28 @@ func TestValidateOutstandingRewardsFunding(t *testing.T) {
 			pool:        sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(30)),
 			expectError: true,
 		},
+		{
+			name: "unsettled delegator pools count as outstanding",
+			genesis: vrtypes.GenesisState{
+				ValidatorOutstandingRewards: []vrtypes.GenesisValidatorOutstandingReward{
+					{
+						Epoch:            1,
+						ValidatorAddress: validAddress,
+						Amount:           sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(25)),
+					},
+				},
+				DelegatorRewardPools: []vrtypes.GenesisDelegatorRewardPool{
+					{
+						Epoch:            1,
+						ValidatorAddress: validAddress,
+						RewardPerShare:   sdkmath.LegacyNewDec(1),
+						Remaining:        sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(10)),
+					},
+				},
+			},
+			pool:        sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(30)),
+			expectError: true,
+		},
 		{
 			name: "foreign denom outstanding reward",
 			genesis: vrtypes.GenesisState{
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+)
+
+// IterateValidatorOutstandingEpochs walks the epochs in [startEpoch, endEpoch]
+// where the validator has a non-zero outstanding reward, oldest first. An
+// endEpoch of 0 leaves the range open.
+func (k Keeper) IterateValidatorOutstandingEpochs(
+	ctx sdk.Context,
+	validatorAddress string,
+	startEpoch, endEpoch uint64,
+	cb func(epoch uint64, amount sdk.Coin) (stop bool),
+) {
+	if endEpoch != 0 && endEpoch < startEpoch {
+		return
+	}
+
+	validatorAddressBytes := []byte(validatorAddress)
+	listKey := vrtypes.GetValidatorOutstandingEpochListKey(validatorAddressBytes)
+	start := vrtypes.GetValidatorOutstandingEpochKey(validatorAddressBytes, startEpoch)
+	end := storetypes.PrefixEndBytes(listKey)
+	if endEpoch != 0 && endEpoch != ^uint64(0) {
+		end = vrtypes.GetValidatorOutstandingEpochKey(validatorAddressBytes, endEpoch+1)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epoch := sdk.BigEndianToUint64(iterator.Key()[len(listKey):])
+		amount := k.GetValidatorOutstandingReward(ctx, epoch, validatorAddress)
+		if cb(epoch, amount) {
+			break
+		}
+	}
+}
+
+// GetClaimableRewards returns the non-zero outstanding rewards of the
+// validator in [startEpoch, endEpoch] and their sum.
+func (k Keeper) GetClaimableRewards(ctx sdk.Context, validatorAddress string, startEpoch, endEpoch uint64) ([]vrtypes.EpochReward, sdk.Coin) {
+	rewards := []vrtypes.EpochReward{}
+	total := sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt())
+	k.IterateValidatorOutstandingEpochs(ctx, validatorAddress, startEpoch, endEpoch, func(epoch uint64, amount sdk.Coin) bool {
+		rewards = append(rewards, vrtypes.EpochReward{Epoch: epoch, Amount: amount})
+		total = total.Add(amount)
+		return false
+	})
+	return rewards, total
+}
+
+// ClaimValidatorRewardsRange pays the withdraw address of the validator every
+// non-zero outstanding reward in [startEpoch, endEpoch], oldest first, stopping
+// after maxEpochs epochs. A maxEpochs of 0 or above MaxClaimEpochsPerCall is
+// capped to MaxClaimEpochsPerCall. hasMore reports whether claimable epochs
+// remain in the range after this call.
+func (k Keeper) ClaimValidatorRewardsRange(
+	ctx sdk.Context,
+	operator sdk.AccAddress,
+	startEpoch, endEpoch uint64,
+	maxEpochs uint32,
+) (rewards []vrtypes.EpochReward, total sdk.Coin, hasMore bool, err error) {
+	if err := k.ensureValidatorOperator(ctx, operator); err != nil {
+		return nil, sdk.Coin{}, false, err
+	}
+	if maxEpochs == 0 || maxEpochs > vrtypes.MaxClaimEpochsPerCall {
+		maxEpochs = vrtypes.MaxClaimEpochsPerCall
+	}
+
+	validatorAddress := sdk.ValAddress(operator.Bytes()).String()
+	total = sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt())
+	k.IterateValidatorOutstandingEpochs(ctx, validatorAddress, startEpoch, endEpoch, func(epoch uint64, amount sdk.Coin) bool {
+		if len(rewards) == int(maxEpochs) {
+			hasMore = true
+			return true
+		}
+		rewards = append(rewards, vrtypes.EpochReward{Epoch: epoch, Amount: amount})
+		total = total.Add(amount)
+		return false
+	})
+
+	if len(rewards) == 0 {
+		return nil, sdk.Coin{}, false, errors.New(vrtypes.ErrNoOutstandingBalance)
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(vrtypes.ModuleName)
+	moduleBalance := k.bankKeeper.GetBalance(ctx, moduleAddr, evmtypes.DefaultEVMDenom)
+	if moduleBalance.IsLT(total) {
+		return nil, sdk.Coin{}, false, errors.New(vrtypes.ErrInsufficientRewardsBalance)
+	}
+
+	for _, reward := range rewards {
+		k.SetValidatorOutstandingReward(ctx, reward.Epoch, validatorAddress, sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt()))
+	}
+
+	recipient := k.GetWithdrawAddress(ctx, validatorAddress)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, vrtypes.ModuleName, recipient, sdk.NewCoins(total)); err != nil {
+		return nil, sdk.Coin{}, false, err
+	}
+
+	for _, reward := range rewards {
+		emitClaimRewardsEvent(ctx, validatorAddress, recipient, reward.Epoch, reward.Amount)
+	}
+	return rewards, total, hasMore, nil
+}
+
+func emitClaimRewardsEvent(ctx sdk.Context, validatorAddress string, recipient sdk.AccAddress, epoch uint64, amount sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeClaimRewards,
+			sdk.NewAttribute(vrtypes.AttributeKeyValidator, validatorAddress),
+			sdk.NewAttribute(vrtypes.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(vrtypes.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+	vrutils "github.com/cosmos/evm/x/valrewards/utils"
+)
+
+// GetValidatorRewardsCommission returns the share of the epoch rewards the
+// validator operator keeps. Validators that have not opted into delegator
+// pass-through keep everything, so the rate is 1 and found is false.
+func (k Keeper) GetValidatorRewardsCommission(ctx sdk.Context, validatorAddress string) (rate math.LegacyDec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(vrtypes.GetRewardsCommissionKey([]byte(validatorAddress)))
+	if bz == nil {
+		return math.LegacyOneDec(), false
+	}
+	return decodeCommissionRate(bz), true
+}
+
+// GetNextValidatorRewardsCommission returns the commission rate that applies
+// to the validator from the next epoch.
+func (k Keeper) GetNextValidatorRewardsCommission(ctx sdk.Context, validatorAddress string) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(vrtypes.GetNextRewardsCommissionKey([]byte(validatorAddress)))
+	if bz == nil {
+		rate, _ := k.GetValidatorRewardsCommission(ctx, validatorAddress)
+		return rate
+	}
+	return decodeCommissionRate(bz)
+}
+
+// SetValidatorRewardsCommission stores the commission rate of the epoch
+// currently accumulating points. A rate of 1 removes the validator from
+// delegator pass-through.
+func (k Keeper) SetValidatorRewardsCommission(ctx sdk.Context, validatorAddress string, rate math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	key := vrtypes.GetRewardsCommissionKey([]byte(validatorAddress))
+	if rate.Equal(math.LegacyOneDec()) {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, encodeCommissionRate(rate))
+}
+
+// SetNextValidatorRewardsCommission stages the commission rate for the next
+// epoch.
+func (k Keeper) SetNextValidatorRewardsCommission(ctx sdk.Context, validatorAddress string, rate math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(vrtypes.GetNextRewardsCommissionKey([]byte(validatorAddress)), encodeCommissionRate(rate))
+}
+
+// IterateValidatorRewardsCommissions walks the active commission rates of the
+// validators that opted into delegator pass-through.
+func (k Keeper) IterateValidatorRewardsCommissions(ctx sdk.Context, cb func(validatorAddress string, rate math.LegacyDec) (stop bool)) {
+	k.iterateCommissions(ctx, vrtypes.KeyPrefixRewardsCommission, cb)
+}
+
+// IterateNextValidatorRewardsCommissions walks the commission rates staged for
+// the next epoch.
+func (k Keeper) IterateNextValidatorRewardsCommissions(ctx sdk.Context, cb func(validatorAddress string, rate math.LegacyDec) (stop bool)) {
+	k.iterateCommissions(ctx, vrtypes.KeyPrefixNextRewardsCommission, cb)
+}
+
+func (k Keeper) iterateCommissions(ctx sdk.Context, prefix []byte, cb func(validatorAddress string, rate math.LegacyDec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validatorAddress := string(iterator.Key()[len(prefix):])
+		if cb(validatorAddress, decodeCommissionRate(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// promoteNextRewardsCommissions activates the staged commission rates at the
+// epoch boundary, after the completed epoch has been paid.
+func (k Keeper) promoteNextRewardsCommissions(ctx sdk.Context) {
+	staged := make(map[string]math.LegacyDec)
+	var order []string
+	k.IterateNextValidatorRewardsCommissions(ctx, func(validatorAddress string, rate math.LegacyDec) bool {
+		staged[validatorAddress] = rate
+		order = append(order, validatorAddress)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, validatorAddress := range order {
+		k.SetValidatorRewardsCommission(ctx, validatorAddress, staged[validatorAddress])
+		store.Delete(vrtypes.GetNextRewardsCommissionKey([]byte(validatorAddress)))
+	}
+}
+
+func (k Keeper) SetDelegatorOutstandingReward(ctx sdk.Context, delegator sdk.AccAddress, epoch uint64, validatorAddress string, amount sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := vrtypes.GetDelegatorOutstandingKey(delegator, epoch, []byte(validatorAddress))
+	indexKey := vrtypes.GetEpochDelegatorOutstandingKey(epoch, delegator, []byte(validatorAddress))
+	k.adjustOutstandingLiabilities(ctx, vrutils.DecodeCoin(store.Get(key)).Amount, amount.Amount)
+	if amount.IsZero() {
+		store.Delete(key)
+		store.Delete(indexKey)
+		return
+	}
+	store.Set(key, vrutils.EncodeCoin(amount))
+	store.Set(indexKey, []byte{})
+}
+
+func (k Keeper) GetDelegatorOutstandingReward(ctx sdk.Context, delegator sdk.AccAddress, epoch uint64, validatorAddress string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(vrtypes.GetDelegatorOutstandingKey(delegator, epoch, []byte(validatorAddress)))
+	return vrutils.DecodeCoin(bz)
+}
+
+// IterateDelegatorOutstandingRewards walks the rewards a delegator can claim
+// in [startEpoch, endEpoch], oldest first. An endEpoch of 0 leaves the range
+// open.
+func (k Keeper) IterateDelegatorOutstandingRewards(
+	ctx sdk.Context,
+	delegator sdk.AccAddress,
+	startEpoch, endEpoch uint64,
+	cb func(epoch uint64, validatorAddress string, amount sdk.Coin) (stop bool),
+) {
+	if endEpoch != 0 && endEpoch < startEpoch {
+		return
+	}
+
+	listKey := vrtypes.GetDelegatorOutstandingListKey(delegator)
+	start := vrtypes.GetDelegatorOutstandingEpochKey(delegator, startEpoch)
+	end := storetypes.PrefixEndBytes(listKey)
+	if endEpoch != 0 && endEpoch != ^uint64(0) {
+		end = vrtypes.GetDelegatorOutstandingEpochKey(delegator, endEpoch+1)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(listKey):]
+		epoch := sdk.BigEndianToUint64(key[:8])
+		validatorAddress := string(key[8:])
+		if cb(epoch, validatorAddress, vrutils.DecodeCoin(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// IterateAllDelegatorsOutstandingRewards walks every delegator reward row.
+func (k Keeper) IterateAllDelegatorsOutstandingRewards(ctx sdk.Context, cb func(delegator sdk.AccAddress, epoch uint64, validatorAddress string, amount sdk.Coin) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vrtypes.KeyPrefixDelegatorOutstanding)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegator, epoch, validatorAddress, err := vrtypes.ParseDelegatorOutstandingKey(iterator.Key())
+		if err != nil {
+			ctx.Logger().Error(
+				"valrewards: skipping malformed delegator outstanding rewards key",
+				"key", hex.EncodeToString(iterator.Key()),
+				"err", err,
+			)
+			continue
+		}
+		if cb(delegator, epoch, validatorAddress, vrutils.DecodeCoin(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetDelegatorClaimableRewards returns the rewards the delegator can claim in
+// [startEpoch, endEpoch] and their sum, including the ones its delegations
+// have not settled yet.
+func (k Keeper) GetDelegatorClaimableRewards(ctx sdk.Context, delegator sdk.AccAddress, startEpoch, endEpoch uint64) ([]vrtypes.DelegatorEpochReward, sdk.Coin) {
+	rewards := []vrtypes.DelegatorEpochReward{}
+	total := sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt())
+	if endEpoch != 0 && endEpoch < startEpoch {
+		return rewards, total
+	}
+
+	k.IterateDelegatorOutstandingRewards(ctx, delegator, startEpoch, endEpoch, func(epoch uint64, validatorAddress string, amount sdk.Coin) bool {
+		rewards = append(rewards, vrtypes.DelegatorEpochReward{Epoch: epoch, ValidatorAddress: validatorAddress, Amount: amount})
+		total = total.Add(amount)
+		return false
+	})
+	for _, reward := range k.pendingDelegatorRewards(ctx, delegator) {
+		if reward.Epoch < startEpoch || (endEpoch != 0 && reward.Epoch > endEpoch) {
+			continue
+		}
+		rewards = append(rewards, reward)
+		total = total.Add(reward.Amount)
+	}
+	sortDelegatorEpochRewards(rewards)
+	return rewards, total
+}
+
+// ClaimDelegatorRewardsRange settles the delegations of the delegator and pays
+// every reward it earned through any validator in [startEpoch, endEpoch],
+// oldest first, stopping after maxEpochs distinct epochs. A maxEpochs of 0 or
+// above MaxClaimEpochsPerCall is capped to MaxClaimEpochsPerCall. hasMore reports whether claimable epochs
+// remain in the range after this call.
+func (k Keeper) ClaimDelegatorRewardsRange(
+	ctx sdk.Context,
+	delegator sdk.AccAddress,
+	startEpoch, endEpoch uint64,
+	maxEpochs uint32,
+) (rewards []vrtypes.DelegatorEpochReward, total sdk.Coin, hasMore bool, err error) {
+	if maxEpochs == 0 || maxEpochs > vrtypes.MaxClaimEpochsPerCall {
+		maxEpochs = vrtypes.MaxClaimEpochsPerCall
+	}
+
+	k.settleDelegatorRewards(ctx, delegator)
+
+	var paidEpochs uint32
+	total = sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt())
+	k.IterateDelegatorOutstandingRewards(ctx, delegator, startEpoch, endEpoch, func(epoch uint64, validatorAddress string, amount sdk.Coin) bool {
+		if len(rewards) == 0 || rewards[len(rewards)-1].Epoch != epoch {
+			if paidEpochs == maxEpochs {
+				hasMore = true
+				return true
+			}
+			paidEpochs++
+		}
+		rewards = append(rewards, vrtypes.DelegatorEpochReward{Epoch: epoch, ValidatorAddress: validatorAddress, Amount: amount})
+		total = total.Add(amount)
+		return false
+	})
+
+	if len(rewards) == 0 {
+		return nil, sdk.Coin{}, false, errors.New(vrtypes.ErrNoOutstandingBalance)
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(vrtypes.ModuleName)
+	moduleBalance := k.bankKeeper.GetBalance(ctx, moduleAddr, evmtypes.DefaultEVMDenom)
+	if moduleBalance.IsLT(total) {
+		return nil, sdk.Coin{}, false, errors.New(vrtypes.ErrInsufficientRewardsBalance)
+	}
+
+	for _, reward := range rewards {
+		k.SetDelegatorOutstandingReward(ctx, delegator, reward.Epoch, reward.ValidatorAddress, sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt()))
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, vrtypes.ModuleName, delegator, sdk.NewCoins(total)); err != nil {
+		return nil, sdk.Coin{}, false, err
+	}
+
+	for _, reward := range rewards {
+		emitClaimDelegatorRewardsEvent(ctx, delegator.String(), reward)
+	}
+	return rewards, total, hasMore, nil
+}
+
+func emitClaimDelegatorRewardsEvent(ctx sdk.Context, delegator string, reward vrtypes.DelegatorEpochReward) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeClaimDelegatorRewards,
+			sdk.NewAttribute(vrtypes.AttributeKeyDelegator, delegator),
+			sdk.NewAttribute(vrtypes.AttributeKeyValidator, reward.ValidatorAddress),
+			sdk.NewAttribute(vrtypes.AttributeKeyEpoch, strconv.FormatUint(reward.Epoch, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyAmount, reward.Amount.String()),
+		),
+	)
+}
+
+func encodeCommissionRate(rate math.LegacyDec) []byte {
+	bz, err := rate.Marshal()
+	if err != nil {
+		panic(err) //nolint:halt // Internal invariant: callers validate the commission rate before persisting it.
+	}
+	return bz
+}
+
+func decodeCommissionRate(bz []byte) math.LegacyDec {
+	var rate math.LegacyDec
+	if err := rate.Unmarshal(bz); err != nil {
+		return math.LegacyOneDec()
+	}
+	return rate
+}
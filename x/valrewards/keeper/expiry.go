@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+	vrutils "github.com/cosmos/evm/x/valrewards/utils"
+)
+
+// GetOutstandingLiabilities returns the sum of every unclaimed validator and
+// delegator reward row and unsettled delegator pool.
+func (k Keeper) GetOutstandingLiabilities(ctx sdk.Context) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	return vrutils.DecodeCoin(store.Get(vrtypes.GetOutstandingLiabilitiesKey()))
+}
+
+func (k Keeper) setOutstandingLiabilities(ctx sdk.Context, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(vrtypes.GetOutstandingLiabilitiesKey(), vrutils.EncodeCoin(sdk.NewCoin(evmtypes.DefaultEVMDenom, amount)))
+}
+
+// adjustOutstandingLiabilities keeps the liabilities total in step with a
+// reward row changing from previous to next.
+func (k Keeper) adjustOutstandingLiabilities(ctx sdk.Context, previous, next math.Int) {
+	if previous.Equal(next) {
+		return
+	}
+	total := k.GetOutstandingLiabilities(ctx).Amount.Add(next).Sub(previous)
+	if total.IsNegative() {
+		ctx.Logger().Error(
+			"valrewards: outstanding liabilities went negative, resetting to zero",
+			"liabilities", total.String(),
+		)
+		total = math.ZeroInt()
+	}
+	k.setOutstandingLiabilities(ctx, total)
+}
+
+// GetUnallocatedRewardsPool returns the part of the rewards pool that is not
+// owed to any validator or delegator.
+func (k Keeper) GetUnallocatedRewardsPool(ctx sdk.Context) sdk.Coin {
+	pool := k.GetRewardsPool(ctx)
+	liabilities := k.GetOutstandingLiabilities(ctx)
+	if pool.Amount.LTE(liabilities.Amount) {
+		return sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt())
+	}
+	return sdk.NewCoin(evmtypes.DefaultEVMDenom, pool.Amount.Sub(liabilities.Amount))
+}
+
+// GetNextEpochToExpire returns the oldest epoch whose unclaimed rewards have
+// not been pruned yet.
+func (k Keeper) GetNextEpochToExpire(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(vrtypes.GetNextEpochToExpireKey())
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextEpochToExpire(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(vrtypes.GetNextEpochToExpireKey(), sdk.Uint64ToBigEndian(epoch))
+}
+
+// expireOutstandingRewards prunes the reward rows of the epochs that left the
+// claim window, oldest first and at most MaxExpiredEpochsPerBlock epochs per
+// block. The rewards of epoch E can be claimed until epoch E+1+claimWindow
+// starts; their amounts then return to the unallocated pool.
+func (k Keeper) expireOutstandingRewards(ctx sdk.Context, currentEpoch, claimWindow uint64) {
+	if claimWindow == 0 || currentEpoch <= claimWindow {
+		return
+	}
+
+	lastExpired := currentEpoch - claimWindow - 1
+	nextEpoch := k.GetNextEpochToExpire(ctx)
+	for pruned := uint64(0); nextEpoch <= lastExpired && pruned < vrtypes.MaxExpiredEpochsPerBlock; pruned++ {
+		k.expireEpoch(ctx, nextEpoch)
+		nextEpoch++
+	}
+	k.SetNextEpochToExpire(ctx, nextEpoch)
+}
+
+func (k Keeper) expireEpoch(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	expired := math.ZeroInt()
+
+	var validatorKeys [][]byte
+	k.iterateValidatorsOutstanding(ctx, epoch, func(validatorAddress string, amount sdk.Coin) bool {
+		validatorKeys = append(validatorKeys, []byte(validatorAddress))
+		if amount.IsPositive() {
+			expired = expired.Add(amount.Amount)
+			emitExpireRewardsEvent(ctx, "", validatorAddress, epoch, amount)
+		}
+		return false
+	})
+	for _, validatorAddressBytes := range validatorKeys {
+		store.Delete(vrtypes.GetEpochValidatorOutstandingKey(epoch, validatorAddressBytes))
+		store.Delete(vrtypes.GetValidatorOutstandingEpochKey(validatorAddressBytes, epoch))
+	}
+	k.deleteEpochValidatorsPoints(ctx, epoch)
+
+	type delegatorRow struct {
+		delegator        sdk.AccAddress
+		validatorAddress string
+	}
+	var delegatorRows []delegatorRow
+	indexPrefix := vrtypes.GetEpochDelegatorOutstandingListKey(epoch)
+	iterator := storetypes.KVStorePrefixIterator(store, indexPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		_, delegator, validatorAddress, err := vrtypes.ParseEpochDelegatorOutstandingKey(iterator.Key())
+		if err != nil {
+			continue
+		}
+		delegatorRows = append(delegatorRows, delegatorRow{delegator: delegator, validatorAddress: validatorAddress})
+	}
+	iterator.Close()
+
+	for _, row := range delegatorRows {
+		amount := k.GetDelegatorOutstandingReward(ctx, row.delegator, epoch, row.validatorAddress)
+		if amount.IsPositive() {
+			expired = expired.Add(amount.Amount)
+			emitExpireRewardsEvent(ctx, row.delegator.String(), row.validatorAddress, epoch, amount)
+		}
+		store.Delete(vrtypes.GetDelegatorOutstandingKey(row.delegator, epoch, []byte(row.validatorAddress)))
+		store.Delete(vrtypes.GetEpochDelegatorOutstandingKey(epoch, row.delegator, []byte(row.validatorAddress)))
+	}
+
+	var poolValidators []string
+	poolPrefix := vrtypes.GetDelegatorRewardPoolListKey(epoch)
+	poolIterator := storetypes.KVStorePrefixIterator(store, poolPrefix)
+	for ; poolIterator.Valid(); poolIterator.Next() {
+		poolValidators = append(poolValidators, string(poolIterator.Key()[len(poolPrefix):]))
+	}
+	poolIterator.Close()
+
+	// Unsettled delegator pools, including their truncation dust, expire with
+	// the epoch. Their event carries no delegator.
+	for _, validatorAddress := range poolValidators {
+		amount := k.GetDelegatorRewardPool(ctx, epoch, validatorAddress)
+		if amount.IsPositive() {
+			expired = expired.Add(amount.Amount)
+			emitExpireRewardsEvent(ctx, "", validatorAddress, epoch, amount)
+		}
+		store.Delete(vrtypes.GetDelegatorRewardPoolKey(epoch, []byte(validatorAddress)))
+		store.Delete(vrtypes.GetValidatorRewardPerShareKey([]byte(validatorAddress), epoch))
+	}
+
+	k.adjustOutstandingLiabilities(ctx, expired, math.ZeroInt())
+}
+
+// deleteEpochValidatorsPoints drops the point rows of an epoch, including the
+// ones of validators that were never paid.
+func (k Keeper) deleteEpochValidatorsPoints(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vrtypes.GetEpochValidatorPointsListKey(epoch))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func emitExpireRewardsEvent(ctx sdk.Context, delegator, validatorAddress string, epoch uint64, amount sdk.Coin) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(vrtypes.AttributeKeyValidator, validatorAddress),
+		sdk.NewAttribute(vrtypes.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+		sdk.NewAttribute(vrtypes.AttributeKeyAmount, amount.String()),
+	}
+	if delegator != "" {
+		attributes = append(attributes, sdk.NewAttribute(vrtypes.AttributeKeyDelegator, delegator))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(vrtypes.EventTypeExpireRewards, attributes...))
+}
@@ -19,11 +19,14 @@ func (k Keeper) Params(goCtx context.Context, req *vrtypes.QueryParamsRequest) (
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := k.GetParams(ctx)
 
+	currentSettings := k.GetCurrentRewardSettings(ctx)
+
 	return &vrtypes.QueryParamsResponse{
-		CurrentRewardSettings: k.GetCurrentRewardSettings(ctx),
+		CurrentRewardSettings: currentSettings,
 		NextRewardSettings:    k.GetNextRewardSettings(ctx),
-		ProposerBonusPoints:   vrtypes.PROPOSER_BONUS_POINTS,
+		ProposerBonusPoints:   currentSettings.ProposerBonusPoints,
 		Whitelist:             params.Whitelist,
+		RunwayAlertEpochs:     params.RunwayAlertEpochs,
 	}, nil
 }
 
@@ -64,6 +67,67 @@ func (k Keeper) ValidatorOutstandingRewards(goCtx context.Context, req *vrtypes.
 	return &vrtypes.QueryValidatorOutstandingRewardsResponse{Rewards: rewards}, nil
 }
 
+func (k Keeper) ClaimableRewards(goCtx context.Context, req *vrtypes.QueryClaimableRewardsRequest) (*vrtypes.QueryClaimableRewardsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if len([]byte(req.ValidatorAddress)) > 128 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator address exceeds maximum length")
+	}
+	if err := vrtypes.ValidateValidatorOperatorAddress(req.ValidatorAddress); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+	if req.EndEpoch != 0 && req.EndEpoch < req.StartEpoch {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "end_epoch %d is before start_epoch %d", req.EndEpoch, req.StartEpoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rewards, total := k.GetClaimableRewards(ctx, req.ValidatorAddress, req.StartEpoch, req.EndEpoch)
+
+	return &vrtypes.QueryClaimableRewardsResponse{Total: total, Rewards: rewards}, nil
+}
+
+func (k Keeper) RewardsCommission(goCtx context.Context, req *vrtypes.QueryRewardsCommissionRequest) (*vrtypes.QueryRewardsCommissionResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if len([]byte(req.ValidatorAddress)) > 128 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator address exceeds maximum length")
+	}
+	if err := vrtypes.ValidateValidatorOperatorAddress(req.ValidatorAddress); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rate, _ := k.GetValidatorRewardsCommission(ctx, req.ValidatorAddress)
+
+	return &vrtypes.QueryRewardsCommissionResponse{
+		CommissionRate:     rate,
+		NextCommissionRate: k.GetNextValidatorRewardsCommission(ctx, req.ValidatorAddress),
+	}, nil
+}
+
+func (k Keeper) DelegatorRewards(goCtx context.Context, req *vrtypes.QueryDelegatorRewardsRequest) (*vrtypes.QueryDelegatorRewardsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	delegator, err := vrtypes.ParseAccAddress(req.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid delegator address")
+	}
+	if req.EndEpoch != 0 && req.EndEpoch < req.StartEpoch {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "end_epoch %d is before start_epoch %d", req.EndEpoch, req.StartEpoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rewards, total := k.GetDelegatorClaimableRewards(ctx, delegator, req.StartEpoch, req.EndEpoch)
+
+	return &vrtypes.QueryDelegatorRewardsResponse{Total: total, Rewards: rewards}, nil
+}
+
 func (k Keeper) RewardsPool(goCtx context.Context, req *vrtypes.QueryRewardsPoolRequest) (*vrtypes.QueryRewardsPoolResponse, error) {
 	if req == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
@@ -73,3 +137,51 @@ func (k Keeper) RewardsPool(goCtx context.Context, req *vrtypes.QueryRewardsPool
 	pool := k.GetRewardsPool(ctx)
 	return &vrtypes.QueryRewardsPoolResponse{Pool: pool}, nil
 }
+
+func (k Keeper) OutstandingLiabilities(goCtx context.Context, req *vrtypes.QueryOutstandingLiabilitiesRequest) (*vrtypes.QueryOutstandingLiabilitiesResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &vrtypes.QueryOutstandingLiabilitiesResponse{
+		Liabilities: k.GetOutstandingLiabilities(ctx),
+		Pool:        k.GetRewardsPool(ctx),
+		Unallocated: k.GetUnallocatedRewardsPool(ctx),
+	}, nil
+}
+
+func (k Keeper) RewardsRunway(goCtx context.Context, req *vrtypes.QueryRewardsRunwayRequest) (*vrtypes.QueryRewardsRunwayResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	fundedEpochs, unallocated, rewardsPerEpoch := k.GetRewardsRunway(ctx)
+	return &vrtypes.QueryRewardsRunwayResponse{
+		Unallocated:       unallocated,
+		Liabilities:       k.GetOutstandingLiabilities(ctx),
+		RewardsPerEpoch:   rewardsPerEpoch,
+		FundedEpochs:      fundedEpochs,
+		RunwayAlertEpochs: k.GetParams(ctx).RunwayAlertEpochs,
+	}, nil
+}
+
+func (k Keeper) PayoutSettings(goCtx context.Context, req *vrtypes.QueryPayoutSettingsRequest) (*vrtypes.QueryPayoutSettingsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	if len([]byte(req.ValidatorAddress)) > 128 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator address exceeds maximum length")
+	}
+	if err := vrtypes.ValidateValidatorOperatorAddress(req.ValidatorAddress); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &vrtypes.QueryPayoutSettingsResponse{
+		WithdrawAddress: k.GetWithdrawAddress(ctx, req.ValidatorAddress).String(),
+		AutoCompound:    k.IsAutoCompoundEnabled(ctx, req.ValidatorAddress),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks records staking events that affect valrewards point accrual and
+// settles delegator pass-through rewards before delegation shares change.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the staking hooks of the valrewards keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k: k}
+}
+
+// BeforeValidatorSlashed marks the validator as slashed in the epoch currently
+// accumulating points, so the payout of that epoch applies the forfeit rate.
+func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	if !fraction.IsPositive() {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochState := h.k.GetEpochState(sdkCtx)
+	h.k.SetValidatorSlashed(sdkCtx, epochState.CurrentEpoch, valAddr.String())
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error { return nil }
+
+func (h Hooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
+
+func (h Hooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationCreated starts a new delegation after the paid epochs, so it
+// only earns from the epoch currently accumulating points.
+func (h Hooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.markDelegationSettled(sdk.UnwrapSDKContext(ctx), delAddr, valAddr.String())
+	return nil
+}
+
+// BeforeDelegationSharesModified settles the delegation with its current
+// shares before they change.
+func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.settleDelegation(sdk.UnwrapSDKContext(ctx), delAddr, valAddr)
+	return nil
+}
+
+// BeforeDelegationRemoved settles the delegation and drops its record.
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	h.k.settleDelegation(sdkCtx, delAddr, valAddr)
+	h.k.deleteDelegationSettledEpoch(sdkCtx, delAddr, valAddr.String())
+	return nil
+}
+
+func (h Hooks) AfterDelegationModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(context.Context, uint64) error { return nil }
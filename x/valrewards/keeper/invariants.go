@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+)
+
+const (
+	OutstandingLiabilitiesInvariantRoute = "outstanding-liabilities"
+)
+
+// RegisterInvariants registers all x/valrewards invariants.
+//
+//nolint:staticcheck // x/crisis-backed invariant registration is intentional for startup/runtime assertions.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(vrtypes.ModuleName, OutstandingLiabilitiesInvariantRoute, OutstandingLiabilitiesInvariant(k))
+}
+
+// OutstandingLiabilitiesInvariant checks that the tracked liabilities total
+// matches the validator and delegator reward rows plus the unsettled delegator
+// pools and that the rewards pool covers it.
+//
+//nolint:staticcheck // x/crisis-backed invariant registration is intentional for startup/runtime assertions.
+func OutstandingLiabilitiesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		rows := sdkmath.ZeroInt()
+		k.IterateAllValidatorsOutstandingRewards(ctx, func(_ uint64, _ string, amount sdk.Coin) bool {
+			rows = rows.Add(amount.Amount)
+			return false
+		})
+		k.IterateAllDelegatorsOutstandingRewards(ctx, func(_ sdk.AccAddress, _ uint64, _ string, amount sdk.Coin) bool {
+			rows = rows.Add(amount.Amount)
+			return false
+		})
+		k.IterateAllDelegatorRewardPools(ctx, func(_ uint64, _ string, _ sdkmath.LegacyDec, remaining sdk.Coin) bool {
+			rows = rows.Add(remaining.Amount)
+			return false
+		})
+
+		liabilities := k.GetOutstandingLiabilities(ctx)
+		if !rows.Equal(liabilities.Amount) {
+			return sdk.FormatInvariant(
+				vrtypes.ModuleName,
+				OutstandingLiabilitiesInvariantRoute,
+				fmt.Sprintf("tracked liabilities %s do not match the outstanding reward rows %s", liabilities.Amount, rows),
+			), true
+		}
+
+		pool := k.GetRewardsPool(ctx)
+		if pool.Amount.LT(liabilities.Amount) {
+			return sdk.FormatInvariant(
+				vrtypes.ModuleName,
+				OutstandingLiabilitiesInvariantRoute,
+				fmt.Sprintf("rewards pool %s does not cover outstanding liabilities %s", pool, liabilities),
+			), true
+		}
+
+		return "", false
+	}
+}
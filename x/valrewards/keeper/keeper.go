@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -56,10 +57,13 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	epochState := k.GetEpochState(ctx)
 
 	k.processValidatorsPoints(ctx, epochState.CurrentEpoch, currentSettings)
+	k.expireOutstandingRewards(ctx, epochState.CurrentEpoch, currentSettings.ClaimWindowEpochs)
 
 	epochState.BlocksIntoCurrentEpoch++
 	if epochState.BlocksIntoCurrentEpoch >= currentSettings.BlocksInEpoch {
 		k.processValidatorsRewards(ctx, epochState.CurrentEpoch, currentSettings)
+		k.autoCompoundRewards(ctx, epochState.CurrentEpoch)
+		k.clearSlashedValidators(ctx, epochState.CurrentEpoch)
 
 		nextSettings := k.GetNextRewardSettings(ctx)
 		if err := nextSettings.Validate(); err != nil {
@@ -89,6 +93,8 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 		k.SetEpochState(ctx, epochState)
 		k.SetCurrentRewardSettings(ctx, nextSettings)
 		k.SetNextRewardSettings(ctx, nextSettings)
+		k.promoteNextRewardsCommissions(ctx)
+		k.checkRewardsRunway(ctx, epochState.CurrentEpoch)
 		return nil
 	}
 
@@ -119,16 +125,56 @@ func (k Keeper) processValidatorsRewards(ctx sdk.Context, completedEpoch uint64,
 		return
 	}
 
+	// Only the part of the pool not already owed to someone can fund the epoch,
+	// so outstanding rewards never exceed the pool balance.
+	unallocated := k.GetUnallocatedRewardsPool(ctx)
+	if unallocated.IsLT(requiredRewards) {
+		ctx.Logger().Error(
+			"valrewards: rewards pool cannot fund the epoch, distributing the unallocated balance",
+			"epoch", completedEpoch,
+			"required", requiredRewards.String(),
+			"unallocated", unallocated.String(),
+		)
+		emitFundingShortfallEvent(ctx, completedEpoch, requiredRewards, unallocated)
+		requiredRewards = unallocated
+	}
+
+	forfeitRate, err := vrtypes.ParseSlashForfeitRate(settings.SlashForfeitRate)
+	if err != nil {
+		ctx.Logger().Error(
+			"valrewards: invalid slash forfeit rate, paying slashed validators in full",
+			"epoch", completedEpoch,
+			"err", err,
+		)
+		forfeitRate = math.LegacyZeroDec()
+	}
+
+	// Forfeited points stay in the epoch total, so their share of the epoch
+	// rewards is never allocated and remains in the rewards pool.
 	for _, epochValidatorToPay := range epochValidatorsToPay {
-		paymentFraction := math.LegacyNewDec(int64(epochValidatorToPay.EpochPoints)).QuoTruncate(math.LegacyNewDec(int64(epochTotalPoints)))
-		toPayAmount := math.LegacyNewDecFromInt(requiredRewards.Amount).Mul(paymentFraction).TruncateInt()
+		payablePoints, forfeitedPoints := k.payablePoints(ctx, completedEpoch, epochValidatorToPay.ValidatorAddress, epochValidatorToPay.EpochPoints, forfeitRate)
+		toPayAmount := epochRewardShare(requiredRewards.Amount, payablePoints, epochTotalPoints)
 		toPayCoin := sdk.NewCoin(evmtypes.DefaultEVMDenom, toPayAmount)
+		if forfeitedPoints > 0 {
+			fullAmount := epochRewardShare(requiredRewards.Amount, epochValidatorToPay.EpochPoints, epochTotalPoints)
+			forfeitedCoin := sdk.NewCoin(evmtypes.DefaultEVMDenom, fullAmount.Sub(toPayAmount))
+			emitForfeitRewardsEvent(ctx, epochValidatorToPay.ValidatorAddress, completedEpoch, forfeitedPoints, forfeitedCoin)
+		}
+		toPayCoin = k.allocateDelegatorRewards(ctx, completedEpoch, epochValidatorToPay.ValidatorAddress, toPayCoin)
 		k.SetValidatorOutstandingReward(ctx, completedEpoch, epochValidatorToPay.ValidatorAddress, toPayCoin)
 	}
 
 	k.SetEpochToPay(ctx, completedEpoch+1)
 }
 
+func epochRewardShare(epochRewards math.Int, points, totalPoints uint64) math.Int {
+	paymentFraction := math.LegacyNewDec(int64(points)).QuoTruncate(math.LegacyNewDec(int64(totalPoints)))
+	return math.LegacyNewDecFromInt(epochRewards).Mul(paymentFraction).TruncateInt()
+}
+
+// processValidatorsPoints awards points for the commit signatures of the
+// previous block. Absent and nil votes earn nothing, and neither do jailed
+// validators, which covers tombstoned ones.
 func (k Keeper) processValidatorsPoints(ctx sdk.Context, currentEpoch uint64, settings vrtypes.RewardSettings) {
 	if settings.RewardingPaused {
 		return
@@ -144,8 +190,11 @@ func (k Keeper) processValidatorsPoints(ctx sdk.Context, currentEpoch uint64, se
 
 	proposerAddress := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress).String()
 	for _, voteInfo := range ctx.VoteInfos() {
+		if voteInfo.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
 		validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, voteInfo.Validator.Address)
-		if err != nil {
+		if err != nil || validator.IsJailed() {
 			continue
 		}
 
@@ -153,8 +202,8 @@ func (k Keeper) processValidatorsPoints(ctx sdk.Context, currentEpoch uint64, se
 		validatorPoints := uint64(powerFraction.MulInt64(100).TruncateInt64())
 		k.AddValidatorRewardPoints(ctx, currentEpoch, validator.GetOperator(), validatorPoints)
 
-		if sdk.ConsAddress(voteInfo.Validator.Address).String() == proposerAddress {
-			k.AddValidatorRewardPoints(ctx, currentEpoch, validator.GetOperator(), vrtypes.PROPOSER_BONUS_POINTS)
+		if settings.ProposerBonusPoints > 0 && sdk.ConsAddress(voteInfo.Validator.Address).String() == proposerAddress {
+			k.AddValidatorRewardPoints(ctx, currentEpoch, validator.GetOperator(), settings.ProposerBonusPoints)
 		}
 	}
 }
@@ -282,8 +331,16 @@ func (k Keeper) SetValidatorOutstandingReward(ctx sdk.Context, epoch uint64, val
 	validatorAddressBytes := []byte(validatorAddress)
 	store := ctx.KVStore(k.storeKey)
 	key := vrtypes.GetEpochValidatorOutstandingKey(epoch, validatorAddressBytes)
+	k.adjustOutstandingLiabilities(ctx, vrutils.DecodeCoin(store.Get(key)).Amount, amount.Amount)
 	bz := vrutils.EncodeCoin(amount)
 	store.Set(key, bz)
+
+	indexKey := vrtypes.GetValidatorOutstandingEpochKey(validatorAddressBytes, epoch)
+	if amount.IsZero() {
+		store.Delete(indexKey)
+	} else {
+		store.Set(indexKey, []byte{})
+	}
 }
 
 func (k Keeper) GetValidatorOutstandingReward(ctx sdk.Context, epoch uint64, validatorAddress string) sdk.Coin {
@@ -457,11 +514,13 @@ func (k Keeper) ClaimValidatorRewards(ctx sdk.Context, operator sdk.AccAddress,
 
 	k.SetValidatorOutstandingReward(ctx, epoch, validatorAddress, sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt()))
 
+	recipient := k.GetWithdrawAddress(ctx, validatorAddress)
 	transferCoins := sdk.NewCoins(rewards)
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, vrtypes.ModuleName, operator, transferCoins); err != nil {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, vrtypes.ModuleName, recipient, transferCoins); err != nil {
 		return sdk.Coin{}, err
 	}
 
+	emitClaimRewardsEvent(ctx, validatorAddress, recipient, epoch, rewards)
 	return rewards, nil
 }
 
@@ -37,6 +37,10 @@ type fakeStakingKeeper struct {
 	maxValidators uint32
 	byConsAddr    map[string]stakingtypes.Validator
 	byValAddr     map[string]stakingtypes.Validator
+	delegations   map[string][]stakingtypes.Delegation
+	bondDenom     string
+	delegated     map[string]sdkmath.Int
+	delegateErr   error
 }
 
 type fakeAccountKeeper struct {
@@ -60,6 +64,11 @@ func (f *fakeAccountKeeper) GetSequence(context.Context, sdk.AccAddress) (uint64
 type fakeBankKeeper struct {
 	balances    map[string]sdk.Coins
 	moduleAddrs map[string]sdk.AccAddress
+	blocked     map[string]bool
+}
+
+func (f *fakeBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return f.blocked[addr.String()]
 }
 
 func (f *fakeBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
@@ -101,6 +110,56 @@ func (f *fakeStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress)
 	return val, nil
 }
 
+func (f *fakeStakingKeeper) GetDelegation(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error) {
+	for _, delegation := range f.delegations[valAddr.String()] {
+		if delegation.DelegatorAddress == delAddr.String() {
+			return delegation, nil
+		}
+	}
+	return stakingtypes.Delegation{}, stakingtypes.ErrNoDelegation
+}
+
+func (f *fakeStakingKeeper) GetDelegatorDelegations(_ context.Context, delegator sdk.AccAddress, _ uint16) ([]stakingtypes.Delegation, error) {
+	var delegations []stakingtypes.Delegation
+	for _, validatorDelegations := range f.delegations {
+		for _, delegation := range validatorDelegations {
+			if delegation.DelegatorAddress == delegator.String() {
+				delegations = append(delegations, delegation)
+			}
+		}
+	}
+	return delegations, nil
+}
+
+func (f *fakeStakingKeeper) BondDenom(_ context.Context) (string, error) {
+	if f.bondDenom == "" {
+		return evmtypes.DefaultEVMDenom, nil
+	}
+	return f.bondDenom, nil
+}
+
+func (f *fakeStakingKeeper) Delegate(
+	_ context.Context,
+	delAddr sdk.AccAddress,
+	bondAmt sdkmath.Int,
+	_ stakingtypes.BondStatus,
+	validator stakingtypes.Validator,
+	_ bool,
+) (sdkmath.LegacyDec, error) {
+	if f.delegateErr != nil {
+		return sdkmath.LegacyDec{}, f.delegateErr
+	}
+	if f.delegated == nil {
+		f.delegated = make(map[string]sdkmath.Int)
+	}
+	key := delAddr.String() + "/" + validator.OperatorAddress
+	if current, ok := f.delegated[key]; ok {
+		bondAmt = current.Add(bondAmt)
+	}
+	f.delegated[key] = bondAmt
+	return sdkmath.LegacyNewDecFromInt(bondAmt), nil
+}
+
 func repeatedAddress(seed byte) []byte {
 	addr := make([]byte, 20)
 	for i := range addr {
@@ -150,6 +209,9 @@ func setupKeeperWithValidators(t *testing.T, count int) (Keeper, sdk.Context, []
 	}
 
 	k := NewKeeper(cdc, storeKey, authority, stakingKeeper, nil, nil)
+	// Reward allocation is capped by the unallocated pool, so start with a
+	// pool large enough to never bind unless a test overrides it.
+	fundRewardsModule(&k, sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntWithDecimal(1, 30)))
 	return k, ctx, fixtures
 }
 
@@ -356,9 +418,10 @@ func TestBeginBlockerActivatesPendingSettingsAtEpochBoundary(t *testing.T) {
 	k, ctx, _, valAddr, consAddr := setupKeeper(t)
 
 	currentSettings := vrtypes.RewardSettings{
-		BlocksInEpoch:   20,
-		RewardsPerEpoch: vrtypes.MinRewardsPerEpoch,
-		RewardingPaused: false,
+		BlocksInEpoch:       20,
+		RewardsPerEpoch:     vrtypes.MinRewardsPerEpoch,
+		RewardingPaused:     false,
+		ProposerBonusPoints: 1,
 	}
 	nextSettings := vrtypes.RewardSettings{
 		BlocksInEpoch:   25,
@@ -376,6 +439,7 @@ func TestBeginBlockerActivatesPendingSettingsAtEpochBoundary(t *testing.T) {
 			Address: consAddr.Bytes(),
 			Power:   100,
 		},
+		BlockIdFlag: cmtproto.BlockIDFlagCommit,
 	}}
 
 	for height := int64(2); height <= 21; height++ {
@@ -414,9 +478,10 @@ func TestBeginBlockerSplitsRewardsAcrossMultipleValidators(t *testing.T) {
 	k, ctx, fixtures := setupKeeperWithValidators(t, 2)
 
 	currentSettings := vrtypes.RewardSettings{
-		BlocksInEpoch:   20,
-		RewardsPerEpoch: "2020000000000000000000",
-		RewardingPaused: false,
+		BlocksInEpoch:       20,
+		RewardsPerEpoch:     "2020000000000000000000",
+		RewardingPaused:     false,
+		ProposerBonusPoints: 1,
 	}
 	k.SetCurrentRewardSettings(ctx, currentSettings)
 	k.SetNextRewardSettings(ctx, currentSettings)
@@ -429,12 +494,14 @@ func TestBeginBlockerSplitsRewardsAcrossMultipleValidators(t *testing.T) {
 				Address: fixtures[0].consAddr.Bytes(),
 				Power:   60,
 			},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
 		},
 		{
 			Validator: abci.Validator{
 				Address: fixtures[1].consAddr.Bytes(),
 				Power:   40,
 			},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
 		},
 	}
 
@@ -518,6 +585,7 @@ func TestBeginBlockerSkipsInvalidCurrentRewardSettings(t *testing.T) {
 			Address: consAddr.Bytes(),
 			Power:   100,
 		},
+		BlockIdFlag: cmtproto.BlockIDFlagCommit,
 	}}
 
 	for height := int64(2); height <= 21; height++ {
@@ -560,6 +628,7 @@ func TestBeginBlockerFallsBackWhenNextRewardSettingsAreInvalid(t *testing.T) {
 			Address: consAddr.Bytes(),
 			Power:   100,
 		},
+		BlockIdFlag: cmtproto.BlockIDFlagCommit,
 	}}
 
 	for height := int64(2); height <= 21; height++ {
@@ -619,3 +688,1029 @@ func TestIterateAllValidatorsOutstandingRewardsSkipsMalformedKeys(t *testing.T)
 	})
 	require.Equal(t, 1, visited)
 }
+
+func fundRewardsModule(k *Keeper, amount sdk.Coin) sdk.AccAddress {
+	moduleAddr := sdk.AccAddress(repeatedAddress(88))
+	k.accountKeeper = &fakeAccountKeeper{
+		moduleAddrs: map[string]sdk.AccAddress{
+			vrtypes.ModuleName: moduleAddr,
+		},
+	}
+	k.bankKeeper = &fakeBankKeeper{
+		balances: map[string]sdk.Coins{
+			moduleAddr.String(): sdk.NewCoins(amount),
+		},
+		moduleAddrs: map[string]sdk.AccAddress{
+			vrtypes.ModuleName: moduleAddr,
+		},
+	}
+	return moduleAddr
+}
+
+func rewardCoin(amount int64) sdk.Coin {
+	return sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(amount))
+}
+
+// rewardUnits counts hundredths of MinRewardsPerEpoch.
+func rewardUnits(amount int64) sdk.Coin {
+	return sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(amount).Mul(sdkmath.NewIntWithDecimal(1, 16)))
+}
+
+func TestClaimRewardsRangeSweepsOutstandingEpochsInBatches(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	requester := sdk.AccAddress(repeatedAddress(77))
+	fundRewardsModule(&k, rewardCoin(1000))
+
+	for epoch, amount := range map[uint64]int64{1: 10, 3: 30, 4: 0, 5: 50, 8: 80} {
+		k.SetValidatorOutstandingReward(ctx, epoch, valAddr.String(), rewardCoin(amount))
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := k.ClaimRewardsRange(ctx, &vrtypes.MsgClaimRewardsRange{
+		ValidatorOperator: operatorAcc.String(),
+		Requester:         requester.String(),
+		MaxEpochs:         2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []vrtypes.EpochReward{{Epoch: 1, Amount: rewardCoin(10)}, {Epoch: 3, Amount: rewardCoin(30)}}, res.Rewards)
+	require.Equal(t, rewardCoin(40), res.Total)
+	require.True(t, res.HasMore)
+	require.Equal(t, rewardCoin(40), k.bankKeeper.GetBalance(ctx, operatorAcc, evmtypes.DefaultEVMDenom))
+
+	var claimEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vrtypes.EventTypeClaimRewards {
+			claimEvents = append(claimEvents, event)
+		}
+	}
+	require.Len(t, claimEvents, 2)
+	epochAttr, found := claimEvents[1].GetAttribute(vrtypes.AttributeKeyEpoch)
+	require.True(t, found)
+	require.Equal(t, "3", epochAttr.Value)
+	amountAttr, found := claimEvents[1].GetAttribute(vrtypes.AttributeKeyAmount)
+	require.True(t, found)
+	require.Equal(t, rewardCoin(30).String(), amountAttr.Value)
+
+	res, err = k.ClaimRewardsRange(ctx, &vrtypes.MsgClaimRewardsRange{
+		ValidatorOperator: operatorAcc.String(),
+		Requester:         requester.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []vrtypes.EpochReward{{Epoch: 5, Amount: rewardCoin(50)}, {Epoch: 8, Amount: rewardCoin(80)}}, res.Rewards)
+	require.False(t, res.HasMore)
+	require.Equal(t, rewardCoin(170), k.bankKeeper.GetBalance(ctx, operatorAcc, evmtypes.DefaultEVMDenom))
+
+	_, err = k.ClaimRewardsRange(ctx, &vrtypes.MsgClaimRewardsRange{
+		ValidatorOperator: operatorAcc.String(),
+		Requester:         requester.String(),
+	})
+	require.ErrorContains(t, err, vrtypes.ErrNoOutstandingBalance)
+}
+
+func TestClaimRewardsRangeHonorsEpochBounds(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	fundRewardsModule(&k, rewardCoin(1000))
+
+	for _, epoch := range []uint64{2, 3, 5, 6} {
+		k.SetValidatorOutstandingReward(ctx, epoch, valAddr.String(), rewardCoin(int64(epoch)))
+	}
+
+	rewards, total, hasMore, err := k.ClaimValidatorRewardsRange(ctx, operatorAcc, 3, 5, 0)
+	require.NoError(t, err)
+	require.Equal(t, []vrtypes.EpochReward{{Epoch: 3, Amount: rewardCoin(3)}, {Epoch: 5, Amount: rewardCoin(5)}}, rewards)
+	require.Equal(t, rewardCoin(8), total)
+	require.False(t, hasMore)
+
+	claimable, total := k.GetClaimableRewards(ctx, valAddr.String(), 0, 0)
+	require.Equal(t, []vrtypes.EpochReward{{Epoch: 2, Amount: rewardCoin(2)}, {Epoch: 6, Amount: rewardCoin(6)}}, claimable)
+	require.Equal(t, rewardCoin(8), total)
+}
+
+func TestClaimRewardsRangeRejectsUnderfundedPool(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	fundRewardsModule(&k, rewardCoin(15))
+
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), rewardCoin(10))
+	k.SetValidatorOutstandingReward(ctx, 2, valAddr.String(), rewardCoin(10))
+
+	_, _, _, err := k.ClaimValidatorRewardsRange(ctx, operatorAcc, 0, 0, 0)
+	require.ErrorContains(t, err, vrtypes.ErrInsufficientRewardsBalance)
+
+	_, total := k.GetClaimableRewards(ctx, valAddr.String(), 0, 0)
+	require.Equal(t, rewardCoin(20), total)
+}
+
+func TestClaimRewardsRangeRejectsNonValidatorTarget(t *testing.T) {
+	k, ctx, _, _, _ := setupKeeper(t)
+	nonValidator := sdk.AccAddress(repeatedAddress(99))
+
+	_, err := k.ClaimRewardsRange(ctx, &vrtypes.MsgClaimRewardsRange{
+		ValidatorOperator: nonValidator.String(),
+		Requester:         nonValidator.String(),
+	})
+	require.ErrorContains(t, err, "target address must be a validator operator")
+}
+
+func TestClaimableRewardsQuery(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), rewardCoin(10))
+	k.SetValidatorOutstandingReward(ctx, 4, valAddr.String(), rewardCoin(40))
+
+	res, err := k.ClaimableRewards(ctx, &vrtypes.QueryClaimableRewardsRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, rewardCoin(50), res.Total)
+	require.Len(t, res.Rewards, 2)
+
+	res, err = k.ClaimableRewards(ctx, &vrtypes.QueryClaimableRewardsRequest{ValidatorAddress: valAddr.String(), StartEpoch: 2})
+	require.NoError(t, err)
+	require.Equal(t, rewardCoin(40), res.Total)
+
+	_, err = k.ClaimableRewards(ctx, &vrtypes.QueryClaimableRewardsRequest{ValidatorAddress: valAddr.String(), StartEpoch: 5, EndEpoch: 4})
+	require.Error(t, err)
+}
+
+func TestMigrate1to2IndexesOutstandingRewards(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(vrtypes.GetEpochValidatorOutstandingKey(2, []byte(valAddr.String())), vrutils.EncodeCoin(rewardCoin(20)))
+	store.Set(vrtypes.GetEpochValidatorOutstandingKey(3, []byte(valAddr.String())), vrutils.EncodeCoin(rewardCoin(0)))
+	store.Set(vrtypes.GetEpochValidatorOutstandingKey(7, []byte(valAddr.String())), vrutils.EncodeCoin(rewardCoin(70)))
+
+	claimable, _ := k.GetClaimableRewards(ctx, valAddr.String(), 0, 0)
+	require.Empty(t, claimable)
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	claimable, total := k.GetClaimableRewards(ctx, valAddr.String(), 0, 0)
+	require.Equal(t, []vrtypes.EpochReward{{Epoch: 2, Amount: rewardCoin(20)}, {Epoch: 7, Amount: rewardCoin(70)}}, claimable)
+	require.Equal(t, rewardCoin(90), total)
+}
+
+func delegateToValidator(k Keeper, valAddr sdk.ValAddress, shares map[string]int64) {
+	stakingKeeper := k.stakingKeeper.(*fakeStakingKeeper)
+	if stakingKeeper.delegations == nil {
+		stakingKeeper.delegations = make(map[string][]stakingtypes.Delegation)
+	}
+
+	validator := stakingKeeper.byValAddr[valAddr.String()]
+	validator.DelegatorShares = sdkmath.LegacyZeroDec()
+	stakingKeeper.delegations[valAddr.String()] = nil
+	for delegator, amount := range shares {
+		delegationShares := sdkmath.LegacyNewDec(amount)
+		stakingKeeper.delegations[valAddr.String()] = append(
+			stakingKeeper.delegations[valAddr.String()],
+			stakingtypes.NewDelegation(delegator, valAddr.String(), delegationShares),
+		)
+		validator.DelegatorShares = validator.DelegatorShares.Add(delegationShares)
+	}
+	stakingKeeper.byValAddr[valAddr.String()] = validator
+}
+
+func TestProcessValidatorsRewardsPassesRewardsThroughToDelegators(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	delegator := sdk.AccAddress(repeatedAddress(50))
+	delegateToValidator(k, valAddr, map[string]int64{
+		operatorAcc.String(): 1,
+		delegator.String():   2,
+	})
+	k.SetValidatorRewardsCommission(ctx, valAddr.String(), sdkmath.LegacyNewDecWithPrec(1, 1))
+
+	settings := vrtypes.RewardSettings{
+		BlocksInEpoch:   20,
+		RewardsPerEpoch: "1000000000000000001",
+	}
+	k.SetValidatorRewardPoints(ctx, 3, valAddr.String(), 10)
+	k.processValidatorsRewards(ctx, 3, settings)
+
+	// The payout only records the delegator pool; no delegation is walked.
+	operatorReward := k.GetValidatorOutstandingReward(ctx, 3, valAddr.String())
+	require.Equal(t, sdkmath.NewInt(100000000000000000), operatorReward.Amount)
+	require.Equal(t, sdkmath.NewInt(900000000000000001), k.GetDelegatorRewardPool(ctx, 3, valAddr.String()).Amount)
+	k.IterateAllDelegatorsOutstandingRewards(ctx, func(sdk.AccAddress, uint64, string, sdk.Coin) bool {
+		t.Fatal("payout must not write delegator reward rows")
+		return true
+	})
+
+	total, err := vrtypes.ParseRewardsPerEpoch(settings.RewardsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, total, k.GetOutstandingLiabilities(ctx).Amount)
+
+	// The remaining 900000000000000001 is split 1:2 when the delegations settle.
+	operatorRewards, _ := k.GetDelegatorClaimableRewards(ctx, operatorAcc, 0, 0)
+	require.Equal(t, []vrtypes.DelegatorEpochReward{
+		{Epoch: 3, ValidatorAddress: valAddr.String(), Amount: sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(300000000000000000))},
+	}, operatorRewards)
+
+	rewards, claimed, _, err := k.ClaimDelegatorRewardsRange(ctx, delegator, 0, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, rewards, 1)
+	require.Equal(t, sdkmath.NewInt(600000000000000000), claimed.Amount)
+	require.Equal(t, sdkmath.NewInt(300000000000000001), k.GetDelegatorRewardPool(ctx, 3, valAddr.String()).Amount)
+	require.Equal(t, uint64(4), k.GetDelegationSettledEpoch(ctx, delegator, valAddr.String()))
+
+	rewards, _ = k.GetDelegatorClaimableRewards(ctx, delegator, 0, 0)
+	require.Empty(t, rewards)
+	_, broken := OutstandingLiabilitiesInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestDelegationHooksSettleWithPreviousShares(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	delegator := sdk.AccAddress(repeatedAddress(50))
+	newDelegator := sdk.AccAddress(repeatedAddress(51))
+	delegateToValidator(k, valAddr, map[string]int64{
+		operatorAcc.String(): 1,
+		delegator.String():   1,
+	})
+	k.SetValidatorRewardsCommission(ctx, valAddr.String(), sdkmath.LegacyZeroDec())
+
+	settings := vrtypes.RewardSettings{
+		BlocksInEpoch:   20,
+		RewardsPerEpoch: vrtypes.MinRewardsPerEpoch,
+	}
+	k.SetValidatorRewardPoints(ctx, 0, valAddr.String(), 10)
+	k.processValidatorsRewards(ctx, 0, settings)
+
+	// The delegator triples its shares during epoch 1: epoch 0 settles at the
+	// old shares.
+	require.NoError(t, k.Hooks().BeforeDelegationSharesModified(ctx, delegator, valAddr))
+	require.Equal(t, rewardUnits(50), k.GetDelegatorOutstandingReward(ctx, delegator, 0, valAddr.String()))
+	require.Equal(t, uint64(1), k.GetDelegationSettledEpoch(ctx, delegator, valAddr.String()))
+	delegateToValidator(k, valAddr, map[string]int64{
+		operatorAcc.String(): 1,
+		delegator.String():   3,
+	})
+
+	k.SetValidatorRewardPoints(ctx, 1, valAddr.String(), 10)
+	k.processValidatorsRewards(ctx, 1, settings)
+
+	rewards, total := k.GetDelegatorClaimableRewards(ctx, delegator, 0, 0)
+	require.Equal(t, []vrtypes.DelegatorEpochReward{
+		{Epoch: 0, ValidatorAddress: valAddr.String(), Amount: rewardUnits(50)},
+		{Epoch: 1, ValidatorAddress: valAddr.String(), Amount: rewardUnits(75)},
+	}, rewards)
+	require.Equal(t, rewardUnits(125), total)
+	rewards, _ = k.GetDelegatorClaimableRewards(ctx, operatorAcc, 1, 1)
+	require.Equal(t, []vrtypes.DelegatorEpochReward{
+		{Epoch: 1, ValidatorAddress: valAddr.String(), Amount: rewardUnits(25)},
+	}, rewards)
+
+	// A delegation created after the payouts earns nothing from them.
+	require.NoError(t, k.Hooks().BeforeDelegationCreated(ctx, newDelegator, valAddr))
+	delegateToValidator(k, valAddr, map[string]int64{
+		operatorAcc.String():  1,
+		delegator.String():    3,
+		newDelegator.String(): 1,
+	})
+	rewards, _ = k.GetDelegatorClaimableRewards(ctx, newDelegator, 0, 0)
+	require.Empty(t, rewards)
+
+	// Removing a delegation settles it and drops its record.
+	require.NoError(t, k.Hooks().BeforeDelegationRemoved(ctx, operatorAcc, valAddr))
+	require.Equal(t, rewardUnits(50), k.GetDelegatorOutstandingReward(ctx, operatorAcc, 0, valAddr.String()))
+	require.Equal(t, rewardUnits(25), k.GetDelegatorOutstandingReward(ctx, operatorAcc, 1, valAddr.String()))
+	require.Zero(t, k.GetDelegationSettledEpoch(ctx, operatorAcc, valAddr.String()))
+	require.Equal(t, rewardUnits(75), k.GetDelegatorRewardPool(ctx, 1, valAddr.String()))
+
+	_, broken := OutstandingLiabilitiesInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestExpireEpochReleasesUnsettledDelegatorPool(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	delegator := sdk.AccAddress(repeatedAddress(50))
+	delegateToValidator(k, valAddr, map[string]int64{
+		operatorAcc.String(): 1,
+		delegator.String():   2,
+	})
+	k.SetValidatorRewardsCommission(ctx, valAddr.String(), sdkmath.LegacyZeroDec())
+
+	settings := vrtypes.RewardSettings{
+		BlocksInEpoch:   20,
+		RewardsPerEpoch: vrtypes.MinRewardsPerEpoch,
+	}
+	k.SetValidatorRewardPoints(ctx, 0, valAddr.String(), 10)
+	k.processValidatorsRewards(ctx, 0, settings)
+	require.NoError(t, k.Hooks().BeforeDelegationSharesModified(ctx, delegator, valAddr))
+	require.Equal(t, sdkmath.NewInt(666666666666666666), k.GetDelegatorOutstandingReward(ctx, delegator, 0, valAddr.String()).Amount)
+	require.Equal(t, vrtypes.MinRewardsPerEpoch, k.GetOutstandingLiabilities(ctx).Amount.String())
+
+	// The unsettled share of the operator and the truncation dust expire with
+	// the settled row.
+	k.expireEpoch(ctx, 0)
+	require.True(t, k.GetOutstandingLiabilities(ctx).IsZero())
+	require.True(t, k.GetDelegatorRewardPool(ctx, 0, valAddr.String()).IsZero())
+	_, found := k.GetValidatorRewardPerShare(ctx, valAddr.String(), 0)
+	require.False(t, found)
+	rewards, _ := k.GetDelegatorClaimableRewards(ctx, operatorAcc, 0, 0)
+	require.Empty(t, rewards)
+}
+
+func TestProcessValidatorsRewardsKeepsOperatorPayoutWithoutOptIn(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	delegator := sdk.AccAddress(repeatedAddress(50))
+	delegateToValidator(k, valAddr, map[string]int64{
+		operatorAcc.String(): 1,
+		delegator.String():   1,
+	})
+
+	settings := vrtypes.DefaultRewardSettings()
+	k.SetValidatorRewardPoints(ctx, 0, valAddr.String(), 10)
+	k.processValidatorsRewards(ctx, 0, settings)
+
+	expected, err := settings.RewardsCoin()
+	require.NoError(t, err)
+	require.Equal(t, expected, k.GetValidatorOutstandingReward(ctx, 0, valAddr.String()))
+	rewards, _ := k.GetDelegatorClaimableRewards(ctx, delegator, 0, 0)
+	require.Empty(t, rewards)
+}
+
+func TestSetRewardsCommissionStagesRateForNextEpoch(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, consAddr := setupKeeper(t)
+	settings := vrtypes.RewardSettings{
+		BlocksInEpoch:   20,
+		RewardsPerEpoch: "1000000000000000000",
+	}
+	k.SetCurrentRewardSettings(ctx, settings)
+	k.SetNextRewardSettings(ctx, settings)
+	k.SetEpochState(ctx, vrtypes.DefaultEpochState())
+
+	_, err := k.SetRewardsCommission(ctx, &vrtypes.MsgSetRewardsCommission{
+		ValidatorOperator: sdk.AccAddress(repeatedAddress(99)).String(),
+		CommissionRate:    sdkmath.LegacyZeroDec(),
+	})
+	require.ErrorContains(t, err, "target address must be a validator operator")
+
+	_, err = k.SetRewardsCommission(ctx, &vrtypes.MsgSetRewardsCommission{
+		ValidatorOperator: operatorAcc.String(),
+		CommissionRate:    sdkmath.LegacyNewDecWithPrec(15, 1),
+	})
+	require.Error(t, err)
+
+	_, err = k.SetRewardsCommission(ctx, &vrtypes.MsgSetRewardsCommission{
+		ValidatorOperator: operatorAcc.String(),
+		CommissionRate:    sdkmath.LegacyNewDecWithPrec(2, 1),
+	})
+	require.NoError(t, err)
+
+	res, err := k.RewardsCommission(ctx, &vrtypes.QueryRewardsCommissionRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyOneDec(), res.CommissionRate)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(2, 1), res.NextCommissionRate)
+
+	voteInfos := []abci.VoteInfo{{Validator: abci.Validator{Address: consAddr.Bytes(), Power: 100}, BlockIdFlag: cmtproto.BlockIDFlagCommit}}
+	for height := int64(2); height <= 21; height++ {
+		ctx = ctx.WithBlockHeader(cmtproto.Header{Height: height, ProposerAddress: consAddr.Bytes()}).WithVoteInfos(voteInfos)
+		require.NoError(t, k.BeginBlocker(ctx))
+	}
+
+	res, err = k.RewardsCommission(ctx, &vrtypes.QueryRewardsCommissionRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(2, 1), res.CommissionRate)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(2, 1), res.NextCommissionRate)
+	require.Equal(t, rewardCoin(1000000000000000000), k.GetValidatorOutstandingReward(ctx, 0, valAddr.String()))
+
+	_, err = k.SetRewardsCommission(ctx, &vrtypes.MsgSetRewardsCommission{
+		ValidatorOperator: operatorAcc.String(),
+		CommissionRate:    sdkmath.LegacyOneDec(),
+	})
+	require.NoError(t, err)
+	k.promoteNextRewardsCommissions(ctx)
+
+	_, found := k.GetValidatorRewardsCommission(ctx, valAddr.String())
+	require.False(t, found)
+}
+
+func TestClaimDelegatorRewardsSweepsEpochsInBatches(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+	otherValAddr := sdk.ValAddress(repeatedAddress(60))
+	delegator := sdk.AccAddress(repeatedAddress(50))
+	requester := sdk.AccAddress(repeatedAddress(77))
+	fundRewardsModule(&k, rewardCoin(1000))
+
+	k.SetDelegatorOutstandingReward(ctx, delegator, 1, valAddr.String(), rewardCoin(10))
+	k.SetDelegatorOutstandingReward(ctx, delegator, 1, otherValAddr.String(), rewardCoin(11))
+	k.SetDelegatorOutstandingReward(ctx, delegator, 4, valAddr.String(), rewardCoin(40))
+	k.SetDelegatorOutstandingReward(ctx, delegator, 6, valAddr.String(), rewardCoin(60))
+
+	query, err := k.DelegatorRewards(ctx, &vrtypes.QueryDelegatorRewardsRequest{Delegator: delegator.String(), EndEpoch: 4})
+	require.NoError(t, err)
+	require.Equal(t, rewardCoin(61), query.Total)
+	require.Len(t, query.Rewards, 3)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := k.ClaimDelegatorRewards(ctx, &vrtypes.MsgClaimDelegatorRewards{
+		Delegator: delegator.String(),
+		Requester: requester.String(),
+		MaxEpochs: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []vrtypes.DelegatorEpochReward{
+		{Epoch: 1, ValidatorAddress: otherValAddr.String(), Amount: rewardCoin(11)},
+		{Epoch: 1, ValidatorAddress: valAddr.String(), Amount: rewardCoin(10)},
+		{Epoch: 4, ValidatorAddress: valAddr.String(), Amount: rewardCoin(40)},
+	}, res.Rewards)
+	require.Equal(t, rewardCoin(61), res.Total)
+	require.True(t, res.HasMore)
+	require.Equal(t, rewardCoin(61), k.bankKeeper.GetBalance(ctx, delegator, evmtypes.DefaultEVMDenom))
+	require.Zero(t, k.bankKeeper.GetBalance(ctx, requester, evmtypes.DefaultEVMDenom).Amount.Int64())
+
+	var claimEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vrtypes.EventTypeClaimDelegatorRewards {
+			claimEvents++
+		}
+	}
+	require.Equal(t, 3, claimEvents)
+
+	res, err = k.ClaimDelegatorRewards(ctx, &vrtypes.MsgClaimDelegatorRewards{
+		Delegator: delegator.String(),
+		Requester: requester.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, rewardCoin(60), res.Total)
+	require.False(t, res.HasMore)
+
+	_, err = k.ClaimDelegatorRewards(ctx, &vrtypes.MsgClaimDelegatorRewards{
+		Delegator: delegator.String(),
+		Requester: requester.String(),
+	})
+	require.ErrorContains(t, err, vrtypes.ErrNoOutstandingBalance)
+}
+
+func TestClaimDelegatorRewardsRejectsUnderfundedPool(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+	delegator := sdk.AccAddress(repeatedAddress(50))
+	fundRewardsModule(&k, rewardCoin(5))
+	k.SetDelegatorOutstandingReward(ctx, delegator, 1, valAddr.String(), rewardCoin(10))
+
+	_, _, _, err := k.ClaimDelegatorRewardsRange(ctx, delegator, 0, 0, 0)
+	require.ErrorContains(t, err, vrtypes.ErrInsufficientRewardsBalance)
+	require.Equal(t, rewardCoin(10), k.GetDelegatorOutstandingReward(ctx, delegator, 1, valAddr.String()))
+}
+
+func TestProcessValidatorsPointsOnlyRewardsCommitSignatures(t *testing.T) {
+	k, ctx, fixtures := setupKeeperWithValidators(t, 3)
+	stakingKeeper := k.stakingKeeper.(*fakeStakingKeeper)
+	jailed := stakingKeeper.byConsAddr[fixtures[2].consAddr.String()]
+	jailed.Jailed = true
+	stakingKeeper.byConsAddr[fixtures[2].consAddr.String()] = jailed
+
+	settings := vrtypes.DefaultRewardSettings()
+	settings.ProposerBonusPoints = 5
+	ctx = ctx.WithBlockHeader(cmtproto.Header{
+		Height:          2,
+		ProposerAddress: fixtures[1].consAddr.Bytes(),
+	}).WithVoteInfos([]abci.VoteInfo{
+		{
+			Validator:   abci.Validator{Address: fixtures[0].consAddr.Bytes(), Power: 50},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		},
+		{
+			Validator:   abci.Validator{Address: fixtures[1].consAddr.Bytes(), Power: 25},
+			BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+		},
+		{
+			Validator:   abci.Validator{Address: fixtures[2].consAddr.Bytes(), Power: 25},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		},
+	})
+
+	k.processValidatorsPoints(ctx, 0, settings)
+
+	require.Equal(t, uint64(50), k.GetValidatorPoints(ctx, 0, fixtures[0].valAddr.String()))
+	require.Zero(t, k.GetValidatorPoints(ctx, 0, fixtures[1].valAddr.String()))
+	require.Zero(t, k.GetValidatorPoints(ctx, 0, fixtures[2].valAddr.String()))
+}
+
+func TestProcessValidatorsPointsUsesConfiguredProposerBonus(t *testing.T) {
+	k, ctx, _, valAddr, consAddr := setupKeeper(t)
+
+	settings := vrtypes.DefaultRewardSettings()
+	settings.ProposerBonusPoints = 7
+	ctx = ctx.WithBlockHeader(cmtproto.Header{
+		Height:          2,
+		ProposerAddress: consAddr.Bytes(),
+	}).WithVoteInfos([]abci.VoteInfo{{
+		Validator:   abci.Validator{Address: consAddr.Bytes(), Power: 100},
+		BlockIdFlag: cmtproto.BlockIDFlagCommit,
+	}})
+
+	k.processValidatorsPoints(ctx, 0, settings)
+	require.Equal(t, uint64(107), k.GetValidatorPoints(ctx, 0, valAddr.String()))
+}
+
+func TestBeginBlockerForfeitsPointsOfSlashedValidators(t *testing.T) {
+	k, ctx, fixtures := setupKeeperWithValidators(t, 2)
+
+	settings := vrtypes.RewardSettings{
+		BlocksInEpoch:       20,
+		RewardsPerEpoch:     "1000000000000000000",
+		ProposerBonusPoints: 1,
+		SlashForfeitRate:    "0.5",
+	}
+	k.SetCurrentRewardSettings(ctx, settings)
+	k.SetNextRewardSettings(ctx, settings)
+	k.SetEpochState(ctx, vrtypes.EpochState{CurrentEpoch: 4, BlocksIntoCurrentEpoch: 19})
+	k.SetValidatorRewardPoints(ctx, 4, fixtures[0].valAddr.String(), 300)
+	k.SetValidatorRewardPoints(ctx, 4, fixtures[1].valAddr.String(), 100)
+
+	require.NoError(t, k.Hooks().BeforeValidatorSlashed(ctx, fixtures[1].valAddr, sdkmath.LegacyNewDecWithPrec(1, 2)))
+	require.NoError(t, k.Hooks().BeforeValidatorSlashed(ctx, fixtures[0].valAddr, sdkmath.LegacyZeroDec()))
+	require.True(t, k.IsValidatorSlashed(ctx, 4, fixtures[1].valAddr.String()))
+	require.False(t, k.IsValidatorSlashed(ctx, 4, fixtures[0].valAddr.String()))
+
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 100})
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	// The slashed validator keeps 50 of its 100 points; the 50 forfeited
+	// points still count in the total, so their share stays in the pool.
+	require.Equal(t, sdkmath.NewInt(750000000000000000), k.GetValidatorOutstandingReward(ctx, 4, fixtures[0].valAddr.String()).Amount)
+	require.Equal(t, sdkmath.NewInt(125000000000000000), k.GetValidatorOutstandingReward(ctx, 4, fixtures[1].valAddr.String()).Amount)
+	require.False(t, k.IsValidatorSlashed(ctx, 4, fixtures[1].valAddr.String()))
+
+	var forfeited []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vrtypes.EventTypeForfeitRewards {
+			forfeited = append(forfeited, event)
+		}
+	}
+	require.Len(t, forfeited, 1)
+	amount, ok := forfeited[0].GetAttribute(vrtypes.AttributeKeyAmount)
+	require.True(t, ok)
+	require.Equal(t, rewardCoin(125000000000000000).String(), amount.Value)
+}
+
+func TestSetProposerBonusPointsAndSlashForfeitRateStageNextEpochSettingsOnly(t *testing.T) {
+	k, ctx, operatorAcc, _, _ := setupKeeper(t)
+	k.SetParams(ctx, vrtypes.Params{Whitelist: []string{operatorAcc.String()}})
+	k.SetCurrentRewardSettings(ctx, vrtypes.DefaultRewardSettings())
+	k.SetNextRewardSettings(ctx, vrtypes.DefaultRewardSettings())
+
+	_, err := k.SetProposerBonusPoints(sdk.WrapSDKContext(ctx), &vrtypes.MsgSetProposerBonusPoints{
+		Signer:              operatorAcc.String(),
+		ProposerBonusPoints: 3,
+	})
+	require.NoError(t, err)
+	_, err = k.SetSlashForfeitRate(sdk.WrapSDKContext(ctx), &vrtypes.MsgSetSlashForfeitRate{
+		Signer:           operatorAcc.String(),
+		SlashForfeitRate: "0.25",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, vrtypes.DefaultRewardSettings(), k.GetCurrentRewardSettings(ctx))
+	require.Equal(t, uint64(3), k.GetNextRewardSettings(ctx).ProposerBonusPoints)
+	require.Equal(t, "0.25", k.GetNextRewardSettings(ctx).SlashForfeitRate)
+
+	_, err = k.SetSlashForfeitRate(sdk.WrapSDKContext(ctx), &vrtypes.MsgSetSlashForfeitRate{
+		Signer:           operatorAcc.String(),
+		SlashForfeitRate: "2",
+	})
+	require.Error(t, err)
+
+	_, err = k.SetProposerBonusPoints(sdk.WrapSDKContext(ctx), &vrtypes.MsgSetProposerBonusPoints{
+		Signer:              sdk.AccAddress([]byte("not_whitelisted")).String(),
+		ProposerBonusPoints: 2,
+	})
+	require.Error(t, err)
+}
+
+func TestMigrate2to3FillsProposerBonusAndForfeitRate(t *testing.T) {
+	k, ctx, _, _, _ := setupKeeper(t)
+	legacy := vrtypes.RewardSettings{
+		BlocksInEpoch:   20,
+		RewardsPerEpoch: vrtypes.MinRewardsPerEpoch,
+	}
+	storeRawRewardSettings(t, k, ctx, vrtypes.GetCurrentRewardSettingsKey(), legacy)
+
+	require.NoError(t, NewMigrator(k).Migrate2to3(ctx))
+
+	migrated := k.GetCurrentRewardSettings(ctx)
+	require.Equal(t, vrtypes.PROPOSER_BONUS_POINTS, migrated.ProposerBonusPoints)
+	require.Equal(t, vrtypes.SLASH_FORFEIT_RATE, migrated.SlashForfeitRate)
+	require.Nil(t, ctx.KVStore(k.storeKey).Get(vrtypes.GetNextRewardSettingsKey()))
+}
+
+func TestOutstandingLiabilitiesTrackRewardRows(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	delegator := sdk.AccAddress(repeatedAddress(50))
+
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), rewardCoin(100))
+	k.SetValidatorOutstandingReward(ctx, 2, valAddr.String(), rewardCoin(40))
+	k.SetDelegatorOutstandingReward(ctx, delegator, 1, valAddr.String(), rewardCoin(25))
+	require.Equal(t, rewardCoin(165), k.GetOutstandingLiabilities(ctx))
+
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), rewardCoin(60))
+	k.SetDelegatorOutstandingReward(ctx, delegator, 1, valAddr.String(), rewardCoin(0))
+	require.Equal(t, rewardCoin(100), k.GetOutstandingLiabilities(ctx))
+
+	fundRewardsModule(&k, rewardCoin(130))
+	res, err := k.OutstandingLiabilities(sdk.WrapSDKContext(ctx), &vrtypes.QueryOutstandingLiabilitiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, rewardCoin(100), res.Liabilities)
+	require.Equal(t, rewardCoin(130), res.Pool)
+	require.Equal(t, rewardCoin(30), res.Unallocated)
+
+	_, err = k.ClaimRewardsRange(ctx, &vrtypes.MsgClaimRewardsRange{
+		ValidatorOperator: operatorAcc.String(),
+		Requester:         operatorAcc.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, k.GetOutstandingLiabilities(ctx).IsZero())
+}
+
+func TestProcessValidatorsRewardsCapsAllocationToUnallocatedPool(t *testing.T) {
+	k, ctx, fixtures := setupKeeperWithValidators(t, 2)
+	fundRewardsModule(&k, rewardCoin(1000))
+	k.SetValidatorOutstandingReward(ctx, 1, fixtures[0].valAddr.String(), rewardCoin(400))
+
+	settings := vrtypes.RewardSettings{
+		BlocksInEpoch:   20,
+		RewardsPerEpoch: "1000000000000000000",
+	}
+	k.SetValidatorRewardPoints(ctx, 2, fixtures[0].valAddr.String(), 1)
+	k.SetValidatorRewardPoints(ctx, 2, fixtures[1].valAddr.String(), 1)
+	k.processValidatorsRewards(ctx, 2, settings)
+
+	require.Equal(t, rewardCoin(300), k.GetValidatorOutstandingReward(ctx, 2, fixtures[0].valAddr.String()))
+	require.Equal(t, rewardCoin(300), k.GetValidatorOutstandingReward(ctx, 2, fixtures[1].valAddr.String()))
+	require.Equal(t, rewardCoin(1000), k.GetOutstandingLiabilities(ctx))
+	require.True(t, k.GetUnallocatedRewardsPool(ctx).IsZero())
+
+	_, broken := OutstandingLiabilitiesInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestBeginBlockerExpiresRewardsOutsideClaimWindow(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	delegator := sdk.AccAddress(repeatedAddress(50))
+
+	settings := vrtypes.DefaultRewardSettings()
+	settings.ClaimWindowEpochs = 2
+	k.SetCurrentRewardSettings(ctx, settings)
+	k.SetNextRewardSettings(ctx, settings)
+	k.SetEpochState(ctx, vrtypes.EpochState{CurrentEpoch: 4, BlocksIntoCurrentEpoch: 1})
+	k.SetEpochToPay(ctx, 4)
+
+	for epoch := uint64(0); epoch < 3; epoch++ {
+		k.SetValidatorOutstandingReward(ctx, epoch, valAddr.String(), rewardCoin(10))
+		k.SetValidatorRewardPoints(ctx, epoch, valAddr.String(), 5)
+	}
+	k.SetDelegatorOutstandingReward(ctx, delegator, 1, valAddr.String(), rewardCoin(7))
+	k.SetDelegatorOutstandingReward(ctx, delegator, 2, valAddr.String(), rewardCoin(3))
+	require.Equal(t, rewardCoin(40), k.GetOutstandingLiabilities(ctx))
+
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 10})
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	// Epochs 0 and 1 left the two epoch claim window once epoch 4 started.
+	require.Equal(t, uint64(2), k.GetNextEpochToExpire(ctx))
+	require.True(t, k.GetValidatorOutstandingReward(ctx, 0, valAddr.String()).IsZero())
+	require.True(t, k.GetValidatorOutstandingReward(ctx, 1, valAddr.String()).IsZero())
+	require.True(t, k.GetDelegatorOutstandingReward(ctx, delegator, 1, valAddr.String()).IsZero())
+	require.Empty(t, k.GetEpochValidatorsPoints(ctx, 1))
+	require.Equal(t, rewardCoin(10), k.GetValidatorOutstandingReward(ctx, 2, valAddr.String()))
+	require.Len(t, k.GetEpochValidatorsPoints(ctx, 2), 1)
+	require.Equal(t, rewardCoin(13), k.GetOutstandingLiabilities(ctx))
+
+	rewards, _ := k.GetClaimableRewards(ctx, valAddr.String(), 0, 0)
+	require.Len(t, rewards, 1)
+	require.Equal(t, uint64(2), rewards[0].Epoch)
+
+	var expired []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vrtypes.EventTypeExpireRewards {
+			expired = append(expired, event)
+		}
+	}
+	require.Len(t, expired, 3)
+	delegatorAttr, ok := expired[2].GetAttribute(vrtypes.AttributeKeyDelegator)
+	require.True(t, ok)
+	require.Equal(t, delegator.String(), delegatorAttr.Value)
+
+	_, err := k.ClaimRewardsRange(ctx, &vrtypes.MsgClaimRewardsRange{
+		ValidatorOperator: operatorAcc.String(),
+		Requester:         operatorAcc.String(),
+		EndEpoch:          1,
+	})
+	require.ErrorContains(t, err, "no outstanding balance")
+}
+
+func TestExpireOutstandingRewardsPrunesBoundedEpochsPerBlock(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+
+	for epoch := uint64(0); epoch < 15; epoch++ {
+		k.SetValidatorOutstandingReward(ctx, epoch, valAddr.String(), rewardCoin(1))
+	}
+
+	k.expireOutstandingRewards(ctx, 20, 3)
+	require.Equal(t, vrtypes.MaxExpiredEpochsPerBlock, k.GetNextEpochToExpire(ctx))
+	require.Equal(t, rewardCoin(5), k.GetOutstandingLiabilities(ctx))
+
+	k.expireOutstandingRewards(ctx, 20, 3)
+	require.Equal(t, uint64(17), k.GetNextEpochToExpire(ctx))
+	require.True(t, k.GetOutstandingLiabilities(ctx).IsZero())
+
+	// A zero claim window keeps rewards claimable forever.
+	k.SetValidatorOutstandingReward(ctx, 17, valAddr.String(), rewardCoin(1))
+	k.expireOutstandingRewards(ctx, 40, 0)
+	require.Equal(t, uint64(17), k.GetNextEpochToExpire(ctx))
+	require.Equal(t, rewardCoin(1), k.GetOutstandingLiabilities(ctx))
+}
+
+func TestSetClaimWindowEpochsStagesNextEpochSettingsOnly(t *testing.T) {
+	k, ctx, operatorAcc, _, _ := setupKeeper(t)
+	k.SetParams(ctx, vrtypes.Params{Whitelist: []string{operatorAcc.String()}})
+	k.SetCurrentRewardSettings(ctx, vrtypes.DefaultRewardSettings())
+	k.SetNextRewardSettings(ctx, vrtypes.DefaultRewardSettings())
+
+	_, err := k.SetClaimWindowEpochs(sdk.WrapSDKContext(ctx), &vrtypes.MsgSetClaimWindowEpochs{
+		Signer:            operatorAcc.String(),
+		ClaimWindowEpochs: 7,
+	})
+	require.NoError(t, err)
+	require.Equal(t, vrtypes.DefaultRewardSettings(), k.GetCurrentRewardSettings(ctx))
+	require.Equal(t, uint64(7), k.GetNextRewardSettings(ctx).ClaimWindowEpochs)
+
+	_, err = k.SetClaimWindowEpochs(sdk.WrapSDKContext(ctx), &vrtypes.MsgSetClaimWindowEpochs{
+		Signer:            sdk.AccAddress([]byte("not_whitelisted")).String(),
+		ClaimWindowEpochs: 2,
+	})
+	require.Error(t, err)
+}
+
+func TestOutstandingLiabilitiesInvariant(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+	fundRewardsModule(&k, rewardCoin(50))
+
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), rewardCoin(50))
+	_, broken := OutstandingLiabilitiesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.setOutstandingLiabilities(ctx, sdkmath.NewInt(40))
+	msg, broken := OutstandingLiabilitiesInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "do not match")
+
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), rewardCoin(0))
+	k.SetValidatorOutstandingReward(ctx, 2, valAddr.String(), rewardCoin(60))
+	k.setOutstandingLiabilities(ctx, sdkmath.NewInt(60))
+	msg, broken = OutstandingLiabilitiesInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "does not cover")
+}
+
+func TestMigrate3to4BuildsLiabilitiesAndClaimWindow(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+	delegator := sdk.AccAddress(repeatedAddress(50))
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(vrtypes.GetEpochValidatorOutstandingKey(3, []byte(valAddr.String())), vrutils.EncodeCoin(rewardCoin(20)))
+	store.Set(vrtypes.GetDelegatorOutstandingKey(delegator, 3, []byte(valAddr.String())), vrutils.EncodeCoin(rewardCoin(5)))
+	legacy := vrtypes.DefaultRewardSettings()
+	legacy.ClaimWindowEpochs = 0
+	storeRawRewardSettings(t, k, ctx, vrtypes.GetCurrentRewardSettingsKey(), legacy)
+
+	require.NoError(t, NewMigrator(k).Migrate3to4(ctx))
+
+	require.Equal(t, rewardCoin(25), k.GetOutstandingLiabilities(ctx))
+	require.True(t, store.Has(vrtypes.GetEpochDelegatorOutstandingKey(3, delegator, []byte(valAddr.String()))))
+	require.Equal(t, vrtypes.CLAIM_WINDOW_EPOCHS, k.GetCurrentRewardSettings(ctx).ClaimWindowEpochs)
+
+	k.expireOutstandingRewards(ctx, 3+vrtypes.CLAIM_WINDOW_EPOCHS+1, vrtypes.CLAIM_WINDOW_EPOCHS)
+	require.True(t, k.GetOutstandingLiabilities(ctx).IsZero())
+	require.True(t, k.GetDelegatorOutstandingReward(ctx, delegator, 3, valAddr.String()).IsZero())
+}
+
+func TestRewardsRunwayQuery(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+	settings := vrtypes.DefaultRewardSettings()
+	settings.RewardsPerEpoch = "1000000000000000000"
+	k.SetCurrentRewardSettings(ctx, settings)
+	k.SetParams(ctx, vrtypes.Params{Whitelist: []string{}, RunwayAlertEpochs: 4})
+
+	fundRewardsModule(&k, sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntWithDecimal(35, 17)))
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntWithDecimal(1, 17)))
+
+	res, err := k.RewardsRunway(sdk.WrapSDKContext(ctx), &vrtypes.QueryRewardsRunwayRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewIntWithDecimal(34, 17), res.Unallocated.Amount)
+	require.Equal(t, sdkmath.NewIntWithDecimal(1, 17), res.Liabilities.Amount)
+	require.Equal(t, sdkmath.NewIntWithDecimal(1, 18), res.RewardsPerEpoch.Amount)
+	require.Equal(t, uint64(3), res.FundedEpochs)
+	require.Equal(t, uint64(4), res.RunwayAlertEpochs)
+
+	_, err = k.RewardsRunway(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
+
+func TestBeginBlockerEmitsLowRunwayAndShortfallEvents(t *testing.T) {
+	k, ctx, _, valAddr, _ := setupKeeper(t)
+	settings := vrtypes.RewardSettings{
+		BlocksInEpoch:   20,
+		RewardsPerEpoch: "1000000000000000000",
+	}
+	k.SetCurrentRewardSettings(ctx, settings)
+	k.SetNextRewardSettings(ctx, settings)
+	k.SetParams(ctx, vrtypes.Params{Whitelist: []string{}, RunwayAlertEpochs: 3})
+	k.SetEpochState(ctx, vrtypes.EpochState{CurrentEpoch: 2, BlocksIntoCurrentEpoch: 19})
+	k.SetEpochToPay(ctx, 2)
+	k.SetValidatorRewardPoints(ctx, 2, valAddr.String(), 10)
+	fundRewardsModule(&k, sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntWithDecimal(25, 17)))
+
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 100})
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	// One epoch is allocated in full; 1.5 epochs of rewards remain unallocated.
+	events := ctx.EventManager().Events()
+	var runwayLow []sdk.Event
+	for _, event := range events {
+		require.NotEqual(t, vrtypes.EventTypeRewardsFundingShortfall, event.Type)
+		if event.Type == vrtypes.EventTypeRewardsRunwayLow {
+			runwayLow = append(runwayLow, event)
+		}
+	}
+	require.Len(t, runwayLow, 1)
+	funded, ok := runwayLow[0].GetAttribute(vrtypes.AttributeKeyFundedEpochs)
+	require.True(t, ok)
+	require.Equal(t, "1", funded.Value)
+	epochAttr, ok := runwayLow[0].GetAttribute(vrtypes.AttributeKeyEpoch)
+	require.True(t, ok)
+	require.Equal(t, "3", epochAttr.Value)
+
+	// Draining the unallocated balance caps the next allocation.
+	k.SetEpochState(ctx, vrtypes.EpochState{CurrentEpoch: 3, BlocksIntoCurrentEpoch: 19})
+	k.SetValidatorRewardPoints(ctx, 3, valAddr.String(), 10)
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntWithDecimal(1, 18)))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	require.Equal(t, sdkmath.NewIntWithDecimal(5, 17), k.GetValidatorOutstandingReward(ctx, 3, valAddr.String()).Amount)
+	var shortfall []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vrtypes.EventTypeRewardsFundingShortfall {
+			shortfall = append(shortfall, event)
+		}
+	}
+	require.Len(t, shortfall, 1)
+	allocated, ok := shortfall[0].GetAttribute(vrtypes.AttributeKeyAmount)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntWithDecimal(5, 17)).String(), allocated.Value)
+}
+
+func TestClaimRewardsPaysWithdrawAddress(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	withdrawAddr := sdk.AccAddress(repeatedAddress(60))
+	fundRewardsModule(&k, rewardCoin(1000))
+
+	require.Equal(t, operatorAcc, k.GetWithdrawAddress(ctx, valAddr.String()))
+	_, err := k.SetWithdrawAddress(ctx, &vrtypes.MsgSetWithdrawAddress{
+		ValidatorOperator: operatorAcc.String(),
+		WithdrawAddress:   withdrawAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, withdrawAddr, k.GetWithdrawAddress(ctx, valAddr.String()))
+
+	k.SetValidatorOutstandingReward(ctx, 1, valAddr.String(), rewardCoin(10))
+	k.SetValidatorOutstandingReward(ctx, 2, valAddr.String(), rewardCoin(20))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, _, _, err = k.ClaimValidatorRewardsRange(ctx, operatorAcc, 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, rewardCoin(30), k.bankKeeper.GetBalance(ctx, withdrawAddr, evmtypes.DefaultEVMDenom))
+	require.True(t, k.bankKeeper.GetBalance(ctx, operatorAcc, evmtypes.DefaultEVMDenom).IsZero())
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != vrtypes.EventTypeClaimRewards {
+			continue
+		}
+		recipient, ok := event.GetAttribute(vrtypes.AttributeKeyRecipient)
+		require.True(t, ok)
+		require.Equal(t, withdrawAddr.String(), recipient.Value)
+	}
+
+	// Pointing the withdraw address back at the operator clears the entry.
+	_, err = k.SetWithdrawAddress(ctx, &vrtypes.MsgSetWithdrawAddress{
+		ValidatorOperator: operatorAcc.String(),
+		WithdrawAddress:   operatorAcc.String(),
+	})
+	require.NoError(t, err)
+	var entries int
+	k.IterateWithdrawAddresses(ctx, func(string, sdk.AccAddress) bool {
+		entries++
+		return false
+	})
+	require.Zero(t, entries)
+}
+
+func TestSetWithdrawAddressRejectsBlockedAndNonOperators(t *testing.T) {
+	k, ctx, operatorAcc, _, _ := setupKeeper(t)
+	blockedAddr := sdk.AccAddress(repeatedAddress(61))
+	k.bankKeeper.(*fakeBankKeeper).blocked = map[string]bool{blockedAddr.String(): true}
+
+	_, err := k.SetWithdrawAddress(ctx, &vrtypes.MsgSetWithdrawAddress{
+		ValidatorOperator: operatorAcc.String(),
+		WithdrawAddress:   blockedAddr.String(),
+	})
+	require.ErrorContains(t, err, "not allowed to receive funds")
+
+	nonValidator := sdk.AccAddress(repeatedAddress(99))
+	_, err = k.SetWithdrawAddress(ctx, &vrtypes.MsgSetWithdrawAddress{
+		ValidatorOperator: nonValidator.String(),
+		WithdrawAddress:   nonValidator.String(),
+	})
+	require.ErrorContains(t, err, "target address must be a validator operator")
+
+	_, err = k.SetAutoCompound(ctx, &vrtypes.MsgSetAutoCompound{
+		ValidatorOperator: nonValidator.String(),
+		Enabled:           true,
+	})
+	require.ErrorContains(t, err, "target address must be a validator operator")
+}
+
+func TestBeginBlockerAutoCompoundsOperatorRewards(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	settings := vrtypes.RewardSettings{
+		BlocksInEpoch:   20,
+		RewardsPerEpoch: "1000000000000000000",
+	}
+	k.SetCurrentRewardSettings(ctx, settings)
+	k.SetNextRewardSettings(ctx, settings)
+	k.SetEpochState(ctx, vrtypes.EpochState{CurrentEpoch: 2, BlocksIntoCurrentEpoch: 19})
+	k.SetEpochToPay(ctx, 2)
+	k.SetValidatorRewardPoints(ctx, 2, valAddr.String(), 10)
+
+	_, err := k.SetAutoCompound(ctx, &vrtypes.MsgSetAutoCompound{
+		ValidatorOperator: operatorAcc.String(),
+		Enabled:           true,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 100})
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	stakingKeeper := k.stakingKeeper.(*fakeStakingKeeper)
+	require.Equal(t, sdkmath.NewIntWithDecimal(1, 18), stakingKeeper.delegated[operatorAcc.String()+"/"+valAddr.String()])
+	require.True(t, k.GetValidatorOutstandingReward(ctx, 2, valAddr.String()).IsZero())
+	require.True(t, k.GetOutstandingLiabilities(ctx).IsZero())
+
+	var compounded []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vrtypes.EventTypeAutoCompoundRewards {
+			compounded = append(compounded, event)
+		}
+	}
+	require.Len(t, compounded, 1)
+	amountAttr, ok := compounded[0].GetAttribute(vrtypes.AttributeKeyAmount)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntWithDecimal(1, 18)).String(), amountAttr.Value)
+}
+
+func TestAutoCompoundFailureLeavesRewardsClaimable(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		setup func(*fakeStakingKeeper)
+	}{
+		{
+			name:  "delegation fails",
+			setup: func(f *fakeStakingKeeper) { f.delegateErr = errors.New("validator is jailed") },
+		},
+		{
+			name:  "bond denom differs from rewards denom",
+			setup: func(f *fakeStakingKeeper) { f.bondDenom = "stake" },
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _, valAddr, _ := setupKeeper(t)
+			tc.setup(k.stakingKeeper.(*fakeStakingKeeper))
+			k.SetValidatorAutoCompound(ctx, valAddr.String(), true)
+			k.SetValidatorOutstandingReward(ctx, 3, valAddr.String(), rewardCoin(50))
+
+			k.autoCompoundRewards(ctx, 3)
+
+			require.Equal(t, rewardCoin(50), k.GetValidatorOutstandingReward(ctx, 3, valAddr.String()))
+			require.Equal(t, rewardCoin(50), k.GetOutstandingLiabilities(ctx))
+			require.Empty(t, k.stakingKeeper.(*fakeStakingKeeper).delegated)
+		})
+	}
+}
+
+func TestPayoutSettingsQuery(t *testing.T) {
+	k, ctx, operatorAcc, valAddr, _ := setupKeeper(t)
+	withdrawAddr := sdk.AccAddress(repeatedAddress(62))
+
+	res, err := k.PayoutSettings(sdk.WrapSDKContext(ctx), &vrtypes.QueryPayoutSettingsRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, operatorAcc.String(), res.WithdrawAddress)
+	require.False(t, res.AutoCompound)
+
+	k.SetValidatorWithdrawAddress(ctx, valAddr.String(), withdrawAddr)
+	k.SetValidatorAutoCompound(ctx, valAddr.String(), true)
+	res, err = k.PayoutSettings(sdk.WrapSDKContext(ctx), &vrtypes.QueryPayoutSettingsRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, withdrawAddr.String(), res.WithdrawAddress)
+	require.True(t, res.AutoCompound)
+
+	_, err = k.PayoutSettings(sdk.WrapSDKContext(ctx), &vrtypes.QueryPayoutSettingsRequest{ValidatorAddress: operatorAcc.String()})
+	require.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 builds the per-validator index of non-zero outstanding rewards
+// used by range claims from the existing epoch-major rows.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var indexKeys [][]byte
+	m.keeper.IterateAllValidatorsOutstandingRewards(ctx, func(epoch uint64, validatorAddress string, amount sdk.Coin) bool {
+		if !amount.IsZero() {
+			indexKeys = append(indexKeys, vrtypes.GetValidatorOutstandingEpochKey([]byte(validatorAddress), epoch))
+		}
+		return false
+	})
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+	return nil
+}
+
+// Migrate2to3 fills the proposer bonus and slash forfeit rate that joined the
+// reward settings. Stored settings keep the previously hard-coded proposer
+// bonus and pick up the default forfeit rate.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, key := range [][]byte{vrtypes.GetCurrentRewardSettingsKey(), vrtypes.GetNextRewardSettingsKey()} {
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var settings vrtypes.RewardSettings
+		if err := m.keeper.cdc.Unmarshal(bz, &settings); err != nil {
+			return err
+		}
+		if settings.ProposerBonusPoints == 0 {
+			settings.ProposerBonusPoints = vrtypes.PROPOSER_BONUS_POINTS
+		}
+		if settings.SlashForfeitRate == "" {
+			settings.SlashForfeitRate = vrtypes.SLASH_FORFEIT_RATE
+		}
+		store.Set(key, m.keeper.cdc.MustMarshal(&settings))
+	}
+	return nil
+}
+
+// Migrate3to4 records the outstanding liabilities total and the epoch index of
+// the delegator reward rows, and gives stored settings the default claim
+// window.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	liabilities := math.ZeroInt()
+	m.keeper.IterateAllValidatorsOutstandingRewards(ctx, func(_ uint64, _ string, amount sdk.Coin) bool {
+		liabilities = liabilities.Add(amount.Amount)
+		return false
+	})
+
+	var indexKeys [][]byte
+	m.keeper.IterateAllDelegatorsOutstandingRewards(ctx, func(delegator sdk.AccAddress, epoch uint64, validatorAddress string, amount sdk.Coin) bool {
+		liabilities = liabilities.Add(amount.Amount)
+		indexKeys = append(indexKeys, vrtypes.GetEpochDelegatorOutstandingKey(epoch, delegator, []byte(validatorAddress)))
+		return false
+	})
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+	m.keeper.setOutstandingLiabilities(ctx, liabilities)
+
+	for _, key := range [][]byte{vrtypes.GetCurrentRewardSettingsKey(), vrtypes.GetNextRewardSettingsKey()} {
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var settings vrtypes.RewardSettings
+		if err := m.keeper.cdc.Unmarshal(bz, &settings); err != nil {
+			return err
+		}
+		if settings.ClaimWindowEpochs == 0 {
+			settings.ClaimWindowEpochs = vrtypes.CLAIM_WINDOW_EPOCHS
+		}
+		store.Set(key, m.keeper.cdc.MustMarshal(&settings))
+	}
+	return nil
+}
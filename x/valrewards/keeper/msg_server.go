@@ -109,6 +109,78 @@ func (k Keeper) SetRewardingPaused(goCtx context.Context, msg *vrtypes.MsgSetRew
 	return &vrtypes.MsgSetRewardingPausedResponse{}, nil
 }
 
+func (k Keeper) SetProposerBonusPoints(goCtx context.Context, msg *vrtypes.MsgSetProposerBonusPoints) (*vrtypes.MsgSetProposerBonusPointsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signer address")
+	}
+	if !k.IsWhitelisted(ctx, signer) {
+		return nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "signer not whitelisted")
+	}
+
+	settings := k.GetNextRewardSettings(ctx)
+	settings.ProposerBonusPoints = msg.ProposerBonusPoints
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetNextRewardSettings(ctx, settings)
+	return &vrtypes.MsgSetProposerBonusPointsResponse{}, nil
+}
+
+func (k Keeper) SetSlashForfeitRate(goCtx context.Context, msg *vrtypes.MsgSetSlashForfeitRate) (*vrtypes.MsgSetSlashForfeitRateResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signer address")
+	}
+	if !k.IsWhitelisted(ctx, signer) {
+		return nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "signer not whitelisted")
+	}
+
+	settings := k.GetNextRewardSettings(ctx)
+	settings.SlashForfeitRate = msg.SlashForfeitRate
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetNextRewardSettings(ctx, settings)
+	return &vrtypes.MsgSetSlashForfeitRateResponse{}, nil
+}
+
+func (k Keeper) SetClaimWindowEpochs(goCtx context.Context, msg *vrtypes.MsgSetClaimWindowEpochs) (*vrtypes.MsgSetClaimWindowEpochsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signer address")
+	}
+	if !k.IsWhitelisted(ctx, signer) {
+		return nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "signer not whitelisted")
+	}
+
+	settings := k.GetNextRewardSettings(ctx)
+	settings.ClaimWindowEpochs = msg.ClaimWindowEpochs
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetNextRewardSettings(ctx, settings)
+	return &vrtypes.MsgSetClaimWindowEpochsResponse{}, nil
+}
+
 func (k Keeper) ClaimRewards(goCtx context.Context, msg *vrtypes.MsgClaimRewards) (*vrtypes.MsgClaimRewardsResponse, error) {
 	if msg == nil {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
@@ -131,6 +203,97 @@ func (k Keeper) ClaimRewards(goCtx context.Context, msg *vrtypes.MsgClaimRewards
 	return &vrtypes.MsgClaimRewardsResponse{}, nil
 }
 
+func (k Keeper) ClaimRewardsRange(goCtx context.Context, msg *vrtypes.MsgClaimRewardsRange) (*vrtypes.MsgClaimRewardsRangeResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := vrtypes.ParseAccAddress(msg.ValidatorOperator)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := vrtypes.ParseAccAddress(msg.Requester); err != nil {
+		return nil, err
+	}
+	if err := vrtypes.ValidateClaimRange(msg.StartEpoch, msg.EndEpoch, msg.MaxEpochs); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	rewards, total, hasMore, err := k.ClaimValidatorRewardsRange(ctx, operator, msg.StartEpoch, msg.EndEpoch, msg.MaxEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vrtypes.MsgClaimRewardsRangeResponse{
+		Rewards: rewards,
+		Total:   total,
+		HasMore: hasMore,
+	}, nil
+}
+
+func (k Keeper) SetRewardsCommission(goCtx context.Context, msg *vrtypes.MsgSetRewardsCommission) (*vrtypes.MsgSetRewardsCommissionResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := vrtypes.ParseAccAddress(msg.ValidatorOperator)
+	if err != nil {
+		return nil, err
+	}
+	if err := vrtypes.ValidateRewardsCommissionRate(msg.CommissionRate); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	if err := k.ensureValidatorOperator(ctx, operator); err != nil {
+		return nil, err
+	}
+
+	validatorAddress := sdk.ValAddress(operator.Bytes()).String()
+	k.SetNextValidatorRewardsCommission(ctx, validatorAddress, msg.CommissionRate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeSetRewardsCommission,
+			sdk.NewAttribute(vrtypes.AttributeKeyValidator, validatorAddress),
+			sdk.NewAttribute(vrtypes.AttributeKeyCommissionRate, msg.CommissionRate.String()),
+		),
+	)
+	return &vrtypes.MsgSetRewardsCommissionResponse{}, nil
+}
+
+func (k Keeper) ClaimDelegatorRewards(goCtx context.Context, msg *vrtypes.MsgClaimDelegatorRewards) (*vrtypes.MsgClaimDelegatorRewardsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := vrtypes.ParseAccAddress(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := vrtypes.ParseAccAddress(msg.Requester); err != nil {
+		return nil, err
+	}
+	if err := vrtypes.ValidateClaimRange(msg.StartEpoch, msg.EndEpoch, msg.MaxEpochs); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	rewards, total, hasMore, err := k.ClaimDelegatorRewardsRange(ctx, delegator, msg.StartEpoch, msg.EndEpoch, msg.MaxEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vrtypes.MsgClaimDelegatorRewardsResponse{
+		Rewards: rewards,
+		Total:   total,
+		HasMore: hasMore,
+	}, nil
+}
+
 func (k Keeper) DepositRewardsPool(goCtx context.Context, msg *vrtypes.MsgDepositRewardsPool) (*vrtypes.MsgDepositRewardsPoolResponse, error) {
 	if msg == nil {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
@@ -156,3 +319,43 @@ func (k Keeper) DepositRewardsPool(goCtx context.Context, msg *vrtypes.MsgDeposi
 
 	return &vrtypes.MsgDepositRewardsPoolResponse{}, nil
 }
+
+func (k Keeper) SetWithdrawAddress(goCtx context.Context, msg *vrtypes.MsgSetWithdrawAddress) (*vrtypes.MsgSetWithdrawAddressResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := vrtypes.ParseAccAddress(msg.ValidatorOperator)
+	if err != nil {
+		return nil, err
+	}
+	withdrawAddress, err := vrtypes.ParseAccAddress(msg.WithdrawAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid withdraw address")
+	}
+
+	if err := k.SetOperatorWithdrawAddress(ctx, operator, withdrawAddress); err != nil {
+		return nil, err
+	}
+	return &vrtypes.MsgSetWithdrawAddressResponse{}, nil
+}
+
+func (k Keeper) SetAutoCompound(goCtx context.Context, msg *vrtypes.MsgSetAutoCompound) (*vrtypes.MsgSetAutoCompoundResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := vrtypes.ParseAccAddress(msg.ValidatorOperator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetOperatorAutoCompound(ctx, operator, msg.Enabled); err != nil {
+		return nil, err
+	}
+	return &vrtypes.MsgSetAutoCompoundResponse{}, nil
+}
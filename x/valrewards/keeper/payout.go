@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+)
+
+// GetWithdrawAddress returns the account that receives the claimed rewards of
+// the validator. It is the operator account unless another one was set.
+func (k Keeper) GetWithdrawAddress(ctx sdk.Context, validatorAddress string) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(vrtypes.GetWithdrawAddressKey([]byte(validatorAddress)))
+	if bz != nil {
+		return sdk.AccAddress(bz)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return nil
+	}
+	return sdk.AccAddress(valAddr.Bytes())
+}
+
+// SetValidatorWithdrawAddress stores the withdraw address of the validator.
+// Setting it to the operator account removes the entry.
+func (k Keeper) SetValidatorWithdrawAddress(ctx sdk.Context, validatorAddress string, withdrawAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := vrtypes.GetWithdrawAddressKey([]byte(validatorAddress))
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err == nil && withdrawAddress.Equals(sdk.AccAddress(valAddr.Bytes())) {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, withdrawAddress.Bytes())
+}
+
+// IterateWithdrawAddresses walks the validators that set a withdraw address
+// other than their operator account.
+func (k Keeper) IterateWithdrawAddresses(ctx sdk.Context, cb func(validatorAddress string, withdrawAddress sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vrtypes.KeyPrefixWithdrawAddress)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validatorAddress := string(iterator.Key()[len(vrtypes.KeyPrefixWithdrawAddress):])
+		if cb(validatorAddress, sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// IsAutoCompoundEnabled reports whether the epoch rewards of the validator are
+// delegated back to it at the epoch rollover.
+func (k Keeper) IsAutoCompoundEnabled(ctx sdk.Context, validatorAddress string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(vrtypes.GetAutoCompoundKey([]byte(validatorAddress)))
+}
+
+// SetValidatorAutoCompound turns auto-compounding of the validator on or off.
+func (k Keeper) SetValidatorAutoCompound(ctx sdk.Context, validatorAddress string, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := vrtypes.GetAutoCompoundKey([]byte(validatorAddress))
+	if !enabled {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, []byte{})
+}
+
+// IterateAutoCompoundValidators walks the validators with auto-compounding on.
+func (k Keeper) IterateAutoCompoundValidators(ctx sdk.Context, cb func(validatorAddress string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vrtypes.KeyPrefixAutoCompound)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()[len(vrtypes.KeyPrefixAutoCompound):])) {
+			break
+		}
+	}
+}
+
+// SetOperatorWithdrawAddress sets the withdraw address on behalf of the
+// validator operator. Module accounts that cannot receive funds are rejected.
+func (k Keeper) SetOperatorWithdrawAddress(ctx sdk.Context, operator, withdrawAddress sdk.AccAddress) error {
+	if err := k.ensureValidatorOperator(ctx, operator); err != nil {
+		return err
+	}
+	if k.bankKeeper.BlockedAddr(withdrawAddress) {
+		return errortypes.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", withdrawAddress)
+	}
+
+	validatorAddress := sdk.ValAddress(operator.Bytes()).String()
+	k.SetValidatorWithdrawAddress(ctx, validatorAddress, withdrawAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(vrtypes.AttributeKeyValidator, validatorAddress),
+			sdk.NewAttribute(vrtypes.AttributeKeyWithdrawAddress, withdrawAddress.String()),
+		),
+	)
+	return nil
+}
+
+// SetOperatorAutoCompound turns auto-compounding on or off on behalf of the
+// validator operator.
+func (k Keeper) SetOperatorAutoCompound(ctx sdk.Context, operator sdk.AccAddress, enabled bool) error {
+	if err := k.ensureValidatorOperator(ctx, operator); err != nil {
+		return err
+	}
+
+	validatorAddress := sdk.ValAddress(operator.Bytes()).String()
+	k.SetValidatorAutoCompound(ctx, validatorAddress, enabled)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeSetAutoCompound,
+			sdk.NewAttribute(vrtypes.AttributeKeyValidator, validatorAddress),
+			sdk.NewAttribute(vrtypes.AttributeKeyAutoCompound, strconv.FormatBool(enabled)),
+		),
+	)
+	return nil
+}
+
+// autoCompoundRewards delegates the operator share of the completed epoch to
+// every validator with auto-compounding on. A validator whose delegation
+// fails keeps its reward outstanding so it can still be claimed.
+func (k Keeper) autoCompoundRewards(ctx sdk.Context, epoch uint64) {
+	var validators []string
+	k.IterateAutoCompoundValidators(ctx, func(validatorAddress string) bool {
+		validators = append(validators, validatorAddress)
+		return false
+	})
+	if len(validators) == 0 {
+		return
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil || bondDenom != evmtypes.DefaultEVMDenom {
+		ctx.Logger().Error(
+			"valrewards: rewards denom cannot be staked, skipping auto-compounding",
+			"epoch", epoch,
+			"bond_denom", bondDenom,
+			"err", err,
+		)
+		return
+	}
+
+	for _, validatorAddress := range validators {
+		reward := k.GetValidatorOutstandingReward(ctx, epoch, validatorAddress)
+		if !reward.IsPositive() {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.compoundValidatorReward(cacheCtx, epoch, validatorAddress, reward); err != nil {
+			ctx.Logger().Error(
+				"valrewards: failed to auto-compound validator rewards, leaving them claimable",
+				"epoch", epoch,
+				"validator", validatorAddress,
+				"err", err,
+			)
+			continue
+		}
+		write()
+	}
+}
+
+func (k Keeper) compoundValidatorReward(ctx sdk.Context, epoch uint64, validatorAddress string, reward sdk.Coin) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return err
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	operator := sdk.AccAddress(valAddr.Bytes())
+
+	k.SetValidatorOutstandingReward(ctx, epoch, validatorAddress, sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt()))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, vrtypes.ModuleName, operator, sdk.NewCoins(reward)); err != nil {
+		return err
+	}
+	if _, err := k.stakingKeeper.Delegate(ctx, operator, reward.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeAutoCompoundRewards,
+			sdk.NewAttribute(vrtypes.AttributeKeyValidator, validatorAddress),
+			sdk.NewAttribute(vrtypes.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyAmount, reward.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+)
+
+// GetRewardsRunway returns how many full epochs the unallocated rewards pool
+// can fund at the current reward settings, together with the unallocated
+// balance and the per-epoch allocation it was measured against.
+func (k Keeper) GetRewardsRunway(ctx sdk.Context) (fundedEpochs uint64, unallocated, rewardsPerEpoch sdk.Coin) {
+	unallocated = k.GetUnallocatedRewardsPool(ctx)
+	rewardsPerEpoch, err := k.GetCurrentRewardSettings(ctx).RewardsCoin()
+	if err != nil {
+		return 0, unallocated, sdk.NewCoin(evmtypes.DefaultEVMDenom, math.ZeroInt())
+	}
+	return vrtypes.RunwayEpochs(unallocated.Amount, rewardsPerEpoch.Amount), unallocated, rewardsPerEpoch
+}
+
+// checkRewardsRunway emits a rewards_runway_low event when the pool can fund
+// fewer epochs than the governance-set alert threshold.
+func (k Keeper) checkRewardsRunway(ctx sdk.Context, epoch uint64) {
+	threshold := k.GetParams(ctx).RunwayAlertEpochs
+	if threshold == 0 {
+		return
+	}
+
+	fundedEpochs, unallocated, rewardsPerEpoch := k.GetRewardsRunway(ctx)
+	if fundedEpochs >= threshold {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeRewardsRunwayLow,
+			sdk.NewAttribute(vrtypes.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyFundedEpochs, strconv.FormatUint(fundedEpochs, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyRunwayAlertEpochs, strconv.FormatUint(threshold, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyUnallocated, unallocated.String()),
+			sdk.NewAttribute(vrtypes.AttributeKeyRewardsPerEpoch, rewardsPerEpoch.String()),
+		),
+	)
+}
+
+func emitFundingShortfallEvent(ctx sdk.Context, epoch uint64, required, allocated sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeRewardsFundingShortfall,
+			sdk.NewAttribute(vrtypes.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyRewardsPerEpoch, required.String()),
+			sdk.NewAttribute(vrtypes.AttributeKeyAmount, allocated.String()),
+		),
+	)
+}
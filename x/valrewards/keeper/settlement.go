@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"math"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+	vrutils "github.com/cosmos/evm/x/valrewards/utils"
+)
+
+// allocateDelegatorRewards splits the epoch reward of a validator that opted
+// into delegator pass-through. The operator keeps the commission and the rest
+// is set aside as the delegator pool of the epoch, together with the reward
+// per delegator share at the epoch boundary. Delegations settle their part
+// lazily, on claim or when their shares change, so the payout does not walk
+// the delegations. It returns the part of the reward left to the operator.
+func (k Keeper) allocateDelegatorRewards(ctx sdk.Context, epoch uint64, validatorAddress string, reward sdk.Coin) sdk.Coin {
+	rate, found := k.GetValidatorRewardsCommission(ctx, validatorAddress)
+	if !found || !reward.IsPositive() {
+		return reward
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return reward
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil || !validator.DelegatorShares.IsPositive() {
+		return reward
+	}
+
+	commission := sdkmath.LegacyNewDecFromInt(reward.Amount).Mul(rate).TruncateInt()
+	delegatorsPool := reward.Amount.Sub(commission)
+	rewardPerShare := sdkmath.LegacyNewDecFromInt(delegatorsPool).QuoTruncate(validator.DelegatorShares)
+	if !rewardPerShare.IsPositive() {
+		return reward
+	}
+
+	k.setDelegatorRewardPool(ctx, epoch, validatorAddress, sdk.NewCoin(reward.Denom, delegatorsPool))
+	k.setValidatorRewardPerShare(ctx, validatorAddress, epoch, rewardPerShare)
+	return sdk.NewCoin(reward.Denom, commission)
+}
+
+// GetDelegatorRewardPool returns the part of the validator's epoch reward that
+// its delegators have not settled yet.
+func (k Keeper) GetDelegatorRewardPool(ctx sdk.Context, epoch uint64, validatorAddress string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	return vrutils.DecodeCoin(store.Get(vrtypes.GetDelegatorRewardPoolKey(epoch, []byte(validatorAddress))))
+}
+
+// setDelegatorRewardPool stores the unsettled delegator pool of an epoch. The
+// row is kept when it reaches zero so expiry still finds the reward per share
+// of the epoch.
+func (k Keeper) setDelegatorRewardPool(ctx sdk.Context, epoch uint64, validatorAddress string, amount sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := vrtypes.GetDelegatorRewardPoolKey(epoch, []byte(validatorAddress))
+	k.adjustOutstandingLiabilities(ctx, vrutils.DecodeCoin(store.Get(key)).Amount, amount.Amount)
+	store.Set(key, vrutils.EncodeCoin(amount))
+}
+
+// IterateAllDelegatorRewardPools walks every delegator pool with the reward
+// per share it was allocated with.
+func (k Keeper) IterateAllDelegatorRewardPools(
+	ctx sdk.Context,
+	cb func(epoch uint64, validatorAddress string, rewardPerShare sdkmath.LegacyDec, remaining sdk.Coin) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vrtypes.KeyPrefixDelegatorRewardPool)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epoch, validatorAddress, err := vrtypes.ParseDelegatorRewardPoolKey(iterator.Key())
+		if err != nil {
+			ctx.Logger().Error(
+				"valrewards: skipping malformed delegator reward pool key",
+				"key", hex.EncodeToString(iterator.Key()),
+				"err", err,
+			)
+			continue
+		}
+		rewardPerShare, _ := k.GetValidatorRewardPerShare(ctx, validatorAddress, epoch)
+		if cb(epoch, validatorAddress, rewardPerShare, vrutils.DecodeCoin(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// SetDelegatorRewardPool restores a delegator pool and its reward per share.
+func (k Keeper) SetDelegatorRewardPool(ctx sdk.Context, epoch uint64, validatorAddress string, rewardPerShare sdkmath.LegacyDec, remaining sdk.Coin) {
+	k.setDelegatorRewardPool(ctx, epoch, validatorAddress, remaining)
+	k.setValidatorRewardPerShare(ctx, validatorAddress, epoch, rewardPerShare)
+}
+
+// GetValidatorRewardPerShare returns the reward each delegator share of the
+// validator earned in the epoch.
+func (k Keeper) GetValidatorRewardPerShare(ctx sdk.Context, validatorAddress string, epoch uint64) (sdkmath.LegacyDec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(vrtypes.GetValidatorRewardPerShareKey([]byte(validatorAddress), epoch))
+	if bz == nil {
+		return sdkmath.LegacyZeroDec(), false
+	}
+	return decodeRewardPerShare(bz), true
+}
+
+func (k Keeper) setValidatorRewardPerShare(ctx sdk.Context, validatorAddress string, epoch uint64, rewardPerShare sdkmath.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := rewardPerShare.Marshal()
+	if err != nil {
+		panic(err) //nolint:halt // Internal invariant: a LegacyDec computed by the keeper always marshals.
+	}
+	store.Set(vrtypes.GetValidatorRewardPerShareKey([]byte(validatorAddress), epoch), bz)
+}
+
+// iterateValidatorRewardsPerShare walks the rewards per share of the
+// validator from startEpoch, oldest first.
+func (k Keeper) iterateValidatorRewardsPerShare(
+	ctx sdk.Context,
+	validatorAddress string,
+	startEpoch uint64,
+	cb func(epoch uint64, rewardPerShare sdkmath.LegacyDec) (stop bool),
+) {
+	listKey := vrtypes.GetValidatorRewardPerShareListKey([]byte(validatorAddress))
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		vrtypes.GetValidatorRewardPerShareKey([]byte(validatorAddress), startEpoch),
+		storetypes.PrefixEndBytes(listKey),
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epoch := sdk.BigEndianToUint64(iterator.Key()[len(listKey):])
+		if cb(epoch, decodeRewardPerShare(iterator.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) hasValidatorRewardsPerShare(ctx sdk.Context, validatorAddress string) bool {
+	found := false
+	k.iterateValidatorRewardsPerShare(ctx, validatorAddress, 0, func(uint64, sdkmath.LegacyDec) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// GetDelegationSettledEpoch returns the first epoch the delegation has not
+// settled. A delegation without a record has settled nothing.
+func (k Keeper) GetDelegationSettledEpoch(ctx sdk.Context, delegator sdk.AccAddress, validatorAddress string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(vrtypes.GetDelegationSettledEpochKey(delegator, []byte(validatorAddress)))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetDelegationSettledEpoch records the first epoch the delegation has not
+// settled.
+func (k Keeper) SetDelegationSettledEpoch(ctx sdk.Context, delegator sdk.AccAddress, validatorAddress string, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(vrtypes.GetDelegationSettledEpochKey(delegator, []byte(validatorAddress)), sdk.Uint64ToBigEndian(epoch))
+}
+
+func (k Keeper) deleteDelegationSettledEpoch(ctx sdk.Context, delegator sdk.AccAddress, validatorAddress string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(vrtypes.GetDelegationSettledEpochKey(delegator, []byte(validatorAddress)))
+}
+
+// IterateDelegationSettledEpochs walks every delegation settlement record.
+func (k Keeper) IterateDelegationSettledEpochs(ctx sdk.Context, cb func(delegator sdk.AccAddress, validatorAddress string, epoch uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vrtypes.KeyPrefixDelegationSettledEpoch)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegator, validatorAddress, err := vrtypes.ParseDelegationSettledEpochKey(iterator.Key())
+		if err != nil {
+			ctx.Logger().Error(
+				"valrewards: skipping malformed delegation settlement key",
+				"key", hex.EncodeToString(iterator.Key()),
+				"err", err,
+			)
+			continue
+		}
+		if cb(delegator, validatorAddress, sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// markDelegationSettled moves the delegation past every paid epoch. Nothing is
+// recorded for validators without delegator pools, since a missing record
+// already settles from their first pool.
+func (k Keeper) markDelegationSettled(ctx sdk.Context, delegator sdk.AccAddress, validatorAddress string) {
+	if !k.hasValidatorRewardsPerShare(ctx, validatorAddress) {
+		k.deleteDelegationSettledEpoch(ctx, delegator, validatorAddress)
+		return
+	}
+	k.SetDelegationSettledEpoch(ctx, delegator, validatorAddress, k.GetEpochToPay(ctx))
+}
+
+// pendingDelegationRewards returns the rewards the delegation earned in the
+// epochs it has not settled, oldest first, without writing them.
+func (k Keeper) pendingDelegationRewards(ctx sdk.Context, delegator sdk.AccAddress, validatorAddress string, shares sdkmath.LegacyDec) []vrtypes.DelegatorEpochReward {
+	if !shares.IsPositive() {
+		return nil
+	}
+
+	var rewards []vrtypes.DelegatorEpochReward
+	startEpoch := k.GetDelegationSettledEpoch(ctx, delegator, validatorAddress)
+	k.iterateValidatorRewardsPerShare(ctx, validatorAddress, startEpoch, func(epoch uint64, rewardPerShare sdkmath.LegacyDec) bool {
+		amount := shares.Mul(rewardPerShare).TruncateInt()
+		pool := k.GetDelegatorRewardPool(ctx, epoch, validatorAddress)
+		amount = sdkmath.MinInt(amount, pool.Amount)
+		if amount.IsPositive() {
+			rewards = append(rewards, vrtypes.DelegatorEpochReward{
+				Epoch:            epoch,
+				ValidatorAddress: validatorAddress,
+				Amount:           sdk.NewCoin(pool.Denom, amount),
+			})
+		}
+		return false
+	})
+	return rewards
+}
+
+// settleDelegation moves the pending rewards of the delegation from the
+// delegator pools to its reward rows. It must run before the delegation
+// shares change.
+func (k Keeper) settleDelegation(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress) {
+	validatorAddress := valAddr.String()
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	if err == nil {
+		for _, reward := range k.pendingDelegationRewards(ctx, delegator, validatorAddress, delegation.Shares) {
+			pool := k.GetDelegatorRewardPool(ctx, reward.Epoch, validatorAddress)
+			k.setDelegatorRewardPool(ctx, reward.Epoch, validatorAddress, pool.Sub(reward.Amount))
+			outstanding := k.GetDelegatorOutstandingReward(ctx, delegator, reward.Epoch, validatorAddress)
+			k.SetDelegatorOutstandingReward(ctx, delegator, reward.Epoch, validatorAddress, outstanding.Add(reward.Amount))
+		}
+	}
+	k.markDelegationSettled(ctx, delegator, validatorAddress)
+}
+
+// settleDelegatorRewards settles every delegation of the delegator.
+func (k Keeper) settleDelegatorRewards(ctx sdk.Context, delegator sdk.AccAddress) {
+	for _, delegation := range k.delegatorDelegations(ctx, delegator) {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			continue
+		}
+		k.settleDelegation(ctx, delegator, valAddr)
+	}
+}
+
+// pendingDelegatorRewards returns the unsettled rewards of every delegation of
+// the delegator.
+func (k Keeper) pendingDelegatorRewards(ctx sdk.Context, delegator sdk.AccAddress) []vrtypes.DelegatorEpochReward {
+	var rewards []vrtypes.DelegatorEpochReward
+	for _, delegation := range k.delegatorDelegations(ctx, delegator) {
+		rewards = append(rewards, k.pendingDelegationRewards(ctx, delegator, delegation.ValidatorAddress, delegation.Shares)...)
+	}
+	return rewards
+}
+
+func (k Keeper) delegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation {
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, math.MaxUint16)
+	if err != nil {
+		ctx.Logger().Error(
+			"valrewards: failed to read delegations, skipping settlement",
+			"delegator", delegator.String(),
+			"err", err,
+		)
+		return nil
+	}
+	return delegations
+}
+
+// sortDelegatorEpochRewards orders rewards by epoch, then validator, the order
+// of the delegator reward rows in the store.
+func sortDelegatorEpochRewards(rewards []vrtypes.DelegatorEpochReward) {
+	sort.SliceStable(rewards, func(i, j int) bool {
+		if rewards[i].Epoch != rewards[j].Epoch {
+			return rewards[i].Epoch < rewards[j].Epoch
+		}
+		return rewards[i].ValidatorAddress < rewards[j].ValidatorAddress
+	})
+}
+
+func decodeRewardPerShare(bz []byte) sdkmath.LegacyDec {
+	var rewardPerShare sdkmath.LegacyDec
+	if err := rewardPerShare.Unmarshal(bz); err != nil {
+		return sdkmath.LegacyZeroDec()
+	}
+	return rewardPerShare
+}
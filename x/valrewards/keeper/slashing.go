@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vrtypes "github.com/cosmos/evm/x/valrewards/types"
+)
+
+// SetValidatorSlashed records that the validator was slashed during the epoch.
+func (k Keeper) SetValidatorSlashed(ctx sdk.Context, epoch uint64, validatorAddress string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(vrtypes.GetEpochSlashedValidatorKey(epoch, []byte(validatorAddress)), []byte{})
+}
+
+// IsValidatorSlashed reports whether the validator was slashed during the
+// epoch.
+func (k Keeper) IsValidatorSlashed(ctx sdk.Context, epoch uint64, validatorAddress string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(vrtypes.GetEpochSlashedValidatorKey(epoch, []byte(validatorAddress)))
+}
+
+// IterateAllSlashedValidators walks every recorded slash marker.
+func (k Keeper) IterateAllSlashedValidators(ctx sdk.Context, cb func(epoch uint64, validatorAddress string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vrtypes.KeyPrefixEpochSlashedValidator)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epoch, validatorAddress, err := vrtypes.ParseEpochSlashedValidatorKey(iterator.Key())
+		if err != nil {
+			ctx.Logger().Error(
+				"valrewards: skipping malformed slashed validator key",
+				"key", hex.EncodeToString(iterator.Key()),
+				"err", err,
+			)
+			continue
+		}
+		if cb(epoch, validatorAddress) {
+			break
+		}
+	}
+}
+
+// clearSlashedValidators drops the slash markers of an epoch once it has been
+// paid.
+func (k Keeper) clearSlashedValidators(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vrtypes.GetEpochSlashedValidatorListKey(epoch))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// payablePoints returns the points the validator is paid for in the epoch and
+// the points it forfeited because it was slashed during the epoch.
+func (k Keeper) payablePoints(ctx sdk.Context, epoch uint64, validatorAddress string, points uint64, forfeitRate math.LegacyDec) (payable, forfeited uint64) {
+	if points == 0 || !forfeitRate.IsPositive() || !k.IsValidatorSlashed(ctx, epoch, validatorAddress) {
+		return points, 0
+	}
+
+	forfeited = math.NewIntFromUint64(points).ToLegacyDec().Mul(forfeitRate).TruncateInt().Uint64()
+	return points - forfeited, forfeited
+}
+
+func emitForfeitRewardsEvent(ctx sdk.Context, validatorAddress string, epoch, forfeitedPoints uint64, amount sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			vrtypes.EventTypeForfeitRewards,
+			sdk.NewAttribute(vrtypes.AttributeKeyValidator, validatorAddress),
+			sdk.NewAttribute(vrtypes.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyForfeitedPoints, strconv.FormatUint(forfeitedPoints, 10)),
+			sdk.NewAttribute(vrtypes.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
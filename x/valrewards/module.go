@@ -35,7 +35,7 @@ var (
 	_ appmodule.AppModule       = AppModule{}
 )
 
-const consensusVersion = 1
+const consensusVersion = 4
 
 type AppModuleBasic struct{}
 
@@ -91,9 +91,27 @@ func (AppModule) Name() string {
 	return vrtypes.ModuleName
 }
 
+// RegisterInvariants registers the x/valrewards crisis invariants.
+//
+//nolint:staticcheck // x/crisis-backed invariant registration is intentional for startup/runtime assertions.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	vrkeeper.RegisterInvariants(ir, am.keeper)
+}
+
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	vrtypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	vrtypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := vrkeeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(vrtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 1 to 2: %v", vrtypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(vrtypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 2 to 3: %v", vrtypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(vrtypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 3 to 4: %v", vrtypes.ModuleName, err))
+	}
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
@@ -149,6 +167,30 @@ func parseGenesisState(bz json.RawMessage) (vrtypes.GenesisState, error) {
 	if gs.ValidatorOutstandingRewards == nil {
 		gs.ValidatorOutstandingRewards = []vrtypes.GenesisValidatorOutstandingReward{}
 	}
+	if gs.RewardsCommissions == nil {
+		gs.RewardsCommissions = []vrtypes.GenesisRewardsCommission{}
+	}
+	if gs.NextRewardsCommissions == nil {
+		gs.NextRewardsCommissions = []vrtypes.GenesisRewardsCommission{}
+	}
+	if gs.DelegatorOutstandingRewards == nil {
+		gs.DelegatorOutstandingRewards = []vrtypes.GenesisDelegatorOutstandingReward{}
+	}
+	if gs.DelegatorRewardPools == nil {
+		gs.DelegatorRewardPools = []vrtypes.GenesisDelegatorRewardPool{}
+	}
+	if gs.DelegationSettledEpochs == nil {
+		gs.DelegationSettledEpochs = []vrtypes.GenesisDelegationSettledEpoch{}
+	}
+	if gs.SlashedValidators == nil {
+		gs.SlashedValidators = []vrtypes.GenesisSlashedValidator{}
+	}
+	if gs.WithdrawAddresses == nil {
+		gs.WithdrawAddresses = []vrtypes.GenesisWithdrawAddress{}
+	}
+	if gs.AutoCompoundValidators == nil {
+		gs.AutoCompoundValidators = []string{}
+	}
 	if gs.Params.Whitelist == nil {
 		gs.Params.Whitelist = []string{}
 	}
@@ -178,6 +220,30 @@ func validateOutstandingRewardsFunding(gs vrtypes.GenesisState, pool sdk.Coin) e
 		}
 		totalOutstanding = totalOutstanding.Add(entry.Amount.Amount)
 	}
+	for _, entry := range gs.DelegatorOutstandingRewards {
+		if entry.Amount.Denom != pool.Denom {
+			return fmt.Errorf(
+				"invalid delegator outstanding reward denom for epoch %d and delegator %s: got %s, expected %s",
+				entry.Epoch,
+				entry.DelegatorAddress,
+				entry.Amount.Denom,
+				pool.Denom,
+			)
+		}
+		totalOutstanding = totalOutstanding.Add(entry.Amount.Amount)
+	}
+	for _, entry := range gs.DelegatorRewardPools {
+		if entry.Remaining.Denom != pool.Denom {
+			return fmt.Errorf(
+				"invalid delegator reward pool denom for epoch %d and validator %s: got %s, expected %s",
+				entry.Epoch,
+				entry.ValidatorAddress,
+				entry.Remaining.Denom,
+				pool.Denom,
+			)
+		}
+		totalOutstanding = totalOutstanding.Add(entry.Remaining.Amount)
+	}
 
 	required := sdk.NewCoin(pool.Denom, totalOutstanding)
 	if pool.IsLT(required) {
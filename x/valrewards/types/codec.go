@@ -16,12 +16,20 @@ var (
 )
 
 const (
-	msgUpdateParamsName       = "cosmos/evm/x/valrewards/MsgUpdateParams"
-	msgSetBlocksInEpochName   = "cosmos/evm/x/valrewards/MsgSetBlocksInEpoch"
-	msgSetRewardsPerEpochName = "cosmos/evm/x/valrewards/MsgSetRewardsPerEpoch"
-	msgSetRewardingPausedName = "cosmos/evm/x/valrewards/MsgSetRewardingPaused"
-	msgClaimRewardsName       = "cosmos/evm/x/valrewards/MsgClaimRewards"
-	msgDepositRewardsPoolName = "cosmos/evm/x/valrewards/MsgDepositRewardsPool"
+	msgUpdateParamsName           = "cosmos/evm/x/valrewards/MsgUpdateParams"
+	msgSetBlocksInEpochName       = "cosmos/evm/x/valrewards/MsgSetBlocksInEpoch"
+	msgSetRewardsPerEpochName     = "cosmos/evm/x/valrewards/MsgSetRewardsPerEpoch"
+	msgSetRewardingPausedName     = "cosmos/evm/x/valrewards/MsgSetRewardingPaused"
+	msgSetProposerBonusPointsName = "cosmos/evm/x/valrewards/MsgSetProposerBonusPoints"
+	msgSetSlashForfeitRateName    = "cosmos/evm/x/valrewards/MsgSetSlashForfeitRate"
+	msgSetClaimWindowEpochsName   = "cosmos/evm/x/valrewards/MsgSetClaimWindowEpochs"
+	msgClaimRewardsName           = "cosmos/evm/x/valrewards/MsgClaimRewards"
+	msgClaimRewardsRangeName      = "cosmos/evm/x/valrewards/MsgClaimRewardsRange"
+	msgSetRewardsCommissionName   = "cosmos/evm/x/valrewards/MsgSetRewardsCommission"
+	msgClaimDelegatorRewardsName  = "cosmos/evm/x/valrewards/MsgClaimDelegatorRewards"
+	msgDepositRewardsPoolName     = "cosmos/evm/x/valrewards/MsgDepositRewardsPool"
+	msgSetWithdrawAddressName     = "cosmos/evm/x/valrewards/MsgSetWithdrawAddress"
+	msgSetAutoCompoundName        = "cosmos/evm/x/valrewards/MsgSetAutoCompound"
 )
 
 func init() {
@@ -36,8 +44,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSetBlocksInEpoch{},
 		&MsgSetRewardsPerEpoch{},
 		&MsgSetRewardingPaused{},
+		&MsgSetProposerBonusPoints{},
+		&MsgSetSlashForfeitRate{},
+		&MsgSetClaimWindowEpochs{},
 		&MsgClaimRewards{},
+		&MsgClaimRewardsRange{},
+		&MsgSetRewardsCommission{},
+		&MsgClaimDelegatorRewards{},
 		&MsgDepositRewardsPool{},
+		&MsgSetWithdrawAddress{},
+		&MsgSetAutoCompound{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -48,6 +64,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetBlocksInEpoch{}, msgSetBlocksInEpochName, nil)
 	cdc.RegisterConcrete(&MsgSetRewardsPerEpoch{}, msgSetRewardsPerEpochName, nil)
 	cdc.RegisterConcrete(&MsgSetRewardingPaused{}, msgSetRewardingPausedName, nil)
+	cdc.RegisterConcrete(&MsgSetProposerBonusPoints{}, msgSetProposerBonusPointsName, nil)
+	cdc.RegisterConcrete(&MsgSetSlashForfeitRate{}, msgSetSlashForfeitRateName, nil)
+	cdc.RegisterConcrete(&MsgSetClaimWindowEpochs{}, msgSetClaimWindowEpochsName, nil)
 	cdc.RegisterConcrete(&MsgClaimRewards{}, msgClaimRewardsName, nil)
+	cdc.RegisterConcrete(&MsgClaimRewardsRange{}, msgClaimRewardsRangeName, nil)
+	cdc.RegisterConcrete(&MsgSetRewardsCommission{}, msgSetRewardsCommissionName, nil)
+	cdc.RegisterConcrete(&MsgClaimDelegatorRewards{}, msgClaimDelegatorRewardsName, nil)
 	cdc.RegisterConcrete(&MsgDepositRewardsPool{}, msgDepositRewardsPoolName, nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, msgSetWithdrawAddressName, nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, msgSetAutoCompoundName, nil)
 }
@@ -7,3 +7,5 @@ const BLOCKS_IN_EPOCH int64 = 20
 const REWARDS_PER_EPOCH string = "1000000000000000000"
 const REWARDING_PAUSED bool = false
 const PROPOSER_BONUS_POINTS uint64 = 1
+const SLASH_FORFEIT_RATE string = "0.5"
+const CLAIM_WINDOW_EPOCHS uint64 = 90
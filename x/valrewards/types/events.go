@@ -0,0 +1,29 @@
+package types
+
+// valrewards events
+const (
+	EventTypeClaimRewards            = "claim_rewards"
+	EventTypeClaimDelegatorRewards   = "claim_delegator_rewards"
+	EventTypeSetRewardsCommission    = "set_rewards_commission"
+	EventTypeForfeitRewards          = "forfeit_rewards"
+	EventTypeExpireRewards           = "expire_rewards"
+	EventTypeRewardsFundingShortfall = "rewards_funding_shortfall"
+	EventTypeRewardsRunwayLow        = "rewards_runway_low"
+	EventTypeSetWithdrawAddress      = "set_withdraw_address"
+	EventTypeSetAutoCompound         = "set_auto_compound"
+	EventTypeAutoCompoundRewards     = "auto_compound_rewards"
+
+	AttributeKeyValidator         = "validator"
+	AttributeKeyDelegator         = "delegator"
+	AttributeKeyEpoch             = "epoch"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyCommissionRate    = "commission_rate"
+	AttributeKeyForfeitedPoints   = "forfeited_points"
+	AttributeKeyFundedEpochs      = "funded_epochs"
+	AttributeKeyRunwayAlertEpochs = "runway_alert_epochs"
+	AttributeKeyUnallocated       = "unallocated"
+	AttributeKeyRewardsPerEpoch   = "rewards_per_epoch"
+	AttributeKeyWithdrawAddress   = "withdraw_address"
+	AttributeKeyAutoCompound      = "auto_compound"
+	AttributeKeyRecipient         = "recipient"
+)
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -22,14 +23,62 @@ type GenesisValidatorOutstandingReward struct {
 	Amount           sdk.Coin `json:"amount"`
 }
 
+type GenesisRewardsCommission struct {
+	ValidatorAddress string            `json:"validator_address"`
+	CommissionRate   sdkmath.LegacyDec `json:"commission_rate"`
+}
+
+type GenesisDelegatorOutstandingReward struct {
+	Epoch            uint64   `json:"epoch"`
+	DelegatorAddress string   `json:"delegator_address"`
+	ValidatorAddress string   `json:"validator_address"`
+	Amount           sdk.Coin `json:"amount"`
+}
+
+// GenesisDelegatorRewardPool is the part of a validator's epoch reward its
+// delegators have not settled, with the reward per share it was allocated with.
+type GenesisDelegatorRewardPool struct {
+	Epoch            uint64            `json:"epoch"`
+	ValidatorAddress string            `json:"validator_address"`
+	RewardPerShare   sdkmath.LegacyDec `json:"reward_per_share"`
+	Remaining        sdk.Coin          `json:"remaining"`
+}
+
+// GenesisDelegationSettledEpoch is the first epoch a delegation has not
+// settled.
+type GenesisDelegationSettledEpoch struct {
+	DelegatorAddress string `json:"delegator_address"`
+	ValidatorAddress string `json:"validator_address"`
+	Epoch            uint64 `json:"epoch"`
+}
+
+type GenesisSlashedValidator struct {
+	Epoch            uint64 `json:"epoch"`
+	ValidatorAddress string `json:"validator_address"`
+}
+
+type GenesisWithdrawAddress struct {
+	ValidatorAddress string `json:"validator_address"`
+	WithdrawAddress  string `json:"withdraw_address"`
+}
+
 type GenesisState struct {
 	Params                      Params                              `json:"params"`
 	CurrentRewardSettings       RewardSettings                      `json:"current_reward_settings"`
 	NextRewardSettings          RewardSettings                      `json:"next_reward_settings"`
 	EpochState                  EpochState                          `json:"epoch_state"`
 	EpochToPay                  uint64                              `json:"epoch_to_pay"`
+	NextEpochToExpire           uint64                              `json:"next_epoch_to_expire"`
 	ValidatorPoints             []GenesisValidatorPoint             `json:"validator_points"`
 	ValidatorOutstandingRewards []GenesisValidatorOutstandingReward `json:"validator_outstanding_rewards"`
+	RewardsCommissions          []GenesisRewardsCommission          `json:"rewards_commissions"`
+	NextRewardsCommissions      []GenesisRewardsCommission          `json:"next_rewards_commissions"`
+	DelegatorOutstandingRewards []GenesisDelegatorOutstandingReward `json:"delegator_outstanding_rewards"`
+	DelegatorRewardPools        []GenesisDelegatorRewardPool        `json:"delegator_reward_pools"`
+	DelegationSettledEpochs     []GenesisDelegationSettledEpoch     `json:"delegation_settled_epochs"`
+	SlashedValidators           []GenesisSlashedValidator           `json:"slashed_validators"`
+	WithdrawAddresses           []GenesisWithdrawAddress            `json:"withdraw_addresses"`
+	AutoCompoundValidators      []string                            `json:"auto_compound_validators"`
 }
 
 func DefaultGenesisState() *GenesisState {
@@ -41,6 +90,14 @@ func DefaultGenesisState() *GenesisState {
 		EpochToPay:                  0,
 		ValidatorPoints:             []GenesisValidatorPoint{},
 		ValidatorOutstandingRewards: []GenesisValidatorOutstandingReward{},
+		RewardsCommissions:          []GenesisRewardsCommission{},
+		NextRewardsCommissions:      []GenesisRewardsCommission{},
+		DelegatorOutstandingRewards: []GenesisDelegatorOutstandingReward{},
+		DelegatorRewardPools:        []GenesisDelegatorRewardPool{},
+		DelegationSettledEpochs:     []GenesisDelegationSettledEpoch{},
+		SlashedValidators:           []GenesisSlashedValidator{},
+		WithdrawAddresses:           []GenesisWithdrawAddress{},
+		AutoCompoundValidators:      []string{},
 	}
 }
 
@@ -63,6 +120,9 @@ func (gs GenesisState) Validate() error {
 	if gs.EpochToPay > gs.EpochState.CurrentEpoch {
 		return fmt.Errorf("epoch_to_pay cannot exceed epoch_state.current_epoch")
 	}
+	if gs.NextEpochToExpire > gs.EpochState.CurrentEpoch {
+		return fmt.Errorf("next_epoch_to_expire cannot exceed epoch_state.current_epoch")
+	}
 
 	pointEntries := make(map[string]struct{}, len(gs.ValidatorPoints))
 	for _, entry := range gs.ValidatorPoints {
@@ -108,6 +168,157 @@ func (gs GenesisState) Validate() error {
 		outstandingEntries[key] = struct{}{}
 	}
 
+	if err := validateRewardsCommissions("rewards_commissions", gs.RewardsCommissions); err != nil {
+		return err
+	}
+	if err := validateRewardsCommissions("next_rewards_commissions", gs.NextRewardsCommissions); err != nil {
+		return err
+	}
+
+	delegatorEntries := make(map[string]struct{}, len(gs.DelegatorOutstandingRewards))
+	for _, entry := range gs.DelegatorOutstandingRewards {
+		if entry.Epoch > gs.EpochState.CurrentEpoch {
+			return fmt.Errorf("delegator outstanding rewards entry epoch %d cannot exceed epoch_state.current_epoch %d", entry.Epoch, gs.EpochState.CurrentEpoch)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.DelegatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid delegator outstanding rewards delegator address")
+		}
+		if err := ValidateValidatorOperatorAddress(entry.ValidatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid delegator outstanding rewards validator address")
+		}
+		if !entry.Amount.IsValid() || !entry.Amount.IsPositive() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid delegator outstanding reward coin %s", entry.Amount.String())
+		}
+		if entry.Amount.Denom != evmtypes.DefaultEVMDenom {
+			return fmt.Errorf(
+				"invalid delegator outstanding reward denom for epoch %d and delegator %s: got %s, expected %s",
+				entry.Epoch,
+				entry.DelegatorAddress,
+				entry.Amount.Denom,
+				evmtypes.DefaultEVMDenom,
+			)
+		}
+
+		key := fmt.Sprintf("%d/%s/%s", entry.Epoch, entry.DelegatorAddress, entry.ValidatorAddress)
+		if _, exists := delegatorEntries[key]; exists {
+			return fmt.Errorf(
+				"duplicate delegator outstanding rewards entry for epoch %d, delegator %s and validator %s",
+				entry.Epoch,
+				entry.DelegatorAddress,
+				entry.ValidatorAddress,
+			)
+		}
+		delegatorEntries[key] = struct{}{}
+	}
+
+	poolEntries := make(map[string]struct{}, len(gs.DelegatorRewardPools))
+	for _, entry := range gs.DelegatorRewardPools {
+		if entry.Epoch >= gs.EpochToPay {
+			return fmt.Errorf("delegator reward pools entry epoch %d must be before epoch_to_pay %d", entry.Epoch, gs.EpochToPay)
+		}
+		if err := ValidateValidatorOperatorAddress(entry.ValidatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid delegator reward pools validator address")
+		}
+		if entry.RewardPerShare.IsNil() || !entry.RewardPerShare.IsPositive() {
+			return fmt.Errorf("delegator reward pools entry for epoch %d and validator %s must have a positive reward_per_share", entry.Epoch, entry.ValidatorAddress)
+		}
+		if !entry.Remaining.IsValid() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid delegator reward pool coin %s", entry.Remaining.String())
+		}
+		if entry.Remaining.Denom != evmtypes.DefaultEVMDenom {
+			return fmt.Errorf(
+				"invalid delegator reward pool denom for epoch %d and validator %s: got %s, expected %s",
+				entry.Epoch,
+				entry.ValidatorAddress,
+				entry.Remaining.Denom,
+				evmtypes.DefaultEVMDenom,
+			)
+		}
+
+		key := fmt.Sprintf("%d/%s", entry.Epoch, entry.ValidatorAddress)
+		if _, exists := poolEntries[key]; exists {
+			return fmt.Errorf("duplicate delegator reward pools entry for epoch %d and validator %s", entry.Epoch, entry.ValidatorAddress)
+		}
+		poolEntries[key] = struct{}{}
+	}
+
+	settledEntries := make(map[string]struct{}, len(gs.DelegationSettledEpochs))
+	for _, entry := range gs.DelegationSettledEpochs {
+		if entry.Epoch > gs.EpochToPay {
+			return fmt.Errorf("delegation settled epochs entry epoch %d cannot exceed epoch_to_pay %d", entry.Epoch, gs.EpochToPay)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.DelegatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid delegation settled epochs delegator address")
+		}
+		if err := ValidateValidatorOperatorAddress(entry.ValidatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid delegation settled epochs validator address")
+		}
+
+		key := fmt.Sprintf("%s/%s", entry.DelegatorAddress, entry.ValidatorAddress)
+		if _, exists := settledEntries[key]; exists {
+			return fmt.Errorf("duplicate delegation settled epochs entry for delegator %s and validator %s", entry.DelegatorAddress, entry.ValidatorAddress)
+		}
+		settledEntries[key] = struct{}{}
+	}
+
+	slashedEntries := make(map[string]struct{}, len(gs.SlashedValidators))
+	for _, entry := range gs.SlashedValidators {
+		if entry.Epoch > gs.EpochState.CurrentEpoch {
+			return fmt.Errorf("slashed validators entry epoch %d cannot exceed epoch_state.current_epoch %d", entry.Epoch, gs.EpochState.CurrentEpoch)
+		}
+		if err := ValidateValidatorOperatorAddress(entry.ValidatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid slashed validators address")
+		}
+
+		key := fmt.Sprintf("%d/%s", entry.Epoch, entry.ValidatorAddress)
+		if _, exists := slashedEntries[key]; exists {
+			return fmt.Errorf("duplicate slashed validators entry for epoch %d and validator %s", entry.Epoch, entry.ValidatorAddress)
+		}
+		slashedEntries[key] = struct{}{}
+	}
+
+	withdrawEntries := make(map[string]struct{}, len(gs.WithdrawAddresses))
+	for _, entry := range gs.WithdrawAddresses {
+		if err := ValidateValidatorOperatorAddress(entry.ValidatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid withdraw addresses validator address")
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.WithdrawAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid withdraw address")
+		}
+		if _, exists := withdrawEntries[entry.ValidatorAddress]; exists {
+			return fmt.Errorf("duplicate withdraw addresses entry for validator %s", entry.ValidatorAddress)
+		}
+		withdrawEntries[entry.ValidatorAddress] = struct{}{}
+	}
+
+	autoCompoundEntries := make(map[string]struct{}, len(gs.AutoCompoundValidators))
+	for _, validatorAddress := range gs.AutoCompoundValidators {
+		if err := ValidateValidatorOperatorAddress(validatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid auto compound validator address")
+		}
+		if _, exists := autoCompoundEntries[validatorAddress]; exists {
+			return fmt.Errorf("duplicate auto compound validators entry for validator %s", validatorAddress)
+		}
+		autoCompoundEntries[validatorAddress] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateRewardsCommissions(field string, entries []GenesisRewardsCommission) error {
+	seen := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		if err := ValidateValidatorOperatorAddress(entry.ValidatorAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid %s validator address", field)
+		}
+		if err := ValidateRewardsCommissionRate(entry.CommissionRate); err != nil {
+			return errorsmod.Wrapf(err, "invalid %s entry for validator %s", field, entry.ValidatorAddress)
+		}
+		if _, exists := seen[entry.ValidatorAddress]; exists {
+			return fmt.Errorf("duplicate %s entry for validator %s", field, entry.ValidatorAddress)
+		}
+		seen[entry.ValidatorAddress] = struct{}{}
+	}
 	return nil
 }
 
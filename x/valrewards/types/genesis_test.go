@@ -55,6 +55,21 @@ func TestGenesisStateValidate(t *testing.T) {
 				ValidatorOutstandingRewards: []GenesisValidatorOutstandingReward{
 					{Epoch: 1, ValidatorAddress: validAddress, Amount: sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(25))},
 				},
+				DelegatorRewardPools: []GenesisDelegatorRewardPool{
+					{
+						Epoch:            2,
+						ValidatorAddress: validAddress,
+						RewardPerShare:   sdkmath.LegacyNewDecWithPrec(5, 1),
+						Remaining:        sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(10)),
+					},
+				},
+				DelegationSettledEpochs: []GenesisDelegationSettledEpoch{
+					{DelegatorAddress: validWhitelistAddress, ValidatorAddress: validAddress, Epoch: 3},
+				},
+				WithdrawAddresses: []GenesisWithdrawAddress{
+					{ValidatorAddress: validAddress, WithdrawAddress: validWhitelistAddress},
+				},
+				AutoCompoundValidators: []string{validAddress},
 			},
 			expectError: false,
 		},
@@ -243,6 +258,107 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "next_epoch_to_expire exceeds current epoch",
+			genesis: GenesisState{
+				Params:                DefaultParams(),
+				CurrentRewardSettings: DefaultRewardSettings(),
+				NextRewardSettings:    DefaultRewardSettings(),
+				EpochState: EpochState{
+					CurrentEpoch:           1,
+					BlocksIntoCurrentEpoch: 0,
+				},
+				NextEpochToExpire: 2,
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate withdraw address entry",
+			genesis: GenesisState{
+				Params:                DefaultParams(),
+				CurrentRewardSettings: DefaultRewardSettings(),
+				NextRewardSettings:    DefaultRewardSettings(),
+				WithdrawAddresses: []GenesisWithdrawAddress{
+					{ValidatorAddress: validAddress, WithdrawAddress: validWhitelistAddress},
+					{ValidatorAddress: validAddress, WithdrawAddress: validWhitelistAddress},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid withdraw address",
+			genesis: GenesisState{
+				Params:                DefaultParams(),
+				CurrentRewardSettings: DefaultRewardSettings(),
+				NextRewardSettings:    DefaultRewardSettings(),
+				WithdrawAddresses: []GenesisWithdrawAddress{
+					{ValidatorAddress: validAddress, WithdrawAddress: "invalid"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "delegator reward pool of an unpaid epoch",
+			genesis: GenesisState{
+				Params:                DefaultParams(),
+				CurrentRewardSettings: DefaultRewardSettings(),
+				NextRewardSettings:    DefaultRewardSettings(),
+				EpochState:            EpochState{CurrentEpoch: 2},
+				EpochToPay:            1,
+				DelegatorRewardPools: []GenesisDelegatorRewardPool{
+					{
+						Epoch:            1,
+						ValidatorAddress: validAddress,
+						RewardPerShare:   sdkmath.LegacyNewDec(1),
+						Remaining:        sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(25)),
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "delegator reward pool without reward per share",
+			genesis: GenesisState{
+				Params:                DefaultParams(),
+				CurrentRewardSettings: DefaultRewardSettings(),
+				NextRewardSettings:    DefaultRewardSettings(),
+				EpochState:            EpochState{CurrentEpoch: 2},
+				EpochToPay:            2,
+				DelegatorRewardPools: []GenesisDelegatorRewardPool{
+					{
+						Epoch:            1,
+						ValidatorAddress: validAddress,
+						RewardPerShare:   sdkmath.LegacyZeroDec(),
+						Remaining:        sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(25)),
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "delegation settled past epoch_to_pay",
+			genesis: GenesisState{
+				Params:                DefaultParams(),
+				CurrentRewardSettings: DefaultRewardSettings(),
+				NextRewardSettings:    DefaultRewardSettings(),
+				EpochState:            EpochState{CurrentEpoch: 2},
+				EpochToPay:            2,
+				DelegationSettledEpochs: []GenesisDelegationSettledEpoch{
+					{DelegatorAddress: validWhitelistAddress, ValidatorAddress: validAddress, Epoch: 3},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate auto compound validator",
+			genesis: GenesisState{
+				Params:                 DefaultParams(),
+				CurrentRewardSettings:  DefaultRewardSettings(),
+				NextRewardSettings:     DefaultRewardSettings(),
+				AutoCompoundValidators: []string{validAddress, validAddress},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
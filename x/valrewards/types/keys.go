@@ -3,6 +3,9 @@ package types
 import (
 	"encoding/binary"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -17,6 +20,19 @@ const (
 	prefix5
 	prefix6
 	prefix7
+	prefix8
+	prefix9
+	prefix10
+	prefix11
+	prefix12
+	prefix13
+	prefix14
+	prefix15
+	prefix16
+	prefix17
+	prefix18
+	prefix19
+	prefix20
 )
 
 var (
@@ -27,6 +43,19 @@ var (
 	KeyCurrentRewardSettings           = []byte{prefix5}
 	KeyNextRewardSettings              = []byte{prefix6}
 	KeyEpochState                      = []byte{prefix7}
+	KeyPrefixValidatorOutstandingEpoch = []byte{prefix8}
+	KeyPrefixRewardsCommission         = []byte{prefix9}
+	KeyPrefixNextRewardsCommission     = []byte{prefix10}
+	KeyPrefixDelegatorOutstanding      = []byte{prefix11}
+	KeyPrefixEpochSlashedValidator     = []byte{prefix12}
+	KeyOutstandingLiabilities          = []byte{prefix13}
+	KeyPrefixEpochDelegatorOutstanding = []byte{prefix14}
+	KeyNextEpochToExpire               = []byte{prefix15}
+	KeyPrefixWithdrawAddress           = []byte{prefix16}
+	KeyPrefixAutoCompound              = []byte{prefix17}
+	KeyPrefixDelegatorRewardPool       = []byte{prefix18}
+	KeyPrefixValidatorRewardPerShare   = []byte{prefix19}
+	KeyPrefixDelegationSettledEpoch    = []byte{prefix20}
 )
 
 func GetEpochValidatorPointsKey(epoch uint64, addressBytes []byte) []byte {
@@ -63,6 +92,139 @@ func GetEpochValidatorOutstandingListKey(epoch uint64) []byte {
 	return append(bz, epochBz[:]...)
 }
 
+// GetValidatorOutstandingEpochKey indexes a non-zero outstanding reward row by
+// validator first, so the epochs a validator can claim are found without
+// walking every epoch.
+func GetValidatorOutstandingEpochKey(addressBytes []byte, epoch uint64) []byte {
+	var epochBz [8]byte
+	binary.BigEndian.PutUint64(epochBz[:], epoch)
+
+	bz := GetValidatorOutstandingEpochListKey(addressBytes)
+	return append(bz, epochBz[:]...)
+}
+
+func GetValidatorOutstandingEpochListKey(addressBytes []byte) []byte {
+	bz := append([]byte{}, KeyPrefixValidatorOutstandingEpoch...)
+	return append(bz, address.MustLengthPrefix(addressBytes)...)
+}
+
+func GetRewardsCommissionKey(addressBytes []byte) []byte {
+	return append(append([]byte{}, KeyPrefixRewardsCommission...), addressBytes...)
+}
+
+func GetNextRewardsCommissionKey(addressBytes []byte) []byte {
+	return append(append([]byte{}, KeyPrefixNextRewardsCommission...), addressBytes...)
+}
+
+// GetDelegatorOutstandingKey keys a delegator reward row by delegator, then
+// epoch, then validator, so a delegator's claimable rows are walked oldest
+// first.
+func GetDelegatorOutstandingKey(delegator []byte, epoch uint64, validatorAddressBytes []byte) []byte {
+	bz := GetDelegatorOutstandingEpochKey(delegator, epoch)
+	return append(bz, validatorAddressBytes...)
+}
+
+func GetDelegatorOutstandingEpochKey(delegator []byte, epoch uint64) []byte {
+	var epochBz [8]byte
+	binary.BigEndian.PutUint64(epochBz[:], epoch)
+
+	bz := GetDelegatorOutstandingListKey(delegator)
+	return append(bz, epochBz[:]...)
+}
+
+func GetDelegatorOutstandingListKey(delegator []byte) []byte {
+	bz := append([]byte{}, KeyPrefixDelegatorOutstanding...)
+	return append(bz, address.MustLengthPrefix(delegator)...)
+}
+
+// GetEpochDelegatorOutstandingKey indexes a delegator reward row by epoch
+// first, so the rows of an expired epoch are found without walking every
+// delegator.
+func GetEpochDelegatorOutstandingKey(epoch uint64, delegator []byte, validatorAddressBytes []byte) []byte {
+	bz := GetEpochDelegatorOutstandingListKey(epoch)
+	bz = append(bz, address.MustLengthPrefix(delegator)...)
+	return append(bz, validatorAddressBytes...)
+}
+
+func GetEpochDelegatorOutstandingListKey(epoch uint64) []byte {
+	var epochBz [8]byte
+	binary.BigEndian.PutUint64(epochBz[:], epoch)
+
+	bz := append([]byte{}, KeyPrefixEpochDelegatorOutstanding...)
+	return append(bz, epochBz[:]...)
+}
+
+// GetDelegatorRewardPoolKey keys the part of a validator's epoch reward that
+// its delegators have not settled yet, by epoch first so the pools of an
+// expired epoch are found without walking every validator.
+func GetDelegatorRewardPoolKey(epoch uint64, addressBytes []byte) []byte {
+	bz := GetDelegatorRewardPoolListKey(epoch)
+	return append(bz, addressBytes...)
+}
+
+func GetDelegatorRewardPoolListKey(epoch uint64) []byte {
+	var epochBz [8]byte
+	binary.BigEndian.PutUint64(epochBz[:], epoch)
+
+	bz := append([]byte{}, KeyPrefixDelegatorRewardPool...)
+	return append(bz, epochBz[:]...)
+}
+
+// GetValidatorRewardPerShareKey keys the delegator reward per share a validator
+// paid for an epoch, by validator first so a delegation settles its pending
+// epochs oldest first.
+func GetValidatorRewardPerShareKey(addressBytes []byte, epoch uint64) []byte {
+	var epochBz [8]byte
+	binary.BigEndian.PutUint64(epochBz[:], epoch)
+
+	bz := GetValidatorRewardPerShareListKey(addressBytes)
+	return append(bz, epochBz[:]...)
+}
+
+func GetValidatorRewardPerShareListKey(addressBytes []byte) []byte {
+	bz := append([]byte{}, KeyPrefixValidatorRewardPerShare...)
+	return append(bz, address.MustLengthPrefix(addressBytes)...)
+}
+
+// GetDelegationSettledEpochKey keys the first epoch a delegation has not
+// settled yet, by delegator first so a claim finds every validator of the
+// delegator.
+func GetDelegationSettledEpochKey(delegator []byte, validatorAddressBytes []byte) []byte {
+	bz := append([]byte{}, KeyPrefixDelegationSettledEpoch...)
+	bz = append(bz, address.MustLengthPrefix(delegator)...)
+	return append(bz, validatorAddressBytes...)
+}
+
+// GetEpochSlashedValidatorKey marks a validator slashed during an epoch.
+func GetEpochSlashedValidatorKey(epoch uint64, addressBytes []byte) []byte {
+	bz := GetEpochSlashedValidatorListKey(epoch)
+	return append(bz, addressBytes...)
+}
+
+func GetEpochSlashedValidatorListKey(epoch uint64) []byte {
+	var epochBz [8]byte
+	binary.BigEndian.PutUint64(epochBz[:], epoch)
+
+	bz := append([]byte{}, KeyPrefixEpochSlashedValidator...)
+	return append(bz, epochBz[:]...)
+}
+
+func GetWithdrawAddressKey(addressBytes []byte) []byte {
+	return append(append([]byte{}, KeyPrefixWithdrawAddress...), addressBytes...)
+}
+
+func GetAutoCompoundKey(addressBytes []byte) []byte {
+	return append(append([]byte{}, KeyPrefixAutoCompound...), addressBytes...)
+}
+
+func GetOutstandingLiabilitiesKey() []byte {
+	return KeyOutstandingLiabilities
+}
+
+func GetNextEpochToExpireKey() []byte {
+	return KeyNextEpochToExpire
+}
+
 func GetEpochToPayKey() []byte {
 	return KeyPrefixEpochToPay
 }
@@ -91,6 +253,94 @@ func ParseEpochValidatorOutstandingKey(key []byte) (uint64, string, error) {
 	return parseEpochValidatorKey(KeyPrefixEpochValidatorOutstanding, key)
 }
 
+func ParseEpochSlashedValidatorKey(key []byte) (uint64, string, error) {
+	return parseEpochValidatorKey(KeyPrefixEpochSlashedValidator, key)
+}
+
+func ParseDelegatorRewardPoolKey(key []byte) (uint64, string, error) {
+	return parseEpochValidatorKey(KeyPrefixDelegatorRewardPool, key)
+}
+
+// ParseValidatorRewardPerShareKey splits a reward per share key into the
+// validator operator address and epoch.
+func ParseValidatorRewardPerShareKey(key []byte) (string, uint64, error) {
+	prefixLen := len(KeyPrefixValidatorRewardPerShare)
+	if len(key) < prefixLen+1 || string(key[:prefixLen]) != string(KeyPrefixValidatorRewardPerShare) {
+		return "", 0, fmt.Errorf("invalid key prefix")
+	}
+
+	validatorLen := int(key[prefixLen])
+	offset := prefixLen + 1
+	if validatorLen == 0 || len(key) != offset+validatorLen+8 {
+		return "", 0, fmt.Errorf("invalid key length %d", len(key))
+	}
+
+	validatorAddress := string(key[offset : offset+validatorLen])
+	epoch := binary.BigEndian.Uint64(key[offset+validatorLen:])
+	return validatorAddress, epoch, nil
+}
+
+// ParseDelegationSettledEpochKey splits a delegation settlement key into the
+// delegator and validator operator address.
+func ParseDelegationSettledEpochKey(key []byte) (sdk.AccAddress, string, error) {
+	prefixLen := len(KeyPrefixDelegationSettledEpoch)
+	if len(key) < prefixLen+1 || string(key[:prefixLen]) != string(KeyPrefixDelegationSettledEpoch) {
+		return nil, "", fmt.Errorf("invalid key prefix")
+	}
+
+	delegatorLen := int(key[prefixLen])
+	offset := prefixLen + 1
+	if len(key) < offset+delegatorLen+1 {
+		return nil, "", fmt.Errorf("invalid key length %d", len(key))
+	}
+
+	delegator := sdk.AccAddress(key[offset : offset+delegatorLen])
+	validatorAddress := string(key[offset+delegatorLen:])
+	return delegator, validatorAddress, nil
+}
+
+// ParseDelegatorOutstandingKey splits a delegator reward row key into the
+// delegator, epoch and validator operator address.
+func ParseDelegatorOutstandingKey(key []byte) (sdk.AccAddress, uint64, string, error) {
+	prefixLen := len(KeyPrefixDelegatorOutstanding)
+	if len(key) < prefixLen+1 || string(key[:prefixLen]) != string(KeyPrefixDelegatorOutstanding) {
+		return nil, 0, "", fmt.Errorf("invalid key prefix")
+	}
+
+	delegatorLen := int(key[prefixLen])
+	offset := prefixLen + 1
+	if len(key) < offset+delegatorLen+8+1 {
+		return nil, 0, "", fmt.Errorf("invalid key length %d", len(key))
+	}
+
+	delegator := sdk.AccAddress(key[offset : offset+delegatorLen])
+	offset += delegatorLen
+	epoch := binary.BigEndian.Uint64(key[offset : offset+8])
+	validatorAddress := string(key[offset+8:])
+	return delegator, epoch, validatorAddress, nil
+}
+
+// ParseEpochDelegatorOutstandingKey splits an epoch index key of a delegator
+// reward row into the epoch, delegator and validator operator address.
+func ParseEpochDelegatorOutstandingKey(key []byte) (uint64, sdk.AccAddress, string, error) {
+	prefixLen := len(KeyPrefixEpochDelegatorOutstanding)
+	if len(key) < prefixLen+8+1 || string(key[:prefixLen]) != string(KeyPrefixEpochDelegatorOutstanding) {
+		return 0, nil, "", fmt.Errorf("invalid key prefix")
+	}
+
+	epoch := binary.BigEndian.Uint64(key[prefixLen : prefixLen+8])
+	offset := prefixLen + 8
+	delegatorLen := int(key[offset])
+	offset++
+	if len(key) < offset+delegatorLen+1 {
+		return 0, nil, "", fmt.Errorf("invalid key length %d", len(key))
+	}
+
+	delegator := sdk.AccAddress(key[offset : offset+delegatorLen])
+	validatorAddress := string(key[offset+delegatorLen:])
+	return epoch, delegator, validatorAddress, nil
+}
+
 func parseEpochValidatorKey(prefix, key []byte) (uint64, string, error) {
 	if len(key) < len(prefix)+8+1 {
 		return 0, "", fmt.Errorf("invalid key length %d", len(key))
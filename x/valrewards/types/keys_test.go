@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,3 +19,14 @@ func TestKeyBuildersDoNotMutateSharedPrefixes(t *testing.T) {
 	require.Equal(t, originalPointsPrefix, KeyPrefixEpochValidatorPoints)
 	require.Equal(t, originalOutstandingPrefix, KeyPrefixEpochValidatorOutstanding)
 }
+
+func TestValidatorOutstandingEpochKeysAreScopedPerValidator(t *testing.T) {
+	listKey := GetValidatorOutstandingEpochListKey([]byte("validator-a"))
+
+	require.True(t, bytes.HasPrefix(GetValidatorOutstandingEpochKey([]byte("validator-a"), 3), listKey))
+	require.False(t, bytes.HasPrefix(GetValidatorOutstandingEpochKey([]byte("validator-ab"), 3), listKey))
+	require.Equal(t, -1, bytes.Compare(
+		GetValidatorOutstandingEpochKey([]byte("validator-a"), 255),
+		GetValidatorOutstandingEpochKey([]byte("validator-a"), 256),
+	))
+}
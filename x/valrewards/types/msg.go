@@ -10,11 +10,19 @@ import (
 )
 
 var _ sdk.Msg = &MsgClaimRewards{}
+var _ sdk.Msg = &MsgClaimRewardsRange{}
+var _ sdk.Msg = &MsgSetRewardsCommission{}
+var _ sdk.Msg = &MsgClaimDelegatorRewards{}
 var _ sdk.Msg = &MsgDepositRewardsPool{}
 var _ sdk.Msg = &MsgUpdateParams{}
 var _ sdk.Msg = &MsgSetBlocksInEpoch{}
 var _ sdk.Msg = &MsgSetRewardsPerEpoch{}
 var _ sdk.Msg = &MsgSetRewardingPaused{}
+var _ sdk.Msg = &MsgSetProposerBonusPoints{}
+var _ sdk.Msg = &MsgSetSlashForfeitRate{}
+var _ sdk.Msg = &MsgSetClaimWindowEpochs{}
+var _ sdk.Msg = &MsgSetWithdrawAddress{}
+var _ sdk.Msg = &MsgSetAutoCompound{}
 
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
@@ -95,6 +103,63 @@ func (m *MsgSetRewardingPaused) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (m *MsgSetProposerBonusPoints) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	return ValidateProposerBonusPoints(m.ProposerBonusPoints)
+}
+
+func (m MsgSetProposerBonusPoints) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgSetProposerBonusPoints) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgSetSlashForfeitRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	return ValidateSlashForfeitRate(m.SlashForfeitRate)
+}
+
+func (m MsgSetSlashForfeitRate) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgSetSlashForfeitRate) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgSetClaimWindowEpochs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	return nil
+}
+
+func (m MsgSetClaimWindowEpochs) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgSetClaimWindowEpochs) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{addr}
+}
+
 func (m *MsgClaimRewards) ValidateBasic() error {
 	if err := validateAddress(m.ValidatorOperator); err != nil {
 		return errorsmod.Wrap(err, "invalid validator operator address")
@@ -117,6 +182,69 @@ func (m *MsgClaimRewards) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{requester}
 }
 
+func (m *MsgClaimRewardsRange) ValidateBasic() error {
+	if err := validateAddress(m.ValidatorOperator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator operator address")
+	}
+	if err := validateAddress(m.Requester); err != nil {
+		return errorsmod.Wrap(err, "invalid requester address")
+	}
+	return ValidateClaimRange(m.StartEpoch, m.EndEpoch, m.MaxEpochs)
+}
+
+func (m MsgClaimRewardsRange) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgClaimRewardsRange) GetSigners() []sdk.AccAddress {
+	requester, err := ParseAccAddress(m.Requester)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{requester}
+}
+
+func (m *MsgSetRewardsCommission) ValidateBasic() error {
+	if err := validateAddress(m.ValidatorOperator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator operator address")
+	}
+	return ValidateRewardsCommissionRate(m.CommissionRate)
+}
+
+func (m MsgSetRewardsCommission) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgSetRewardsCommission) GetSigners() []sdk.AccAddress {
+	operator, err := ParseAccAddress(m.ValidatorOperator)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (m *MsgClaimDelegatorRewards) ValidateBasic() error {
+	if err := validateAddress(m.Delegator); err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+	if err := validateAddress(m.Requester); err != nil {
+		return errorsmod.Wrap(err, "invalid requester address")
+	}
+	return ValidateClaimRange(m.StartEpoch, m.EndEpoch, m.MaxEpochs)
+}
+
+func (m MsgClaimDelegatorRewards) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgClaimDelegatorRewards) GetSigners() []sdk.AccAddress {
+	requester, err := ParseAccAddress(m.Requester)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{requester}
+}
+
 func (m *MsgDepositRewardsPool) ValidateBasic() error {
 	if err := validateAddress(m.Depositor); err != nil {
 		return errorsmod.Wrap(err, "invalid depositor address")
@@ -145,6 +273,47 @@ func (m *MsgDepositRewardsPool) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (m *MsgSetWithdrawAddress) ValidateBasic() error {
+	if err := validateAddress(m.ValidatorOperator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator operator address")
+	}
+	if err := validateAddress(m.WithdrawAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid withdraw address")
+	}
+	return nil
+}
+
+func (m MsgSetWithdrawAddress) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	operator, err := ParseAccAddress(m.ValidatorOperator)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (m *MsgSetAutoCompound) ValidateBasic() error {
+	if err := validateAddress(m.ValidatorOperator); err != nil {
+		return errorsmod.Wrap(err, "invalid validator operator address")
+	}
+	return nil
+}
+
+func (m MsgSetAutoCompound) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	operator, err := ParseAccAddress(m.ValidatorOperator)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{operator}
+}
+
 func validateAddress(addr string) error {
 	if strings.HasPrefix(addr, "0x") {
 		if !common.IsHexAddress(addr) {
@@ -58,6 +58,34 @@ func TestMsgClaimRewardsGetSignersUsesRequester(t *testing.T) {
 	require.Equal(t, requester, signers[0].String())
 }
 
+func TestMsgClaimRewardsRangeValidateBasic(t *testing.T) {
+	requester := sdk.AccAddress(bytesRepeat(1, 20)).String()
+	validator := sdk.AccAddress(bytesRepeat(2, 20)).String()
+
+	testCases := []struct {
+		name   string
+		msg    MsgClaimRewardsRange
+		expErr string
+	}{
+		{"open range", MsgClaimRewardsRange{ValidatorOperator: validator, Requester: requester}, ""},
+		{"closed range", MsgClaimRewardsRange{ValidatorOperator: validator, Requester: requester, StartEpoch: 3, EndEpoch: 3, MaxEpochs: MaxClaimEpochsPerCall}, ""},
+		{"end before start", MsgClaimRewardsRange{ValidatorOperator: validator, Requester: requester, StartEpoch: 4, EndEpoch: 3}, "before start_epoch"},
+		{"too many epochs", MsgClaimRewardsRange{ValidatorOperator: validator, Requester: requester, MaxEpochs: MaxClaimEpochsPerCall + 1}, "max_epochs"},
+		{"invalid requester", MsgClaimRewardsRange{ValidatorOperator: validator, Requester: "not-an-address"}, "invalid requester address"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
 func bytesRepeat(b byte, size int) []byte {
 	out := make([]byte, size)
 	for i := range out {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -15,6 +16,18 @@ const (
 
 	MinRewardsPerEpoch string = "1000000000000000000"
 	MaxRewardsPerEpoch string = "25000000000000000000000000"
+
+	// MaxClaimEpochsPerCall bounds the number of epochs paid by a single
+	// range claim so its gas cost stays predictable.
+	MaxClaimEpochsPerCall uint32 = 100
+
+	// MaxProposerBonusPoints caps the proposer bonus at the points a validator
+	// holding all the voting power earns for signing a block.
+	MaxProposerBonusPoints uint64 = 100
+
+	// MaxExpiredEpochsPerBlock bounds the number of epochs whose unclaimed
+	// rewards are pruned in a single block.
+	MaxExpiredEpochsPerBlock uint64 = 10
 )
 
 func DefaultParams() Params {
@@ -23,9 +36,12 @@ func DefaultParams() Params {
 
 func DefaultRewardSettings() RewardSettings {
 	return RewardSettings{
-		BlocksInEpoch:   BLOCKS_IN_EPOCH,
-		RewardsPerEpoch: REWARDS_PER_EPOCH,
-		RewardingPaused: REWARDING_PAUSED,
+		BlocksInEpoch:       BLOCKS_IN_EPOCH,
+		RewardsPerEpoch:     REWARDS_PER_EPOCH,
+		RewardingPaused:     REWARDING_PAUSED,
+		ProposerBonusPoints: PROPOSER_BONUS_POINTS,
+		SlashForfeitRate:    SLASH_FORFEIT_RATE,
+		ClaimWindowEpochs:   CLAIM_WINDOW_EPOCHS,
 	}
 }
 
@@ -36,6 +52,19 @@ func DefaultEpochState() EpochState {
 	}
 }
 
+// RunwayEpochs returns the number of full epochs an unallocated balance can
+// fund at the given per-epoch allocation.
+func RunwayEpochs(unallocated, rewardsPerEpoch sdkmath.Int) uint64 {
+	if !rewardsPerEpoch.IsPositive() || !unallocated.IsPositive() {
+		return 0
+	}
+	epochs := unallocated.Quo(rewardsPerEpoch)
+	if !epochs.IsUint64() {
+		return math.MaxUint64
+	}
+	return epochs.Uint64()
+}
+
 func GetRewardsPerEpoch() sdk.Coin {
 	coin, err := DefaultRewardSettings().RewardsCoin()
 	if err != nil {
@@ -54,6 +83,67 @@ func ValidateBlocksInEpoch(blocksInEpoch int64) error {
 	return nil
 }
 
+// ValidateClaimRange checks the epoch range and batch size of a range claim.
+// An endEpoch of 0 leaves the range open and a maxEpochs of 0 selects
+// MaxClaimEpochsPerCall.
+func ValidateClaimRange(startEpoch, endEpoch uint64, maxEpochs uint32) error {
+	if endEpoch != 0 && endEpoch < startEpoch {
+		return fmt.Errorf("end_epoch %d is before start_epoch %d", endEpoch, startEpoch)
+	}
+	if maxEpochs > MaxClaimEpochsPerCall {
+		return fmt.Errorf("max_epochs must be at most %d", MaxClaimEpochsPerCall)
+	}
+	return nil
+}
+
+// ValidateRewardsCommissionRate checks that a valrewards commission rate is a
+// fraction between 0 and 1 inclusive.
+func ValidateRewardsCommissionRate(rate sdkmath.LegacyDec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("commission_rate cannot be empty")
+	}
+	if rate.IsNegative() {
+		return fmt.Errorf("commission_rate cannot be negative")
+	}
+	if rate.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("commission_rate cannot be greater than 1")
+	}
+	return nil
+}
+
+func ValidateProposerBonusPoints(proposerBonusPoints uint64) error {
+	if proposerBonusPoints > MaxProposerBonusPoints {
+		return fmt.Errorf("proposer_bonus_points must be at most %d", MaxProposerBonusPoints)
+	}
+	return nil
+}
+
+func ValidateSlashForfeitRate(slashForfeitRate string) error {
+	_, err := ParseSlashForfeitRate(slashForfeitRate)
+	return err
+}
+
+// ParseSlashForfeitRate parses the fraction of its epoch points a slashed
+// validator forfeits. An empty rate forfeits nothing, which keeps settings
+// stored before the field existed valid.
+func ParseSlashForfeitRate(slashForfeitRate string) (sdkmath.LegacyDec, error) {
+	if strings.TrimSpace(slashForfeitRate) == "" {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+
+	rate, err := sdkmath.LegacyNewDecFromStr(slashForfeitRate)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid slash_forfeit_rate %q", slashForfeitRate)
+	}
+	if rate.IsNegative() {
+		return sdkmath.LegacyDec{}, fmt.Errorf("slash_forfeit_rate cannot be negative")
+	}
+	if rate.GT(sdkmath.LegacyOneDec()) {
+		return sdkmath.LegacyDec{}, fmt.Errorf("slash_forfeit_rate cannot be greater than 1")
+	}
+	return rate, nil
+}
+
 func ValidateRewardsPerEpoch(rewardsPerEpoch string) error {
 	_, err := ParseRewardsPerEpoch(rewardsPerEpoch)
 	return err
@@ -112,6 +202,12 @@ func (s RewardSettings) Validate() error {
 	if err := ValidateRewardsPerEpoch(s.RewardsPerEpoch); err != nil {
 		return err
 	}
+	if err := ValidateProposerBonusPoints(s.ProposerBonusPoints); err != nil {
+		return err
+	}
+	if err := ValidateSlashForfeitRate(s.SlashForfeitRate); err != nil {
+		return err
+	}
 
 	return nil
 }
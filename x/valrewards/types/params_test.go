@@ -1,8 +1,10 @@
 package types
 
 import (
+	"math"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -69,6 +71,51 @@ func TestRewardSettingsValidate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "proposer bonus and forfeit rate",
+			settings: RewardSettings{
+				BlocksInEpoch:       20,
+				RewardsPerEpoch:     MinRewardsPerEpoch,
+				ProposerBonusPoints: MaxProposerBonusPoints,
+				SlashForfeitRate:    "1",
+			},
+		},
+		{
+			name: "proposer bonus above maximum",
+			settings: RewardSettings{
+				BlocksInEpoch:       20,
+				RewardsPerEpoch:     MinRewardsPerEpoch,
+				ProposerBonusPoints: MaxProposerBonusPoints + 1,
+			},
+			expectError: true,
+		},
+		{
+			name: "non numeric forfeit rate",
+			settings: RewardSettings{
+				BlocksInEpoch:    20,
+				RewardsPerEpoch:  MinRewardsPerEpoch,
+				SlashForfeitRate: "half",
+			},
+			expectError: true,
+		},
+		{
+			name: "negative forfeit rate",
+			settings: RewardSettings{
+				BlocksInEpoch:    20,
+				RewardsPerEpoch:  MinRewardsPerEpoch,
+				SlashForfeitRate: "-0.1",
+			},
+			expectError: true,
+		},
+		{
+			name: "forfeit rate above one",
+			settings: RewardSettings{
+				BlocksInEpoch:    20,
+				RewardsPerEpoch:  MinRewardsPerEpoch,
+				SlashForfeitRate: "1.01",
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -91,3 +138,12 @@ func TestParamsValidate(t *testing.T) {
 	require.Error(t, Params{Whitelist: []string{"bad"}}.Validate())
 	require.Error(t, Params{Whitelist: []string{addr, addr}}.Validate())
 }
+
+func TestRunwayEpochs(t *testing.T) {
+	perEpoch := sdkmath.NewIntWithDecimal(1, 18)
+	require.Equal(t, uint64(0), RunwayEpochs(sdkmath.ZeroInt(), perEpoch))
+	require.Equal(t, uint64(0), RunwayEpochs(sdkmath.NewIntWithDecimal(9, 17), perEpoch))
+	require.Equal(t, uint64(2), RunwayEpochs(sdkmath.NewIntWithDecimal(25, 17), perEpoch))
+	require.Equal(t, uint64(0), RunwayEpochs(perEpoch, sdkmath.ZeroInt()))
+	require.Equal(t, uint64(math.MaxUint64), RunwayEpochs(sdkmath.NewIntWithDecimal(1, 60), sdkmath.OneInt()))
+}
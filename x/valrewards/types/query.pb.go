@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -71,6 +73,7 @@ type QueryParamsResponse struct {
 	NextRewardSettings    RewardSettings `protobuf:"bytes,2,opt,name=next_reward_settings,json=nextRewardSettings,proto3" json:"next_reward_settings"`
 	ProposerBonusPoints   uint64         `protobuf:"varint,3,opt,name=proposer_bonus_points,json=proposerBonusPoints,proto3" json:"proposer_bonus_points,omitempty"`
 	Whitelist             []string       `protobuf:"bytes,4,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	RunwayAlertEpochs     uint64         `protobuf:"varint,5,opt,name=runway_alert_epochs,json=runwayAlertEpochs,proto3" json:"runway_alert_epochs,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -134,6 +137,13 @@ func (m *QueryParamsResponse) GetWhitelist() []string {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetRunwayAlertEpochs() uint64 {
+	if m != nil {
+		return m.RunwayAlertEpochs
+	}
+	return 0
+}
+
 type QueryDelegationRewardsRequest struct {
 	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 	Epoch     uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -410,647 +420,3396 @@ func (m *QueryRewardsPoolResponse) GetPool() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.valrewards.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.valrewards.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "cosmos.evm.valrewards.v1.QueryDelegationRewardsRequest")
-	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "cosmos.evm.valrewards.v1.QueryDelegationRewardsResponse")
-	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "cosmos.evm.valrewards.v1.QueryValidatorOutstandingRewardsRequest")
-	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "cosmos.evm.valrewards.v1.QueryValidatorOutstandingRewardsResponse")
-	proto.RegisterType((*QueryRewardsPoolRequest)(nil), "cosmos.evm.valrewards.v1.QueryRewardsPoolRequest")
-	proto.RegisterType((*QueryRewardsPoolResponse)(nil), "cosmos.evm.valrewards.v1.QueryRewardsPoolResponse")
+// QueryClaimableRewardsRequest selects the epochs between start_epoch and
+// end_epoch (inclusive) of a validator. An end_epoch of 0 leaves the range
+// open.
+type QueryClaimableRewardsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StartEpoch       uint64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch         uint64 `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/evm/valrewards/v1/query.proto", fileDescriptor_4ce219a702b0e9dd)
+func (m *QueryClaimableRewardsRequest) Reset()         { *m = QueryClaimableRewardsRequest{} }
+func (m *QueryClaimableRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRewardsRequest) ProtoMessage()    {}
+func (*QueryClaimableRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{8}
 }
-
-var fileDescriptor_4ce219a702b0e9dd = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x5b, 0xb7, 0x28, 0xd7, 0x85, 0x5e, 0x53, 0x35, 0x35, 0x60, 0x22, 0x0b, 0x09, 0xf3,
-	0x65, 0x2b, 0x61, 0xa0, 0x13, 0x52, 0x0d, 0x3b, 0xc1, 0x45, 0x0c, 0x2c, 0xa9, 0x13, 0x1f, 0x8e,
-	0x91, 0x7d, 0xe7, 0xde, 0x9d, 0xdd, 0xf6, 0x0f, 0x30, 0x33, 0xf0, 0x13, 0x18, 0x18, 0xe1, 0x5f,
-	0x74, 0xec, 0xc8, 0x84, 0x50, 0x32, 0xf0, 0x37, 0x90, 0xef, 0xce, 0x4d, 0xda, 0x28, 0x84, 0x00,
-	0x8b, 0x75, 0x7e, 0x3f, 0x9e, 0xe7, 0xfd, 0x78, 0xee, 0xc0, 0x9d, 0x01, 0x61, 0x29, 0x61, 0x2e,
-	0x2a, 0x52, 0xb7, 0x08, 0x12, 0x8a, 0x8e, 0x03, 0x1a, 0x32, 0xb7, 0x68, 0xbb, 0x47, 0x39, 0xa2,
-	0xa7, 0x4e, 0x46, 0x09, 0x27, 0xb0, 0x29, 0xa3, 0x1c, 0x54, 0xa4, 0xce, 0x24, 0xca, 0x29, 0xda,
-	0xc6, 0x66, 0x90, 0xc6, 0x98, 0xb8, 0xe2, 0x2b, 0x83, 0x0d, 0x53, 0x41, 0xf6, 0x03, 0x86, 0xdc,
-	0xa2, 0xdd, 0x47, 0x3c, 0x68, 0xbb, 0x03, 0x12, 0x63, 0xe5, 0xbf, 0x37, 0x97, 0x72, 0x0a, 0x5a,
-	0x86, 0x36, 0x22, 0x12, 0x11, 0x71, 0x74, 0xcb, 0x93, 0xb4, 0x5a, 0x0d, 0x00, 0x5f, 0x96, 0xc5,
-	0x75, 0x03, 0x1a, 0xa4, 0xcc, 0x47, 0x47, 0x39, 0x62, 0xdc, 0xfa, 0xba, 0x02, 0xb6, 0x2e, 0x99,
-	0x59, 0x46, 0x30, 0x43, 0xf0, 0x2d, 0xd8, 0x19, 0xe4, 0x94, 0x22, 0xcc, 0x7b, 0x12, 0xbc, 0xc7,
-	0x10, 0xe7, 0x31, 0x8e, 0x58, 0x53, 0x6b, 0x69, 0xf6, 0x46, 0xc7, 0x76, 0xe6, 0x75, 0xe7, 0xf8,
-	0xe2, 0x78, 0xa0, 0xe2, 0x3d, 0xfd, 0xec, 0xfb, 0xed, 0x9a, 0xbf, 0xad, 0xe0, 0x2e, 0x3b, 0xe1,
-	0x21, 0x68, 0x60, 0x74, 0x32, 0x4b, 0xb2, 0xf2, 0x57, 0x24, 0xb0, 0xc4, 0xba, 0xc2, 0xd0, 0x01,
-	0xdb, 0x19, 0x25, 0x19, 0x61, 0x88, 0xf6, 0xfa, 0x04, 0xe7, 0xac, 0x97, 0x91, 0x18, 0x73, 0xd6,
-	0x5c, 0x6d, 0x69, 0xb6, 0xee, 0x6f, 0x55, 0x4e, 0xaf, 0xf4, 0x75, 0x85, 0x0b, 0xde, 0x04, 0xf5,
-	0xe3, 0x61, 0xcc, 0x51, 0x12, 0x33, 0xde, 0xd4, 0x5b, 0xab, 0x76, 0xdd, 0x9f, 0x18, 0xac, 0x03,
-	0x70, 0x4b, 0x8c, 0xec, 0x39, 0x4a, 0x50, 0x14, 0xf0, 0x98, 0x60, 0x49, 0x59, 0x0d, 0xb5, 0x4c,
-	0x0f, 0xa5, 0x8f, 0x50, 0x31, 0xae, 0xba, 0x3f, 0x31, 0xc0, 0x06, 0x58, 0x43, 0x19, 0x19, 0x0c,
-	0x45, 0x8f, 0xba, 0x2f, 0x7f, 0xac, 0x43, 0x60, 0xce, 0x03, 0x55, 0x2b, 0x79, 0x0a, 0xae, 0xa9,
-	0xfe, 0xd5, 0x0a, 0x76, 0xab, 0xe9, 0x94, 0x9a, 0x71, 0x94, 0x66, 0x9c, 0x67, 0x24, 0xc6, 0x5e,
-	0xbd, 0x1c, 0xc7, 0xe7, 0x9f, 0x5f, 0xee, 0x6b, 0x7e, 0x95, 0x64, 0x25, 0xe0, 0xae, 0x60, 0x78,
-	0x1d, 0x24, 0x71, 0x58, 0x56, 0xf2, 0x22, 0xe7, 0x8c, 0x07, 0x38, 0x8c, 0x71, 0x74, 0xa5, 0x81,
-	0x8b, 0x12, 0xb5, 0xa9, 0x12, 0xe1, 0x03, 0xb0, 0x59, 0x54, 0xb9, 0xbd, 0x20, 0x0c, 0x29, 0x62,
-	0x72, 0x51, 0x75, 0xff, 0xfa, 0x85, 0x63, 0x5f, 0xda, 0xad, 0x77, 0xc0, 0x5e, 0xcc, 0xf6, 0x9f,
-	0x3a, 0xdb, 0x05, 0x3b, 0x82, 0x4b, 0xe1, 0x76, 0x09, 0x49, 0x2a, 0x7d, 0xbf, 0x02, 0xcd, 0x59,
-	0x97, 0xa2, 0xdd, 0x03, 0x7a, 0x46, 0x48, 0xb2, 0x14, 0xa7, 0xc8, 0xe8, 0x7c, 0xd4, 0xc1, 0x9a,
-	0x80, 0x85, 0x08, 0xac, 0xcb, 0x9b, 0x03, 0x1f, 0xce, 0xd7, 0xea, 0xec, 0xbd, 0x33, 0x1e, 0xfd,
-	0x61, 0xb4, 0x2a, 0xf5, 0xbd, 0x06, 0x36, 0x67, 0x94, 0x01, 0x9f, 0x2c, 0x00, 0x99, 0x27, 0x50,
-	0x63, 0x6f, 0xf9, 0x44, 0x55, 0xc8, 0x27, 0x0d, 0xdc, 0xf8, 0xcd, 0x4a, 0xe1, 0xfe, 0x02, 0xe4,
-	0xc5, 0xe2, 0x33, 0xbc, 0x7f, 0x81, 0x50, 0x65, 0x72, 0xb0, 0x31, 0xb5, 0x71, 0xd8, 0x5e, 0x00,
-	0x39, 0x2b, 0x1c, 0xa3, 0xb3, 0x4c, 0x8a, 0x64, 0xf5, 0xbc, 0xb3, 0x91, 0xa9, 0x9d, 0x8f, 0x4c,
-	0xed, 0xc7, 0xc8, 0xd4, 0x3e, 0x8c, 0xcd, 0xda, 0xf9, 0xd8, 0xac, 0x7d, 0x1b, 0x9b, 0xb5, 0x37,
-	0x76, 0x14, 0xf3, 0x61, 0xde, 0x77, 0x06, 0x24, 0x75, 0xa7, 0x1e, 0xf2, 0x93, 0xe9, 0xa7, 0x9c,
-	0x9f, 0x66, 0x88, 0xf5, 0xd7, 0xc5, 0x6b, 0xfd, 0xf8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6,
-	0x93, 0x30, 0x79, 0x63, 0x06, 0x00, 0x00,
+func (m *QueryClaimableRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRewardsRequest.Merge(m, src)
+}
+func (m *QueryClaimableRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRewardsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryClaimableRewardsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryClaimableRewardsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error)
-	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
-	RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error)
+func (m *QueryClaimableRewardsRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryClaimableRewardsRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryClaimableRewardsResponse struct {
+	// total is the sum of the outstanding rewards in the range.
+	Total types.Coin `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+	// rewards are the non-zero outstanding rewards per epoch, oldest first.
+	Rewards []EpochReward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClaimableRewardsResponse) Reset()         { *m = QueryClaimableRewardsResponse{} }
+func (m *QueryClaimableRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRewardsResponse) ProtoMessage()    {}
+func (*QueryClaimableRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{9}
+}
+func (m *QueryClaimableRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryClaimableRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRewardsResponse.Merge(m, src)
+}
+func (m *QueryClaimableRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRewardsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error) {
-	out := new(QueryDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/DelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableRewardsResponse) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error) {
-	out := new(QueryValidatorOutstandingRewardsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/ValidatorOutstandingRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClaimableRewardsResponse) GetRewards() []EpochReward {
+	if m != nil {
+		return m.Rewards
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error) {
-	out := new(QueryRewardsPoolResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/RewardsPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryRewardsCommissionRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryRewardsCommissionRequest) Reset()         { *m = QueryRewardsCommissionRequest{} }
+func (m *QueryRewardsCommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsCommissionRequest) ProtoMessage()    {}
+func (*QueryRewardsCommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{10}
+}
+func (m *QueryRewardsCommissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsCommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsCommissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRewardsCommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsCommissionRequest.Merge(m, src)
+}
+func (m *QueryRewardsCommissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsCommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsCommissionRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
-	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
-	RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error)
+var xxx_messageInfo_QueryRewardsCommissionRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsCommissionRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryRewardsCommissionResponse reports the share of the epoch rewards a
+// validator operator keeps. A validator that has not opted into delegator
+// pass-through reports a rate of 1.
+type QueryRewardsCommissionResponse struct {
+	// commission_rate applies to the epoch currently accumulating points.
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// next_commission_rate applies from the next epoch.
+	NextCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=next_commission_rate,json=nextCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"next_commission_rate"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRewardsCommissionResponse) Reset()         { *m = QueryRewardsCommissionResponse{} }
+func (m *QueryRewardsCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsCommissionResponse) ProtoMessage()    {}
+func (*QueryRewardsCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{11}
 }
-func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
+func (m *QueryRewardsCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context, req *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingRewards not implemented")
+func (m *QueryRewardsCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RewardsPool(ctx context.Context, req *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsPool not implemented")
+func (m *QueryRewardsCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsCommissionResponse.Merge(m, src)
+}
+func (m *QueryRewardsCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsCommissionResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryRewardsCommissionResponse proto.InternalMessageInfo
+
+// QueryDelegatorRewardsRequest selects the epochs between start_epoch and
+// end_epoch (inclusive) of a delegator. An end_epoch of 0 leaves the range
+// open.
+type QueryDelegatorRewardsRequest struct {
+	Delegator  string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	StartEpoch uint64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch   uint64 `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryDelegatorRewardsRequest) Reset()         { *m = QueryDelegatorRewardsRequest{} }
+func (m *QueryDelegatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRewardsRequest) ProtoMessage()    {}
+func (*QueryDelegatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{12}
+}
+func (m *QueryDelegatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRewardsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRewardsRequest.DiscardUnknown(m)
 }
 
-func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Query/DelegationRewards",
+var xxx_messageInfo_QueryDelegatorRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorRewardsRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegationRewards(ctx, req.(*QueryDelegationRewardsRequest))
+	return ""
+}
+
+func (m *QueryDelegatorRewardsRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ValidatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorOutstandingRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryDelegatorRewardsRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, in)
+	return 0
+}
+
+type QueryDelegatorRewardsResponse struct {
+	// total is the sum of the claimable delegator rewards in the range.
+	Total types.Coin `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+	// rewards are the claimable rewards per epoch and validator, oldest first.
+	Rewards []DelegatorEpochReward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *QueryDelegatorRewardsResponse) Reset()         { *m = QueryDelegatorRewardsResponse{} }
+func (m *QueryDelegatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRewardsResponse) ProtoMessage()    {}
+func (*QueryDelegatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{13}
+}
+func (m *QueryDelegatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Query/ValidatorOutstandingRewards",
+}
+func (m *QueryDelegatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRewardsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorRewardsResponse) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, req.(*QueryValidatorOutstandingRewardsRequest))
+	return types.Coin{}
+}
+
+func (m *QueryDelegatorRewardsResponse) GetRewards() []DelegatorEpochReward {
+	if m != nil {
+		return m.Rewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Query/RewardsPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsPool(ctx, req.(*QueryRewardsPoolRequest))
+type QueryOutstandingLiabilitiesRequest struct {
+}
+
+func (m *QueryOutstandingLiabilitiesRequest) Reset()         { *m = QueryOutstandingLiabilitiesRequest{} }
+func (m *QueryOutstandingLiabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingLiabilitiesRequest) ProtoMessage()    {}
+func (*QueryOutstandingLiabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{14}
+}
+func (m *QueryOutstandingLiabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingLiabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingLiabilitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOutstandingLiabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingLiabilitiesRequest.Merge(m, src)
+}
+func (m *QueryOutstandingLiabilitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingLiabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingLiabilitiesRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.evm.valrewards.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DelegationRewards",
-			Handler:    _Query_DelegationRewards_Handler,
-		},
-		{
-			MethodName: "ValidatorOutstandingRewards",
-			Handler:    _Query_ValidatorOutstandingRewards_Handler,
-		},
-		{
-			MethodName: "RewardsPool",
-			Handler:    _Query_RewardsPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/evm/valrewards/v1/query.proto",
+var xxx_messageInfo_QueryOutstandingLiabilitiesRequest proto.InternalMessageInfo
+
+// QueryOutstandingLiabilitiesResponse compares the rewards owed to validators
+// and delegators with the rewards pool balance backing them.
+type QueryOutstandingLiabilitiesResponse struct {
+	// liabilities is the sum of every unclaimed validator and delegator reward.
+	Liabilities types.Coin `protobuf:"bytes,1,opt,name=liabilities,proto3" json:"liabilities"`
+	// pool is the rewards pool balance.
+	Pool types.Coin `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool"`
+	// unallocated is the part of the pool not owed to anyone, available to
+	// future epochs.
+	Unallocated types.Coin `protobuf:"bytes,3,opt,name=unallocated,proto3" json:"unallocated"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOutstandingLiabilitiesResponse) Reset()         { *m = QueryOutstandingLiabilitiesResponse{} }
+func (m *QueryOutstandingLiabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingLiabilitiesResponse) ProtoMessage()    {}
+func (*QueryOutstandingLiabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{15}
+}
+func (m *QueryOutstandingLiabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingLiabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingLiabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryOutstandingLiabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingLiabilitiesResponse.Merge(m, src)
+}
+func (m *QueryOutstandingLiabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingLiabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingLiabilitiesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryOutstandingLiabilitiesResponse proto.InternalMessageInfo
+
+func (m *QueryOutstandingLiabilitiesResponse) GetLiabilities() types.Coin {
+	if m != nil {
+		return m.Liabilities
+	}
+	return types.Coin{}
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryOutstandingLiabilitiesResponse) GetPool() types.Coin {
+	if m != nil {
+		return m.Pool
+	}
+	return types.Coin{}
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOutstandingLiabilitiesResponse) GetUnallocated() types.Coin {
+	if m != nil {
+		return m.Unallocated
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRewardsRunwayRequest struct {
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Whitelist) > 0 {
-		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Whitelist[iNdEx])
-			copy(dAtA[i:], m.Whitelist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Whitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.ProposerBonusPoints != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposerBonusPoints))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.NextRewardSettings.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardsRunwayRequest) Reset()         { *m = QueryRewardsRunwayRequest{} }
+func (m *QueryRewardsRunwayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRunwayRequest) ProtoMessage()    {}
+func (*QueryRewardsRunwayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{16}
+}
+func (m *QueryRewardsRunwayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRunwayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRunwayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CurrentRewardSettings.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *QueryRewardsRunwayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRunwayRequest.Merge(m, src)
+}
+func (m *QueryRewardsRunwayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRunwayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRunwayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsRunwayRequest proto.InternalMessageInfo
+
+// QueryRewardsRunwayResponse reports how long the rewards pool can keep
+// funding epochs at the current reward settings.
+type QueryRewardsRunwayResponse struct {
+	// unallocated is the part of the pool not owed to anyone.
+	Unallocated types.Coin `protobuf:"bytes,1,opt,name=unallocated,proto3" json:"unallocated"`
+	// liabilities is the sum of every unclaimed validator and delegator reward.
+	Liabilities types.Coin `protobuf:"bytes,2,opt,name=liabilities,proto3" json:"liabilities"`
+	// rewards_per_epoch is the allocation of the current reward settings.
+	RewardsPerEpoch types.Coin `protobuf:"bytes,3,opt,name=rewards_per_epoch,json=rewardsPerEpoch,proto3" json:"rewards_per_epoch"`
+	// funded_epochs is the number of full epochs the unallocated balance can
+	// fund at rewards_per_epoch.
+	FundedEpochs uint64 `protobuf:"varint,4,opt,name=funded_epochs,json=fundedEpochs,proto3" json:"funded_epochs,omitempty"`
+	// runway_alert_epochs is the governance-set alert threshold.
+	RunwayAlertEpochs uint64 `protobuf:"varint,5,opt,name=runway_alert_epochs,json=runwayAlertEpochs,proto3" json:"runway_alert_epochs,omitempty"`
+}
+
+func (m *QueryRewardsRunwayResponse) Reset()         { *m = QueryRewardsRunwayResponse{} }
+func (m *QueryRewardsRunwayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRunwayResponse) ProtoMessage()    {}
+func (*QueryRewardsRunwayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{17}
+}
+func (m *QueryRewardsRunwayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRunwayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRunwayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsRunwayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRunwayResponse.Merge(m, src)
+}
+func (m *QueryRewardsRunwayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRunwayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRunwayResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsRunwayResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsRunwayResponse) GetUnallocated() types.Coin {
+	if m != nil {
+		return m.Unallocated
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardsRunwayResponse) GetLiabilities() types.Coin {
+	if m != nil {
+		return m.Liabilities
+	}
+	return types.Coin{}
 }
 
-func (m *QueryDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryRewardsRunwayResponse) GetRewardsPerEpoch() types.Coin {
+	if m != nil {
+		return m.RewardsPerEpoch
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+	return types.Coin{}
+}
+
+func (m *QueryRewardsRunwayResponse) GetFundedEpochs() uint64 {
+	if m != nil {
+		return m.FundedEpochs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardsRunwayResponse) GetRunwayAlertEpochs() uint64 {
+	if m != nil {
+		return m.RunwayAlertEpochs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPayoutSettingsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPayoutSettingsRequest) Reset()         { *m = QueryPayoutSettingsRequest{} }
+func (m *QueryPayoutSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutSettingsRequest) ProtoMessage()    {}
+func (*QueryPayoutSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{18}
+}
+func (m *QueryPayoutSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutSettingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPayoutSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutSettingsRequest.Merge(m, src)
 }
-
-func (m *QueryValidatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPayoutSettingsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryValidatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryPayoutSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutSettingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPayoutSettingsRequest proto.InternalMessageInfo
+
+func (m *QueryPayoutSettingsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPayoutSettingsResponse reports where the rewards of a validator go.
+type QueryPayoutSettingsResponse struct {
+	// withdraw_address receives claimed rewards; it is the operator account
+	// unless another one was set.
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	// auto_compound reports whether epoch rewards are delegated to the
+	// validator at the epoch rollover.
+	AutoCompound bool `protobuf:"varint,2,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPayoutSettingsResponse) Reset()         { *m = QueryPayoutSettingsResponse{} }
+func (m *QueryPayoutSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutSettingsResponse) ProtoMessage()    {}
+func (*QueryPayoutSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce219a702b0e9dd, []int{19}
+}
+func (m *QueryPayoutSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPayoutSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutSettingsResponse.Merge(m, src)
+}
+func (m *QueryPayoutSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutSettingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPayoutSettingsResponse proto.InternalMessageInfo
+
+func (m *QueryPayoutSettingsResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRewardsPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPayoutSettingsResponse) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
 }
 
-func (m *QueryRewardsPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.valrewards.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.valrewards.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "cosmos.evm.valrewards.v1.QueryDelegationRewardsRequest")
+	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "cosmos.evm.valrewards.v1.QueryDelegationRewardsResponse")
+	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "cosmos.evm.valrewards.v1.QueryValidatorOutstandingRewardsRequest")
+	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "cosmos.evm.valrewards.v1.QueryValidatorOutstandingRewardsResponse")
+	proto.RegisterType((*QueryRewardsPoolRequest)(nil), "cosmos.evm.valrewards.v1.QueryRewardsPoolRequest")
+	proto.RegisterType((*QueryRewardsPoolResponse)(nil), "cosmos.evm.valrewards.v1.QueryRewardsPoolResponse")
+	proto.RegisterType((*QueryClaimableRewardsRequest)(nil), "cosmos.evm.valrewards.v1.QueryClaimableRewardsRequest")
+	proto.RegisterType((*QueryClaimableRewardsResponse)(nil), "cosmos.evm.valrewards.v1.QueryClaimableRewardsResponse")
+	proto.RegisterType((*QueryRewardsCommissionRequest)(nil), "cosmos.evm.valrewards.v1.QueryRewardsCommissionRequest")
+	proto.RegisterType((*QueryRewardsCommissionResponse)(nil), "cosmos.evm.valrewards.v1.QueryRewardsCommissionResponse")
+	proto.RegisterType((*QueryDelegatorRewardsRequest)(nil), "cosmos.evm.valrewards.v1.QueryDelegatorRewardsRequest")
+	proto.RegisterType((*QueryDelegatorRewardsResponse)(nil), "cosmos.evm.valrewards.v1.QueryDelegatorRewardsResponse")
+	proto.RegisterType((*QueryOutstandingLiabilitiesRequest)(nil), "cosmos.evm.valrewards.v1.QueryOutstandingLiabilitiesRequest")
+	proto.RegisterType((*QueryOutstandingLiabilitiesResponse)(nil), "cosmos.evm.valrewards.v1.QueryOutstandingLiabilitiesResponse")
+	proto.RegisterType((*QueryRewardsRunwayRequest)(nil), "cosmos.evm.valrewards.v1.QueryRewardsRunwayRequest")
+	proto.RegisterType((*QueryRewardsRunwayResponse)(nil), "cosmos.evm.valrewards.v1.QueryRewardsRunwayResponse")
+	proto.RegisterType((*QueryPayoutSettingsRequest)(nil), "cosmos.evm.valrewards.v1.QueryPayoutSettingsRequest")
+	proto.RegisterType((*QueryPayoutSettingsResponse)(nil), "cosmos.evm.valrewards.v1.QueryPayoutSettingsResponse")
 }
 
-func (m *QueryRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("cosmos/evm/valrewards/v1/query.proto", fileDescriptor_4ce219a702b0e9dd)
+}
+
+var fileDescriptor_4ce219a702b0e9dd = []byte{
+	// 1167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x4e, 0xa9, 0x5f, 0x9a, 0x26, 0x99, 0xa4, 0xd4, 0xd9, 0x24, 0x4e, 0xb4, 0x29,
+	0xc2, 0x05, 0xba, 0x56, 0xd2, 0xd2, 0x44, 0x08, 0x90, 0xe2, 0xa4, 0x48, 0x48, 0x11, 0x98, 0x2d,
+	0xe2, 0xc0, 0xc5, 0x1d, 0x7b, 0xa7, 0xf6, 0xc2, 0x7a, 0xc7, 0xdd, 0x99, 0x75, 0x9a, 0x4a, 0x9c,
+	0x90, 0x90, 0xb8, 0x71, 0xe1, 0x0b, 0x20, 0x0e, 0x48, 0x5c, 0x38, 0x70, 0xe4, 0x03, 0xf4, 0x58,
+	0xc1, 0x05, 0x71, 0xa8, 0x20, 0x39, 0x70, 0xe0, 0xc0, 0x57, 0xa8, 0x76, 0x66, 0xd6, 0x5e, 0xff,
+	0xd9, 0x38, 0x9b, 0xf4, 0x12, 0xc5, 0xef, 0xcd, 0x7b, 0xbf, 0xdf, 0x7b, 0xf3, 0xe6, 0xbd, 0xb7,
+	0x70, 0xa3, 0x4e, 0x59, 0x8b, 0xb2, 0x12, 0xe9, 0xb4, 0x4a, 0x1d, 0xec, 0xfa, 0xe4, 0x10, 0xfb,
+	0x36, 0x2b, 0x75, 0x36, 0x4b, 0x8f, 0x02, 0xe2, 0x1f, 0x99, 0x6d, 0x9f, 0x72, 0x8a, 0xf2, 0xf2,
+	0x94, 0x49, 0x3a, 0x2d, 0xb3, 0x77, 0xca, 0xec, 0x6c, 0xea, 0xf3, 0xb8, 0xe5, 0x78, 0xb4, 0x24,
+	0xfe, 0xca, 0xc3, 0xfa, 0x92, 0x3c, 0x5c, 0x15, 0xbf, 0x4a, 0xca, 0x52, 0xaa, 0x0a, 0x0a, 0xad,
+	0x86, 0x19, 0x29, 0x75, 0x36, 0x6b, 0x84, 0xe3, 0xcd, 0x52, 0x9d, 0x3a, 0x9e, 0xd2, 0xdf, 0x4c,
+	0x64, 0xd3, 0xfb, 0xa5, 0x8e, 0x2e, 0x36, 0x68, 0x83, 0x4a, 0x88, 0xf0, 0x3f, 0x29, 0x35, 0x16,
+	0x01, 0x7d, 0x12, 0xf2, 0xae, 0x60, 0x1f, 0xb7, 0x98, 0x45, 0x1e, 0x05, 0x84, 0x71, 0xe3, 0x9f,
+	0x0c, 0x2c, 0xf4, 0x89, 0x59, 0x9b, 0x7a, 0x8c, 0xa0, 0x87, 0x70, 0xbd, 0x1e, 0xf8, 0x3e, 0xf1,
+	0x78, 0x55, 0x3a, 0xaf, 0x32, 0xc2, 0xb9, 0xe3, 0x35, 0x58, 0x5e, 0x5b, 0xd7, 0x8a, 0xd3, 0x5b,
+	0x45, 0x33, 0x29, 0x70, 0xd3, 0x12, 0xff, 0xde, 0x57, 0xe7, 0xcb, 0xd9, 0xa7, 0xcf, 0xd7, 0x26,
+	0xac, 0x6b, 0xca, 0x5d, 0xbf, 0x12, 0x3d, 0x80, 0x45, 0x8f, 0x3c, 0x1e, 0x06, 0xc9, 0x9c, 0x0b,
+	0x04, 0x85, 0xbe, 0x06, 0x10, 0xb6, 0xe0, 0x5a, 0xdb, 0xa7, 0x6d, 0xca, 0x88, 0x5f, 0xad, 0x51,
+	0x2f, 0x60, 0xd5, 0x36, 0x75, 0x3c, 0xce, 0xf2, 0x93, 0xeb, 0x5a, 0x31, 0x6b, 0x2d, 0x44, 0xca,
+	0x72, 0xa8, 0xab, 0x08, 0x15, 0x5a, 0x81, 0xdc, 0x61, 0xd3, 0xe1, 0xc4, 0x75, 0x18, 0xcf, 0x67,
+	0xd7, 0x27, 0x8b, 0x39, 0xab, 0x27, 0x40, 0x26, 0x2c, 0xf8, 0x81, 0x77, 0x88, 0x8f, 0xaa, 0xd8,
+	0x25, 0x3e, 0xaf, 0x92, 0x36, 0xad, 0x37, 0x59, 0x7e, 0x4a, 0xf8, 0x9b, 0x97, 0xaa, 0xdd, 0x50,
+	0x73, 0x4f, 0x28, 0x8c, 0xfb, 0xb0, 0x2a, 0x52, 0xbc, 0x4f, 0x5c, 0xd2, 0xc0, 0xdc, 0xa1, 0x9e,
+	0xa4, 0x18, 0x5d, 0x42, 0x08, 0x67, 0x4b, 0x1d, 0xf5, 0x45, 0x7a, 0x73, 0x56, 0x4f, 0x80, 0x16,
+	0x61, 0x4a, 0x20, 0x88, 0x9c, 0x64, 0x2d, 0xf9, 0xc3, 0x78, 0x00, 0x85, 0x24, 0xa7, 0xea, 0x0a,
+	0xdf, 0x87, 0x57, 0x54, 0xbe, 0xd4, 0x95, 0x2d, 0x45, 0xd9, 0x0c, 0x6b, 0xcc, 0x54, 0x35, 0x66,
+	0xee, 0x51, 0xc7, 0x2b, 0xe7, 0xc2, 0xf4, 0xfd, 0xf4, 0xef, 0x2f, 0x6f, 0x68, 0x56, 0x64, 0x64,
+	0xb8, 0xf0, 0xba, 0x40, 0xf8, 0x0c, 0xbb, 0x8e, 0x1d, 0x32, 0xf9, 0x38, 0xe0, 0x8c, 0x63, 0xcf,
+	0x76, 0xbc, 0xc6, 0x40, 0x00, 0x5d, 0x8a, 0x5a, 0x8c, 0x22, 0x7a, 0x13, 0xe6, 0x3b, 0x91, 0x6d,
+	0x15, 0xdb, 0xb6, 0x4f, 0x98, 0xbc, 0xd8, 0x9c, 0x35, 0xd7, 0x55, 0xec, 0x4a, 0xb9, 0xf1, 0x05,
+	0x14, 0xc7, 0xa3, 0xbd, 0xa4, 0xc8, 0x96, 0xe0, 0xba, 0xc0, 0x52, 0x7e, 0x2b, 0x94, 0xba, 0xd1,
+	0x7b, 0xf8, 0x14, 0xf2, 0xc3, 0x2a, 0x05, 0xbb, 0x03, 0xd9, 0x36, 0xa5, 0x6e, 0x2a, 0x4c, 0x61,
+	0x61, 0x7c, 0xab, 0xc1, 0x8a, 0x70, 0xbb, 0xe7, 0x62, 0xa7, 0x85, 0x6b, 0x2e, 0x19, 0x48, 0xe0,
+	0xc8, 0x54, 0x69, 0xa3, 0x53, 0x85, 0xd6, 0x60, 0x9a, 0x71, 0x1c, 0x15, 0x9e, 0x2a, 0x0b, 0x10,
+	0x22, 0x51, 0x71, 0x68, 0x19, 0x72, 0xc4, 0xb3, 0x95, 0x5a, 0x96, 0xf9, 0x65, 0xe2, 0xd9, 0x42,
+	0x69, 0xfc, 0xa0, 0xc1, 0x6a, 0x02, 0x17, 0x15, 0xe7, 0x3b, 0x30, 0xc5, 0x29, 0xc7, 0xe9, 0x02,
+	0x95, 0x26, 0xe8, 0x5e, 0xef, 0x6a, 0x32, 0xeb, 0x93, 0xc5, 0xe9, 0xad, 0xd7, 0x92, 0x9f, 0xb0,
+	0xe0, 0x23, 0xc1, 0xd5, 0xfb, 0xed, 0xde, 0xd0, 0x81, 0xe2, 0xa8, 0xa8, 0xed, 0xd1, 0x56, 0xcb,
+	0x61, 0x4c, 0x14, 0x79, 0xfa, 0x84, 0x19, 0xff, 0x69, 0x50, 0x48, 0x72, 0xa7, 0x62, 0xae, 0xc2,
+	0x6c, 0xbd, 0x2b, 0xad, 0xfa, 0x98, 0x13, 0xe9, 0xad, 0x7c, 0x37, 0x24, 0xf6, 0xd7, 0xf3, 0xb5,
+	0x65, 0x19, 0x06, 0xb3, 0xbf, 0x34, 0x1d, 0x5a, 0x6a, 0x61, 0xde, 0x34, 0x0f, 0x48, 0x03, 0xd7,
+	0x8f, 0xf6, 0x49, 0xfd, 0xf7, 0x5f, 0x6f, 0x81, 0x8a, 0x72, 0x9f, 0xd4, 0x65, 0x3e, 0xae, 0xf6,
+	0xdc, 0x59, 0x98, 0x13, 0xd4, 0x54, 0x8d, 0x6e, 0x10, 0x25, 0x73, 0x21, 0x14, 0xd1, 0xf0, 0xf6,
+	0xfa, 0x90, 0x8c, 0x27, 0xb0, 0x12, 0xef, 0x0c, 0xd4, 0x4f, 0xd5, 0x6d, 0x2e, 0x56, 0x5c, 0x3f,
+	0x6b, 0xb0, 0x9a, 0x00, 0xfe, 0x12, 0x8a, 0xeb, 0xa3, 0xc1, 0xe2, 0x32, 0x93, 0x8b, 0xab, 0x4b,
+	0xe0, 0x94, 0x2a, 0xbb, 0x01, 0x86, 0x20, 0x1b, 0x6b, 0x35, 0x07, 0x0e, 0xae, 0x39, 0xae, 0xc3,
+	0x1d, 0xd2, 0x1d, 0x91, 0xff, 0x6b, 0xb0, 0x71, 0xea, 0x31, 0x15, 0xd9, 0x07, 0x30, 0xed, 0xf6,
+	0xc4, 0xa9, 0xe2, 0x8b, 0x1b, 0x76, 0xdb, 0x4c, 0x26, 0x6d, 0x9b, 0x09, 0x19, 0x04, 0x1e, 0x76,
+	0x5d, 0x5a, 0xc7, 0x9c, 0xd8, 0xf9, 0xc9, 0x14, 0x0e, 0xe2, 0x86, 0xc6, 0x32, 0x2c, 0xc5, 0x9f,
+	0x8b, 0x25, 0x26, 0x5a, 0x94, 0x8e, 0x3f, 0x32, 0xa0, 0x8f, 0xd2, 0xf6, 0xb2, 0x10, 0xe7, 0xa0,
+	0x9d, 0x93, 0xc3, 0x60, 0x36, 0x33, 0xe7, 0xcd, 0x66, 0x05, 0xe6, 0xd5, 0x75, 0x57, 0xdb, 0xc4,
+	0x8f, 0x95, 0xed, 0x59, 0xbd, 0xcd, 0x2a, 0xf3, 0x0a, 0x91, 0xa5, 0x84, 0x36, 0x60, 0xe6, 0x61,
+	0xe0, 0xd9, 0xc4, 0x8e, 0x06, 0x7f, 0x56, 0x3c, 0x82, 0x2b, 0x52, 0x28, 0xce, 0xb0, 0xd4, 0x3b,
+	0xc2, 0x87, 0x2a, 0xa9, 0x15, 0x7c, 0x44, 0x03, 0x1e, 0x2d, 0x2f, 0xe7, 0xea, 0x76, 0x2d, 0x58,
+	0x1e, 0xe9, 0x4a, 0x5d, 0xd0, 0x4d, 0x98, 0x3b, 0x74, 0x78, 0xd3, 0xf6, 0xf1, 0xe1, 0x80, 0xab,
+	0xd9, 0x48, 0x1e, 0x0d, 0x9a, 0x0d, 0x98, 0xc1, 0x01, 0xa7, 0x61, 0xcf, 0x6a, 0xd3, 0xc0, 0xb3,
+	0xc5, 0x2d, 0x5c, 0xb6, 0xae, 0x84, 0xc2, 0x3d, 0x25, 0xdb, 0xfa, 0x0d, 0x60, 0x4a, 0xe0, 0x21,
+	0x02, 0x97, 0xe4, 0x16, 0x89, 0xde, 0x4a, 0x7e, 0x97, 0xc3, 0x3b, 0xa8, 0x7e, 0xeb, 0x8c, 0xa7,
+	0x55, 0x00, 0xdf, 0x68, 0x30, 0x3f, 0xb4, 0xf5, 0xa0, 0xed, 0x31, 0x4e, 0x92, 0x96, 0x2f, 0x7d,
+	0x27, 0xbd, 0xa1, 0x22, 0xf2, 0xa3, 0x06, 0xcb, 0xa7, 0xac, 0x2b, 0x68, 0x77, 0x8c, 0xe7, 0xf1,
+	0x8b, 0x95, 0x5e, 0xbe, 0x88, 0x0b, 0x45, 0x93, 0xc3, 0x74, 0x6c, 0x9b, 0x41, 0x9b, 0x63, 0x5c,
+	0x0e, 0x2f, 0x45, 0xfa, 0x56, 0x1a, 0x13, 0x85, 0xfa, 0xb5, 0x06, 0x73, 0x83, 0x1b, 0x06, 0xba,
+	0x3b, 0xc6, 0x51, 0xc2, 0x7a, 0xa4, 0x6f, 0xa7, 0xb6, 0x8b, 0xd5, 0xca, 0xd0, 0xd0, 0x1f, 0x5b,
+	0x2b, 0x49, 0x5b, 0x87, 0xbe, 0x93, 0xde, 0x30, 0x96, 0x8e, 0xc1, 0x99, 0x38, 0x36, 0x1d, 0x09,
+	0x13, 0x5c, 0xdf, 0x4e, 0x6d, 0xa7, 0x58, 0x7c, 0xaf, 0xc1, 0xab, 0xa3, 0xa7, 0x18, 0x7a, 0x77,
+	0x8c, 0xcf, 0x53, 0x67, 0xa4, 0xfe, 0xde, 0x39, 0xad, 0x15, 0xaf, 0x27, 0x30, 0xd3, 0x37, 0x4d,
+	0xd0, 0xed, 0xb3, 0x25, 0xba, 0x6f, 0x32, 0xe9, 0x77, 0xd2, 0x19, 0x29, 0xec, 0xaf, 0xe0, 0x6a,
+	0x7f, 0xa7, 0x44, 0x77, 0xc6, 0xf6, 0xa3, 0x11, 0x3d, 0x5a, 0x7f, 0x3b, 0xa5, 0x95, 0x84, 0x2f,
+	0x97, 0x9f, 0x1e, 0x17, 0xb4, 0x67, 0xc7, 0x05, 0xed, 0xef, 0xe3, 0x82, 0xf6, 0xdd, 0x49, 0x61,
+	0xe2, 0xd9, 0x49, 0x61, 0xe2, 0xcf, 0x93, 0xc2, 0xc4, 0xe7, 0xc5, 0x86, 0xc3, 0x9b, 0x41, 0xcd,
+	0xac, 0xd3, 0x56, 0x29, 0xf6, 0xf1, 0xff, 0x38, 0xfe, 0xf9, 0xcf, 0x8f, 0xda, 0x84, 0xd5, 0x2e,
+	0x89, 0x2f, 0xfc, 0xdb, 0x2f, 0x06, 0x00, 0x6e, 0xf3, 0x52, 0xba, 0xb2, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error)
+	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
+	RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error)
+	ClaimableRewards(ctx context.Context, in *QueryClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryClaimableRewardsResponse, error)
+	RewardsCommission(ctx context.Context, in *QueryRewardsCommissionRequest, opts ...grpc.CallOption) (*QueryRewardsCommissionResponse, error)
+	DelegatorRewards(ctx context.Context, in *QueryDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsResponse, error)
+	OutstandingLiabilities(ctx context.Context, in *QueryOutstandingLiabilitiesRequest, opts ...grpc.CallOption) (*QueryOutstandingLiabilitiesResponse, error)
+	RewardsRunway(ctx context.Context, in *QueryRewardsRunwayRequest, opts ...grpc.CallOption) (*QueryRewardsRunwayResponse, error)
+	PayoutSettings(ctx context.Context, in *QueryPayoutSettingsRequest, opts ...grpc.CallOption) (*QueryPayoutSettingsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error) {
+	out := new(QueryDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/DelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error) {
+	out := new(QueryValidatorOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/ValidatorOutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error) {
+	out := new(QueryRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/RewardsPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) ClaimableRewards(ctx context.Context, in *QueryClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryClaimableRewardsResponse, error) {
+	out := new(QueryClaimableRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/ClaimableRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CurrentRewardSettings.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NextRewardSettings.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ProposerBonusPoints != 0 {
-		n += 1 + sovQuery(uint64(m.ProposerBonusPoints))
-	}
-	if len(m.Whitelist) > 0 {
-		for _, s := range m.Whitelist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) RewardsCommission(ctx context.Context, in *QueryRewardsCommissionRequest, opts ...grpc.CallOption) (*QueryRewardsCommissionResponse, error) {
+	out := new(QueryRewardsCommissionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/RewardsCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
+func (c *queryClient) DelegatorRewards(ctx context.Context, in *QueryDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsResponse, error) {
+	out := new(QueryDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/DelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) OutstandingLiabilities(ctx context.Context, in *QueryOutstandingLiabilitiesRequest, opts ...grpc.CallOption) (*QueryOutstandingLiabilitiesResponse, error) {
+	out := new(QueryOutstandingLiabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/OutstandingLiabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RewardsRunway(ctx context.Context, in *QueryRewardsRunwayRequest, opts ...grpc.CallOption) (*QueryRewardsRunwayResponse, error) {
+	out := new(QueryRewardsRunwayResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/RewardsRunway", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PayoutSettings(ctx context.Context, in *QueryPayoutSettingsRequest, opts ...grpc.CallOption) (*QueryPayoutSettingsResponse, error) {
+	out := new(QueryPayoutSettingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Query/PayoutSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
+	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
+	RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error)
+	ClaimableRewards(context.Context, *QueryClaimableRewardsRequest) (*QueryClaimableRewardsResponse, error)
+	RewardsCommission(context.Context, *QueryRewardsCommissionRequest) (*QueryRewardsCommissionResponse, error)
+	DelegatorRewards(context.Context, *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error)
+	OutstandingLiabilities(context.Context, *QueryOutstandingLiabilitiesRequest) (*QueryOutstandingLiabilitiesResponse, error)
+	RewardsRunway(context.Context, *QueryRewardsRunwayRequest) (*QueryRewardsRunwayResponse, error)
+	PayoutSettings(context.Context, *QueryPayoutSettingsRequest) (*QueryPayoutSettingsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context, req *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) RewardsPool(ctx context.Context, req *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsPool not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableRewards(ctx context.Context, req *QueryClaimableRewardsRequest) (*QueryClaimableRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableRewards not implemented")
+}
+func (*UnimplementedQueryServer) RewardsCommission(ctx context.Context, req *QueryRewardsCommissionRequest) (*QueryRewardsCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsCommission not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorRewards(ctx context.Context, req *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) OutstandingLiabilities(ctx context.Context, req *QueryOutstandingLiabilitiesRequest) (*QueryOutstandingLiabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutstandingLiabilities not implemented")
+}
+func (*UnimplementedQueryServer) RewardsRunway(ctx context.Context, req *QueryRewardsRunwayRequest) (*QueryRewardsRunwayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsRunway not implemented")
+}
+func (*UnimplementedQueryServer) PayoutSettings(ctx context.Context, req *QueryPayoutSettingsRequest) (*QueryPayoutSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutSettings not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/DelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewards(ctx, req.(*QueryDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/ValidatorOutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, req.(*QueryValidatorOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/RewardsPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsPool(ctx, req.(*QueryRewardsPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/ClaimableRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableRewards(ctx, req.(*QueryClaimableRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/RewardsCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsCommission(ctx, req.(*QueryRewardsCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/DelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRewards(ctx, req.(*QueryDelegatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutstandingLiabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutstandingLiabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutstandingLiabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/OutstandingLiabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutstandingLiabilities(ctx, req.(*QueryOutstandingLiabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsRunway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRunwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsRunway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/RewardsRunway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsRunway(ctx, req.(*QueryRewardsRunwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Query/PayoutSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutSettings(ctx, req.(*QueryPayoutSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.valrewards.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DelegationRewards",
+			Handler:    _Query_DelegationRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorOutstandingRewards",
+			Handler:    _Query_ValidatorOutstandingRewards_Handler,
+		},
+		{
+			MethodName: "RewardsPool",
+			Handler:    _Query_RewardsPool_Handler,
+		},
+		{
+			MethodName: "ClaimableRewards",
+			Handler:    _Query_ClaimableRewards_Handler,
+		},
+		{
+			MethodName: "RewardsCommission",
+			Handler:    _Query_RewardsCommission_Handler,
+		},
+		{
+			MethodName: "DelegatorRewards",
+			Handler:    _Query_DelegatorRewards_Handler,
+		},
+		{
+			MethodName: "OutstandingLiabilities",
+			Handler:    _Query_OutstandingLiabilities_Handler,
+		},
+		{
+			MethodName: "RewardsRunway",
+			Handler:    _Query_RewardsRunway_Handler,
+		},
+		{
+			MethodName: "PayoutSettings",
+			Handler:    _Query_PayoutSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/valrewards/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RunwayAlertEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RunwayAlertEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Whitelist) > 0 {
+		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Whitelist[iNdEx])
+			copy(dAtA[i:], m.Whitelist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Whitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ProposerBonusPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposerBonusPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.NextRewardSettings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrentRewardSettings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NextCommissionRate.Size()
+		i -= size
+		if _, err := m.NextCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingLiabilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingLiabilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingLiabilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingLiabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingLiabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingLiabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Unallocated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Liabilities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRunwayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRunwayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRunwayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRunwayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRunwayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRunwayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RunwayAlertEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RunwayAlertEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FundedEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FundedEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.RewardsPerEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Liabilities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Unallocated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutSettingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutSettingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutSettingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentRewardSettings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NextRewardSettings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProposerBonusPoints != 0 {
+		n += 1 + sovQuery(uint64(m.ProposerBonusPoints))
+	}
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RunwayAlertEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.RunwayAlertEpochs))
+	}
+	return n
+}
+
+func (m *QueryDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimableRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *QueryClaimableRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NextCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *QueryDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOutstandingLiabilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOutstandingLiabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Liabilities.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unallocated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsRunwayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsRunwayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Unallocated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Liabilities.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardsPerEpoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FundedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.FundedEpochs))
+	}
+	if m.RunwayAlertEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.RunwayAlertEpochs))
+	}
+	return n
+}
+
+func (m *QueryPayoutSettingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayoutSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AutoCompound {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewardSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentRewardSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRewardSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextRewardSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBonusPoints", wireType)
+			}
+			m.ProposerBonusPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerBonusPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwayAlertEpochs", wireType)
+			}
+			m.RunwayAlertEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunwayAlertEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, EpochReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryRewardsPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1073,12 +3832,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1100,7 +3929,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1123,15 +3952,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewardSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1158,13 +3987,13 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentRewardSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextRewardSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1191,61 +4020,61 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NextRewardSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, DelegatorEpochReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBonusPoints", wireType)
-			}
-			m.ProposerBonusPoints = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposerBonusPoints |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingLiabilitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingLiabilitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingLiabilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1267,7 +4096,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOutstandingLiabilitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1290,17 +4119,50 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutstandingLiabilitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutstandingLiabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liabilities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1310,29 +4172,30 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unallocated", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1342,11 +4205,25 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unallocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1368,7 +4245,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsRunwayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1391,45 +4268,12 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsRunwayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsRunwayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1451,7 +4295,7 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsRunwayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1474,17 +4318,17 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsRunwayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsRunwayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unallocated", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1494,16 +4338,30 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unallocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liabilities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1513,77 +4371,28 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Liabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1610,10 +4419,48 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardsPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedEpochs", wireType)
+			}
+			m.FundedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwayAlertEpochs", wireType)
+			}
+			m.RunwayAlertEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunwayAlertEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1635,7 +4482,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutSettingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1658,12 +4505,44 @@ func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutSettingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1685,7 +4564,7 @@ func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutSettingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1708,17 +4587,17 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutSettingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1728,25 +4607,44 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
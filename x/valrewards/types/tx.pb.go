@@ -4,20 +4,16 @@
 package types
 
 import (
-	bytes "bytes"
-	gzip "compress/gzip"
 	context "context"
-	apiamino "cosmossdk.io/api/amino"
-	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	cosmosproto "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
-	proto2 "google.golang.org/protobuf/proto"
-	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -389,6 +385,270 @@ func (m *MsgSetRewardingPausedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetRewardingPausedResponse proto.InternalMessageInfo
 
+type MsgSetProposerBonusPoints struct {
+	Signer              string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ProposerBonusPoints uint64 `protobuf:"varint,2,opt,name=proposer_bonus_points,json=proposerBonusPoints,proto3" json:"proposer_bonus_points,omitempty"`
+}
+
+func (m *MsgSetProposerBonusPoints) Reset()         { *m = MsgSetProposerBonusPoints{} }
+func (m *MsgSetProposerBonusPoints) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProposerBonusPoints) ProtoMessage()    {}
+func (*MsgSetProposerBonusPoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{8}
+}
+func (m *MsgSetProposerBonusPoints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProposerBonusPoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProposerBonusPoints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProposerBonusPoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProposerBonusPoints.Merge(m, src)
+}
+func (m *MsgSetProposerBonusPoints) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProposerBonusPoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProposerBonusPoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProposerBonusPoints proto.InternalMessageInfo
+
+func (m *MsgSetProposerBonusPoints) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetProposerBonusPoints) GetProposerBonusPoints() uint64 {
+	if m != nil {
+		return m.ProposerBonusPoints
+	}
+	return 0
+}
+
+type MsgSetProposerBonusPointsResponse struct {
+}
+
+func (m *MsgSetProposerBonusPointsResponse) Reset()         { *m = MsgSetProposerBonusPointsResponse{} }
+func (m *MsgSetProposerBonusPointsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProposerBonusPointsResponse) ProtoMessage()    {}
+func (*MsgSetProposerBonusPointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{9}
+}
+func (m *MsgSetProposerBonusPointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProposerBonusPointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProposerBonusPointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProposerBonusPointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProposerBonusPointsResponse.Merge(m, src)
+}
+func (m *MsgSetProposerBonusPointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProposerBonusPointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProposerBonusPointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProposerBonusPointsResponse proto.InternalMessageInfo
+
+type MsgSetSlashForfeitRate struct {
+	Signer           string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	SlashForfeitRate string `protobuf:"bytes,2,opt,name=slash_forfeit_rate,json=slashForfeitRate,proto3" json:"slash_forfeit_rate,omitempty"`
+}
+
+func (m *MsgSetSlashForfeitRate) Reset()         { *m = MsgSetSlashForfeitRate{} }
+func (m *MsgSetSlashForfeitRate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSlashForfeitRate) ProtoMessage()    {}
+func (*MsgSetSlashForfeitRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{10}
+}
+func (m *MsgSetSlashForfeitRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSlashForfeitRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSlashForfeitRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSlashForfeitRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSlashForfeitRate.Merge(m, src)
+}
+func (m *MsgSetSlashForfeitRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSlashForfeitRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSlashForfeitRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSlashForfeitRate proto.InternalMessageInfo
+
+func (m *MsgSetSlashForfeitRate) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetSlashForfeitRate) GetSlashForfeitRate() string {
+	if m != nil {
+		return m.SlashForfeitRate
+	}
+	return ""
+}
+
+type MsgSetSlashForfeitRateResponse struct {
+}
+
+func (m *MsgSetSlashForfeitRateResponse) Reset()         { *m = MsgSetSlashForfeitRateResponse{} }
+func (m *MsgSetSlashForfeitRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSlashForfeitRateResponse) ProtoMessage()    {}
+func (*MsgSetSlashForfeitRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{11}
+}
+func (m *MsgSetSlashForfeitRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSlashForfeitRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSlashForfeitRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSlashForfeitRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSlashForfeitRateResponse.Merge(m, src)
+}
+func (m *MsgSetSlashForfeitRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSlashForfeitRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSlashForfeitRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSlashForfeitRateResponse proto.InternalMessageInfo
+
+type MsgSetClaimWindowEpochs struct {
+	Signer            string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ClaimWindowEpochs uint64 `protobuf:"varint,2,opt,name=claim_window_epochs,json=claimWindowEpochs,proto3" json:"claim_window_epochs,omitempty"`
+}
+
+func (m *MsgSetClaimWindowEpochs) Reset()         { *m = MsgSetClaimWindowEpochs{} }
+func (m *MsgSetClaimWindowEpochs) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimWindowEpochs) ProtoMessage()    {}
+func (*MsgSetClaimWindowEpochs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{12}
+}
+func (m *MsgSetClaimWindowEpochs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimWindowEpochs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimWindowEpochs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClaimWindowEpochs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimWindowEpochs.Merge(m, src)
+}
+func (m *MsgSetClaimWindowEpochs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimWindowEpochs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimWindowEpochs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClaimWindowEpochs proto.InternalMessageInfo
+
+func (m *MsgSetClaimWindowEpochs) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetClaimWindowEpochs) GetClaimWindowEpochs() uint64 {
+	if m != nil {
+		return m.ClaimWindowEpochs
+	}
+	return 0
+}
+
+type MsgSetClaimWindowEpochsResponse struct {
+}
+
+func (m *MsgSetClaimWindowEpochsResponse) Reset()         { *m = MsgSetClaimWindowEpochsResponse{} }
+func (m *MsgSetClaimWindowEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimWindowEpochsResponse) ProtoMessage()    {}
+func (*MsgSetClaimWindowEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{13}
+}
+func (m *MsgSetClaimWindowEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimWindowEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimWindowEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClaimWindowEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimWindowEpochsResponse.Merge(m, src)
+}
+func (m *MsgSetClaimWindowEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimWindowEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimWindowEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClaimWindowEpochsResponse proto.InternalMessageInfo
+
 type MsgClaimRewards struct {
 	ValidatorOperator string `protobuf:"bytes,1,opt,name=validator_operator,json=validatorOperator,proto3" json:"validator_operator,omitempty"`
 	Epoch             uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -399,7 +659,7 @@ func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
 func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimRewards) ProtoMessage()    {}
 func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e686e8b3d8dc774, []int{8}
+	return fileDescriptor_8e686e8b3d8dc774, []int{14}
 }
 func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +716,7 @@ func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse
 func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimRewardsResponse) ProtoMessage()    {}
 func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e686e8b3d8dc774, []int{9}
+	return fileDescriptor_8e686e8b3d8dc774, []int{15}
 }
 func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,23 +745,30 @@ func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
 
-type MsgDepositRewardsPool struct {
-	Depositor string      `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
-	Amount    *types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+// MsgClaimRewardsRange claims the outstanding rewards of a validator operator
+// for every epoch between start_epoch and end_epoch (inclusive) that still
+// holds a balance, oldest first. An end_epoch of 0 leaves the range open. At
+// most max_epochs epochs are paid per message; 0 selects the module limit.
+type MsgClaimRewardsRange struct {
+	ValidatorOperator string `protobuf:"bytes,1,opt,name=validator_operator,json=validatorOperator,proto3" json:"validator_operator,omitempty"`
+	Requester         string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	StartEpoch        uint64 `protobuf:"varint,3,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch          uint64 `protobuf:"varint,4,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	MaxEpochs         uint32 `protobuf:"varint,5,opt,name=max_epochs,json=maxEpochs,proto3" json:"max_epochs,omitempty"`
 }
 
-func (m *MsgDepositRewardsPool) Reset()         { *m = MsgDepositRewardsPool{} }
-func (m *MsgDepositRewardsPool) String() string { return proto.CompactTextString(m) }
-func (*MsgDepositRewardsPool) ProtoMessage()    {}
-func (*MsgDepositRewardsPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e686e8b3d8dc774, []int{10}
+func (m *MsgClaimRewardsRange) Reset()         { *m = MsgClaimRewardsRange{} }
+func (m *MsgClaimRewardsRange) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsRange) ProtoMessage()    {}
+func (*MsgClaimRewardsRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{16}
 }
-func (m *MsgDepositRewardsPool) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimRewardsRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDepositRewardsPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimRewardsRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDepositRewardsPool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimRewardsRange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -511,47 +778,74 @@ func (m *MsgDepositRewardsPool) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgDepositRewardsPool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDepositRewardsPool.Merge(m, src)
+func (m *MsgClaimRewardsRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsRange.Merge(m, src)
 }
-func (m *MsgDepositRewardsPool) XXX_Size() int {
+func (m *MsgClaimRewardsRange) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDepositRewardsPool) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDepositRewardsPool.DiscardUnknown(m)
+func (m *MsgClaimRewardsRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsRange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDepositRewardsPool proto.InternalMessageInfo
+var xxx_messageInfo_MsgClaimRewardsRange proto.InternalMessageInfo
 
-func (m *MsgDepositRewardsPool) GetDepositor() string {
+func (m *MsgClaimRewardsRange) GetValidatorOperator() string {
 	if m != nil {
-		return m.Depositor
+		return m.ValidatorOperator
 	}
 	return ""
 }
 
-func (m *MsgDepositRewardsPool) GetAmount() *types.Coin {
+func (m *MsgClaimRewardsRange) GetRequester() string {
 	if m != nil {
-		return m.Amount
+		return m.Requester
 	}
-	return nil
+	return ""
 }
 
-type MsgDepositRewardsPoolResponse struct {
+func (m *MsgClaimRewardsRange) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
 }
 
-func (m *MsgDepositRewardsPoolResponse) Reset()         { *m = MsgDepositRewardsPoolResponse{} }
-func (m *MsgDepositRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDepositRewardsPoolResponse) ProtoMessage()    {}
-func (*MsgDepositRewardsPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e686e8b3d8dc774, []int{11}
+func (m *MsgClaimRewardsRange) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
 }
-func (m *MsgDepositRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgClaimRewardsRange) GetMaxEpochs() uint32 {
+	if m != nil {
+		return m.MaxEpochs
+	}
+	return 0
+}
+
+type MsgClaimRewardsRangeResponse struct {
+	// rewards are the amounts paid per epoch, oldest first.
+	Rewards []EpochReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total is the sum of rewards.
+	Total types.Coin `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+	// has_more is true when claimable epochs remain in the range.
+	HasMore bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (m *MsgClaimRewardsRangeResponse) Reset()         { *m = MsgClaimRewardsRangeResponse{} }
+func (m *MsgClaimRewardsRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsRangeResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{17}
+}
+func (m *MsgClaimRewardsRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDepositRewardsPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimRewardsRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDepositRewardsPoolResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimRewardsRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -561,975 +855,3872 @@ func (m *MsgDepositRewardsPoolResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgDepositRewardsPoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDepositRewardsPoolResponse.Merge(m, src)
+func (m *MsgClaimRewardsRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsRangeResponse.Merge(m, src)
 }
-func (m *MsgDepositRewardsPoolResponse) XXX_Size() int {
+func (m *MsgClaimRewardsRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDepositRewardsPoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDepositRewardsPoolResponse.DiscardUnknown(m)
+func (m *MsgClaimRewardsRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDepositRewardsPoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgClaimRewardsRangeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.valrewards.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.valrewards.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgSetBlocksInEpoch)(nil), "cosmos.evm.valrewards.v1.MsgSetBlocksInEpoch")
-	proto.RegisterType((*MsgSetBlocksInEpochResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetBlocksInEpochResponse")
-	proto.RegisterType((*MsgSetRewardsPerEpoch)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardsPerEpoch")
-	proto.RegisterType((*MsgSetRewardsPerEpochResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardsPerEpochResponse")
-	proto.RegisterType((*MsgSetRewardingPaused)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardingPaused")
-	proto.RegisterType((*MsgSetRewardingPausedResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardingPausedResponse")
-	proto.RegisterType((*MsgClaimRewards)(nil), "cosmos.evm.valrewards.v1.MsgClaimRewards")
-	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "cosmos.evm.valrewards.v1.MsgClaimRewardsResponse")
-	proto.RegisterType((*MsgDepositRewardsPool)(nil), "cosmos.evm.valrewards.v1.MsgDepositRewardsPool")
-	proto.RegisterType((*MsgDepositRewardsPoolResponse)(nil), "cosmos.evm.valrewards.v1.MsgDepositRewardsPoolResponse")
+func (m *MsgClaimRewardsRangeResponse) GetRewards() []EpochReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("cosmos/evm/valrewards/v1/tx.proto", fileDescriptor_8e686e8b3d8dc774) }
-
-var fileDescriptor_8e686e8b3d8dc774 = buildValRewardsTxFileDescriptorGZIP()
-
-func buildValRewardsTxFileDescriptorGZIP() []byte {
-	strp := func(s string) *string { return &s }
-	i32p := func(v int32) *int32 { return &v }
-	labelp := func(v descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto_Label { return &v }
-	typep := func(v descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto_Type { return &v }
-
-	fd := &descriptorpb.FileDescriptorProto{
-		Name:    strp("cosmos/evm/valrewards/v1/tx.proto"),
-		Package: strp("cosmos.evm.valrewards.v1"),
-		Dependency: []string{
-			"amino/amino.proto",
-			"cosmos/base/v1beta1/coin.proto",
-			"cosmos/evm/valrewards/v1/valrewards.proto",
-			"cosmos/msg/v1/msg.proto",
-			"cosmos_proto/cosmos.proto",
-		},
-		MessageType: []*descriptorpb.DescriptorProto{
-			{
-				Name: strp("MsgUpdateParams"),
-				Field: []*descriptorpb.FieldDescriptorProto{
-					{Name: strp("authority"), Number: i32p(1), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
-					{Name: strp("params"), Number: i32p(2), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE), TypeName: strp(".cosmos.evm.valrewards.v1.Params")},
-				},
-				Options: &descriptorpb.MessageOptions{},
-			},
-			{Name: strp("MsgUpdateParamsResponse")},
-			{
-				Name: strp("MsgSetBlocksInEpoch"),
-				Field: []*descriptorpb.FieldDescriptorProto{
-					{Name: strp("signer"), Number: i32p(1), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
-					{Name: strp("blocks_in_epoch"), Number: i32p(2), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_INT64)},
-				},
-				Options: &descriptorpb.MessageOptions{},
-			},
-			{Name: strp("MsgSetBlocksInEpochResponse")},
-			{
-				Name: strp("MsgSetRewardsPerEpoch"),
-				Field: []*descriptorpb.FieldDescriptorProto{
-					{Name: strp("signer"), Number: i32p(1), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
-					{Name: strp("rewards_per_epoch"), Number: i32p(2), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
-				},
-				Options: &descriptorpb.MessageOptions{},
-			},
-			{Name: strp("MsgSetRewardsPerEpochResponse")},
-			{
-				Name: strp("MsgSetRewardingPaused"),
-				Field: []*descriptorpb.FieldDescriptorProto{
-					{Name: strp("signer"), Number: i32p(1), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
-					{Name: strp("rewarding_paused"), Number: i32p(2), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_BOOL)},
-				},
-				Options: &descriptorpb.MessageOptions{},
-			},
-			{Name: strp("MsgSetRewardingPausedResponse")},
-			{
-				Name: strp("MsgClaimRewards"),
-				Field: []*descriptorpb.FieldDescriptorProto{
-					{Name: strp("validator_operator"), Number: i32p(1), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_STRING), Options: &descriptorpb.FieldOptions{}},
-					{Name: strp("epoch"), Number: i32p(2), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_UINT64)},
-					{Name: strp("requester"), Number: i32p(3), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_STRING), Options: &descriptorpb.FieldOptions{}},
-				},
-				Options: &descriptorpb.MessageOptions{},
-			},
-			{Name: strp("MsgClaimRewardsResponse")},
-			{
-				Name: strp("MsgDepositRewardsPool"),
-				Field: []*descriptorpb.FieldDescriptorProto{
-					{Name: strp("depositor"), Number: i32p(1), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_STRING), Options: &descriptorpb.FieldOptions{}},
-					{Name: strp("amount"), Number: i32p(2), Label: labelp(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), Type: typep(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE), TypeName: strp(".cosmos.base.v1beta1.Coin"), Options: &descriptorpb.FieldOptions{}},
-				},
-				Options: &descriptorpb.MessageOptions{},
-			},
-			{Name: strp("MsgDepositRewardsPoolResponse")},
-		},
-		Service: []*descriptorpb.ServiceDescriptorProto{
-			{
-				Name: strp("Msg"),
-				Method: []*descriptorpb.MethodDescriptorProto{
-					{Name: strp("UpdateParams"), InputType: strp(".cosmos.evm.valrewards.v1.MsgUpdateParams"), OutputType: strp(".cosmos.evm.valrewards.v1.MsgUpdateParamsResponse")},
-					{Name: strp("SetBlocksInEpoch"), InputType: strp(".cosmos.evm.valrewards.v1.MsgSetBlocksInEpoch"), OutputType: strp(".cosmos.evm.valrewards.v1.MsgSetBlocksInEpochResponse")},
-					{Name: strp("SetRewardsPerEpoch"), InputType: strp(".cosmos.evm.valrewards.v1.MsgSetRewardsPerEpoch"), OutputType: strp(".cosmos.evm.valrewards.v1.MsgSetRewardsPerEpochResponse")},
-					{Name: strp("SetRewardingPaused"), InputType: strp(".cosmos.evm.valrewards.v1.MsgSetRewardingPaused"), OutputType: strp(".cosmos.evm.valrewards.v1.MsgSetRewardingPausedResponse")},
-					{Name: strp("ClaimRewards"), InputType: strp(".cosmos.evm.valrewards.v1.MsgClaimRewards"), OutputType: strp(".cosmos.evm.valrewards.v1.MsgClaimRewardsResponse")},
-					{Name: strp("DepositRewardsPool"), InputType: strp(".cosmos.evm.valrewards.v1.MsgDepositRewardsPool"), OutputType: strp(".cosmos.evm.valrewards.v1.MsgDepositRewardsPoolResponse")},
-				},
-				Options: &descriptorpb.ServiceOptions{},
-			},
-		},
-		Options: &descriptorpb.FileOptions{GoPackage: strp("github.com/cosmos/evm/x/valrewards/types")},
-		Syntax:  strp("proto3"),
-	}
-
-	proto2.SetExtension(fd.Service[0].Options, msgv1.E_Service, true)
-	proto2.SetExtension(fd.MessageType[0].Options, msgv1.E_Signer, []string{"authority"})
-	proto2.SetExtension(fd.MessageType[0].Options, apiamino.E_Name, "cosmos/evm/x/valrewards/MsgUpdateParams")
-	proto2.SetExtension(fd.MessageType[2].Options, msgv1.E_Signer, []string{"signer"})
-	proto2.SetExtension(fd.MessageType[2].Options, apiamino.E_Name, "cosmos/evm/x/valrewards/MsgSetBlocksInEpoch")
-	proto2.SetExtension(fd.MessageType[4].Options, msgv1.E_Signer, []string{"signer"})
-	proto2.SetExtension(fd.MessageType[4].Options, apiamino.E_Name, "cosmos/evm/x/valrewards/MsgSetRewardsPerEpoch")
-	proto2.SetExtension(fd.MessageType[6].Options, msgv1.E_Signer, []string{"signer"})
-	proto2.SetExtension(fd.MessageType[6].Options, apiamino.E_Name, "cosmos/evm/x/valrewards/MsgSetRewardingPaused")
-	proto2.SetExtension(fd.MessageType[8].Options, msgv1.E_Signer, []string{"requester"})
-	proto2.SetExtension(fd.MessageType[8].Options, apiamino.E_Name, "cosmos/evm/x/valrewards/MsgClaimRewards")
-	proto2.SetExtension(fd.MessageType[8].Field[0].Options, cosmosproto.E_Scalar, "cosmos.AddressString")
-	proto2.SetExtension(fd.MessageType[8].Field[2].Options, cosmosproto.E_Scalar, "cosmos.AddressString")
-	proto2.SetExtension(fd.MessageType[10].Options, msgv1.E_Signer, []string{"depositor"})
-	proto2.SetExtension(fd.MessageType[10].Options, apiamino.E_Name, "cosmos/evm/x/valrewards/MsgDepositRewardsPool")
-	proto2.SetExtension(fd.MessageType[10].Field[0].Options, cosmosproto.E_Scalar, "cosmos.AddressString")
-	proto2.SetExtension(fd.MessageType[10].Field[1].Options, apiamino.E_DontOmitempty, true)
-
-	raw, err := proto2.Marshal(fd)
-	if err != nil {
-		panic(err)
+func (m *MsgClaimRewardsRangeResponse) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
 	}
+	return types.Coin{}
+}
 
-	var buf bytes.Buffer
-	zw := gzip.NewWriter(&buf)
-	if _, err := zw.Write(raw); err != nil {
-		panic(err)
-	}
-	if err := zw.Close(); err != nil {
-		panic(err)
+func (m *MsgClaimRewardsRangeResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
 	}
+	return false
+}
 
-	return buf.Bytes()
+// MsgSetRewardsCommission opts a validator into passing its valrewards through
+// to its delegators. The operator keeps commission_rate of every epoch reward
+// and the rest is split pro-rata to delegation shares. A commission_rate of 1
+// opts the validator back out. The new rate applies from the next epoch.
+type MsgSetRewardsCommission struct {
+	ValidatorOperator string                      `protobuf:"bytes,1,opt,name=validator_operator,json=validatorOperator,proto3" json:"validator_operator,omitempty"`
+	CommissionRate    cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSetRewardsCommission) Reset()         { *m = MsgSetRewardsCommission{} }
+func (m *MsgSetRewardsCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsCommission) ProtoMessage()    {}
+func (*MsgSetRewardsCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{18}
+}
+func (m *MsgSetRewardsCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardsCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsCommission.Merge(m, src)
+}
+func (m *MsgSetRewardsCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsCommission.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetRewardsCommission proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	SetBlocksInEpoch(ctx context.Context, in *MsgSetBlocksInEpoch, opts ...grpc.CallOption) (*MsgSetBlocksInEpochResponse, error)
-	SetRewardsPerEpoch(ctx context.Context, in *MsgSetRewardsPerEpoch, opts ...grpc.CallOption) (*MsgSetRewardsPerEpochResponse, error)
-	SetRewardingPaused(ctx context.Context, in *MsgSetRewardingPaused, opts ...grpc.CallOption) (*MsgSetRewardingPausedResponse, error)
-	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
-	DepositRewardsPool(ctx context.Context, in *MsgDepositRewardsPool, opts ...grpc.CallOption) (*MsgDepositRewardsPoolResponse, error)
+func (m *MsgSetRewardsCommission) GetValidatorOperator() string {
+	if m != nil {
+		return m.ValidatorOperator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+type MsgSetRewardsCommissionResponse struct {
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetRewardsCommissionResponse) Reset()         { *m = MsgSetRewardsCommissionResponse{} }
+func (m *MsgSetRewardsCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsCommissionResponse) ProtoMessage()    {}
+func (*MsgSetRewardsCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{19}
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRewardsCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetRewardsCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsCommissionResponse.Merge(m, src)
+}
+func (m *MsgSetRewardsCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsCommissionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetBlocksInEpoch(ctx context.Context, in *MsgSetBlocksInEpoch, opts ...grpc.CallOption) (*MsgSetBlocksInEpochResponse, error) {
-	out := new(MsgSetBlocksInEpochResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetBlocksInEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetRewardsCommissionResponse proto.InternalMessageInfo
+
+// MsgClaimDelegatorRewards claims the valrewards a delegator earned through
+// every validator for the epochs between start_epoch and end_epoch (inclusive),
+// oldest first. An end_epoch of 0 leaves the range open. At most max_epochs
+// epochs are paid per message; 0 selects the module limit.
+type MsgClaimDelegatorRewards struct {
+	Delegator  string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Requester  string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	StartEpoch uint64 `protobuf:"varint,3,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch   uint64 `protobuf:"varint,4,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	MaxEpochs  uint32 `protobuf:"varint,5,opt,name=max_epochs,json=maxEpochs,proto3" json:"max_epochs,omitempty"`
+}
+
+func (m *MsgClaimDelegatorRewards) Reset()         { *m = MsgClaimDelegatorRewards{} }
+func (m *MsgClaimDelegatorRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDelegatorRewards) ProtoMessage()    {}
+func (*MsgClaimDelegatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{20}
+}
+func (m *MsgClaimDelegatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDelegatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDelegatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgClaimDelegatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDelegatorRewards.Merge(m, src)
+}
+func (m *MsgClaimDelegatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDelegatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDelegatorRewards.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetRewardsPerEpoch(ctx context.Context, in *MsgSetRewardsPerEpoch, opts ...grpc.CallOption) (*MsgSetRewardsPerEpochResponse, error) {
-	out := new(MsgSetRewardsPerEpochResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetRewardsPerEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimDelegatorRewards proto.InternalMessageInfo
+
+func (m *MsgClaimDelegatorRewards) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetRewardingPaused(ctx context.Context, in *MsgSetRewardingPaused, opts ...grpc.CallOption) (*MsgSetRewardingPausedResponse, error) {
-	out := new(MsgSetRewardingPausedResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetRewardingPaused", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimDelegatorRewards) GetRequester() string {
+	if m != nil {
+		return m.Requester
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
-	out := new(MsgClaimRewardsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/ClaimRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimDelegatorRewards) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DepositRewardsPool(ctx context.Context, in *MsgDepositRewardsPool, opts ...grpc.CallOption) (*MsgDepositRewardsPoolResponse, error) {
-	out := new(MsgDepositRewardsPoolResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/DepositRewardsPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimDelegatorRewards) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	SetBlocksInEpoch(context.Context, *MsgSetBlocksInEpoch) (*MsgSetBlocksInEpochResponse, error)
-	SetRewardsPerEpoch(context.Context, *MsgSetRewardsPerEpoch) (*MsgSetRewardsPerEpochResponse, error)
-	SetRewardingPaused(context.Context, *MsgSetRewardingPaused) (*MsgSetRewardingPausedResponse, error)
-	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
-	DepositRewardsPool(context.Context, *MsgDepositRewardsPool) (*MsgDepositRewardsPoolResponse, error)
+func (m *MsgClaimDelegatorRewards) GetMaxEpochs() uint32 {
+	if m != nil {
+		return m.MaxEpochs
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgClaimDelegatorRewardsResponse struct {
+	// rewards are the amounts paid per epoch and validator, oldest first.
+	Rewards []DelegatorEpochReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total is the sum of rewards.
+	Total types.Coin `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+	// has_more is true when claimable epochs remain in the range.
+	HasMore bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgClaimDelegatorRewardsResponse) Reset()         { *m = MsgClaimDelegatorRewardsResponse{} }
+func (m *MsgClaimDelegatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDelegatorRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimDelegatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{21}
 }
-func (*UnimplementedMsgServer) SetBlocksInEpoch(ctx context.Context, req *MsgSetBlocksInEpoch) (*MsgSetBlocksInEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBlocksInEpoch not implemented")
+func (m *MsgClaimDelegatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetRewardsPerEpoch(ctx context.Context, req *MsgSetRewardsPerEpoch) (*MsgSetRewardsPerEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRewardsPerEpoch not implemented")
+func (m *MsgClaimDelegatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDelegatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SetRewardingPaused(ctx context.Context, req *MsgSetRewardingPaused) (*MsgSetRewardingPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRewardingPaused not implemented")
+func (m *MsgClaimDelegatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDelegatorRewardsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+func (m *MsgClaimDelegatorRewardsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DepositRewardsPool(ctx context.Context, req *MsgDepositRewardsPool) (*MsgDepositRewardsPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositRewardsPool not implemented")
+func (m *MsgClaimDelegatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDelegatorRewardsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgClaimDelegatorRewardsResponse proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgClaimDelegatorRewardsResponse) GetRewards() []DelegatorEpochReward {
+	if m != nil {
+		return m.Rewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SetBlocksInEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBlocksInEpoch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBlocksInEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetBlocksInEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBlocksInEpoch(ctx, req.(*MsgSetBlocksInEpoch))
+func (m *MsgClaimDelegatorRewardsResponse) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_SetRewardsPerEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetRewardsPerEpoch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetRewardsPerEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetRewardsPerEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetRewardsPerEpoch(ctx, req.(*MsgSetRewardsPerEpoch))
+func (m *MsgClaimDelegatorRewardsResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_SetRewardingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetRewardingPaused)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetRewardingPaused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetRewardingPaused",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetRewardingPaused(ctx, req.(*MsgSetRewardingPaused))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgDepositRewardsPool struct {
+	Depositor string      `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    *types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Msg/ClaimRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgDepositRewardsPool) Reset()         { *m = MsgDepositRewardsPool{} }
+func (m *MsgDepositRewardsPool) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositRewardsPool) ProtoMessage()    {}
+func (*MsgDepositRewardsPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{22}
 }
-
-func _Msg_DepositRewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositRewardsPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositRewardsPool(ctx, in)
+func (m *MsgDepositRewardsPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositRewardsPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositRewardsPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.evm.valrewards.v1.Msg/DepositRewardsPool",
+}
+func (m *MsgDepositRewardsPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositRewardsPool.Merge(m, src)
+}
+func (m *MsgDepositRewardsPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositRewardsPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositRewardsPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositRewardsPool proto.InternalMessageInfo
+
+func (m *MsgDepositRewardsPool) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositRewardsPool(ctx, req.(*MsgDepositRewardsPool))
+	return ""
+}
+
+func (m *MsgDepositRewardsPool) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.evm.valrewards.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "SetBlocksInEpoch",
-			Handler:    _Msg_SetBlocksInEpoch_Handler,
-		},
-		{
-			MethodName: "SetRewardsPerEpoch",
-			Handler:    _Msg_SetRewardsPerEpoch_Handler,
-		},
-		{
-			MethodName: "SetRewardingPaused",
-			Handler:    _Msg_SetRewardingPaused_Handler,
-		},
-		{
-			MethodName: "ClaimRewards",
-			Handler:    _Msg_ClaimRewards_Handler,
-		},
-		{
-			MethodName: "DepositRewardsPool",
-			Handler:    _Msg_DepositRewardsPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/evm/valrewards/v1/tx.proto",
+type MsgDepositRewardsPoolResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositRewardsPoolResponse) Reset()         { *m = MsgDepositRewardsPoolResponse{} }
+func (m *MsgDepositRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositRewardsPoolResponse) ProtoMessage()    {}
+func (*MsgDepositRewardsPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{23}
+}
+func (m *MsgDepositRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositRewardsPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositRewardsPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDepositRewardsPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositRewardsPoolResponse.Merge(m, src)
+}
+func (m *MsgDepositRewardsPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositRewardsPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositRewardsPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDepositRewardsPoolResponse proto.InternalMessageInfo
+
+// MsgSetWithdrawAddress sets the account that receives the claimed valrewards
+// of a validator. Setting it to the operator account restores the default.
+type MsgSetWithdrawAddress struct {
+	ValidatorOperator string `protobuf:"bytes,1,opt,name=validator_operator,json=validatorOperator,proto3" json:"validator_operator,omitempty"`
+	WithdrawAddress   string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgSetWithdrawAddress) Reset()         { *m = MsgSetWithdrawAddress{} }
+func (m *MsgSetWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{24}
+}
+func (m *MsgSetWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddress.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetWithdrawAddress proto.InternalMessageInfo
+
+func (m *MsgSetWithdrawAddress) GetValidatorOperator() string {
+	if m != nil {
+		return m.ValidatorOperator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetWithdrawAddressResponse struct {
 }
 
-func (m *MsgSetBlocksInEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetWithdrawAddressResponse) Reset()         { *m = MsgSetWithdrawAddressResponse{} }
+func (m *MsgSetWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{25}
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetBlocksInEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgSetAutoCompound turns auto-compounding on or off for a validator. While
+// it is on, the operator share of every epoch reward is delegated to the
+// validator from the operator account at the epoch rollover instead of being
+// left to claim.
+type MsgSetAutoCompound struct {
+	ValidatorOperator string `protobuf:"bytes,1,opt,name=validator_operator,json=validatorOperator,proto3" json:"validator_operator,omitempty"`
+	Enabled           bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgSetBlocksInEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlocksInEpoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlocksInEpoch))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{26}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetAutoCompound) GetValidatorOperator() string {
+	if m != nil {
+		return m.ValidatorOperator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetBlocksInEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgSetBlocksInEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetAutoCompoundResponse struct {
 }
 
-func (m *MsgSetBlocksInEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e686e8b3d8dc774, []int{27}
 }
-
-func (m *MsgSetRewardsPerEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetRewardsPerEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.valrewards.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.valrewards.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetBlocksInEpoch)(nil), "cosmos.evm.valrewards.v1.MsgSetBlocksInEpoch")
+	proto.RegisterType((*MsgSetBlocksInEpochResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetBlocksInEpochResponse")
+	proto.RegisterType((*MsgSetRewardsPerEpoch)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardsPerEpoch")
+	proto.RegisterType((*MsgSetRewardsPerEpochResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardsPerEpochResponse")
+	proto.RegisterType((*MsgSetRewardingPaused)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardingPaused")
+	proto.RegisterType((*MsgSetRewardingPausedResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardingPausedResponse")
+	proto.RegisterType((*MsgSetProposerBonusPoints)(nil), "cosmos.evm.valrewards.v1.MsgSetProposerBonusPoints")
+	proto.RegisterType((*MsgSetProposerBonusPointsResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetProposerBonusPointsResponse")
+	proto.RegisterType((*MsgSetSlashForfeitRate)(nil), "cosmos.evm.valrewards.v1.MsgSetSlashForfeitRate")
+	proto.RegisterType((*MsgSetSlashForfeitRateResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetSlashForfeitRateResponse")
+	proto.RegisterType((*MsgSetClaimWindowEpochs)(nil), "cosmos.evm.valrewards.v1.MsgSetClaimWindowEpochs")
+	proto.RegisterType((*MsgSetClaimWindowEpochsResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetClaimWindowEpochsResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "cosmos.evm.valrewards.v1.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "cosmos.evm.valrewards.v1.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgClaimRewardsRange)(nil), "cosmos.evm.valrewards.v1.MsgClaimRewardsRange")
+	proto.RegisterType((*MsgClaimRewardsRangeResponse)(nil), "cosmos.evm.valrewards.v1.MsgClaimRewardsRangeResponse")
+	proto.RegisterType((*MsgSetRewardsCommission)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardsCommission")
+	proto.RegisterType((*MsgSetRewardsCommissionResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetRewardsCommissionResponse")
+	proto.RegisterType((*MsgClaimDelegatorRewards)(nil), "cosmos.evm.valrewards.v1.MsgClaimDelegatorRewards")
+	proto.RegisterType((*MsgClaimDelegatorRewardsResponse)(nil), "cosmos.evm.valrewards.v1.MsgClaimDelegatorRewardsResponse")
+	proto.RegisterType((*MsgDepositRewardsPool)(nil), "cosmos.evm.valrewards.v1.MsgDepositRewardsPool")
+	proto.RegisterType((*MsgDepositRewardsPoolResponse)(nil), "cosmos.evm.valrewards.v1.MsgDepositRewardsPoolResponse")
+	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.evm.valrewards.v1.MsgSetWithdrawAddress")
+	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetWithdrawAddressResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.evm.valrewards.v1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.evm.valrewards.v1.MsgSetAutoCompoundResponse")
 }
 
-func (m *MsgSetRewardsPerEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardsPerEpoch) > 0 {
-		i -= len(m.RewardsPerEpoch)
-		copy(dAtA[i:], m.RewardsPerEpoch)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardsPerEpoch)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("cosmos/evm/valrewards/v1/tx.proto", fileDescriptor_8e686e8b3d8dc774) }
+
+var fileDescriptor_8e686e8b3d8dc774 = []byte{
+	// 1452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0xfd, 0x27, 0xb1, 0x26, 0xc9, 0xb3, 0x4d, 0x3b, 0x89, 0xcc, 0x24, 0xb2, 0xa2, 0x87,
+	0xf7, 0x9e, 0xe3, 0x17, 0x53, 0x91, 0xd3, 0xda, 0x89, 0x82, 0xfe, 0x89, 0xec, 0xa4, 0x08, 0x5a,
+	0xb7, 0x86, 0x8c, 0x22, 0x40, 0x2f, 0xc4, 0x4a, 0xda, 0x50, 0x44, 0x44, 0x2e, 0xcb, 0xa5, 0x64,
+	0x07, 0x05, 0x8a, 0xb6, 0x28, 0x50, 0x20, 0xa7, 0x7c, 0x8c, 0x1e, 0x7a, 0x08, 0xd0, 0x14, 0xe8,
+	0xa1, 0x40, 0x2e, 0x3d, 0xa4, 0xe8, 0x25, 0xc8, 0xa9, 0xe8, 0x21, 0x28, 0x92, 0x02, 0xb9, 0xf6,
+	0x1b, 0xb4, 0x20, 0x77, 0xb9, 0x92, 0x57, 0x94, 0x28, 0x09, 0x46, 0xd1, 0x8b, 0x21, 0xee, 0xcc,
+	0xfc, 0x66, 0x7e, 0xb3, 0x3b, 0xb3, 0xb3, 0x86, 0xf3, 0x55, 0x42, 0x6d, 0x42, 0xf3, 0xb8, 0x65,
+	0xe7, 0x5b, 0xa8, 0xe1, 0xe1, 0x3d, 0xe4, 0xd5, 0x68, 0xbe, 0x55, 0xc8, 0xfb, 0xfb, 0xba, 0xeb,
+	0x11, 0x9f, 0xa8, 0x69, 0xa6, 0xa2, 0xe3, 0x96, 0xad, 0xb7, 0x55, 0xf4, 0x56, 0x41, 0x9b, 0x43,
+	0xb6, 0xe5, 0x90, 0x7c, 0xf8, 0x97, 0x29, 0x6b, 0x19, 0x8e, 0x57, 0x41, 0x14, 0xe7, 0x5b, 0x85,
+	0x0a, 0xf6, 0x51, 0x21, 0x5f, 0x25, 0x96, 0xc3, 0xe5, 0x17, 0x7a, 0xfa, 0xeb, 0x80, 0x66, 0xaa,
+	0xa7, 0xb9, 0xaa, 0x4d, 0xcd, 0x40, 0x6e, 0x53, 0x93, 0x0b, 0x16, 0x99, 0xc0, 0x08, 0xbf, 0xf2,
+	0x3c, 0x3a, 0x26, 0x5a, 0x30, 0x89, 0x49, 0xd8, 0x7a, 0xf0, 0x8b, 0xad, 0xe6, 0x1e, 0x2b, 0x30,
+	0xb3, 0x4d, 0xcd, 0x0f, 0xdd, 0x1a, 0xf2, 0xf1, 0x0e, 0xf2, 0x90, 0x4d, 0xd5, 0x75, 0x48, 0xa1,
+	0xa6, 0x5f, 0x27, 0x9e, 0xe5, 0xdf, 0x4b, 0x2b, 0x59, 0x65, 0x39, 0x55, 0x4a, 0x3f, 0x7b, 0xb4,
+	0xba, 0xc0, 0xe1, 0xae, 0xd7, 0x6a, 0x1e, 0xa6, 0x74, 0xd7, 0xf7, 0x2c, 0xc7, 0x2c, 0xb7, 0x55,
+	0xd5, 0x2b, 0x70, 0xc4, 0x0d, 0x11, 0xd2, 0xe3, 0x59, 0x65, 0xf9, 0xd8, 0x5a, 0x56, 0xef, 0x95,
+	0x1e, 0x9d, 0x79, 0x2a, 0x73, 0xfd, 0x62, 0xf1, 0x8b, 0x57, 0x0f, 0x57, 0xda, 0x48, 0xf7, 0x5f,
+	0x3d, 0x5c, 0xf9, 0x5f, 0x47, 0x36, 0xf6, 0x3b, 0xf3, 0x21, 0x45, 0x9b, 0x5b, 0x84, 0xd3, 0xd2,
+	0x52, 0x19, 0x53, 0x97, 0x38, 0x14, 0xe7, 0xbe, 0x51, 0x60, 0x7e, 0x9b, 0x9a, 0xbb, 0xd8, 0x2f,
+	0x35, 0x48, 0xf5, 0x2e, 0xbd, 0xe5, 0xdc, 0x70, 0x49, 0xb5, 0xae, 0x5e, 0x82, 0x23, 0xd4, 0x32,
+	0x1d, 0xec, 0x25, 0xb2, 0xe3, 0x7a, 0xea, 0x7f, 0x61, 0xa6, 0x12, 0x42, 0x18, 0x96, 0x63, 0xe0,
+	0x00, 0x24, 0xe4, 0x38, 0x51, 0x3e, 0x51, 0xe9, 0x44, 0x2e, 0x5e, 0x0b, 0x88, 0x70, 0xa3, 0x80,
+	0xc5, 0xff, 0xfb, 0xb0, 0x90, 0xc3, 0xca, 0x9d, 0x83, 0x33, 0x31, 0xcb, 0x82, 0xcd, 0x23, 0x05,
+	0x4e, 0x32, 0x79, 0x99, 0x81, 0xec, 0x60, 0x6f, 0x54, 0x3e, 0x2b, 0x30, 0xc7, 0x23, 0x31, 0x5c,
+	0xec, 0x75, 0x30, 0x4a, 0x95, 0x67, 0xbc, 0x83, 0xe8, 0xc5, 0x37, 0x24, 0x4e, 0xab, 0xfd, 0x39,
+	0x49, 0xc1, 0xe5, 0x96, 0xe0, 0x5c, 0xac, 0x40, 0xf0, 0xfa, 0x56, 0xe2, 0x65, 0x39, 0xe6, 0x0e,
+	0x6a, 0x52, 0x5c, 0x1b, 0x81, 0xd7, 0x05, 0x98, 0xf5, 0x22, 0x10, 0xc3, 0x0d, 0x51, 0x42, 0x5a,
+	0xd3, 0x11, 0x2d, 0x01, 0x3e, 0x1a, 0x2d, 0x61, 0x2e, 0xd3, 0x12, 0x02, 0x41, 0xeb, 0x47, 0x05,
+	0x16, 0x99, 0xc6, 0x8e, 0x47, 0x5c, 0x42, 0xb1, 0x57, 0x22, 0x4e, 0x93, 0xee, 0x10, 0xcb, 0xf1,
+	0xe9, 0x08, 0xd4, 0xd6, 0xe0, 0xa4, 0xcb, 0x81, 0x8c, 0x4a, 0x80, 0x64, 0xb8, 0x21, 0x54, 0xc8,
+	0x6f, 0xb2, 0x3c, 0xef, 0x76, 0x7b, 0x29, 0x5e, 0x97, 0x38, 0x16, 0xfa, 0x73, 0x8c, 0x09, 0x34,
+	0xf7, 0x6f, 0x38, 0xdf, 0x53, 0x28, 0xb8, 0x7e, 0xaf, 0xc0, 0x29, 0xa6, 0xb5, 0xdb, 0x40, 0xb4,
+	0x7e, 0x93, 0x78, 0x77, 0xb0, 0xe5, 0x97, 0x91, 0x8f, 0x47, 0x20, 0x7a, 0x11, 0x54, 0x1a, 0xa0,
+	0x18, 0x77, 0x18, 0x8c, 0xe1, 0x21, 0x1f, 0xf3, 0xc3, 0x39, 0x4b, 0x25, 0xfc, 0xe2, 0x9b, 0x12,
+	0x45, 0xbd, 0x3f, 0x45, 0x39, 0xbe, 0x5c, 0x16, 0x32, 0xf1, 0x12, 0x41, 0xee, 0x07, 0x25, 0xec,
+	0x30, 0xbb, 0xd8, 0xdf, 0x6c, 0x20, 0xcb, 0xbe, 0x6d, 0x39, 0x35, 0xb2, 0x17, 0x1e, 0xe1, 0x51,
+	0xb6, 0x51, 0x87, 0xf9, 0x6a, 0x00, 0x63, 0xec, 0x85, 0x38, 0xac, 0xf4, 0xa2, 0x4d, 0x9c, 0xab,
+	0xca, 0x1e, 0x8a, 0x6f, 0x49, 0xfc, 0xf2, 0xfd, 0xf9, 0x75, 0x85, 0x98, 0x3b, 0x0f, 0x4b, 0x3d,
+	0x44, 0x82, 0xe1, 0xef, 0xec, 0x12, 0x08, 0x15, 0x78, 0x95, 0xaa, 0xef, 0x80, 0xda, 0x42, 0x0d,
+	0xab, 0x86, 0x7c, 0xe2, 0x19, 0xc4, 0xc5, 0x5e, 0xf0, 0x23, 0x91, 0xe5, 0x9c, 0xb0, 0xf9, 0x80,
+	0x9b, 0xa8, 0x0b, 0x30, 0xd5, 0x6e, 0x2f, 0x93, 0x65, 0xf6, 0x11, 0xdc, 0x31, 0x1e, 0xfe, 0xb8,
+	0x89, 0xa9, 0x8f, 0xbd, 0xf4, 0x44, 0xd2, 0x1d, 0x23, 0x54, 0xf9, 0x4d, 0x21, 0xbe, 0x93, 0x6e,
+	0x8a, 0x4e, 0x4a, 0xfc, 0xa6, 0xe8, 0x5c, 0x12, 0x19, 0xf8, 0x6e, 0x1c, 0x16, 0x64, 0x19, 0x72,
+	0x4c, 0x7c, 0x78, 0x69, 0x38, 0x40, 0x78, 0x7c, 0x60, 0xc2, 0xea, 0x12, 0x1c, 0xa3, 0x3e, 0xf2,
+	0x7c, 0xde, 0xa3, 0x27, 0xc2, 0x24, 0x42, 0xb8, 0xc4, 0x9a, 0xff, 0x19, 0x48, 0x61, 0xa7, 0xc6,
+	0xc5, 0x93, 0xa1, 0x78, 0x1a, 0x3b, 0x35, 0x26, 0x3c, 0x07, 0x60, 0xa3, 0xfd, 0xe8, 0x90, 0x4d,
+	0x65, 0x95, 0xe5, 0x13, 0xe5, 0x94, 0x8d, 0xf6, 0x3b, 0x0f, 0xd7, 0xc1, 0x6c, 0x5e, 0x1c, 0x30,
+	0x9b, 0x61, 0x7a, 0x82, 0xda, 0x38, 0x1b, 0x27, 0x88, 0x12, 0xab, 0xde, 0x80, 0xa3, 0x1c, 0x20,
+	0xad, 0x64, 0x27, 0x96, 0x8f, 0xad, 0xfd, 0xa7, 0xf7, 0x50, 0xc0, 0xaf, 0x85, 0xe0, 0xbb, 0x34,
+	0xf9, 0xe4, 0xf9, 0xd2, 0x58, 0x39, 0xb2, 0x55, 0x8b, 0x30, 0xe5, 0x13, 0x1f, 0x35, 0xf8, 0x64,
+	0xb1, 0x18, 0x81, 0x04, 0xb3, 0x94, 0xce, 0x67, 0x29, 0x7d, 0x93, 0x58, 0x4e, 0x29, 0x15, 0x18,
+	0x7e, 0xfd, 0xea, 0xe1, 0x8a, 0x52, 0x66, 0x26, 0xea, 0x22, 0x4c, 0xd7, 0x11, 0x35, 0x6c, 0xe2,
+	0xe1, 0x30, 0x7d, 0xd3, 0xe5, 0xa3, 0x75, 0x44, 0xb7, 0x89, 0x87, 0x73, 0x0f, 0xc6, 0xa3, 0xd2,
+	0xe6, 0xc1, 0x6f, 0x12, 0xdb, 0xb6, 0x28, 0xb5, 0x88, 0x73, 0x78, 0x3b, 0x6f, 0xc0, 0x4c, 0x55,
+	0xc0, 0x76, 0x34, 0xb3, 0xd2, 0x7a, 0x10, 0xea, 0xaf, 0xcf, 0x97, 0xce, 0x30, 0x24, 0x5a, 0xbb,
+	0xab, 0x5b, 0x24, 0x6f, 0x23, 0xbf, 0xae, 0xbf, 0x87, 0x4d, 0x54, 0xbd, 0xb7, 0x85, 0xab, 0xcf,
+	0x1e, 0xad, 0x02, 0x77, 0xb4, 0x85, 0xab, 0x8c, 0xd7, 0xbf, 0xda, 0x70, 0x61, 0x0b, 0x7c, 0x37,
+	0xd8, 0xc5, 0x98, 0x60, 0x07, 0x68, 0x17, 0x5d, 0xb4, 0xdb, 0xed, 0xa2, 0x4b, 0xd4, 0x1e, 0xab,
+	0xc6, 0x21, 0x1d, 0x6d, 0xfa, 0x16, 0x6e, 0x60, 0x33, 0x70, 0x16, 0xf5, 0x8d, 0x75, 0x48, 0xd5,
+	0xa2, 0xb5, 0xe4, 0xe1, 0x51, 0xa8, 0xfe, 0x33, 0xeb, 0x63, 0xb3, 0xbb, 0x3e, 0x2e, 0x25, 0xd5,
+	0x87, 0x9c, 0x91, 0xdc, 0x4f, 0x0a, 0x64, 0x7b, 0x09, 0x45, 0x9d, 0xbc, 0x2f, 0xd7, 0x89, 0xde,
+	0xbb, 0x4e, 0x04, 0xc8, 0xdf, 0x5f, 0x30, 0x3f, 0xb3, 0x59, 0x6d, 0x0b, 0xbb, 0x84, 0x5a, 0x62,
+	0xa2, 0x23, 0xa4, 0xc1, 0xf6, 0x3d, 0x5c, 0x1d, 0x6c, 0xdf, 0xb9, 0x6a, 0xf0, 0x68, 0x40, 0x36,
+	0x69, 0x3a, 0x7e, 0x72, 0xa4, 0x53, 0x2c, 0x4a, 0xae, 0x5f, 0x7c, 0x3b, 0xdc, 0x1c, 0x81, 0x94,
+	0x34, 0xc3, 0x75, 0xc7, 0xcc, 0x67, 0xb8, 0x6e, 0x81, 0x38, 0xe9, 0x7f, 0x88, 0xd1, 0xf4, 0xb6,
+	0xe5, 0xd7, 0x6b, 0x1e, 0xda, 0xe3, 0x3c, 0x0e, 0xaf, 0x3b, 0x6c, 0xc2, 0xec, 0x1e, 0xc7, 0x36,
+	0x10, 0x53, 0x4e, 0x3c, 0xfe, 0x33, 0x7b, 0x07, 0xa3, 0x29, 0xde, 0xea, 0xd3, 0x01, 0x12, 0xe6,
+	0x5a, 0x89, 0x58, 0x7b, 0xae, 0x95, 0x04, 0x22, 0x27, 0x8f, 0x15, 0x50, 0x99, 0xc6, 0xf5, 0xa6,
+	0x4f, 0x36, 0x89, 0xed, 0x92, 0xa6, 0x53, 0x3b, 0xbc, 0x84, 0xa4, 0xe1, 0x28, 0x76, 0x50, 0xa5,
+	0x21, 0x26, 0xf7, 0xe8, 0xb3, 0x78, 0xb3, 0x0f, 0xcb, 0x95, 0xfe, 0x2c, 0x3b, 0x43, 0xcd, 0x9d,
+	0x05, 0xad, 0x7b, 0x35, 0xe2, 0xb7, 0xf6, 0xe7, 0x09, 0x98, 0xd8, 0xa6, 0xa6, 0xda, 0x80, 0xe3,
+	0x07, 0x5e, 0xc5, 0x17, 0x7a, 0x17, 0xa4, 0xf4, 0xfe, 0xd4, 0x0a, 0x03, 0xab, 0x8a, 0xfa, 0xdf,
+	0x87, 0xd9, 0xae, 0x67, 0xea, 0x6a, 0x5f, 0x18, 0x59, 0x5d, 0x7b, 0x7d, 0x28, 0x75, 0xe1, 0xf9,
+	0x53, 0x50, 0x63, 0x9e, 0x94, 0xf9, 0x24, 0x30, 0xc9, 0x40, 0xdb, 0x18, 0xd2, 0x20, 0xd6, 0x7f,
+	0xfb, 0xe9, 0x37, 0xa0, 0x7f, 0x61, 0xa0, 0x6d, 0x0c, 0x69, 0x20, 0xfc, 0xdf, 0x57, 0xe0, 0x54,
+	0x8f, 0x47, 0xda, 0xe5, 0x24, 0xcc, 0x18, 0x23, 0xed, 0xda, 0x08, 0x46, 0x22, 0x98, 0xcf, 0x15,
+	0x98, 0x8f, 0x7d, 0x45, 0x25, 0x81, 0xca, 0x16, 0xda, 0x95, 0x61, 0x2d, 0x44, 0x0c, 0x5f, 0x2a,
+	0xb0, 0x10, 0xfb, 0xd8, 0x29, 0x24, 0x41, 0x76, 0x99, 0x68, 0x57, 0x87, 0x36, 0x11, 0x61, 0x34,
+	0xe0, 0xf8, 0x81, 0x07, 0x49, 0xff, 0xfa, 0xeb, 0x54, 0xd5, 0x0a, 0x03, 0xab, 0x0a, 0x6f, 0x9f,
+	0xc0, 0x5c, 0xf7, 0xf0, 0xaf, 0x0f, 0x8e, 0x13, 0xe8, 0x6b, 0xeb, 0xc3, 0xe9, 0xcb, 0x19, 0xef,
+	0x9e, 0x41, 0x0b, 0x03, 0x16, 0x55, 0xdb, 0x44, 0xbb, 0x3a, 0xb4, 0x89, 0x08, 0xe3, 0x2b, 0x05,
+	0x4e, 0xc6, 0x0f, 0x75, 0x6b, 0xc9, 0xc4, 0x64, 0x1b, 0xad, 0x38, 0xbc, 0x4d, 0x67, 0x4f, 0x88,
+	0x19, 0x31, 0xfa, 0xf7, 0x84, 0x6e, 0x03, 0x6d, 0x63, 0x48, 0x03, 0xa9, 0x27, 0xc9, 0x77, 0x7e,
+	0x62, 0x4f, 0x92, 0x0c, 0xb4, 0x8d, 0x21, 0x0d, 0x84, 0xff, 0x26, 0xcc, 0xc8, 0xf7, 0xeb, 0xc5,
+	0x24, 0xac, 0x4e, 0x6d, 0xed, 0xb5, 0x61, 0xb4, 0x23, 0xb7, 0xda, 0xd4, 0x67, 0xc1, 0x80, 0x55,
+	0x2a, 0x3d, 0x79, 0x91, 0x51, 0x9e, 0xbe, 0xc8, 0x28, 0xbf, 0xbd, 0xc8, 0x28, 0x0f, 0x5e, 0x66,
+	0xc6, 0x9e, 0xbe, 0xcc, 0x8c, 0xfd, 0xf2, 0x32, 0x33, 0xf6, 0xd1, 0xb2, 0x69, 0xf9, 0xf5, 0x66,
+	0x45, 0xaf, 0x12, 0xbb, 0xe7, 0xc3, 0xc2, 0xbf, 0xe7, 0x62, 0x5a, 0x39, 0x12, 0xfe, 0x7b, 0xf9,
+	0xf2, 0x5f, 0x03, 0x00, 0x1c, 0x54, 0xdc, 0xfc, 0x45, 0x17, 0x00, 0x00,
 }
 
-func (m *MsgSetRewardsPerEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SetBlocksInEpoch(ctx context.Context, in *MsgSetBlocksInEpoch, opts ...grpc.CallOption) (*MsgSetBlocksInEpochResponse, error)
+	SetRewardsPerEpoch(ctx context.Context, in *MsgSetRewardsPerEpoch, opts ...grpc.CallOption) (*MsgSetRewardsPerEpochResponse, error)
+	SetRewardingPaused(ctx context.Context, in *MsgSetRewardingPaused, opts ...grpc.CallOption) (*MsgSetRewardingPausedResponse, error)
+	SetProposerBonusPoints(ctx context.Context, in *MsgSetProposerBonusPoints, opts ...grpc.CallOption) (*MsgSetProposerBonusPointsResponse, error)
+	SetSlashForfeitRate(ctx context.Context, in *MsgSetSlashForfeitRate, opts ...grpc.CallOption) (*MsgSetSlashForfeitRateResponse, error)
+	SetClaimWindowEpochs(ctx context.Context, in *MsgSetClaimWindowEpochs, opts ...grpc.CallOption) (*MsgSetClaimWindowEpochsResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	ClaimRewardsRange(ctx context.Context, in *MsgClaimRewardsRange, opts ...grpc.CallOption) (*MsgClaimRewardsRangeResponse, error)
+	SetRewardsCommission(ctx context.Context, in *MsgSetRewardsCommission, opts ...grpc.CallOption) (*MsgSetRewardsCommissionResponse, error)
+	ClaimDelegatorRewards(ctx context.Context, in *MsgClaimDelegatorRewards, opts ...grpc.CallOption) (*MsgClaimDelegatorRewardsResponse, error)
+	DepositRewardsPool(ctx context.Context, in *MsgDepositRewardsPool, opts ...grpc.CallOption) (*MsgDepositRewardsPoolResponse, error)
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
-func (m *MsgSetRewardsPerEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgSetRewardsPerEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgSetRewardingPaused) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetRewardingPaused) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBlocksInEpoch(ctx context.Context, in *MsgSetBlocksInEpoch, opts ...grpc.CallOption) (*MsgSetBlocksInEpochResponse, error) {
+	out := new(MsgSetBlocksInEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetBlocksInEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetRewardingPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RewardingPaused {
-		i--
-		if m.RewardingPaused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetRewardsPerEpoch(ctx context.Context, in *MsgSetRewardsPerEpoch, opts ...grpc.CallOption) (*MsgSetRewardsPerEpochResponse, error) {
+	out := new(MsgSetRewardsPerEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetRewardsPerEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSetRewardingPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetRewardingPaused(ctx context.Context, in *MsgSetRewardingPaused, opts ...grpc.CallOption) (*MsgSetRewardingPausedResponse, error) {
+	out := new(MsgSetRewardingPausedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetRewardingPaused", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetRewardingPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetProposerBonusPoints(ctx context.Context, in *MsgSetProposerBonusPoints, opts ...grpc.CallOption) (*MsgSetProposerBonusPointsResponse, error) {
+	out := new(MsgSetProposerBonusPointsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetProposerBonusPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetRewardingPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetSlashForfeitRate(ctx context.Context, in *MsgSetSlashForfeitRate, opts ...grpc.CallOption) (*MsgSetSlashForfeitRateResponse, error) {
+	out := new(MsgSetSlashForfeitRateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetSlashForfeitRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetClaimWindowEpochs(ctx context.Context, in *MsgSetClaimWindowEpochs, opts ...grpc.CallOption) (*MsgSetClaimWindowEpochsResponse, error) {
+	out := new(MsgSetClaimWindowEpochsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetClaimWindowEpochs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ValidatorOperator) > 0 {
-		i -= len(m.ValidatorOperator)
-		copy(dAtA[i:], m.ValidatorOperator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorOperator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ClaimRewardsRange(ctx context.Context, in *MsgClaimRewardsRange, opts ...grpc.CallOption) (*MsgClaimRewardsRangeResponse, error) {
+	out := new(MsgClaimRewardsRangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/ClaimRewardsRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetRewardsCommission(ctx context.Context, in *MsgSetRewardsCommission, opts ...grpc.CallOption) (*MsgSetRewardsCommissionResponse, error) {
+	out := new(MsgSetRewardsCommissionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetRewardsCommission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimDelegatorRewards(ctx context.Context, in *MsgClaimDelegatorRewards, opts ...grpc.CallOption) (*MsgClaimDelegatorRewardsResponse, error) {
+	out := new(MsgClaimDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/ClaimDelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DepositRewardsPool(ctx context.Context, in *MsgDepositRewardsPool, opts ...grpc.CallOption) (*MsgDepositRewardsPoolResponse, error) {
+	out := new(MsgDepositRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/DepositRewardsPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDepositRewardsPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
+	out := new(MsgSetWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetWithdrawAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDepositRewardsPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.valrewards.v1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDepositRewardsPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetBlocksInEpoch(context.Context, *MsgSetBlocksInEpoch) (*MsgSetBlocksInEpochResponse, error)
+	SetRewardsPerEpoch(context.Context, *MsgSetRewardsPerEpoch) (*MsgSetRewardsPerEpochResponse, error)
+	SetRewardingPaused(context.Context, *MsgSetRewardingPaused) (*MsgSetRewardingPausedResponse, error)
+	SetProposerBonusPoints(context.Context, *MsgSetProposerBonusPoints) (*MsgSetProposerBonusPointsResponse, error)
+	SetSlashForfeitRate(context.Context, *MsgSetSlashForfeitRate) (*MsgSetSlashForfeitRateResponse, error)
+	SetClaimWindowEpochs(context.Context, *MsgSetClaimWindowEpochs) (*MsgSetClaimWindowEpochsResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	ClaimRewardsRange(context.Context, *MsgClaimRewardsRange) (*MsgClaimRewardsRangeResponse, error)
+	SetRewardsCommission(context.Context, *MsgSetRewardsCommission) (*MsgSetRewardsCommissionResponse, error)
+	ClaimDelegatorRewards(context.Context, *MsgClaimDelegatorRewards) (*MsgClaimDelegatorRewardsResponse, error)
+	DepositRewardsPool(context.Context, *MsgDepositRewardsPool) (*MsgDepositRewardsPoolResponse, error)
+	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
-func (m *MsgDepositRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgDepositRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetBlocksInEpoch(ctx context.Context, req *MsgSetBlocksInEpoch) (*MsgSetBlocksInEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlocksInEpoch not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardsPerEpoch(ctx context.Context, req *MsgSetRewardsPerEpoch) (*MsgSetRewardsPerEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardsPerEpoch not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardingPaused(ctx context.Context, req *MsgSetRewardingPaused) (*MsgSetRewardingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardingPaused not implemented")
+}
+func (*UnimplementedMsgServer) SetProposerBonusPoints(ctx context.Context, req *MsgSetProposerBonusPoints) (*MsgSetProposerBonusPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProposerBonusPoints not implemented")
+}
+func (*UnimplementedMsgServer) SetSlashForfeitRate(ctx context.Context, req *MsgSetSlashForfeitRate) (*MsgSetSlashForfeitRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSlashForfeitRate not implemented")
+}
+func (*UnimplementedMsgServer) SetClaimWindowEpochs(ctx context.Context, req *MsgSetClaimWindowEpochs) (*MsgSetClaimWindowEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimWindowEpochs not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewardsRange(ctx context.Context, req *MsgClaimRewardsRange) (*MsgClaimRewardsRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewardsRange not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardsCommission(ctx context.Context, req *MsgSetRewardsCommission) (*MsgSetRewardsCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardsCommission not implemented")
+}
+func (*UnimplementedMsgServer) ClaimDelegatorRewards(ctx context.Context, req *MsgClaimDelegatorRewards) (*MsgClaimDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDelegatorRewards not implemented")
+}
+func (*UnimplementedMsgServer) DepositRewardsPool(ctx context.Context, req *MsgDepositRewardsPool) (*MsgDepositRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositRewardsPool not implemented")
+}
+func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
 }
 
-func (m *MsgDepositRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/UpdateParams",
 	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetBlocksInEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlocksInEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetBlocksInEpoch) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SetBlocksInEpoch(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetBlocksInEpoch",
 	}
-	if m.BlocksInEpoch != 0 {
-		n += 1 + sovTx(uint64(m.BlocksInEpoch))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBlocksInEpoch(ctx, req.(*MsgSetBlocksInEpoch))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetBlocksInEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetRewardsPerEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardsPerEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetRewardsPerEpoch) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardsPerEpoch(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetRewardsPerEpoch",
 	}
-	l = len(m.RewardsPerEpoch)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardsPerEpoch(ctx, req.(*MsgSetRewardsPerEpoch))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetRewardsPerEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetRewardingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardingPaused)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetRewardingPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardingPaused(ctx, req.(*MsgSetRewardingPaused))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetRewardingPaused) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetProposerBonusPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProposerBonusPoints)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetProposerBonusPoints(ctx, in)
 	}
-	if m.RewardingPaused {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetProposerBonusPoints",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProposerBonusPoints(ctx, req.(*MsgSetProposerBonusPoints))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetRewardingPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetSlashForfeitRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSlashForfeitRate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetSlashForfeitRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetSlashForfeitRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSlashForfeitRate(ctx, req.(*MsgSetSlashForfeitRate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetClaimWindowEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClaimWindowEpochs)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorOperator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetClaimWindowEpochs(ctx, in)
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovTx(uint64(m.Epoch))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetClaimWindowEpochs",
 	}
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClaimWindowEpochs(ctx, req.(*MsgSetClaimWindowEpochs))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewardsRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewardsRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewardsRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/ClaimRewardsRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewardsRange(ctx, req.(*MsgClaimRewardsRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardsCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardsCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardsCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetRewardsCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardsCommission(ctx, req.(*MsgSetRewardsCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/ClaimDelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimDelegatorRewards(ctx, req.(*MsgClaimDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositRewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositRewardsPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositRewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/DepositRewardsPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositRewardsPool(ctx, req.(*MsgDepositRewardsPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.valrewards.v1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.valrewards.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetBlocksInEpoch",
+			Handler:    _Msg_SetBlocksInEpoch_Handler,
+		},
+		{
+			MethodName: "SetRewardsPerEpoch",
+			Handler:    _Msg_SetRewardsPerEpoch_Handler,
+		},
+		{
+			MethodName: "SetRewardingPaused",
+			Handler:    _Msg_SetRewardingPaused_Handler,
+		},
+		{
+			MethodName: "SetProposerBonusPoints",
+			Handler:    _Msg_SetProposerBonusPoints_Handler,
+		},
+		{
+			MethodName: "SetSlashForfeitRate",
+			Handler:    _Msg_SetSlashForfeitRate_Handler,
+		},
+		{
+			MethodName: "SetClaimWindowEpochs",
+			Handler:    _Msg_SetClaimWindowEpochs_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "ClaimRewardsRange",
+			Handler:    _Msg_ClaimRewardsRange_Handler,
+		},
+		{
+			MethodName: "SetRewardsCommission",
+			Handler:    _Msg_SetRewardsCommission_Handler,
+		},
+		{
+			MethodName: "ClaimDelegatorRewards",
+			Handler:    _Msg_ClaimDelegatorRewards_Handler,
+		},
+		{
+			MethodName: "DepositRewardsPool",
+			Handler:    _Msg_DepositRewardsPool_Handler,
+		},
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/valrewards/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlocksInEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlocksInEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlocksInEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksInEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlocksInEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlocksInEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlocksInEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlocksInEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsPerEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsPerEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsPerEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPerEpoch) > 0 {
+		i -= len(m.RewardsPerEpoch)
+		copy(dAtA[i:], m.RewardsPerEpoch)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardsPerEpoch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsPerEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsPerEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsPerEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardingPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardingPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardingPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardingPaused {
+		i--
+		if m.RewardingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardingPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardingPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardingPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProposerBonusPoints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProposerBonusPoints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProposerBonusPoints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposerBonusPoints != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposerBonusPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProposerBonusPointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProposerBonusPointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProposerBonusPointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSlashForfeitRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSlashForfeitRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSlashForfeitRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashForfeitRate) > 0 {
+		i -= len(m.SlashForfeitRate)
+		copy(dAtA[i:], m.SlashForfeitRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SlashForfeitRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSlashForfeitRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSlashForfeitRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSlashForfeitRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimWindowEpochs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimWindowEpochs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimWindowEpochs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimWindowEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimWindowEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimWindowEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimWindowEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimWindowEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorOperator) > 0 {
+		i -= len(m.ValidatorOperator)
+		copy(dAtA[i:], m.ValidatorOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorOperator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorOperator) > 0 {
+		i -= len(m.ValidatorOperator)
+		copy(dAtA[i:], m.ValidatorOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorOperator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorOperator) > 0 {
+		i -= len(m.ValidatorOperator)
+		copy(dAtA[i:], m.ValidatorOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorOperator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDelegatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDelegatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDelegatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositRewardsPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositRewardsPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositRewardsPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorOperator) > 0 {
+		i -= len(m.ValidatorOperator)
+		copy(dAtA[i:], m.ValidatorOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorOperator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorOperator) > 0 {
+		i -= len(m.ValidatorOperator)
+		copy(dAtA[i:], m.ValidatorOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorOperator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBlocksInEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlocksInEpoch != 0 {
+		n += 1 + sovTx(uint64(m.BlocksInEpoch))
+	}
+	return n
+}
+
+func (m *MsgSetBlocksInEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRewardsPerEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardsPerEpoch)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardsPerEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRewardingPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardingPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetRewardingPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProposerBonusPoints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposerBonusPoints != 0 {
+		n += 1 + sovTx(uint64(m.ProposerBonusPoints))
+	}
+	return n
+}
+
+func (m *MsgSetProposerBonusPointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSlashForfeitRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SlashForfeitRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSlashForfeitRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetClaimWindowEpochs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClaimWindowEpochs != 0 {
+		n += 1 + sovTx(uint64(m.ClaimWindowEpochs))
+	}
+	return n
+}
+
+func (m *MsgSetClaimWindowEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewardsRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovTx(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovTx(uint64(m.EndEpoch))
+	}
+	if m.MaxEpochs != 0 {
+		n += 1 + sovTx(uint64(m.MaxEpochs))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetRewardsCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRewardsCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimDelegatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovTx(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovTx(uint64(m.EndEpoch))
+	}
+	if m.MaxEpochs != 0 {
+		n += 1 + sovTx(uint64(m.MaxEpochs))
+	}
+	return n
+}
+
+func (m *MsgClaimDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDepositRewardsPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositRewardsPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBlocksInEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBlocksInEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBlocksInEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksInEpoch", wireType)
+			}
+			m.BlocksInEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksInEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBlocksInEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBlocksInEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBlocksInEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardsPerEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardsPerEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardsPerEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardsPerEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardsPerEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardsPerEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardingPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardingPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardingPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardingPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardingPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardingPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardingPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetProposerBonusPoints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetProposerBonusPoints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetProposerBonusPoints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBonusPoints", wireType)
+			}
+			m.ProposerBonusPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerBonusPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetProposerBonusPointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetProposerBonusPointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetProposerBonusPointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSlashForfeitRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSlashForfeitRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSlashForfeitRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashForfeitRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashForfeitRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSlashForfeitRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSlashForfeitRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSlashForfeitRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetClaimWindowEpochs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClaimWindowEpochs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClaimWindowEpochs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimWindowEpochs", wireType)
+			}
+			m.ClaimWindowEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimWindowEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetClaimWindowEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClaimWindowEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClaimWindowEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgDepositRewardsPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgDepositRewardsPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewardsRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1552,15 +4743,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewardsRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewardsRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOperator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1588,13 +4779,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ValidatorOperator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1604,28 +4795,81 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochs", wireType)
+			}
+			m.MaxEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1647,7 +4891,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewardsRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1670,12 +4914,99 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewardsRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewardsRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, EpochReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1697,7 +5028,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBlocksInEpoch) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardsCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1720,15 +5051,15 @@ func (m *MsgSetBlocksInEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBlocksInEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardsCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBlocksInEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardsCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOperator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1756,13 +5087,13 @@ func (m *MsgSetBlocksInEpoch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.ValidatorOperator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksInEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
-			m.BlocksInEpoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1772,11 +5103,26 @@ func (m *MsgSetBlocksInEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksInEpoch |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1798,7 +5144,7 @@ func (m *MsgSetBlocksInEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBlocksInEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardsCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1821,10 +5167,10 @@ func (m *MsgSetBlocksInEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBlocksInEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardsCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBlocksInEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardsCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1848,7 +5194,7 @@ func (m *MsgSetBlocksInEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardsPerEpoch) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimDelegatorRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1871,15 +5217,15 @@ func (m *MsgSetRewardsPerEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardsPerEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimDelegatorRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardsPerEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimDelegatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1907,11 +5253,11 @@ func (m *MsgSetRewardsPerEpoch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1939,8 +5285,65 @@ func (m *MsgSetRewardsPerEpoch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsPerEpoch = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochs", wireType)
+			}
+			m.MaxEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1962,7 +5365,7 @@ func (m *MsgSetRewardsPerEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardsPerEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1985,12 +5388,99 @@ func (m *MsgSetRewardsPerEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardsPerEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimDelegatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardsPerEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DelegatorEpochReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2012,7 +5502,7 @@ func (m *MsgSetRewardsPerEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardingPaused) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositRewardsPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2035,15 +5525,15 @@ func (m *MsgSetRewardingPaused) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardingPaused: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositRewardsPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardingPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2071,13 +5561,13 @@ func (m *MsgSetRewardingPaused) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardingPaused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2087,12 +5577,28 @@ func (m *MsgSetRewardingPaused) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RewardingPaused = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2114,7 +5620,7 @@ func (m *MsgSetRewardingPaused) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardingPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2137,10 +5643,10 @@ func (m *MsgSetRewardingPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardingPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositRewardsPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2164,7 +5670,7 @@ func (m *MsgSetRewardingPausedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,10 +5693,10 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2226,27 +5732,8 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 			m.ValidatorOperator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2274,7 +5761,7 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2297,7 +5784,7 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2320,10 +5807,10 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2347,7 +5834,7 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositRewardsPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2370,15 +5857,15 @@ func (m *MsgDepositRewardsPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositRewardsPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOperator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2406,13 +5893,13 @@ func (m *MsgDepositRewardsPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.ValidatorOperator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2422,28 +5909,12 @@ func (m *MsgDepositRewardsPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2465,7 +5936,7 @@ func (m *MsgDepositRewardsPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2488,10 +5959,10 @@ func (m *MsgDepositRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositRewardsPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -26,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// whitelist are the addresses allowed to stage runtime reward-setting updates.
 	Whitelist []string `protobuf:"bytes,1,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	// runway_alert_epochs is the number of epochs the unallocated rewards pool
+	// must be able to fund before a rewards_runway_low event is emitted at each
+	// epoch rollover. 0 disables the alert.
+	RunwayAlertEpochs uint64 `protobuf:"varint,2,opt,name=runway_alert_epochs,json=runwayAlertEpochs,proto3" json:"runway_alert_epochs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,6 +74,13 @@ func (m *Params) GetWhitelist() []string {
 	return nil
 }
 
+func (m *Params) GetRunwayAlertEpochs() uint64 {
+	if m != nil {
+		return m.RunwayAlertEpochs
+	}
+	return 0
+}
+
 // RewardSettings defines the reward settings applied by epoch.
 type RewardSettings struct {
 	// blocks_in_epoch is the number of blocks in an epoch.
@@ -76,6 +89,14 @@ type RewardSettings struct {
 	RewardsPerEpoch string `protobuf:"bytes,2,opt,name=rewards_per_epoch,json=rewardsPerEpoch,proto3" json:"rewards_per_epoch,omitempty"`
 	// rewarding_paused disables point accumulation and reward row creation for the epoch when true.
 	RewardingPaused bool `protobuf:"varint,3,opt,name=rewarding_paused,json=rewardingPaused,proto3" json:"rewarding_paused,omitempty"`
+	// proposer_bonus_points are the extra points awarded to the block proposer on top of its signing points.
+	ProposerBonusPoints uint64 `protobuf:"varint,4,opt,name=proposer_bonus_points,json=proposerBonusPoints,proto3" json:"proposer_bonus_points,omitempty"`
+	// slash_forfeit_rate is the fraction of its epoch points a validator slashed during the epoch forfeits, in
+	// decimal string form. An empty value forfeits nothing.
+	SlashForfeitRate string `protobuf:"bytes,5,opt,name=slash_forfeit_rate,json=slashForfeitRate,proto3" json:"slash_forfeit_rate,omitempty"`
+	// claim_window_epochs is the number of epochs a reward stays claimable after its epoch has been paid. Unclaimed
+	// rows older than the window are pruned and their amounts returned to the pool. 0 keeps rewards forever.
+	ClaimWindowEpochs uint64 `protobuf:"varint,6,opt,name=claim_window_epochs,json=claimWindowEpochs,proto3" json:"claim_window_epochs,omitempty"`
 }
 
 func (m *RewardSettings) Reset()         { *m = RewardSettings{} }
@@ -132,6 +153,27 @@ func (m *RewardSettings) GetRewardingPaused() bool {
 	return false
 }
 
+func (m *RewardSettings) GetProposerBonusPoints() uint64 {
+	if m != nil {
+		return m.ProposerBonusPoints
+	}
+	return 0
+}
+
+func (m *RewardSettings) GetSlashForfeitRate() string {
+	if m != nil {
+		return m.SlashForfeitRate
+	}
+	return ""
+}
+
+func (m *RewardSettings) GetClaimWindowEpochs() uint64 {
+	if m != nil {
+		return m.ClaimWindowEpochs
+	}
+	return 0
+}
+
 // EpochState defines the explicit epoch-tracking state stored by the module.
 type EpochState struct {
 	// current_epoch is the epoch currently collecting points.
@@ -187,10 +229,132 @@ func (m *EpochState) GetBlocksIntoCurrentEpoch() int64 {
 	return 0
 }
 
+// EpochReward defines the reward amount of a validator for a single epoch.
+type EpochReward struct {
+	// epoch is the epoch the reward was earned in.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// amount is the reward amount for the epoch.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EpochReward) Reset()         { *m = EpochReward{} }
+func (m *EpochReward) String() string { return proto.CompactTextString(m) }
+func (*EpochReward) ProtoMessage()    {}
+func (*EpochReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aba4a89507aa464b, []int{3}
+}
+func (m *EpochReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochReward.Merge(m, src)
+}
+func (m *EpochReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochReward proto.InternalMessageInfo
+
+func (m *EpochReward) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochReward) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// DelegatorEpochReward defines the reward a delegator earned through one
+// validator for a single epoch.
+type DelegatorEpochReward struct {
+	// epoch is the epoch the reward was earned in.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// validator_address is the validator operator address the reward was earned through.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the reward amount for the epoch.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *DelegatorEpochReward) Reset()         { *m = DelegatorEpochReward{} }
+func (m *DelegatorEpochReward) String() string { return proto.CompactTextString(m) }
+func (*DelegatorEpochReward) ProtoMessage()    {}
+func (*DelegatorEpochReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aba4a89507aa464b, []int{4}
+}
+func (m *DelegatorEpochReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorEpochReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorEpochReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorEpochReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorEpochReward.Merge(m, src)
+}
+func (m *DelegatorEpochReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorEpochReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorEpochReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorEpochReward proto.InternalMessageInfo
+
+func (m *DelegatorEpochReward) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DelegatorEpochReward) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorEpochReward) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.evm.valrewards.v1.Params")
 	proto.RegisterType((*RewardSettings)(nil), "cosmos.evm.valrewards.v1.RewardSettings")
 	proto.RegisterType((*EpochState)(nil), "cosmos.evm.valrewards.v1.EpochState")
+	proto.RegisterType((*EpochReward)(nil), "cosmos.evm.valrewards.v1.EpochReward")
+	proto.RegisterType((*DelegatorEpochReward)(nil), "cosmos.evm.valrewards.v1.DelegatorEpochReward")
 }
 
 func init() {
@@ -198,27 +362,41 @@ func init() {
 }
 
 var fileDescriptor_aba4a89507aa464b = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x31, 0x4b, 0xc3, 0x40,
-	0x1c, 0xc5, 0x7b, 0xad, 0x14, 0x7b, 0x58, 0xab, 0x19, 0x24, 0x82, 0x1c, 0xa1, 0x42, 0x49, 0x1d,
-	0x12, 0x8a, 0x93, 0x6b, 0xc5, 0xc1, 0xad, 0xa4, 0x9b, 0x4b, 0xb8, 0xa6, 0x7f, 0xd2, 0xc3, 0xe4,
-	0x2e, 0xdc, 0xfd, 0x9b, 0xea, 0xa7, 0xd0, 0x8f, 0xe5, 0xd8, 0xd1, 0x51, 0xda, 0x2f, 0x22, 0x26,
-	0xa9, 0x9e, 0xe3, 0xfd, 0xde, 0x7b, 0xf7, 0x7f, 0xf0, 0xe8, 0x38, 0x51, 0x26, 0x57, 0x26, 0x84,
-	0x32, 0x0f, 0x4b, 0x9e, 0x69, 0xd8, 0x70, 0xbd, 0x34, 0x61, 0x39, 0xb1, 0x5e, 0x41, 0xa1, 0x15,
-	0x2a, 0xc7, 0xad, 0xad, 0x01, 0x94, 0x79, 0x60, 0x89, 0xe5, 0x64, 0x38, 0xa2, 0xdd, 0x19, 0xd7,
-	0x3c, 0x37, 0xce, 0x15, 0xed, 0x6d, 0x56, 0x02, 0x21, 0x13, 0x06, 0x5d, 0xe2, 0x75, 0xfc, 0x5e,
-	0xf4, 0x07, 0x86, 0x6f, 0x84, 0x9e, 0x46, 0x55, 0x6c, 0x0e, 0x88, 0x42, 0xa6, 0xc6, 0x19, 0xd1,
-	0xc1, 0x22, 0x53, 0xc9, 0xb3, 0x89, 0x85, 0x8c, 0xa1, 0x50, 0xc9, 0xca, 0x25, 0x1e, 0xf1, 0x3b,
-	0x51, 0xbf, 0xc6, 0x8f, 0xf2, 0xe1, 0x07, 0x3a, 0x37, 0xf4, 0xbc, 0x39, 0x18, 0x17, 0xa0, 0x1b,
-	0x67, 0xdb, 0x23, 0x7e, 0x2f, 0x1a, 0x34, 0xc2, 0x0c, 0x74, 0xed, 0x1d, 0xd3, 0xb3, 0x1a, 0x09,
-	0x99, 0xc6, 0x05, 0x5f, 0x1b, 0x58, 0xba, 0x1d, 0x8f, 0xf8, 0xc7, 0x07, 0xab, 0x90, 0xe9, 0xac,
-	0xc2, 0xc3, 0x8c, 0xd2, 0x2a, 0x33, 0x47, 0x8e, 0xe0, 0x5c, 0xd3, 0x7e, 0xb2, 0xd6, 0x1a, 0x24,
-	0x5a, 0x55, 0x8e, 0xa2, 0x93, 0x06, 0xd6, 0xbf, 0xdf, 0xd1, 0xcb, 0xdf, 0xc6, 0xa8, 0xe2, 0xff,
-	0x81, 0x76, 0xd5, 0xfd, 0xe2, 0xd0, 0x1d, 0xd5, 0xbd, 0x15, 0x9d, 0x4e, 0x3f, 0x76, 0x8c, 0x6c,
-	0x77, 0x8c, 0x7c, 0xed, 0x18, 0x79, 0xdf, 0xb3, 0xd6, 0x76, 0xcf, 0x5a, 0x9f, 0x7b, 0xd6, 0x7a,
-	0xf2, 0x53, 0x81, 0xab, 0xf5, 0x22, 0x48, 0x54, 0x1e, 0x5a, 0x8b, 0xbc, 0xd8, 0x9b, 0xe0, 0x6b,
-	0x01, 0x66, 0xd1, 0xad, 0xc6, 0xb8, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x72, 0x77, 0x8c, 0x08,
-	0xb9, 0x01, 0x00, 0x00,
+	// 536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb5, 0xab, 0xa8, 0xc7, 0x58, 0x9b, 0x15, 0x94, 0x4d, 0x28, 0x54, 0x45, 0x42,
+	0x1d, 0xa0, 0x44, 0x1d, 0x07, 0xc4, 0x71, 0x1d, 0x20, 0x71, 0xab, 0x32, 0x09, 0x24, 0x84, 0x64,
+	0x39, 0x89, 0x97, 0x5a, 0x24, 0x7e, 0x91, 0xed, 0x24, 0xec, 0x5b, 0x20, 0xbe, 0x01, 0xdf, 0x66,
+	0xc7, 0x1d, 0x39, 0x21, 0xd4, 0x7e, 0x11, 0x14, 0x3b, 0x1d, 0xe1, 0x84, 0xb8, 0x3d, 0xff, 0x7f,
+	0xef, 0xd9, 0xcf, 0x7f, 0xfb, 0xa1, 0x93, 0x08, 0x64, 0x06, 0xd2, 0xa7, 0x65, 0xe6, 0x97, 0x24,
+	0x15, 0xb4, 0x22, 0x22, 0x96, 0x7e, 0x39, 0x6f, 0xad, 0xbc, 0x5c, 0x80, 0x02, 0xdb, 0x31, 0xa9,
+	0x1e, 0x2d, 0x33, 0xaf, 0x05, 0xcb, 0xf9, 0xb1, 0xdb, 0x6c, 0x12, 0x12, 0x49, 0xfd, 0x72, 0x1e,
+	0x52, 0x45, 0xe6, 0x7e, 0x04, 0x8c, 0x9b, 0xca, 0xe3, 0x71, 0x02, 0x09, 0xe8, 0xd0, 0xaf, 0x23,
+	0xa3, 0x4e, 0xdf, 0xa3, 0xfe, 0x92, 0x08, 0x92, 0x49, 0xfb, 0x21, 0x1a, 0x54, 0x2b, 0xa6, 0x68,
+	0xca, 0xa4, 0x72, 0xac, 0x49, 0x77, 0x36, 0x08, 0xfe, 0x08, 0xb6, 0x87, 0x0e, 0x45, 0xc1, 0x2b,
+	0x72, 0x85, 0x49, 0x4a, 0x85, 0xc2, 0x34, 0x87, 0x68, 0x25, 0x9d, 0x9d, 0x89, 0x35, 0xeb, 0x05,
+	0x23, 0x83, 0xce, 0x6a, 0xf2, 0x46, 0x83, 0xe9, 0xf7, 0x1d, 0x74, 0x2f, 0xd0, 0xcd, 0x5d, 0x50,
+	0xa5, 0x18, 0x4f, 0xa4, 0xfd, 0x04, 0x1d, 0x84, 0x29, 0x44, 0x9f, 0x25, 0x66, 0xdc, 0xd4, 0x3b,
+	0xd6, 0xc4, 0x9a, 0x75, 0x83, 0x7d, 0x23, 0xbf, 0xe3, 0xba, 0xd6, 0x7e, 0x8a, 0x46, 0xcd, 0xb5,
+	0x70, 0x4e, 0x45, 0x93, 0x59, 0x1f, 0x34, 0x08, 0x0e, 0x1a, 0xb0, 0xa4, 0xc2, 0xe4, 0x9e, 0xa0,
+	0xa1, 0x91, 0x18, 0x4f, 0x70, 0x4e, 0x0a, 0x49, 0x63, 0xa7, 0x3b, 0xb1, 0x66, 0x77, 0xb6, 0xa9,
+	0x8c, 0x27, 0x4b, 0x2d, 0xdb, 0xa7, 0xe8, 0x7e, 0x2e, 0x20, 0x07, 0x49, 0x05, 0x0e, 0x81, 0x17,
+	0x12, 0xe7, 0xc0, 0xb8, 0x92, 0x4e, 0x4f, 0xdf, 0xe1, 0x70, 0x0b, 0x17, 0x35, 0x5b, 0x6a, 0x64,
+	0x3f, 0x47, 0xb6, 0x4c, 0x89, 0x5c, 0xe1, 0x4b, 0x10, 0x97, 0x94, 0x29, 0x2c, 0x88, 0xa2, 0xce,
+	0xae, 0xee, 0x65, 0xa8, 0xc9, 0x5b, 0x03, 0x02, 0xa2, 0x68, 0xed, 0x51, 0x94, 0x12, 0x96, 0xe1,
+	0x8a, 0xf1, 0x18, 0xaa, 0xad, 0x47, 0x7d, 0xe3, 0x91, 0x46, 0x1f, 0x34, 0x69, 0x3c, 0x4a, 0x11,
+	0xd2, 0xd1, 0x85, 0xaa, 0xab, 0x1f, 0xa3, 0xfd, 0xa8, 0x10, 0x82, 0x72, 0xd5, 0x32, 0xa7, 0x17,
+	0xdc, 0x6d, 0x44, 0x73, 0xdf, 0x57, 0xe8, 0xe8, 0xd6, 0x43, 0x05, 0xf8, 0xef, 0x82, 0x1d, 0xed,
+	0xe6, 0x83, 0xad, 0x9b, 0x0a, 0xce, 0x5b, 0xa5, 0xd3, 0x4f, 0x68, 0x4f, 0x07, 0xe6, 0x55, 0xec,
+	0x31, 0xda, 0x6d, 0x1f, 0x63, 0x16, 0xf6, 0x4b, 0xd4, 0x27, 0x19, 0x14, 0x5c, 0xe9, 0xcd, 0xf6,
+	0x4e, 0x8f, 0xbc, 0xe6, 0xbf, 0xd5, 0xbf, 0xca, 0x6b, 0x7e, 0x95, 0x77, 0x0e, 0x8c, 0x2f, 0x7a,
+	0xd7, 0x3f, 0x1f, 0x75, 0x82, 0x26, 0x7d, 0xfa, 0xcd, 0x42, 0xe3, 0xd7, 0x34, 0xa5, 0x09, 0x51,
+	0x20, 0xfe, 0x7d, 0xce, 0x33, 0x34, 0x2a, 0x49, 0xca, 0xe2, 0x3a, 0x1b, 0x93, 0x38, 0x16, 0x54,
+	0xca, 0xe6, 0x8d, 0x87, 0xb7, 0xe0, 0xcc, 0xe8, 0xad, 0xa6, 0xba, 0xff, 0xd5, 0xd4, 0x62, 0x71,
+	0xbd, 0x76, 0xad, 0x9b, 0xb5, 0x6b, 0xfd, 0x5a, 0xbb, 0xd6, 0xd7, 0x8d, 0xdb, 0xb9, 0xd9, 0xb8,
+	0x9d, 0x1f, 0x1b, 0xb7, 0xf3, 0x71, 0x96, 0x30, 0xb5, 0x2a, 0x42, 0x2f, 0x82, 0xcc, 0x6f, 0x0d,
+	0xdf, 0x97, 0xf6, 0xf8, 0xa9, 0xab, 0x9c, 0xca, 0xb0, 0xaf, 0xe7, 0xe4, 0xc5, 0xef, 0x01, 0x00,
+	0x6e, 0x49, 0x23, 0xd6, 0xa4, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -241,6 +419,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RunwayAlertEpochs != 0 {
+		i = encodeVarintValrewards(dAtA, i, uint64(m.RunwayAlertEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Whitelist) > 0 {
 		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Whitelist[iNdEx])
@@ -273,6 +456,23 @@ func (m *RewardSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimWindowEpochs != 0 {
+		i = encodeVarintValrewards(dAtA, i, uint64(m.ClaimWindowEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SlashForfeitRate) > 0 {
+		i -= len(m.SlashForfeitRate)
+		copy(dAtA[i:], m.SlashForfeitRate)
+		i = encodeVarintValrewards(dAtA, i, uint64(len(m.SlashForfeitRate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProposerBonusPoints != 0 {
+		i = encodeVarintValrewards(dAtA, i, uint64(m.ProposerBonusPoints))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.RewardingPaused {
 		i--
 		if m.RewardingPaused {
@@ -331,6 +531,89 @@ func (m *EpochState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintValrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintValrewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorEpochReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorEpochReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorEpochReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintValrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintValrewards(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValrewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintValrewards(dAtA []byte, offset int, v uint64) int {
 	offset -= sovValrewards(v)
 	base := offset
@@ -354,6 +637,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovValrewards(uint64(l))
 		}
 	}
+	if m.RunwayAlertEpochs != 0 {
+		n += 1 + sovValrewards(uint64(m.RunwayAlertEpochs))
+	}
 	return n
 }
 
@@ -373,6 +659,16 @@ func (m *RewardSettings) Size() (n int) {
 	if m.RewardingPaused {
 		n += 2
 	}
+	if m.ProposerBonusPoints != 0 {
+		n += 1 + sovValrewards(uint64(m.ProposerBonusPoints))
+	}
+	l = len(m.SlashForfeitRate)
+	if l > 0 {
+		n += 1 + l + sovValrewards(uint64(l))
+	}
+	if m.ClaimWindowEpochs != 0 {
+		n += 1 + sovValrewards(uint64(m.ClaimWindowEpochs))
+	}
 	return n
 }
 
@@ -391,6 +687,38 @@ func (m *EpochState) Size() (n int) {
 	return n
 }
 
+func (m *EpochReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValrewards(uint64(m.Epoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovValrewards(uint64(l))
+	return n
+}
+
+func (m *DelegatorEpochReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValrewards(uint64(m.Epoch))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovValrewards(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovValrewards(uint64(l))
+	return n
+}
+
 func sovValrewards(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +786,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwayAlertEpochs", wireType)
+			}
+			m.RunwayAlertEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunwayAlertEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValrewards(dAtA[iNdEx:])
@@ -579,6 +926,76 @@ func (m *RewardSettings) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RewardingPaused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBonusPoints", wireType)
+			}
+			m.ProposerBonusPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerBonusPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashForfeitRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashForfeitRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimWindowEpochs", wireType)
+			}
+			m.ClaimWindowEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimWindowEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValrewards(dAtA[iNdEx:])
@@ -688,6 +1105,242 @@ func (m *EpochState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorEpochReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorEpochReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorEpochReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipValrewards(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -67,6 +67,9 @@ type Keeper struct {
 	// with consensus params when not set in context.
 	consensusKeeper types.ConsensusParamsKeeper
 
+	// optional circuitKeeper used to reject EVM calls to frozen addresses and disabled contract creation
+	circuitKeeper types.CircuitKeeper
+
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
@@ -150,6 +153,13 @@ func (k *Keeper) WithDefaultEvmCoinInfo(coinInfo types.EvmCoinInfo) *Keeper {
 	return k
 }
 
+// WithCircuitKeeper sets the circuit keeper used to reject EVM calls to addresses
+// frozen by the circuit breaker and contract creation while it is disabled.
+func (k *Keeper) WithCircuitKeeper(circuitKeeper types.CircuitKeeper) *Keeper {
+	k.circuitKeeper = circuitKeeper
+	return k
+}
+
 // ----------------------------------------------------------------------------
 // Block Bloom
 // Required by Web3 API.
@@ -73,6 +73,22 @@ func (k *Keeper) NewEVMWithOverridePrecompiles(
 	evmHooks.AddCallHooks(
 		accessControl.GetCallHook(signer),
 	)
+	if k.circuitKeeper != nil {
+		circuit := types.NewCircuitPermissionPolicy(
+			func(address common.Address) bool {
+				return k.circuitKeeper.IsContractFrozen(ctx, address)
+			},
+			func() bool {
+				return k.circuitKeeper.IsContractCreationDisabled(ctx)
+			},
+		)
+		evmHooks.AddCreateHooks(
+			circuit.GetCreateHook(signer),
+		)
+		evmHooks.AddCallHooks(
+			circuit.GetCallHook(signer),
+		)
+	}
 	if overridePrecompiles {
 		evmHooks.AddCallHooks(
 			k.GetPrecompilesCallHook(ctx),
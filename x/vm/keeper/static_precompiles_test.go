@@ -11,7 +11,7 @@ import (
 )
 
 func TestWithStaticPrecompilesRejectsZeroAddress(t *testing.T) {
-	precompile, err := reserved.NewPrecompile(15)
+	precompile, err := reserved.NewPrecompile(18)
 	require.NoError(t, err)
 
 	keeper := &Keeper{}
@@ -73,6 +73,14 @@ type Erc20Keeper interface {
 	GetERC20PrecompileInstance(ctx sdk.Context, address common.Address) (contract vm.PrecompiledContract, found bool, err error)
 }
 
+// CircuitKeeper defines the expected interface needed to reject EVM calls to
+// addresses frozen by the circuit breaker and contract creation while the
+// circuit disables it.
+type CircuitKeeper interface {
+	IsContractFrozen(ctx sdk.Context, address common.Address) bool
+	IsContractCreationDisabled(ctx sdk.Context) bool
+}
+
 // EvmHooks event hooks for evm tx processing
 type EvmHooks interface {
 	// Must be called after tx is processed successfully, if return an error, the whole transaction is reverted.
@@ -139,3 +139,52 @@ func permissionedCheckFn(addresses []string, signer common.Address) callerFn {
 		return isSignerAllowed || slices.Contains(addresses, strCaller)
 	}
 }
+
+// CircuitPermissionPolicy is a permission policy that enforces the circuit
+// breaker inside the EVM. It rejects every call to a frozen address, including
+// internal calls made by other contracts, since the call hook runs before every
+// CALL, CALLCODE, DELEGATECALL and STATICCALL. It also rejects contract creation
+// while the circuit disables it, both for top-level transactions and for the
+// CREATE and CREATE2 opcodes.
+type CircuitPermissionPolicy struct {
+	isFrozen           func(address common.Address) bool
+	isCreationDisabled func() bool
+}
+
+func NewCircuitPermissionPolicy(isFrozen func(address common.Address) bool, isCreationDisabled func() bool) PermissionPolicy {
+	return CircuitPermissionPolicy{isFrozen: isFrozen, isCreationDisabled: isCreationDisabled}
+}
+
+var _ PermissionPolicy = CircuitPermissionPolicy{}
+
+// GetCallHook returns a CallHook that checks if the recipient is frozen.
+func (p CircuitPermissionPolicy) GetCallHook(signer common.Address) CallHook {
+	return func(_ *vm.EVM, caller, recipient common.Address) error {
+		if p.CanCall(signer, caller, recipient) {
+			return nil
+		}
+		return fmt.Errorf("calls to address %s are frozen by the circuit breaker", recipient)
+	}
+}
+
+// GetCreateHook returns a CreateHook that checks if contract creation is disabled.
+func (p CircuitPermissionPolicy) GetCreateHook(signer common.Address) CreateHook {
+	return func(_ *vm.EVM, caller common.Address) error {
+		if p.CanCreate(signer, caller) {
+			return nil
+		}
+		return fmt.Errorf("caller address %s cannot deploy contracts while contract creation is disabled by the circuit breaker", caller)
+	}
+}
+
+// CanCreate implements the PermissionPolicy interface.
+// It allows contract creation unless the circuit disables it.
+func (p CircuitPermissionPolicy) CanCreate(_, _ common.Address) bool {
+	return !p.isCreationDisabled()
+}
+
+// CanCall implements the PermissionPolicy interface.
+// It allows the call unless the recipient is frozen.
+func (p CircuitPermissionPolicy) CanCall(_, _, recipient common.Address) bool {
+	return !p.isFrozen(recipient)
+}
@@ -229,3 +229,36 @@ func (suite *UnitTestSuite) TestAccessControl() {
 		})
 	}
 }
+
+func (suite *UnitTestSuite) TestCircuitPermissionPolicy() {
+	keyring := testkeyring.New(3)
+	signer := keyring.GetAddr(0)
+	caller := keyring.GetAddr(1)
+	frozen := keyring.GetAddr(2)
+
+	creationDisabled := false
+	policy := types.NewCircuitPermissionPolicy(
+		func(address common.Address) bool {
+			return address == frozen
+		},
+		func() bool {
+			return creationDisabled
+		},
+	)
+
+	suite.Require().True(policy.CanCreate(signer, caller))
+	suite.Require().NoError(policy.GetCreateHook(signer)(nil, caller))
+
+	suite.Require().True(policy.CanCall(signer, caller, caller))
+	suite.Require().NoError(policy.GetCallHook(signer)(nil, caller, caller))
+
+	suite.Require().False(policy.CanCall(signer, caller, frozen))
+	err := policy.GetCallHook(signer)(nil, caller, frozen)
+	suite.Require().ErrorContains(err, "frozen by the circuit breaker")
+
+	creationDisabled = true
+	suite.Require().False(policy.CanCreate(signer, caller))
+	err = policy.GetCreateHook(signer)(nil, caller)
+	suite.Require().ErrorContains(err, "contract creation is disabled by the circuit breaker")
+	suite.Require().True(policy.CanCall(signer, caller, caller))
+}
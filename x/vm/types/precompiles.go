@@ -29,9 +29,9 @@ const (
 	PQMLDSAPrecompileAddress        = "0x0000000000000000000000000000000000000712"
 	PQSLHDSAPrecompileAddress       = "0x0000000000000000000000000000000000000713"
 	ValRewardsPrecompileAddress     = "0x0000000000000000000000000000000000000714"
-	ReservedSlot15PrecompileAddress = "0x0000000000000000000000000000000000000715"
-	ReservedSlot16PrecompileAddress = "0x0000000000000000000000000000000000000716"
-	ReservedSlot17PrecompileAddress = "0x0000000000000000000000000000000000000717"
+	CircuitPrecompileAddress        = "0x0000000000000000000000000000000000000715"
+	ZKVerifierPrecompileAddress     = "0x0000000000000000000000000000000000000716"
+	FrostGroupPrecompileAddress     = "0x0000000000000000000000000000000000000717"
 	ReservedSlot18PrecompileAddress = "0x0000000000000000000000000000000000000718"
 	ReservedSlot19PrecompileAddress = "0x0000000000000000000000000000000000000719"
 	ReservedSlot20PrecompileAddress = "0x0000000000000000000000000000000000000720"
@@ -96,9 +96,9 @@ var AvailableStaticPrecompiles = []string{
 	PQMLDSAPrecompileAddress,
 	PQSLHDSAPrecompileAddress,
 	ValRewardsPrecompileAddress,
-	ReservedSlot15PrecompileAddress,
-	ReservedSlot16PrecompileAddress,
-	ReservedSlot17PrecompileAddress,
+	CircuitPrecompileAddress,
+	ZKVerifierPrecompileAddress,
+	FrostGroupPrecompileAddress,
 	ReservedSlot18PrecompileAddress,
 	ReservedSlot19PrecompileAddress,
 	ReservedSlot20PrecompileAddress,
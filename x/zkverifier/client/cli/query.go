@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	zkverifiertypes "github.com/cosmos/evm/x/zkverifier/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        zkverifiertypes.ModuleName,
+		Short:                      "SNARK verifying key query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryVerifyingKey(),
+		GetCmdQueryVerifyingKeys(),
+	)
+	return cmd
+}
+
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query who may register verifying keys and the registration fee",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := zkverifiertypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &zkverifiertypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryVerifyingKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verifying-key [ID]",
+		Short: "Query a registered verifying key by its 0x-prefixed id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := hexutil.Decode(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := zkverifiertypes.NewQueryClient(clientCtx)
+			res, err := queryClient.VerifyingKey(context.Background(), &zkverifiertypes.QueryVerifyingKeyRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryVerifyingKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verifying-keys",
+		Short: "Query the registered verifying keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := zkverifiertypes.NewQueryClient(clientCtx)
+			res, err := queryClient.VerifyingKeys(context.Background(), &zkverifiertypes.QueryVerifyingKeysRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "verifying-keys")
+	return cmd
+}
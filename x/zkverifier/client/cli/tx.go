@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/zkverifier/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "zkverifier subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterVerifyingKeyCmd(),
+	)
+	return txCmd
+}
+
+func NewRegisterVerifyingKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-verifying-key [groth16|plonk] [bn254|bls12-381] [KEY_FILE]",
+		Short: "Register a Groth16 or Plonk verifying key",
+		Long: `Register a verifying key written by gnark's VerifyingKey.WriteTo or
+WriteRawTo. The registration fee from the params is paid to the community pool.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			system, err := parseProofSystem(args[0])
+			if err != nil {
+				return err
+			}
+
+			curve, err := parseCurve(args[1])
+			if err != nil {
+				return err
+			}
+
+			key, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterVerifyingKey{
+				Signer:      clientCtx.GetFromAddress().String(),
+				ProofSystem: system,
+				Curve:       curve,
+				Key:         key,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseProofSystem(s string) (types.ProofSystem, error) {
+	switch strings.ToLower(s) {
+	case "groth16":
+		return types.ProofSystemGroth16, nil
+	case "plonk":
+		return types.ProofSystemPlonk, nil
+	default:
+		return types.ProofSystemUnspecified, fmt.Errorf("unknown proof system %q, expected groth16 or plonk", s)
+	}
+}
+
+func parseCurve(s string) (types.Curve, error) {
+	switch strings.ToLower(s) {
+	case "bn254":
+		return types.CurveBN254, nil
+	case "bls12-381", "bls12381":
+		return types.CurveBLS12381, nil
+	default:
+		return types.CurveUnspecified, fmt.Errorf("unknown curve %q, expected bn254 or bls12-381", s)
+	}
+}
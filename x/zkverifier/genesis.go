@@ -0,0 +1,22 @@
+package zkverifier
+
+import (
+	"github.com/cosmos/evm/x/zkverifier/keeper"
+	"github.com/cosmos/evm/x/zkverifier/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	for _, vk := range data.VerifyingKeys {
+		k.SetVerifyingKey(ctx, vk)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:        k.GetParams(ctx),
+		VerifyingKeys: k.GetAllVerifyingKeys(ctx),
+	}
+}
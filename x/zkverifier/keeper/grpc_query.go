@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/evm/x/zkverifier/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) VerifyingKey(c context.Context, req *types.QueryVerifyingKeyRequest) (*types.QueryVerifyingKeyResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if len(req.Id) != types.VerifyingKeyIDLength {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "id must be %d bytes", types.VerifyingKeyIDLength)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	vk, found := k.GetVerifyingKey(ctx, req.Id)
+	if !found {
+		return nil, types.ErrVerifyingKeyNotFound.Wrapf("0x%x", req.Id)
+	}
+	return &types.QueryVerifyingKeyResponse{VerifyingKey: vk}, nil
+}
+
+func (k Keeper) VerifyingKeys(c context.Context, req *types.QueryVerifyingKeysRequest) (*types.QueryVerifyingKeysResponse, error) {
+	if c == nil || req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerifyingKey)
+
+	vks := []types.VerifyingKey{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var vk types.VerifyingKey
+		if err := k.cdc.Unmarshal(value, &vk); err != nil {
+			return err
+		}
+		vks = append(vks, vk)
+		return nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryVerifyingKeysResponse{
+		VerifyingKeys: vks,
+		Pagination:    pageRes,
+	}, nil
+}
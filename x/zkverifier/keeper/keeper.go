@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/cosmos/evm/x/zkverifier/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	storeKey    storetypes.StoreKey
+	authority   sdk.AccAddress
+	distrKeeper DistributionKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
+	distrKeeper DistributionKeeper,
+) Keeper {
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err) //nolint:halt // Constructor guard: zkverifier authority wiring must be valid at boot.
+	}
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    storeKey,
+		authority:   authority,
+		distrKeeper: distrKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetAuthority returns the governance account, which can always register
+// verifying keys without paying the fee.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyParams)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyParams, k.cdc.MustMarshal(&params))
+}
+
+// GetVerifyingKey returns the registered verifying key with the given id.
+func (k Keeper) GetVerifyingKey(ctx sdk.Context, id []byte) (types.VerifyingKey, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVerifyingKeyKey(id))
+	if bz == nil {
+		return types.VerifyingKey{}, false
+	}
+
+	var vk types.VerifyingKey
+	k.cdc.MustUnmarshal(bz, &vk)
+	return vk, true
+}
+
+func (k Keeper) SetVerifyingKey(ctx sdk.Context, vk types.VerifyingKey) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVerifyingKeyKey(vk.Id), k.cdc.MustMarshal(&vk))
+}
+
+// IterateVerifyingKeys walks the registered verifying keys in id order.
+func (k Keeper) IterateVerifyingKeys(ctx sdk.Context, cb func(vk types.VerifyingKey) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixVerifyingKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vk types.VerifyingKey
+		k.cdc.MustUnmarshal(iterator.Value(), &vk)
+		if cb(vk) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAllVerifyingKeys(ctx sdk.Context) []types.VerifyingKey {
+	vks := []types.VerifyingKey{}
+	k.IterateVerifyingKeys(ctx, func(vk types.VerifyingKey) bool {
+		vks = append(vks, vk)
+		return false
+	})
+	return vks
+}
+
+// VerifyProof checks proof against the registered key with the given id. It
+// returns ErrProofVerificationFailed when a well-formed proof does not verify.
+func (k Keeper) VerifyProof(ctx sdk.Context, id []byte, proof []byte, publicInputs []*big.Int) error {
+	vk, found := k.GetVerifyingKey(ctx, id)
+	if !found {
+		return types.ErrVerifyingKeyNotFound.Wrapf("0x%x", id)
+	}
+	return vk.Verify(proof, publicInputs)
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/x/zkverifier/testutil"
+	"github.com/cosmos/evm/x/zkverifier/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	store "cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+type fakeDistributionKeeper struct {
+	funded map[string]sdk.Coins
+}
+
+func (f *fakeDistributionKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	f.funded[sender.String()] = f.funded[sender.String()].Add(amount...)
+	return nil
+}
+
+func setupKeeper(t *testing.T) (Keeper, sdk.Context, *fakeDistributionKeeper) {
+	t.Helper()
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount("cosmos", "cosmospub")
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, cmttypes.Header{}, false, log.NewNopLogger())
+	ir := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(ir)
+	authority := sdk.AccAddress([]byte("authority_addr_12345"))
+
+	dk := &fakeDistributionKeeper{funded: map[string]sdk.Coins{}}
+	k := NewKeeper(cdc, storeKey, authority, dk)
+	return k, ctx, dk
+}
+
+func TestGetParamsDefaultsWhenUnset(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	srv := NewMsgServerImpl(k)
+	params := types.Params{
+		PermissionlessRegistration: true,
+		RegistrationFee:            sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(100))),
+		MaxVerifyingKeySize:        1024,
+	}
+
+	_, err := srv.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("bad_authority")).String(),
+		Params:    &params,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	invalid := params
+	invalid.MaxVerifyingKeySize = 0
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.authority.String(), Params: &invalid})
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.authority.String(), Params: &params})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
+
+func TestRegisterVerifyingKey(t *testing.T) {
+	f, err := testutil.NewFixture(types.ProofSystemGroth16, types.CurveBN254)
+	require.NoError(t, err)
+	fee := sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(100)))
+	user := sdk.AccAddress([]byte("user_addr_123456789_"))
+
+	testCases := []struct {
+		name       string
+		params     types.Params
+		registrant func(k Keeper) sdk.AccAddress
+		key        []byte
+		expErr     error
+		expFee     sdk.Coins
+	}{
+		{
+			name:       "authority registers without fee",
+			params:     types.Params{RegistrationFee: fee, MaxVerifyingKeySize: types.DefaultMaxVerifyingKeySize},
+			registrant: func(k Keeper) sdk.AccAddress { return k.authority },
+			key:        f.Key,
+		},
+		{
+			name:       "user blocked when registration is permissioned",
+			params:     types.DefaultParams(),
+			registrant: func(Keeper) sdk.AccAddress { return user },
+			key:        f.Key,
+			expErr:     types.ErrRegistrationDisabled,
+		},
+		{
+			name:       "user pays the fee",
+			params:     types.Params{PermissionlessRegistration: true, RegistrationFee: fee, MaxVerifyingKeySize: types.DefaultMaxVerifyingKeySize},
+			registrant: func(Keeper) sdk.AccAddress { return user },
+			key:        f.Key,
+			expFee:     fee,
+		},
+		{
+			name:       "key over the size limit",
+			params:     types.Params{PermissionlessRegistration: true, MaxVerifyingKeySize: uint64(len(f.Key) - 1)},
+			registrant: func(Keeper) sdk.AccAddress { return user },
+			key:        f.Key,
+			expErr:     types.ErrInvalidVerifyingKey,
+		},
+		{
+			name:       "malformed key",
+			params:     types.Params{PermissionlessRegistration: true, RegistrationFee: fee, MaxVerifyingKeySize: types.DefaultMaxVerifyingKeySize},
+			registrant: func(Keeper) sdk.AccAddress { return user },
+			key:        f.Key[:len(f.Key)-1],
+			expErr:     types.ErrInvalidVerifyingKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, dk := setupKeeper(t)
+			k.SetParams(ctx, tc.params)
+			registrant := tc.registrant(k)
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			vk, err := k.RegisterVerifyingKey(ctx, registrant, f.ProofSystem, f.Curve, tc.key)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Empty(t, k.GetAllVerifyingKeys(ctx))
+				require.Empty(t, dk.funded)
+				return
+			}
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, types.GasRegisterVerifyingKeyPerByte*uint64(len(tc.key)))
+			require.Equal(t, registrant.String(), vk.Registrant)
+			require.Equal(t, tc.expFee, dk.funded[registrant.String()])
+
+			stored, found := k.GetVerifyingKey(ctx, vk.Id)
+			require.True(t, found)
+			require.Equal(t, vk, stored)
+
+			_, err = k.RegisterVerifyingKey(ctx, registrant, f.ProofSystem, f.Curve, tc.key)
+			require.ErrorIs(t, err, types.ErrVerifyingKeyExists)
+			// The same key in its stored encoding has the same id.
+			_, err = k.RegisterVerifyingKey(ctx, registrant, f.ProofSystem, f.Curve, vk.Key)
+			require.ErrorIs(t, err, types.ErrVerifyingKeyExists)
+		})
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	f, err := testutil.NewFixture(types.ProofSystemPlonk, types.CurveBLS12381)
+	require.NoError(t, err)
+	vk, err := k.RegisterVerifyingKey(ctx, k.authority, f.ProofSystem, f.Curve, f.Key)
+	require.NoError(t, err)
+
+	require.NoError(t, k.VerifyProof(ctx, vk.Id, f.Proof, f.PublicInputs))
+
+	err = k.VerifyProof(ctx, vk.Id, f.Proof, []*big.Int{big.NewInt(9), big.NewInt(13)})
+	require.ErrorIs(t, err, types.ErrProofVerificationFailed)
+
+	err = k.VerifyProof(ctx, make([]byte, types.VerifyingKeyIDLength), f.Proof, f.PublicInputs)
+	require.ErrorIs(t, err, types.ErrVerifyingKeyNotFound)
+}
+
+func TestQueryVerifyingKeys(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	var ids [][]byte
+	for _, system := range []types.ProofSystem{types.ProofSystemGroth16, types.ProofSystemPlonk} {
+		f, err := testutil.NewFixture(system, types.CurveBN254)
+		require.NoError(t, err)
+		vk, err := k.RegisterVerifyingKey(ctx, k.authority, f.ProofSystem, f.Curve, f.Key)
+		require.NoError(t, err)
+		ids = append(ids, vk.Id)
+	}
+
+	res, err := k.VerifyingKey(ctx, &types.QueryVerifyingKeyRequest{Id: ids[0]})
+	require.NoError(t, err)
+	require.Equal(t, ids[0], res.VerifyingKey.Id)
+
+	_, err = k.VerifyingKey(ctx, &types.QueryVerifyingKeyRequest{Id: ids[0][:1]})
+	require.ErrorContains(t, err, "id must be")
+
+	_, err = k.VerifyingKey(ctx, &types.QueryVerifyingKeyRequest{Id: make([]byte, types.VerifyingKeyIDLength)})
+	require.ErrorIs(t, err, types.ErrVerifyingKeyNotFound)
+
+	page, err := k.VerifyingKeys(ctx, &types.QueryVerifyingKeysRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, page.VerifyingKeys, 1)
+	require.Equal(t, uint64(2), page.Pagination.Total)
+
+	all, err := k.VerifyingKeys(ctx, &types.QueryVerifyingKeysRequest{})
+	require.NoError(t, err)
+	require.Equal(t, k.GetAllVerifyingKeys(ctx), all.VerifyingKeys)
+}
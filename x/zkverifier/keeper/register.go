@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/x/zkverifier/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterVerifyingKey decodes and stores a verifying key and returns it.
+// Accounts other than the governance authority can only register keys when
+// permissionless registration is on, and pay the registration fee to the
+// community pool. Decoding the key is charged per byte.
+func (k Keeper) RegisterVerifyingKey(
+	ctx sdk.Context,
+	registrant sdk.AccAddress,
+	system types.ProofSystem,
+	curve types.Curve,
+	key []byte,
+) (types.VerifyingKey, error) {
+	params := k.GetParams(ctx)
+	isAuthority := registrant.Equals(k.authority)
+	if !isAuthority && !params.PermissionlessRegistration {
+		return types.VerifyingKey{}, types.ErrRegistrationDisabled
+	}
+	if uint64(len(key)) > params.MaxVerifyingKeySize {
+		return types.VerifyingKey{}, types.ErrInvalidVerifyingKey.Wrapf(
+			"key is %d bytes, the maximum is %d", len(key), params.MaxVerifyingKeySize,
+		)
+	}
+
+	ctx.GasMeter().ConsumeGas(types.GasRegisterVerifyingKeyPerByte*uint64(len(key)), "zkverifier register verifying key")
+
+	vk, err := types.NewVerifyingKey(system, curve, key, registrant.String())
+	if err != nil {
+		return types.VerifyingKey{}, err
+	}
+	if _, found := k.GetVerifyingKey(ctx, vk.Id); found {
+		return types.VerifyingKey{}, types.ErrVerifyingKeyExists.Wrapf("0x%x", vk.Id)
+	}
+
+	if !isAuthority && !params.RegistrationFee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, params.RegistrationFee, registrant); err != nil {
+			return types.VerifyingKey{}, err
+		}
+	}
+
+	k.SetVerifyingKey(ctx, vk)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterVerifyingKey,
+			sdk.NewAttribute(types.AttributeKeyID, hexutil.Encode(vk.Id)),
+			sdk.NewAttribute(types.AttributeKeyProofSystem, vk.ProofSystem.String()),
+			sdk.NewAttribute(types.AttributeKeyCurve, vk.Curve.String()),
+			sdk.NewAttribute(types.AttributeKeyRegistrant, vk.Registrant),
+		),
+	)
+	return vk, nil
+}
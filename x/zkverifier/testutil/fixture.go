@@ -0,0 +1,119 @@
+package testutil
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/test/unsafekzg"
+
+	"github.com/cosmos/evm/x/zkverifier/types"
+)
+
+// squareCircuit proves knowledge of X such that Y = X² and Z = X + Y. It
+// commits to X so the proofs carry a BSB22 commitment.
+type squareCircuit struct {
+	X frontend.Variable
+	Y frontend.Variable `gnark:",public"`
+	Z frontend.Variable `gnark:",public"`
+}
+
+func (c *squareCircuit) Define(api frontend.API) error {
+	y := api.Mul(c.X, c.X)
+	api.AssertIsEqual(y, c.Y)
+	api.AssertIsEqual(api.Add(c.X, y), c.Z)
+	cmt, err := api.(frontend.Committer).Commit(c.X)
+	if err != nil {
+		return err
+	}
+	api.AssertIsDifferent(cmt, 0)
+	return nil
+}
+
+// Fixture is a verifying key of a small test circuit with a valid proof.
+type Fixture struct {
+	ProofSystem types.ProofSystem
+	Curve       types.Curve
+	// Key is the verifying key in gnark's compressed binary encoding.
+	Key []byte
+	// Proof is a proof for PublicInputs in gnark's binary encoding.
+	Proof        []byte
+	PublicInputs []*big.Int
+}
+
+// NewFixture runs an insecure setup of the test circuit for the proof system
+// and curve and proves it for X = 3. It is meant for tests only.
+func NewFixture(system types.ProofSystem, curve types.Curve) (Fixture, error) {
+	if err := system.Validate(); err != nil {
+		return Fixture{}, err
+	}
+	curveID, err := curve.CurveID()
+	if err != nil {
+		return Fixture{}, err
+	}
+
+	assignment := &squareCircuit{X: 3, Y: 9, Z: 12}
+	fullWitness, err := frontend.NewWitness(assignment, curveID.ScalarField())
+	if err != nil {
+		return Fixture{}, err
+	}
+
+	var vk, proof io.WriterTo
+	if system == types.ProofSystemGroth16 {
+		ccs, err := frontend.Compile(curveID.ScalarField(), r1cs.NewBuilder, &squareCircuit{})
+		if err != nil {
+			return Fixture{}, err
+		}
+		pk, groth16VK, err := groth16.Setup(ccs)
+		if err != nil {
+			return Fixture{}, err
+		}
+		if proof, err = groth16.Prove(ccs, pk, fullWitness); err != nil {
+			return Fixture{}, err
+		}
+		vk = groth16VK
+	} else {
+		ccs, err := frontend.Compile(curveID.ScalarField(), scs.NewBuilder, &squareCircuit{})
+		if err != nil {
+			return Fixture{}, err
+		}
+		pk, plonkVK, err := plonkSetup(ccs)
+		if err != nil {
+			return Fixture{}, err
+		}
+		if proof, err = plonk.Prove(ccs, pk, fullWitness); err != nil {
+			return Fixture{}, err
+		}
+		vk = plonkVK
+	}
+
+	var key, proofBz bytes.Buffer
+	if _, err := vk.WriteTo(&key); err != nil {
+		return Fixture{}, err
+	}
+	if _, err := proof.WriteTo(&proofBz); err != nil {
+		return Fixture{}, err
+	}
+
+	return Fixture{
+		ProofSystem:  system,
+		Curve:        curve,
+		Key:          key.Bytes(),
+		Proof:        proofBz.Bytes(),
+		PublicInputs: []*big.Int{big.NewInt(9), big.NewInt(12)},
+	}, nil
+}
+
+func plonkSetup(ccs constraint.ConstraintSystem) (plonk.ProvingKey, plonk.VerifyingKey, error) {
+	srs, srsLagrange, err := unsafekzg.NewSRS(ccs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plonk.Setup(ccs, srs, srsLagrange)
+}
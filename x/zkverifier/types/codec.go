@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var AminoCdc = codec.NewLegacyAmino()
+
+const (
+	msgRegisterVerifyingKeyName = "cosmos/evm/x/zkverifier/MsgRegisterVerifyingKey"
+	msgUpdateParamsName         = "cosmos/evm/x/zkverifier/MsgUpdateParams"
+)
+
+func init() {
+	RegisterLegacyAminoCodec(AminoCdc)
+	AminoCdc.Seal()
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterVerifyingKey{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterVerifyingKey{}, msgRegisterVerifyingKeyName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, msgUpdateParamsName, nil)
+}
@@ -0,0 +1,255 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+)
+
+// encodingScanner walks gnark's binary encoding of verifying keys and proofs
+// without decoding it. gnark allocates every slice from its length prefix
+// before reading the elements, so a forged prefix exhausts the memory of the
+// node. Scanning first bounds every prefix by the bytes that are left.
+type encodingScanner struct {
+	buf []byte
+
+	g1Size, g2Size int // compressed point sizes
+	frSize         int
+	linesSize      int // precomputed KZG pairing lines of Plonk keys
+	isCompressed   func(msb byte) bool
+}
+
+func newEncodingScanner(curve Curve, buf []byte) (*encodingScanner, error) {
+	switch curve {
+	case CurveBN254:
+		return &encodingScanner{
+			buf:       buf,
+			g1Size:    bn254.SizeOfG1AffineCompressed,
+			g2Size:    bn254.SizeOfG2AffineCompressed,
+			frSize:    fr_bn254.Bytes,
+			linesSize: binary.Size(kzg_bn254.VerifyingKey{}.Lines),
+			// The two most significant bits are 0b00 for uncompressed points.
+			isCompressed: func(msb byte) bool { return msb>>6 != 0b00 },
+		}, nil
+	case CurveBLS12381:
+		return &encodingScanner{
+			buf:       buf,
+			g1Size:    bls12381.SizeOfG1AffineCompressed,
+			g2Size:    bls12381.SizeOfG2AffineCompressed,
+			frSize:    fr_bls12381.Bytes,
+			linesSize: binary.Size(kzg_bls12381.VerifyingKey{}.Lines),
+			// The three most significant bits are 0b000, or 0b010 for the
+			// point at infinity, for uncompressed points.
+			isCompressed: func(msb byte) bool { return msb>>5 != 0b000 && msb>>5 != 0b010 },
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported curve %s", curve)
+	}
+}
+
+func (s *encodingScanner) skip(n int) error {
+	if n < 0 || n > len(s.buf) {
+		return fmt.Errorf("unexpected end of input")
+	}
+	s.buf = s.buf[n:]
+	return nil
+}
+
+func (s *encodingScanner) uint32() (int, error) {
+	if len(s.buf) < 4 {
+		return 0, fmt.Errorf("unexpected end of input")
+	}
+	n := binary.BigEndian.Uint32(s.buf)
+	s.buf = s.buf[4:]
+	return int(n), nil
+}
+
+// sliceLen reads a slice length prefix and checks that the elements, of at
+// least minSize bytes each, fit in the rest of the input.
+func (s *encodingScanner) sliceLen(minSize int) (int, error) {
+	n, err := s.uint32()
+	if err != nil {
+		return 0, err
+	}
+	if n > len(s.buf)/minSize {
+		return 0, fmt.Errorf("slice length %d exceeds the input", n)
+	}
+	return n, nil
+}
+
+func (s *encodingScanner) point(compressedSize int) error {
+	if len(s.buf) == 0 {
+		return fmt.Errorf("unexpected end of input")
+	}
+	if s.isCompressed(s.buf[0]) {
+		return s.skip(compressedSize)
+	}
+	return s.skip(2 * compressedSize)
+}
+
+func (s *encodingScanner) g1() error { return s.point(s.g1Size) }
+
+func (s *encodingScanner) g2() error { return s.point(s.g2Size) }
+
+func (s *encodingScanner) fr() error { return s.skip(s.frSize) }
+
+// g1Slice scans a slice of G1 points and returns its length.
+func (s *encodingScanner) g1Slice() (int, error) {
+	n, err := s.sliceLen(s.g1Size)
+	if err != nil {
+		return 0, err
+	}
+	for range n {
+		if err := s.g1(); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func (s *encodingScanner) frSlice() error {
+	n, err := s.sliceLen(s.frSize)
+	if err != nil {
+		return err
+	}
+	return s.skip(n * s.frSize)
+}
+
+func (s *encodingScanner) uint64Slice() error {
+	n, err := s.sliceLen(8)
+	if err != nil {
+		return err
+	}
+	return s.skip(n * 8)
+}
+
+func (s *encodingScanner) uint64SliceSlice() error {
+	n, err := s.sliceLen(4)
+	if err != nil {
+		return err
+	}
+	for range n {
+		if err := s.uint64Slice(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *encodingScanner) end() error {
+	if len(s.buf) != 0 {
+		return fmt.Errorf("%d trailing bytes", len(s.buf))
+	}
+	return nil
+}
+
+// scanVerifyingKey checks that key is laid out as a gnark verifying key of the
+// proof system, with every length prefix bounded by the size of key.
+func scanVerifyingKey(system ProofSystem, curve Curve, key []byte) error {
+	s, err := newEncodingScanner(curve, key)
+	if err != nil {
+		return err
+	}
+	if system == ProofSystemGroth16 {
+		// [α]1, [β]1, [β]2, [γ]2, [δ]1, [δ]2, K, the committed public inputs
+		// and the Pedersen commitment keys, each a pair of G2 points.
+		for _, point := range []func() error{s.g1, s.g1, s.g2, s.g2, s.g1, s.g2} {
+			if err := point(); err != nil {
+				return err
+			}
+		}
+		if _, err := s.g1Slice(); err != nil {
+			return err
+		}
+		if err := s.uint64SliceSlice(); err != nil {
+			return err
+		}
+		nbCommitments, err := s.sliceLen(2 * s.g2Size)
+		if err != nil {
+			return err
+		}
+		for range 2 * nbCommitments {
+			if err := s.g2(); err != nil {
+				return err
+			}
+		}
+		return s.end()
+	}
+
+	// Size, SizeInv, Generator, NbPublicVariables, CosetShift, S[0..2],
+	// Ql, Qr, Qm, Qo, Qk, Qcp, the KZG key and the commitment constraints.
+	for _, field := range []func() error{
+		func() error { return s.skip(8) }, s.fr, s.fr, func() error { return s.skip(8) }, s.fr,
+		s.g1, s.g1, s.g1, s.g1, s.g1, s.g1, s.g1, s.g1,
+	} {
+		if err := field(); err != nil {
+			return err
+		}
+	}
+	if _, err := s.g1Slice(); err != nil {
+		return err
+	}
+	for _, field := range []func() error{s.g1, s.g2, s.g2, func() error { return s.skip(s.linesSize) }} {
+		if err := field(); err != nil {
+			return err
+		}
+	}
+	if err := s.uint64Slice(); err != nil {
+		return err
+	}
+	return s.end()
+}
+
+// scanProof checks that proof is laid out as a gnark proof of the proof
+// system with nbCommitments BSB22 commitments.
+func scanProof(system ProofSystem, curve Curve, nbCommitments uint32, proof []byte) error {
+	s, err := newEncodingScanner(curve, proof)
+	if err != nil {
+		return err
+	}
+	var commitments int
+	if system == ProofSystemGroth16 {
+		// Ar, Bs, Krs, the commitments and their proof of knowledge.
+		for _, point := range []func() error{s.g1, s.g2, s.g1} {
+			if err := point(); err != nil {
+				return err
+			}
+		}
+		if commitments, err = s.g1Slice(); err != nil {
+			return err
+		}
+		if err := s.g1(); err != nil {
+			return err
+		}
+	} else {
+		// LRO, Z, H, the batched opening with its claimed values, the opening
+		// at the shifted point and the commitments.
+		for range 8 {
+			if err := s.g1(); err != nil {
+				return err
+			}
+		}
+		if err := s.frSlice(); err != nil {
+			return err
+		}
+		if err := s.g1(); err != nil {
+			return err
+		}
+		if err := s.fr(); err != nil {
+			return err
+		}
+		if commitments, err = s.g1Slice(); err != nil {
+			return err
+		}
+	}
+	if commitments != int(nbCommitments) {
+		return fmt.Errorf("expected %d commitments, got %d", nbCommitments, commitments)
+	}
+	return s.end()
+}
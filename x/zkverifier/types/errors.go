@@ -0,0 +1,13 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrInvalidVerifyingKey     = errorsmod.Register(ModuleName, 2, "invalid verifying key")
+	ErrVerifyingKeyExists      = errorsmod.Register(ModuleName, 3, "verifying key already registered")
+	ErrVerifyingKeyNotFound    = errorsmod.Register(ModuleName, 4, "verifying key not found")
+	ErrRegistrationDisabled    = errorsmod.Register(ModuleName, 5, "verifying key registration is restricted to governance")
+	ErrInvalidProof            = errorsmod.Register(ModuleName, 6, "invalid proof")
+	ErrInvalidPublicInputs     = errorsmod.Register(ModuleName, 7, "invalid public inputs")
+	ErrProofVerificationFailed = errorsmod.Register(ModuleName, 8, "proof verification failed")
+)
@@ -0,0 +1,11 @@
+package types
+
+// zkverifier events
+const (
+	EventTypeRegisterVerifyingKey = "register_verifying_key"
+
+	AttributeKeyID          = "id"
+	AttributeKeyProofSystem = "proof_system"
+	AttributeKeyCurve       = "curve"
+	AttributeKeyRegistrant  = "registrant"
+)
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+)
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:        DefaultParams(),
+		VerifyingKeys: []VerifyingKey{},
+	}
+}
+
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.VerifyingKeys))
+	for _, vk := range gs.VerifyingKeys {
+		if _, ok := seen[string(vk.Id)]; ok {
+			return fmt.Errorf("duplicate verifying key 0x%x", vk.Id)
+		}
+		seen[string(vk.Id)] = struct{}{}
+
+		if err := vk.Validate(); err != nil {
+			return fmt.Errorf("invalid verifying key 0x%x: %w", vk.Id, err)
+		}
+	}
+	return nil
+}
+
+// Validate decodes the key with all the registration checks and compares the
+// result with the stored id and shape, since stored keys are later decoded
+// without them.
+func (vk VerifyingKey) Validate() error {
+	parsed, err := NewVerifyingKey(vk.ProofSystem, vk.Curve, vk.Key, vk.Registrant)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(parsed.Key, vk.Key) {
+		return fmt.Errorf("key is not in the uncompressed encoding")
+	}
+	if !bytes.Equal(parsed.Id, vk.Id) {
+		return fmt.Errorf("id does not match the key, expected 0x%x", parsed.Id)
+	}
+	if parsed.NbPublicInputs != vk.NbPublicInputs || parsed.NbCommitments != vk.NbCommitments {
+		return fmt.Errorf(
+			"expected %d public inputs and %d commitments, got %d and %d",
+			parsed.NbPublicInputs, parsed.NbCommitments, vk.NbPublicInputs, vk.NbCommitments,
+		)
+	}
+	return nil
+}
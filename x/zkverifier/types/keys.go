@@ -0,0 +1,24 @@
+package types
+
+const (
+	ModuleName = "zkverifier"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+)
+
+const (
+	prefixParams = iota + 1
+	prefixVerifyingKey
+)
+
+var (
+	KeyParams             = []byte{prefixParams}
+	KeyPrefixVerifyingKey = []byte{prefixVerifyingKey}
+)
+
+// GetVerifyingKeyKey returns the key of a registered verifying key.
+func GetVerifyingKeyKey(id []byte) []byte {
+	return append(append([]byte{}, KeyPrefixVerifyingKey...), id...)
+}
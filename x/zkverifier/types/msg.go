@@ -0,0 +1,57 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterVerifyingKey{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// ValidateBasic only checks the encoding-independent fields. The key itself
+// is decoded by the msg server, where the size limit from the params is known.
+func (m *MsgRegisterVerifyingKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	if err := m.ProofSystem.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+	if _, err := m.Curve.CurveID(); err != nil {
+		return errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+	if len(m.Key) == 0 {
+		return errorsmod.Wrap(ErrInvalidVerifyingKey, "key cannot be empty")
+	}
+	return nil
+}
+
+func (m MsgRegisterVerifyingKey) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if m.Params == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "params cannot be nil")
+	}
+	return m.Params.Validate()
+}
+
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,30 @@
+package types
+
+import "fmt"
+
+// DefaultMaxVerifyingKeySize fits a Groth16 key with a few thousand public
+// inputs and any Plonk key.
+const DefaultMaxVerifyingKeySize = 256 * 1024
+
+// GasRegisterVerifyingKeyPerByte is charged for every byte of a registered
+// key, on top of the storage cost, for the curve and subgroup checks of its
+// points.
+const GasRegisterVerifyingKeyPerByte = 100
+
+// DefaultParams only lets governance register verifying keys.
+func DefaultParams() Params {
+	return Params{
+		PermissionlessRegistration: false,
+		MaxVerifyingKeySize:        DefaultMaxVerifyingKeySize,
+	}
+}
+
+func (p Params) Validate() error {
+	if err := p.RegistrationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid registration_fee: %w", err)
+	}
+	if p.MaxVerifyingKeySize == 0 {
+		return fmt.Errorf("max_verifying_key_size must be positive")
+	}
+	return nil
+}
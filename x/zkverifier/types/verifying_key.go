@@ -0,0 +1,230 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16_bls12381 "github.com/consensys/gnark/backend/groth16/bls12-381"
+	groth16_bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/plonk"
+	plonk_bls12381 "github.com/consensys/gnark/backend/plonk/bls12-381"
+	plonk_bn254 "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/consensys/gnark/backend/witness"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate checks that the proof system is supported.
+func (s ProofSystem) Validate() error {
+	switch s {
+	case ProofSystemGroth16, ProofSystemPlonk:
+		return nil
+	default:
+		return fmt.Errorf("unsupported proof system %s", s)
+	}
+}
+
+// CurveID returns the gnark id of the curve.
+func (c Curve) CurveID() (ecc.ID, error) {
+	switch c {
+	case CurveBN254:
+		return ecc.BN254, nil
+	case CurveBLS12381:
+		return ecc.BLS12_381, nil
+	default:
+		return ecc.UNKNOWN, fmt.Errorf("unsupported curve %s", c)
+	}
+}
+
+// VerifyingKeyIDLength is the length of a verifying key id.
+const VerifyingKeyIDLength = sha256.Size
+
+// VerifyingKeyID returns the id of a verifying key in its stored, uncompressed
+// encoding. The same key therefore gets the same id whichever encoding it was
+// registered with.
+func VerifyingKeyID(system ProofSystem, curve Curve, key []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{byte(system), byte(curve)})
+	h.Write(key)
+	return h.Sum(nil)
+}
+
+// gnarkVerifyingKey is implemented by the Groth16 and Plonk verifying keys of
+// every curve.
+type gnarkVerifyingKey interface {
+	io.ReaderFrom
+	WriteRawTo(w io.Writer) (int64, error)
+	UnsafeReadFrom(r io.Reader) (int64, error)
+}
+
+func newGnarkVerifyingKey(system ProofSystem, curve Curve) (gnarkVerifyingKey, ecc.ID, error) {
+	if err := system.Validate(); err != nil {
+		return nil, ecc.UNKNOWN, err
+	}
+	curveID, err := curve.CurveID()
+	if err != nil {
+		return nil, ecc.UNKNOWN, err
+	}
+	if system == ProofSystemGroth16 {
+		return groth16.NewVerifyingKey(curveID), curveID, nil
+	}
+	return plonk.NewVerifyingKey(curveID), curveID, nil
+}
+
+// NewVerifyingKey decodes key, checking that every point is on the curve and
+// in the prime-order subgroup, and returns the record to store. The key is
+// stored uncompressed so that verification can skip the checks.
+func NewVerifyingKey(system ProofSystem, curve Curve, key []byte, registrant string) (VerifyingKey, error) {
+	vk, _, err := newGnarkVerifyingKey(system, curve)
+	if err != nil {
+		return VerifyingKey{}, errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+	if err := scanVerifyingKey(system, curve, key); err != nil {
+		return VerifyingKey{}, errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+	n, err := vk.ReadFrom(bytes.NewReader(key))
+	if err != nil {
+		return VerifyingKey{}, errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+	if n != int64(len(key)) {
+		return VerifyingKey{}, errorsmod.Wrapf(ErrInvalidVerifyingKey, "%d trailing bytes", int64(len(key))-n)
+	}
+
+	nbPublicInputs, nbCommitments, err := verifyingKeyShape(vk)
+	if err != nil {
+		return VerifyingKey{}, errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+
+	var raw bytes.Buffer
+	if _, err := vk.WriteRawTo(&raw); err != nil {
+		return VerifyingKey{}, errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+
+	return VerifyingKey{
+		Id:             VerifyingKeyID(system, curve, raw.Bytes()),
+		ProofSystem:    system,
+		Curve:          curve,
+		Key:            raw.Bytes(),
+		NbPublicInputs: nbPublicInputs,
+		NbCommitments:  nbCommitments,
+		Registrant:     registrant,
+	}, nil
+}
+
+// verifyingKeyShape returns the number of public inputs and BSB22 commitments
+// of a decoded key.
+func verifyingKeyShape(vk gnarkVerifyingKey) (nbPublicInputs, nbCommitments uint32, err error) {
+	var nbPublic, nbCommit int
+	switch vk := vk.(type) {
+	case *groth16_bn254.VerifyingKey:
+		// K holds a base for the constant one wire, one per public input and
+		// one per commitment.
+		nbPublic = len(vk.G1.K) - len(vk.PublicAndCommitmentCommitted) - 1
+		nbCommit = len(vk.CommitmentKeys)
+	case *groth16_bls12381.VerifyingKey:
+		nbPublic = len(vk.G1.K) - len(vk.PublicAndCommitmentCommitted) - 1
+		nbCommit = len(vk.CommitmentKeys)
+	case *plonk_bn254.VerifyingKey:
+		nbPublic = int(vk.NbPublicVariables)
+		nbCommit = len(vk.Qcp)
+	case *plonk_bls12381.VerifyingKey:
+		nbPublic = int(vk.NbPublicVariables)
+		nbCommit = len(vk.Qcp)
+	default:
+		return 0, 0, fmt.Errorf("unsupported verifying key type %T", vk)
+	}
+	if nbPublic < 0 || nbPublic > maxUint32 || nbCommit > maxUint32 {
+		return 0, 0, fmt.Errorf("malformed verifying key")
+	}
+	return uint32(nbPublic), uint32(nbCommit), nil
+}
+
+const maxUint32 = 1<<32 - 1
+
+// Verify checks proof against the key and the public inputs. It returns
+// ErrProofVerificationFailed for a well-formed proof that does not verify and
+// ErrInvalidProof or ErrInvalidPublicInputs for malformed input.
+func (vk VerifyingKey) Verify(proof []byte, publicInputs []*big.Int) error {
+	gnarkVK, curveID, err := newGnarkVerifyingKey(vk.ProofSystem, vk.Curve)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+	// The key was checked when it was registered.
+	if _, err := gnarkVK.UnsafeReadFrom(bytes.NewReader(vk.Key)); err != nil {
+		return errorsmod.Wrap(ErrInvalidVerifyingKey, err.Error())
+	}
+
+	publicWitness, err := newPublicWitness(curveID, vk.NbPublicInputs, publicInputs)
+	if err != nil {
+		return err
+	}
+
+	if err := scanProof(vk.ProofSystem, vk.Curve, vk.NbCommitments, proof); err != nil {
+		return errorsmod.Wrap(ErrInvalidProof, err.Error())
+	}
+
+	switch vk.ProofSystem {
+	case ProofSystemGroth16:
+		p := groth16.NewProof(curveID)
+		if err := readProof(p, proof); err != nil {
+			return err
+		}
+		err = groth16.Verify(p, gnarkVK.(groth16.VerifyingKey), publicWitness)
+	default:
+		p := plonk.NewProof(curveID)
+		if err := readProof(p, proof); err != nil {
+			return err
+		}
+		err = plonk.Verify(p, gnarkVK.(plonk.VerifyingKey), publicWitness)
+	}
+	if err != nil {
+		return errorsmod.Wrap(ErrProofVerificationFailed, err.Error())
+	}
+	return nil
+}
+
+// readProof decodes a proof in gnark's binary encoding, once scanProof has
+// bounded its length prefixes. Points are checked to be on the curve and in
+// the prime-order subgroup.
+func readProof(p io.ReaderFrom, proof []byte) error {
+	n, err := p.ReadFrom(bytes.NewReader(proof))
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidProof, err.Error())
+	}
+	if n != int64(len(proof)) {
+		return errorsmod.Wrapf(ErrInvalidProof, "%d trailing bytes", int64(len(proof))-n)
+	}
+	return nil
+}
+
+// newPublicWitness builds the public witness, rejecting inputs that are not
+// canonical scalar field elements rather than reducing them.
+func newPublicWitness(curveID ecc.ID, nbPublicInputs uint32, publicInputs []*big.Int) (witness.Witness, error) {
+	if len(publicInputs) != int(nbPublicInputs) {
+		return nil, errorsmod.Wrapf(ErrInvalidPublicInputs, "expected %d public inputs, got %d", nbPublicInputs, len(publicInputs))
+	}
+
+	modulus := curveID.ScalarField()
+	values := make(chan any, len(publicInputs))
+	for i, input := range publicInputs {
+		if input == nil || input.Sign() < 0 || input.Cmp(modulus) >= 0 {
+			return nil, errorsmod.Wrapf(ErrInvalidPublicInputs, "public input %d is not in the scalar field", i)
+		}
+		values <- input
+	}
+	close(values)
+
+	w, err := witness.New(modulus)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPublicInputs, err.Error())
+	}
+	if err := w.Fill(len(publicInputs), 0, values); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidPublicInputs, err.Error())
+	}
+	return w, nil
+}
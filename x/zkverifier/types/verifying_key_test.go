@@ -0,0 +1,214 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/zkverifier/testutil"
+	"github.com/cosmos/evm/x/zkverifier/types"
+)
+
+func fixtures(t *testing.T) []testutil.Fixture {
+	t.Helper()
+	var out []testutil.Fixture
+	for _, system := range []types.ProofSystem{types.ProofSystemGroth16, types.ProofSystemPlonk} {
+		for _, curve := range []types.Curve{types.CurveBN254, types.CurveBLS12381} {
+			f, err := testutil.NewFixture(system, curve)
+			require.NoError(t, err)
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// invalidPoint overwrites the first point with an x-coordinate larger than
+// the base field modulus.
+func invalidPoint(bz []byte) []byte {
+	out := append([]byte{}, bz...)
+	for i := 0; i < 8; i++ {
+		out[i] = 0xff
+	}
+	return out
+}
+
+func TestVerifyingKeyVerify(t *testing.T) {
+	for _, f := range fixtures(t) {
+		t.Run(f.ProofSystem.String()+"/"+f.Curve.String(), func(t *testing.T) {
+			vk, err := types.NewVerifyingKey(f.ProofSystem, f.Curve, f.Key, "registrant")
+			require.NoError(t, err)
+			require.Equal(t, uint32(2), vk.NbPublicInputs)
+			require.Equal(t, uint32(1), vk.NbCommitments)
+			require.Len(t, vk.Id, types.VerifyingKeyIDLength)
+			require.Equal(t, types.VerifyingKeyID(f.ProofSystem, f.Curve, vk.Key), vk.Id)
+			require.NoError(t, vk.Validate())
+
+			// The stored key is uncompressed and decodes to the same id.
+			stored, err := types.NewVerifyingKey(f.ProofSystem, f.Curve, vk.Key, "registrant")
+			require.NoError(t, err)
+			require.Equal(t, vk, stored)
+
+			modulus, err := f.Curve.CurveID()
+			require.NoError(t, err)
+
+			testCases := []struct {
+				name         string
+				proof        []byte
+				publicInputs []*big.Int
+				expErr       error
+			}{
+				{"valid proof", f.Proof, f.PublicInputs, nil},
+				{"wrong public input", f.Proof, []*big.Int{big.NewInt(9), big.NewInt(13)}, types.ErrProofVerificationFailed},
+				{"too few public inputs", f.Proof, f.PublicInputs[:1], types.ErrInvalidPublicInputs},
+				{"nil public input", f.Proof, []*big.Int{big.NewInt(9), nil}, types.ErrInvalidPublicInputs},
+				{"negative public input", f.Proof, []*big.Int{big.NewInt(9), big.NewInt(-1)}, types.ErrInvalidPublicInputs},
+				{"public input out of field", f.Proof, []*big.Int{big.NewInt(9), modulus.ScalarField()}, types.ErrInvalidPublicInputs},
+				{"truncated proof", f.Proof[:len(f.Proof)-1], f.PublicInputs, types.ErrInvalidProof},
+				{"trailing bytes", append(append([]byte{}, f.Proof...), 0), f.PublicInputs, types.ErrInvalidProof},
+				{"invalid point", invalidPoint(f.Proof), f.PublicInputs, types.ErrInvalidProof},
+			}
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					err := vk.Verify(tc.proof, tc.publicInputs)
+					if tc.expErr == nil {
+						require.NoError(t, err)
+						return
+					}
+					require.ErrorIs(t, err, tc.expErr)
+				})
+			}
+		})
+	}
+}
+
+func TestNewVerifyingKeyInvalid(t *testing.T) {
+	f, err := testutil.NewFixture(types.ProofSystemGroth16, types.CurveBN254)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		system types.ProofSystem
+		curve  types.Curve
+		key    []byte
+	}{
+		{"unspecified proof system", types.ProofSystemUnspecified, types.CurveBN254, f.Key},
+		{"unspecified curve", types.ProofSystemGroth16, types.CurveUnspecified, f.Key},
+		{"wrong curve", types.ProofSystemGroth16, types.CurveBLS12381, f.Key},
+		{"wrong proof system", types.ProofSystemPlonk, types.CurveBN254, f.Key},
+		{"truncated key", types.ProofSystemGroth16, types.CurveBN254, f.Key[:len(f.Key)-1]},
+		{"trailing bytes", types.ProofSystemGroth16, types.CurveBN254, append(append([]byte{}, f.Key...), 0)},
+		{"invalid point", types.ProofSystemGroth16, types.CurveBN254, invalidPoint(f.Key)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.NewVerifyingKey(tc.system, tc.curve, tc.key, "")
+			require.ErrorIs(t, err, types.ErrInvalidVerifyingKey)
+		})
+	}
+}
+
+// forgeLength returns the first n bytes of bz followed by the length prefix
+// 0x7fffffff, which gnark would allocate up front.
+func forgeLength(bz []byte, n int) []byte {
+	return append(append([]byte{}, bz[:n]...), 0x7f, 0xff, 0xff, 0xff)
+}
+
+func TestForgedLengthPrefixes(t *testing.T) {
+	groth16Fixture, err := testutil.NewFixture(types.ProofSystemGroth16, types.CurveBN254)
+	require.NoError(t, err)
+	plonkFixture, err := testutil.NewFixture(types.ProofSystemPlonk, types.CurveBN254)
+	require.NoError(t, err)
+
+	// The fixtures use compressed BN254 points of 32 (G1) and 64 (G2) bytes.
+	keyTestCases := []struct {
+		name   string
+		system types.ProofSystem
+		key    []byte
+	}{
+		// [α]1, [β]1, [β]2, [γ]2, [δ]1, [δ]2, then len(K).
+		{"groth16 K", types.ProofSystemGroth16, forgeLength(groth16Fixture.Key, 288)},
+		// Size, SizeInv, Generator, NbPublicVariables, CosetShift, S and the
+		// five selectors, then len(Qcp).
+		{"plonk Qcp", types.ProofSystemPlonk, forgeLength(plonkFixture.Key, 368)},
+	}
+	for _, tc := range keyTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.NewVerifyingKey(tc.system, types.CurveBN254, tc.key, "")
+			require.ErrorIs(t, err, types.ErrInvalidVerifyingKey)
+			require.ErrorContains(t, err, "exceeds the input")
+		})
+	}
+
+	groth16VK, err := types.NewVerifyingKey(groth16Fixture.ProofSystem, groth16Fixture.Curve, groth16Fixture.Key, "")
+	require.NoError(t, err)
+	plonkVK, err := types.NewVerifyingKey(plonkFixture.ProofSystem, plonkFixture.Curve, plonkFixture.Key, "")
+	require.NoError(t, err)
+
+	// A commitment count other than the key's is rejected too.
+	extraCommitment := append([]byte{}, groth16Fixture.Proof[:128]...)
+	extraCommitment = append(extraCommitment, 0, 0, 0, 2)
+	extraCommitment = append(extraCommitment, groth16Fixture.Proof[132:]...)
+	extraCommitment = append(extraCommitment, groth16Fixture.Proof[132:164]...)
+
+	proofTestCases := []struct {
+		name   string
+		vk     types.VerifyingKey
+		proof  []byte
+		expErr string
+	}{
+		// Ar, Bs, Krs, then len(Commitments): the 132-byte proof that ran
+		// gnark out of memory.
+		{"groth16 commitments", groth16VK, forgeLength(groth16Fixture.Proof, 128), "exceeds the input"},
+		{"groth16 commitment count", groth16VK, extraCommitment, "expected 1 commitments, got 2"},
+		// LRO, Z, H and the batched opening, then len(ClaimedValues).
+		{"plonk claimed values", plonkVK, forgeLength(plonkFixture.Proof, 256), "exceeds the input"},
+	}
+	for _, tc := range proofTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.vk.Verify(tc.proof, groth16Fixture.PublicInputs)
+			require.ErrorIs(t, err, types.ErrInvalidProof)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	f, err := testutil.NewFixture(types.ProofSystemGroth16, types.CurveBN254)
+	require.NoError(t, err)
+	vk, err := types.NewVerifyingKey(f.ProofSystem, f.Curve, f.Key, "registrant")
+	require.NoError(t, err)
+
+	compressed := vk
+	compressed.Key = f.Key
+	wrongID := vk
+	wrongID.Id = make([]byte, types.VerifyingKeyIDLength)
+	wrongShape := vk
+	wrongShape.NbPublicInputs = 3
+	zeroMaxSize := types.DefaultParams()
+	zeroMaxSize.MaxVerifyingKeySize = 0
+
+	testCases := []struct {
+		name        string
+		genesis     types.GenesisState
+		expectError bool
+	}{
+		{"default", *types.DefaultGenesisState(), false},
+		{"with verifying key", types.GenesisState{Params: types.DefaultParams(), VerifyingKeys: []types.VerifyingKey{vk}}, false},
+		{"zero max key size", types.GenesisState{Params: zeroMaxSize}, true},
+		{"duplicate verifying key", types.GenesisState{Params: types.DefaultParams(), VerifyingKeys: []types.VerifyingKey{vk, vk}}, true},
+		{"compressed key", types.GenesisState{Params: types.DefaultParams(), VerifyingKeys: []types.VerifyingKey{compressed}}, true},
+		{"wrong id", types.GenesisState{Params: types.DefaultParams(), VerifyingKeys: []types.VerifyingKey{wrongID}}, true},
+		{"wrong shape", types.GenesisState{Params: types.DefaultParams(), VerifyingKeys: []types.VerifyingKey{wrongShape}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,940 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/zkverifier/v1/zkverifier.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProofSystem is the SNARK a verifying key belongs to.
+type ProofSystem int32
+
+const (
+	// PROOF_SYSTEM_UNSPECIFIED defines an invalid/undefined proof system.
+	ProofSystemUnspecified ProofSystem = 0
+	// PROOF_SYSTEM_GROTH16 is Groth16, including gnark's BSB22 commitments.
+	ProofSystemGroth16 ProofSystem = 1
+	// PROOF_SYSTEM_PLONK is gnark's KZG-based Plonk.
+	ProofSystemPlonk ProofSystem = 2
+)
+
+var ProofSystem_name = map[int32]string{
+	0: "PROOF_SYSTEM_UNSPECIFIED",
+	1: "PROOF_SYSTEM_GROTH16",
+	2: "PROOF_SYSTEM_PLONK",
+}
+
+var ProofSystem_value = map[string]int32{
+	"PROOF_SYSTEM_UNSPECIFIED": 0,
+	"PROOF_SYSTEM_GROTH16":     1,
+	"PROOF_SYSTEM_PLONK":       2,
+}
+
+func (x ProofSystem) String() string {
+	return proto.EnumName(ProofSystem_name, int32(x))
+}
+
+func (ProofSystem) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f6bc994c12890dfa, []int{0}
+}
+
+// Curve is the pairing-friendly curve a verifying key is defined over.
+type Curve int32
+
+const (
+	// CURVE_UNSPECIFIED defines an invalid/undefined curve.
+	CurveUnspecified Curve = 0
+	// CURVE_BN254 is BN254 (alt_bn128), the curve of the EVM pairing precompile.
+	CurveBN254 Curve = 1
+	// CURVE_BLS12_381 is BLS12-381.
+	CurveBLS12381 Curve = 2
+)
+
+var Curve_name = map[int32]string{
+	0: "CURVE_UNSPECIFIED",
+	1: "CURVE_BN254",
+	2: "CURVE_BLS12_381",
+}
+
+var Curve_value = map[string]int32{
+	"CURVE_UNSPECIFIED": 0,
+	"CURVE_BN254":       1,
+	"CURVE_BLS12_381":   2,
+}
+
+func (x Curve) String() string {
+	return proto.EnumName(Curve_name, int32(x))
+}
+
+func (Curve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f6bc994c12890dfa, []int{1}
+}
+
+// Params defines who may register verifying keys.
+type Params struct {
+	// permissionless_registration allows any account to register a verifying
+	// key by paying the registration fee. When false only the governance
+	// authority can register keys.
+	PermissionlessRegistration bool `protobuf:"varint,1,opt,name=permissionless_registration,json=permissionlessRegistration,proto3" json:"permissionless_registration,omitempty"`
+	// registration_fee is paid to the community pool by accounts other than
+	// the governance authority for every key they register.
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee"`
+	// max_verifying_key_size is the largest encoded verifying key, in bytes,
+	// that can be registered.
+	MaxVerifyingKeySize uint64 `protobuf:"varint,3,opt,name=max_verifying_key_size,json=maxVerifyingKeySize,proto3" json:"max_verifying_key_size,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6bc994c12890dfa, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetPermissionlessRegistration() bool {
+	if m != nil {
+		return m.PermissionlessRegistration
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxVerifyingKeySize() uint64 {
+	if m != nil {
+		return m.MaxVerifyingKeySize
+	}
+	return 0
+}
+
+// VerifyingKey is a registered verifying key.
+type VerifyingKey struct {
+	// id is the SHA-256 digest of the proof system, the curve and the key, see
+	// VerifyingKeyID.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proof_system is the SNARK the key belongs to.
+	ProofSystem ProofSystem `protobuf:"varint,2,opt,name=proof_system,json=proofSystem,proto3,enum=cosmos.evm.zkverifier.v1.ProofSystem" json:"proof_system,omitempty"`
+	// curve is the curve the key is defined over.
+	Curve Curve `protobuf:"varint,3,opt,name=curve,proto3,enum=cosmos.evm.zkverifier.v1.Curve" json:"curve,omitempty"`
+	// key is the verifying key in gnark's uncompressed binary encoding.
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// nb_public_inputs is the number of public inputs a proof is checked
+	// against.
+	NbPublicInputs uint32 `protobuf:"varint,5,opt,name=nb_public_inputs,json=nbPublicInputs,proto3" json:"nb_public_inputs,omitempty"`
+	// nb_commitments is the number of BSB22 commitments of the circuit.
+	NbCommitments uint32 `protobuf:"varint,6,opt,name=nb_commitments,json=nbCommitments,proto3" json:"nb_commitments,omitempty"`
+	// registrant is the account that registered the key.
+	Registrant string `protobuf:"bytes,7,opt,name=registrant,proto3" json:"registrant,omitempty"`
+}
+
+func (m *VerifyingKey) Reset()         { *m = VerifyingKey{} }
+func (m *VerifyingKey) String() string { return proto.CompactTextString(m) }
+func (*VerifyingKey) ProtoMessage()    {}
+func (*VerifyingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6bc994c12890dfa, []int{1}
+}
+func (m *VerifyingKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyingKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyingKey.Merge(m, src)
+}
+func (m *VerifyingKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyingKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyingKey proto.InternalMessageInfo
+
+func (m *VerifyingKey) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *VerifyingKey) GetProofSystem() ProofSystem {
+	if m != nil {
+		return m.ProofSystem
+	}
+	return ProofSystemUnspecified
+}
+
+func (m *VerifyingKey) GetCurve() Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return CurveUnspecified
+}
+
+func (m *VerifyingKey) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *VerifyingKey) GetNbPublicInputs() uint32 {
+	if m != nil {
+		return m.NbPublicInputs
+	}
+	return 0
+}
+
+func (m *VerifyingKey) GetNbCommitments() uint32 {
+	if m != nil {
+		return m.NbCommitments
+	}
+	return 0
+}
+
+func (m *VerifyingKey) GetRegistrant() string {
+	if m != nil {
+		return m.Registrant
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.evm.zkverifier.v1.ProofSystem", ProofSystem_name, ProofSystem_value)
+	proto.RegisterEnum("cosmos.evm.zkverifier.v1.Curve", Curve_name, Curve_value)
+	proto.RegisterType((*Params)(nil), "cosmos.evm.zkverifier.v1.Params")
+	proto.RegisterType((*VerifyingKey)(nil), "cosmos.evm.zkverifier.v1.VerifyingKey")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/evm/zkverifier/v1/zkverifier.proto", fileDescriptor_f6bc994c12890dfa)
+}
+
+var fileDescriptor_f6bc994c12890dfa = []byte{
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4d, 0x4f, 0xdb, 0x48,
+	0x1c, 0xc6, 0x33, 0xe1, 0x65, 0x77, 0x27, 0x10, 0xcc, 0x2c, 0x42, 0x5e, 0xaf, 0xe4, 0x58, 0x48,
+	0x54, 0x2e, 0x6d, 0xed, 0x3a, 0x29, 0x88, 0x5b, 0xa5, 0xa4, 0xe1, 0x45, 0x50, 0x12, 0x39, 0x80,
+	0xd4, 0x5e, 0x2c, 0x3b, 0x19, 0xc2, 0x28, 0x78, 0xc6, 0xf2, 0x38, 0x16, 0xa1, 0xc7, 0x5e, 0xaa,
+	0x9c, 0xfa, 0x05, 0x72, 0x69, 0x2f, 0x55, 0xa5, 0x4a, 0xfd, 0x18, 0x1c, 0x39, 0xf6, 0xd4, 0x56,
+	0x70, 0xe8, 0x87, 0xe8, 0xa5, 0xf2, 0x38, 0x08, 0x53, 0x95, 0x4b, 0x32, 0xf3, 0xcc, 0xef, 0x19,
+	0x3f, 0xff, 0xbf, 0xc7, 0x03, 0xef, 0xb7, 0x19, 0xf7, 0x19, 0x37, 0x71, 0xec, 0x9b, 0x67, 0xbd,
+	0x18, 0x87, 0xe4, 0x88, 0xe0, 0xd0, 0x8c, 0xad, 0xcc, 0xcc, 0x08, 0x42, 0x16, 0x31, 0x24, 0xa7,
+	0xa8, 0x81, 0x63, 0xdf, 0xc8, 0x2c, 0xc6, 0x96, 0x32, 0xef, 0xfa, 0x84, 0x32, 0x53, 0xfc, 0xa6,
+	0xb0, 0xa2, 0x8e, 0xf7, 0xf5, 0x5c, 0x8e, 0xcd, 0xd8, 0xf2, 0x70, 0xe4, 0x5a, 0x66, 0x9b, 0x11,
+	0x3a, 0x5e, 0x5f, 0xe8, 0xb2, 0x2e, 0x13, 0x43, 0x33, 0x19, 0xa5, 0xea, 0xd2, 0x4f, 0x00, 0xa7,
+	0x9b, 0x6e, 0xe8, 0xfa, 0x1c, 0x3d, 0x85, 0xff, 0x07, 0x38, 0xf4, 0x09, 0xe7, 0x84, 0xd1, 0x13,
+	0xcc, 0xb9, 0x13, 0xe2, 0x2e, 0xe1, 0x51, 0xe8, 0x46, 0x84, 0x51, 0x19, 0x68, 0x40, 0xff, 0xdb,
+	0x56, 0x6e, 0x23, 0x76, 0x86, 0x40, 0xaf, 0xa0, 0x94, 0x75, 0x38, 0x47, 0x18, 0xcb, 0x79, 0x6d,
+	0x42, 0x2f, 0x94, 0xff, 0x33, 0xc6, 0x95, 0x24, 0xe1, 0x8c, 0x71, 0x38, 0xa3, 0xc6, 0x08, 0xad,
+	0xae, 0x9e, 0x7f, 0x2d, 0xe5, 0x3e, 0x7e, 0x2b, 0xe9, 0x5d, 0x12, 0x1d, 0xf7, 0x3d, 0xa3, 0xcd,
+	0x7c, 0x73, 0x5c, 0x49, 0xfa, 0xf7, 0x88, 0x77, 0x7a, 0x66, 0x34, 0x08, 0x30, 0x17, 0x06, 0xfe,
+	0xe1, 0xc7, 0xe7, 0x15, 0x60, 0xcf, 0x65, 0x9f, 0xb4, 0x81, 0x31, 0xaa, 0xc0, 0x45, 0xdf, 0x3d,
+	0x75, 0x44, 0x93, 0x06, 0x84, 0x76, 0x9d, 0x1e, 0x1e, 0x38, 0x9c, 0x9c, 0x61, 0x79, 0x42, 0x03,
+	0xfa, 0xa4, 0xfd, 0xaf, 0xef, 0x9e, 0x1e, 0x5e, 0x2f, 0xee, 0xe0, 0x41, 0x8b, 0x9c, 0xe1, 0xa5,
+	0x77, 0x79, 0x38, 0x93, 0x15, 0x51, 0x11, 0xe6, 0x49, 0x47, 0x94, 0x3a, 0x63, 0xe7, 0x49, 0x07,
+	0x6d, 0xc1, 0x99, 0x20, 0x64, 0xec, 0xc8, 0xe1, 0x03, 0x1e, 0x61, 0x5f, 0xce, 0x6b, 0x40, 0x2f,
+	0x96, 0x97, 0x8d, 0xbb, 0x5e, 0x8c, 0xd1, 0x4c, 0xe8, 0x96, 0x80, 0xed, 0x42, 0x70, 0x33, 0x41,
+	0xab, 0x70, 0xaa, 0xdd, 0x0f, 0xe3, 0x34, 0x4e, 0xb1, 0x5c, 0xba, 0x7b, 0x8b, 0x5a, 0x82, 0xd9,
+	0x29, 0x8d, 0x24, 0x38, 0xd1, 0xc3, 0x03, 0x79, 0x52, 0x24, 0x4a, 0x86, 0x48, 0x87, 0x12, 0xf5,
+	0x9c, 0xa0, 0xef, 0x9d, 0x90, 0xb6, 0x43, 0x68, 0xd0, 0x8f, 0xb8, 0x3c, 0xa5, 0x01, 0x7d, 0xd6,
+	0x2e, 0x52, 0xaf, 0x29, 0xe4, 0x6d, 0xa1, 0xa2, 0x65, 0x58, 0xa4, 0x9e, 0xd3, 0x66, 0xbe, 0x4f,
+	0x22, 0x1f, 0xd3, 0x88, 0xcb, 0xd3, 0x82, 0x9b, 0xa5, 0x5e, 0xed, 0x46, 0x44, 0x2a, 0x84, 0xd7,
+	0xcd, 0xa4, 0x91, 0xfc, 0x97, 0x06, 0xf4, 0x7f, 0xec, 0x8c, 0xb2, 0xf2, 0x09, 0xc0, 0x42, 0xa6,
+	0x2c, 0xb4, 0x0e, 0xe5, 0xa6, 0xdd, 0x68, 0x6c, 0x38, 0xad, 0x17, 0xad, 0xfd, 0xfa, 0x73, 0xe7,
+	0x60, 0xaf, 0xd5, 0xac, 0xd7, 0xb6, 0x37, 0xb6, 0xeb, 0xcf, 0xa4, 0x9c, 0xa2, 0x0c, 0x47, 0xda,
+	0x62, 0x06, 0x3f, 0xa0, 0x3c, 0xc0, 0xed, 0xa4, 0xb8, 0x0e, 0x7a, 0x0c, 0x17, 0x6e, 0x39, 0x37,
+	0xed, 0xc6, 0xfe, 0x96, 0xb5, 0x26, 0x01, 0x65, 0x71, 0x38, 0xd2, 0x50, 0xc6, 0xb5, 0x19, 0xb2,
+	0xe8, 0xd8, 0x5a, 0x43, 0x0f, 0x21, 0xba, 0xe5, 0x68, 0xee, 0x36, 0xf6, 0x76, 0xa4, 0xbc, 0xb2,
+	0x30, 0x1c, 0x69, 0x52, 0x86, 0x6f, 0x9e, 0x30, 0xda, 0x53, 0x26, 0xdf, 0xbc, 0x57, 0x73, 0x2b,
+	0xaf, 0x01, 0x9c, 0x12, 0x3d, 0x44, 0x0f, 0xe0, 0x7c, 0xed, 0xc0, 0x3e, 0xac, 0xff, 0x16, 0x51,
+	0x98, 0x05, 0x91, 0x0d, 0x57, 0x82, 0x85, 0x14, 0xae, 0xee, 0x95, 0x57, 0x9f, 0x48, 0x40, 0x29,
+	0x0e, 0x47, 0x1a, 0x14, 0x98, 0x50, 0xd0, 0x3d, 0x38, 0x37, 0x06, 0x76, 0x5b, 0x56, 0xd9, 0xa9,
+	0xac, 0x5b, 0x52, 0x5e, 0x99, 0x1f, 0x8e, 0xb4, 0xd9, 0x14, 0x4a, 0xd4, 0xca, 0xba, 0x95, 0xa6,
+	0xa8, 0x56, 0xcf, 0x2f, 0x55, 0x70, 0x71, 0xa9, 0x82, 0xef, 0x97, 0x2a, 0x78, 0x7b, 0xa5, 0xe6,
+	0x2e, 0xae, 0xd4, 0xdc, 0x97, 0x2b, 0x35, 0xf7, 0xf2, 0x0f, 0x27, 0x3d, 0xb9, 0x0b, 0x4e, 0xb3,
+	0xb7, 0x81, 0x38, 0xef, 0xde, 0xb4, 0xf8, 0x46, 0x2b, 0xbf, 0x06, 0x00, 0x5a, 0xd3, 0x49, 0xb8,
+	0x33, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxVerifyingKeySize != 0 {
+		i = encodeVarintZkverifier(dAtA, i, uint64(m.MaxVerifyingKeySize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintZkverifier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PermissionlessRegistration {
+		i--
+		if m.PermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyingKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyingKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyingKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Registrant) > 0 {
+		i -= len(m.Registrant)
+		copy(dAtA[i:], m.Registrant)
+		i = encodeVarintZkverifier(dAtA, i, uint64(len(m.Registrant)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NbCommitments != 0 {
+		i = encodeVarintZkverifier(dAtA, i, uint64(m.NbCommitments))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NbPublicInputs != 0 {
+		i = encodeVarintZkverifier(dAtA, i, uint64(m.NbPublicInputs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintZkverifier(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Curve != 0 {
+		i = encodeVarintZkverifier(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProofSystem != 0 {
+		i = encodeVarintZkverifier(dAtA, i, uint64(m.ProofSystem))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintZkverifier(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintZkverifier(dAtA []byte, offset int, v uint64) int {
+	offset -= sovZkverifier(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermissionlessRegistration {
+		n += 2
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovZkverifier(uint64(l))
+		}
+	}
+	if m.MaxVerifyingKeySize != 0 {
+		n += 1 + sovZkverifier(uint64(m.MaxVerifyingKeySize))
+	}
+	return n
+}
+
+func (m *VerifyingKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovZkverifier(uint64(l))
+	}
+	if m.ProofSystem != 0 {
+		n += 1 + sovZkverifier(uint64(m.ProofSystem))
+	}
+	if m.Curve != 0 {
+		n += 1 + sovZkverifier(uint64(m.Curve))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovZkverifier(uint64(l))
+	}
+	if m.NbPublicInputs != 0 {
+		n += 1 + sovZkverifier(uint64(m.NbPublicInputs))
+	}
+	if m.NbCommitments != 0 {
+		n += 1 + sovZkverifier(uint64(m.NbCommitments))
+	}
+	l = len(m.Registrant)
+	if l > 0 {
+		n += 1 + l + sovZkverifier(uint64(l))
+	}
+	return n
+}
+
+func sovZkverifier(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozZkverifier(x uint64) (n int) {
+	return sovZkverifier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZkverifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessRegistration = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVerifyingKeySize", wireType)
+			}
+			m.MaxVerifyingKeySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVerifyingKeySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZkverifier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyingKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZkverifier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyingKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
+			}
+			m.ProofSystem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofSystem |= ProofSystem(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= Curve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbPublicInputs", wireType)
+			}
+			m.NbPublicInputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbPublicInputs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbCommitments", wireType)
+			}
+			m.NbCommitments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NbCommitments |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZkverifier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZkverifier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipZkverifier(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowZkverifier
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowZkverifier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthZkverifier
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupZkverifier
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthZkverifier
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthZkverifier        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowZkverifier          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupZkverifier = fmt.Errorf("proto: unexpected end of group")
+)